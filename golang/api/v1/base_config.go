@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// DefaultBaseConfigLimit is the number of base configs the agent keeps, the least recently used one is evicted
+const DefaultBaseConfigLimit = 256
+
+var (
+	ErrBaseConfigNotFound     = errors.New("base config not found")
+	ErrBaseConfigHashMismatch = errors.New("base config hash mismatch")
+	ErrInvalidOverlay         = errors.New("invalid base config overlay")
+)
+
+// BaseConfigRef references the base container config of a request, the container config of the request
+// is the base with the overlay of the environment applied, Config is only sent if the agent does not have the base
+type BaseConfigRef struct {
+	ID   string `json:"id" binding:"required"`
+	Hash string `json:"hash" binding:"required"`
+	// Overlay is a JSON merge patch (RFC 7386) of the container config, objects are merged key by key,
+	// arrays and values are replaced and null removes the value of the base
+	Overlay json.RawMessage `json:"overlay,omitempty"`
+	Config  json.RawMessage `json:"config,omitempty"`
+}
+
+// BaseConfigMissError is the error of a request referencing a base config the agent does not have, e.g. it was
+// evicted or the agent restarted, the platform sends the request again with the config
+type BaseConfigMissError struct {
+	ID   string
+	Hash string
+}
+
+func (e *BaseConfigMissError) Error() string {
+	return fmt.Sprintf("%s: %s with hash %s, the config has to be sent", ErrBaseConfigNotFound, e.ID, e.Hash)
+}
+
+func (e *BaseConfigMissError) Unwrap() error {
+	return ErrBaseConfigNotFound
+}
+
+// BaseConfigHash is the hash of the JSON of a base config, as it is sent
+func BaseConfigHash(config []byte) string {
+	sum := sha256.Sum256(config)
+	return hex.EncodeToString(sum[:])
+}
+
+// MergeContainerConfig applies the overlay to the JSON of the base config, the result only depends
+// on the base and the overlay
+func MergeContainerConfig(base, overlay json.RawMessage) (ContainerConfig, error) {
+	var target any
+	if err := json.Unmarshal(base, &target); err != nil {
+		return ContainerConfig{}, fmt.Errorf("failed to parse the base config: %w", err)
+	}
+
+	if len(overlay) > 0 {
+		var patch any
+		if err := json.Unmarshal(overlay, &patch); err != nil {
+			return ContainerConfig{}, fmt.Errorf("%w: %w", ErrInvalidOverlay, err)
+		}
+		if _, ok := patch.(map[string]any); !ok {
+			return ContainerConfig{}, fmt.Errorf("%w: it is not an object", ErrInvalidOverlay)
+		}
+
+		target = mergePatch(target, patch)
+	}
+
+	merged, err := json.Marshal(target)
+	if err != nil {
+		return ContainerConfig{}, err
+	}
+
+	config := ContainerConfig{}
+	if err = json.Unmarshal(merged, &config); err != nil {
+		return ContainerConfig{}, fmt.Errorf("%w: %w", ErrInvalidOverlay, err)
+	}
+
+	return config, nil
+}
+
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
+
+type storedBaseConfig struct {
+	hash   string
+	config json.RawMessage
+	// used is the order of the last use, the lowest one is evicted first
+	used uint64
+}
+
+// BaseConfigStore keeps the last base config of every ID the agent received, up to its limit
+type BaseConfigStore struct {
+	configs map[string]*storedBaseConfig
+	limit   int
+	uses    uint64
+	mutex   sync.Mutex
+}
+
+// NewBaseConfigStore keeps the limit of base configs, if it is not positive, there is no limit
+func NewBaseConfigStore(limit int) *BaseConfigStore {
+	return &BaseConfigStore{configs: map[string]*storedBaseConfig{}, limit: limit}
+}
+
+// get stores the config of the reference if it is sent, otherwise it is looked up by its ID and hash
+func (s *BaseConfigStore) get(ref *BaseConfigRef) (json.RawMessage, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.uses++
+	if len(ref.Config) > 0 {
+		if hash := BaseConfigHash(ref.Config); hash != ref.Hash {
+			return nil, fmt.Errorf("%w: %s is %s instead of %s", ErrBaseConfigHashMismatch, ref.ID, hash, ref.Hash)
+		}
+
+		s.configs[ref.ID] = &storedBaseConfig{hash: ref.Hash, config: ref.Config, used: s.uses}
+		s.evict()
+		return ref.Config, nil
+	}
+
+	stored, ok := s.configs[ref.ID]
+	if !ok || stored.hash != ref.Hash {
+		return nil, &BaseConfigMissError{ID: ref.ID, Hash: ref.Hash}
+	}
+	stored.used = s.uses
+
+	return stored.config, nil
+}
+
+// evict removes the least recently used configs over the limit
+func (s *BaseConfigStore) evict() {
+	for s.limit > 0 && len(s.configs) > s.limit {
+		oldest := ""
+		for id, it := range s.configs {
+			if oldest == "" || it.used < s.configs[oldest].used {
+				oldest = id
+			}
+		}
+		delete(s.configs, oldest)
+	}
+}
+
+// Invalidate removes the base config of the ID, or every one if the ID is empty, the deploy requests
+// referencing them fail until Crux sends them again
+func (s *BaseConfigStore) Invalidate(id string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if id == "" {
+		removed := len(s.configs)
+		s.configs = map[string]*storedBaseConfig{}
+		return removed
+	}
+
+	if _, ok := s.configs[id]; !ok {
+		return 0
+	}
+	delete(s.configs, id)
+
+	return 1
+}
+
+// Resolve replaces the container config of the request with the merged one, if it has a base,
+// the name of the container, its prefix and the secrets encrypted for the node are kept from the request
+func (s *BaseConfigStore) Resolve(req *DeployImageRequest) error {
+	if req.Base == nil {
+		return nil
+	}
+
+	base, err := s.get(req.Base)
+	if err != nil {
+		return err
+	}
+
+	config, err := MergeContainerConfig(base, req.Base.Overlay)
+	if err != nil {
+		return fmt.Errorf("base config %s: %w", req.Base.ID, err)
+	}
+
+	if req.ContainerConfig.Container != "" {
+		config.Container = req.ContainerConfig.Container
+	}
+	if req.ContainerConfig.ContainerPreName != "" {
+		config.ContainerPreName = req.ContainerConfig.ContainerPreName
+	}
+	if len(req.ContainerConfig.Secrets) > 0 {
+		if config.Secrets == nil {
+			config.Secrets = map[string]string{}
+		}
+		maps.Copy(config.Secrets, req.ContainerConfig.Secrets)
+	}
+	req.ContainerConfig = config
+
+	return nil
+}
@@ -0,0 +1,121 @@
+//go:build unit
+// +build unit
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+)
+
+const baseConfigJSON = `{
+	"container": "base",
+	"environment": {"LOG_LEVEL": "info", "FEATURE": "on"},
+	"args": ["--verbose"],
+	"networkMode": "bridge",
+	"restartPolicy": "always"
+}`
+
+func TestMergeContainerConfig(t *testing.T) {
+	overlay := json.RawMessage(`{"environment": {"LOG_LEVEL": "debug", "FEATURE": null, "REGION": "eu"}, "args": [], "expose": true}`)
+
+	config, err := v1.MergeContainerConfig(json.RawMessage(baseConfigJSON), overlay)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "debug", "REGION": "eu"}, config.Environment)
+	assert.Equal(t, []string{}, config.Args)
+	assert.Equal(t, "bridge", config.NetworkMode)
+	assert.True(t, config.Expose)
+
+	config, err = v1.MergeContainerConfig(json.RawMessage(baseConfigJSON), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "info", "FEATURE": "on"}, config.Environment)
+	assert.Equal(t, []string{"--verbose"}, config.Args)
+
+	_, err = v1.MergeContainerConfig(json.RawMessage(baseConfigJSON), json.RawMessage(`["args"]`))
+	assert.ErrorIs(t, err, v1.ErrInvalidOverlay)
+
+	_, err = v1.MergeContainerConfig(json.RawMessage(baseConfigJSON), json.RawMessage(`{"args": "--verbose"}`))
+	assert.ErrorIs(t, err, v1.ErrInvalidOverlay)
+}
+
+func TestBaseConfigStoreResolve(t *testing.T) {
+	store := v1.NewBaseConfigStore(v1.DefaultBaseConfigLimit)
+	hash := v1.BaseConfigHash([]byte(baseConfigJSON))
+
+	request := func(ref v1.BaseConfigRef) *v1.DeployImageRequest {
+		return &v1.DeployImageRequest{
+			ContainerConfig: v1.ContainerConfig{Container: "web", ContainerPreName: "staging"},
+			Base:            &ref,
+		}
+	}
+
+	req := request(v1.BaseConfigRef{ID: "web-base", Hash: hash, Overlay: json.RawMessage(`{"environment": {"REGION": "eu"}}`)})
+	assert.ErrorIs(t, store.Resolve(req), v1.ErrBaseConfigNotFound)
+
+	req = request(v1.BaseConfigRef{ID: "web-base", Hash: "invalid", Config: json.RawMessage(baseConfigJSON)})
+	assert.ErrorIs(t, store.Resolve(req), v1.ErrBaseConfigHashMismatch)
+
+	req = request(v1.BaseConfigRef{ID: "web-base", Hash: hash, Config: json.RawMessage(baseConfigJSON)})
+	assert.NoError(t, store.Resolve(req))
+
+	// the base is sent once, the other environments only reference it
+	req = request(v1.BaseConfigRef{ID: "web-base", Hash: hash, Overlay: json.RawMessage(`{"environment": {"REGION": "eu"}}`)})
+	assert.NoError(t, store.Resolve(req))
+	assert.Equal(t, "web", req.ContainerConfig.Container)
+	assert.Equal(t, "staging", req.ContainerConfig.ContainerPreName)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "info", "FEATURE": "on", "REGION": "eu"}, req.ContainerConfig.Environment)
+
+	// the secrets are encrypted for the node, they are sent with every request
+	req = request(v1.BaseConfigRef{ID: "web-base", Hash: hash})
+	req.ContainerConfig.Secrets = map[string]string{"DB_PASSWORD": "encrypted"}
+	assert.NoError(t, store.Resolve(req))
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "encrypted"}, req.ContainerConfig.Secrets)
+
+	req = request(v1.BaseConfigRef{ID: "web-base", Hash: v1.BaseConfigHash([]byte("{}"))})
+	err := store.Resolve(req)
+	assert.ErrorIs(t, err, v1.ErrBaseConfigNotFound)
+
+	miss := &v1.BaseConfigMissError{}
+	assert.ErrorAs(t, err, &miss)
+	assert.Equal(t, "web-base", miss.ID)
+}
+
+func TestBaseConfigStoreEviction(t *testing.T) {
+	store := v1.NewBaseConfigStore(2)
+	hash := v1.BaseConfigHash([]byte(baseConfigJSON))
+
+	for _, id := range []string{"web-base", "api-base"} {
+		req := &v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: id, Hash: hash, Config: json.RawMessage(baseConfigJSON)}}
+		assert.NoError(t, store.Resolve(req))
+	}
+
+	// the web base is used again, so the api base is the least recently used one
+	assert.NoError(t, store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "web-base", Hash: hash}}))
+	req := &v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "worker-base", Hash: hash, Config: json.RawMessage(baseConfigJSON)}}
+	assert.NoError(t, store.Resolve(req))
+
+	assert.NoError(t, store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "web-base", Hash: hash}}))
+	assert.NoError(t, store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "worker-base", Hash: hash}}))
+	assert.ErrorIs(t, store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "api-base", Hash: hash}}), v1.ErrBaseConfigNotFound)
+}
+
+func TestBaseConfigStoreInvalidate(t *testing.T) {
+	store := v1.NewBaseConfigStore(v1.DefaultBaseConfigLimit)
+	hash := v1.BaseConfigHash([]byte(baseConfigJSON))
+
+	for _, id := range []string{"web-base", "api-base"} {
+		req := &v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: id, Hash: hash, Config: json.RawMessage(baseConfigJSON)}}
+		assert.NoError(t, store.Resolve(req))
+	}
+
+	assert.Equal(t, 1, store.Invalidate("web-base"))
+	assert.Equal(t, 0, store.Invalidate("web-base"))
+	assert.ErrorIs(t, store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "web-base", Hash: hash}}), v1.ErrBaseConfigNotFound)
+	assert.NoError(t, store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "api-base", Hash: hash}}))
+
+	assert.Equal(t, 1, store.Invalidate(""))
+}
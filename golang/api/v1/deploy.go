@@ -21,15 +21,20 @@
 )
 
 type DeployImageRequest struct {
-	RegistryAuth    *imageHelper.RegistryAuth `json:"RegistryAuth,omitempty"`
-	Registry        *string                   `json:"Registry,omitempty"`
-	RequestID       string                    `json:"RequestId" binding:"required"`
-	ImageName       string                    `json:"ImageName" binding:"required"`
-	Tag             string                    `json:"Tag" binding:"required"`
-	Issuer          string                    `json:"Issuer"`
-	InstanceConfig  InstanceConfig            `json:"InstanceConfig" binding:"required"`
-	RuntimeConfig   Base64JSONBytes           `json:"RuntimeConfig,omitempty"`
-	ContainerConfig ContainerConfig           `json:"ContainerConfig" binding:"required"`
+	RegistryAuth *imageHelper.RegistryAuth `json:"RegistryAuth,omitempty"`
+	Registry     *string                   `json:"Registry,omitempty"`
+	// Base is the shared config the container config is merged from, resolved by the agent
+	Base            *BaseConfigRef  `json:"Base,omitempty"`
+	RequestID       string          `json:"RequestId" binding:"required"`
+	DeploymentID    string          `json:"DeploymentId,omitempty"`
+	ImageName       string          `json:"ImageName" binding:"required"`
+	Tag             string          `json:"Tag" binding:"required"`
+	Issuer          string          `json:"Issuer"`
+	InstanceConfig  InstanceConfig  `json:"InstanceConfig" binding:"required"`
+	RuntimeConfig   Base64JSONBytes `json:"RuntimeConfig,omitempty"`
+	ContainerConfig ContainerConfig `json:"ContainerConfig" binding:"required"`
+	// RecreateUnchanged disables skipping the containers running with the same configuration and image
+	RecreateUnchanged bool `json:"RecreateUnchanged,omitempty"`
 }
 type VersionData struct {
 	Version      string `json:"version" binding:"required"`
@@ -65,6 +70,23 @@ type DeployImageResponse struct {
 
 type DeployVersionResponse []DeployImageResponse
 
+// DeploymentChange is a field of the effective configuration which differs from the running deployment,
+// Old or New is nil if the field is added or removed
+type DeploymentChange struct {
+	Old  *string `json:"old,omitempty"`
+	New  *string `json:"new,omitempty"`
+	Path string  `json:"path"`
+}
+
+// DeploymentDiff compares a deploy request with the snapshot of the running container
+type DeploymentDiff struct {
+	Container   string             `json:"container"`
+	CurrentHash string             `json:"currentHash,omitempty"`
+	NewHash     string             `json:"newHash"`
+	Changes     []DeploymentChange `json:"changes"`
+	Deployed    bool               `json:"deployed"`
+}
+
 type Base64JSONBytes []byte
 
 type InstanceConfig struct {
@@ -86,8 +108,29 @@ func (i *InstanceConfig) Strings() []string {
 	return str
 }
 
+// probe types, a probe without a type is an http probe
+const (
+	ProbeHTTP = "http"
+	ProbeTCP  = "tcp"
+	ProbeExec = "exec"
+)
+
+// Probe is a health check of the container, the unset timings and thresholds are the defaults of the agent
 type Probe struct {
-	Path string `json:"path"`
+	// Headers are sent by http probes
+	Headers             map[string]string `json:"headers,omitempty"`
+	InitialDelaySeconds *int32            `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       *int32            `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      *int32            `json:"timeoutSeconds,omitempty"`
+	SuccessThreshold    *int32            `json:"successThreshold,omitempty"`
+	FailureThreshold    *int32            `json:"failureThreshold,omitempty"`
+	Type                string            `json:"type,omitempty"`
+	Path                string            `json:"path"`
+	// Scheme of http probes, HTTP or HTTPS
+	Scheme  string   `json:"scheme,omitempty"`
+	Command []string `json:"command,omitempty"`
+	// Port of http and tcp probes, the port of the health check config if it is not set, the range is validated by crane
+	Port int32 `json:"port,omitempty"`
 }
 
 type RuntimeConfigType string
@@ -137,20 +180,42 @@ type ExpectedState struct {
 	State    ContainerState `json:"state"`
 }
 
+// give up actions of the restart budget
+const (
+	RestartGiveUpBackoff = "backoff"
+	RestartGiveUpStop    = "stop"
+)
+
+// RestartBudget limits the restarts of a crash-looping container on top of its restart policy,
+// the ones over the budget of the window are delayed by an exponential backoff
+type RestartBudget struct {
+	// GiveUpAction is what happens when the backoff would exceed its max, backoff keeps it at the max
+	GiveUpAction      string `json:"giveUpAction,omitempty"`
+	MaxRestarts       uint32 `json:"maxRestarts"`
+	WindowSeconds     uint32 `json:"windowSeconds"`
+	BackoffSeconds    uint32 `json:"backoffSeconds,omitempty"`
+	MaxBackoffSeconds uint32 `json:"maxBackoffSeconds,omitempty"`
+}
+
 type ContainerConfig struct {
+	NetworkOptions map[string]builder.NetworkOptions `json:"networkOptions,omitempty"`
+
 	HealthCheckConfig  HealthCheckConfig           `json:"healthCheck"`
 	Labels             Markers                     `json:"labels"`
 	Annotations        Markers                     `json:"annotations"`
 	ConfigContainer    *ConfigContainer            `json:"configContainer,omitempty"`
 	ImportContainer    *ImportContainer            `json:"importContainer,omitempty"`
 	ExpectedState      *ExpectedState              `json:"expectedState,omitempty"`
+	RestartBudget      *RestartBudget              `json:"restartBudget,omitempty"`
 	Environment        map[string]string           `json:"environment"`
 	Secrets            map[string]string           `json:"secrets,omitempty"`
 	LogConfig          *container.LogConfig        `json:"logConfig"`
 	ExtraLBAnnotations map[string]string           `json:"extraLBAnnotations,omitempty"`
 	User               *int64                      `json:"user"`
 	Metrics            *Metrics                    `json:"metrics,omitempty"`
+	WorkloadIdentity   *WorkloadIdentity           `json:"workloadIdentity,omitempty"`
 	DockerLabels       map[string]string           `json:"dockerLabels"`
+	RuntimeOptions     map[string]string           `json:"runtimeOptions,omitempty"`
 	ResourceConfig     ResourceConfig              `json:"resourceConfig"`
 	IngressPath        string                      `json:"ingressPath"`
 	Container          string                      `json:"container" binding:"required"`
@@ -161,10 +226,19 @@ type ContainerConfig struct {
 	IngressName        string                      `json:"ingressName"`
 	ContainerPreName   string                      `json:"containerPreName"`
 	NetworkMode        string                      `json:"networkMode"`
+	Isolation          string                      `json:"isolation,omitempty"`
+	PidMode            string                      `json:"pidMode,omitempty"`
+	IpcMode            string                      `json:"ipcMode,omitempty"`
+	Hostname           string                      `json:"hostname,omitempty"`
+	Domainname         string                      `json:"domainname,omitempty"`
+	Runtime            string                      `json:"runtime,omitempty"`
 	RestartPolicy      container.RestartPolicyMode `json:"restartPolicy"`
 	RuntimeConfigType  RuntimeConfigType           `json:"runtimeConfigType"`
+	ServiceType        ServiceType                 `json:"serviceType,omitempty"`
 	InitContainers     []InitContainer             `json:"initContainers,omitempty" binding:"dive"`
 	Volumes            []Volume                    `json:"volumes,omitempty" binding:"dive"`
+	ConfigFiles        []ConfigFile                `json:"configFiles,omitempty" binding:"dive"`
+	ProjectedTokens    []ProjectedToken            `json:"projectedTokens,omitempty" binding:"dive"`
 	Args               []string                    `json:"args"`
 	Command            []string                    `json:"command"`
 	Networks           []string                    `json:"networks"`
@@ -172,6 +246,7 @@ type ContainerConfig struct {
 	PortRanges         []builder.PortRangeBinding  `json:"portRanges" binding:"dive"`
 	CustomHeaders      []string                    `json:"customHeaders,omitempty"`
 	Mounts             []string                    `json:"mount"`
+	LogFiles           []string                    `json:"logFiles,omitempty"`
 	IngressPort        uint16                      `json:"ingressPort"`
 	Shared             bool                        `json:"shared"`
 	UseLoadBalancer    bool                        `json:"useLoadBalancer"`
@@ -210,6 +285,28 @@ func (c *ContainerConfig) Strings(appConfig *config.CommonConfiguration) []strin
 		str = append(str, fmt.Sprintf("Volumes: %v", c.Volumes))
 	}
 
+	if len(c.ConfigFiles) > 0 {
+		paths := []string{}
+		for _, file := range c.ConfigFiles {
+			paths = append(paths, file.Path)
+		}
+		str = append(str, fmt.Sprintf("Config files: %v", paths))
+	}
+
+	if c.WorkloadIdentity != nil && c.WorkloadIdentity.ServiceAccount != "" {
+		str = append(str, fmt.Sprintf("Service account: %s", c.WorkloadIdentity.ServiceAccount))
+	} else if c.WorkloadIdentity != nil {
+		str = append(str, fmt.Sprintf("Workload identity: %v", c.WorkloadIdentity.Annotations))
+	}
+
+	if len(c.ProjectedTokens) > 0 {
+		audiences := []string{}
+		for _, token := range c.ProjectedTokens {
+			audiences = append(audiences, token.Audience)
+		}
+		str = append(str, fmt.Sprintf("Projected tokens: %v", audiences))
+	}
+
 	if len(c.InitContainers) > 0 {
 		names := []string{}
 		for _, ic := range c.InitContainers {
@@ -265,6 +362,34 @@ type InitContainer struct {
 	UseParent bool              `json:"useParent"`
 }
 
+// ConfigFile is a file mounted into the container, kubernetes only
+// crane stores plain files in a ConfigMap and secret files in a Secret
+type ConfigFile struct {
+	// absolute path of the file in the container
+	Path string `json:"path" binding:"required"`
+	// content of the file, encrypted the same way as the secrets of the container if secret is set
+	Content string `json:"content"`
+	Secret  bool   `json:"secret,omitempty"`
+}
+
+// WorkloadIdentity is the service account of the pods, kubernetes only
+// crane creates a service account named after the container with the annotations,
+// eg. iam.gke.io/gcp-service-account or eks.amazonaws.com/role-arn
+type WorkloadIdentity struct {
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// existing service account used instead, the annotations are not applied to it
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+// ProjectedToken is a service account token for a custom audience, kubernetes only
+type ProjectedToken struct {
+	// expiration of the token, the kubelet rotates it before, at least 600 if set
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+	// absolute path of the token file in the container
+	Path     string `json:"path" binding:"required"`
+	Audience string `json:"audience" binding:"required"`
+}
+
 type VolumeLink struct {
 	Name string `json:"name"`
 	Path string `json:"path"`
@@ -327,6 +452,19 @@ type Volume struct {
 	SecretVolumeType        VolumeType = "secret"
 )
 
+// ServiceType is the shape of the kubernetes service of the container,
+// if it is empty, the service is a load balancer or cluster IP based on UseLoadBalancer
+type ServiceType string
+
+const (
+	ClusterIPServiceType ServiceType = "ClusterIP"
+	// HeadlessServiceType is a cluster IP service without an IP, the DNS name resolves to the pods
+	HeadlessServiceType ServiceType = "Headless"
+	// NodePortServiceType uses the port bindings as node ports, without a binding the port is allocated by the cluster
+	NodePortServiceType     ServiceType = "NodePort"
+	LoadBalancerServiceType ServiceType = "LoadBalancer"
+)
+
 type ConfigContainer struct {
 	Image     string `json:"image" binding:"required"`
 	Volume    string `json:"volume" binding:"required"`
@@ -374,6 +512,21 @@ func (vt *VolumeType) UnmarshalJSON(b []byte) error {
 	return errors.New("Invalid volume type")
 }
 
+func (st *ServiceType) UnmarshalJSON(b []byte) error {
+	var s string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	serviceType := ServiceType(s)
+	switch serviceType {
+	case "", ClusterIPServiceType, HeadlessServiceType, NodePortServiceType, LoadBalancerServiceType:
+		*st = serviceType
+		return nil
+	}
+	return fmt.Errorf("invalid service type: %s", s)
+}
+
 // setting known defaults from constants
 func SetDeploymentDefaults(
 	deployImageRequest *DeployImageRequest,
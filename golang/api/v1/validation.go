@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/api/validation"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+)
+
+// FieldError is an invalid field of a deploy request, Path uses the JSON names of the fields
+type FieldError struct {
+	Path       string `json:"path"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+func (e *FieldError) String() string {
+	if e.Suggestion == "" {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+
+	return fmt.Sprintf("%s: %s, %s", e.Path, e.Message, e.Suggestion)
+}
+
+// ValidationError lists every invalid field of a deploy request at once
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *ValidationError) Error() string {
+	fields := []string{}
+	for i := range e.Fields {
+		fields = append(fields, e.Fields[i].String())
+	}
+
+	return fmt.Sprintf("invalid deploy request: %s", strings.Join(fields, "; "))
+}
+
+// Add appends an invalid field, suggestion is optional
+func (e *ValidationError) Add(fieldPath, message, suggestion string) {
+	e.Fields = append(e.Fields, FieldError{Path: fieldPath, Message: message, Suggestion: suggestion})
+}
+
+// ValidationRule checks the parts of a request an agent depends on, on top of the common rules
+type ValidationRule func(req *DeployImageRequest, result *ValidationError)
+
+var requestValidator = newRequestValidator()
+
+// newRequestValidator checks the binding tags, the field names of the errors are the JSON names
+func newRequestValidator() *validator.Validate {
+	validate := validator.New()
+	validate.SetTagName("binding")
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+
+	// the size is optional, the agents use their defaults without it
+	err := validate.RegisterValidation("validSize", func(fl validator.FieldLevel) bool {
+		return fl.Field().String() == "" || ValidSize(fl)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return validate
+}
+
+// ValidateDeployImageRequest runs the binding tags, the common rules and the rules of the agent,
+// the error is a *ValidationError if any field is invalid
+func ValidateDeployImageRequest(req *DeployImageRequest, rules ...ValidationRule) error {
+	result := &ValidationError{}
+
+	err := requestValidator.Struct(req)
+	validationErrors := validator.ValidationErrors{}
+	if errors.As(err, &validationErrors) {
+		for _, fieldErr := range validationErrors {
+			fieldPath := strings.TrimPrefix(fieldErr.Namespace(), "DeployImageRequest.")
+			message, suggestion := describeTagError(fieldErr)
+			result.Add(fieldPath, message, suggestion)
+		}
+	} else if err != nil {
+		return err
+	}
+
+	rules = append([]ValidationRule{validateNames, validateImage, validatePorts, validateVolumes, validateResources}, rules...)
+	for _, rule := range rules {
+		rule(req, result)
+	}
+
+	if len(result.Fields) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func describeTagError(fieldErr validator.FieldError) (message, suggestion string) {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required", ""
+	case "gte":
+		return fmt.Sprintf("must be at least %s", fieldErr.Param()), ""
+	case "lte":
+		return fmt.Sprintf("must be at most %s", fieldErr.Param()), ""
+	case "gtefield":
+		return fmt.Sprintf("must be at least the value of %s", fieldErr.Param()), ""
+	case "validSize":
+		return fmt.Sprintf("%q is not a valid size", fieldErr.Value()), "use a quantity like 512Mi or 10Gi"
+	default:
+		return fmt.Sprintf("failed the %s check", fieldErr.Tag()), ""
+	}
+}
+
+// validateNames checks the prefix and the container name, both are used as DNS labels by the agents
+func validateNames(req *DeployImageRequest, result *ValidationError) {
+	names := map[string]string{
+		"InstanceConfig.containerPreName": req.InstanceConfig.ContainerPreName,
+		"ContainerConfig.container":       req.ContainerConfig.Container,
+	}
+
+	for _, fieldPath := range []string{"InstanceConfig.containerPreName", "ContainerConfig.container"} {
+		name := names[fieldPath]
+		if name == "" {
+			continue
+		}
+
+		if errs := validation.NameIsDNSLabel(name, false); len(errs) > 0 {
+			result.Add(fieldPath, strings.Join(errs, ", "),
+				fmt.Sprintf("use lowercase letters, digits and dashes, eg. %q", suggestDNSLabel(name)))
+		}
+	}
+}
+
+// suggestDNSLabel lowercases the name and replaces the invalid characters with dashes
+func suggestDNSLabel(name string) string {
+	label := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(name))
+
+	return strings.Trim(label, "-")
+}
+
+func validateImage(req *DeployImageRequest, result *ValidationError) {
+	if req.ImageName == "" {
+		return
+	}
+
+	_, err := imageHelper.ExpandImageName(util.JoinV(":", req.ImageName, req.Tag))
+	if err != nil {
+		result.Add("ImageName", fmt.Sprintf("%q is not a valid image reference: %s", req.ImageName, err.Error()),
+			"use the repository without the tag, eg. library/nginx, the tag goes into Tag")
+	}
+}
+
+func validatePorts(req *DeployImageRequest, result *ValidationError) {
+	exposed := map[uint16]int{}
+	bound := map[uint16]int{}
+	for i, port := range req.ContainerConfig.Ports {
+		if first, ok := exposed[port.ExposedPort]; ok && port.ExposedPort != 0 {
+			result.Add(fmt.Sprintf("ContainerConfig.port[%d].exposedPort", i),
+				fmt.Sprintf("port %d is already exposed by port[%d]", port.ExposedPort, first), "remove the duplicate")
+		} else {
+			exposed[port.ExposedPort] = i
+		}
+
+		if port.PortBinding == nil {
+			continue
+		}
+
+		if first, ok := bound[*port.PortBinding]; ok {
+			result.Add(fmt.Sprintf("ContainerConfig.port[%d].portBinding", i),
+				fmt.Sprintf("port %d is already bound by port[%d]", *port.PortBinding, first), "bind another port")
+		} else {
+			bound[*port.PortBinding] = i
+		}
+	}
+}
+
+func validateVolumes(req *DeployImageRequest, result *ValidationError) {
+	names := map[string]int{}
+	for i, volume := range req.ContainerConfig.Volumes {
+		if volume.Name == "" {
+			result.Add(fmt.Sprintf("ContainerConfig.volumes[%d].name", i), "is required", "")
+		} else if first, ok := names[volume.Name]; ok {
+			result.Add(fmt.Sprintf("ContainerConfig.volumes[%d].name", i),
+				fmt.Sprintf("volume %s is already defined by volumes[%d]", volume.Name, first), "use a unique name")
+		} else {
+			names[volume.Name] = i
+		}
+
+		if volume.Path != "" && !path.IsAbs(volume.Path) {
+			result.Add(fmt.Sprintf("ContainerConfig.volumes[%d].path", i),
+				fmt.Sprintf("%q is not an absolute path", volume.Path), fmt.Sprintf("use %q", "/"+volume.Path))
+		}
+	}
+
+	for i, file := range req.ContainerConfig.ConfigFiles {
+		if file.Path != "" && !path.IsAbs(file.Path) {
+			result.Add(fmt.Sprintf("ContainerConfig.configFiles[%d].path", i),
+				fmt.Sprintf("%q is not an absolute path", file.Path), fmt.Sprintf("use %q", "/"+file.Path))
+		}
+	}
+}
+
+func validateResources(req *DeployImageRequest, result *ValidationError) {
+	const (
+		cpuSuggestion    = "use cores or millicores, eg. 0.5 or 500m"
+		memorySuggestion = "use a quantity like 256Mi or 1Gi"
+	)
+
+	quantities := []struct {
+		path       string
+		value      string
+		suggestion string
+	}{
+		{"ContainerConfig.resourceConfig.limits.cpu", req.ContainerConfig.ResourceConfig.Limits.CPU, cpuSuggestion},
+		{"ContainerConfig.resourceConfig.limits.memory", req.ContainerConfig.ResourceConfig.Limits.Memory, memorySuggestion},
+		{"ContainerConfig.resourceConfig.requests.cpu", req.ContainerConfig.ResourceConfig.Requests.CPU, cpuSuggestion},
+		{"ContainerConfig.resourceConfig.requests.memory", req.ContainerConfig.ResourceConfig.Requests.Memory, memorySuggestion},
+	}
+
+	for _, quantity := range quantities {
+		if quantity.value == "" {
+			continue
+		}
+
+		if _, err := resource.ParseQuantity(quantity.value); err != nil {
+			result.Add(quantity.path, fmt.Sprintf("%q is not a valid quantity", quantity.value), quantity.suggestion)
+		}
+	}
+}
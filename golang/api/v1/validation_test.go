@@ -0,0 +1,85 @@
+//go:build unit
+// +build unit
+
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+func validDeployImageRequest() *v1.DeployImageRequest {
+	return &v1.DeployImageRequest{
+		RequestID: "request-id",
+		ImageName: "library/nginx",
+		Tag:       "1.25",
+		InstanceConfig: v1.InstanceConfig{
+			ContainerPreName: "prefix",
+		},
+		ContainerConfig: v1.ContainerConfig{
+			Container: "web",
+			Ports:     []container.PortBinding{{ExposedPort: 80, PortBinding: pointer.ToUint16(8080)}, {ExposedPort: 443}},
+			Volumes:   []v1.Volume{{Name: "data", Path: "/data"}},
+		},
+	}
+}
+
+func TestValidateDeployImageRequest(t *testing.T) {
+	assert.NoError(t, v1.ValidateDeployImageRequest(validDeployImageRequest()))
+}
+
+func fieldPaths(t *testing.T, err error) []string {
+	t.Helper()
+
+	validationErr := &v1.ValidationError{}
+	if !assert.ErrorAs(t, err, &validationErr) {
+		return nil
+	}
+
+	paths := []string{}
+	for _, field := range validationErr.Fields {
+		assert.NotEmpty(t, field.Message, field.Path)
+		paths = append(paths, field.Path)
+	}
+
+	return paths
+}
+
+func TestValidateDeployImageRequestFields(t *testing.T) {
+	req := validDeployImageRequest()
+	req.RequestID = ""
+	req.InstanceConfig.ContainerPreName = "My_Prefix"
+	req.ContainerConfig.Ports = append(req.ContainerConfig.Ports, container.PortBinding{ExposedPort: 80, PortBinding: pointer.ToUint16(8080)})
+	req.ContainerConfig.Volumes = append(req.ContainerConfig.Volumes, v1.Volume{Name: "data", Path: "cache", Size: "lots"})
+	req.ContainerConfig.ResourceConfig.Limits.Memory = "1 GB"
+
+	err := v1.ValidateDeployImageRequest(req)
+	assert.ElementsMatch(t, []string{
+		"RequestId",
+		"ContainerConfig.volumes[1].size",
+		"InstanceConfig.containerPreName",
+		"ContainerConfig.port[2].exposedPort",
+		"ContainerConfig.port[2].portBinding",
+		"ContainerConfig.volumes[1].name",
+		"ContainerConfig.volumes[1].path",
+		"ContainerConfig.resourceConfig.limits.memory",
+	}, fieldPaths(t, err))
+	assert.ErrorContains(t, err, `InstanceConfig.containerPreName: a lowercase RFC 1123 label must consist of`)
+	assert.ErrorContains(t, err, `use lowercase letters, digits and dashes, eg. "my-prefix"`)
+	assert.ErrorContains(t, err, `ContainerConfig.volumes[1].path: "cache" is not an absolute path, use "/cache"`)
+}
+
+func TestValidateDeployImageRequestRules(t *testing.T) {
+	req := validDeployImageRequest()
+
+	err := v1.ValidateDeployImageRequest(req, func(req *v1.DeployImageRequest, result *v1.ValidationError) {
+		result.Add("ContainerConfig.networkMode", "is not supported", "")
+	})
+	assert.Equal(t, []string{"ContainerConfig.networkMode"}, fieldPaths(t, err))
+	assert.EqualError(t, err, "invalid deploy request: ContainerConfig.networkMode: is not supported")
+}
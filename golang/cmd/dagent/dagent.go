@@ -1,11 +1,13 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/rs/zerolog/log"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/health"
+	"github.com/dyrector-io/dyrectorio/golang/internal/systemd"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	"github.com/dyrector-io/dyrectorio/golang/internal/version"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent"
@@ -14,7 +16,13 @@
 	cli "github.com/urfave/cli/v2"
 )
 
-func serve(_ *cli.Context) error {
+const flagSystemdUnit = "systemd-unit"
+
+func serve(cCtx *cli.Context) error {
+	if cCtx.Bool(flagSystemdUnit) {
+		return printSystemdUnit()
+	}
+
 	cfg := config.Configuration{}
 
 	err := util.ReadConfig(&cfg)
@@ -54,6 +62,33 @@ func getHealth(_ *cli.Context) error {
 	return nil
 }
 
+// printSystemdUnit writes a unit for the running binary, data path is taken from the environment
+func printSystemdUnit() error {
+	cfg := config.Configuration{}
+
+	err := util.ReadConfig(&cfg)
+	if err != nil {
+		return err
+	}
+
+	opts := systemd.DefaultUnitOptions()
+	opts.DataPath = cfg.DataMountPath
+
+	executable, err := os.Executable()
+	if err == nil {
+		opts.BinaryPath = executable
+	}
+
+	unit, err := systemd.RenderDagentUnit(opts)
+	if err != nil {
+		return err
+	}
+
+	//nolint:forbidigo
+	fmt.Print(unit)
+	return nil
+}
+
 func main() {
 	app := &cli.App{
 		Name:     "dagent",
@@ -61,6 +96,12 @@ func main() {
 		HelpName: "dagent",
 		Usage:    "cli tool for serving a Docker agent of dyrector.io",
 		Action:   serve,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  flagSystemdUnit,
+				Usage: "Print a systemd service unit for running this binary instead of serving",
+			},
+		},
 
 		Commands: []*cli.Command{
 			{
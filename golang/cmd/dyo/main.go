@@ -16,7 +16,8 @@ func main() {
 
 	app := cli.InitCLI()
 
+	// the commands return their errors, the message is the error, so the failure notifications tell the cause
 	if err := app.Run(os.Args); err != nil {
-		log.Fatal().Err(err).Send()
+		log.Fatal().Msg(err.Error())
 	}
 }
@@ -0,0 +1,62 @@
+// Package cache routes the invalidation events of the platform to what the agents keep from the earlier commands,
+// so the entries rotated on the server are refreshed on their next use
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// Invalidator is a cache the platform can invalidate, the result is the number of the removed entries
+type Invalidator interface {
+	Invalidate(key string) int
+}
+
+// Registry maps the kinds of the invalidation events to the caches of the agent
+type Registry struct {
+	caches map[agent.CacheKind][]Invalidator
+	mutex  sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{caches: map[agent.CacheKind][]Invalidator{}}
+}
+
+func (r *Registry) Register(kind agent.CacheKind, cache Invalidator) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.caches[kind] = append(r.caches[kind], cache)
+}
+
+// invalidate removes the entries of the request, an unspecified kind invalidates every cache
+func (r *Registry) invalidate(req *agent.CacheInvalidationRequest) int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	removed := 0
+	for kind, caches := range r.caches {
+		if req.GetKind() != agent.CacheKind_CACHE_KIND_UNSPECIFIED && req.GetKind() != kind {
+			continue
+		}
+
+		for _, it := range caches {
+			removed += it.Invalidate(req.GetKey())
+		}
+	}
+
+	return removed
+}
+
+// Invalidate is the handler of the invalidation events of the platform, the next use of the entries refreshes them
+func (r *Registry) Invalidate(_ context.Context, req *agent.CacheInvalidationRequest) error {
+	removed := r.invalidate(req)
+
+	log.Info().Stringer("kind", req.GetKind()).Str("key", req.GetKey()).Int("removed", removed).Msg("Cache invalidated")
+
+	return nil
+}
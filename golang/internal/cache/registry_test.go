@@ -0,0 +1,41 @@
+//go:build unit
+// +build unit
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/cache"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// keys records the invalidated keys
+type keys []string
+
+func (k *keys) Invalidate(key string) int {
+	*k = append(*k, key)
+	return 1
+}
+
+func TestRegistryInvalidate(t *testing.T) {
+	ctx := context.Background()
+	configs := &keys{}
+
+	registry := cache.NewRegistry()
+	registry.Register(agent.CacheKind_CACHE_KIND_CONFIG_BUNDLE, configs)
+
+	assert.NoError(t, registry.Invalidate(ctx, &agent.CacheInvalidationRequest{
+		Kind: agent.CacheKind_CACHE_KIND_CONFIG_BUNDLE,
+		Key:  pointer.ToString("base-config-id"),
+	}))
+	assert.Equal(t, keys{"base-config-id"}, *configs)
+
+	// the unspecified kind invalidates every cache, an empty key every entry
+	assert.NoError(t, registry.Invalidate(ctx, &agent.CacheInvalidationRequest{}))
+	assert.Equal(t, keys{"base-config-id", ""}, *configs)
+}
@@ -2,6 +2,8 @@
 
 import (
 	"time"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/mirror"
 )
 
 // Configuration defaults
@@ -34,11 +36,20 @@ type CommonConfiguration struct {
 	DefaultLimitsMemory  string `yaml:"defaultLimitsMemory"      env:"DEFAULT_LIMITS_MEMORY"       env-default:"128Mi"`
 	DefaultRegistry      string `yaml:"registry"             env:"DEFAULT_REGISTRY"                 env-default:"index.docker.io"`
 	DefaultLimitsCPU     string `yaml:"defaultLimitsCPU"         env:"DEFAULT_LIMITS_CPU"          env-default:"100m"`
+	HealthAddress        string `yaml:"healthAddress"            env:"HEALTH_ADDRESS"              env-default:""`
+	MetricsToken         string `yaml:"metricsToken"             env:"METRICS_TOKEN"               env-default:""`
 	//nolint:lll
 	ImportContainerImage     string        `yaml:"importContainerImage"     env:"IMPORT_CONTAINER_IMAGE"      env-default:"rclone/rclone:1.57.0"`
+	ImageRewriteRules        mirror.Rules  `yaml:"imageRewriteRules"        env:"IMAGE_REWRITE_RULES"`
 	ReadHeaderTimeout        time.Duration `yaml:"readHeaderTimeout"        env:"READ_HEADER_TIMEOUT"         env-default:"15s"`
 	GrpcKeepalive            time.Duration `yaml:"grpcKeepalive"            env:"GRPC_KEEPALIVE"              env-default:"30s"`
+	GrpcKeepaliveTimeout     time.Duration `yaml:"grpcKeepaliveTimeout"     env:"GRPC_KEEPALIVE_TIMEOUT"      env-default:"5s"`
+	GrpcReconnectInitial     time.Duration `yaml:"grpcReconnectInitial"     env:"GRPC_RECONNECT_INITIAL"      env-default:"1s"`
+	GrpcReconnectMax         time.Duration `yaml:"grpcReconnectMax"         env:"GRPC_RECONNECT_MAX"          env-default:"30s"`
+	GrpcReconnectJitter      float64       `yaml:"grpcReconnectJitter"      env:"GRPC_RECONNECT_JITTER"       env-default:"0.2"`
 	DefaultTimeout           time.Duration `yaml:"defaultTimeout"           env:"DEFAULT_TIMEOUT"             env-default:"5s"`
+	StatusBatchInterval      time.Duration `yaml:"statusBatchInterval"      env:"STATUS_BATCH_INTERVAL"       env-default:"500ms"`
+	StatusBatchSize          int           `yaml:"statusBatchSize"          env:"STATUS_BATCH_SIZE"           env-default:"100"`
 	DebugUpdateUseContainers bool          `yaml:"debugUpdateUseContainers" env:"DEBUG_UPDATE_USE_CONTAINERS" env-default:"true"`
 	DebugUpdateAlways        bool          `yaml:"debugUpdateAlways"        env:"DEBUG_UPDATE_ALWAYS"         env-default:"false"`
 	Debug                    bool          `yaml:"debug"                    env:"DEBUG"                       env-default:"false"`
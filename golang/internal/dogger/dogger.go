@@ -39,7 +39,11 @@ type DeploymentLogger struct {
 	appConfig    *config.CommonConfiguration
 	deploymentID string
 	requestID    string
-	logs         []string
+	// nodeID tags the status messages, so the ones of the nodes of a node group can be told apart
+	nodeID string
+	logs   []string
+	// command is the effective command of the deployed container, reported with its states
+	command []string
 }
 
 func NewDeploymentLogger(ctx context.Context, deploymentID *string,
@@ -65,6 +69,22 @@ func (dog *DeploymentLogger) SetRequestID(requestID string) {
 	dog.requestID = requestID
 }
 
+func (dog *DeploymentLogger) SetNodeID(nodeID string) {
+	dog.nodeID = nodeID
+}
+
+func (dog *DeploymentLogger) SetContainerCommand(command []string) {
+	dog.command = command
+}
+
+func (dog *DeploymentLogger) nodeIDTag() *string {
+	if dog.nodeID == "" {
+		return nil
+	}
+
+	return &dog.nodeID
+}
+
 // Writes to all available streams: std.out and gRPC streams
 func (dog *DeploymentLogger) Write(level Level, messages ...string) {
 	for i := range messages {
@@ -77,6 +97,7 @@ func (dog *DeploymentLogger) Write(level Level, messages ...string) {
 		err := dog.stream.Send(&common.DeploymentStatusMessage{
 			Log:      messages,
 			LogLevel: &logLevel,
+			NodeId:   dog.nodeIDTag(),
 		})
 		if err != nil {
 			log.Error().Err(err).Stack().Str("deployment", dog.deploymentID).Msg("Write error")
@@ -98,6 +119,7 @@ func (dog *DeploymentLogger) WriteDeploymentStatus(status common.DeploymentStatu
 		err := dog.stream.Send(&common.DeploymentStatusMessage{
 			Log:      messages,
 			LogLevel: &logLevel,
+			NodeId:   dog.nodeIDTag(),
 			Data: &common.DeploymentStatusMessage_DeploymentStatus{
 				DeploymentStatus: status,
 			},
@@ -127,6 +149,7 @@ func (dog *DeploymentLogger) WriteContainerState(
 				InstanceId: dog.requestID,
 				State:      containerState,
 				Reason:     reason,
+				Command:    dog.command,
 			},
 		}
 
@@ -135,6 +158,7 @@ func (dog *DeploymentLogger) WriteContainerState(
 		err := dog.stream.Send(&common.DeploymentStatusMessage{
 			Log:      messages,
 			LogLevel: &logLevel,
+			NodeId:   dog.nodeIDTag(),
 			Data:     instance,
 		})
 		if err != nil {
@@ -159,7 +183,8 @@ func (dog *DeploymentLogger) WriteContainerProgress(status string, progress floa
 		}
 
 		err := dog.stream.Send(&common.DeploymentStatusMessage{
-			Data: progress,
+			NodeId: dog.nodeIDTag(),
+			Data:   progress,
 		})
 		if err != nil {
 			log.Error().
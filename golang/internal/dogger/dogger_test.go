@@ -17,11 +17,13 @@
 type AgentTestStream struct {
 	testing  *testing.T
 	messages []string
+	nodeIDs  []string
 }
 
 func (tStream *AgentTestStream) Send(message *common.DeploymentStatusMessage) error {
 	tStream.testing.Logf("Dogger said: '%s'", message.Log)
 	tStream.messages = append(tStream.messages, message.Log...)
+	tStream.nodeIDs = append(tStream.nodeIDs, message.GetNodeId())
 	return nil
 }
 
@@ -116,3 +118,20 @@ func TestDoggerContainerStatus(t *testing.T) {
 
 	assertDoggerMessage(t, ts, []string{"hello"})
 }
+
+func TestDoggerNodeID(t *testing.T) {
+	cfg := &config.CommonConfiguration{}
+	deploymentId := "abcd"
+
+	ts := &AgentTestStream{
+		testing: t,
+	}
+	dog := dogger.NewDeploymentLogger(context.Background(), &deploymentId, ts, cfg)
+
+	dog.WriteInfo("untagged")
+	dog.SetNodeID("node-1")
+	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "hello")
+	dog.WriteContainerProgress("pulling", 0.5)
+
+	assert.Equal(t, []string{"", "node-1", "node-1"}, ts.nodeIDs)
+}
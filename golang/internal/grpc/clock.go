@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// the date header has a resolution of a second, smaller skews are not corrected
+	clockSkewTolerance = 2 * time.Second
+	// lines later than this after the correction are flagged as suspicious
+	logTimestampTolerance = 5 * time.Second
+	dateHeader            = "date"
+)
+
+// clockSkew is how far the clock of the node is ahead of the platform, measured from the date header of the gRPC
+// responses, there is no separate measurement, so the skew stays zero unless the platform or a proxy in front of it
+// sets the header, then only the suspicious lines are flagged
+type clockSkew struct {
+	now  func() time.Time
+	skew atomic.Int64
+}
+
+func newClockSkew(now func() time.Time) *clockSkew {
+	return &clockSkew{now: now}
+}
+
+func (c *clockSkew) get() time.Duration {
+	return time.Duration(c.skew.Load())
+}
+
+// measure updates the skew from the date of a response, the platform sent it between the request and the response
+func (c *clockSkew) measure(md metadata.MD, sent, received time.Time) {
+	dates := md.Get(dateHeader)
+	if len(dates) == 0 {
+		return
+	}
+
+	date, err := http.ParseTime(dates[0])
+	if err != nil {
+		log.Trace().Err(err).Str("date", dates[0]).Msg("Failed to parse the date of the platform")
+		return
+	}
+
+	// the date is truncated to the second
+	platform := date.Add(time.Second / 2)
+	local := sent.Add(received.Sub(sent) / 2)
+
+	skew := local.Sub(platform)
+	if skew.Abs() < clockSkewTolerance {
+		skew = 0
+	}
+
+	previous := time.Duration(c.skew.Swap(int64(skew)))
+	if skew != 0 && (skew-previous).Abs() >= clockSkewTolerance {
+		log.Warn().Dur("skew", skew).Msg("The clock of the node is out of sync with the platform, log timestamps are corrected")
+	}
+}
+
+func (c *clockSkew) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	md := metadata.MD{}
+	sent := c.now()
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&md))...)
+	if err == nil {
+		c.measure(md, sent, c.now())
+	}
+
+	return err
+}
+
+// measureStream measures the skew from the headers of a stream, the platform might send them later than
+// the stream is opened, so only the time they are received is known
+func (c *clockSkew) measureStream(stream grpc.ClientStream) {
+	md, err := stream.Header()
+	if err == nil {
+		received := c.now()
+		c.measure(md, received, received)
+	}
+}
+
+// correctLogLine corrects the timestamp in front of the line by the skew, the line is suspicious if it is
+// still in the future, lines without a timestamp are left as they are
+func (c *clockSkew) correctLogLine(line string) (string, bool) {
+	stamp, text, found := strings.Cut(line, " ")
+	if !found {
+		return line, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, stamp)
+	if err != nil {
+		return line, false
+	}
+
+	skew := c.get()
+	t = t.Add(-skew)
+	suspicious := t.After(c.now().Add(-skew).Add(logTimestampTolerance))
+
+	return t.UTC().Format(time.RFC3339Nano) + " " + text, suspicious
+}
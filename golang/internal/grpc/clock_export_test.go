@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+var NewClockSkew = newClockSkew
+
+func (c *clockSkew) Get() time.Duration {
+	return c.get()
+}
+
+func (c *clockSkew) Measure(md metadata.MD, sent, received time.Time) {
+	c.measure(md, sent, received)
+}
+
+func (c *clockSkew) CorrectLogLine(line string) (string, bool) {
+	return c.correctLogLine(line)
+}
@@ -0,0 +1,67 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func dateMetadata(t time.Time) metadata.MD {
+	return metadata.Pairs("date", t.UTC().Format(http.TimeFormat))
+}
+
+func TestClockSkewMeasure(t *testing.T) {
+	platform := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	clock := grpc.NewClockSkew(time.Now)
+
+	// within the resolution of the date header
+	clock.Measure(dateMetadata(platform), platform.Add(time.Second), platform.Add(1200*time.Millisecond))
+	assert.Equal(t, time.Duration(0), clock.Get())
+
+	clock.Measure(dateMetadata(platform), platform.Add(10*time.Second), platform.Add(11*time.Second))
+	assert.Equal(t, 10*time.Second, clock.Get())
+
+	clock.Measure(dateMetadata(platform), platform.Add(-time.Minute), platform.Add(-time.Minute))
+	assert.Equal(t, -time.Minute-time.Second/2, clock.Get())
+
+	// no date, the last measurement is kept
+	clock.Measure(metadata.MD{}, platform, platform)
+	clock.Measure(metadata.Pairs("date", "yesterday"), platform, platform)
+	assert.Equal(t, -time.Minute-time.Second/2, clock.Get())
+}
+
+func TestClockSkewCorrectLogLine(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 30, 0, time.UTC)
+	clock := grpc.NewClockSkew(func() time.Time { return now })
+
+	line, suspicious := clock.CorrectLogLine("2024-05-01T12:00:00.123456789Z started\n")
+	assert.Equal(t, "2024-05-01T12:00:00.123456789Z started\n", line)
+	assert.False(t, suspicious)
+
+	line, suspicious = clock.CorrectLogLine("2024-05-01T12:01:00Z from the future\n")
+	assert.Equal(t, "2024-05-01T12:01:00Z from the future\n", line)
+	assert.True(t, suspicious)
+
+	line, suspicious = clock.CorrectLogLine("no timestamp\n")
+	assert.Equal(t, "no timestamp\n", line)
+	assert.False(t, suspicious)
+
+	// the node is 30 seconds ahead
+	platform := now.Add(-30 * time.Second)
+	clock.Measure(dateMetadata(platform), now.Add(time.Second/2), now.Add(time.Second/2))
+
+	line, suspicious = clock.CorrectLogLine("2024-05-01T14:00:20+02:00 started\n")
+	assert.Equal(t, "2024-05-01T11:59:50Z started\n", line)
+	assert.False(t, suspicious)
+
+	_, suspicious = clock.CorrectLogLine("2024-05-01T12:00:40Z still from the future\n")
+	assert.True(t, suspicious)
+}
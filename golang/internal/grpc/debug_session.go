@@ -0,0 +1,191 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/AlekSi/pointer"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// DebugSession is the terminal of a debug container streamed to crux, the stdin ends when crux closes it
+type DebugSession struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// Resize receives the size of the terminal of crux, only the latest one is kept until it is read
+	Resize <-chan *agent.TerminalSize
+	// Started has to be called once the debug container is running, before anything is written to the output
+	Started func(pod, container string)
+}
+
+// debugSessionStream serializes the sends of the writers of the session
+type debugSessionStream struct {
+	stream agent.Agent_DebugSessionClient
+	mutex  sync.Mutex
+}
+
+func (s *debugSessionStream) send(output *agent.DebugSessionOutput) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.stream.Send(output)
+}
+
+type debugOutputWriter struct {
+	stream *debugSessionStream
+	stderr bool
+}
+
+func (w *debugOutputWriter) Write(p []byte) (int, error) {
+	data := append([]byte{}, p...)
+
+	output := &agent.DebugSessionOutput{Output: &agent.DebugSessionOutput_Stdout{Stdout: data}}
+	if w.stderr {
+		output = &agent.DebugSessionOutput{Output: &agent.DebugSessionOutput_Stderr{Stderr: data}}
+	}
+
+	if err := w.stream.send(output); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// offerTerminalSize replaces the size waiting in the channel, so a slow reader gets the latest one
+func offerTerminalSize(resize chan *agent.TerminalSize, size *agent.TerminalSize) {
+	for {
+		select {
+		case resize <- size:
+			return
+		default:
+		}
+
+		select {
+		case <-resize:
+		default:
+		}
+	}
+}
+
+// pumpDebugInput passes the input of crux to the session until crux closes the stream,
+// the session is canceled if the stream breaks
+func pumpDebugInput(stream agent.Agent_DebugSessionClient, stdin *io.PipeWriter, resize chan *agent.TerminalSize,
+	cancel context.CancelFunc,
+) {
+	defer close(resize)
+
+	for {
+		input, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			_ = stdin.Close()
+			return
+		}
+		if err != nil {
+			_ = stdin.CloseWithError(err)
+			cancel()
+			return
+		}
+
+		switch {
+		case input.GetStdin() != nil:
+			if _, err = stdin.Write(input.GetStdin()); err != nil {
+				log.Debug().Err(err).Msg("Debug session input is closed")
+			}
+		case input.GetResize() != nil:
+			offerTerminalSize(resize, input.GetResize())
+		case input.GetCloseStdin() != nil:
+			_ = stdin.Close()
+		}
+	}
+}
+
+func mapDebugContainerErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_DebugContainer{
+			DebugContainer: err,
+		},
+	}
+}
+
+// executeDebugContainer reports the errors before the session is open as command errors,
+// after that the error ends the session
+func executeDebugContainer(
+	ctx context.Context,
+	command *agent.DebugContainerRequest,
+	debugFunc DebugContainerFunc,
+) *AgentGrpcError {
+	prefix := command.GetContainer().GetPrefix()
+	name := command.GetContainer().GetName()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", prefix, "dyo-container-name", name)
+
+	if debugFunc == nil {
+		log.Error().Msg("Debug container function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	sessionCtx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(ctx, "dyo-session-id", command.Id))
+	defer cancel()
+
+	stream, err := grpcConn.Client.DebugSession(sessionCtx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Stack().Err(err).Str("session", command.Id).Msg("Debug session connect error")
+		return agentError(ctx, err)
+	}
+
+	output := &debugSessionStream{stream: stream}
+	stdin, stdinWriter := io.Pipe()
+	resize := make(chan *agent.TerminalSize, 1)
+	go pumpDebugInput(stream, stdinWriter, resize, cancel)
+
+	session := &DebugSession{
+		Stdin:  stdin,
+		Stdout: &debugOutputWriter{stream: output},
+		Stderr: &debugOutputWriter{stream: output, stderr: true},
+		Resize: resize,
+		Started: func(pod, container string) {
+			log.Info().Str("session", command.Id).Str("pod", pod).Str("container", container).Msg("Debug container is attached")
+
+			started := &agent.DebugSessionStarted{Pod: pod, Container: container}
+			if sendErr := output.send(&agent.DebugSessionOutput{Output: &agent.DebugSessionOutput_Started{Started: started}}); sendErr != nil {
+				log.Error().Err(sendErr).Str("session", command.Id).Msg("Debug session start error")
+			}
+		},
+	}
+
+	log.Info().
+		Str("session", command.Id).
+		Str("prefix", prefix).
+		Str("name", name).
+		Str("image", command.Image).
+		Msg("Starting debug container")
+
+	ended := &agent.DebugSessionEnded{}
+	err = debugFunc(sessionCtx, command, session)
+	// the input pump may wait for the session to read the stdin
+	_ = stdin.Close()
+	if err != nil {
+		log.Error().Stack().Err(err).Str("session", command.Id).Msg("Debug session failed")
+		ended.Error = pointer.ToString(err.Error())
+	}
+
+	err = output.send(&agent.DebugSessionOutput{Output: &agent.DebugSessionOutput_Ended{Ended: ended}})
+	if err != nil {
+		log.Error().Err(err).Str("session", command.Id).Msg("Debug session end error")
+	}
+
+	err = stream.CloseSend()
+	if err != nil {
+		log.Error().Stack().Err(err).Str("session", command.Id).Msg("Debug session close error")
+	}
+
+	return nil
+}
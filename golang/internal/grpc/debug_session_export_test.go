@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+var OfferTerminalSize = offerTerminalSize
+
+func PumpDebugInput(stream agent.Agent_DebugSessionClient, stdin *io.PipeWriter, resize chan *agent.TerminalSize,
+	cancel context.CancelFunc,
+) {
+	pumpDebugInput(stream, stdin, resize, cancel)
+}
@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+var errStreamBroken = errors.New("stream broken")
+
+// debugInputStream replays the inputs, then ends with the error
+type debugInputStream struct {
+	agent.Agent_DebugSessionClient
+	err    error
+	inputs []*agent.DebugSessionInput
+}
+
+func (s *debugInputStream) Recv() (*agent.DebugSessionInput, error) {
+	if len(s.inputs) == 0 {
+		return nil, s.err
+	}
+
+	input := s.inputs[0]
+	s.inputs = s.inputs[1:]
+
+	return input, nil
+}
+
+func TestOfferTerminalSizeKeepsLatest(t *testing.T) {
+	resize := make(chan *agent.TerminalSize, 1)
+
+	grpc.OfferTerminalSize(resize, &agent.TerminalSize{Width: 80, Height: 24})
+	grpc.OfferTerminalSize(resize, &agent.TerminalSize{Width: 120, Height: 40})
+
+	assert.Equal(t, uint32(120), (<-resize).Width)
+	assert.Empty(t, resize)
+}
+
+func TestPumpDebugInput(t *testing.T) {
+	stream := &debugInputStream{
+		err: io.EOF,
+		inputs: []*agent.DebugSessionInput{
+			{Input: &agent.DebugSessionInput_Stdin{Stdin: []byte("ls\n")}},
+			{Input: &agent.DebugSessionInput_Resize{Resize: &agent.TerminalSize{Width: 100, Height: 30}}},
+			{Input: &agent.DebugSessionInput_CloseStdin{CloseStdin: &common.Empty{}}},
+		},
+	}
+	stdin, stdinWriter := io.Pipe()
+	resize := make(chan *agent.TerminalSize, 1)
+	canceled := false
+
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(stdin)
+		done <- data
+	}()
+
+	grpc.PumpDebugInput(stream, stdinWriter, resize, func() { canceled = true })
+
+	assert.Equal(t, []byte("ls\n"), <-done)
+	assert.Equal(t, uint32(100), (<-resize).Width)
+	_, open := <-resize
+	assert.False(t, open)
+	assert.False(t, canceled)
+}
+
+func TestPumpDebugInputBrokenStream(t *testing.T) {
+	stream := &debugInputStream{err: errStreamBroken}
+	stdin, stdinWriter := io.Pipe()
+	resize := make(chan *agent.TerminalSize, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	grpc.PumpDebugInput(stream, stdinWriter, resize, cancel)
+
+	_, err := stdin.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, errStreamBroken)
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// finishedDeploymentTTL is how long the agent remembers a successful deployment of a node group
+const finishedDeploymentTTL = 24 * time.Hour
+
+type deploymentRun struct {
+	done     chan struct{}
+	finished time.Time
+	status   common.DeploymentStatus
+}
+
+// deploymentRuns are the deployments fanned out to a node group by their idempotency keys, so a retry of the
+// platform after a partial fan-out does not deploy again on the nodes which finished already.
+// Only the successful deployments are kept, a failed one is deployed again by the next retry.
+type deploymentRuns struct {
+	items map[string]*deploymentRun
+	now   func() time.Time
+	mutex sync.Mutex
+}
+
+func newDeploymentRuns(now func() time.Time) *deploymentRuns {
+	return &deploymentRuns{items: map[string]*deploymentRun{}, now: now}
+}
+
+// acquire starts the deployment of the key, deployed is true if it finished successfully already.
+// If it is in progress, acquire calls waiting and waits for it to finish, then tries again if it failed.
+// The caller deploys if the run is not nil and it has to finish it.
+func (r *deploymentRuns) acquire(ctx context.Context, key string, waiting func()) (run *deploymentRun, deployed bool, err error) {
+	for {
+		run, started := r.start(key)
+		if started {
+			return run, false, nil
+		}
+
+		select {
+		case <-run.done:
+		default:
+			waiting()
+			select {
+			case <-ctx.Done():
+				return nil, false, ctx.Err()
+			case <-run.done:
+			}
+		}
+
+		if run.status == common.DeploymentStatus_SUCCESSFUL {
+			return nil, true, nil
+		}
+	}
+}
+
+// start returns the run of the key and true if it was started by this call
+func (r *deploymentRuns) start(key string) (*deploymentRun, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.prune()
+
+	if run, ok := r.items[key]; ok {
+		return run, false
+	}
+
+	run := &deploymentRun{done: make(chan struct{})}
+	r.items[key] = run
+	return run, true
+}
+
+// finish records the final status of the run, failed runs are forgotten
+func (r *deploymentRuns) finish(key string, run *deploymentRun, status common.DeploymentStatus) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	run.status = status
+	run.finished = r.now()
+	if status != common.DeploymentStatus_SUCCESSFUL && r.items[key] == run {
+		delete(r.items, key)
+	}
+
+	close(run.done)
+}
+
+// prune forgets the successful runs older than the TTL, the caller holds the lock
+func (r *deploymentRuns) prune() {
+	now := r.now()
+	for key, run := range r.items {
+		if !run.finished.IsZero() && now.Sub(run.finished) > finishedDeploymentTTL {
+			delete(r.items, key)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+var NewDeploymentRuns = newDeploymentRuns
+
+type DeploymentRun = deploymentRun
+
+func (r *deploymentRuns) Acquire(ctx context.Context, key string, waiting func()) (*DeploymentRun, bool, error) {
+	return r.acquire(ctx, key, waiting)
+}
+
+func (r *deploymentRuns) Finish(key string, run *DeploymentRun, status common.DeploymentStatus) {
+	r.finish(key, run, status)
+}
@@ -0,0 +1,95 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestDeploymentRunsSkipSuccessful(t *testing.T) {
+	runs := grpc.NewDeploymentRuns(time.Now)
+	ctx := context.Background()
+
+	run, deployed, err := runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+	assert.False(t, deployed)
+	assert.NotNil(t, run)
+	runs.Finish("key", run, common.DeploymentStatus_SUCCESSFUL)
+
+	run, deployed, err = runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+	assert.True(t, deployed)
+	assert.Nil(t, run)
+
+	run, deployed, err = runs.Acquire(ctx, "other-key", func() {})
+	assert.NoError(t, err)
+	assert.False(t, deployed)
+	assert.NotNil(t, run)
+}
+
+func TestDeploymentRunsRetryFailed(t *testing.T) {
+	runs := grpc.NewDeploymentRuns(time.Now)
+	ctx := context.Background()
+
+	run, _, err := runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+	runs.Finish("key", run, common.DeploymentStatus_FAILED)
+
+	run, deployed, err := runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+	assert.False(t, deployed)
+	assert.NotNil(t, run)
+}
+
+func TestDeploymentRunsWaitInProgress(t *testing.T) {
+	runs := grpc.NewDeploymentRuns(time.Now)
+	ctx := context.Background()
+
+	run, _, err := runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+
+	waiting := make(chan struct{})
+	result := make(chan bool)
+	go func() {
+		_, deployed, _ := runs.Acquire(ctx, "key", func() { close(waiting) })
+		result <- deployed
+	}()
+
+	<-waiting
+	runs.Finish("key", run, common.DeploymentStatus_SUCCESSFUL)
+	assert.True(t, <-result)
+}
+
+func TestDeploymentRunsWaitCanceled(t *testing.T) {
+	runs := grpc.NewDeploymentRuns(time.Now)
+
+	_, _, err := runs.Acquire(context.Background(), "key", func() {})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, _, err = runs.Acquire(ctx, "key", cancel)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDeploymentRunsExpire(t *testing.T) {
+	now := time.Now()
+	runs := grpc.NewDeploymentRuns(func() time.Time { return now })
+	ctx := context.Background()
+
+	run, _, err := runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+	runs.Finish("key", run, common.DeploymentStatus_SUCCESSFUL)
+
+	now = now.Add(25 * time.Hour)
+	_, deployed, err := runs.Acquire(ctx, "key", func() {})
+	assert.NoError(t, err)
+	assert.False(t, deployed)
+}
@@ -0,0 +1,254 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// the sources of the errors in the details of the envelope
+const (
+	errorSourceDocker     = "docker"
+	errorSourceKubernetes = "kubernetes"
+	errorSourceGrpc       = "grpc"
+)
+
+// ErrorClass is how the platform handles an error of the agents, it is sent as the envelope of the error
+type ErrorClass struct {
+	Details   map[string]string
+	Code      string
+	Category  agent.ErrorCategory
+	Retryable bool
+}
+
+// the categories of the errors which are expected to go away without changing the command
+var retryableCategories = map[agent.ErrorCategory]bool{
+	agent.ErrorCategory_ERROR_CATEGORY_CONFLICT:           true,
+	agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE:        true,
+	agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT:            true,
+	agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED: true,
+	agent.ErrorCategory_ERROR_CATEGORY_CANCELED:           true,
+}
+
+// the status codes of the categories, kept in the status of the AgentError for the older servers
+var categoryStatusCodes = map[agent.ErrorCategory]codes.Code{
+	agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT:    codes.InvalidArgument,
+	agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND:           codes.NotFound,
+	agent.ErrorCategory_ERROR_CATEGORY_CONFLICT:            codes.Aborted,
+	agent.ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED:        codes.PermissionDenied,
+	agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION: codes.FailedPrecondition,
+	agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE:         codes.Unavailable,
+	agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT:             codes.DeadlineExceeded,
+	agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED:  codes.ResourceExhausted,
+	agent.ErrorCategory_ERROR_CATEGORY_CANCELED:            codes.Canceled,
+	agent.ErrorCategory_ERROR_CATEGORY_UNIMPLEMENTED:       codes.Unimplemented,
+	agent.ErrorCategory_ERROR_CATEGORY_INTERNAL:            codes.Internal,
+}
+
+// the categories of the status codes returned by the other gRPC services, e.g. containerd
+var statusCodeCategories = map[codes.Code]agent.ErrorCategory{
+	codes.InvalidArgument:    agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT,
+	codes.OutOfRange:         agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT,
+	codes.NotFound:           agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND,
+	codes.AlreadyExists:      agent.ErrorCategory_ERROR_CATEGORY_CONFLICT,
+	codes.Aborted:            agent.ErrorCategory_ERROR_CATEGORY_CONFLICT,
+	codes.Unauthenticated:    agent.ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED,
+	codes.PermissionDenied:   agent.ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED,
+	codes.FailedPrecondition: agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION,
+	codes.Unavailable:        agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE,
+	codes.DeadlineExceeded:   agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT,
+	codes.ResourceExhausted:  agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED,
+	codes.Canceled:           agent.ErrorCategory_ERROR_CATEGORY_CANCELED,
+	codes.Unimplemented:      agent.ErrorCategory_ERROR_CATEGORY_UNIMPLEMENTED,
+}
+
+// ErrorClassOf is the class of the category, with the retryability of the category
+func ErrorClassOf(category agent.ErrorCategory, code string) *ErrorClass {
+	return &ErrorClass{
+		Code:      code,
+		Category:  category,
+		Retryable: retryableCategories[category],
+	}
+}
+
+// ClassifyError is the shared classification of an error not sent by the agents, e.g. the ones of the CLI,
+// so they are handled the same way
+func ClassifyError(err error) *ErrorClass {
+	return classifyError(err)
+}
+
+// categoryClass is the class of the errors without a more specific code, the code is the name of the category
+func categoryClass(category agent.ErrorCategory, source string) *ErrorClass {
+	class := ErrorClassOf(category, strings.TrimPrefix(category.String(), "ERROR_CATEGORY_"))
+	class.Details = map[string]string{"source": source}
+
+	return class
+}
+
+// classifyError classifies the errors shared by the agents, the unknown ones are internal errors
+func classifyError(err error) *ErrorClass {
+	switch {
+	case errors.Is(err, internalCommon.ErrContainerNotFound):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND, "CONTAINER_NOT_FOUND")
+	case errors.Is(err, ErrInvalidPageToken):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, "INVALID_PAGE_TOKEN")
+	case errors.Is(err, v1.ErrBaseConfigHashMismatch):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, "BASE_CONFIG_HASH_MISMATCH")
+	case errors.Is(err, internalCommon.ErrMethodNotImplemented):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_UNIMPLEMENTED, "METHOD_NOT_IMPLEMENTED")
+	case errors.Is(err, imageHelper.ErrImageNotFound), errors.Is(err, imageHelper.ErrLocalImageNotFound):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND, "IMAGE_NOT_FOUND")
+	case errors.Is(err, imageHelper.ErrInvalidImage), errors.Is(err, imageHelper.ErrInvalidTag):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, "INVALID_IMAGE")
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT, "DEADLINE_EXCEEDED")
+	case errors.Is(err, context.Canceled):
+		return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_CANCELED, "CANCELED")
+	}
+
+	// the platform sends the request again with the base config
+	var baseConfigMiss *v1.BaseConfigMissError
+	if errors.As(err, &baseConfigMiss) {
+		class := ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION, "BASE_CONFIG_NOT_FOUND")
+		class.Details = map[string]string{"baseConfigId": baseConfigMiss.ID, "baseConfigHash": baseConfigMiss.Hash}
+		return class
+	}
+
+	// every invalid field is in the details by its path
+	var validationErr *v1.ValidationError
+	if errors.As(err, &validationErr) {
+		class := ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, "VALIDATION_FAILED")
+		class.Details = map[string]string{}
+		for _, it := range validationErr.Fields {
+			class.Details[it.Path] = it.Message
+		}
+		return class
+	}
+
+	if class := kubernetesErrorClass(err); class != nil {
+		return class
+	}
+	if class := dockerErrorClass(err); class != nil {
+		return class
+	}
+	if st, ok := status.FromError(err); ok {
+		if category, found := statusCodeCategories[st.Code()]; found {
+			return categoryClass(category, errorSourceGrpc)
+		}
+	}
+
+	return ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_INTERNAL, "INTERNAL")
+}
+
+// kubernetesErrorClass classifies the errors of the API server, the reason and the object are in the details
+func kubernetesErrorClass(err error) *ErrorClass {
+	var apiStatus kerrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return nil
+	}
+
+	category := agent.ErrorCategory_ERROR_CATEGORY_INTERNAL
+	switch {
+	case kerrors.IsNotFound(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND
+	case kerrors.IsAlreadyExists(err), kerrors.IsConflict(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_CONFLICT
+	case kerrors.IsInvalid(err), kerrors.IsBadRequest(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT
+	case kerrors.IsUnauthorized(err), kerrors.IsForbidden(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED
+	case kerrors.IsTimeout(err), kerrors.IsServerTimeout(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT
+	case kerrors.IsTooManyRequests(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED
+	case kerrors.IsServiceUnavailable(err), kerrors.IsInternalError(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE
+	}
+
+	class := categoryClass(category, errorSourceKubernetes)
+	st := apiStatus.Status()
+	if st.Reason != "" {
+		class.Details["reason"] = string(st.Reason)
+	}
+	if st.Details != nil {
+		if st.Details.Kind != "" {
+			class.Details["kind"] = st.Details.Kind
+		}
+		if st.Details.Name != "" {
+			class.Details["name"] = st.Details.Name
+		}
+	}
+
+	return class
+}
+
+// dockerErrorClass classifies the errors of the docker daemon and its client
+func dockerErrorClass(err error) *ErrorClass {
+	var category agent.ErrorCategory
+	switch {
+	case client.IsErrConnectionFailed(err), errdefs.IsUnavailable(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE
+	case errdefs.IsNotFound(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND
+	case errdefs.IsInvalidParameter(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT
+	case errdefs.IsConflict(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_CONFLICT
+	case errdefs.IsUnauthorized(err), errdefs.IsForbidden(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED
+	case errdefs.IsDeadline(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT
+	case errdefs.IsCancelled(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_CANCELED
+	case errdefs.IsNotImplemented(err):
+		category = agent.ErrorCategory_ERROR_CATEGORY_UNIMPLEMENTED
+	default:
+		return nil
+	}
+
+	return categoryClass(category, errorSourceDocker)
+}
+
+func statusCodeOf(category agent.ErrorCategory) codes.Code {
+	if code, ok := categoryStatusCodes[category]; ok {
+		return code
+	}
+
+	return codes.Internal
+}
+
+// agentErrorOf is the envelope of the error sent to the platform, the classification of the agent comes first,
+// then the shared one
+func agentErrorOf(err error, classify ClassifyErrorFunc) *agent.AgentError {
+	if err == nil {
+		err = internalCommon.ErrUnknown
+	}
+
+	var class *ErrorClass
+	if classify != nil {
+		class = classify(err)
+	}
+	if class == nil {
+		class = classifyError(err)
+	}
+
+	return &agent.AgentError{
+		Status:    int32(statusCodeOf(class.Category)),
+		Error:     err.Error(),
+		Code:      class.Code,
+		Category:  class.Category,
+		Retryable: class.Retryable,
+		Details:   class.Details,
+	}
+}
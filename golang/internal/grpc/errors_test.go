@@ -0,0 +1,113 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/docker/docker/errdefs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestAgentErrorOfSharedErrors(t *testing.T) {
+	notFound := grpc.AgentErrorOf(fmt.Errorf("inspect: %w", internalCommon.ErrContainerNotFound), nil)
+	assert.Equal(t, int32(codes.NotFound), notFound.GetStatus())
+	assert.Equal(t, "CONTAINER_NOT_FOUND", notFound.GetCode())
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND, notFound.GetCategory())
+	assert.False(t, notFound.GetRetryable())
+	assert.Equal(t, "inspect: container not found", notFound.GetError())
+
+	timeout := grpc.AgentErrorOf(fmt.Errorf("pull: %w", context.DeadlineExceeded), nil)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_TIMEOUT, timeout.GetCategory())
+	assert.True(t, timeout.GetRetryable())
+
+	unknown := grpc.AgentErrorOf(errors.New("something went wrong"), nil)
+	assert.Equal(t, int32(codes.Internal), unknown.GetStatus())
+	assert.Equal(t, "INTERNAL", unknown.GetCode())
+	assert.False(t, unknown.GetRetryable())
+
+	assert.Equal(t, internalCommon.ErrUnknown.Error(), grpc.AgentErrorOf(nil, nil).GetError())
+}
+
+func TestAgentErrorOfValidation(t *testing.T) {
+	validationErr := &v1.ValidationError{}
+	validationErr.Add("ContainerConfig.runtime", "must not contain whitespace", "")
+
+	agentErr := grpc.AgentErrorOf(validationErr, nil)
+	assert.Equal(t, "VALIDATION_FAILED", agentErr.GetCode())
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, agentErr.GetCategory())
+	assert.Equal(t, map[string]string{"ContainerConfig.runtime": "must not contain whitespace"}, agentErr.GetDetails())
+}
+
+func TestAgentErrorOfBaseConfigMiss(t *testing.T) {
+	store := v1.NewBaseConfigStore(v1.DefaultBaseConfigLimit)
+	err := store.Resolve(&v1.DeployImageRequest{Base: &v1.BaseConfigRef{ID: "web-base", Hash: "abc"}})
+
+	agentErr := grpc.AgentErrorOf(fmt.Errorf("deploy: %w", err), nil)
+	assert.Equal(t, "BASE_CONFIG_NOT_FOUND", agentErr.GetCode())
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION, agentErr.GetCategory())
+	assert.False(t, agentErr.GetRetryable())
+	assert.Equal(t, map[string]string{"baseConfigId": "web-base", "baseConfigHash": "abc"}, agentErr.GetDetails())
+}
+
+func TestAgentErrorOfRuntimeErrors(t *testing.T) {
+	conflict := grpc.AgentErrorOf(fmt.Errorf("update: %w",
+		kerrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "api", errors.New("modified"))), nil)
+	assert.Equal(t, "CONFLICT", conflict.GetCode())
+	assert.True(t, conflict.GetRetryable())
+	assert.Equal(t, "kubernetes", conflict.GetDetails()["source"])
+	assert.Equal(t, "Conflict", conflict.GetDetails()["reason"])
+	assert.Equal(t, "deployments", conflict.GetDetails()["kind"])
+	assert.Equal(t, "api", conflict.GetDetails()["name"])
+
+	forbidden := grpc.AgentErrorOf(kerrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "s", errors.New("rbac")), nil)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED, forbidden.GetCategory())
+	assert.False(t, forbidden.GetRetryable())
+
+	invalid := grpc.AgentErrorOf(fmt.Errorf("create: %w", errdefs.InvalidParameter(errors.New("unknown runtime"))), nil)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, invalid.GetCategory())
+	assert.Equal(t, "docker", invalid.GetDetails()["source"])
+
+	unavailable := grpc.AgentErrorOf(errdefs.Unavailable(errors.New("daemon restarting")), nil)
+	assert.Equal(t, int32(codes.Unavailable), unavailable.GetStatus())
+	assert.True(t, unavailable.GetRetryable())
+
+	exhausted := grpc.AgentErrorOf(status.Error(codes.ResourceExhausted, "quota"), nil)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED, exhausted.GetCategory())
+	assert.Equal(t, "grpc", exhausted.GetDetails()["source"])
+}
+
+func TestAgentErrorOfAgentClassification(t *testing.T) {
+	errOverloaded := errors.New("overloaded")
+	classify := func(err error) *grpc.ErrorClass {
+		if errors.Is(err, errOverloaded) {
+			return grpc.ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED, "NODE_OVERLOADED")
+		}
+		return nil
+	}
+
+	overloaded := grpc.AgentErrorOf(errOverloaded, classify)
+	assert.Equal(t, "NODE_OVERLOADED", overloaded.GetCode())
+	assert.Equal(t, int32(codes.ResourceExhausted), overloaded.GetStatus())
+	assert.True(t, overloaded.GetRetryable())
+
+	// the shared classification is the fallback
+	assert.Equal(t, "CONTAINER_NOT_FOUND", grpc.AgentErrorOf(internalCommon.ErrContainerNotFound, classify).GetCode())
+
+	// an unspecified category is still reported as an internal error
+	unspecified := grpc.AgentErrorOf(errOverloaded, func(error) *grpc.ErrorClass { return &grpc.ErrorClass{Code: "CUSTOM"} })
+	assert.Equal(t, int32(codes.Internal), unspecified.GetStatus())
+}
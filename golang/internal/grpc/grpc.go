@@ -7,6 +7,7 @@
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"strings"
 	"time"
@@ -14,10 +15,12 @@
 	"github.com/rs/zerolog/log"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/cache"
 	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	"github.com/dyrector-io/dyrectorio/golang/internal/health"
+	netHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
 	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
 	"github.com/dyrector-io/dyrectorio/golang/internal/version"
@@ -32,6 +35,7 @@
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Connection struct {
@@ -68,12 +72,14 @@ type UpdateOptions struct {
 }
 
 type ClientLoop struct {
-	Ctx         context.Context
-	WorkerFuncs WorkerFunctions
-	Secrets     config.SecretStore
-	cancel      context.CancelFunc
-	AppConfig   *config.CommonConfiguration
-	NodeID      string
+	Ctx           context.Context
+	WorkerFuncs   WorkerFunctions
+	Secrets       config.SecretStore
+	cancel        context.CancelFunc
+	AppConfig     *config.CommonConfiguration
+	subscriptions *subscriptions
+	deployments   *deploymentRuns
+	NodeID        string
 }
 
 type (
@@ -89,8 +95,30 @@ type ClientLoop struct {
 	DeleteContainersFunc     func(context.Context, *common.DeleteContainersRequest) error
 	ContainerLogFunc         func(context.Context, *agent.ContainerLogRequest) (*ContainerLogStream, error)
 	ContainerInspectFunc     func(context.Context, *agent.ContainerInspectRequest) (string, error)
+	DeploymentDiffFunc       func(context.Context, *v1.DeployImageRequest) (*v1.DeploymentDiff, error)
+	VolumeUsageFunc          func(context.Context, *agent.VolumeUsageRequest) ([]*agent.VolumeUsage, error)
+	PruneReportFunc          func(context.Context, *agent.PruneReportRequest) ([]*agent.PruneCandidate, error)
+	TrafficUsageFunc         func(context.Context, *agent.TrafficUsageRequest) (*agent.TrafficUsageResponse, error)
+	IngressConfigFunc        func(context.Context, *agent.IngressConfigRequest) (*agent.IngressConfigResponse, error)
+	InvalidateCacheFunc      func(context.Context, *agent.CacheInvalidationRequest) error
+	DebugContainerFunc       func(context.Context, *agent.DebugContainerRequest, *DebugSession) error
+	RollbackToRevisionFunc   func(context.Context, *agent.RollbackToRevisionRequest) (*agent.RollbackToRevisionResponse, error)
+	RecommendResourcesFunc   func(context.Context, *agent.ResourceRecommendationRequest) ([]*agent.ResourceRecommendation, error)
+	WorkloadOperationFunc    func(context.Context, *agent.WorkloadOperationRequest, WorkloadProgressFunc) error
+	WorkloadProgressFunc     func(*agent.WorkloadOperationMessage)
 	ReplaceTokenFunc         func(context.Context, *agent.ReplaceTokenRequest) error
-	SendLogFunc              func(string) error
+	ContainerCheckpointFunc  func(context.Context, *agent.ContainerCheckpointRequest) (*agent.ContainerCheckpointResponse, error)
+	ContainerRestoreFunc     func(context.Context, *agent.ContainerRestoreRequest) (*agent.ContainerRestoreResponse, error)
+	SendLogFunc              func(log string, suspiciousTimestamp bool) error
+	// ClassifyErrorFunc classifies the errors specific to the agent, the shared classification is used for nil
+	ClassifyErrorFunc       func(error) *ErrorClass
+	ClusterCapabilitiesFunc func(context.Context) (*agent.ClusterCapabilitiesMessage, error)
+	// WatchClusterCapabilitiesFunc reports the changes of the cluster until the context is done
+	WatchClusterCapabilitiesFunc  func(context.Context, ClusterCapabilitiesReportFunc)
+	ClusterCapabilitiesReportFunc func(*agent.ClusterCapabilitiesMessage)
+	// WatchHostMetricsFunc samples the host periodically until the context is done
+	WatchHostMetricsFunc  func(context.Context, HostMetricsReportFunc)
+	HostMetricsReportFunc func(*agent.HostMetricsMessage)
 )
 
 type WorkerFunctions struct {
@@ -106,6 +134,57 @@ type WorkerFunctions struct {
 	DeleteContainers     DeleteContainersFunc
 	ContainerLog         ContainerLogFunc
 	ContainerInspect     ContainerInspectFunc
+	DeploymentDiff       DeploymentDiffFunc
+	VolumeUsage          VolumeUsageFunc
+	PruneReport          PruneReportFunc
+	TrafficUsage         TrafficUsageFunc
+	RollbackToRevision   RollbackToRevisionFunc
+	RecommendResources   RecommendResourcesFunc
+	WorkloadOperation    WorkloadOperationFunc
+	DebugContainer       DebugContainerFunc
+	RuntimeCheck         health.RuntimeCheck
+	// the errors of the commands are classified by it first, so the platform can retry them or alert on them
+	ClassifyError ClassifyErrorFunc
+	// the capabilities of the cluster are sent on connect, then their changes while the agent runs
+	ClusterCapabilities      ClusterCapabilitiesFunc
+	WatchClusterCapabilities WatchClusterCapabilitiesFunc
+	// the samples of the host are streamed to the platform and served for Prometheus, the capability is advertised if set
+	WatchHostMetrics WatchHostMetricsFunc
+	HostMetrics      health.MetricsWriter
+	// the traefik of the node is managed by the configuration of the platform, the capability is advertised if set
+	IngressConfig IngressConfigFunc
+	// the cached credentials and configurations are dropped when the platform rotates them, the capability is advertised if set
+	InvalidateCache InvalidateCacheFunc
+	// experimental, the checkpoint capability is advertised if both are set
+	ContainerCheckpoint ContainerCheckpointFunc
+	ContainerRestore    ContainerRestoreFunc
+}
+
+// capabilities of the agent sent to the platform on connect
+const (
+	CapabilityCheckpoint     = "checkpoint"
+	CapabilityHostMetrics    = "hostMetrics"
+	CapabilityManagedIngress = "managedIngress"
+	// the agent handles the cache invalidation events
+	CapabilityCacheInvalidation = "cacheInvalidation"
+)
+
+func (wf *WorkerFunctions) capabilities() []string {
+	capabilities := []string{}
+	if wf.ContainerCheckpoint != nil && wf.ContainerRestore != nil {
+		capabilities = append(capabilities, CapabilityCheckpoint)
+	}
+	if wf.WatchHostMetrics != nil {
+		capabilities = append(capabilities, CapabilityHostMetrics)
+	}
+	if wf.IngressConfig != nil {
+		capabilities = append(capabilities, CapabilityManagedIngress)
+	}
+	if wf.InvalidateCache != nil {
+		capabilities = append(capabilities, CapabilityCacheInvalidation)
+	}
+
+	return capabilities
 }
 
 type contextKey int
@@ -148,6 +227,16 @@ func (g *Connection) SetConn(conn *grpc.ClientConn) {
 // Singleton instance
 var grpcConn *Connection
 
+var nodeClock = newClockSkew(time.Now)
+
+// baseConfigs are the base container configs Crux sent, the deploy requests referencing them are merged on the agent
+var baseConfigs = v1.NewBaseConfigStore(v1.DefaultBaseConfigLimit)
+
+// RegisterCaches adds the caches of the command loop to the invalidation events of the platform
+func RegisterCaches(registry *cache.Registry) {
+	registry.Register(agent.CacheKind_CACHE_KIND_CONFIG_BUNDLE, baseConfigs)
+}
+
 func fetchCertificatesFromURL(ctx context.Context, addr string) (*x509.CertPool, error) {
 	log.Info().Msg("Retrieving certificate")
 
@@ -185,24 +274,39 @@ func fetchCertificatesFromURL(ctx context.Context, addr string) (*x509.CertPool,
 	return pool, nil
 }
 
+//nolint:gocyclo // one case for each command
 func (cl *ClientLoop) grpcProcessCommand(command *agent.AgentCommand) {
 	switch {
 	case command.GetDeploy() != nil:
-		go executeDeployRequest(
-			cl.Ctx,
-			command.GetDeploy(),
-			cl.WorkerFuncs.Deploy,
-			cl.WorkerFuncs.DeploySharedSecrets,
-			cl.AppConfig,
-		)
+		go cl.executeDeployRequest(command.GetDeploy())
 	case command.GetContainerState() != nil:
-		go executeWatchContainerStatus(cl.Ctx, command.GetContainerState(), cl.WorkerFuncs.WatchContainerStatus)
+		req := command.GetContainerState()
+		if err := validatePageRequest(req.GetPage()); err != nil {
+			go func() {
+				ctx := metadata.AppendToOutgoingContext(cl.Ctx, "dyo-container-prefix", req.GetPrefix())
+				cl.executeCallback(mapContainerStateErrorToCommandError, agentError(ctx, err))
+			}()
+			break
+		}
+		go cl.subscriptions.run(containerStateSubscriptionKey(req.GetPrefix()), func(opened func()) bool {
+			return executeWatchContainerStatus(cl.Ctx, req, cl.WorkerFuncs.WatchContainerStatus, newStatusBatching(cl.AppConfig), opened)
+		})
 	case command.GetContainerDelete() != nil:
-		go executeDeleteContainer(cl.Ctx, command.GetContainerDelete(), cl.WorkerFuncs.Delete)
+		go func() {
+			cl.executeCallback(
+				mapDeleteContainerErrorToCommandError,
+				executeDeleteContainer(cl.Ctx, command.GetContainerDelete(), cl.WorkerFuncs.Delete),
+			)
+		}()
 	case command.GetDeployLegacy() != nil:
-		go executeVersionDeployLegacyRequest(cl.Ctx, command.GetDeployLegacy(), cl.WorkerFuncs.Deploy, cl.AppConfig)
+		go func() {
+			cl.executeCallback(
+				mapDeployErrorToCommandError,
+				executeVersionDeployLegacyRequest(cl.Ctx, command.GetDeployLegacy(), cl.WorkerFuncs.Deploy, cl.AppConfig),
+			)
+		}()
 	case command.GetListSecrets() != nil:
-		go executeCallback(
+		go cl.executeCallback(
 			mapListSecretsErrorToCommandError,
 			executeSecretList(cl.Ctx, command.GetListSecrets(), cl.WorkerFuncs.SecretList, cl.AppConfig),
 		)
@@ -211,22 +315,90 @@ func (cl *ClientLoop) grpcProcessCommand(command *agent.AgentCommand) {
 	case command.GetClose() != nil:
 		go cl.executeClose(command.GetClose())
 	case command.GetContainerCommand() != nil:
-		go executeContainerCommand(cl.Ctx, command.GetContainerCommand(), cl.WorkerFuncs.ContainerCommand)
+		go func() {
+			cl.executeCallback(
+				mapContainerCommandErrorToCommandError,
+				executeContainerCommand(cl.Ctx, command.GetContainerCommand(), cl.WorkerFuncs.ContainerCommand),
+			)
+		}()
 	case command.GetDeleteContainers() != nil:
-		go executeCallback(
+		go cl.executeCallback(
 			mapDeleteContainersErrorToCommandError,
 			executeDeleteMultipleContainers(cl.Ctx, command.GetDeleteContainers(), cl.WorkerFuncs.DeleteContainers),
 		)
 	case command.GetContainerLog() != nil:
-		go executeCallback(
+		go cl.executeCallback(
 			mapContainerLogErrorToCommandError,
-			executeContainerLog(cl.Ctx, command.GetContainerLog(), cl.WorkerFuncs.ContainerLog, cl.WorkerFuncs.WatchContainerStatus),
+			executeContainerLog(cl.Ctx, command.GetContainerLog(), cl.WorkerFuncs.ContainerLog, cl.WorkerFuncs.WatchContainerStatus,
+				cl.subscriptions),
 		)
 	case command.GetContainerInspect() != nil:
-		go executeCallback(
+		go cl.executeCallback(
 			mapContainerInspectErrorToCommandError,
 			executeContainerInspect(cl.Ctx, command.GetContainerInspect(), cl.WorkerFuncs.ContainerInspect),
 		)
+	case command.GetDeploymentDiff() != nil:
+		go cl.executeCallback(
+			mapDeploymentDiffErrorToCommandError,
+			executeDeploymentDiff(cl.Ctx, command.GetDeploymentDiff(), cl.WorkerFuncs.DeploymentDiff, cl.AppConfig),
+		)
+	case command.GetVolumeUsage() != nil:
+		go cl.executeCallback(
+			mapVolumeUsageErrorToCommandError,
+			executeVolumeUsage(cl.Ctx, command.GetVolumeUsage(), cl.WorkerFuncs.VolumeUsage),
+		)
+	case command.GetPruneReport() != nil:
+		go cl.executeCallback(
+			mapPruneReportErrorToCommandError,
+			executePruneReport(cl.Ctx, command.GetPruneReport(), cl.WorkerFuncs.PruneReport),
+		)
+	case command.GetTrafficUsage() != nil:
+		go cl.executeCallback(
+			mapTrafficUsageErrorToCommandError,
+			executeTrafficUsage(cl.Ctx, command.GetTrafficUsage(), cl.WorkerFuncs.TrafficUsage),
+		)
+	case command.GetIngressConfig() != nil:
+		go func() {
+			cl.executeCallback(
+				mapIngressConfigErrorToCommandError,
+				executeIngressConfig(cl.Ctx, command.GetIngressConfig(), cl.WorkerFuncs.IngressConfig),
+			)
+		}()
+	case command.GetInvalidateCache() != nil:
+		go executeInvalidateCache(cl.Ctx, command.GetInvalidateCache(), cl.WorkerFuncs.InvalidateCache)
+	case command.GetRollbackToRevision() != nil:
+		go cl.executeCallback(
+			mapRollbackToRevisionErrorToCommandError,
+			executeRollbackToRevision(cl.Ctx, command.GetRollbackToRevision(), cl.WorkerFuncs.RollbackToRevision),
+		)
+	case command.GetResourceRecommendation() != nil:
+		go cl.executeCallback(
+			mapResourceRecommendationErrorToCommandError,
+			executeResourceRecommendation(cl.Ctx, command.GetResourceRecommendation(), cl.WorkerFuncs.RecommendResources),
+		)
+	case command.GetWorkloadOperation() != nil:
+		go cl.executeCallback(
+			mapWorkloadOperationErrorToCommandError,
+			executeWorkloadOperation(cl.Ctx, command.GetWorkloadOperation(), cl.WorkerFuncs.WorkloadOperation),
+		)
+	case command.GetDebugContainer() != nil:
+		// the session is interactive, it must not block the loop receiving the commands
+		go func() {
+			cl.executeCallback(
+				mapDebugContainerErrorToCommandError,
+				executeDebugContainer(cl.Ctx, command.GetDebugContainer(), cl.WorkerFuncs.DebugContainer),
+			)
+		}()
+	case command.GetContainerCheckpoint() != nil:
+		go cl.executeCallback(
+			mapContainerCheckpointErrorToCommandError,
+			executeContainerCheckpoint(cl.Ctx, command.GetContainerCheckpoint(), cl.WorkerFuncs.ContainerCheckpoint),
+		)
+	case command.GetContainerRestore() != nil:
+		go cl.executeCallback(
+			mapContainerRestoreErrorToCommandError,
+			executeContainerRestore(cl.Ctx, command.GetContainerRestore(), cl.WorkerFuncs.ContainerRestore),
+		)
 	case command.GetReplaceToken() != nil:
 		// NOTE(@m8vago): should be sync?
 		err := cl.executeReplaceToken(command.GetReplaceToken())
@@ -238,37 +410,135 @@ func (cl *ClientLoop) grpcProcessCommand(command *agent.AgentCommand) {
 	}
 }
 
-func statusCodeOf(err *AgentGrpcError) codes.Code {
-	if errors.Is(err.InnerError, internalCommon.ErrContainerNotFound) {
-		return codes.NotFound
+func (cl *ClientLoop) executeCallback(mapError func(*agent.AgentError) *agent.AgentCommandError, grpcErr *AgentGrpcError) {
+	if grpcErr == nil {
+		return
+	}
+
+	cmdErr := mapError(agentErrorOf(grpcErr.InnerError, cl.WorkerFuncs.ClassifyError))
+
+	_, err := grpcConn.Client.CommandError(grpcErr.Context, cmdErr)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Reporting callback error failed")
+	}
+}
+
+// connect opens the command stream of the agent
+func (cl *ClientLoop) connect() (agent.Agent_ConnectClient, error) {
+	client := agent.NewAgentClient(grpcConn.Conn)
+	grpcConn.SetClient(client)
+
+	publicKey, keyErr := config.GetPublicKey(cl.AppConfig.SecretPrivateKey)
+
+	if keyErr != nil {
+		log.Panic().Stack().Err(keyErr).Str("publicKey", publicKey).Msg("gRPC public key error")
+	}
+
+	containerName := ""
+	if cl.WorkerFuncs.GetSelfContainerName != nil {
+		var err error
+		containerName, err = cl.WorkerFuncs.GetSelfContainerName(cl.Ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get the agent's container name")
+		}
+	}
+
+	var cluster *agent.ClusterCapabilitiesMessage
+	if cl.WorkerFuncs.ClusterCapabilities != nil {
+		var err error
+		cluster, err = cl.WorkerFuncs.ClusterCapabilities(cl.Ctx)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to discover the capabilities of the cluster")
+		}
 	}
 
-	return codes.Internal
+	return grpcConn.Client.Connect(
+		cl.Ctx, &agent.AgentInfo{
+			Id:            cl.NodeID,
+			Version:       version.BuildVersion(),
+			PublicKey:     publicKey,
+			ContainerName: &containerName,
+			Capabilities:  cl.WorkerFuncs.capabilities(),
+			Cluster:       cluster,
+		},
+		grpc.WaitForReady(true),
+	)
 }
 
-func executeCallback(mapError func(*agent.AgentError) *agent.AgentCommandError, grpcErr *AgentGrpcError) {
-	if grpcErr == nil {
+// watchClusterCapabilities sends the changes of the cluster, the ones while the agent is disconnected are dropped,
+// as the capabilities are sent on connect
+func (cl *ClientLoop) watchClusterCapabilities() {
+	if cl.WorkerFuncs.WatchClusterCapabilities == nil {
 		return
 	}
 
-	statusCode := statusCodeOf(grpcErr)
+	cl.WorkerFuncs.WatchClusterCapabilities(cl.Ctx, func(message *agent.ClusterCapabilitiesMessage) {
+		client := grpcConn.Client
+		if client == nil {
+			log.Debug().Msg("Cluster capabilities changed while disconnected")
+			return
+		}
+
+		if _, err := client.ClusterCapabilities(cl.Ctx, message); err != nil {
+			log.Warn().Err(err).Msg("Failed to report the capabilities of the cluster")
+		}
+	})
+}
 
-	agentError := agent.AgentError{
-		Status: int32(statusCode),
-		Error:  grpcErr.Error(),
+// watchHostMetrics streams the samples of the host, the ones while the agent is disconnected are dropped,
+// the stream is reopened by the first sample after a reconnect
+func (cl *ClientLoop) watchHostMetrics() {
+	if cl.WorkerFuncs.WatchHostMetrics == nil {
+		return
 	}
 
-	cmdErr := mapError(&agentError)
+	var stream agent.Agent_HostMetricsClient
+	cl.WorkerFuncs.WatchHostMetrics(cl.Ctx, func(message *agent.HostMetricsMessage) {
+		client := grpcConn.Client
+		if client == nil {
+			log.Debug().Msg("Host metrics sampled while disconnected")
+			return
+		}
 
-	_, err := grpcConn.Client.CommandError(grpcErr.Context, cmdErr)
-	if err != nil {
-		log.Error().Stack().Err(err).Msg("Reporting callback error failed")
+		if stream == nil {
+			opened, err := client.HostMetrics(cl.Ctx)
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to open the host metrics stream")
+				return
+			}
+			stream = opened
+		}
+
+		if err := stream.Send(message); err != nil {
+			log.Warn().Err(err).Msg("Failed to send the host metrics, the stream is reopened")
+			stream = nil
+		}
+	})
+
+	if stream != nil {
+		if _, err := stream.CloseAndRecv(); err != nil && !errors.Is(err, io.EOF) {
+			log.Debug().Err(err).Msg("Failed to close the host metrics stream")
+		}
+	}
+}
+
+// waitToReconnect waits for the delay of the next reconnect attempt or until the agent stops
+func (cl *ClientLoop) waitToReconnect(reconnect *reconnectBackoff) {
+	delay := reconnect.next()
+	log.Info().Dur("delay", delay).Msg("Reconnecting")
+
+	select {
+	case <-cl.Ctx.Done():
+	case <-time.After(delay):
 	}
 }
 
 func (cl *ClientLoop) grpcLoop(token *config.ValidJWT) error {
 	var stream agent.Agent_ConnectClient
 	var err error
+	//nolint:gosec // the jitter of the reconnects does not need a secure source
+	reconnect := newReconnectBackoff(cl.AppConfig, rand.New(rand.NewSource(time.Now().UnixNano())))
+	connected := false
 	defer cl.cancel()
 	defer func() {
 		err = grpcConn.Conn.Close()
@@ -280,40 +550,38 @@ func (cl *ClientLoop) grpcLoop(token *config.ValidJWT) error {
 		grpcConn.Client = nil
 	}()
 	for {
-		if grpcConn.Client == nil {
-			client := agent.NewAgentClient(grpcConn.Conn)
-			grpcConn.SetClient(client)
-
-			publicKey, keyErr := config.GetPublicKey(cl.AppConfig.SecretPrivateKey)
-
-			if keyErr != nil {
-				log.Panic().Stack().Err(keyErr).Str("publicKey", publicKey).Msg("gRPC public key error")
-			}
-
-			containerName := ""
-			if cl.WorkerFuncs.GetSelfContainerName != nil {
-				containerName, err = cl.WorkerFuncs.GetSelfContainerName(cl.Ctx)
-				if err != nil {
-					log.Error().Err(err).Msg("Failed to get the agent's container name")
-				}
-			}
+		if cl.Ctx.Err() != nil {
+			log.Info().Msg("Agent stopped")
+			return cl.Ctx.Err()
+		}
 
-			stream, err = grpcConn.Client.Connect(
-				cl.Ctx, &agent.AgentInfo{Id: cl.NodeID, Version: version.BuildVersion(), PublicKey: publicKey, ContainerName: &containerName},
-				grpc.WaitForReady(true),
-			)
+		if grpcConn.Client == nil {
+			stream, err = cl.connect()
 			if err != nil {
 				log.Error().Stack().Err(err).Send()
-				time.Sleep(time.Second)
 				grpcConn.Client = nil
+				cl.waitToReconnect(reconnect)
 				continue
 			}
 			log.Info().Msg("Stream connection is up")
+			go nodeClock.measureStream(stream)
 			health.SetHealthGRPCStatus(true)
+			reconnect.reset()
+
+			if connected {
+				go cl.subscriptions.resubscribe(cl.Ctx)
+			} else {
+				go cl.watchClusterCapabilities()
+				go cl.watchHostMetrics()
+			}
+			connected = true
 		}
 
 		command := new(agent.AgentCommand)
 		err = stream.RecvMsg(command)
+		if err != nil && cl.Ctx.Err() != nil {
+			continue
+		}
 		if err != nil {
 			s := status.Convert(err)
 			if s != nil && (s.Code() == codes.Unauthenticated || s.Code() == codes.PermissionDenied || s.Code() == codes.NotFound) {
@@ -331,7 +599,7 @@ func (cl *ClientLoop) grpcLoop(token *config.ValidJWT) error {
 				// TODO replace the line above with an error status code check and terminate dagent accordingly
 			}
 
-			time.Sleep(cl.AppConfig.DefaultTimeout)
+			cl.waitToReconnect(reconnect)
 			continue
 		}
 
@@ -350,16 +618,26 @@ func initWithToken(
 
 	ctx, cancel := context.WithCancel(grpcContext)
 	loop := ClientLoop{
-		cancel:      cancel,
-		AppConfig:   appConfig,
-		Ctx:         ctx,
-		WorkerFuncs: *workerFuncs,
-		Secrets:     secrets,
-		NodeID:      token.Subject,
+		cancel:        cancel,
+		AppConfig:     appConfig,
+		Ctx:           ctx,
+		WorkerFuncs:   *workerFuncs,
+		Secrets:       secrets,
+		subscriptions: newSubscriptions(),
+		deployments:   newDeploymentRuns(time.Now),
+		NodeID:        token.Subject,
 	}
 
 	loop.Ctx = metadata.AppendToOutgoingContext(loop.Ctx, contextMetadataKeyToken, token.StringifiedToken)
 
+	reconnect := newReconnectBackoff(appConfig, rand.New(rand.NewSource(time.Now().UnixNano())))
+
+	probeCtx, probeCancel := context.WithTimeout(loop.Ctx, appConfig.DefaultTimeout)
+	defer probeCancel()
+	if probeErr := netHelper.WaitForTCP(probeCtx, address, reconnect.probeBackoff()); probeErr != nil {
+		log.Warn().Err(probeErr).Str("address", address).Msg("Platform address is not reachable yet, check the network of the agent")
+	}
+
 	var creds credentials.TransportCredentials
 
 	httpAddr := fmt.Sprintf("https://%s", address)
@@ -378,12 +656,17 @@ func initWithToken(
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(nodeClock.unaryInterceptor),
 		grpc.WithKeepaliveParams(
 			keepalive.ClientParameters{
 				Time:                appConfig.GrpcKeepalive,
-				Timeout:             appConfig.DefaultTimeout,
+				Timeout:             appConfig.GrpcKeepaliveTimeout,
 				PermitWithoutStream: true,
 			}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           reconnect.connectConfig(),
+			MinConnectTimeout: minConnectTimeout,
+		}),
 	}
 
 	log.Info().Str("address", address).Msg("Dialing to address.")
@@ -398,7 +681,9 @@ func initWithToken(
 			break
 		}
 		log.Debug().Msgf("Waiting for state to change: %s", state.String())
-		conn.WaitForStateChange(loop.Ctx, state)
+		if !conn.WaitForStateChange(loop.Ctx, state) {
+			return errors.Join(loop.Ctx.Err(), conn.Close())
+		}
 		log.Debug().Msgf("State Changed to: %d", conn.GetState())
 	}
 	grpcConn.Conn = conn
@@ -423,8 +708,16 @@ func Init(grpcContext context.Context,
 		log.Warn().Err(err).Msg("Failed to start serving health")
 	}
 
+	if appConfig.HealthAddress != "" {
+		err = health.ServeHTTP(healthContext, appConfig.HealthAddress, workerFuncs.RuntimeCheck, workerFuncs.HostMetrics,
+			appConfig.MetricsToken)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to start serving health probes")
+		}
+	}
+
 	err = initWithToken(grpcContext, appConfig, workerFuncs, secrets, appConfig.JwtToken)
-	if err == nil {
+	if err == nil || grpcContext.Err() != nil {
 		return
 	}
 
@@ -463,10 +756,11 @@ func (cl *ClientLoop) handleGrpcTokenError(err error, token *config.ValidJWT) {
 	}
 }
 
-func executeDeployRequest(
-	ctx context.Context, req *agent.DeployRequest,
-	deploy DeployFunc, deploySecrets DeploySharedSecretsFunc, appConfig *config.CommonConfiguration,
-) {
+// executeDeployRequest reports the status of the deployment tagged with the node, a deployment of a node group
+// with an idempotency key is skipped if the agent finished it already
+func (cl *ClientLoop) executeDeployRequest(req *agent.DeployRequest) {
+	ctx := cl.Ctx
+	deploy := cl.WorkerFuncs.Deploy
 	if deploy == nil {
 		log.Error().Msg("Deploy function not implemented")
 		return
@@ -476,7 +770,7 @@ func executeDeployRequest(
 		log.Warn().Msg("Empty request id for deployment")
 		return
 	}
-	log.Info().Str("deployment", req.Id).Msg("Opening status channel")
+	log.Info().Str("deployment", req.Id).Str("nodeGroup", req.GetNodeGroup()).Msg("Opening status channel")
 
 	deployCtx := metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", req.Id)
 	statusStream, err := grpcConn.Client.DeploymentStatus(deployCtx, grpc.WaitForReady(true))
@@ -485,7 +779,8 @@ func executeDeployRequest(
 		return
 	}
 
-	dog := dogger.NewDeploymentLogger(ctx, &req.Id, statusStream, appConfig)
+	dog := dogger.NewDeploymentLogger(ctx, &req.Id, statusStream, cl.AppConfig)
+	dog.SetNodeID(cl.NodeID)
 
 	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Started.")
 
@@ -504,26 +799,52 @@ func executeDeployRequest(
 		}
 	}()
 
+	if key := req.GetIdempotencyKey(); key != "" {
+		run, deployed, acquireErr := cl.deployments.acquire(ctx, key, func() {
+			dog.WriteInfo("The deployment is in progress on the node already, waiting for it to finish.")
+		})
+		if acquireErr != nil {
+			cl.deployFailed(deployCtx, dog, acquireErr)
+			return
+		}
+		if deployed {
+			dog.WriteInfo("The deployment was finished on the node already, skipping.")
+			deployStatus = common.DeploymentStatus_SUCCESSFUL
+			return
+		}
+
+		defer func() {
+			cl.deployments.finish(key, run, deployStatus)
+		}()
+	}
+
 	if len(req.Secrets) > 0 {
 		dog.WriteInfo("Deploying secrets")
-		err = deploySecrets(ctx, req.Prefix, req.Secrets)
+		err = cl.WorkerFuncs.DeploySharedSecrets(ctx, req.Prefix, req.Secrets)
 		if err != nil {
-			dog.WriteError(err.Error())
+			cl.deployFailed(deployCtx, dog, err)
 			return
 		}
 	}
 
 	for i := range req.Requests {
-		imageReq := mapper.MapDeployImage(req.Prefix, req.Requests[i], appConfig)
+		imageReq := mapper.MapDeployImage(req.Prefix, req.Requests[i], cl.AppConfig)
+		imageReq.DeploymentID = req.Id
+		imageReq.RecreateUnchanged = req.RecreateUnchanged
 		dog.SetRequestID(imageReq.RequestID)
 
+		if err = resolveBaseConfig(imageReq, cl.AppConfig); err != nil {
+			cl.deployFailed(deployCtx, dog, err)
+			return
+		}
+
 		var versionData *v1.VersionData
 		if req.VersionName != "" {
 			versionData = &v1.VersionData{Version: req.VersionName, ReleaseNotes: req.ReleaseNotes}
 		}
 
 		if err = deploy(ctx, dog, imageReq, versionData); err != nil {
-			dog.WriteError(err.Error())
+			cl.deployFailed(deployCtx, dog, err)
 			return
 		}
 	}
@@ -531,13 +852,72 @@ func executeDeployRequest(
 	deployStatus = common.DeploymentStatus_SUCCESSFUL
 }
 
+// deployFailed writes the error into the log of the deployment, then reports its envelope like the errors of
+// the other commands, the context carries the id of the deployment
+func (cl *ClientLoop) deployFailed(ctx context.Context, dog *dogger.DeploymentLogger, err error) {
+	dog.WriteError(deployErrorMessages(err)...)
+	cl.executeCallback(mapDeployErrorToCommandError, agentError(ctx, err))
+}
+
+func mapDeployErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_Deploy{
+			Deploy: err,
+		},
+	}
+}
+
+// resolveBaseConfig merges the container config of a request with a base, the defaults are applied to the merged one
+func resolveBaseConfig(req *v1.DeployImageRequest, appConfig *config.CommonConfiguration) error {
+	if req.Base == nil {
+		return nil
+	}
+
+	if err := baseConfigs.Resolve(req); err != nil {
+		return err
+	}
+	v1.SetDeploymentDefaults(req, appConfig)
+
+	return nil
+}
+
+// deployErrorMessages puts every invalid field of a validation error into its own line
+func deployErrorMessages(err error) []string {
+	validationErr := &v1.ValidationError{}
+	if !errors.As(err, &validationErr) {
+		return []string{err.Error()}
+	}
+
+	messages := []string{"Invalid deploy request:"}
+	for i := range validationErr.Fields {
+		messages = append(messages, validationErr.Fields[i].String())
+	}
+
+	return messages
+}
+
 func streamContainerStatus(
 	streamCtx context.Context,
 	filterPrefix string,
 	stream agent.Agent_ContainerStateClient,
 	req *agent.ContainerStateRequest,
 	eventsContext *ContainerStatusStream,
+	batching statusBatching,
 ) {
+	if req.OneShot != nil && *req.OneShot {
+		sendContainerStatusOnce(streamCtx, filterPrefix, stream, req, eventsContext)
+		return
+	}
+
+	filter := newStatusFilter(req.GetFilter())
+	batch := newStatusBatch()
+	var flush <-chan time.Time
+	if batching.interval > 0 {
+		ticker := time.NewTicker(batching.interval)
+		defer ticker.Stop()
+		flush = ticker.C
+	}
+
 	for {
 		select {
 		case <-streamCtx.Done():
@@ -546,34 +926,77 @@ func streamContainerStatus(
 			log.Error().Err(eventError).Msg("Container status stream error")
 			return
 		case event := <-eventsContext.Events:
-			err := stream.Send(&common.ContainerStateListMessage{
-				Prefix: req.Prefix,
-				Data:   event,
-			})
-			if err != nil {
-				log.Error().Err(err).Msg("Container status channel error")
+			batch.add(filter.apply(event))
+			if (flush == nil || batch.len() >= batching.size) && !sendStatusBatch(stream, req, batch, batching) {
 				return
 			}
-
-			if req.OneShot != nil && *req.OneShot {
-				err := stream.CloseSend()
-				if err == nil {
-					log.Info().Str("prefix", filterPrefix).Msg("Closed container status channel")
-				} else {
-					log.Error().Err(err).Str("prefix", filterPrefix).Msg("Failed to close container status channel")
-				}
-
+		case <-flush:
+			if batch.len() > 0 && !sendStatusBatch(stream, req, batch, batching) {
 				return
 			}
+		}
+	}
+}
+
+// sendContainerStatusOnce sends the filtered page of the first list of states, then closes the stream
+func sendContainerStatusOnce(
+	streamCtx context.Context,
+	filterPrefix string,
+	stream agent.Agent_ContainerStateClient,
+	req *agent.ContainerStateRequest,
+	eventsContext *ContainerStatusStream,
+) {
+	select {
+	case <-streamCtx.Done():
+		return
+	case eventError := <-eventsContext.Error:
+		log.Error().Err(eventError).Msg("Container status stream error")
+		return
+	case event := <-eventsContext.Events:
+		items, nextPageToken, err := containerStatesPage(filterContainerStates(event, req.GetFilter()), req.GetPage())
+		if err != nil {
+			log.Error().Err(err).Str("prefix", filterPrefix).Msg("Container status page error")
 			break
 		}
+
+		msg := &common.ContainerStateListMessage{
+			Prefix: req.Prefix,
+			Data:   items,
+		}
+		if nextPageToken != "" {
+			msg.NextPageToken = &nextPageToken
+		}
+		if err = stream.Send(msg); err != nil {
+			log.Error().Err(err).Msg("Container status channel error")
+			return
+		}
+	}
+
+	err := stream.CloseSend()
+	if err == nil {
+		log.Info().Str("prefix", filterPrefix).Msg("Closed container status channel")
+	} else {
+		log.Error().Err(err).Str("prefix", filterPrefix).Msg("Failed to close container status channel")
 	}
 }
 
-func executeWatchContainerStatus(ctx context.Context, req *agent.ContainerStateRequest, containerStatusFn WatchContainerStatusFunc) {
+// streamLost is true if the stream ended because the connection was lost, not because the platform or the agent closed it
+func streamLost(ctx context.Context, err error) bool {
+	return ctx.Err() == nil && status.Code(err) == codes.Unavailable
+}
+
+// executeWatchContainerStatus returns true if the stream was lost with the connection, one-shot streams are never lost
+func executeWatchContainerStatus(ctx context.Context,
+	req *agent.ContainerStateRequest,
+	containerStatusFn WatchContainerStatusFunc,
+	batching statusBatching,
+	opened func(),
+) bool {
+	defer opened()
+
 	if containerStatusFn == nil {
 		log.Error().Msg("Watch function not implemented")
-		return
+		return false
 	}
 
 	filterPrefix := ""
@@ -585,9 +1008,10 @@ func executeWatchContainerStatus(ctx context.Context, req *agent.ContainerStateR
 
 	streamCtx := metadata.AppendToOutgoingContext(ctx, "dyo-filter-prefix", filterPrefix)
 	stream, err := grpcConn.Client.ContainerState(streamCtx, grpc.WaitForReady(true))
+	opened()
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to open container status channel")
-		return
+		return false
 	}
 
 	defer func() {
@@ -602,16 +1026,16 @@ func executeWatchContainerStatus(ctx context.Context, req *agent.ContainerStateR
 	eventsContext, err := containerStatusFn(streamCtx, filterPrefix, true)
 	if err != nil {
 		log.Error().Err(err).Str("prefix", filterPrefix).Msg("Failed to open container status reader")
-		return
+		return false
 	}
 
 	// The channel consumer must run in a gofunc so RecvMsg can receive server side stream close events
-	go streamContainerStatus(streamCtx, filterPrefix, stream, req, eventsContext)
+	go streamContainerStatus(streamCtx, filterPrefix, stream, req, eventsContext, batching)
 
 	// RecvMsg must be called in order to get an error if the server closes the stream
 	for {
 		var msg interface{}
-		err := stream.RecvMsg(&msg)
+		err = stream.RecvMsg(&msg)
 		if err != nil {
 			break
 		}
@@ -619,13 +1043,18 @@ func executeWatchContainerStatus(ctx context.Context, req *agent.ContainerStateR
 
 	<-streamCtx.Done()
 
-	log.Info().Str("prefix", filterPrefix).Msg("Container status channel closed")
+	lost := !req.GetOneShot() && streamLost(ctx, err)
+	log.Info().Str("prefix", filterPrefix).Bool("lost", lost).Msg("Container status channel closed")
+
+	return lost
 }
 
-func executeDeleteContainer(ctx context.Context, req *agent.ContainerDeleteRequest, deleteFn DeleteFunc) {
+func executeDeleteContainer(ctx context.Context, req *agent.ContainerDeleteRequest, deleteFn DeleteFunc) *AgentGrpcError {
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", req.Prefix, "dyo-container-name", req.Name)
+
 	if deleteFn == nil {
 		log.Error().Msg("Delete function not implemented")
-		return
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
 	}
 
 	log.Info().Str("prefix", req.Prefix).Str("name", req.Name).Msg("Deleting container")
@@ -633,6 +1062,17 @@ func executeDeleteContainer(ctx context.Context, req *agent.ContainerDeleteReque
 	err := deleteFn(ctx, req.Prefix, req.Name)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to delete container")
+		return agentError(ctx, err)
+	}
+
+	return nil
+}
+
+func mapDeleteContainerErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_DeleteContainer{
+			DeleteContainer: err,
+		},
 	}
 }
 
@@ -678,26 +1118,28 @@ func executeDeleteMultipleContainers(
 	return nil
 }
 
+// executeVersionDeployLegacyRequest deploys a single container, the error is reported with the id of the deployment
+// in the context, after it is written into the log of the deployment
 func executeVersionDeployLegacyRequest(
 	ctx context.Context, req *agent.DeployRequestLegacy,
 	deploy DeployFunc, appConfig *config.CommonConfiguration,
-) {
-	if deploy == nil {
-		log.Error().Msg("Deploy function not implemented")
-		return
-	}
-
+) *AgentGrpcError {
 	if req.RequestId == "" {
 		log.Warn().Msg("Empty request id for legacy deployment")
-		return
+		return nil
 	}
-	log.Info().Str("deployment", req.RequestId).Msg("Opening status channel.")
 
 	deployCtx := metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", req.RequestId)
+	if deploy == nil {
+		log.Error().Msg("Deploy function not implemented")
+		return agentError(deployCtx, internalCommon.ErrMethodNotImplemented)
+	}
+	log.Info().Str("deployment", req.RequestId).Msg("Opening status channel.")
+
 	statusStream, err := grpcConn.Client.DeploymentStatus(deployCtx, grpc.WaitForReady(true))
 	if err != nil {
 		log.Error().Stack().Err(err).Str("deployment", req.RequestId).Msg("Status connect error")
-		return
+		return agentError(deployCtx, err)
 	}
 
 	dog := dogger.NewDeploymentLogger(ctx, &req.RequestId, statusStream, appConfig)
@@ -709,7 +1151,7 @@ func executeVersionDeployLegacyRequest(
 
 		errorText := fmt.Sprintf("JSON parse error: %v", err)
 		dog.WriteDeploymentStatus(common.DeploymentStatus_FAILED, errorText)
-		return
+		return agentError(deployCtx, err)
 	}
 
 	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Started.")
@@ -717,12 +1159,13 @@ func executeVersionDeployLegacyRequest(
 	t1 := time.Now()
 
 	deployStatus := common.DeploymentStatus_SUCCESSFUL
-	if err = deploy(ctx, dog, &deployImageRequest, nil); err == nil {
+	deployErr := deploy(ctx, dog, &deployImageRequest, nil)
+	if deployErr == nil {
 		dog.WriteInfo(fmt.Sprintf("Deployment took: %.2f seconds", time.Since(t1).Seconds()))
 		dog.WriteInfo("Deployment succeeded.")
 	} else {
 		deployStatus = common.DeploymentStatus_FAILED
-		dog.WriteError(fmt.Sprintf("Deployment failed %s", err.Error()))
+		dog.WriteError(fmt.Sprintf("Deployment failed %s", deployErr.Error()))
 	}
 
 	dog.WriteDeploymentStatus(deployStatus)
@@ -733,8 +1176,13 @@ func executeVersionDeployLegacyRequest(
 			Str("deployment", req.RequestId).
 			Str("deployImageRequestId", deployImageRequest.RequestID).
 			Msg("Status close err")
-		return
 	}
+
+	if deployErr != nil {
+		return agentError(deployCtx, deployErr)
+	}
+
+	return nil
 }
 
 func mapListSecretsErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
@@ -775,6 +1223,11 @@ func executeSecretList(
 		return agentError(ctx, err)
 	}
 
+	keys, nextPageToken, err := secretKeysPage(keys, command.GetPage())
+	if err != nil {
+		return agentError(ctx, err)
+	}
+
 	publicKey, err := config.GetPublicKey(appConfig.SecretPrivateKey)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Failed to get public key")
@@ -786,6 +1239,9 @@ func executeSecretList(
 		PublicKey: publicKey,
 		Keys:      keys,
 	}
+	if nextPageToken != "" {
+		resp.NextPageToken = &nextPageToken
+	}
 
 	_, err = grpcConn.Client.SecretList(ctx, resp)
 	if err != nil {
@@ -860,10 +1316,15 @@ func (cl *ClientLoop) executeClose(command *agent.CloseConnectionRequest) {
 	}
 }
 
-func executeContainerCommand(ctx context.Context, command *common.ContainerCommandRequest, containerCommandFunc ContainerCommandFunc) {
+func executeContainerCommand(ctx context.Context, command *common.ContainerCommandRequest,
+	containerCommandFunc ContainerCommandFunc,
+) *AgentGrpcError {
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		"dyo-container-prefix", command.GetContainer().GetPrefix(), "dyo-container-name", command.GetContainer().GetName())
+
 	if containerCommandFunc == nil {
 		log.Error().Msg("Container command function not implemented")
-		return
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
 	}
 
 	log.Info().
@@ -875,6 +1336,37 @@ func executeContainerCommand(ctx context.Context, command *common.ContainerComma
 	err := containerCommandFunc(ctx, command)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Container Command error")
+		return agentError(ctx, err)
+	}
+
+	return nil
+}
+
+func mapContainerStateErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_ContainerState{
+			ContainerState: err,
+		},
+	}
+}
+
+func mapContainerCommandErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_ContainerCommand{
+			ContainerCommand: err,
+		},
+	}
+}
+
+func executeInvalidateCache(ctx context.Context, command *agent.CacheInvalidationRequest, invalidateFunc InvalidateCacheFunc) {
+	if invalidateFunc == nil {
+		log.Error().Msg("Cache invalidation function not implemented")
+		return
+	}
+
+	err := invalidateFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Cache invalidation error")
 	}
 }
 
@@ -907,7 +1399,8 @@ func readContainerLog(logContext *ContainerLogStream, sendLog SendLogFunc, prefi
 			log.Trace().Str("prefix", prefix).Str("name", name).Str("log", event.Message).Msg("Container log")
 		}
 
-		err := sendLog(event.Message)
+		line, suspicious := nodeClock.correctLogLine(event.Message)
+		err := sendLog(line, suspicious)
 		if err != nil {
 			log.Error().Err(err).Stack().Str("prefix", prefix).Str("name", name).Msg("Container log channel error")
 			return err
@@ -968,8 +1461,9 @@ func streamContainerLog(
 			return
 		}
 
-		err = readContainerLog(logContext, func(log string) error {
+		err = readContainerLog(logContext, func(log string, suspiciousTimestamp bool) error {
 			message.Log = log
+			message.SuspiciousTimestamp = suspiciousTimestamp
 			return client.Send(&message)
 		}, prefix, name)
 		if err == nil {
@@ -998,18 +1492,23 @@ func streamContainerLog(
 	}
 }
 
+// executeContainerLogStream returns true if the stream was lost with the connection
 func executeContainerLogStream(streamCtx context.Context,
 	logFunc ContainerLogFunc,
 	statusFunc WatchContainerStatusFunc,
 	command *agent.ContainerLogRequest,
-) {
+	opened func(),
+) bool {
+	defer opened()
+
 	prefix := command.Container.Prefix
 	name := command.Container.Name
 
 	stream, err := grpcConn.Client.ContainerLogStream(streamCtx, grpc.WaitForReady(true))
+	opened()
 	if err != nil {
 		log.Error().Err(err).Str("prefix", prefix).Str("name", name).Msg("Failed to open container log stream")
-		return
+		return false
 	}
 
 	defer func() {
@@ -1019,13 +1518,14 @@ func executeContainerLogStream(streamCtx context.Context,
 		}
 	}()
 
+	ctx := streamCtx
 	streamCtx = stream.Context()
 
 	go streamContainerLog(streamCtx, logFunc, stream, statusFunc, command)
 
 	for {
 		var msg interface{}
-		err := stream.RecvMsg(&msg)
+		err = stream.RecvMsg(&msg)
 		if err != nil {
 			break
 		}
@@ -1033,7 +1533,10 @@ func executeContainerLogStream(streamCtx context.Context,
 
 	<-streamCtx.Done()
 
-	log.Trace().Str("prefix", prefix).Str("name", name).Msg("Container log exited")
+	lost := streamLost(ctx, err)
+	log.Trace().Str("prefix", prefix).Str("name", name).Bool("lost", lost).Msg("Container log exited")
+
+	return lost
 }
 
 func collectContainerLog(
@@ -1042,7 +1545,7 @@ func collectContainerLog(
 ) []string {
 	containerLog := make([]string, 0)
 
-	err := readContainerLog(logContext, func(log string) error {
+	err := readContainerLog(logContext, func(log string, _ bool) error {
 		containerLog = append(containerLog, log)
 		return nil
 	}, prefix, name)
@@ -1093,6 +1596,7 @@ func executeContainerLog(
 	command *agent.ContainerLogRequest,
 	logFunc ContainerLogFunc,
 	statusFunc WatchContainerStatusFunc,
+	subs *subscriptions,
 ) *AgentGrpcError {
 	prefix := command.Container.Prefix
 	name := command.Container.Name
@@ -1110,7 +1614,9 @@ func executeContainerLog(
 		return executeContainerLogRequest(ctx, logFunc, command)
 	}
 
-	go executeContainerLogStream(ctx, logFunc, statusFunc, command)
+	go subs.run(containerLogSubscriptionKey(prefix, name), func(opened func()) bool {
+		return executeContainerLogStream(ctx, logFunc, statusFunc, command, opened)
+	})
 	return nil
 }
 
@@ -1158,6 +1664,441 @@ func executeContainerInspect(
 	return nil
 }
 
+func mapDeploymentDiffErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_DeploymentDiff{
+			DeploymentDiff: err,
+		},
+	}
+}
+
+func executeDeploymentDiff(
+	ctx context.Context,
+	command *agent.DeploymentDiffRequest,
+	diffFunc DeploymentDiffFunc,
+	appConfig *config.CommonConfiguration,
+) *AgentGrpcError {
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", command.Id)
+
+	if diffFunc == nil {
+		log.Error().Msg("Deployment diff function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("deployment", command.Id).Str("prefix", command.Prefix).Msg("Comparing deployment with the running one")
+
+	resp := &agent.DeploymentDiffResponse{
+		Id:        command.Id,
+		Workloads: []*agent.WorkloadDiff{},
+	}
+	for _, req := range command.Requests {
+		imageReq := mapper.MapDeployImage(command.Prefix, req, appConfig)
+		if err := resolveBaseConfig(imageReq, appConfig); err != nil {
+			log.Error().Err(err).Str("deployment", command.Id).Msg("Failed to resolve the base config")
+			return agentError(ctx, err)
+		}
+
+		diff, err := diffFunc(ctx, imageReq)
+		if err != nil {
+			log.Error().Stack().Err(err).Str("deployment", command.Id).Msg("Failed to compare deployment")
+			return agentError(ctx, err)
+		}
+
+		resp.Workloads = append(resp.Workloads, mapper.MapDeploymentDiff(req.Id, command.Prefix, diff))
+	}
+
+	_, err := grpcConn.Client.DeploymentDiff(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Deployment diff response error")
+	}
+
+	return nil
+}
+
+func mapVolumeUsageErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_VolumeUsage{
+			VolumeUsage: err,
+		},
+	}
+}
+
+func executeVolumeUsage(
+	ctx context.Context,
+	command *agent.VolumeUsageRequest,
+	usageFunc VolumeUsageFunc,
+) *AgentGrpcError {
+	prefix := command.GetPrefix()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-filter-prefix", prefix)
+
+	if usageFunc == nil {
+		log.Error().Msg("Volume usage function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("prefix", prefix).Msg("Measuring volume usage")
+
+	volumes, err := usageFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to measure volume usage")
+		return agentError(ctx, err)
+	}
+
+	resp := &agent.VolumeUsageResponse{
+		Prefix:  command.Prefix,
+		Volumes: volumes,
+	}
+
+	_, err = grpcConn.Client.VolumeUsage(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Volume usage response error")
+	}
+
+	return nil
+}
+
+func mapPruneReportErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_PruneReport{
+			PruneReport: err,
+		},
+	}
+}
+
+func executePruneReport(
+	ctx context.Context,
+	command *agent.PruneReportRequest,
+	reportFunc PruneReportFunc,
+) *AgentGrpcError {
+	if reportFunc == nil {
+		log.Error().Msg("Prune report function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("targets", fmt.Sprint(command.GetTargets())).Msg("Collecting prune candidates")
+
+	candidates, err := reportFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to collect prune candidates")
+		return agentError(ctx, err)
+	}
+
+	resp := &agent.PruneReportResponse{
+		Candidates: candidates,
+	}
+	for _, it := range candidates {
+		if it.Size > 0 {
+			resp.Reclaimable += it.Size
+		}
+	}
+
+	_, err = grpcConn.Client.PruneReport(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Prune report response error")
+	}
+
+	return nil
+}
+
+func mapTrafficUsageErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_TrafficUsage{
+			TrafficUsage: err,
+		},
+	}
+}
+
+func executeTrafficUsage(
+	ctx context.Context,
+	command *agent.TrafficUsageRequest,
+	usageFunc TrafficUsageFunc,
+) *AgentGrpcError {
+	prefix := command.GetPrefix()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-filter-prefix", prefix)
+
+	if usageFunc == nil {
+		log.Error().Msg("Traffic usage function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("prefix", prefix).Msg("Collecting traffic usage")
+
+	resp, err := usageFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to collect traffic usage")
+		return agentError(ctx, err)
+	}
+
+	_, err = grpcConn.Client.TrafficUsage(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Traffic usage response error")
+	}
+
+	return nil
+}
+
+func mapIngressConfigErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_IngressConfig{
+			IngressConfig: err,
+		},
+	}
+}
+
+func executeIngressConfig(
+	ctx context.Context,
+	command *agent.IngressConfigRequest,
+	ingressFunc IngressConfigFunc,
+) *AgentGrpcError {
+	if ingressFunc == nil {
+		log.Error().Msg("Ingress config function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Bool("enabled", command.GetEnabled()).Str("version", command.GetVersion()).Msg("Applying the ingress configuration")
+
+	resp, err := ingressFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to apply the ingress configuration")
+		return agentError(ctx, err)
+	}
+
+	_, err = grpcConn.Client.IngressConfig(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Ingress config response error")
+	}
+
+	return nil
+}
+
+func mapRollbackToRevisionErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_RollbackToRevision{
+			RollbackToRevision: err,
+		},
+	}
+}
+
+func executeRollbackToRevision(
+	ctx context.Context,
+	command *agent.RollbackToRevisionRequest,
+	rollbackFunc RollbackToRevisionFunc,
+) *AgentGrpcError {
+	prefix := command.Container.Prefix
+	name := command.Container.Name
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", prefix, "dyo-container-name", name)
+
+	if rollbackFunc == nil {
+		log.Error().Msg("Rollback function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("prefix", prefix).Str("name", name).Int64("revision", command.GetRevision()).Msg("Rolling back container")
+
+	resp, err := rollbackFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to roll back container")
+		return agentError(ctx, err)
+	}
+
+	_, err = grpcConn.Client.RollbackToRevision(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Rollback response error")
+	}
+
+	return nil
+}
+
+func mapResourceRecommendationErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_ResourceRecommendation{
+			ResourceRecommendation: err,
+		},
+	}
+}
+
+func executeResourceRecommendation(
+	ctx context.Context,
+	command *agent.ResourceRecommendationRequest,
+	recommendFunc RecommendResourcesFunc,
+) *AgentGrpcError {
+	prefix := command.GetPrefix()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-filter-prefix", prefix)
+
+	if recommendFunc == nil {
+		log.Error().Msg("Resource recommendation function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("prefix", prefix).Msg("Computing resource recommendations")
+
+	recommendations, err := recommendFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to compute resource recommendations")
+		return agentError(ctx, err)
+	}
+
+	resp := &agent.ResourceRecommendationResponse{
+		Prefix:          command.Prefix,
+		Recommendations: recommendations,
+	}
+
+	_, err = grpcConn.Client.ResourceRecommendation(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Resource recommendation response error")
+	}
+
+	return nil
+}
+
+func mapWorkloadOperationErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_WorkloadOperation{
+			WorkloadOperation: err,
+		},
+	}
+}
+
+// executeWorkloadOperation reports the errors before the progress channel is open as command errors,
+// after that the outcome is the last message of the channel
+func executeWorkloadOperation(
+	ctx context.Context,
+	command *agent.WorkloadOperationRequest,
+	operationFunc WorkloadOperationFunc,
+) *AgentGrpcError {
+	prefix := command.Container.Prefix
+	name := command.Container.Name
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", prefix, "dyo-container-name", name)
+
+	if operationFunc == nil {
+		log.Error().Msg("Workload operation function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	streamCtx := metadata.AppendToOutgoingContext(ctx, "dyo-operation-id", command.Id)
+	stream, err := grpcConn.Client.WorkloadOperation(streamCtx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Stack().Err(err).Str("operation", command.Id).Msg("Workload operation connect error")
+		return agentError(ctx, err)
+	}
+
+	send := func(message *agent.WorkloadOperationMessage) {
+		if message.CreatedAt == nil {
+			message.CreatedAt = timestamppb.Now()
+		}
+
+		if sendErr := stream.Send(message); sendErr != nil {
+			log.Error().Err(sendErr).Str("operation", command.Id).Msg("Workload operation status error")
+		}
+	}
+
+	log.Info().
+		Str("operation", command.Operation.String()).
+		Str("prefix", prefix).
+		Str("name", name).
+		Msg("Executing workload operation")
+
+	send(&agent.WorkloadOperationMessage{Status: common.DeploymentStatus_IN_PROGRESS, Message: "Started."})
+
+	err = operationFunc(ctx, command, send)
+	if err != nil {
+		log.Error().Stack().Err(err).Str("operation", command.Id).Msg("Workload operation failed")
+		send(&agent.WorkloadOperationMessage{Status: common.DeploymentStatus_FAILED, Message: err.Error()})
+	} else {
+		send(&agent.WorkloadOperationMessage{Status: common.DeploymentStatus_SUCCESSFUL, Message: "Finished."})
+	}
+
+	err = stream.CloseSend()
+	if err != nil {
+		log.Error().Stack().Err(err).Str("operation", command.Id).Msg("Workload operation close error")
+	}
+
+	return nil
+}
+
+func mapContainerCheckpointErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_ContainerCheckpoint{
+			ContainerCheckpoint: err,
+		},
+	}
+}
+
+func executeContainerCheckpoint(
+	ctx context.Context,
+	command *agent.ContainerCheckpointRequest,
+	checkpointFunc ContainerCheckpointFunc,
+) *AgentGrpcError {
+	prefix := command.Container.Prefix
+	name := command.Container.Name
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", prefix, "dyo-container-name", name)
+
+	if checkpointFunc == nil {
+		log.Error().Msg("Container checkpoint function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("prefix", prefix).Str("name", name).Msg("Checkpointing container")
+
+	resp, err := checkpointFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to checkpoint container")
+		return agentError(ctx, err)
+	}
+
+	_, err = grpcConn.Client.ContainerCheckpoint(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Container checkpoint response error")
+	}
+
+	return nil
+}
+
+func mapContainerRestoreErrorToCommandError(err *agent.AgentError) *agent.AgentCommandError {
+	return &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_ContainerRestore{
+			ContainerRestore: err,
+		},
+	}
+}
+
+func executeContainerRestore(
+	ctx context.Context,
+	command *agent.ContainerRestoreRequest,
+	restoreFunc ContainerRestoreFunc,
+) *AgentGrpcError {
+	prefix := command.Container.Prefix
+	name := command.Container.Name
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", prefix, "dyo-container-name", name)
+
+	if restoreFunc == nil {
+		log.Error().Msg("Container restore function not implemented")
+		return agentError(ctx, internalCommon.ErrMethodNotImplemented)
+	}
+
+	log.Info().Str("prefix", prefix).Str("name", name).Str("checkpoint", command.CheckpointId).Msg("Restoring container")
+
+	resp, err := restoreFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to restore container")
+		return agentError(ctx, err)
+	}
+
+	_, err = grpcConn.Client.ContainerRestore(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Container restore response error")
+	}
+
+	return nil
+}
+
 func (cl *ClientLoop) executeReplaceToken(command *agent.ReplaceTokenRequest) error {
 	log.Debug().Msg("Replace token requested")
 
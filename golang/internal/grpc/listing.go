@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+const (
+	defaultListPageSize = 100
+	maxListPageSize     = 1000
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// matchesListFilter tells if the container is in one of the states of the filter and has every label of it
+func matchesListFilter(item *common.ContainerStateItem, filter *common.ListFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.GetStates()) > 0 && !slices.Contains(filter.GetStates(), item.GetState()) {
+		return false
+	}
+
+	for key, value := range filter.GetLabels() {
+		actual, ok := item.GetLabels()[key]
+		if !ok || (value != "" && actual != value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterContainerStates are the items matching the filter, in their order
+func filterContainerStates(items []*common.ContainerStateItem, filter *common.ListFilter) []*common.ContainerStateItem {
+	if filter == nil {
+		return items
+	}
+
+	matching := []*common.ContainerStateItem{}
+	for _, item := range items {
+		if matchesListFilter(item, filter) {
+			matching = append(matching, item)
+		}
+	}
+
+	return matching
+}
+
+// statusFilter filters the updates of a watching stream, the update of a container which stops matching the filter
+// is still sent once, so the platform does not keep its last matching state
+type statusFilter struct {
+	filter   *common.ListFilter
+	matching map[string]bool
+}
+
+func newStatusFilter(filter *common.ListFilter) *statusFilter {
+	return &statusFilter{filter: filter, matching: map[string]bool{}}
+}
+
+func (f *statusFilter) apply(items []*common.ContainerStateItem) []*common.ContainerStateItem {
+	if f.filter == nil {
+		return items
+	}
+
+	passed := []*common.ContainerStateItem{}
+	for _, item := range items {
+		key := statusBatchKey(item)
+		switch {
+		case matchesListFilter(item, f.filter):
+			f.matching[key] = true
+		case f.matching[key]:
+			delete(f.matching, key)
+		default:
+			continue
+		}
+		passed = append(passed, item)
+	}
+
+	return passed
+}
+
+func encodePageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodePageToken(token string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(key) == 0 {
+		return "", ErrInvalidPageToken
+	}
+
+	return string(key), nil
+}
+
+// validatePageRequest checks the token of the page, so the request can fail before anything is listed
+func validatePageRequest(page *common.PageRequest) error {
+	if page == nil || page.PageToken == nil {
+		return nil
+	}
+
+	_, err := decodePageToken(page.GetPageToken())
+	return err
+}
+
+// pageOf is the page of the items ordered by their keys with the token of the next page, the token is empty
+// on the last page, the token is the key of the last item, so the pages are stable while the items change
+func pageOf[T any](items []T, keyOf func(T) string, page *common.PageRequest) ([]T, string, error) {
+	if page == nil {
+		return items, "", nil
+	}
+
+	size := int(page.GetPageSize())
+	if size <= 0 {
+		size = defaultListPageSize
+	}
+	size = min(size, maxListPageSize)
+
+	sorted := slices.Clone(items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keyOf(sorted[i]) < keyOf(sorted[j])
+	})
+
+	start := 0
+	if page.PageToken != nil {
+		after, err := decodePageToken(page.GetPageToken())
+		if err != nil {
+			return nil, "", err
+		}
+
+		start = sort.Search(len(sorted), func(i int) bool {
+			return keyOf(sorted[i]) > after
+		})
+	}
+
+	end := min(start+size, len(sorted))
+	next := ""
+	if end < len(sorted) {
+		next = encodePageToken(keyOf(sorted[end-1]))
+	}
+
+	return sorted[start:end], next, nil
+}
+
+// containerStatesPage is the page of the containers keyed by their prefix and name
+func containerStatesPage(items []*common.ContainerStateItem, page *common.PageRequest) ([]*common.ContainerStateItem, string, error) {
+	return pageOf(items, statusBatchKey, page)
+}
+
+// secretKeysPage is the page of the keys of the secrets
+func secretKeysPage(keys []string, page *common.PageRequest) ([]string, string, error) {
+	return pageOf(keys, func(key string) string { return key }, page)
+}
@@ -0,0 +1,13 @@
+package grpc
+
+import "github.com/dyrector-io/dyrectorio/protobuf/go/common"
+
+var (
+	FilterContainerStates = filterContainerStates
+	ContainerStatesPage   = containerStatesPage
+	ValidatePageRequest   = validatePageRequest
+)
+
+func SecretKeysPage(keys []string, page *common.PageRequest) ([]string, string, error) {
+	return secretKeysPage(keys, page)
+}
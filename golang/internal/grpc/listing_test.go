@@ -0,0 +1,173 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func labeledStateItem(name string, state common.ContainerState, labels map[string]string) *common.ContainerStateItem {
+	item := stateItem(name, state)
+	item.Labels = labels
+	return item
+}
+
+func TestFilterContainerStates(t *testing.T) {
+	items := []*common.ContainerStateItem{
+		labeledStateItem("api", common.ContainerState_RUNNING, map[string]string{"tier": "backend", "team": "core"}),
+		labeledStateItem("ui", common.ContainerState_RUNNING, map[string]string{"tier": "frontend"}),
+		labeledStateItem("job", common.ContainerState_EXITED, map[string]string{"tier": "backend"}),
+		stateItem("db", common.ContainerState_WAITING),
+	}
+
+	assert.Len(t, grpc.FilterContainerStates(items, nil), 4)
+
+	running := grpc.FilterContainerStates(items, &common.ListFilter{States: []common.ContainerState{common.ContainerState_RUNNING}})
+	assert.Equal(t, []string{"api:RUNNING", "ui:RUNNING"}, names(running))
+
+	backend := grpc.FilterContainerStates(items, &common.ListFilter{Labels: map[string]string{"tier": "backend"}})
+	assert.Equal(t, []string{"api:RUNNING", "job:EXITED"}, names(backend))
+
+	// an empty value only needs the label
+	team := grpc.FilterContainerStates(items, &common.ListFilter{
+		States: []common.ContainerState{common.ContainerState_RUNNING, common.ContainerState_EXITED},
+		Labels: map[string]string{"team": ""},
+	})
+	assert.Equal(t, []string{"api:RUNNING"}, names(team))
+}
+
+func TestContainerStatesPage(t *testing.T) {
+	items := []*common.ContainerStateItem{}
+	for _, it := range []string{"e", "b", "d", "a", "c"} {
+		items = append(items, stateItem(it, common.ContainerState_RUNNING))
+	}
+
+	page, next, err := grpc.ContainerStatesPage(items, nil)
+	assert.NoError(t, err)
+	assert.Len(t, page, 5)
+	assert.Empty(t, next)
+
+	page, next, err = grpc.ContainerStatesPage(items, &common.PageRequest{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a:RUNNING", "b:RUNNING"}, names(page))
+	assert.NotEmpty(t, next)
+
+	// a container added before the cursor is not repeated
+	items = append(items, stateItem("aa", common.ContainerState_RUNNING))
+	page, next, err = grpc.ContainerStatesPage(items, &common.PageRequest{PageSize: 2, PageToken: &next})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c:RUNNING", "d:RUNNING"}, names(page))
+
+	page, next, err = grpc.ContainerStatesPage(items, &common.PageRequest{PageSize: 2, PageToken: &next})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e:RUNNING"}, names(page))
+	assert.Empty(t, next)
+
+	_, _, err = grpc.ContainerStatesPage(items, &common.PageRequest{PageToken: pointer.ToString("not a token!")})
+	assert.ErrorIs(t, err, grpc.ErrInvalidPageToken)
+}
+
+func TestSecretKeysPageDefaultSize(t *testing.T) {
+	keys := []string{}
+	for i := 0; i < 150; i++ {
+		keys = append(keys, fmt.Sprintf("KEY_%03d", i))
+	}
+
+	page, next, err := grpc.SecretKeysPage(keys, &common.PageRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, page, 100)
+	assert.Equal(t, "KEY_099", page[99])
+
+	page, next, err = grpc.SecretKeysPage(keys, &common.PageRequest{PageToken: &next})
+	assert.NoError(t, err)
+	assert.Len(t, page, 50)
+	assert.Equal(t, "KEY_100", page[0])
+	assert.Empty(t, next)
+}
+
+func TestStreamContainerStatusOneShotPage(t *testing.T) {
+	stream := &containerStateClient{}
+	events := &grpc.ContainerStatusStream{Events: make(chan []*common.ContainerStateItem, 1), Error: make(chan error)}
+	events.Events <- []*common.ContainerStateItem{
+		stateItem("c", common.ContainerState_RUNNING),
+		stateItem("b", common.ContainerState_EXITED),
+		stateItem("a", common.ContainerState_RUNNING),
+		stateItem("d", common.ContainerState_RUNNING),
+	}
+
+	req := &agent.ContainerStateRequest{
+		OneShot: pointer.ToBool(true),
+		Filter:  &common.ListFilter{States: []common.ContainerState{common.ContainerState_RUNNING}},
+		Page:    &common.PageRequest{PageSize: 2},
+	}
+	grpc.StreamContainerStatus(context.Background(), stream, req, events, time.Hour, 100)
+
+	sent := stream.sent()
+	assert.Len(t, sent, 1)
+	assert.Equal(t, []string{"a:RUNNING", "c:RUNNING"}, names(sent[0].Data))
+	assert.NotEmpty(t, sent[0].GetNextPageToken())
+	assert.True(t, stream.closed)
+}
+
+func TestStreamContainerStatusFiltersUpdates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &containerStateClient{}
+	events := &grpc.ContainerStatusStream{Events: make(chan []*common.ContainerStateItem), Error: make(chan error)}
+	req := &agent.ContainerStateRequest{Filter: &common.ListFilter{Labels: map[string]string{"tier": "backend"}}}
+	go grpc.StreamContainerStatus(ctx, stream, req, events, 0, 100)
+
+	events.Events <- []*common.ContainerStateItem{
+		labeledStateItem("api", common.ContainerState_RUNNING, map[string]string{"tier": "backend"}),
+		labeledStateItem("ui", common.ContainerState_RUNNING, map[string]string{"tier": "frontend"}),
+	}
+	events.Events <- []*common.ContainerStateItem{
+		labeledStateItem("ui", common.ContainerState_EXITED, map[string]string{"tier": "frontend"}),
+	}
+
+	assert.Eventually(t, func() bool { return len(stream.sent()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"api:RUNNING"}, names(stream.sent()[0].Data))
+}
+
+func TestStreamContainerStatusSendsLeavingFilter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &containerStateClient{}
+	events := &grpc.ContainerStatusStream{Events: make(chan []*common.ContainerStateItem), Error: make(chan error)}
+	req := &agent.ContainerStateRequest{Filter: &common.ListFilter{States: []common.ContainerState{common.ContainerState_RUNNING}}}
+	go grpc.StreamContainerStatus(ctx, stream, req, events, 0, 100)
+
+	// the container leaving the filter is sent once with its new state
+	events.Events <- []*common.ContainerStateItem{stateItem("api", common.ContainerState_RUNNING)}
+	events.Events <- []*common.ContainerStateItem{stateItem("api", common.ContainerState_EXITED)}
+	events.Events <- []*common.ContainerStateItem{stateItem("api", common.ContainerState_REMOVED)}
+	events.Events <- []*common.ContainerStateItem{stateItem("api", common.ContainerState_RUNNING)}
+
+	assert.Eventually(t, func() bool { return len(stream.sent()) == 3 }, time.Second, 10*time.Millisecond)
+	sent := stream.sent()
+	assert.Equal(t, []string{"api:RUNNING"}, names(sent[0].Data))
+	assert.Equal(t, []string{"api:EXITED"}, names(sent[1].Data))
+	assert.Equal(t, []string{"api:RUNNING"}, names(sent[2].Data))
+}
+
+func TestValidatePageRequest(t *testing.T) {
+	assert.NoError(t, grpc.ValidatePageRequest(nil))
+	assert.NoError(t, grpc.ValidatePageRequest(&common.PageRequest{PageSize: 10}))
+	assert.ErrorIs(t, grpc.ValidatePageRequest(&common.PageRequest{PageToken: pointer.ToString("!")}), grpc.ErrInvalidPageToken)
+
+	agentErr := grpc.AgentErrorOf(grpc.ValidatePageRequest(&common.PageRequest{PageToken: pointer.ToString("")}), nil)
+	assert.Equal(t, "INVALID_PAGE_TOKEN", agentErr.GetCode())
+}
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/backoff"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	netHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
+)
+
+const (
+	reconnectMultiplier     = 2
+	defaultReconnectInitial = time.Second
+	// grpc-go waits this long for a connection attempt by default, it is lost when the backoff is configured
+	minConnectTimeout = 20 * time.Second
+)
+
+// reconnectBackoff is the delay between the attempts to reconnect to the platform, it doubles after every failed
+// attempt until it reaches the max, then it is spread randomly by the jitter so agents do not reconnect in bursts
+type reconnectBackoff struct {
+	random  *rand.Rand
+	initial time.Duration
+	max     time.Duration
+	delay   time.Duration
+	jitter  float64
+}
+
+func newReconnectBackoff(appConfig *config.CommonConfiguration, random *rand.Rand) *reconnectBackoff {
+	initial := appConfig.GrpcReconnectInitial
+	if initial <= 0 {
+		initial = defaultReconnectInitial
+	}
+
+	return &reconnectBackoff{
+		random:  random,
+		initial: initial,
+		max:     max(appConfig.GrpcReconnectMax, initial),
+		jitter:  min(max(appConfig.GrpcReconnectJitter, 0), 1),
+	}
+}
+
+func (b *reconnectBackoff) next() time.Duration {
+	if b.delay == 0 {
+		b.delay = b.initial
+	} else {
+		b.delay = min(b.delay*reconnectMultiplier, b.max)
+	}
+
+	spread := float64(b.delay) * b.jitter
+	return b.delay + time.Duration(spread*(2*b.random.Float64()-1))
+}
+
+func (b *reconnectBackoff) reset() {
+	b.delay = 0
+}
+
+// connectConfig is the same backoff for the reconnects of grpc-go, so the dial does not retry on other delays
+func (b *reconnectBackoff) connectConfig() backoff.Config {
+	return backoff.Config{
+		BaseDelay:  b.initial,
+		Multiplier: reconnectMultiplier,
+		Jitter:     b.jitter,
+		MaxDelay:   b.max,
+	}
+}
+
+// probeBackoff is the backoff of the reachability probe of the platform address
+func (b *reconnectBackoff) probeBackoff() netHelper.Backoff {
+	return netHelper.Backoff{Initial: b.initial, Max: b.max}
+}
+
+// subscribeFunc opens a long-lived stream, calls opened when the stream is open, then serves it until it ends,
+// the result is true if the stream was lost with the connection and it has to be opened again
+type subscribeFunc func(opened func()) bool
+
+type subscription struct {
+	subscribe subscribeFunc
+	active    bool
+}
+
+// subscriptions are the long-lived streams opened by the platform, eg. the container states and logs.
+// They are kept after the connection is lost, so the agent can open them again when it reconnects.
+type subscriptions struct {
+	items map[string]*subscription
+	mutex sync.Mutex
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{items: map[string]*subscription{}}
+}
+
+func containerStateSubscriptionKey(prefix string) string {
+	return "container-state/" + prefix
+}
+
+func containerLogSubscriptionKey(prefix, name string) string {
+	return "container-log/" + prefix + "/" + name
+}
+
+// run serves the subscription, a subscription with the same key replaces the previous one
+func (s *subscriptions) run(key string, subscribe subscribeFunc) {
+	sub := &subscription{subscribe: subscribe, active: true}
+
+	s.mutex.Lock()
+	s.items[key] = sub
+	s.mutex.Unlock()
+
+	s.serve(key, sub, func() {})
+}
+
+func (s *subscriptions) serve(key string, sub *subscription, opened func()) {
+	lost := sub.subscribe(opened)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.items[key] != sub {
+		return
+	}
+
+	if lost {
+		sub.active = false
+		return
+	}
+
+	delete(s.items, key)
+}
+
+// activate marks the subscription active if it was lost, the result is nil if it is served already
+func (s *subscriptions) activate(key string) *subscription {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sub, ok := s.items[key]
+	if !ok || sub.active {
+		return nil
+	}
+
+	sub.active = true
+	return sub
+}
+
+// resubscribe opens the lost subscriptions again in the order of their keys, one after the other,
+// so the platform receives the streams in the same order after every reconnect
+func (s *subscriptions) resubscribe(ctx context.Context) {
+	s.mutex.Lock()
+	keys := []string{}
+	for key, sub := range s.items {
+		if !sub.active {
+			keys = append(keys, key)
+		}
+	}
+	s.mutex.Unlock()
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		sub := s.activate(key)
+		if sub == nil {
+			continue
+		}
+
+		opened := make(chan struct{})
+		once := sync.Once{}
+		markOpened := func() {
+			once.Do(func() {
+				close(opened)
+			})
+		}
+
+		go func(key string) {
+			s.serve(key, sub, markOpened)
+			markOpened()
+		}(key)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-opened:
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/backoff"
+)
+
+var (
+	NewReconnectBackoff = newReconnectBackoff
+	NewSubscriptions    = newSubscriptions
+)
+
+func (b *reconnectBackoff) Next() time.Duration {
+	return b.next()
+}
+
+func (b *reconnectBackoff) Reset() {
+	b.reset()
+}
+
+func (b *reconnectBackoff) ConnectConfig() backoff.Config {
+	return b.connectConfig()
+}
+
+func (s *subscriptions) Run(key string, subscribe func(opened func()) bool) {
+	s.run(key, subscribe)
+}
+
+func (s *subscriptions) Resubscribe(ctx context.Context) {
+	s.resubscribe(ctx)
+}
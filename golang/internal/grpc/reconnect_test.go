@@ -0,0 +1,103 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func TestReconnectBackoff(t *testing.T) {
+	backoff := grpc.NewReconnectBackoff(&config.CommonConfiguration{
+		GrpcReconnectInitial: time.Second,
+		GrpcReconnectMax:     5 * time.Second,
+	}, rand.New(rand.NewSource(1)))
+
+	delays := []time.Duration{}
+	for i := 0; i < 5; i++ {
+		delays = append(delays, backoff.Next())
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, delays)
+
+	backoff.Reset()
+	assert.Equal(t, time.Second, backoff.Next())
+}
+
+func TestReconnectBackoffConnectConfig(t *testing.T) {
+	// the dial of the platform falls back to the same defaults as the reconnects
+	connect := grpc.NewReconnectBackoff(&config.CommonConfiguration{GrpcReconnectJitter: 2}, rand.New(rand.NewSource(1))).ConnectConfig()
+
+	assert.Equal(t, time.Second, connect.BaseDelay)
+	assert.Equal(t, time.Second, connect.MaxDelay)
+	assert.EqualValues(t, 1, connect.Jitter)
+}
+
+func TestReconnectBackoffJitter(t *testing.T) {
+	backoff := grpc.NewReconnectBackoff(&config.CommonConfiguration{
+		GrpcReconnectInitial: 10 * time.Second,
+		GrpcReconnectMax:     10 * time.Second,
+		GrpcReconnectJitter:  0.2,
+	}, rand.New(rand.NewSource(1)))
+
+	for i := 0; i < 100; i++ {
+		delay := backoff.Next()
+		assert.GreaterOrEqual(t, delay, 8*time.Second)
+		assert.LessOrEqual(t, delay, 12*time.Second)
+	}
+}
+
+type subscriber struct {
+	opened []string
+	lost   bool
+	mutex  sync.Mutex
+}
+
+func (s *subscriber) subscribe(key string) func(func()) bool {
+	return func(opened func()) bool {
+		s.mutex.Lock()
+		s.opened = append(s.opened, key)
+		lost := s.lost
+		s.mutex.Unlock()
+
+		opened()
+		return lost
+	}
+}
+
+func (s *subscriber) reset(lost bool) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	opened := s.opened
+	s.opened = nil
+	s.lost = lost
+
+	return opened
+}
+
+func TestResubscribe(t *testing.T) {
+	ctx := context.Background()
+	subs := grpc.NewSubscriptions()
+	streams := &subscriber{lost: true}
+
+	for _, key := range []string{"container-state/b", "container-log/a/web", "container-state/a"} {
+		subs.Run(key, streams.subscribe(key))
+	}
+	assert.Len(t, streams.reset(false), 3)
+
+	subs.Resubscribe(ctx)
+	assert.Equal(t, []string{"container-log/a/web", "container-state/a", "container-state/b"}, streams.reset(false))
+
+	// the streams closed by the platform are not opened again
+	subs.Resubscribe(ctx)
+	assert.Empty(t, streams.reset(false))
+}
@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+const defaultStatusBatchSize = 100
+
+// statusBatching bounds the state updates sent to the platform, the updates are collected for the interval
+// or until there are size of them, a zero interval sends them as they come
+type statusBatching struct {
+	interval time.Duration
+	size     int
+}
+
+func newStatusBatching(appConfig *config.CommonConfiguration) statusBatching {
+	size := appConfig.StatusBatchSize
+	if size <= 0 {
+		size = defaultStatusBatchSize
+	}
+
+	return statusBatching{
+		interval: max(appConfig.StatusBatchInterval, 0),
+		size:     size,
+	}
+}
+
+// statusBatch collects the state items of the containers until they are flushed, a newer item of a container replaces
+// its pending one, so a flapping container is sent once per flush with its latest state, in the order of its last update
+type statusBatch struct {
+	pending map[string]int
+	items   []*common.ContainerStateItem
+	count   int
+}
+
+func newStatusBatch() *statusBatch {
+	return &statusBatch{pending: map[string]int{}}
+}
+
+func statusBatchKey(item *common.ContainerStateItem) string {
+	return item.GetId().GetPrefix() + "/" + item.GetId().GetName()
+}
+
+func (b *statusBatch) add(items []*common.ContainerStateItem) {
+	for _, item := range items {
+		key := statusBatchKey(item)
+		if index, ok := b.pending[key]; ok {
+			b.items[index] = nil
+			b.count--
+		}
+
+		b.pending[key] = len(b.items)
+		b.items = append(b.items, item)
+		b.count++
+	}
+}
+
+func (b *statusBatch) len() int {
+	return b.count
+}
+
+// flush returns the pending items in chunks of at most size items and empties the batch
+func (b *statusBatch) flush(size int) [][]*common.ContainerStateItem {
+	chunks := [][]*common.ContainerStateItem{}
+	chunk := []*common.ContainerStateItem{}
+	for _, item := range b.items {
+		if item == nil {
+			continue
+		}
+
+		chunk = append(chunk, item)
+		if len(chunk) == size {
+			chunks = append(chunks, chunk)
+			chunk = []*common.ContainerStateItem{}
+		}
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	b.pending = map[string]int{}
+	b.items = nil
+	b.count = 0
+
+	return chunks
+}
+
+// sendStatusBatch sends the pending items of the batch, the result is false if the stream failed
+func sendStatusBatch(stream agent.Agent_ContainerStateClient, req *agent.ContainerStateRequest,
+	batch *statusBatch, batching statusBatching,
+) bool {
+	for _, chunk := range batch.flush(batching.size) {
+		err := stream.Send(&common.ContainerStateListMessage{
+			Prefix: req.Prefix,
+			Data:   chunk,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Container status channel error")
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func StatusBatchingOf(appConfig *config.CommonConfiguration) (interval time.Duration, size int) {
+	batching := newStatusBatching(appConfig)
+	return batching.interval, batching.size
+}
+
+// CoalesceStatus adds the updates to a batch, then flushes it in chunks of size
+func CoalesceStatus(size int, updates ...[]*common.ContainerStateItem) [][]*common.ContainerStateItem {
+	batch := newStatusBatch()
+	for _, it := range updates {
+		batch.add(it)
+	}
+
+	return batch.flush(size)
+}
+
+func StreamContainerStatus(ctx context.Context, stream agent.Agent_ContainerStateClient, req *agent.ContainerStateRequest,
+	events *ContainerStatusStream, interval time.Duration, size int,
+) {
+	streamContainerStatus(ctx, req.GetPrefix(), stream, req, events, statusBatching{interval: interval, size: size})
+}
@@ -0,0 +1,153 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func stateItem(name string, state common.ContainerState) *common.ContainerStateItem {
+	return &common.ContainerStateItem{
+		Id:    &common.ContainerIdentifier{Prefix: "prefix", Name: name},
+		State: state,
+	}
+}
+
+// names are the names of the items with their states
+func names(items []*common.ContainerStateItem) []string {
+	result := []string{}
+	for _, it := range items {
+		result = append(result, it.Id.Name+":"+it.State.String())
+	}
+
+	return result
+}
+
+type containerStateClient struct {
+	agent.Agent_ContainerStateClient
+	messages []*common.ContainerStateListMessage
+	mutex    sync.Mutex
+	closed   bool
+}
+
+func (c *containerStateClient) Send(message *common.ContainerStateListMessage) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.messages = append(c.messages, message)
+	return nil
+}
+
+func (c *containerStateClient) CloseSend() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.closed = true
+	return nil
+}
+
+func (c *containerStateClient) sent() []*common.ContainerStateListMessage {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]*common.ContainerStateListMessage{}, c.messages...)
+}
+
+func TestStatusBatchingDefaults(t *testing.T) {
+	interval, size := grpc.StatusBatchingOf(&config.CommonConfiguration{})
+	assert.Equal(t, time.Duration(0), interval)
+	assert.Equal(t, 100, size)
+
+	interval, size = grpc.StatusBatchingOf(&config.CommonConfiguration{StatusBatchInterval: time.Second, StatusBatchSize: 10})
+	assert.Equal(t, time.Second, interval)
+	assert.Equal(t, 10, size)
+}
+
+func TestCoalesceStatus(t *testing.T) {
+	chunks := grpc.CoalesceStatus(100,
+		[]*common.ContainerStateItem{stateItem("a", common.ContainerState_RUNNING), stateItem("b", common.ContainerState_RUNNING)},
+		[]*common.ContainerStateItem{stateItem("a", common.ContainerState_EXITED)},
+		[]*common.ContainerStateItem{stateItem("c", common.ContainerState_RUNNING), stateItem("a", common.ContainerState_RUNNING)},
+	)
+
+	assert.Len(t, chunks, 1)
+	// a flapping container is sent once, in the order of its last update
+	assert.Equal(t, []string{"b:RUNNING", "c:RUNNING", "a:RUNNING"}, names(chunks[0]))
+}
+
+func TestCoalesceStatusChunks(t *testing.T) {
+	items := []*common.ContainerStateItem{}
+	for _, it := range []string{"a", "b", "c", "d", "e"} {
+		items = append(items, stateItem(it, common.ContainerState_RUNNING))
+	}
+
+	chunks := grpc.CoalesceStatus(2, items)
+	assert.Len(t, chunks, 3)
+	assert.Equal(t, []string{"e:RUNNING"}, names(chunks[2]))
+
+	assert.Empty(t, grpc.CoalesceStatus(2))
+}
+
+func TestStreamContainerStatusBatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &containerStateClient{}
+	events := &grpc.ContainerStatusStream{Events: make(chan []*common.ContainerStateItem), Error: make(chan error)}
+	go grpc.StreamContainerStatus(ctx, stream, &agent.ContainerStateRequest{}, events, 100*time.Millisecond, 3)
+
+	for i := 0; i < 10; i++ {
+		state := common.ContainerState_RUNNING
+		if i%2 == 0 {
+			state = common.ContainerState_EXITED
+		}
+		events.Events <- []*common.ContainerStateItem{stateItem("flapping", state)}
+	}
+	assert.Empty(t, stream.sent(), "the updates are sent by the interval")
+
+	assert.Eventually(t, func() bool { return len(stream.sent()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"flapping:RUNNING"}, names(stream.sent()[0].Data))
+
+	// a full batch is sent without waiting for the interval
+	events.Events <- []*common.ContainerStateItem{
+		stateItem("a", common.ContainerState_RUNNING),
+		stateItem("b", common.ContainerState_RUNNING),
+		stateItem("c", common.ContainerState_RUNNING),
+		stateItem("d", common.ContainerState_RUNNING),
+	}
+	if assert.Eventually(t, func() bool { return len(stream.sent()) == 3 }, 50*time.Millisecond, time.Millisecond) {
+		sent := stream.sent()
+		assert.Equal(t, []string{"a:RUNNING", "b:RUNNING", "c:RUNNING"}, names(sent[1].Data))
+		assert.Equal(t, []string{"d:RUNNING"}, names(sent[2].Data))
+	}
+}
+
+func TestStreamContainerStatusOneShot(t *testing.T) {
+	stream := &containerStateClient{}
+	events := &grpc.ContainerStatusStream{Events: make(chan []*common.ContainerStateItem, 1), Error: make(chan error)}
+
+	items := []*common.ContainerStateItem{}
+	for _, it := range []string{"a", "b", "c", "d"} {
+		items = append(items, stateItem(it, common.ContainerState_RUNNING))
+	}
+	events.Events <- items
+
+	oneShot := true
+	grpc.StreamContainerStatus(context.Background(), stream, &agent.ContainerStateRequest{OneShot: &oneShot}, events, time.Hour, 2)
+
+	// the list is sent as it is, so it is complete
+	assert.Len(t, stream.sent(), 1)
+	assert.Len(t, stream.sent()[0].Data, 4)
+	assert.True(t, stream.closed)
+}
@@ -0,0 +1,139 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	readinessTimeout  = 5 * time.Second
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// RuntimeCheck tells whether the container runtime of the agent, the docker daemon or the k8s API, is reachable
+type RuntimeCheck func(ctx context.Context) error
+
+// MetricsWriter writes the metrics of the agent in the Prometheus text format
+type MetricsWriter func(ctx context.Context, w io.Writer) error
+
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Probe is the response of the HTTP endpoints, Runtime is the error of the runtime check if it failed
+type Probe struct {
+	Status    string `json:"status"`
+	Runtime   string `json:"runtime,omitempty"`
+	Connected bool   `json:"connected"`
+}
+
+func writeProbe(w http.ResponseWriter, probe *Probe, ready bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if ready {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if err := json.NewEncoder(w).Encode(probe); err != nil {
+		log.Warn().Err(err).Msg("Failed to write health probe")
+	}
+}
+
+// NewHTTPHandler serves /healthz, which answers while the process is up, and /readyz,
+// which fails unless the agent is connected to Crux and its runtime is reachable,
+// and /metrics for Prometheus if the agent has metrics, only with the bearer token, as they tell about the host
+func NewHTTPHandler(runtimeCheck RuntimeCheck, metrics MetricsWriter, metricsToken string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		writeProbe(w, &Probe{Status: "ok", Connected: connected.Load()}, true)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		probe := &Probe{Status: "ready", Connected: connected.Load()}
+
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+
+		if runtimeCheck != nil {
+			if err := runtimeCheck(ctx); err != nil {
+				probe.Runtime = err.Error()
+			}
+		}
+
+		ready := probe.Connected && probe.Runtime == ""
+		if !ready {
+			probe.Status = "not ready"
+		}
+		writeProbe(w, probe, ready)
+	})
+	if metrics != nil && metricsToken != "" {
+		mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(metricsToken)) != 1 {
+				http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			buffer := &bytes.Buffer{}
+			if err := metrics(r.Context(), buffer); err != nil {
+				log.Warn().Err(err).Msg("Failed to collect the metrics")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set("Content-Type", metricsContentType)
+			if _, err := buffer.WriteTo(w); err != nil {
+				log.Warn().Err(err).Msg("Failed to write the metrics")
+			}
+		})
+	}
+
+	return mux
+}
+
+// ServeHTTP listens on the address until the context is done, the listening errors are returned right away
+func ServeHTTP(ctx context.Context, address string, runtimeCheck RuntimeCheck, metrics MetricsWriter, metricsToken string) error {
+	if metrics != nil && metricsToken == "" {
+		log.Warn().Msg("The metrics are not served on the health address without METRICS_TOKEN")
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen for health probes: %w", err)
+	}
+
+	server := &http.Server{
+		Handler:           NewHTTPHandler(runtimeCheck, metrics, metricsToken),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Health probe server shutdown error")
+		}
+	}()
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("Health probe server error")
+		}
+	}()
+
+	log.Info().Str("address", listener.Addr().String()).Msg("Serving health probes")
+	return nil
+}
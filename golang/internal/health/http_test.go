@@ -0,0 +1,80 @@
+//go:build unit
+// +build unit
+
+package health_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/health"
+)
+
+func probe(handler http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+	return rec
+}
+
+func probeWithToken(handler http.Handler, path, token string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+token)
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHealthzWhileDisconnected(t *testing.T) {
+	health.SetHealthGRPCStatus(false)
+	handler := health.NewHTTPHandler(nil, nil, "")
+
+	assert.Equal(t, http.StatusOK, probe(handler, "/healthz").Code)
+	assert.Equal(t, http.StatusServiceUnavailable, probe(handler, "/readyz").Code)
+}
+
+func TestReadyzChecksTheRuntime(t *testing.T) {
+	health.SetHealthGRPCStatus(true)
+	defer health.SetHealthGRPCStatus(false)
+
+	var runtimeErr error
+	handler := health.NewHTTPHandler(func(context.Context) error { return runtimeErr }, nil, "")
+
+	assert.Equal(t, http.StatusOK, probe(handler, "/readyz").Code)
+
+	runtimeErr = errors.New("docker is not running")
+	rec := probe(handler, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"status":"not ready","runtime":"docker is not running","connected":true}`, rec.Body.String())
+}
+
+func TestMetrics(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, probe(health.NewHTTPHandler(nil, nil, ""), "/metrics").Code)
+
+	var metricsErr error
+	metrics := func(_ context.Context, w io.Writer) error {
+		if metricsErr != nil {
+			return metricsErr
+		}
+		_, err := fmt.Fprintln(w, "node_load1 0.42")
+		return err
+	}
+	assert.Equal(t, http.StatusNotFound, probe(health.NewHTTPHandler(nil, metrics, ""), "/metrics").Code)
+
+	handler := health.NewHTTPHandler(nil, metrics, "secret")
+	assert.Equal(t, http.StatusUnauthorized, probe(handler, "/metrics").Code)
+
+	rec := probeWithToken(handler, "/metrics", "secret")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "node_load1 0.42\n", rec.Body.String())
+
+	metricsErr = errors.New("proc is not mounted")
+	assert.Equal(t, http.StatusInternalServerError, probeWithToken(handler, "/metrics", "secret").Code)
+}
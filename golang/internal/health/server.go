@@ -6,13 +6,13 @@
 	"errors"
 	"net"
 	"os"
+	"sync/atomic"
 
 	"github.com/rs/zerolog/log"
 )
 
-var health = Status{
-	Connected: false,
-}
+// connected is written by the gRPC loop and read by the health socket and the HTTP probes
+var connected atomic.Bool
 
 func sendHealthData(conn net.Conn, healthData *Status) error {
 	data, err := json.Marshal(healthData)
@@ -32,7 +32,7 @@ func acceptLoop(socket net.Listener) {
 			break
 		}
 
-		err = sendHealthData(conn, &health)
+		err = sendHealthData(conn, &Status{Connected: connected.Load()})
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to write health socket")
 		}
@@ -44,8 +44,8 @@ func acceptLoop(socket net.Listener) {
 	}
 }
 
-func SetHealthGRPCStatus(connected bool) {
-	health.Connected = connected
+func SetHealthGRPCStatus(isConnected bool) {
+	connected.Store(isConnected)
 }
 
 func Serve(ctx context.Context) error {
@@ -0,0 +1,114 @@
+// Package bandwidth limits the transfers of the agent, so deployments do not saturate the uplink of the node
+// and starve the traffic of the containers running on it.
+package bandwidth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/docker/go-units"
+	"golang.org/x/time/rate"
+)
+
+// maxBurst caps the size of a single read, so a transfer waits for the limiter in small steps
+const maxBurst = 1 << 20
+
+// ParseLimit parses a limit in bytes per second with an optional binary unit, eg. 512k or 10MiB,
+// the result is nil if the limit is empty or zero, which means unlimited
+func ParseLimit(limit string) (*rate.Limiter, error) {
+	if limit == "" {
+		return nil, nil
+	}
+
+	bytesPerSecond, err := units.RAMInBytes(limit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bandwidth limit %q: %w", limit, err)
+	}
+
+	return NewLimiter(bytesPerSecond), nil
+}
+
+// NewLimiter returns a limiter shared by the transfers, the result is nil if the limit is not positive
+func NewLimiter(bytesPerSecond int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), int(min(bytesPerSecond, maxBurst)))
+}
+
+type reader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
+
+// Reader limits the reads of r, it returns r if the limiter is nil
+func Reader(ctx context.Context, r io.Reader, limiter *rate.Limiter) io.Reader {
+	if limiter == nil {
+		return r
+	}
+
+	return &reader{ctx: ctx, reader: r, limiter: limiter}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// ReadCloser limits the reads of rc, it returns rc if the limiter is nil
+func ReadCloser(ctx context.Context, rc io.ReadCloser, limiter *rate.Limiter) io.ReadCloser {
+	if limiter == nil {
+		return rc
+	}
+
+	return readCloser{Reader: Reader(ctx, rc, limiter), Closer: rc}
+}
+
+type transport struct {
+	base    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody {
+		// a round tripper must not modify the request of the caller
+		req = req.Clone(req.Context())
+		req.Body = ReadCloser(req.Context(), req.Body, t.limiter)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ReadCloser(req.Context(), resp.Body, t.limiter)
+	return resp, nil
+}
+
+// Transport limits both the uploads and the downloads of base, it returns base if the limiter is nil
+func Transport(base http.RoundTripper, limiter *rate.Limiter) http.RoundTripper {
+	if limiter == nil {
+		return base
+	}
+
+	return &transport{base: base, limiter: limiter}
+}
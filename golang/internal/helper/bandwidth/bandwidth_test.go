@@ -0,0 +1,83 @@
+//go:build unit
+// +build unit
+
+package bandwidth_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/bandwidth"
+)
+
+const limit = 100 * 1024
+
+func TestParseLimit(t *testing.T) {
+	limiter, err := bandwidth.ParseLimit("")
+	assert.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	limiter, err = bandwidth.ParseLimit("0")
+	assert.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	limiter, err = bandwidth.ParseLimit("10MiB")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(10<<20), float64(limiter.Limit()))
+	assert.Equal(t, 1<<20, limiter.Burst())
+
+	_, err = bandwidth.ParseLimit("fast")
+	assert.Error(t, err)
+}
+
+func TestReader(t *testing.T) {
+	data := bytes.Repeat([]byte{1}, 2*limit)
+
+	start := time.Now()
+	read, err := io.ReadAll(bandwidth.Reader(context.Background(), bytes.NewReader(data), bandwidth.NewLimiter(limit)))
+	assert.NoError(t, err)
+	assert.Equal(t, data, read)
+	// the first second is the burst of the limiter
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestReaderCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := io.ReadAll(bandwidth.Reader(ctx, bytes.NewReader(make([]byte, limit)), bandwidth.NewLimiter(limit)))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnlimited(t *testing.T) {
+	reader := bytes.NewReader(nil)
+	assert.Equal(t, io.Reader(reader), bandwidth.Reader(context.Background(), reader, nil))
+	assert.Equal(t, http.DefaultTransport, bandwidth.Transport(http.DefaultTransport, nil))
+}
+
+func TestTransport(t *testing.T) {
+	data := bytes.Repeat([]byte{1}, 2*limit)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: bandwidth.Transport(http.DefaultTransport, bandwidth.NewLimiter(limit))}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	read, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, data, read)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
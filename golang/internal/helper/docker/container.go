@@ -14,6 +14,7 @@
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	"github.com/dyrector-io/dyrectorio/golang/internal/helper"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 )
 
@@ -29,26 +30,30 @@ func DeleteContainerByName(ctx context.Context, cli client.APIClient, nameFilter
 		return nil
 	}
 
-	return deleteContainerByIDAndState(ctx, nil, matchedContainer.ID, matchedContainer.State)
+	return deleteContainerByIDAndState(ctx, cli, nil, matchedContainer.ID, matchedContainer.State)
 }
 
 func DeleteContainer(ctx context.Context, cont *types.Container) error {
-	return deleteContainerByIDAndState(ctx, nil, cont.ID, cont.State)
+	return deleteContainerByIDAndState(ctx, newEnvClient(), nil, cont.ID, cont.State)
 }
 
-func deleteContainerByIDAndState(ctx context.Context, dog *dogger.DeploymentLogger, id, state string) error {
+func newEnvClient() client.APIClient {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		log.Fatal().Err(err).Send()
 	}
 
+	return cli
+}
+
+func deleteContainerByIDAndState(ctx context.Context, cli client.APIClient, dog *dogger.DeploymentLogger, id, state string) error {
 	switch state {
 	case "running", "paused", "restarting":
 		if dog != nil {
 			dog.WriteInfo("Stopping container: " + helper.FirstN(id, VisibleIDLimit))
 		}
 
-		if err = cli.ContainerStop(ctx, id, container.StopOptions{}); err != nil {
+		if err := cli.ContainerStop(ctx, id, container.StopOptions{}); err != nil {
 			return fmt.Errorf("could not stop container (%s): %s", helper.FirstN(id, VisibleIDLimit), err.Error())
 		}
 
@@ -58,7 +63,7 @@ func deleteContainerByIDAndState(ctx context.Context, dog *dogger.DeploymentLogg
 			dog.WriteContainerState(common.ContainerState_WAITING, state, dogger.Info, "Removing container: "+helper.FirstN(id, VisibleIDLimit))
 		}
 
-		if err = cli.ContainerRemove(ctx, id, container.RemoveOptions{}); err != nil {
+		if err := cli.ContainerRemove(ctx, id, container.RemoveOptions{}); err != nil {
 			return fmt.Errorf("could not remove container (%s): %s", helper.FirstN(id, VisibleIDLimit), err.Error())
 		}
 
@@ -78,7 +83,7 @@ func DeleteContainersByLabel(ctx context.Context, label string) error {
 	baseErr := fmt.Errorf("failed to delete containers")
 	err = baseErr
 	for i := range containers {
-		containerDeleteErr := deleteContainerByIDAndState(ctx, nil, containers[i].ID, containers[i].State)
+		containerDeleteErr := deleteContainerByIDAndState(ctx, newEnvClient(), nil, containers[i].ID, containers[i].State)
 
 		if containerDeleteErr != nil {
 			log.Error().Err(containerDeleteErr).Stack().Send()
@@ -141,7 +146,7 @@ func DeleteContainerByID(ctx context.Context, dog *dogger.DeploymentLogger, id s
 		return nil
 	}
 
-	return deleteContainerByIDAndState(ctx, dog, id, cont.State)
+	return deleteContainerByIDAndState(ctx, newEnvClient(), dog, id, cont.State)
 }
 
 func GetAllContainersByLabel(ctx context.Context, label string) ([]types.Container, error) {
@@ -158,6 +163,21 @@ func GetAllContainersByLabel(ctx context.Context, label string) ([]types.Contain
 	return containers, nil
 }
 
+// GetContainersByDeploymentID lists the containers created by a deployment, based on the standard metadata labels
+func GetContainersByDeploymentID(ctx context.Context, cli client.APIClient, deploymentID string) ([]types.Container, error) {
+	return GetContainersByMetadata(ctx, cli, label.DeploymentID, deploymentID)
+}
+
+// GetContainersByMetadata lists the containers having the given standard metadata label, eg. label.VersionName
+func GetContainersByMetadata(ctx context.Context, cli client.APIClient, key, value string) ([]types.Container, error) {
+	containers, err := cli.ContainerList(ctx, containerListOptionsfilter("label", label.GetMetadataLabelFilter(key, value)))
+	if err != nil {
+		return []types.Container{}, err
+	}
+
+	return containers, nil
+}
+
 // Using exact match!
 func GetContainerByName(ctx context.Context, cli client.APIClient, nameFilter string) (*types.Container, error) {
 	containers, err := GetAllContainersByName(ctx, cli, fmt.Sprintf("^%s$", nameFilter))
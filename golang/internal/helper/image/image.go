@@ -26,6 +26,7 @@
 	"github.com/google/go-containerregistry/pkg/crane"
 	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
 )
 
 // PullResponse is not explicit
@@ -245,7 +246,12 @@ func shouldUseLocalImage(ctx context.Context, cli client.APIClient,
 	return false, nil
 }
 
-func pullImage(ctx context.Context, cli client.APIClient, imageName, encodedAuth string) (io.ReadCloser, error) {
+// pullImage pulls with the daemon, or with the agent if the bandwidth is limited
+func pullImage(ctx context.Context, cli client.APIClient, imageName, encodedAuth string, limiter *rate.Limiter) (io.ReadCloser, error) {
+	if limiter != nil {
+		return pullImageLimited(ctx, cli, imageName, encodedAuth, limiter)
+	}
+
 	options := image.PullOptions{
 		RegistryAuth: encodedAuth,
 	}
@@ -261,9 +267,10 @@ func pullImage(ctx context.Context, cli client.APIClient, imageName, encodedAuth
 	return responseBody, nil
 }
 
-// CustomImagePull is a client side `smart` Pull, that only pulls if the digests are not matching
+// CustomImagePull is a client side `smart` Pull, that only pulls if the digests are not matching,
+// the limiter is optional, a nil limiter means unlimited bandwidth
 func CustomImagePull(ctx context.Context, cli client.APIClient,
-	imageName, encodedAuth string, imagePriority PullPriority, displayFn PullDisplayFn,
+	imageName, encodedAuth string, imagePriority PullPriority, displayFn PullDisplayFn, limiter *rate.Limiter,
 ) error {
 	distributionRef, err := parseDistributionRef(imageName)
 	if err != nil {
@@ -282,7 +289,7 @@ func CustomImagePull(ctx context.Context, cli client.APIClient,
 		}
 	}
 
-	responseBody, err := pullImage(ctx, cli, imageName, encodedAuth)
+	responseBody, err := pullImage(ctx, cli, imageName, encodedAuth, limiter)
 	if err != nil {
 		return err
 	}
@@ -70,7 +70,7 @@ func TestNewPull(t *testing.T) {
 		t.Fatal(err)
 	}
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
-	err = image.CustomImagePull(ctx, client, nginxImage, "", image.LocalOnly, cli.DockerPullProgressDisplayer)
+	err = image.CustomImagePull(ctx, client, nginxImage, "", image.LocalOnly, cli.DockerPullProgressDisplayer, nil)
 	assert.Nilf(t, err, "expected err to be nil for a valid image name")
 }
 
@@ -90,7 +90,7 @@ func TestPullFullQualifiedImage(t *testing.T) {
 		return nil
 	})
 
-	err = image.CustomImagePull(ctx, cli, nginxImage, "", image.ForcePull, cb)
+	err = image.CustomImagePull(ctx, cli, nginxImage, "", image.ForcePull, cb, nil)
 	assert.Nilf(t, err, "expected err to be nil for a valid image name")
 	assert.Truef(t, called, "display func is called")
 
@@ -108,7 +108,7 @@ func TestPrettyPullFullQualifiedInvalidImage(t *testing.T) {
 	img := fmt.Sprintf("%s:nonexistenttag", nginxImageNoTag)
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
 
-	err = image.CustomImagePull(ctx, client, img, "", image.LocalOnly, cli.DockerPullProgressDisplayer)
+	err = image.CustomImagePull(ctx, client, img, "", image.LocalOnly, cli.DockerPullProgressDisplayer, nil)
 	assert.ErrorIs(t, err, image.ErrImageNotFound, "expected err to be notfound for a invalid image name")
 }
 
@@ -0,0 +1,99 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/docker/docker/client"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/bandwidth"
+)
+
+// loadResponse is the progress of the image load, it ends with the error of the tarball transfer if there is one
+type loadResponse struct {
+	io.ReadCloser
+	written chan error
+}
+
+func (r *loadResponse) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		if writeErr, ok := <-r.written; ok && writeErr != nil {
+			return n, writeErr
+		}
+	}
+
+	return n, err
+}
+
+// pullImageLimited downloads the image on the agent within the limit and loads it into the daemon as a tarball,
+// the pulls of the daemon can not be limited by its clients
+func pullImageLimited(ctx context.Context, cli client.APIClient,
+	imageName, encodedAuth string, limiter *rate.Limiter,
+) (io.ReadCloser, error) {
+	ref, err := name.ParseReference(imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, ok := ref.(name.Tag)
+	if !ok {
+		// a loaded image can not be referenced by its digest, only by its tag
+		log.Warn().Str("image", imageName).Msg("Bandwidth limit is not applied to images referenced by digest")
+		return pullImage(ctx, cli, imageName, encodedAuth, nil)
+	}
+
+	server, err := cli.ServerVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithTransport(bandwidth.Transport(remote.DefaultTransport, limiter)),
+		remote.WithPlatform(v1.Platform{OS: server.Os, Architecture: server.Arch}),
+	}
+	if encodedAuth != "" {
+		basicAuth, convertErr := authConfigToBasicAuth(encodedAuth)
+		if convertErr != nil {
+			return nil, convertErr
+		}
+		opts = append(opts, remote.WithAuth(authn.FromConfig(authn.AuthConfig{Auth: basicAuth})))
+	}
+
+	img, err := remote.Image(tag, opts...)
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return nil, ErrImageNotFound
+		}
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	written := make(chan error, 1)
+	go func() {
+		writeErr := tarball.Write(tag, img, writer)
+		written <- writeErr
+		close(written)
+		writer.CloseWithError(writeErr)
+	}()
+
+	resp, err := cli.ImageLoad(ctx, reader, false)
+	if err != nil {
+		reader.CloseWithError(err)
+		return nil, err
+	}
+
+	return &loadResponse{ReadCloser: resp.Body, written: written}, nil
+}
@@ -0,0 +1,87 @@
+//go:build unit
+// +build unit
+
+package image_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/bandwidth"
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+func pushRandomImage(t *testing.T, layerSize int64) (tag, digest string) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	img, err := random.Image(layerSize, 2)
+	assert.NoError(t, err)
+
+	tag = fmt.Sprintf("%s/test/app:1.0", strings.TrimPrefix(server.URL, "http://"))
+	ref, err := name.ParseReference(tag)
+	assert.NoError(t, err)
+	assert.NoError(t, remote.Write(ref, img))
+
+	hash, err := img.Digest()
+	assert.NoError(t, err)
+
+	return tag, strings.Replace(tag, ":1.0", "@"+hash.String(), 1)
+}
+
+func TestLimitedPull(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+	tag, _ := pushRandomImage(t, 1024)
+
+	err := imageHelper.CustomImagePull(ctx, cli, tag, "", imageHelper.ForcePull, nil, bandwidth.NewLimiter(1<<20))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cli.CallCount("ImageLoad"))
+	assert.Equal(t, 0, cli.CallCount("ImagePull"))
+
+	_, err = imageHelper.GetImageByReference(ctx, cli, tag)
+	assert.NoError(t, err)
+}
+
+func TestLimitedPullRate(t *testing.T) {
+	cli := dockerfake.New()
+	tag, _ := pushRandomImage(t, 8<<10)
+
+	// the layers of 16KiB are downloaded by the agent, after the burst of the limiter it waits for the rest
+	started := time.Now()
+	err := imageHelper.CustomImagePull(context.Background(), cli, tag, "", imageHelper.ForcePull, nil, bandwidth.NewLimiter(8<<10))
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(started), 900*time.Millisecond)
+}
+
+func TestLimitedPullByDigest(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+	_, digest := pushRandomImage(t, 1024)
+
+	err := imageHelper.CustomImagePull(ctx, cli, digest, "", imageHelper.ForcePull, nil, bandwidth.NewLimiter(1<<20))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cli.CallCount("ImageLoad"))
+	assert.Equal(t, 1, cli.CallCount("ImagePull"))
+}
+
+func TestLimitedPullNotFound(t *testing.T) {
+	tag, _ := pushRandomImage(t, 1024)
+
+	err := imageHelper.CustomImagePull(context.Background(), dockerfake.New(), strings.Replace(tag, "app", "missing", 1), "",
+		imageHelper.ForcePull, nil, bandwidth.NewLimiter(1<<20))
+	assert.ErrorIs(t, err, imageHelper.ErrImageNotFound)
+}
@@ -0,0 +1,153 @@
+// Package mirror rewrites image references, so air-gapped sites can redirect whole registries or repositories,
+// eg. ghcr.io/dyrector-io/*, to an internal mirror without editing every reference.
+package mirror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/distribution/reference"
+)
+
+var ErrInvalidRule = errors.New("invalid image rewrite rule")
+
+const (
+	dockerDomain       = "docker.io"
+	legacyDockerDomain = "index.docker.io"
+)
+
+// Rule replaces the Match prefix of the fully qualified image names, eg. docker.io/library/postgres,
+// the prefix only matches whole path segments, a trailing / or /* is the same as the prefix itself,
+// familiar names like postgres are matched as docker.io/library/postgres
+type Rule struct {
+	Match   string `yaml:"match"`
+	Replace string `yaml:"replace"`
+}
+
+// Rules are applied by the longest matching prefix
+type Rules []Rule
+
+func trimPrefix(prefix string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(prefix), "/*"), "/")
+}
+
+// normalizeMatch qualifies the match like the references are, a registry on its own is kept as it is
+func normalizeMatch(match string) string {
+	match = trimPrefix(match)
+	if !strings.Contains(match, "/") && (strings.ContainsAny(match, ".:") || match == "localhost") {
+		if match == legacyDockerDomain {
+			return dockerDomain
+		}
+		return match
+	}
+
+	named, err := reference.ParseNormalizedNamed(match)
+	if err != nil {
+		return match
+	}
+
+	return named.Name()
+}
+
+func (r *Rule) validate() error {
+	r.Match = normalizeMatch(r.Match)
+	r.Replace = trimPrefix(r.Replace)
+
+	if r.Match == "" || r.Replace == "" {
+		return fmt.Errorf("%w: both the match and the replacement are required", ErrInvalidRule)
+	}
+
+	if strings.Contains(r.Match, "/") {
+		if _, err := reference.ParseNamed(r.Match); err != nil {
+			return fmt.Errorf("%w: %s is not a repository: %w", ErrInvalidRule, r.Match, err)
+		}
+	}
+
+	if _, err := reference.ParseNamed(r.Replace); err != nil {
+		return fmt.Errorf("%w: %s is not a repository: %w", ErrInvalidRule, r.Replace, err)
+	}
+
+	return nil
+}
+
+// ParseRules parses the rules separated by commas or whitespace, eg. ghcr.io/dyrector-io/*=mirror.local/dyrector-io
+func ParseRules(rules string) (Rules, error) {
+	parsed := Rules{}
+	for _, it := range strings.FieldsFunc(rules, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' }) {
+		match, replace, ok := strings.Cut(it, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q, expected <match>=<replace>", ErrInvalidRule, it)
+		}
+
+		rule := Rule{Match: match, Replace: replace}
+		if err := rule.validate(); err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, rule)
+	}
+
+	return parsed, nil
+}
+
+// Validate checks the rules, eg. the ones read from a settings file, and normalizes their wildcards
+func (r Rules) Validate() error {
+	for i := range r {
+		if err := r[i].validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetValue parses the rules from an environment variable, see cleanenv.Setter
+func (r *Rules) SetValue(rules string) error {
+	parsed, err := ParseRules(rules)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+func matches(name, prefix string) bool {
+	return name == prefix || strings.HasPrefix(name, prefix+"/")
+}
+
+// Rewrite returns the reference redirected by the longest matching rule, the tag and the digest are kept.
+// References without a matching rule and invalid ones are returned as they are.
+func (r Rules) Rewrite(ref string) string {
+	if len(r) == 0 {
+		return ref
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return ref
+	}
+
+	name := named.Name()
+	var rule *Rule
+	match := ""
+	for i := range r {
+		// rules not checked by Validate are normalized here, so they match the same
+		if it := normalizeMatch(r[i].Match); matches(name, it) && (rule == nil || len(it) > len(match)) {
+			rule, match = &r[i], it
+		}
+	}
+	if rule == nil {
+		return ref
+	}
+
+	rewritten := trimPrefix(rule.Replace) + strings.TrimPrefix(name, match)
+	if tagged, ok := named.(reference.Tagged); ok {
+		rewritten += ":" + tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		rewritten += "@" + digested.Digest().String()
+	}
+
+	return rewritten
+}
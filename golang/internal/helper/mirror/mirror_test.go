@@ -0,0 +1,120 @@
+//go:build unit
+// +build unit
+
+package mirror_test
+
+import (
+	"testing"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/mirror"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := mirror.ParseRules("ghcr.io/dyrector-io/*=mirror.local/dyo, docker.io=mirror.local/hub")
+	assert.NoError(t, err)
+	assert.Equal(t, mirror.Rules{
+		{Match: "ghcr.io/dyrector-io", Replace: "mirror.local/dyo"},
+		{Match: "docker.io", Replace: "mirror.local/hub"},
+	}, rules)
+
+	rules, err = mirror.ParseRules("")
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+
+	_, err = mirror.ParseRules("ghcr.io")
+	assert.ErrorIs(t, err, mirror.ErrInvalidRule)
+
+	_, err = mirror.ParseRules("ghcr.io=")
+	assert.ErrorIs(t, err, mirror.ErrInvalidRule)
+
+	_, err = mirror.ParseRules("ghcr.io=Mirror.local/UPPER")
+	assert.ErrorIs(t, err, mirror.ErrInvalidRule)
+}
+
+func TestRewrite(t *testing.T) {
+	rules, err := mirror.ParseRules("ghcr.io/dyrector-io=mirror.local/dyo,ghcr.io/dyrector-io/dyrectorio/web=mirror.local/web,docker.io=mirror.local/hub")
+	assert.NoError(t, err)
+
+	cases := map[string]string{
+		"ghcr.io/dyrector-io/dyrectorio/agent/dagent:stable":   "mirror.local/dyo/dyrectorio/agent/dagent:stable",
+		"ghcr.io/dyrector-io/dyrectorio/web/crux:1.0.0":        "mirror.local/web/crux:1.0.0",
+		"postgres:14.2-alpine":                                 "mirror.local/hub/library/postgres:14.2-alpine",
+		"index.docker.io/library/traefik:v2.8.0":               "mirror.local/hub/library/traefik:v2.8.0",
+		"ghcr.io/dyrector-io-fork/agent:latest":                "ghcr.io/dyrector-io-fork/agent:latest",
+		"quay.io/prometheus/prometheus":                        "quay.io/prometheus/prometheus",
+		"not a reference":                                      "not a reference",
+		"ghcr.io/dyrector-io/agent@sha256:" + sha256Hex('a'):   "mirror.local/dyo/agent@sha256:" + sha256Hex('a'),
+		"ghcr.io/dyrector-io/agent:1@sha256:" + sha256Hex('b'): "mirror.local/dyo/agent:1@sha256:" + sha256Hex('b'),
+	}
+	for ref, expected := range cases {
+		assert.Equal(t, expected, rules.Rewrite(ref), ref)
+	}
+
+	assert.Equal(t, "postgres:14", mirror.Rules(nil).Rewrite("postgres:14"))
+}
+
+func TestValidateNormalizesWildcards(t *testing.T) {
+	rules := mirror.Rules{{Match: "ghcr.io/dyrector-io/*", Replace: "mirror.local/dyo"}}
+	assert.NoError(t, rules.Validate())
+	assert.Equal(t, "mirror.local/dyo/crux:1", rules.Rewrite("ghcr.io/dyrector-io/crux:1"))
+
+	assert.ErrorIs(t, mirror.Rules{{Match: "docker.io"}}.Validate(), mirror.ErrInvalidRule)
+}
+
+func TestRewriteNormalizesMatch(t *testing.T) {
+	rules, err := mirror.ParseRules("docker.io/library/nginx=mirror.local/nginx,redis=mirror.local/redis,ghcr.io/foo/=mirror.local/foo/")
+	assert.NoError(t, err)
+	assert.Equal(t, mirror.Rules{
+		{Match: "docker.io/library/nginx", Replace: "mirror.local/nginx"},
+		{Match: "docker.io/library/redis", Replace: "mirror.local/redis"},
+		{Match: "ghcr.io/foo", Replace: "mirror.local/foo"},
+	}, rules)
+
+	cases := map[string]string{
+		"nginx:latest":                    "mirror.local/nginx:latest",
+		"docker.io/library/nginx:1.25":    "mirror.local/nginx:1.25",
+		"redis:7":                         "mirror.local/redis:7",
+		"index.docker.io/library/redis:7": "mirror.local/redis:7",
+		"ghcr.io/foo/bar:1":               "mirror.local/foo/bar:1",
+		"ghcr.io/foobar/bar:1":            "ghcr.io/foobar/bar:1",
+		"docker.io/nginxinc/nginx:1":      "docker.io/nginxinc/nginx:1",
+	}
+	for ref, expected := range cases {
+		assert.Equal(t, expected, rules.Rewrite(ref), ref)
+	}
+
+	// rules which are not validated match the same
+	unvalidated := mirror.Rules{
+		{Match: "nginx", Replace: "mirror.local/nginx"},
+		{Match: "ghcr.io/foo/", Replace: "mirror.local/foo"},
+		{Match: "index.docker.io", Replace: "mirror.local/hub"},
+	}
+	assert.Equal(t, "mirror.local/nginx:latest", unvalidated.Rewrite("nginx:latest"))
+	assert.Equal(t, "mirror.local/foo/bar:1", unvalidated.Rewrite("ghcr.io/foo/bar:1"))
+	assert.Equal(t, "mirror.local/hub/library/redis:7", unvalidated.Rewrite("redis:7"))
+
+	_, err = mirror.ParseRules("ghcr.io/Foo=mirror.local/foo")
+	assert.ErrorIs(t, err, mirror.ErrInvalidRule)
+}
+
+func TestRulesFromEnvironment(t *testing.T) {
+	t.Setenv("IMAGE_REWRITE_RULES", "docker.io=mirror.local/hub")
+
+	cfg := struct {
+		Rules mirror.Rules `env:"IMAGE_REWRITE_RULES"`
+	}{}
+	assert.NoError(t, cleanenv.ReadEnv(&cfg))
+	assert.Equal(t, "mirror.local/hub/library/nginx:latest", cfg.Rules.Rewrite("nginx:latest"))
+}
+
+func sha256Hex(c byte) string {
+	digest := make([]byte, 64)
+	for i := range digest {
+		digest[i] = c
+	}
+
+	return string(digest)
+}
@@ -0,0 +1,100 @@
+// Package net contains probes for waiting on TCP and HTTP endpoints and checking local ports.
+package net
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+)
+
+const backoffMultiplier = 2
+
+// Backoff configures the delay between probe attempts, the delay doubles after every failed attempt
+// until it reaches Max. The overall deadline is the one of the context passed to the probes.
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// DefaultBackoff is suitable for services starting up in a couple of seconds
+var DefaultBackoff = Backoff{Initial: time.Second, Max: 5 * time.Second}
+
+func (b Backoff) next(delay time.Duration) time.Duration {
+	return min(delay*backoffMultiplier, b.Max)
+}
+
+// retry calls probe until it succeeds or the context is done, the returned error wraps the last probe error
+func retry(ctx context.Context, backoff Backoff, probe func(context.Context) error) error {
+	delay := backoff.Initial
+
+	for {
+		err := probe(ctx)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-time.After(delay):
+		}
+
+		delay = backoff.next(delay)
+	}
+}
+
+// WaitForTCP waits until a TCP connection can be opened to the address (host:port)
+func WaitForTCP(ctx context.Context, address string, backoff Backoff) error {
+	dialer := net.Dialer{Timeout: backoff.Max}
+
+	return retry(ctx, backoff, func(ctx context.Context) error {
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+
+		logdefer.LogDeferredErr(conn.Close, log.Debug(), "failed to close the tcp probe connection")
+		return nil
+	})
+}
+
+// WaitForHTTP waits until a GET request to the address responds with a 2xx status code
+func WaitForHTTP(ctx context.Context, address string, backoff Backoff) error {
+	client := http.Client{Timeout: backoff.Max}
+
+	return retry(ctx, backoff, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, http.NoBody)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		logdefer.LogDeferredErr(resp.Body.Close, log.Debug(), "failed to close the response body of the http probe")
+
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		}
+
+		return nil
+	})
+}
+
+// IsPortFree checks whether the TCP port can be bound on all interfaces of the host
+func IsPortFree(port uint) bool {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+
+	logdefer.LogDeferredErr(ln.Close, log.Debug(), "failed to close the port probe listener")
+	return true
+}
@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+package net_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	netHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
+)
+
+var testBackoff = netHelper.Backoff{Initial: 10 * time.Millisecond, Max: 50 * time.Millisecond}
+
+func TestWaitForTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.NoError(t, netHelper.WaitForTCP(ctx, ln.Addr().String(), testBackoff))
+}
+
+func TestWaitForTCPTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := ln.Addr().String()
+	assert.NoError(t, ln.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err = netHelper.WaitForTCP(ctx, address, testBackoff)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitForHTTP(t *testing.T) {
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.NoError(t, netHelper.WaitForHTTP(ctx, server.URL, testBackoff))
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestWaitForHTTPTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := netHelper.WaitForHTTP(ctx, server.URL, testBackoff)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "unexpected status code: 500")
+}
+
+func TestIsPortFree(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	assert.NoError(t, err)
+
+	port := uint(ln.Addr().(*net.TCPAddr).Port)
+	assert.False(t, netHelper.IsPortFree(port), fmt.Sprintf("port %d is bound", port))
+
+	assert.NoError(t, ln.Close())
+	assert.True(t, netHelper.IsPortFree(port))
+}
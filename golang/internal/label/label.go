@@ -7,6 +7,20 @@
 	SecretKeys      = "secret.keys"
 	ContainerPrefix = "container.prefix"
 	ServiceCategory = "service-category"
+	LogFiles        = "log-files"
+	RestartBudget   = "restart-budget"
+	// RestartPolicy is the restart policy the container was deployed with, the budget disables it for the backoffs
+	RestartPolicy = "restart-policy"
+	// IngressHash is the hash of the configuration the managed traefik of the node runs with
+	IngressHash = "ingress.hash"
+)
+
+// standard metadata applied by the container builder, keys are prefixed with DyrectorioOrg
+const (
+	DeploymentID = "deployment.id"
+	VersionName  = "version.name"
+	TriggeredBy  = "triggered-by"
+	ConfigHash   = "config.hash"
 )
 
 func GetPrefixLabelFilter(prefix string) string {
@@ -16,3 +30,8 @@ func GetPrefixLabelFilter(prefix string) string {
 func GetHiddenServiceCategory(category string) string {
 	return "_" + category
 }
+
+// GetMetadataLabelFilter returns a label filter matching the standard metadata, eg. every container of a deployment
+func GetMetadataLabelFilter(key, value string) string {
+	return util.JoinV("=", DyrectorioOrg+key, value)
+}
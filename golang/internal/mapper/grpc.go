@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -21,7 +22,10 @@
 
 	dockerTypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stringid"
 	"github.com/iancoleman/strcase"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -52,6 +56,10 @@ func MapDeployImage(prefix string, req *agent.DeployWorkloadRequest, appConfig *
 		}
 	}
 
+	if req.Base != nil {
+		res.Base = mapBaseConfigRef(req.Base)
+	}
+
 	v1.SetDeploymentDefaults(res, appConfig)
 
 	if req.Registry != nil {
@@ -60,6 +68,21 @@ func MapDeployImage(prefix string, req *agent.DeployWorkloadRequest, appConfig *
 	return res
 }
 
+func mapBaseConfigRef(in *agent.BaseConfigRef) *v1.BaseConfigRef {
+	ref := &v1.BaseConfigRef{
+		ID:   in.Id,
+		Hash: in.Hash,
+	}
+	if in.OverlayJson != "" {
+		ref.Overlay = json.RawMessage(in.OverlayJson)
+	}
+	if in.ConfigJson != nil {
+		ref.Config = json.RawMessage(*in.ConfigJson)
+	}
+
+	return ref
+}
+
 func mapContainerConfig(prefix string, in *agent.DeployWorkloadRequest) v1.ContainerConfig {
 	cc := in.Common
 
@@ -140,6 +163,10 @@ func mapDagentConfig(dagent *agent.DagentContainerConfig, containerConfig *v1.Co
 		containerConfig.Networks = dagent.Networks
 	}
 
+	if len(dagent.NetworkConfigs) > 0 {
+		containerConfig.NetworkOptions = mapNetworkConfigs(dagent.NetworkConfigs)
+	}
+
 	if dagent.RestartPolicy != nil {
 		containerConfig.RestartPolicy = container.RestartPolicyMode(ProtoEnumToKebabCase(dagent.RestartPolicy.String()))
 	}
@@ -162,6 +189,89 @@ func mapDagentConfig(dagent *agent.DagentContainerConfig, containerConfig *v1.Co
 			ExitCode: dagent.ExpectedState.ExitCode,
 		}
 	}
+
+	if dagent.ResourceConfig != nil {
+		containerConfig.ResourceConfig = mapResourceConfig(dagent.ResourceConfig)
+	}
+
+	if dagent.Isolation != nil {
+		containerConfig.Isolation = ProtoEnumToKebabCase(strings.TrimPrefix(dagent.Isolation.String(), "ISOLATION_"))
+	}
+
+	if dagent.PidMode != nil {
+		containerConfig.PidMode = *dagent.PidMode
+	}
+
+	if dagent.IpcMode != nil {
+		containerConfig.IpcMode = *dagent.IpcMode
+	}
+
+	if dagent.RestartBudget != nil {
+		containerConfig.RestartBudget = mapRestartBudget(dagent.RestartBudget)
+	}
+
+	containerConfig.Hostname = dagent.GetHostname()
+	containerConfig.Domainname = dagent.GetDomainname()
+	containerConfig.LogFiles = dagent.LogFiles
+	containerConfig.Runtime = dagent.GetRuntime()
+	containerConfig.RuntimeOptions = dagent.RuntimeOptions
+}
+
+func mapRestartBudget(in *agent.RestartBudget) *v1.RestartBudget {
+	budget := &v1.RestartBudget{
+		MaxRestarts:       in.MaxRestarts,
+		WindowSeconds:     in.WindowSeconds,
+		BackoffSeconds:    in.GetBackoffSeconds(),
+		MaxBackoffSeconds: in.GetMaxBackoffSeconds(),
+	}
+
+	if in.GetGiveUpAction() != agent.RestartGiveUpAction_RESTART_GIVE_UP_ACTION_UNSPECIFIED {
+		budget.GiveUpAction = ProtoEnumToKebabCase(strings.TrimPrefix(in.GetGiveUpAction().String(), "RESTART_GIVE_UP_ACTION_"))
+	}
+
+	return budget
+}
+
+func mapNetworkConfigs(in []*agent.NetworkConfig) map[string]builder.NetworkOptions {
+	options := map[string]builder.NetworkOptions{}
+
+	for _, it := range in {
+		options[it.Name] = builder.NetworkOptions{
+			Options:   it.Options,
+			Driver:    it.GetDriver(),
+			Parent:    it.GetParent(),
+			Subnet:    it.GetSubnet(),
+			Gateway:   it.GetGateway(),
+			IPRange:   it.GetIpRange(),
+			Mode:      it.GetMode(),
+			IPAddress: it.GetIpAddress(),
+		}
+	}
+
+	return options
+}
+
+func MapDeploymentDiff(requestID, prefix string, diff *v1.DeploymentDiff) *agent.WorkloadDiff {
+	changes := []*agent.DeploymentFieldChange{}
+	for i := range diff.Changes {
+		changes = append(changes, &agent.DeploymentFieldChange{
+			Path: diff.Changes[i].Path,
+			Old:  diff.Changes[i].Old,
+			New:  diff.Changes[i].New,
+		})
+	}
+
+	return &agent.WorkloadDiff{
+		Id: requestID,
+		Container: &common.ContainerIdentifier{
+			Prefix: prefix,
+			Name:   strings.TrimPrefix(diff.Container, prefix+"-"),
+		},
+		Deployed:    diff.Deployed,
+		CurrentHash: pointer.ToStringOrNil(diff.CurrentHash),
+		NewHash:     diff.NewHash,
+		Changes:     changes,
+	}
 }
 
 func ProtoEnumToKebabCase(in string) string {
@@ -172,6 +282,21 @@ func ProtoEnumToKebabCase(in string) string {
 	return res
 }
 
+func mapServiceType(serviceType agent.ServiceType) v1.ServiceType {
+	switch serviceType {
+	case agent.ServiceType_SERVICE_TYPE_CLUSTER_IP:
+		return v1.ClusterIPServiceType
+	case agent.ServiceType_SERVICE_TYPE_HEADLESS:
+		return v1.HeadlessServiceType
+	case agent.ServiceType_SERVICE_TYPE_NODE_PORT:
+		return v1.NodePortServiceType
+	case agent.ServiceType_SERVICE_TYPE_LOAD_BALANCER:
+		return v1.LoadBalancerServiceType
+	default:
+		return ""
+	}
+}
+
 func mapCraneConfig(crane *agent.CraneContainerConfig, containerConfig *v1.ContainerConfig) {
 	containerConfig.DeploymentStrategy = strcase.ToCamel(crane.DeploymentStrategy.String())
 
@@ -217,6 +342,33 @@ func mapCraneConfig(crane *agent.CraneContainerConfig, containerConfig *v1.Conta
 			Port: int(crane.Metrics.Port),
 		}
 	}
+
+	if crane.ServiceType != nil {
+		containerConfig.ServiceType = mapServiceType(*crane.ServiceType)
+	}
+
+	for _, file := range crane.ConfigFiles {
+		containerConfig.ConfigFiles = append(containerConfig.ConfigFiles, v1.ConfigFile{
+			Path:    file.Path,
+			Content: file.Content,
+			Secret:  file.GetSecret(),
+		})
+	}
+
+	if crane.WorkloadIdentity != nil {
+		containerConfig.WorkloadIdentity = &v1.WorkloadIdentity{
+			Annotations:    crane.WorkloadIdentity.Annotations,
+			ServiceAccount: crane.WorkloadIdentity.GetServiceAccount(),
+		}
+	}
+
+	for _, token := range crane.ProjectedTokens {
+		containerConfig.ProjectedTokens = append(containerConfig.ProjectedTokens, v1.ProjectedToken{
+			ExpirationSeconds: token.ExpirationSeconds,
+			Path:              token.Path,
+			Audience:          token.Audience,
+		})
+	}
 }
 
 func mapContainerState(state common.ContainerState) v1.ContainerState {
@@ -285,9 +437,49 @@ func mapHealthCheckConfig(healthCheckConfig *common.HealthCheckConfig) v1.Health
 		mappedConfig.StartupProbe = &v1.Probe{Path: *healthCheckConfig.StartupProbe}
 	}
 
+	// the probe configs take precedence over the paths
+	if healthCheckConfig.Liveness != nil {
+		mappedConfig.LivenessProbe = mapProbeConfig(healthCheckConfig.Liveness)
+	}
+
+	if healthCheckConfig.Readiness != nil {
+		mappedConfig.ReadinessProbe = mapProbeConfig(healthCheckConfig.Readiness)
+	}
+
+	if healthCheckConfig.Startup != nil {
+		mappedConfig.StartupProbe = mapProbeConfig(healthCheckConfig.Startup)
+	}
+
 	return mappedConfig
 }
 
+func mapProbeConfig(probeConfig *common.ProbeConfig) *v1.Probe {
+	probe := &v1.Probe{
+		InitialDelaySeconds: probeConfig.InitialDelaySeconds,
+		PeriodSeconds:       probeConfig.PeriodSeconds,
+		TimeoutSeconds:      probeConfig.TimeoutSeconds,
+		SuccessThreshold:    probeConfig.SuccessThreshold,
+		FailureThreshold:    probeConfig.FailureThreshold,
+	}
+
+	switch handler := probeConfig.Handler.(type) {
+	case *common.ProbeConfig_Http:
+		probe.Type = v1.ProbeHTTP
+		probe.Path = handler.Http.Path
+		probe.Port = pointer.GetInt32(handler.Http.Port)
+		probe.Scheme = handler.Http.GetScheme()
+		probe.Headers = handler.Http.Headers
+	case *common.ProbeConfig_Tcp:
+		probe.Type = v1.ProbeTCP
+		probe.Port = pointer.GetInt32(handler.Tcp.Port)
+	case *common.ProbeConfig_Exec:
+		probe.Type = v1.ProbeExec
+		probe.Command = handler.Exec.Command
+	}
+
+	return probe
+}
+
 func mapVolumes(in []*agent.Volume) []v1.Volume {
 	volumes := []v1.Volume{}
 
@@ -441,7 +633,59 @@ func MapContainerState(it *dockerTypes.Container, prefix string) *common.Contain
 		ImageName: imageName[0],
 		ImageTag:  imageTag,
 		Labels:    it.Labels,
+		Networks:  mapContainerNetworks(it.ID, it.NetworkSettings),
+	}
+}
+
+// MapDockerContainerDetails completes the state with what only the inspection of the container tells,
+// the health check status, the restart count, the OOM kill and the time the state started
+func MapDockerContainerDetails(item *common.ContainerStateItem, inspect *dockerTypes.ContainerJSON) {
+	if item == nil || inspect == nil || inspect.ContainerJSONBase == nil || inspect.State == nil {
+		return
+	}
+
+	state := inspect.State
+	item.RestartCount = int32(inspect.RestartCount)
+	item.OomKilled = state.OOMKilled
+	item.Health = mapDockerHealth(state.Health)
+
+	since := state.FinishedAt
+	if state.Running || state.Paused || state.Restarting {
+		since = state.StartedAt
+	}
+	if sinceTime := parseDockerTime(since); sinceTime != nil {
+		item.StateSince = timestamppb.New(*sinceTime)
+	} else if createdTime := parseDockerTime(inspect.Created); createdTime != nil {
+		item.StateSince = timestamppb.New(*createdTime)
+	}
+}
+
+func mapDockerHealth(health *dockerTypes.Health) common.ContainerHealth {
+	if health == nil {
+		return common.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
 	}
+
+	switch health.Status {
+	case dockerTypes.Starting:
+		return common.ContainerHealth_HEALTH_STARTING
+	case dockerTypes.Healthy:
+		return common.ContainerHealth_HEALTHY
+	case dockerTypes.Unhealthy:
+		return common.ContainerHealth_UNHEALTHY
+	default:
+		return common.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
+	}
+}
+
+// parseDockerTime returns nil for the zero time the engine reports for events that did not happen yet
+func parseDockerTime(value string) *time.Time {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil || parsed.IsZero() {
+		return nil
+	}
+
+	parsed = parsed.UTC()
+	return &parsed
 }
 
 func MapContainerStateList(in []dockerTypes.Container, prefix string) []*common.ContainerStateItem {
@@ -464,12 +708,58 @@ func mapContainerPorts(in *[]dockerTypes.Port) []*common.ContainerStateItemPort
 		ports = append(ports, &common.ContainerStateItemPort{
 			Internal: int32(it.PrivatePort),
 			External: int32(it.PublicPort),
+			HostIp:   pointer.ToStringOrNil(it.IP),
+			Protocol: pointer.ToStringOrNil(it.Type),
 		})
 	}
 
 	return ports
 }
 
+// mapContainerNetworks lists the addresses of the container on every network it is attached to, sorted by network name
+func mapContainerNetworks(containerID string, settings *dockerTypes.SummaryNetworkSettings) []*common.ContainerStateItemNetwork {
+	networks := []*common.ContainerStateItemNetwork{}
+	if settings == nil {
+		return networks
+	}
+
+	names := maps.Keys(settings.Networks)
+	slices.Sort(names)
+
+	for _, name := range names {
+		endpoint := settings.Networks[name]
+		if endpoint == nil {
+			continue
+		}
+
+		networks = append(networks, &common.ContainerStateItemNetwork{
+			Name:        name,
+			IpAddress:   endpoint.IPAddress,
+			Ipv6Address: pointer.ToStringOrNil(endpoint.GlobalIPv6Address),
+			Gateway:     pointer.ToStringOrNil(endpoint.Gateway),
+			MacAddress:  pointer.ToStringOrNil(endpoint.MacAddress),
+			Aliases:     networkAliases(containerID, endpoint.Aliases, endpoint.DNSNames),
+		})
+	}
+
+	return networks
+}
+
+// networkAliases merges the user defined aliases with the DNS names reported by newer engines,
+// leaving out the short container ID the engine adds as a DNS name
+func networkAliases(containerID string, aliases, dnsNames []string) []string {
+	result := []string{}
+	for _, alias := range append(slices.Clone(aliases), dnsNames...) {
+		if alias == "" || alias == containerID || alias == stringid.TruncateID(containerID) || slices.Contains(result, alias) {
+			continue
+		}
+
+		result = append(result, alias)
+	}
+
+	return result
+}
+
 func MapDeploymentLatestPodToStateItem(
 	deployment *appsv1.Deployment,
 	pods []corev1.Pod,
@@ -542,12 +832,24 @@ func MapDeploymentLatestPodToStateItem(
 		}
 	}
 
+	if latestPod != nil && latestPod.Status.PodIP != "" {
+		stateItem.Networks = []*common.ContainerStateItemNetwork{
+			{
+				Name:      "pod",
+				IpAddress: latestPod.Status.PodIP,
+				Aliases:   []string{},
+			},
+		}
+	}
+
 	if latestPod != nil && len(latestPod.Status.ContainerStatuses) > 0 {
 		err := mapKubeStatusToCruxContainerState(stateItem, latestPod.Status.ContainerStatuses[0].State)
 		if err != nil {
 			log.Error().Stack().Err(err).Msg("Failed to map k8s pod info")
 			return nil, nil
 		}
+
+		mapKubeContainerStatusDetails(stateItem, &latestPod.Status.ContainerStatuses[0], hasKubeReadinessProbe(deployment))
 	}
 
 	return stateItem, latestPod
@@ -602,6 +904,7 @@ func mapServicePorts(svc *corev1.Service) []*common.ContainerStateItemPort {
 		res = append(res, &common.ContainerStateItemPort{
 			Internal: port.TargetPort.IntVal,
 			External: port.Port,
+			Protocol: pointer.ToStringOrNil(strings.ToLower(string(port.Protocol))),
 		})
 	}
 
@@ -631,6 +934,48 @@ func mapKubeStatusToCruxContainerState(stateItem *common.ContainerStateItem, kub
 	return fmt.Errorf("unknown pod container state: %s", kubeContainerState.String())
 }
 
+// mapKubeContainerStatusDetails maps the probes the way the docker health check is mapped,
+// readiness becomes the health and failing liveness probes show up in the restart count
+func mapKubeContainerStatusDetails(stateItem *common.ContainerStateItem, status *corev1.ContainerStatus, probed bool) {
+	stateItem.RestartCount = status.RestartCount
+	stateItem.OomKilled = isKubeOOMKilled(status.State.Terminated) || isKubeOOMKilled(status.LastTerminationState.Terminated)
+
+	switch {
+	case !probed || status.State.Running == nil:
+		stateItem.Health = common.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
+	case status.Ready:
+		stateItem.Health = common.ContainerHealth_HEALTHY
+	case status.Started != nil && !*status.Started:
+		stateItem.Health = common.ContainerHealth_HEALTH_STARTING
+	default:
+		stateItem.Health = common.ContainerHealth_UNHEALTHY
+	}
+
+	switch {
+	case status.State.Running != nil:
+		stateItem.StateSince = timestamppb.New(status.State.Running.StartedAt.UTC())
+	case status.State.Terminated != nil:
+		stateItem.StateSince = timestamppb.New(status.State.Terminated.FinishedAt.UTC())
+	}
+}
+
+// hasKubeReadinessProbe tells if the health of the container is checked, without probes
+// kubernetes reports every running container ready, while docker reports no health at all
+func hasKubeReadinessProbe(deployment *appsv1.Deployment) bool {
+	for i := range deployment.Spec.Template.Spec.Containers {
+		it := &deployment.Spec.Template.Spec.Containers[i]
+		if it.Name == deployment.Name {
+			return it.ReadinessProbe != nil || it.StartupProbe != nil
+		}
+	}
+
+	return false
+}
+
+func isKubeOOMKilled(terminated *corev1.ContainerStateTerminated) bool {
+	return terminated != nil && terminated.Reason == "OOMKilled"
+}
+
 func MapDockerStateToCruxContainerState(state string) common.ContainerState {
 	switch state {
 	case "created":
@@ -671,6 +1016,11 @@ func MapDockerContainerEventToContainerState(event string) common.ContainerState
 	case "kill":
 		return common.ContainerState_WAITING
 	default:
+		// health checks only run in running containers, eg. "health_status: healthy"
+		if strings.HasPrefix(event, "health_status") {
+			return common.ContainerState_RUNNING
+		}
+
 		return common.ContainerState_CONTAINER_STATE_UNSPECIFIED
 	}
 }
@@ -8,13 +8,17 @@
 	"time"
 
 	"github.com/AlekSi/pointer"
+	dockerTypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
 	"github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
 	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 )
@@ -120,9 +124,18 @@ func testExpectedCommon(req *agent.DeployWorkloadRequest) *v1.DeployImageRequest
 				Type:   "365",
 				Config: map[string]string{"opt1": "v1", "opt2": "v2"},
 			},
-			RestartPolicy: container.RestartPolicyAlways,
-			Networks:      []string{"n1", "n2"},
-			NetworkMode:   "BRIDGE",
+			RestartPolicy:  container.RestartPolicyAlways,
+			Networks:       []string{"n1", "n2"},
+			NetworkMode:    "BRIDGE",
+			PidMode:        "host",
+			Hostname:       "{{ .Node }}-license",
+			LogFiles:       []string{"/app/logs/*.log"},
+			Runtime:        "kata",
+			RuntimeOptions: map[string]string{"io.katacontainers.config.hypervisor.default_vcpus": "2"},
+			RestartBudget:  &v1.RestartBudget{GiveUpAction: v1.RestartGiveUpStop, MaxRestarts: 5, WindowSeconds: 600, BackoffSeconds: 10},
+			NetworkOptions: map[string]builder.NetworkOptions{
+				"lan": {Driver: "macvlan", Parent: "eth0", Subnet: "192.168.1.0/24", IPAddress: "192.168.1.20"},
+			},
 			CustomHeaders: []string(nil),
 			Annotations: v1.Markers{
 				Deployment: map[string]string{"annot1": "value1"},
@@ -184,6 +197,43 @@ func TestMapPorts(t *testing.T) {
 	assert.Equal(t, expected, bindings)
 }
 
+func TestMapContainerStateNetworks(t *testing.T) {
+	cont := &dockerTypes.Container{
+		ID:    "4f66ad9a0b2e8f1c",
+		Names: []string{"/prefix-web"},
+		Image: "nginx:1.25",
+		State: "running",
+		Ports: []dockerTypes.Port{
+			{IP: "0.0.0.0", PrivatePort: 80, PublicPort: 8080, Type: "tcp"},
+			{PrivatePort: 443, Type: "tcp"},
+		},
+		NetworkSettings: &dockerTypes.SummaryNetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"prefix-default": {
+					IPAddress: "172.20.0.3",
+					Gateway:   "172.20.0.1",
+					Aliases:   []string{"web", "4f66"},
+					DNSNames:  []string{"prefix-web", "web", "4f66ad9a0b2e"},
+				},
+				"bridge": {
+					IPAddress: "172.17.0.2",
+				},
+			},
+		},
+	}
+
+	state := MapContainerState(cont, "prefix")
+
+	assert.Equal(t, []*common.ContainerStateItemPort{
+		{Internal: 80, External: 8080, HostIp: pointer.ToString("0.0.0.0"), Protocol: pointer.ToString("tcp")},
+		{Internal: 443, Protocol: pointer.ToString("tcp")},
+	}, state.Ports)
+	assert.Equal(t, []*common.ContainerStateItemNetwork{
+		{Name: "bridge", IpAddress: "172.17.0.2", Aliases: []string{}},
+		{Name: "prefix-default", IpAddress: "172.20.0.3", Gateway: pointer.ToString("172.20.0.1"), Aliases: []string{"web", "4f66", "prefix-web"}},
+	}, state.Networks)
+}
+
 func TestMapSecrets(t *testing.T) {
 	kvl := testKeyValueList()
 
@@ -204,6 +254,75 @@ func TestMapDockerContainerEventToContainerState(t *testing.T) {
 	assert.Equal(t, common.ContainerState_RUNNING, MapDockerContainerEventToContainerState("start"))
 	assert.Equal(t, common.ContainerState_EXITED, MapDockerContainerEventToContainerState("stop"))
 	assert.Equal(t, common.ContainerState_EXITED, MapDockerContainerEventToContainerState("die"))
+	assert.Equal(t, common.ContainerState_RUNNING, MapDockerContainerEventToContainerState("health_status: unhealthy"))
+}
+
+func TestMapDockerContainerDetails(t *testing.T) {
+	item := &common.ContainerStateItem{}
+	MapDockerContainerDetails(item, &dockerTypes.ContainerJSON{
+		ContainerJSONBase: &dockerTypes.ContainerJSONBase{
+			Created:      "2024-05-01T10:00:00Z",
+			RestartCount: 3,
+			State: &dockerTypes.ContainerState{
+				Running:    true,
+				OOMKilled:  true,
+				StartedAt:  "2024-05-01T10:05:00.5Z",
+				FinishedAt: "2024-05-01T10:04:59Z",
+				Health:     &dockerTypes.Health{Status: dockerTypes.Unhealthy},
+			},
+		},
+	})
+
+	assert.Equal(t, common.ContainerHealth_UNHEALTHY, item.Health)
+	assert.Equal(t, int32(3), item.RestartCount)
+	assert.True(t, item.OomKilled)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 5, 0, 500000000, time.UTC), item.StateSince.AsTime())
+
+	created := &common.ContainerStateItem{}
+	MapDockerContainerDetails(created, &dockerTypes.ContainerJSON{
+		ContainerJSONBase: &dockerTypes.ContainerJSONBase{
+			Created: "2024-05-01T10:00:00Z",
+			State: &dockerTypes.ContainerState{
+				StartedAt:  "0001-01-01T00:00:00Z",
+				FinishedAt: "0001-01-01T00:00:00Z",
+			},
+		},
+	})
+
+	assert.Equal(t, common.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED, created.Health)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), created.StateSince.AsTime(),
+		"never started containers fall back to creation")
+}
+
+func TestMapKubeContainerStatusDetails(t *testing.T) {
+	startedAt := metav1.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC)
+	status := &corev1.ContainerStatus{
+		RestartCount: 2,
+		Started:      pointer.ToBool(true),
+		State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: startedAt}},
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+		},
+	}
+
+	item := &common.ContainerStateItem{}
+	mapKubeContainerStatusDetails(item, status, true)
+	assert.Equal(t, common.ContainerHealth_UNHEALTHY, item.Health, "running but not ready")
+	assert.Equal(t, int32(2), item.RestartCount)
+	assert.True(t, item.OomKilled)
+	assert.Equal(t, startedAt.Time, item.StateSince.AsTime())
+
+	status.Ready = true
+	mapKubeContainerStatusDetails(item, status, true)
+	assert.Equal(t, common.ContainerHealth_HEALTHY, item.Health)
+
+	status.Ready = false
+	status.Started = pointer.ToBool(false)
+	mapKubeContainerStatusDetails(item, status, true)
+	assert.Equal(t, common.ContainerHealth_HEALTH_STARTING, item.Health)
+
+	mapKubeContainerStatusDetails(item, status, false)
+	assert.Equal(t, common.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED, item.Health, "no probes, same as no health check")
 }
 
 func testDeployRequest() *agent.DeployWorkloadRequest {
@@ -320,8 +439,28 @@ func testDagentConfig() *agent.DagentContainerConfig {
 			Driver:  365,
 			Options: map[string]string{"opt1": "v1", "opt2": "v2"},
 		},
-		NetworkMode: common.NetworkMode_BRIDGE.Enum(),
-		Networks:    []string{"n1", "n2"},
+		NetworkMode:    common.NetworkMode_BRIDGE.Enum(),
+		PidMode:        pointer.ToString("host"),
+		Hostname:       pointer.ToString("{{ .Node }}-license"),
+		Networks:       []string{"n1", "n2"},
+		LogFiles:       []string{"/app/logs/*.log"},
+		Runtime:        pointer.ToString("kata"),
+		RuntimeOptions: map[string]string{"io.katacontainers.config.hypervisor.default_vcpus": "2"},
+		RestartBudget: &agent.RestartBudget{
+			MaxRestarts:    5,
+			WindowSeconds:  600,
+			BackoffSeconds: pointer.ToUint32(10),
+			GiveUpAction:   agent.RestartGiveUpAction_RESTART_GIVE_UP_ACTION_STOP.Enum(),
+		},
+		NetworkConfigs: []*agent.NetworkConfig{
+			{
+				Name:      "lan",
+				Driver:    pointer.ToString("macvlan"),
+				Parent:    pointer.ToString("eth0"),
+				Subnet:    pointer.ToString("192.168.1.0/24"),
+				IpAddress: pointer.ToString("192.168.1.20"),
+			},
+		},
 	}
 }
 
@@ -528,3 +667,94 @@ func TestCraneDeploymentStrategyMapping(t *testing.T) {
 		})
 	}
 }
+
+func TestCraneConfigFilesMapping(t *testing.T) {
+	craneConfig := testCraneConfig()
+	craneConfig.ConfigFiles = []*agent.ConfigFile{
+		{Path: "/etc/app/config.yaml", Content: "level: debug"},
+		{Path: "/etc/app/key.pem", Content: "encrypted", Secret: pointer.ToBool(true)},
+	}
+
+	resultConfig := v1.ContainerConfig{}
+	mapCraneConfig(craneConfig, &resultConfig)
+
+	assert.Equal(t, []v1.ConfigFile{
+		{Path: "/etc/app/config.yaml", Content: "level: debug"},
+		{Path: "/etc/app/key.pem", Content: "encrypted", Secret: true},
+	}, resultConfig.ConfigFiles)
+}
+
+func TestCraneServiceTypeMapping(t *testing.T) {
+	craneConfig := testCraneConfig()
+
+	resultConfig := v1.ContainerConfig{}
+	mapCraneConfig(craneConfig, &resultConfig)
+	assert.Empty(t, resultConfig.ServiceType)
+
+	craneConfig.ServiceType = agent.ServiceType_SERVICE_TYPE_HEADLESS.Enum()
+	mapCraneConfig(craneConfig, &resultConfig)
+	assert.Equal(t, v1.HeadlessServiceType, resultConfig.ServiceType)
+}
+
+func TestCraneWorkloadIdentityMapping(t *testing.T) {
+	craneConfig := testCraneConfig()
+	craneConfig.WorkloadIdentity = &agent.WorkloadIdentity{
+		Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/api"},
+	}
+	craneConfig.ProjectedTokens = []*agent.ProjectedToken{
+		{Path: "/var/run/secrets/vault/token", Audience: "vault", ExpirationSeconds: pointer.ToInt64(900)},
+	}
+
+	resultConfig := v1.ContainerConfig{}
+	mapCraneConfig(craneConfig, &resultConfig)
+
+	assert.Equal(t, &v1.WorkloadIdentity{
+		Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/api"},
+	}, resultConfig.WorkloadIdentity)
+	assert.Equal(t, []v1.ProjectedToken{
+		{Path: "/var/run/secrets/vault/token", Audience: "vault", ExpirationSeconds: pointer.ToInt64(900)},
+	}, resultConfig.ProjectedTokens)
+}
+
+func TestCraneProbeConfigMapping(t *testing.T) {
+	craneConfig := testCraneConfig()
+	craneConfig.HealthCheckConfig.Liveness = &common.ProbeConfig{
+		Handler:          &common.ProbeConfig_Tcp{Tcp: &common.TCPProbe{Port: pointer.ToInt32(5432)}},
+		PeriodSeconds:    pointer.ToInt32(10),
+		FailureThreshold: pointer.ToInt32(3),
+	}
+	craneConfig.HealthCheckConfig.Readiness = &common.ProbeConfig{
+		Handler: &common.ProbeConfig_Http{Http: &common.HTTPProbe{
+			Path:    "/ready",
+			Scheme:  pointer.ToString("HTTPS"),
+			Headers: map[string]string{"X-Probe": "crane"},
+		}},
+		SuccessThreshold: pointer.ToInt32(2),
+	}
+	craneConfig.HealthCheckConfig.Startup = &common.ProbeConfig{
+		Handler:             &common.ProbeConfig_Exec{Exec: &common.ExecProbe{Command: []string{"cat", "/tmp/started"}}},
+		InitialDelaySeconds: pointer.ToInt32(0),
+	}
+
+	resultConfig := v1.ContainerConfig{}
+	mapCraneConfig(craneConfig, &resultConfig)
+
+	assert.Equal(t, &v1.Probe{
+		Type:             v1.ProbeTCP,
+		Port:             5432,
+		PeriodSeconds:    pointer.ToInt32(10),
+		FailureThreshold: pointer.ToInt32(3),
+	}, resultConfig.HealthCheckConfig.LivenessProbe)
+	assert.Equal(t, &v1.Probe{
+		Type:             v1.ProbeHTTP,
+		Path:             "/ready",
+		Scheme:           "HTTPS",
+		Headers:          map[string]string{"X-Probe": "crane"},
+		SuccessThreshold: pointer.ToInt32(2),
+	}, resultConfig.HealthCheckConfig.ReadinessProbe)
+	assert.Equal(t, &v1.Probe{
+		Type:                v1.ProbeExec,
+		Command:             []string{"cat", "/tmp/started"},
+		InitialDelaySeconds: pointer.ToInt32(0),
+	}, resultConfig.HealthCheckConfig.StartupProbe)
+}
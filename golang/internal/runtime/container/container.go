@@ -23,6 +23,7 @@
 	RecommendedPodmanServerVersion = "4.4.0"
 	PodmanHost                     = "host.containers.internal"
 	DockerHost                     = "host.docker.internal"
+	WindowsOSType                  = "windows"
 )
 
 var (
@@ -118,6 +119,11 @@ func GetContainerRuntime(ctx context.Context, cli client.APIClient) (string, err
 		return "", err
 	}
 
+	// Windows daemons report no init binary, they would be mistaken for Podman
+	if info.OSType == WindowsOSType {
+		return Docker, nil
+	}
+
 	switch info.InitBinary {
 	case "":
 		return Podman, nil
@@ -127,3 +133,13 @@ func GetContainerRuntime(ctx context.Context, cli client.APIClient) (string, err
 		return UnknownRuntime, ErrServerUnknown
 	}
 }
+
+// IsWindowsDaemon reports whether the daemon runs Windows containers
+func IsWindowsDaemon(ctx context.Context, cli client.APIClient) (bool, error) {
+	info, err := cli.Info(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return info.OSType == WindowsOSType, nil
+}
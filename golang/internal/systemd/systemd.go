@@ -0,0 +1,110 @@
+// Package systemd renders service units for running the agents as plain binaries
+package systemd
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"text/template"
+)
+
+const (
+	DefaultBinaryPath = "/usr/local/bin/dagent"
+	DefaultEnvFile    = "/etc/dyrectorio/dagent.env"
+	DefaultUser       = "dagent"
+	DefaultRestart    = "on-failure"
+	DefaultRestartSec = 5
+	DefaultDataPath   = "/srv/dagent"
+)
+
+//go:embed dagent.service.tmpl
+var unitTmpl embed.FS
+
+var ErrRelativePath = errors.New("path must be absolute")
+
+// UnitOptions are the values substituted into the unit template
+type UnitOptions struct {
+	Description     string
+	BinaryPath      string
+	EnvFile         string
+	User            string
+	Group           string
+	Restart         string
+	StateDirectory  string
+	DataPath        string
+	ReadWritePaths  []string
+	RestartSec      uint
+	EnvFileOptional bool
+}
+
+// DefaultUnitOptions returns options suitable for a standard dagent install
+func DefaultUnitOptions() UnitOptions {
+	return UnitOptions{
+		Description:    "dyrector.io docker agent",
+		BinaryPath:     DefaultBinaryPath,
+		EnvFile:        DefaultEnvFile,
+		User:           DefaultUser,
+		Group:          DefaultUser,
+		Restart:        DefaultRestart,
+		RestartSec:     DefaultRestartSec,
+		StateDirectory: "dagent",
+		DataPath:       DefaultDataPath,
+	}
+}
+
+func validRestart(restart string) bool {
+	switch restart {
+	case "no", "always", "on-success", "on-failure", "on-abnormal", "on-abort", "on-watchdog":
+		return true
+	}
+	return false
+}
+
+func (o *UnitOptions) validate() error {
+	paths := append([]string{o.BinaryPath, o.EnvFile, o.DataPath}, o.ReadWritePaths...)
+	for _, p := range paths {
+		if !filepath.IsAbs(p) {
+			return fmt.Errorf("%w: %q", ErrRelativePath, p)
+		}
+	}
+
+	if !validRestart(o.Restart) {
+		return fmt.Errorf("invalid restart policy: %q", o.Restart)
+	}
+
+	if o.User == "" {
+		return errors.New("user can't be empty")
+	}
+
+	return nil
+}
+
+// RenderDagentUnit renders a hardened systemd unit for running dagent outside of a container
+func RenderDagentUnit(opts UnitOptions) (string, error) {
+	if opts.Group == "" {
+		opts.Group = opts.User
+	}
+
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+
+	content, err := unitTmpl.ReadFile("dagent.service.tmpl")
+	if err != nil {
+		return "", err
+	}
+
+	unit, err := template.New("dagent.service").Parse(string(content))
+	if err != nil {
+		return "", err
+	}
+
+	var result bytes.Buffer
+	if err := unit.Execute(&result, opts); err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
@@ -0,0 +1,49 @@
+//go:build unit
+// +build unit
+
+package systemd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/systemd"
+)
+
+func TestRenderDagentUnitDefaults(t *testing.T) {
+	unit, err := systemd.RenderDagentUnit(systemd.DefaultUnitOptions())
+
+	assert.NoError(t, err)
+	assert.Contains(t, unit, "ExecStart=/usr/local/bin/dagent\n")
+	assert.Contains(t, unit, "EnvironmentFile=/etc/dyrectorio/dagent.env\n")
+	assert.Contains(t, unit, "Restart=on-failure\n")
+	assert.Contains(t, unit, "NoNewPrivileges=true\n")
+	assert.Contains(t, unit, "ReadWritePaths=/srv/dagent\n")
+}
+
+func TestRenderDagentUnitOptionalEnvFile(t *testing.T) {
+	opts := systemd.DefaultUnitOptions()
+	opts.EnvFileOptional = true
+	opts.ReadWritePaths = []string{"/var/lib/traefik"}
+
+	unit, err := systemd.RenderDagentUnit(opts)
+
+	assert.NoError(t, err)
+	assert.Contains(t, unit, "EnvironmentFile=-/etc/dyrectorio/dagent.env\n")
+	assert.Contains(t, unit, "ReadWritePaths=/srv/dagent /var/lib/traefik\n")
+}
+
+func TestRenderDagentUnitInvalid(t *testing.T) {
+	opts := systemd.DefaultUnitOptions()
+	opts.BinaryPath = "dagent"
+
+	_, err := systemd.RenderDagentUnit(opts)
+	assert.ErrorIs(t, err, systemd.ErrRelativePath)
+
+	opts = systemd.DefaultUnitOptions()
+	opts.Restart = "sometimes"
+
+	_, err = systemd.RenderDagentUnit(opts)
+	assert.Error(t, err)
+}
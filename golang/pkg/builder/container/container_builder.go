@@ -4,22 +4,30 @@
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/exp/maps"
+	"golang.org/x/time/rate"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
 	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
 	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 )
@@ -39,6 +47,7 @@ type Builder interface {
 	WithNetworkAliases(aliases ...string) Builder
 	WithNetworkMode(networkMode string) Builder
 	WithNetworks(networks []string) Builder
+	WithNetworkOptions(options map[string]NetworkOptions) Builder
 	WithLabels(labels map[string]string) Builder
 	WithLogConfig(config *container.LogConfig) Builder
 	WithRegistryAuth(auth *imageHelper.RegistryAuth) Builder
@@ -51,16 +60,29 @@ type Builder interface {
 	WithLogWriter(logger dogger.LogWriter) Builder
 	WithoutConflict() Builder
 	WithPullDisplayFunc(imageHelper.PullDisplayFn) Builder
+	WithPullLimiter(limiter *rate.Limiter) Builder
 	WithExtraHosts(hosts []string) Builder
 	WithWorkingDirectory(workingDirectory string) Builder
 	WithSysctls(sysctls map[string]string) Builder
+	WithIsolation(isolation container.Isolation) Builder
+	WithRuntime(runtime string) Builder
+	WithAnnotations(annotations map[string]string) Builder
+	WithPID(pidMode string) Builder
+	WithIPC(ipcMode string) Builder
+	WithHostname(hostname string) Builder
+	WithDomainname(domainname string) Builder
+	WithResources(resources *container.Resources) Builder
+	WithVolumes(volumes ...volume.CreateOptions) Builder
+	WithMetadata(metadata Metadata) Builder
 	WithPreCreateHooks(hooks ...LifecycleFunc) Builder
 	WithPostCreateHooks(hooks ...LifecycleFunc) Builder
 	WithPreStartHooks(hooks ...LifecycleFunc) Builder
 	WithPostStartHooks(hooks ...LifecycleFunc) Builder
+	Spec() Spec
 	Create() (Container, error)
 	CreateAndStart() (Container, error)
 	CreateAndStartWaitUntilExit() (Container, *WaitResult, error)
+	RunAndCapture(ctx context.Context) (*RunResult, error)
 }
 
 type DockerContainerBuilder struct {
@@ -70,16 +92,27 @@ type DockerContainerBuilder struct {
 	user             *int64
 	networkMap       map[string]string
 	labels           map[string]string
+	networkOptions   map[string]NetworkOptions
 	pullDisplayFn    imageHelper.PullDisplayFn
+	pullLimiter      *rate.Limiter
 	containerID      *string
 	logConfig        *container.LogConfig
+	resources        *container.Resources
 	sysctls          map[string]string
+	annotations      map[string]string
+	metadata         Metadata
 	workingDirectory string
 	containerName    string
 	imageWithTag     string
 	registryAuth     string
 	networkMode      string
+	pidMode          string
+	ipcMode          string
+	hostname         string
+	domainname       string
 	restartPolicy    container.RestartPolicyMode
+	isolation        container.Isolation
+	runtime          string
 	hooksPostStart   []LifecycleFunc
 	portList         []PortBinding
 	hooksPreCreate   []LifecycleFunc
@@ -90,6 +123,7 @@ type DockerContainerBuilder struct {
 	mountList        []mount.Mount
 	portRanges       []PortRangeBinding
 	hooksPreStart    []LifecycleFunc
+	volumes          []volume.CreateOptions
 	envList          []string
 	networkAliases   []string
 	extraHosts       []string
@@ -201,6 +235,12 @@ func (dc *DockerContainerBuilder) WithNetworks(networks []string) Builder {
 	return dc
 }
 
+// Sets the driver, addressing and static IP of networks by name, networks without options use the default driver.
+func (dc *DockerContainerBuilder) WithNetworkOptions(options map[string]NetworkOptions) Builder {
+	dc.networkOptions = options
+	return dc
+}
+
 // Sets the registry and authentication for the given image.
 func (dc *DockerContainerBuilder) WithRegistryAuth(auth *imageHelper.RegistryAuth) Builder {
 	if auth != nil {
@@ -270,6 +310,12 @@ func (dc *DockerContainerBuilder) WithPullDisplayFunc(fn imageHelper.PullDisplay
 	return dc
 }
 
+// Sets the limiter shared by the image pulls, the pulls are not limited by default.
+func (dc *DockerContainerBuilder) WithPullLimiter(limiter *rate.Limiter) Builder {
+	dc.pullLimiter = limiter
+	return dc
+}
+
 // Sets the builder to use extra hosts when creating the container.
 // Hosts must be defined in a "HOSTNAME:IP" format.
 func (dc *DockerContainerBuilder) WithExtraHosts(hosts []string) Builder {
@@ -290,6 +336,68 @@ func (dc *DockerContainerBuilder) WithSysctls(sysctls map[string]string) Builder
 	return dc
 }
 
+// Sets the isolation technology of the container, only Windows daemons support values other than default.
+func (dc *DockerContainerBuilder) WithIsolation(isolation container.Isolation) Builder {
+	dc.isolation = isolation
+	return dc
+}
+
+// Sets the OCI runtime of the container, eg. nvidia or kata, it has to be configured on the daemon,
+// empty means the default runtime of the daemon.
+func (dc *DockerContainerBuilder) WithRuntime(runtime string) Builder {
+	dc.runtime = runtime
+	return dc
+}
+
+// Sets the OCI annotations of the container, the runtime reads its options from them.
+func (dc *DockerContainerBuilder) WithAnnotations(annotations map[string]string) Builder {
+	dc.annotations = annotations
+	return dc
+}
+
+// Sets the PID namespace of the container, "host" or "container:<name|id>", empty means a private namespace.
+func (dc *DockerContainerBuilder) WithPID(pidMode string) Builder {
+	dc.pidMode = pidMode
+	return dc
+}
+
+// Sets the IPC namespace of the container, eg. "host", "shareable" or "container:<name|id>".
+func (dc *DockerContainerBuilder) WithIPC(ipcMode string) Builder {
+	dc.ipcMode = ipcMode
+	return dc
+}
+
+// Sets the hostname of the container, it defaults to the name of the container.
+func (dc *DockerContainerBuilder) WithHostname(hostname string) Builder {
+	dc.hostname = hostname
+	return dc
+}
+
+// Sets the domain name of the container, the FQDN of the container is <hostname>.<domainname>.
+func (dc *DockerContainerBuilder) WithDomainname(domainname string) Builder {
+	dc.domainname = domainname
+	return dc
+}
+
+// Sets the cpu and memory constraints of the container.
+func (dc *DockerContainerBuilder) WithResources(resources *container.Resources) Builder {
+	dc.resources = resources
+	return dc
+}
+
+// Sets the named volumes created before the container, existing volumes are reused as they are.
+// Mounting them is still up to WithMountPoints.
+func (dc *DockerContainerBuilder) WithVolumes(volumes ...volume.CreateOptions) Builder {
+	dc.volumes = volumes
+	return dc
+}
+
+// Sets the deployment metadata of the container, the config hash is calculated by the builder.
+func (dc *DockerContainerBuilder) WithMetadata(metadata Metadata) Builder {
+	dc.metadata = metadata
+	return dc
+}
+
 // Sets an array of hooks which runs before the container is created. ContainerID is nil in these hooks.
 func (dc *DockerContainerBuilder) WithPreCreateHooks(hooks ...LifecycleFunc) Builder {
 	dc.hooksPreCreate = hooks
@@ -314,6 +422,31 @@ func (dc *DockerContainerBuilder) WithPostStartHooks(hooks ...LifecycleFunc) Bui
 	return dc
 }
 
+// Spec returns the configuration given by the 'With...' functions, without creating anything.
+// The hooks are not part of it.
+func (dc *DockerContainerBuilder) Spec() Spec {
+	return Spec{
+		Labels:           dc.labels,
+		User:             dc.user,
+		Name:             dc.containerName,
+		Image:            dc.imageWithTag,
+		Hostname:         util.Fallback(dc.hostname, dc.containerName),
+		NetworkMode:      dc.networkMode,
+		WorkingDirectory: dc.workingDirectory,
+		RestartPolicy:    dc.restartPolicy,
+		Env:              dc.envList,
+		Entrypoint:       dc.entrypoint,
+		Cmd:              dc.cmd,
+		Networks:         dc.networks,
+		NetworkAliases:   dc.networkAliases,
+		ExtraHosts:       dc.extraHosts,
+		PortBindings:     dc.portList,
+		PortRanges:       dc.portRanges,
+		Mounts:           dc.mountList,
+		Volumes:          dc.volumes,
+	}
+}
+
 func builderToDockerConfig(dc *DockerContainerBuilder) (hostConfig *container.HostConfig, containerConfig *container.Config, err error) {
 	portListNat := portListToNatBinding(dc.portRanges, dc.portList)
 	exposedPortSet := getPortSet(dc.portRanges, dc.portList)
@@ -323,6 +456,13 @@ func builderToDockerConfig(dc *DockerContainerBuilder) (hostConfig *container.Ho
 		AutoRemove:   dc.remove,
 		ExtraHosts:   dc.extraHosts,
 		Sysctls:      dc.sysctls,
+		Isolation:    dc.isolation,
+		Runtime:      dc.runtime,
+		Annotations:  dc.annotations,
+	}
+
+	if dc.resources != nil {
+		hostConfig.Resources = *dc.resources
 	}
 
 	containerConfig = &container.Config{
@@ -335,9 +475,8 @@ func builderToDockerConfig(dc *DockerContainerBuilder) (hostConfig *container.Ho
 		Cmd:          dc.cmd,
 		Shell:        dc.shell,
 		WorkingDir:   dc.workingDirectory,
-	}
-	if dc.containerName != "" {
-		containerConfig.Hostname = dc.containerName
+		Hostname:     util.Fallback(dc.hostname, dc.containerName),
+		Domainname:   dc.domainname,
 	}
 
 	if dc.user != nil {
@@ -357,6 +496,10 @@ func builderToDockerConfig(dc *DockerContainerBuilder) (hostConfig *container.Ho
 	}
 	hostConfig.RestartPolicy = policy
 
+	if err = applyNamespaces(dc, hostConfig); err != nil {
+		return nil, nil, err
+	}
+
 	if dc.networkMode != "" {
 		dc.logInfo(fmt.Sprintf("Using network mode: %s", dc.networkMode))
 	}
@@ -375,6 +518,40 @@ func builderToDockerConfig(dc *DockerContainerBuilder) (hostConfig *container.Ho
 	return hostConfig, containerConfig, nil
 }
 
+// applyNamespaces validates the PID and IPC modes and warns about the side effects of sharing host namespaces
+func applyNamespaces(dc *DockerContainerBuilder, hostConfig *container.HostConfig) error {
+	pidMode := container.PidMode(dc.pidMode)
+	if !pidMode.Valid() {
+		return fmt.Errorf("builder: invalid pid mode: %s", dc.pidMode)
+	}
+
+	ipcMode := container.IpcMode(dc.ipcMode)
+	if !ipcMode.Valid() || (ipcMode.IsContainer() && ipcMode.Container() == "") {
+		return fmt.Errorf("builder: invalid ipc mode: %s", dc.ipcMode)
+	}
+
+	hostConfig.PidMode = pidMode
+	hostConfig.IpcMode = ipcMode
+
+	if pidMode.IsHost() {
+		dc.logInfo("Warning: container shares the PID namespace of the host, host processes are visible and signalable")
+	}
+	if ipcMode.IsHost() {
+		dc.logInfo("Warning: container shares the IPC namespace of the host, including its shared memory")
+	}
+
+	if container.NetworkMode(dc.networkMode).IsHost() {
+		if len(dc.portList) > 0 || len(dc.portRanges) > 0 {
+			dc.logInfo("Warning: port bindings are ignored in host network mode, the container listens on the host directly")
+		}
+		if len(dc.networks) > 0 {
+			dc.logInfo(fmt.Sprintf("Warning: networks are ignored in host network mode: %v", dc.networks))
+		}
+	}
+
+	return nil
+}
+
 // Creates the container using the configuration given by 'With...' functions.
 func (dc *DockerContainerBuilder) Create() (Container, error) {
 	dc.logInfo(fmt.Sprintf("Creating container: %s", util.Fallback(dc.containerName, dc.imageWithTag)))
@@ -391,6 +568,11 @@ func (dc *DockerContainerBuilder) Create() (Container, error) {
 		}
 	}
 
+	if err := createVolumes(dc); err != nil {
+		dc.logError(fmt.Sprintf("Failed to create volumes: %s", err.Error()))
+		return nil, err
+	}
+
 	if hookError := execHooks(dc, nil, dc.hooksPreCreate); hookError != nil {
 		dc.logInfo(fmt.Sprintln("Container pre-create hook error: ", hookError))
 	}
@@ -400,9 +582,14 @@ func (dc *DockerContainerBuilder) Create() (Container, error) {
 		return nil, err
 	}
 
+	if err = applyMetadata(dc.metadata, hostConfig, containerConfig); err != nil {
+		return nil, err
+	}
+
 	containerCreateResp, err := dc.client.ContainerCreate(dc.ctx, containerConfig, hostConfig, nil, nil, dc.containerName)
 	if err != nil {
 		dc.logError(fmt.Sprintln("Container create failed: ", err))
+		return nil, err
 	}
 	containers, err := dc.client.ContainerList(dc.ctx, container.ListOptions{
 		All:     true,
@@ -462,6 +649,51 @@ func (dc *DockerContainerBuilder) CreateAndStartWaitUntilExit() (Container, *Wai
 	return cont, res, err
 }
 
+// RunAndCapture creates and starts a one-shot container, waits for it to exit, collects its
+// stdout, stderr and exit code, then removes the container regardless of the outcome.
+// Auto remove is disabled, because the engine could delete the container before the logs are read.
+func (dc *DockerContainerBuilder) RunAndCapture(ctx context.Context) (*RunResult, error) {
+	dc.remove = false
+
+	cont, err := dc.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RunResult{Name: cont.GetName()}
+	containerID := *cont.GetContainerID()
+	defer dc.removeOneShot(context.WithoutCancel(ctx), containerID)
+
+	res, err := cont.StartWaitUntilExit(ctx, dc.client)
+	if err != nil {
+		return result, err
+	}
+	result.ExitCode = res.StatusCode
+	result.OOMKilled = res.OOMKilled
+
+	logReader, err := dc.client.ContainerLogs(ctx, containerID, container.LogsOptions{ShowStdout: true, ShowStderr: true})
+	if err != nil {
+		return result, fmt.Errorf("failed to read logs of container %s: %w", result.Name, err)
+	}
+	defer logdefer.LogDeferredErr(logReader.Close, log.Debug(), "could not close log reader")
+
+	stdout, stderr := strings.Builder{}, strings.Builder{}
+	if _, err = stdcopy.StdCopy(&stdout, &stderr, logReader); err != nil {
+		return result, fmt.Errorf("failed to demultiplex logs of container %s: %w", result.Name, err)
+	}
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+
+	return result, nil
+}
+
+func (dc *DockerContainerBuilder) removeOneShot(ctx context.Context, containerID string) {
+	err := dc.client.ContainerRemove(ctx, containerID, container.RemoveOptions{Force: true, RemoveVolumes: true})
+	if err != nil && !errdefs.IsNotFound(err) {
+		dc.logError(fmt.Sprintf("Failed to remove one-shot container (%s): %s", containerID, err.Error()))
+	}
+}
+
 func (dc *DockerContainerBuilder) prepareImage() error {
 	expandedImageName, err := imageHelper.ExpandImageName(dc.imageWithTag)
 	if err != nil {
@@ -481,6 +713,7 @@ func (dc *DockerContainerBuilder) prepareImage() error {
 		dc.registryAuth,
 		dc.imagePriority,
 		dc.pullDisplayFn,
+		dc.pullLimiter,
 	)
 	if err != nil && err.Error() != "EOF" {
 		return fmt.Errorf("image pull error: %s", err.Error())
@@ -501,15 +734,18 @@ func createNetworks(dc *DockerContainerBuilder) (map[string]string, error) {
 			return nil, err
 		}
 
+		options := dc.networkOptions[networkName]
 		if len(networks) == 1 {
+			if options.Driver != "" && options.Driver != networks[0].Driver {
+				dc.logInfo(fmt.Sprintf("Warning: network %s already exists with driver %s instead of %s, it is used as is",
+					networkName, networks[0].Driver, options.Driver))
+			}
+
 			networkMap[networkName] = networks[0].ID
 			continue
 		}
 
-		networkOpts := types.NetworkCreate{
-			CheckDuplicate: true,
-		}
-
+		networkOpts := networkCreateOptions(&options)
 		networkResult, err := dc.client.NetworkCreate(dc.ctx, networkName, networkOpts)
 		if err != nil {
 			dc.logError(fmt.Sprintln("Failed to create network: ", err.Error()))
@@ -522,12 +758,83 @@ func createNetworks(dc *DockerContainerBuilder) (map[string]string, error) {
 	return networkMap, nil
 }
 
+// applyMetadata hashes the configuration before adding the metadata, so it only changes with the configuration
+func applyMetadata(metadata Metadata, hostConfig *container.HostConfig, containerConfig *container.Config) error {
+	hash, err := configHash(hostConfig, containerConfig)
+	if err != nil {
+		return err
+	}
+
+	metadataLabels := map[string]string{
+		label.DyrectorioOrg + label.ConfigHash: hash,
+	}
+	for key, value := range map[string]string{
+		label.DeploymentID: metadata.DeploymentID,
+		label.VersionName:  metadata.VersionName,
+		label.TriggeredBy:  metadata.TriggeredBy,
+	} {
+		if value != "" {
+			metadataLabels[label.DyrectorioOrg+key] = value
+		}
+	}
+
+	labels := map[string]string{}
+	maps.Copy(labels, containerConfig.Labels)
+	maps.Copy(labels, metadataLabels)
+	containerConfig.Labels = labels
+
+	annotations := map[string]string{}
+	maps.Copy(annotations, hostConfig.Annotations)
+	maps.Copy(annotations, metadataLabels)
+	hostConfig.Annotations = annotations
+
+	return nil
+}
+
+func configHash(hostConfig *container.HostConfig, containerConfig *container.Config) (string, error) {
+	data, err := json.Marshal(struct {
+		Host      *container.HostConfig
+		Container *container.Config
+	}{hostConfig, containerConfig})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash container config: %w", err)
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data)), nil
+}
+
+func createVolumes(dc *DockerContainerBuilder) error {
+	for i := range dc.volumes {
+		existing, err := dc.client.VolumeInspect(dc.ctx, dc.volumes[i].Name)
+		if err == nil {
+			if dc.volumes[i].Driver != "" && existing.Driver != dc.volumes[i].Driver {
+				dc.logInfo(fmt.Sprintf("Volume %s exists with driver %s instead of %s, it is used as it is",
+					existing.Name, existing.Driver, dc.volumes[i].Driver))
+			}
+			continue
+		}
+		if !errdefs.IsNotFound(err) {
+			return err
+		}
+
+		if _, err = dc.client.VolumeCreate(dc.ctx, dc.volumes[i]); err != nil {
+			return err
+		}
+		dc.logInfo(fmt.Sprintf("Volume created: %s", dc.volumes[i].Name))
+	}
+
+	return nil
+}
+
 func attachNetworks(dc *DockerContainerBuilder) {
 	if dc.networkMap != nil {
-		for _, networkID := range dc.networkMap {
+		for networkName, networkID := range dc.networkMap {
 			endpointSettings := &network.EndpointSettings{
 				Aliases: dc.networkAliases,
 			}
+			if options, ok := dc.networkOptions[networkName]; ok && options.IPAddress != "" {
+				endpointSettings.IPAMConfig = &network.EndpointIPAMConfig{IPv4Address: options.IPAddress}
+			}
 
 			err := dc.client.NetworkConnect(dc.ctx, networkID, *dc.containerID, endpointSettings)
 			if err != nil {
@@ -1,3 +1,7 @@
 package container
 
-var BuilderToDockerConfig = builderToDockerConfig
+var (
+	BuilderToDockerConfig = builderToDockerConfig
+	ApplyMetadata         = applyMetadata
+	NetworkCreateOptions  = networkCreateOptions
+)
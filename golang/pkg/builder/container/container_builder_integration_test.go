@@ -230,6 +230,30 @@ func TestAutoRemove(t *testing.T) {
 	assert.True(t, containerRemoved, "container should be removed in 5 seconds after exit")
 }
 
+func TestRunAndCapture(t *testing.T) {
+	ctx := context.Background()
+
+	res, err := baseBuilder(ctx).
+		WithName("prefix-one-shot").
+		WithImage("ghcr.io/dyrector-io/mirror/nginx:mainline-alpine").
+		WithEntrypoint([]string{"/bin/sh", "-c"}).
+		WithCmd([]string{"echo out; echo err >&2; exit 3"}).
+		RunAndCapture(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "prefix-one-shot", res.Name)
+	assert.Equal(t, int64(3), res.ExitCode)
+	assert.Equal(t, "out\n", res.Stdout)
+	assert.Equal(t, "err\n", res.Stderr)
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	assert.NoError(t, err)
+
+	containers, err := dockerHelper.GetAllContainersByName(ctx, cli, "prefix-one-shot")
+	assert.NoError(t, err)
+	assert.Empty(t, containers, "one-shot container should be removed after capturing its output")
+}
+
 func TestConflict(t *testing.T) {
 	cont1, err := containerbuilder.NewDockerBuilder(context.Background()).
 		WithImage("ghcr.io/dyrector-io/mirror/nginx:mainline-alpine").
@@ -108,3 +108,129 @@ func TestBuilderToConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyMetadata(t *testing.T) {
+	newConfigs := func() (*container.HostConfig, *container.Config) {
+		return &container.HostConfig{NetworkMode: "bridge"},
+			&container.Config{Image: "nginx:latest", Labels: map[string]string{"app": "web"}}
+	}
+
+	hc, cc := newConfigs()
+	err := containerbuilder.ApplyMetadata(containerbuilder.Metadata{
+		DeploymentID: "deployment-1",
+		VersionName:  "1.0.0",
+		TriggeredBy:  "user@example.com",
+	}, hc, cc)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "web", cc.Labels["app"])
+	assert.Equal(t, "deployment-1", cc.Labels["org.dyrectorio.deployment.id"])
+	assert.Equal(t, "1.0.0", hc.Annotations["org.dyrectorio.version.name"])
+	assert.Equal(t, "user@example.com", cc.Labels["org.dyrectorio.triggered-by"])
+	assert.Contains(t, cc.Labels["org.dyrectorio.config.hash"], "sha256:")
+
+	// the hash doesn't depend on the metadata, only on the configuration
+	otherHC, otherCC := newConfigs()
+	assert.NoError(t, containerbuilder.ApplyMetadata(containerbuilder.Metadata{DeploymentID: "deployment-2"}, otherHC, otherCC))
+	assert.Equal(t, cc.Labels["org.dyrectorio.config.hash"], otherCC.Labels["org.dyrectorio.config.hash"])
+	_, hasVersion := otherCC.Labels["org.dyrectorio.version.name"]
+	assert.False(t, hasVersion)
+}
+
+func TestBuilderRuntime(t *testing.T) {
+	builder := getBuilder(networkMockClient{networkMode: "bridge"})
+	builder.WithRuntime("kata").
+		WithAnnotations(map[string]string{"io.katacontainers.config.hypervisor.default_vcpus": "2"})
+
+	hc, cc, err := containerbuilder.BuilderToDockerConfig(builder)
+	assert.NoError(t, err)
+	assert.Equal(t, "kata", hc.Runtime)
+
+	// the runtime options are kept next to the metadata
+	assert.NoError(t, containerbuilder.ApplyMetadata(containerbuilder.Metadata{VersionName: "1.0.0"}, hc, cc))
+	assert.Equal(t, "2", hc.Annotations["io.katacontainers.config.hypervisor.default_vcpus"])
+	assert.Equal(t, "1.0.0", hc.Annotations["org.dyrectorio.version.name"])
+}
+
+func TestRunResultLines(t *testing.T) {
+	res := &containerbuilder.RunResult{
+		Stdout: "first\r\n\nsecond\n",
+		Stderr: "  \nfailure\n",
+	}
+
+	assert.Equal(t, []string{"first", "second", "failure"}, res.Lines())
+	assert.Empty(t, (&containerbuilder.RunResult{}).Lines())
+}
+
+func TestExitSignal(t *testing.T) {
+	assert.Equal(t, "", containerbuilder.ExitSignal(0))
+	assert.Equal(t, "", containerbuilder.ExitSignal(1))
+	assert.Equal(t, "", containerbuilder.ExitSignal(128))
+	assert.Equal(t, "SIGKILL", containerbuilder.ExitSignal(137))
+	assert.Equal(t, "SIGTERM", containerbuilder.ExitSignal(143))
+	assert.Equal(t, "signal 10", containerbuilder.ExitSignal(138))
+	assert.Equal(t, "SIGSEGV", (&containerbuilder.WaitResult{StatusCode: 139}).Signal())
+}
+
+func TestBuilderNamespaces(t *testing.T) {
+	builder := getBuilder(networkMockClient{networkMode: "host"})
+	builder.WithPID("host").WithIPC("container:monitored")
+
+	hc, _, err := containerbuilder.BuilderToDockerConfig(builder)
+	assert.NoError(t, err)
+	assert.Equal(t, container.NetworkMode("host"), hc.NetworkMode)
+	assert.Equal(t, container.PidMode("host"), hc.PidMode)
+	assert.Equal(t, container.IpcMode("container:monitored"), hc.IpcMode)
+
+	invalidCases := map[string]*containerbuilder.DockerContainerBuilder{
+		"pid mode": getBuilder(networkMockClient{networkMode: "none"}),
+		"ipc mode": getBuilder(networkMockClient{networkMode: "none"}),
+	}
+	invalidCases["pid mode"].WithPID("hots")
+	invalidCases["ipc mode"].WithIPC("container:")
+
+	for name, builder := range invalidCases {
+		_, _, err := containerbuilder.BuilderToDockerConfig(builder)
+		assert.ErrorContainsf(t, err, "invalid "+name, "%s should be validated", name)
+	}
+}
+
+func TestBuilderHostname(t *testing.T) {
+	builder := getBuilder(networkMockClient{networkMode: "bridge"})
+	builder.WithName("prefix-license-server")
+
+	_, cc, err := containerbuilder.BuilderToDockerConfig(builder)
+	assert.NoError(t, err)
+	assert.Equal(t, "prefix-license-server", cc.Hostname)
+	assert.Empty(t, cc.Domainname)
+
+	builder.WithHostname("license").WithDomainname("example.internal")
+	_, cc, err = containerbuilder.BuilderToDockerConfig(builder)
+	assert.NoError(t, err)
+	assert.Equal(t, "license", cc.Hostname)
+	assert.Equal(t, "example.internal", cc.Domainname)
+}
+
+func TestBuilderSpec(t *testing.T) {
+	builder := &containerbuilder.DockerContainerBuilder{}
+	builder.
+		WithName("dyo-stable_crux").
+		WithImage("ghcr.io/dyrector-io/dyrectorio/web/crux:stable").
+		WithCmd([]string{"serve"}).
+		WithEnv([]string{"TZ=UTC"}).
+		WithNetworks([]string{"dyo-stable"}).
+		WithNetworkAliases("dyo-stable_crux").
+		WithRestartPolicy(container.RestartPolicyAlways).
+		WithPortBindings([]containerbuilder.PortBinding{{ExposedPort: 1848, PortBinding: pointer.ToUint16(1848)}})
+
+	spec := builder.Spec()
+	assert.Equal(t, "dyo-stable_crux", spec.Name)
+	assert.Equal(t, "dyo-stable_crux", spec.Hostname)
+	assert.Equal(t, "ghcr.io/dyrector-io/dyrectorio/web/crux:stable", spec.Image)
+	assert.Equal(t, []string{"serve"}, spec.Cmd)
+	assert.Equal(t, []string{"TZ=UTC"}, spec.Env)
+	assert.Equal(t, []string{"dyo-stable"}, spec.Networks)
+	assert.Equal(t, []string{"dyo-stable_crux"}, spec.NetworkAliases)
+	assert.Equal(t, container.RestartPolicyAlways, spec.RestartPolicy)
+	assert.Len(t, spec.PortBindings, 1)
+}
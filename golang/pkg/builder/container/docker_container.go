@@ -11,6 +11,7 @@
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
 )
 
 // Container is the abstract result of the builder
@@ -87,7 +88,20 @@ func (d DockerContainer) StartWaitUntilExit(ctx context.Context, cli client.APIC
 
 	select {
 	case result := <-waitC:
-		return &WaitResult{StatusCode: result.StatusCode}, nil
+		res := &WaitResult{StatusCode: result.StatusCode}
+		if result.Error != nil {
+			res.Error = result.Error.Message
+		}
+
+		// the wait response does not tell why the container exited, only its inspect does
+		inspect, inspectErr := cli.ContainerInspect(ctx, containerID)
+		if inspectErr != nil {
+			log.Debug().Err(inspectErr).Str("container", d.GetName()).Msg("Failed to inspect the exited container")
+		} else if inspect.State != nil {
+			res.OOMKilled = inspect.State.OOMKilled
+		}
+
+		return res, nil
 
 	case err = <-errC:
 		return nil, fmt.Errorf("error container waiting: %w", err)
@@ -183,3 +183,18 @@ type Exec struct {
 func (e Exec) Start() error {
 	return e.client.ContainerExecStart(e.ctx, e.ExecID, e.execStartCheck)
 }
+
+// Attaches to the exec, starting it. The caller has to close the returned connection.
+func (e Exec) Attach() (types.HijackedResponse, error) {
+	return e.client.ContainerExecAttach(e.ctx, e.ExecID, e.execStartCheck)
+}
+
+// Returns the exit code of the exec, it is only valid after the exec has finished.
+func (e Exec) ExitCode() (int, error) {
+	inspect, err := e.client.ContainerExecInspect(e.ctx, e.ExecID)
+	if err != nil {
+		return 0, err
+	}
+
+	return inspect.ExitCode, nil
+}
@@ -0,0 +1,167 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	NetworkDriverBridge  = "bridge"
+	NetworkDriverMacvlan = "macvlan"
+	NetworkDriverIpvlan  = "ipvlan"
+)
+
+var (
+	ErrNetworkParentRequired = errors.New("parent interface is required")
+	ErrNetworkSubnetRequired = errors.New("subnet is required for static addresses")
+
+	macvlanModes = []string{"bridge", "vepa", "private", "passthru"}
+	ipvlanModes  = []string{"l2", "l3", "l3s"}
+)
+
+// NetworkOptions configures the creation of a network and the endpoint of the container on it,
+// macvlan and ipvlan networks expose the container as a host of the network of the parent interface.
+type NetworkOptions struct {
+	// Options are passed to the network driver as is
+	Options map[string]string `json:"options,omitempty"`
+	Driver  string            `json:"driver,omitempty"`
+	// Parent is the host interface of macvlan and ipvlan networks, eg. eth0 or eth0.10 for a VLAN
+	Parent  string `json:"parent,omitempty"`
+	Subnet  string `json:"subnet,omitempty"`
+	Gateway string `json:"gateway,omitempty"`
+	IPRange string `json:"ipRange,omitempty"`
+	// Mode is the macvlan_mode or ipvlan_mode of the network
+	Mode string `json:"mode,omitempty"`
+	// IPAddress is the static IPv4 address of the container on the network
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+func (o *NetworkOptions) isVirtualLAN() bool {
+	return o.Driver == NetworkDriverMacvlan || o.Driver == NetworkDriverIpvlan
+}
+
+// ValidateNetworkOptions checks the options of a network before anything is created
+func ValidateNetworkOptions(name string, options *NetworkOptions) error {
+	if err := validateNetworkDriver(options); err != nil {
+		return fmt.Errorf("invalid network %s: %w", name, err)
+	}
+
+	if err := validateNetworkAddressing(options); err != nil {
+		return fmt.Errorf("invalid network %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func validateNetworkDriver(options *NetworkOptions) error {
+	if options.isVirtualLAN() && options.Parent == "" && options.Options["parent"] == "" {
+		return fmt.Errorf("%s: %w", options.Driver, ErrNetworkParentRequired)
+	}
+
+	if options.Mode != "" {
+		switch options.Driver {
+		case NetworkDriverMacvlan:
+			if !slices.Contains(macvlanModes, options.Mode) {
+				return fmt.Errorf("invalid macvlan mode %s, expected one of %v", options.Mode, macvlanModes)
+			}
+		case NetworkDriverIpvlan:
+			if !slices.Contains(ipvlanModes, options.Mode) {
+				return fmt.Errorf("invalid ipvlan mode %s, expected one of %v", options.Mode, ipvlanModes)
+			}
+		default:
+			return fmt.Errorf("mode is only supported by macvlan and ipvlan networks, not by %s", options.Driver)
+		}
+	}
+
+	return nil
+}
+
+func validateNetworkAddressing(options *NetworkOptions) error {
+	if options.Subnet == "" {
+		if options.Gateway != "" || options.IPRange != "" || options.IPAddress != "" {
+			return ErrNetworkSubnetRequired
+		}
+
+		return nil
+	}
+
+	subnet, err := netip.ParsePrefix(options.Subnet)
+	if err != nil {
+		return fmt.Errorf("invalid subnet: %w", err)
+	}
+
+	for _, it := range []struct{ field, address string }{
+		{"gateway", options.Gateway},
+		{"ip address", options.IPAddress},
+	} {
+		field, address := it.field, it.address
+		if address == "" {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", field, err)
+		}
+		if !subnet.Contains(addr) {
+			return fmt.Errorf("%s %s is outside of the subnet %s", field, address, options.Subnet)
+		}
+	}
+
+	if options.IPRange != "" {
+		ipRange, err := netip.ParsePrefix(options.IPRange)
+		if err != nil {
+			return fmt.Errorf("invalid ip range: %w", err)
+		}
+		if !subnet.Contains(ipRange.Addr()) || ipRange.Bits() < subnet.Bits() {
+			return fmt.Errorf("ip range %s is outside of the subnet %s", options.IPRange, options.Subnet)
+		}
+	}
+
+	if options.IPAddress != "" && options.IPAddress == options.Gateway {
+		return fmt.Errorf("ip address %s is the gateway of the network", options.IPAddress)
+	}
+
+	return nil
+}
+
+// networkCreateOptions maps the options to the engine's network create request
+func networkCreateOptions(options *NetworkOptions) types.NetworkCreate {
+	createOptions := types.NetworkCreate{
+		CheckDuplicate: true,
+		Driver:         options.Driver,
+	}
+
+	driverOptions := map[string]string{}
+	for key, value := range options.Options {
+		driverOptions[key] = value
+	}
+	if options.Parent != "" {
+		driverOptions["parent"] = options.Parent
+	}
+	if options.Mode != "" {
+		driverOptions[options.Driver+"_mode"] = options.Mode
+	}
+	if len(driverOptions) > 0 {
+		createOptions.Options = driverOptions
+	}
+
+	if options.Subnet != "" {
+		createOptions.IPAM = &network.IPAM{
+			Config: []network.IPAMConfig{
+				{
+					Subnet:  options.Subnet,
+					Gateway: options.Gateway,
+					IPRange: options.IPRange,
+				},
+			},
+		}
+	}
+
+	return createOptions
+}
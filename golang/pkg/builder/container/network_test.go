@@ -0,0 +1,86 @@
+//go:build unit
+// +build unit
+
+package container_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/network"
+	"github.com/stretchr/testify/assert"
+
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+func TestValidateNetworkOptions(t *testing.T) {
+	valid := containerbuilder.NetworkOptions{
+		Driver:    containerbuilder.NetworkDriverMacvlan,
+		Parent:    "eth0",
+		Subnet:    "192.168.1.0/24",
+		Gateway:   "192.168.1.1",
+		IPRange:   "192.168.1.128/25",
+		Mode:      "bridge",
+		IPAddress: "192.168.1.200",
+	}
+	assert.NoError(t, containerbuilder.ValidateNetworkOptions("lan", &valid))
+
+	testCases := []struct {
+		modify func(options *containerbuilder.NetworkOptions)
+		err    string
+	}{
+		{func(o *containerbuilder.NetworkOptions) { o.Parent = "" }, "parent interface is required"},
+		{func(o *containerbuilder.NetworkOptions) { o.Mode = "l2" }, "invalid macvlan mode l2"},
+		{func(o *containerbuilder.NetworkOptions) {
+			o.Driver, o.Mode = containerbuilder.NetworkDriverIpvlan, "l3s"
+		}, ""},
+		{func(o *containerbuilder.NetworkOptions) {
+			o.Driver, o.Parent = containerbuilder.NetworkDriverBridge, ""
+		}, "mode is only supported"},
+		{func(o *containerbuilder.NetworkOptions) { o.Subnet = "" }, "subnet is required"},
+		{func(o *containerbuilder.NetworkOptions) { o.Subnet = "192.168.1.0" }, "invalid subnet"},
+		{func(o *containerbuilder.NetworkOptions) { o.IPAddress = "10.0.0.5" }, "ip address 10.0.0.5 is outside of the subnet"},
+		{func(o *containerbuilder.NetworkOptions) { o.Gateway = "192.168.2.1" }, "gateway 192.168.2.1 is outside of the subnet"},
+		{func(o *containerbuilder.NetworkOptions) { o.IPRange = "192.168.0.0/16" }, "ip range 192.168.0.0/16 is outside"},
+		{func(o *containerbuilder.NetworkOptions) { o.IPAddress = "192.168.1.1" }, "is the gateway of the network"},
+	}
+
+	for i, tc := range testCases {
+		options := valid
+		tc.modify(&options)
+
+		err := containerbuilder.ValidateNetworkOptions("lan", &options)
+		if tc.err == "" {
+			assert.NoErrorf(t, err, "test case %d", i)
+		} else {
+			assert.ErrorContainsf(t, err, tc.err, "test case %d", i)
+		}
+	}
+}
+
+func TestNetworkCreateOptions(t *testing.T) {
+	options := containerbuilder.NetworkOptions{
+		Driver:  containerbuilder.NetworkDriverIpvlan,
+		Parent:  "eth0.10",
+		Mode:    "l2",
+		Subnet:  "10.10.0.0/16",
+		Gateway: "10.10.0.1",
+		Options: map[string]string{"com.docker.network.driver.mtu": "1400"},
+	}
+
+	createOptions := containerbuilder.NetworkCreateOptions(&options)
+
+	assert.True(t, createOptions.CheckDuplicate)
+	assert.Equal(t, "ipvlan", createOptions.Driver)
+	assert.Equal(t, map[string]string{
+		"com.docker.network.driver.mtu": "1400",
+		"parent":                        "eth0.10",
+		"ipvlan_mode":                   "l2",
+	}, createOptions.Options)
+	assert.Equal(t, []network.IPAMConfig{{Subnet: "10.10.0.0/16", Gateway: "10.10.0.1"}}, createOptions.IPAM.Config)
+	assert.Equal(t, map[string]string{"com.docker.network.driver.mtu": "1400"}, options.Options, "driver options are copied")
+
+	defaults := containerbuilder.NetworkCreateOptions(&containerbuilder.NetworkOptions{})
+	assert.Empty(t, defaults.Driver)
+	assert.Nil(t, defaults.Options)
+	assert.Nil(t, defaults.IPAM)
+}
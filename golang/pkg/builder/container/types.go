@@ -3,10 +3,13 @@
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/AlekSi/pointer"
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
@@ -16,7 +19,7 @@
 // ExposedPort is the port in the container, while PortBinding is the port
 // on the host.
 type PortBinding struct {
-	PortBinding *uint16 `json:"portBinding" binding:"gte=0,lte=65535"`
+	PortBinding *uint16 `json:"portBinding" binding:"omitempty,gte=0,lte=65535"`
 	ExposedPort uint16  `json:"exposedPort" binding:"required,gte=0,lte=65535"`
 }
 
@@ -51,8 +54,104 @@ type ParentContainer struct {
 // 'containerId' can be nil depending on the hook.
 type LifecycleFunc func(ctx context.Context, client client.APIClient, cont ParentContainer) error
 
+// Metadata is the standard dyrector.io metadata of a container, the builder applies it as labels
+// and OCI annotations, together with the hash of the container configuration.
+type Metadata struct {
+	DeploymentID string
+	VersionName  string
+	TriggeredBy  string
+}
+
+// Spec is the configuration of a container as it is given to a Builder
+type Spec struct {
+	Labels           map[string]string
+	User             *int64
+	Name             string
+	Image            string
+	Hostname         string
+	NetworkMode      string
+	WorkingDirectory string
+	RestartPolicy    container.RestartPolicyMode
+	Env              []string
+	Entrypoint       []string
+	Cmd              []string
+	Networks         []string
+	NetworkAliases   []string
+	ExtraHosts       []string
+	PortBindings     []PortBinding
+	PortRanges       []PortRangeBinding
+	Mounts           []mount.Mount
+	Volumes          []volume.CreateOptions
+}
+
 // WaitResult with the status code from the container
 type WaitResult struct {
+	// Error is reported by the engine if the container could not be waited for properly
+	Error      string
 	Logs       []string
 	StatusCode int64
+	// OOMKilled is true if the kernel killed the container for running out of memory
+	OOMKilled bool
+}
+
+// Signal returns the name of the signal which ended the container, see ExitSignal
+func (r *WaitResult) Signal() string {
+	return ExitSignal(r.StatusCode)
+}
+
+// RunResult is the captured output of a one-shot container started by RunAndCapture
+type RunResult struct {
+	Name      string
+	Stdout    string
+	Stderr    string
+	ExitCode  int64
+	OOMKilled bool
+}
+
+// Signal returns the name of the signal which ended the container, see ExitSignal
+func (r *RunResult) Signal() string {
+	return ExitSignal(r.ExitCode)
+}
+
+// exit codes above this are the signal number plus this, by the convention of the shells and the engine
+const signalExitCodeBase = 128
+
+var signalNames = map[int64]string{
+	1:  "SIGHUP",
+	2:  "SIGINT",
+	3:  "SIGQUIT",
+	6:  "SIGABRT",
+	9:  "SIGKILL",
+	11: "SIGSEGV",
+	13: "SIGPIPE",
+	15: "SIGTERM",
+}
+
+// ExitSignal returns the name of the signal for the exit codes of the killed processes, eg. SIGKILL for 137,
+// it is empty for the regular exit codes
+func ExitSignal(exitCode int64) string {
+	if exitCode <= signalExitCodeBase {
+		return ""
+	}
+
+	signal := exitCode - signalExitCodeBase
+	if name, ok := signalNames[signal]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("signal %d", signal)
+}
+
+// Lines returns the non-empty lines of stdout followed by the ones of stderr
+func (r *RunResult) Lines() []string {
+	lines := []string{}
+	for _, output := range []string{r.Stdout, r.Stderr} {
+		for _, line := range strings.Split(output, "\n") {
+			if line = strings.TrimRight(line, "\r"); strings.TrimSpace(line) != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	return lines
 }
@@ -10,6 +10,7 @@
 	"github.com/docker/docker/api/types/registry"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
 
 	"github.com/rs/zerolog/log"
 )
@@ -31,6 +32,15 @@ func registryAuthBase64(user, password string) string {
 	return base64.URLEncoding.EncodeToString(encodedJSON)
 }
 
+// EncodeRegistryAuth encodes the credentials the way the engine expects them in pull requests
+func EncodeRegistryAuth(auth *imageHelper.RegistryAuth) string {
+	if auth == nil {
+		return ""
+	}
+
+	return registryAuthBase64(auth.User, auth.Password)
+}
+
 type defaultLogger struct {
 	dogger.LogWriter
 }
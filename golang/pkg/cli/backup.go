@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+
+	dockerhelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+)
+
+const (
+	BackupCommand  = "backup"
+	RestoreCommand = "restore"
+)
+
+const (
+	FlagBackupDir = "dir"
+)
+
+// the CA bundle of the postgres image, libpq of the image does not know the system store
+const postgresClientRootCert = "/etc/ssl/certs/ca-certificates.crt"
+
+func backupDirFlag() ucli.Flag {
+	return &ucli.StringFlag{
+		Name:  FlagBackupDir,
+		Value: "",
+		Usage: "directory of the backups, defaults to the backups directory next to the settings",
+	}
+}
+
+var ErrNothingToBackUp = errors.New("there is no database to back up, start the stack first")
+
+// encryptionKeyHash identifies the encryption key of crux in the backups without storing it, the secrets in the
+// database of crux can only be read with the key they were encrypted with
+func encryptionKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// postgresClientEnv are the libpq variables of the external database, so the tools reach it without arguments
+func postgresClientEnv(connection *postgresConnection) []string {
+	env := []string{
+		fmt.Sprintf("PGHOST=%s", connection.host),
+		fmt.Sprintf("PGPORT=%d", connection.port),
+		fmt.Sprintf("PGUSER=%s", connection.user),
+		fmt.Sprintf("PGPASSWORD=%s", connection.password),
+		fmt.Sprintf("PGDATABASE=%s", connection.database),
+		fmt.Sprintf("PGSSLMODE=%s", connection.sslMode),
+	}
+	if connection.sslMode == PostgresSSLVerifyCA || connection.sslMode == PostgresSSLVerifyFull {
+		env = append(env, fmt.Sprintf("PGSSLROOTCERT=%s", postgresClientRootCert))
+	}
+
+	return env
+}
+
+// startPostgresClient starts a container of the postgres image of the stack for the tools of an external database,
+// the tools have to support the version of the server, the image can be pinned by images.postgres
+// or given by the clientImage of the external database
+func startPostgresClient(state *State, args *ArgsFlags, target postgresTarget) (string, error) {
+	cont, err := baseContainer(state.Ctx, args).
+		WithImage(util.Fallback(target.clientImage, state.serviceImage(postgresService))).
+		WithName(target.container).
+		WithEnv(postgresClientEnv(target.external)).
+		WithNetworks([]string{state.SettingsFile.Network}).
+		WithEntrypoint([]string{"sleep", "infinity"}).
+		WithLabels(map[string]string{
+			"com.docker.compose.project":                args.Prefix,
+			"com.docker.compose.service":                target.container,
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		}).
+		CreateAndStart()
+	if err != nil {
+		return "", err
+	}
+
+	return *cont.GetContainerID(), nil
+}
+
+// withPostgres runs the function with the container the postgres tools of the target run in, the bundled database
+// itself or a client container of the external one, it is false if the bundled database does not exist
+func withPostgres(ctx context.Context, cli *client.Client, state *State, args *ArgsFlags, target postgresTarget,
+	run func(containerID string) error,
+) (bool, error) {
+	if target.external != nil {
+		containerID, err := startPostgresClient(state, args, target)
+		if err != nil {
+			return false, fmt.Errorf("failed to start the client of the external database %s: %w", target.id, err)
+		}
+		defer logdefer.LogDeferredErr(func() error {
+			return dockerhelper.DeleteContainerByName(ctx, cli, target.container)
+		}, log.Warn(), "failed to remove the client of the external database")
+
+		return true, run(containerID)
+	}
+
+	cont, err := dockerhelper.GetContainerByName(ctx, cli, target.container)
+	if err != nil {
+		return false, err
+	}
+	if cont == nil {
+		log.Warn().Str("container", target.container).Msg("Database container doesn't exist")
+		return false, nil
+	}
+	if cont.State != "running" {
+		return false, fmt.Errorf("database container %s is %s, start the stack first", target.container, cont.State)
+	}
+
+	return true, run(cont.ID)
+}
+
+// readBackupMeta reads the metadata of the backup directory
+func readBackupMeta(dir string) (*backupMeta, error) {
+	data, err := os.ReadFile(path.Join(dir, backupMetaFile)) //#nosec G304 -- backups are created by the backup and upgrade commands
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup metadata: %w", err)
+	}
+
+	meta := &backupMeta{}
+	if err = yaml.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("failed to parse backup metadata: %w", err)
+	}
+
+	return meta, nil
+}
+
+// resolveBackup is the backup given by its name in the backup root or by its path, or else the latest one
+// taken by dyo backup, the ones of the upgrade are restored by dyo upgrade --rollback
+func resolveBackup(args *ArgsFlags) (string, *backupMeta, error) {
+	if args.Backup == "" {
+		return latestBackup(backupRoot(args), backupOriginManual)
+	}
+
+	dir := args.Backup
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		dir = path.Join(backupRoot(args), args.Backup)
+	}
+
+	meta, err := readBackupMeta(dir)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", dir, err)
+	}
+
+	return dir, meta, nil
+}
+
+// backupStack dumps the databases of the running stack, the external ones too, into a new backup
+func backupStack(ctx context.Context, initialState *State, args *ArgsFlags) (*backupResult, error) {
+	state, err := SettingsFileDefaults(initialState, args)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	result, err := backupDatabases(ctx, cli, state, args, stackPostgresTargets(state), state.SettingsFile.Version, backupOriginManual)
+	if err != nil {
+		return nil, fmt.Errorf("failed to back up the databases: %w", err)
+	}
+	if len(result.Databases) == 0 {
+		return nil, ErrNothingToBackUp
+	}
+
+	return result, nil
+}
+
+// restoreStack stops the stack, restores the databases from the backup, then starts the stack again, the stack
+// can be a freshly created one, its version is kept and its migrations run against the restored databases
+func restoreStack(ctx context.Context, initialState *State, stack *dyrectorioStack, args *ArgsFlags) (*backupResult, error) {
+	dir, meta, err := resolveBackup(args)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := SettingsFileDefaults(initialState, args)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckSettings(state, args); err != nil {
+		return nil, err
+	}
+
+	if meta.Version != state.SettingsFile.Version {
+		log.Warn().Str("backup", meta.Version).Str("stack", state.SettingsFile.Version).
+			Msg("The backup was taken from an other version, the migrations of the stack run against it")
+	}
+	if meta.EncryptionKeyHash != "" && meta.EncryptionKeyHash != encryptionKeyHash(state.SettingsFile.CruxEncryptionKey) {
+		log.Warn().Msg("The backup was taken with an other crux-encryption-key, set it with dyo config set crux-encryption-key, " +
+			"or the secrets stored by crux can not be read")
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	if err = addStackBuilders(stack, state, args); err != nil {
+		return nil, err
+	}
+	if err = prePullImages(ctx, stackImages(state, args), args); err != nil {
+		return nil, fmt.Errorf("failed to pull the images of the stack: %w", err)
+	}
+
+	// the services are stopped, so nothing holds a connection while the dumps are restored
+	if err = stopStack(ctx, cli, args.Prefix, state.SettingsFile.StopGracePeriods); err != nil {
+		return nil, fmt.Errorf("failed to stop the stack before restoring the databases: %w", err)
+	}
+	if _, err = startStackItems(ctx, cli, stack, []stackItemID{cruxPostgres, kratosPostgres}); err != nil {
+		return nil, startFailure(err)
+	}
+
+	log.Info().Str("backup", dir).Msg("Restoring the databases")
+	result, err := restoreDatabases(ctx, cli, state, args, stackPostgresTargets(state), dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore the databases from %s: %w", dir, err)
+	}
+
+	if err = StartContainers(ctx, stack); err != nil {
+		return nil, err
+	}
+	PrintInfo(state, args)
+
+	return result, nil
+}
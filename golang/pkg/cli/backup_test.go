@@ -0,0 +1,82 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func createManualBackup(t *testing.T, root, name, version string) {
+	t.Helper()
+
+	assert.NoError(t, os.MkdirAll(path.Join(root, name), 0o750))
+	assert.NoError(t, os.WriteFile(path.Join(root, name, "backup.yaml"),
+		[]byte("version: "+version+"\norigin: manual\n"), 0o600))
+}
+
+func TestResolveBackup(t *testing.T) {
+	root := t.TempDir()
+	createManualBackup(t, root, "20240101T100000Z-0.10.0", "0.10.0")
+	createManualBackup(t, root, "20240301T100000Z-0.11.0", "0.11.0")
+	// the backup of the upgrade is not restored without its name
+	createBackup(t, root, "20240401T100000Z-0.11.0", "0.11.0")
+
+	dir, version, err := cli.ResolveBackupVersion(&cli.ArgsFlags{BackupDir: root})
+	assert.NoError(t, err)
+	assert.Equal(t, path.Join(root, "20240301T100000Z-0.11.0"), dir)
+	assert.Equal(t, "0.11.0", version)
+
+	dir, version, err = cli.ResolveBackupVersion(&cli.ArgsFlags{BackupDir: root, Backup: "20240101T100000Z-0.10.0"})
+	assert.NoError(t, err)
+	assert.Equal(t, path.Join(root, "20240101T100000Z-0.10.0"), dir)
+	assert.Equal(t, "0.10.0", version)
+
+	// a path is taken as it is
+	dir, _, err = cli.ResolveBackupVersion(&cli.ArgsFlags{Backup: path.Join(root, "20240101T100000Z-0.10.0")})
+	assert.NoError(t, err)
+	assert.Equal(t, path.Join(root, "20240101T100000Z-0.10.0"), dir)
+
+	_, _, err = cli.ResolveBackupVersion(&cli.ArgsFlags{BackupDir: root, Backup: "missing"})
+	assert.Error(t, err)
+
+	_, _, err = cli.ResolveBackupVersion(&cli.ArgsFlags{BackupDir: t.TempDir()})
+	assert.ErrorIs(t, err, cli.ErrNoBackup)
+
+	upgradeOnly := t.TempDir()
+	createBackup(t, upgradeOnly, "20240401T100000Z-0.11.0", "0.11.0")
+	_, _, err = cli.ResolveBackupVersion(&cli.ArgsFlags{BackupDir: upgradeOnly})
+	assert.ErrorIs(t, err, cli.ErrNoBackup)
+}
+
+func TestPostgresClientsOfExternalDatabases(t *testing.T) {
+	args := &cli.ArgsFlags{Prefix: "dyo-stable"}
+	state := &cli.State{Ctx: context.Background(), Containers: &cli.Containers{}}
+	state.SettingsFile.CruxPostgresUser = "crux"
+	state.SettingsFile.CruxPostgresDB = "crux"
+	state.SettingsFile.CruxExternalPostgres = cli.ExternalPostgres{
+		Host:     "db.example.com",
+		Password: "secret",
+		SSLMode:  cli.PostgresSSLVerifyFull,
+	}
+	state = cli.LoadDefaultsOnEmpty(state, args)
+
+	clients := cli.PostgresClients(state)
+	assert.Len(t, clients, 1)
+	assert.ElementsMatch(t, []string{
+		"PGHOST=db.example.com",
+		"PGPORT=5432",
+		"PGUSER=crux",
+		"PGPASSWORD=secret",
+		"PGDATABASE=crux",
+		"PGSSLMODE=verify-full",
+		"PGSSLROOTCERT=/etc/ssl/certs/ca-certificates.crt",
+	}, clients["dyo-stable_crux-postgres-client"])
+}
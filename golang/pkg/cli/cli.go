@@ -2,7 +2,10 @@
 package cli
 
 import (
-	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
 
 	"github.com/rs/zerolog"
 	ucli "github.com/urfave/cli/v2"
@@ -25,6 +28,21 @@
 	FlagExpectContainerEnv = "expect-container-env"
 	FlagNetwork            = "network"
 	FlagEnvFile            = "env-file"
+	FlagMacOS              = "macos"
+	FlagWatchInterval      = "interval"
+	FlagRestartPolicy      = "restart-policy"
+	FlagMaxRestarts        = "max-restarts"
+	FlagNotifyWebhook      = "notify-webhook"
+	FlagDesktopNotify      = "desktop-notify"
+	FlagLocked             = "locked"
+	FlagSudoHelper         = "sudo-helper"
+	FlagOpen               = "open"
+	FlagRuntime            = "runtime"
+)
+
+const (
+	defaultWatchInterval = 10 * time.Second
+	defaultMaxRestarts   = 5
 )
 
 // InitCLI returns the configuration flags of the program
@@ -43,19 +61,132 @@ func InitCLI() *ucli.App {
 				Aliases: []string{"u"},
 				Usage:   "Run the stack",
 				Action:  run,
+				Flags: append([]ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagOpen,
+						Value: false,
+						Usage: "wait for the UI to be ready, then open it in the default browser",
+					},
+				}, offlineFlags()...),
 			},
 			{
 				Name:    DownCommand,
 				Aliases: []string{"d"},
 				Usage:   "Stop the stack",
 				Action:  run,
+				Flags: []ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagVolumes,
+						Value: false,
+						Usage: "remove the volumes of the stack too, its databases are lost",
+					},
+				},
+			},
+			{
+				Name:    WatchCommand,
+				Aliases: []string{"w"},
+				Usage:   "Run the stack and keep supervising it, restarting crashed containers",
+				Action:  run,
+				Flags: append([]ucli.Flag{
+					&ucli.DurationFlag{
+						Name:  FlagWatchInterval,
+						Value: defaultWatchInterval,
+						Usage: "how often the health of the containers is checked",
+					},
+					&ucli.StringFlag{
+						Name:  FlagRestartPolicy,
+						Value: RestartPolicyOnFailure,
+						Usage: fmt.Sprintf("restart crashed containers (%s), crashed and unhealthy containers (%s) or none (%s)",
+							RestartPolicyOnFailure, RestartPolicyUnhealthy, RestartPolicyNever),
+					},
+					&ucli.UintFlag{
+						Name:  FlagMaxRestarts,
+						Value: defaultMaxRestarts,
+						Usage: "maximum number of restarts per container, before giving up on it",
+					},
+					&ucli.StringFlag{
+						Name:    FlagNotifyWebhook,
+						Value:   "",
+						Usage:   "URL receiving the health events as JSON POST requests",
+						EnvVars: []string{"DYO_NOTIFY_WEBHOOK"},
+					},
+					&ucli.BoolFlag{
+						Name:  FlagDesktopNotify,
+						Value: false,
+						Usage: "show health events as desktop notifications",
+					},
+				}, offlineFlags()...),
+			},
+			{
+				Name:  UpgradeCommand,
+				Usage: "Back up the databases, pull the version given by --image-tag, then recreate the outdated containers",
+				Flags: append([]ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagRollback,
+						Value: false,
+						Usage: "restore the latest database backup and the version it was taken from",
+					},
+				}, offlineFlags()...),
+				Action: run,
+			},
+			{
+				Name:   BackupCommand,
+				Usage:  "Dump the databases of the stack, the external ones too, into a new timestamped backup",
+				Flags:  []ucli.Flag{backupDirFlag()},
+				Action: run,
+			},
+			{
+				Name:      RestoreCommand,
+				Usage:     "Stop the stack, restore its databases from the backup, the latest one by default, then start it again",
+				ArgsUsage: "[backup]",
+				Flags:     []ucli.Flag{backupDirFlag()},
+				Action:    run,
+			},
+			{
+				Name:   LockCommand,
+				Usage:  "Resolve the images of the stack to digests and record them in a lockfile next to the settings",
+				Action: run,
+			},
+			{
+				Name:    StatusCommand,
+				Aliases: []string{"ps"},
+				Usage:   "List the containers of the stack with their state, health, version and published ports",
+				Flags: []ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagStatusJSON,
+						Value: false,
+						Usage: "print the status as JSON, shorthand of --format json",
+					},
+				},
+				Before: func(cCtx *ucli.Context) error {
+					return setupOutput(outputFormat(cCtx), os.Stdout)
+				},
+				Action: run,
 			},
 			{
 				Name:    VersionCommand,
 				Aliases: []string{"v"},
 				Action:  run,
 			},
+			GetSaveCommand(),
 			GetGenerateCommand(),
+			GetDebugCommand(),
+			GetDoctorCommand(),
+			GetConfigCommand(),
+			GetSettingsCommand(),
+			GetServeCommand(),
+			GetStatsCommand(),
+			GetNodeCommand(),
+			GetLogsCommand(),
+			GetVolumeCommand(),
+		},
+		// the clients of every command are created from the environment, so it is set up for the runtime first
+		Before: func(cCtx *ucli.Context) error {
+			if err := setupOutput(cCtx.String(FlagFormat), os.Stdout); err != nil {
+				return err
+			}
+
+			return selectRuntime(cCtx.String(FlagRuntime))
 		},
 		Flags: []ucli.Flag{
 			&ucli.BoolFlag{
@@ -107,7 +238,7 @@ func InitCLI() *ucli.App {
 				Name:        FlagConfigPath,
 				Aliases:     []string{"c"},
 				Value:       "",
-				DefaultText: SettingsPath(),
+				DefaultText: defaultSettingsPath(),
 				Usage:       "persisted configuration path",
 				Required:    false,
 				EnvVars:     []string{"DYO_CONFIG"},
@@ -121,10 +252,10 @@ func InitCLI() *ucli.App {
 			},
 			&ucli.StringFlag{
 				Name:        FlagPrefix,
-				Value:       "dyo-stable",
+				Value:       DefaultPrefix,
 				Aliases:     []string{"p"},
-				DefaultText: "dyo-stable",
-				Usage:       "prefix that is preprended to container names",
+				DefaultText: DefaultPrefix,
+				Usage:       "prefix of the container, network and volume names, each prefix has its own settings file, so stacks can run side by side",
 				Required:    false,
 				EnvVars:     []string{"PREFIX"},
 			},
@@ -154,22 +285,83 @@ func InitCLI() *ucli.App {
 				Value:   "",
 				Usage:   "loads the environment variables into all containers from the specified .env file",
 			},
+			&ucli.BoolFlag{
+				Name:     FlagMacOS,
+				Value:    runtime.GOOS == "darwin",
+				Usage:    "handles Docker Desktop's VM networking and file sharing, enabled by default on macOS",
+				Required: false,
+				EnvVars:  []string{"DYO_MACOS"},
+			},
+			&ucli.BoolFlag{
+				Name:     FlagLocked,
+				Value:    false,
+				Usage:    "create the containers strictly from the digests recorded by the lock command",
+				Required: false,
+				EnvVars:  []string{"DYO_LOCKED"},
+			},
+			&ucli.BoolFlag{
+				Name:     FlagSudoHelper,
+				Value:    false,
+				Usage:    "re-run the command with sudo, when the docker socket is not accessible for the user",
+				Required: false,
+				EnvVars:  []string{"DYO_SUDO_HELPER"},
+			},
+			&ucli.BoolFlag{
+				Name:     FlagNoHosts,
+				Value:    false,
+				Usage:    "leave the hosts file alone, the local domain of the stack is not added on up and not removed on down",
+				Required: false,
+				EnvVars:  []string{"DYO_NO_HOSTS"},
+			},
+			&ucli.StringFlag{
+				Name:     FlagRuntime,
+				Value:    RuntimeAuto,
+				Usage:    "container runtime of the stack: auto, docker or podman, auto prefers docker if both are running",
+				Required: false,
+				EnvVars:  []string{"DYO_RUNTIME"},
+			},
+			&ucli.StringFlag{
+				Name:     FlagFormat,
+				Value:    OutputText,
+				Usage:    "format of the output: text or json, where every event and the result of the command is a json line",
+				Required: false,
+				EnvVars:  []string{"DYO_FORMAT"},
+			},
+			&ucli.GenericFlag{
+				Name:  FlagSettingsOverride,
+				Value: &settingsOverrideFlag{},
+				Usage: "override a key of the settings file without writing it, eg. --set traefikWebPort=8001, can be repeated, " +
+					"the flags take precedence over the DYO_ environment variables of the keys, eg. DYO_TRAEFIK_WEB_PORT",
+			},
 		},
 	}
 }
 
+// defaultSettingsPath is shown in the help, without a configuration dir there is no default
+func defaultSettingsPath() string {
+	settingsPath, err := SettingsPath()
+	if err != nil {
+		return ""
+	}
+
+	return settingsPath
+}
+
 func run(cCtx *ucli.Context) error {
 	zerolog.SetGlobalLevel(zerolog.InfoLevel)
 	if cCtx.Bool(FlagDebug) {
 		zerolog.SetGlobalLevel(zerolog.DebugLevel)
 	}
 
+	settingsPath, err := settingsLocation(cCtx)
+	if err != nil {
+		return err
+	}
+
 	args := ArgsFlags{
 		SettingsWrite:      cCtx.Bool(FlagWrite),
-		SettingsFilePath:   SettingsFileLocation(cCtx.String(FlagConfigPath)),
-		SettingsExists:     SettingsExists(cCtx.String(FlagConfigPath)),
+		SettingsFilePath:   settingsPath,
 		ImageTag:           cCtx.String(FlagImageTag),
-		Prefix:             cCtx.String(FlagPrefix),
 		PreferLocalImages:  cCtx.Bool(FlagPreferLocalImages),
 		FullyContainerized: cCtx.Bool(FlagExpectContainerEnv),
 		Network:            cCtx.String(FlagNetwork),
@@ -179,14 +371,56 @@ func run(cCtx *ucli.Context) error {
 		LocalAgent:         cCtx.Bool(FlagLocalAgent),
 		Command:            cCtx.Command.Name,
 		EnvFile:            cCtx.String(FlagEnvFile),
+		MacOS:              cCtx.Bool(FlagMacOS),
+		WatchInterval:      cCtx.Duration(FlagWatchInterval),
+		WatchRestartPolicy: cCtx.String(FlagRestartPolicy),
+		WatchMaxRestarts:   cCtx.Uint(FlagMaxRestarts),
+		NotifyWebhook:      cCtx.String(FlagNotifyWebhook),
+		DesktopNotify:      cCtx.Bool(FlagDesktopNotify),
+		Locked:             cCtx.Bool(FlagLocked),
+		SudoHelper:         cCtx.Bool(FlagSudoHelper),
+		NoHosts:            cCtx.Bool(FlagNoHosts),
+		Rollback:           cCtx.Bool(FlagRollback),
+		Open:               cCtx.Bool(FlagOpen),
+		Runtime:            cCtx.String(FlagRuntime),
+		RemoveVolumes:      cCtx.Bool(FlagVolumes),
+		GenerateOutput:     cCtx.String(FlagGenerateOutput),
+		KubeHost:           cCtx.String(FlagKubernetesHost),
+		KubeNamespace:      cCtx.String(FlagKubernetesNamespace),
+		KubeStorage:        cCtx.String(FlagKubernetesStorage),
+		Output:             outputFormat(cCtx),
+		Offline:            cCtx.Bool(FlagOffline),
+		Bundle:             cCtx.String(FlagBundle),
+		Backup:             cCtx.Args().First(),
+		BackupDir:          cCtx.String(FlagBackupDir),
+		SettingsOverrides:  settingsOverrideFlags(cCtx),
 	}
+	if args.SettingsExists, err = SettingsExists(args.SettingsFilePath); err != nil {
+		return err
+	}
+	prefix, err := stackPrefix(cCtx, &args)
+	if err != nil {
+		return err
+	}
+	args.Prefix = prefix
+
+	// the containers created by an interrupted run are removed, so the builders use the same context
+	ctx, cancel := interruptContext(cCtx.Context)
+	defer cancel()
 
 	initialState := State{
-		Ctx:        context.Background(),
+		Ctx:        ctx,
 		Containers: &Containers{},
 	}
 
-	ProcessCommand(cCtx.Context, &initialState, &args)
+	if err = checkDockerAccess(ctx, &args); err != nil {
+		return err
+	}
+
+	err = ProcessCommand(ctx, &initialState, &args)
+	if args.Output == OutputJSON {
+		return writeCommandResult(os.Stdout, args.Command, initialState.Result, err)
+	}
 
-	return nil
+	return err
 }
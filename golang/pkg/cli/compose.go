@@ -0,0 +1,347 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+const (
+	ComposeCommand = "compose"
+)
+
+const (
+	defaultComposeOutput = "docker-compose.yaml"
+	// composeLabelPrefix is of the labels compose sets itself, the ones set by dyo would conflict with them
+	composeLabelPrefix  = "com.docker.compose."
+	traefikConfigName   = "traefik-dynamic-conf"
+	traefikConfigTarget = "/etc/traefik/dynamic_conf.yml"
+)
+
+const (
+	composeServiceStarted   = "service_started"
+	composeServiceHealthy   = "service_healthy"
+	composeServiceCompleted = "service_completed_successfully"
+)
+
+// composeFile is the subset of the compose specification the stack needs
+type composeFile struct {
+	Services map[string]*composeService `yaml:"services"`
+	Networks map[string]*composeNetwork `yaml:"networks,omitempty"`
+	Volumes  map[string]*composeVolume  `yaml:"volumes,omitempty"`
+	Configs  map[string]*composeConfig  `yaml:"configs,omitempty"`
+	Name     string                     `yaml:"name"`
+}
+
+type composeService struct {
+	Labels        map[string]string                 `yaml:"labels,omitempty"`
+	Networks      map[string]*composeServiceNetwork `yaml:"networks,omitempty"`
+	DependsOn     map[string]*composeDependency     `yaml:"depends_on,omitempty"`
+	Healthcheck   *composeHealthcheck               `yaml:"healthcheck,omitempty"`
+	Image         string                            `yaml:"image"`
+	ContainerName string                            `yaml:"container_name"`
+	Hostname      string                            `yaml:"hostname,omitempty"`
+	Restart       string                            `yaml:"restart,omitempty"`
+	User          string                            `yaml:"user,omitempty"`
+	WorkingDir    string                            `yaml:"working_dir,omitempty"`
+	NetworkMode   string                            `yaml:"network_mode,omitempty"`
+	Entrypoint    []string                          `yaml:"entrypoint,omitempty"`
+	Command       []string                          `yaml:"command,omitempty"`
+	Environment   []string                          `yaml:"environment,omitempty"`
+	Ports         []string                          `yaml:"ports,omitempty"`
+	ExtraHosts    []string                          `yaml:"extra_hosts,omitempty"`
+	Volumes       []*composeMount                   `yaml:"volumes,omitempty"`
+	Configs       []*composeConfigMount             `yaml:"configs,omitempty"`
+}
+
+type composeServiceNetwork struct {
+	Aliases []string `yaml:"aliases,omitempty"`
+}
+
+type composeDependency struct {
+	Condition string `yaml:"condition"`
+}
+
+type composeHealthcheck struct {
+	Interval string   `yaml:"interval,omitempty"`
+	Test     []string `yaml:"test"`
+}
+
+type composeMount struct {
+	Type     string `yaml:"type"`
+	Source   string `yaml:"source"`
+	Target   string `yaml:"target"`
+	ReadOnly bool   `yaml:"read_only,omitempty"`
+}
+
+type composeNetwork struct {
+	Name   string `yaml:"name"`
+	Driver string `yaml:"driver"`
+}
+
+type composeVolume struct {
+	Labels     map[string]string `yaml:"labels,omitempty"`
+	DriverOpts map[string]string `yaml:"driver_opts,omitempty"`
+	Name       string            `yaml:"name"`
+	Driver     string            `yaml:"driver,omitempty"`
+}
+
+type composeConfig struct {
+	Content string `yaml:"content"`
+}
+
+type composeConfigMount struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+}
+
+// composeEscape keeps compose from interpolating the dollar signs of the values, eg. in generated passwords
+func composeEscape(value string) string {
+	return strings.ReplaceAll(value, "$", "$$")
+}
+
+func composeEscapeAll(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	escaped := make([]string, 0, len(values))
+	for _, it := range values {
+		escaped = append(escaped, composeEscape(it))
+	}
+
+	return escaped
+}
+
+// composePorts are the bindings in the short syntax, the host port is random if it is not bound
+func composePorts(spec *containerbuilder.Spec) []string {
+	ports := []string{}
+	for _, it := range spec.PortBindings {
+		if it.PortBinding == nil {
+			ports = append(ports, fmt.Sprint(it.ExposedPort))
+			continue
+		}
+
+		ports = append(ports, fmt.Sprintf("%d:%d", *it.PortBinding, it.ExposedPort))
+	}
+	for _, it := range spec.PortRanges {
+		ports = append(ports, fmt.Sprintf("%d-%d:%d-%d", it.External.From, it.External.To, it.Internal.From, it.Internal.To))
+	}
+
+	return ports
+}
+
+func composeMounts(mounts []mount.Mount) []*composeMount {
+	volumes := []*composeMount{}
+	for _, it := range mounts {
+		mountType := string(it.Type)
+		if it.Type == mount.TypeNamedPipe {
+			mountType = "npipe"
+		}
+
+		volumes = append(volumes, &composeMount{
+			Type:     mountType,
+			Source:   it.Source,
+			Target:   it.Target,
+			ReadOnly: it.ReadOnly,
+		})
+	}
+
+	return volumes
+}
+
+// composeLabels are the labels without the ones compose sets itself
+func composeLabels(labels map[string]string) map[string]string {
+	escaped := map[string]string{}
+	for key, value := range labels {
+		if !strings.HasPrefix(key, composeLabelPrefix) {
+			escaped[key] = composeEscape(value)
+		}
+	}
+
+	return escaped
+}
+
+func composeServiceOf(spec *containerbuilder.Spec) *composeService {
+	service := &composeService{
+		Labels:        composeLabels(spec.Labels),
+		Image:         spec.Image,
+		ContainerName: spec.Name,
+		Hostname:      spec.Hostname,
+		Restart:       string(spec.RestartPolicy),
+		WorkingDir:    spec.WorkingDirectory,
+		NetworkMode:   spec.NetworkMode,
+		Entrypoint:    composeEscapeAll(spec.Entrypoint),
+		Command:       composeEscapeAll(spec.Cmd),
+		Environment:   composeEscapeAll(spec.Env),
+		Ports:         composePorts(spec),
+		ExtraHosts:    spec.ExtraHosts,
+		Volumes:       composeMounts(spec.Mounts),
+	}
+	if spec.User != nil {
+		service.User = fmt.Sprint(*spec.User)
+	}
+
+	if len(spec.Networks) > 0 {
+		service.Networks = map[string]*composeServiceNetwork{}
+		for _, network := range spec.Networks {
+			service.Networks[network] = &composeServiceNetwork{Aliases: spec.NetworkAliases}
+		}
+	}
+
+	return service
+}
+
+// postgresHealthcheck is the readiness dyo probes before starting the dependents of the databases
+func postgresHealthcheck(user, database string) *composeHealthcheck {
+	return &composeHealthcheck{
+		Test:     []string{"CMD", "pg_isready", "-U", composeEscape(user), "-d", composeEscape(database)},
+		Interval: healhProbeInterval.String(),
+	}
+}
+
+// stackComposeFile renders the containers the runner would create, the migrations are one-shot services
+// the services wait for, the readiness of the databases is a healthcheck instead of the probes of dyo
+func stackComposeFile(state *State, args *ArgsFlags) (*composeFile, error) {
+	stack, err := getStackSpecs(state, args)
+	if err != nil {
+		return nil, err
+	}
+
+	compose := &composeFile{
+		Name:     args.Prefix,
+		Services: map[string]*composeService{},
+		Networks: map[string]*composeNetwork{},
+		Volumes:  map[string]*composeVolume{},
+		Configs:  map[string]*composeConfig{},
+	}
+
+	for id := range stack.specs {
+		spec := stack.specs[id]
+		service := composeServiceOf(&spec)
+		compose.Services[string(id)] = service
+
+		for _, network := range spec.Networks {
+			compose.Networks[network] = &composeNetwork{Name: network, Driver: containerNetDriver}
+		}
+		for i := range spec.Volumes {
+			vol := &spec.Volumes[i]
+			compose.Volumes[vol.Name] = &composeVolume{
+				Labels:     composeLabels(vol.Labels),
+				DriverOpts: vol.DriverOpts,
+				Name:       vol.Name,
+				Driver:     vol.Driver,
+			}
+		}
+	}
+
+	// the external databases are not services of the file
+	if service, ok := compose.Services[string(cruxPostgres)]; ok {
+		service.Healthcheck = postgresHealthcheck(state.SettingsFile.CruxPostgresUser, state.SettingsFile.CruxPostgresDB)
+	}
+	if service, ok := compose.Services[string(kratosPostgres)]; ok {
+		service.Healthcheck = postgresHealthcheck(state.SettingsFile.KratosPostgresUser, state.SettingsFile.KratosPostgresDB)
+	}
+
+	for id, service := range compose.Services {
+		dependsOn := map[string]*composeDependency{}
+		for _, dependency := range stack.dependencies[stackItemID(id)] {
+			dependent, ok := compose.Services[string(dependency)]
+			if !ok {
+				continue
+			}
+
+			condition := composeServiceStarted
+			if dependent.Healthcheck != nil {
+				condition = composeServiceHealthy
+			}
+			dependsOn[string(dependency)] = &composeDependency{Condition: condition}
+		}
+		if migration, ok := stack.migrations[stackItemID(id)]; ok {
+			dependsOn[string(migration)] = &composeDependency{Condition: composeServiceCompleted}
+		}
+
+		if len(dependsOn) > 0 {
+			service.DependsOn = dependsOn
+		}
+	}
+
+	// there is no traefik to configure, the external proxy is configured by the user
+	if state.SettingsFile.externalProxy() {
+		return compose, nil
+	}
+
+	if traefikFileProvider(state, args) {
+		content, err := renderTraefikConfiguration(state.InternalHostDomain,
+			traefikTemplatePath(state.SettingsFile.TraefikConfigTemplate, args.SettingsFilePath),
+			state.SettingsFile.CruxHTTPPort, state.SettingsFile.CruxUIPort, state.SettingsFile.TLS.Enabled())
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the Traefik configuration: %w", err)
+		}
+
+		compose.Configs[traefikConfigName] = &composeConfig{Content: composeEscape(content)}
+		compose.Services[string(traefik)].Configs = []*composeConfigMount{{Source: traefikConfigName, Target: traefikConfigTarget}}
+	}
+
+	if state.SettingsFile.TLS.Mode == TLSModeSelfSigned {
+		if err := composeTraefikTLS(compose, args); err != nil {
+			return nil, err
+		}
+	}
+
+	extraFiles, err := traefikExtraConfigFiles(traefikTemplatePath(state.SettingsFile.TraefikExtraConfigDir, args.SettingsFilePath))
+	if err != nil {
+		return nil, err
+	}
+	service := compose.Services[string(traefik)]
+	for _, name := range sortedFileNames(extraFiles) {
+		configName := "traefik-extra-" + strings.ReplaceAll(name, ".", "-")
+		compose.Configs[configName] = &composeConfig{Content: composeEscape(extraFiles[name])}
+		service.Configs = append(service.Configs, &composeConfigMount{Source: configName, Target: path.Join(traefikConfigDirectory, name)})
+	}
+
+	return compose, nil
+}
+
+// composeTraefikTLS adds the self-signed certificate and the configuration loading it to traefik as configs,
+// the file provider watching /etc/traefik picks them up
+func composeTraefikTLS(compose *composeFile, args *ArgsFlags) error {
+	files, err := traefikTLSFiles(args)
+	if err != nil {
+		return fmt.Errorf("failed to read the self-signed certificate: %w", err)
+	}
+
+	service := compose.Services[string(traefik)]
+	for _, name := range sortedFileNames(files) {
+		configName := "traefik-" + strings.ReplaceAll(path.Base(name), ".", "-")
+		compose.Configs[configName] = &composeConfig{Content: composeEscape(files[name])}
+		service.Configs = append(service.Configs, &composeConfigMount{Source: configName, Target: path.Join("/etc", name)})
+	}
+
+	return nil
+}
+
+// writeComposeFile writes the file with the permissions of the settings, as it contains the secrets of the stack
+func writeComposeFile(output string, compose *composeFile) error {
+	data, err := yaml.Marshal(compose)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the compose file: %w", err)
+	}
+
+	output = util.Fallback(output, defaultComposeOutput)
+	if err = os.WriteFile(output, data, filePerms); err != nil {
+		return fmt.Errorf("failed to write the compose file: %w", err)
+	}
+
+	log.Info().Str("path", output).Int("services", len(compose.Services)).Msg("Compose file written, it contains the secrets of the stack")
+
+	return nil
+}
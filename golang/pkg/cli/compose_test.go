@@ -0,0 +1,168 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+type composeService struct {
+	Labels      map[string]string `yaml:"labels"`
+	Healthcheck *struct {
+		Test []string `yaml:"test"`
+	} `yaml:"healthcheck"`
+	DependsOn map[string]struct {
+		Condition string `yaml:"condition"`
+	} `yaml:"depends_on"`
+	Networks map[string]struct {
+		Aliases []string `yaml:"aliases"`
+	} `yaml:"networks"`
+	Image         string   `yaml:"image"`
+	ContainerName string   `yaml:"container_name"`
+	Restart       string   `yaml:"restart"`
+	Command       []string `yaml:"command"`
+	Environment   []string `yaml:"environment"`
+	Ports         []string `yaml:"ports"`
+	Configs       []struct {
+		Source string `yaml:"source"`
+		Target string `yaml:"target"`
+	} `yaml:"configs"`
+}
+
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+	Networks map[string]struct {
+		Name string `yaml:"name"`
+	} `yaml:"networks"`
+	Volumes map[string]struct {
+		Labels map[string]string `yaml:"labels"`
+		Name   string            `yaml:"name"`
+	} `yaml:"volumes"`
+	Configs map[string]struct {
+		Content string `yaml:"content"`
+	} `yaml:"configs"`
+	Name string `yaml:"name"`
+}
+
+func composeState(args *cli.ArgsFlags) *cli.State {
+	state := &cli.State{Ctx: context.Background(), Containers: &cli.Containers{}}
+	state.SettingsFile.Version = "stable"
+	state.SettingsFile.Network = "dyo-stable"
+	state.SettingsFile.CruxPostgresUser = "crux"
+	state.SettingsFile.CruxPostgresDB = "crux"
+	state.SettingsFile.CruxPostgresPassword = "pa$word"
+	state.SettingsFile.CruxPostgresPort = 5432
+	state.SettingsFile.KratosPostgresUser = "kratos"
+	state.SettingsFile.KratosPostgresDB = "kratos"
+	state.SettingsFile.TraefikWebPort = 8000
+
+	return cli.LoadDefaultsOnEmpty(state, args)
+}
+
+func stackCompose(t *testing.T, args *cli.ArgsFlags) *composeFile {
+	data, err := cli.StackCompose(composeState(args), args)
+	assert.NoError(t, err)
+
+	compose := &composeFile{}
+	assert.NoError(t, yaml.Unmarshal(data, compose))
+
+	return compose
+}
+
+func TestStackComposeServices(t *testing.T) {
+	compose := stackCompose(t, &cli.ArgsFlags{Prefix: "dyo-stable"})
+
+	assert.Equal(t, "dyo-stable", compose.Name)
+	assert.ElementsMatch(t, []string{
+		"traefik", "crux", "crux-migrate", "crux-ui", "kratos", "kratos-migrate", "crux-postgres", "kratos-postgres", "mailslurper",
+	}, serviceNames(compose.Services))
+
+	crux := compose.Services["crux"]
+	assert.Equal(t, "dyo-stable_crux", crux.ContainerName)
+	assert.Equal(t, "ghcr.io/dyrector-io/dyrectorio/web/crux:stable", crux.Image)
+	assert.Equal(t, "always", crux.Restart)
+	assert.Equal(t, []string{"serve"}, crux.Command)
+	assert.Equal(t, []string{"dyo-stable_crux"}, crux.Networks["dyo-stable"].Aliases)
+	assert.Equal(t, "dyo-stable", crux.Labels["org.dyrectorio.container.prefix"])
+	assert.NotContains(t, crux.Labels, "com.docker.compose.project")
+	assert.Equal(t, "dyo-stable", compose.Networks["dyo-stable"].Name)
+
+	migrate := compose.Services["crux-migrate"]
+	assert.Equal(t, []string{"migrate"}, migrate.Command)
+	assert.Empty(t, migrate.Restart)
+	assert.Equal(t, "service_healthy", migrate.DependsOn["crux-postgres"].Condition)
+	assert.Equal(t, "service_completed_successfully", crux.DependsOn["crux-migrate"].Condition)
+	assert.Equal(t, "service_healthy", crux.DependsOn["crux-postgres"].Condition)
+	assert.Equal(t, "service_started", compose.Services["crux-ui"].DependsOn["crux"].Condition)
+	assert.Equal(t, "service_completed_successfully", compose.Services["kratos"].DependsOn["kratos-migrate"].Condition)
+	assert.Empty(t, compose.Services["traefik"].DependsOn)
+}
+
+func TestStackComposePostgres(t *testing.T) {
+	compose := stackCompose(t, &cli.ArgsFlags{Prefix: "dyo-stable"})
+
+	postgres := compose.Services["crux-postgres"]
+	assert.Equal(t, []string{"5432:5432"}, postgres.Ports)
+	// compose would interpolate the single dollar sign
+	assert.Contains(t, postgres.Environment, "POSTGRES_PASSWORD=pa$$word")
+	assert.Equal(t, []string{"CMD", "pg_isready", "-U", "crux", "-d", "crux"}, postgres.Healthcheck.Test)
+
+	volume := compose.Volumes["dyo-stable_crux-postgres-data"]
+	assert.Equal(t, "dyo-stable_crux-postgres-data", volume.Name)
+	assert.Equal(t, "dyo-stable", volume.Labels["org.dyrectorio.container.prefix"])
+	assert.NotContains(t, volume.Labels, "com.docker.compose.project")
+}
+
+func TestStackComposeDisabledServices(t *testing.T) {
+	compose := stackCompose(t, &cli.ArgsFlags{Prefix: "dyo-stable", CruxDisabled: true, CruxUIDisabled: true})
+
+	assert.NotContains(t, compose.Services, "crux")
+	assert.NotContains(t, compose.Services, "crux-migrate")
+	assert.NotContains(t, compose.Services, "crux-ui")
+
+	// traefik routes to the services on the host, with the configuration it would get copied
+	traefik := compose.Services["traefik"]
+	assert.Len(t, traefik.Configs, 1)
+	assert.Equal(t, "/etc/traefik/dynamic_conf.yml", traefik.Configs[0].Target)
+	assert.NotEmpty(t, compose.Configs[traefik.Configs[0].Source].Content)
+}
+
+func TestStackComposeFullyContainerized(t *testing.T) {
+	compose := stackCompose(t, &cli.ArgsFlags{Prefix: "dyo-stable", FullyContainerized: true})
+
+	assert.Empty(t, compose.Services["crux"].Ports)
+	assert.Empty(t, compose.Volumes)
+	assert.Equal(t, "service_started", compose.Services["traefik"].DependsOn["crux-ui"].Condition)
+}
+
+func TestStackComposeTraefikExtraConfig(t *testing.T) {
+	args := &cli.ArgsFlags{Prefix: "dyo-stable"}
+	state := composeState(args)
+	state.SettingsFile.TraefikExtraConfigDir = writeExtraConfig(t, map[string]string{"auth.yml": "http: {}\n"})
+
+	data, err := cli.StackCompose(state, args)
+	assert.NoError(t, err)
+	compose := &composeFile{}
+	assert.NoError(t, yaml.Unmarshal(data, compose))
+
+	assert.Equal(t, "http: {}\n", compose.Configs["traefik-extra-auth-yml"].Content)
+	configs := compose.Services["traefik"].Configs
+	assert.Equal(t, "/etc/traefik/auth.yml", configs[len(configs)-1].Target)
+	assert.Contains(t, compose.Services["traefik"].Command, "--providers.file.directory=/etc/traefik")
+}
+
+func serviceNames(services map[string]composeService) []string {
+	result := []string{}
+	for key := range services {
+		result = append(result, key)
+	}
+
+	return result
+}
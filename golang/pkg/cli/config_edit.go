@@ -0,0 +1,495 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigCommand edits the settings file from scripts
+const ConfigCommand = "config"
+
+// optionsSection is the key of the embedded Options in the settings file, it can be left out of the key paths
+const optionsSection = "options"
+
+var (
+	ErrSettingsKeyNotFound = errors.New("unknown settings key")
+	ErrSettingsKeyNotSet   = errors.New("settings key is not set")
+	ErrSettingsNotFound    = errors.New("settings file does not exist, run dyo up first")
+	ErrInvalidSettings     = errors.New("invalid settings")
+)
+
+// generatedSettings are created on the first run, the databases of the stack are initialized with them
+var generatedSettings = []string{
+	"options.crux-secret", "options.crux-encryption-key", "options.kratosSecret",
+	"options.cruxPostgresPassword", "options.kratosPostgresPassword",
+}
+
+// settingsField is a value of the settings file addressed by its key path, eg. options.crux-ui-port,
+// mapKey is set if the value is an entry of a map, eg. options.stopGracePeriods.crux-postgres
+type settingsField struct {
+	value  reflect.Value
+	mapKey *reflect.Value
+	key    string
+}
+
+// yamlName is the key of the struct field in the settings file
+func yamlName(field *reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+
+	return name
+}
+
+func resolveSettingsPath(settings *SettingsFile, key string) (*settingsField, error) {
+	segments := strings.Split(key, ".")
+	current := reflect.ValueOf(settings).Elem()
+
+	for i, segment := range segments {
+		switch current.Kind() {
+		case reflect.Struct:
+			next, ok := structFieldByYAMLName(current, segment)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrSettingsKeyNotFound, key)
+			}
+			current = next
+		case reflect.Map:
+			if i != len(segments)-1 {
+				return nil, fmt.Errorf("%w: %s", ErrSettingsKeyNotFound, key)
+			}
+			mapKey := reflect.ValueOf(segment)
+			return &settingsField{value: current, mapKey: &mapKey, key: key}, nil
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrSettingsKeyNotFound, key)
+		}
+	}
+
+	return &settingsField{value: current, key: key}, nil
+}
+
+func structFieldByYAMLName(value reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.IsExported() && yamlName(&field) == name {
+			return value.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// resolveSettingsKey finds the value of the key, the options section can be left out, eg. crux-ui-port
+func resolveSettingsKey(settings *SettingsFile, key string) (*settingsField, error) {
+	field, err := resolveSettingsPath(settings, key)
+	if err == nil || strings.HasPrefix(key, optionsSection+".") {
+		return field, err
+	}
+
+	field, optionsErr := resolveSettingsPath(settings, optionsSection+"."+key)
+	if optionsErr != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+func (f *settingsField) get() (reflect.Value, error) {
+	if f.mapKey == nil {
+		return f.value, nil
+	}
+
+	value := f.value.MapIndex(*f.mapKey)
+	if !value.IsValid() {
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrSettingsKeyNotSet, f.key)
+	}
+
+	return value, nil
+}
+
+func (f *settingsField) set(value reflect.Value) {
+	if f.mapKey == nil {
+		f.value.Set(value)
+		return
+	}
+
+	if f.value.IsNil() {
+		f.value.Set(reflect.MakeMap(f.value.Type()))
+	}
+	f.value.SetMapIndex(*f.mapKey, value)
+}
+
+func (f *settingsField) valueType() reflect.Type {
+	if f.mapKey == nil {
+		return f.value.Type()
+	}
+
+	return f.value.Type().Elem()
+}
+
+// parseSettingsValue parses the value into the type of the field, strings are taken as they are,
+// everything else is parsed as YAML, eg. 8000, true, 30s, [a, b] or {key: value}
+func parseSettingsValue(valueType reflect.Type, value string) (reflect.Value, error) {
+	parsed := reflect.New(valueType)
+	if valueType.Kind() == reflect.String {
+		parsed.Elem().SetString(value)
+		return parsed.Elem(), nil
+	}
+
+	if err := yaml.Unmarshal([]byte(value), parsed.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("%q is not a valid %s", value, valueType)
+	}
+
+	return parsed.Elem(), nil
+}
+
+func formatSettingsValue(value reflect.Value) (string, error) {
+	switch actual := value.Interface().(type) {
+	case string:
+		return actual, nil
+	case time.Duration:
+		return actual.String(), nil
+	case bool, uint:
+		return fmt.Sprint(actual), nil
+	}
+
+	out, err := yaml.Marshal(value.Interface())
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func getSetting(settings *SettingsFile, key string) (string, error) {
+	field, err := resolveSettingsKey(settings, key)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := field.get()
+	if err != nil {
+		return "", err
+	}
+
+	return formatSettingsValue(value)
+}
+
+func warnGeneratedSetting(field *settingsField) {
+	for _, key := range generatedSettings {
+		if field.key == key || optionsSection+"."+field.key == key {
+			log.Warn().Str("key", key).Msg("The setting was generated on the first run, the existing databases still use the old value")
+		}
+	}
+}
+
+func setSetting(settings *SettingsFile, key, value string) error {
+	field, err := resolveSettingsKey(settings, key)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parseSettingsValue(field.valueType(), value)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidSettings, key, err)
+	}
+
+	warnGeneratedSetting(field)
+	field.set(parsed)
+
+	return validateSettings(settings)
+}
+
+// unsetSetting removes map entries and resets everything else to its default
+func unsetSetting(settings *SettingsFile, key string) error {
+	field, err := resolveSettingsKey(settings, key)
+	if err != nil {
+		return err
+	}
+
+	warnGeneratedSetting(field)
+
+	if field.mapKey != nil {
+		field.value.SetMapIndex(*field.mapKey, reflect.Value{})
+		return nil
+	}
+
+	defaults := &SettingsFile{}
+	if err = cleanenv.ReadEnv(defaults); err != nil {
+		return err
+	}
+
+	defaultField, err := resolveSettingsKey(defaults, field.key)
+	if err != nil {
+		return err
+	}
+	field.set(defaultField.value)
+
+	return validateSettings(settings)
+}
+
+// validateSettings checks the ports of the stack, they have to be valid and unique, the image rewrite rules
+// and the postgres parameters
+func validateSettings(settings *SettingsFile) error {
+	errs := []error{}
+	owners := map[uint]string{}
+
+	options := reflect.ValueOf(settings.Options)
+	for i := 0; i < options.NumField(); i++ {
+		field := options.Type().Field(i)
+		if !isPortSetting(&field) {
+			continue
+		}
+
+		name := yamlName(&field)
+		port := uint(options.Field(i).Uint())
+		if port == 0 || port > math.MaxUint16 {
+			errs = append(errs, fmt.Errorf("%w: %s: port %d is out of range", ErrInvalidSettings, name, port))
+			continue
+		}
+
+		if owner, ok := owners[port]; ok {
+			errs = append(errs, fmt.Errorf("%w: %s: port %d is already used by %s", ErrInvalidSettings, name, port, owner))
+			continue
+		}
+		owners[port] = name
+	}
+
+	for name, period := range settings.StopGracePeriods {
+		if period < 0 {
+			errs = append(errs, fmt.Errorf("%w: stopGracePeriods.%s: negative duration", ErrInvalidSettings, name))
+		}
+	}
+	for name, timeout := range settings.StartTimeouts {
+		if timeout <= 0 {
+			errs = append(errs, fmt.Errorf("%w: startTimeouts.%s: not a positive duration", ErrInvalidSettings, name))
+		}
+	}
+
+	if err := settings.ImageRewrite.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("%w: imageRewrite: %w", ErrInvalidSettings, err))
+	}
+	if err := settings.CruxPostgresTuning.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("%w: cruxPostgresTuning: %w", ErrInvalidSettings, err))
+	}
+	if err := settings.KratosPostgresTuning.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("%w: kratosPostgresTuning: %w", ErrInvalidSettings, err))
+	}
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+
+	return errors.Join(errs...)
+}
+
+// readSettingsFile reads an existing settings file, the missing keys get their defaults
+func readSettingsFile(settingsPath string) (*SettingsFile, error) {
+	if _, err := os.Stat(settingsPath); errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrSettingsNotFound, settingsPath)
+	}
+
+	settings := &SettingsFile{}
+	if err := cleanenv.ReadConfig(settingsPath, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// writeSettingsFile replaces the settings file, a failed write leaves the previous file intact
+func writeSettingsFile(settingsPath string, settings *SettingsFile) error {
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(path.Dir(settingsPath), SettingsFileName+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Chmod(filePerms); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), settingsPath)
+}
+
+// editSettings applies the edit to the settings file and writes it back if the result is valid
+func editSettings(settingsPath string, edit func(*SettingsFile) error) error {
+	settings, err := readSettingsFile(settingsPath)
+	if err != nil {
+		return err
+	}
+
+	if err = edit(settings); err != nil {
+		return err
+	}
+
+	return writeSettingsFile(settingsPath, settings)
+}
+
+func settingsArgs(cCtx *ucli.Context, count int, usage string) ([]string, error) {
+	if cCtx.NArg() != count {
+		return nil, fmt.Errorf("usage: dyo config %s %s", cCtx.Command.Name, usage)
+	}
+
+	return cCtx.Args().Slice(), nil
+}
+
+// existingSettingsArgs are the flags of the commands reading the settings file of the stack without loading it
+func existingSettingsArgs(cCtx *ucli.Context) (*ArgsFlags, error) {
+	settingsPath, err := settingsLocation(cCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := SettingsExists(settingsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArgsFlags{SettingsFilePath: settingsPath, SettingsExists: exists}, nil
+}
+
+// GetConfigCommand returns the get, set and unset subcommands of the settings file
+func GetConfigCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:      ConfigCommand,
+		Action:    ucli.ShowSubcommandHelp,
+		Usage:     "dyo config get|set|unset|show <key> [value]",
+		UsageText: "dyo config set traefikWebPort 8001",
+		Description: "Keys are the paths of the settings file, eg. options.crux-ui-port or options.stopGracePeriods.crux, " +
+			"the options. prefix can be left out. Values are validated before the file is written.",
+		Subcommands: []*ucli.Command{
+			{
+				Name:  "show",
+				Usage: "Print the settings file, or the effective settings with the overrides of the environment and the flags",
+				Flags: []ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagResolved,
+						Usage: "apply the DYO_ environment variables and the --set flags, the overridden keys are commented with their source",
+					},
+				},
+				Action: func(cCtx *ucli.Context) error {
+					args, err := existingSettingsArgs(cCtx)
+					if err != nil {
+						return err
+					}
+
+					if !cCtx.Bool(FlagResolved) {
+						out, err := yaml.Marshal(readSettingsLayer(args))
+						if err != nil {
+							return err
+						}
+
+						//nolint:forbidigo
+						fmt.Print(string(out))
+						return nil
+					}
+
+					args.SettingsOverrides = settingsOverrideFlags(cCtx)
+					out, err := resolvedSettingsYAML(args)
+					if err != nil {
+						return err
+					}
+
+					//nolint:forbidigo
+					fmt.Print(out)
+					return nil
+				},
+			},
+			{
+				Name:      "get",
+				Usage:     "Print the value of the key, maps and lists are printed as YAML",
+				ArgsUsage: "<key>",
+				Action: func(cCtx *ucli.Context) error {
+					args, err := settingsArgs(cCtx, 1, "<key>")
+					if err != nil {
+						return err
+					}
+
+					settingsPath, err := settingsLocation(cCtx)
+					if err != nil {
+						return err
+					}
+
+					// the same reader as set and unset, so a missing or broken file is an error instead of the defaults
+					settings, err := readSettingsFile(settingsPath)
+					if err != nil {
+						return err
+					}
+
+					value, err := getSetting(settings, args[0])
+					if err != nil {
+						return err
+					}
+
+					//nolint:forbidigo
+					fmt.Println(value)
+					return nil
+				},
+			},
+			{
+				Name:      "set",
+				Usage:     "Set the value of the key, maps and lists are given in YAML flow style, eg. [a, b]",
+				ArgsUsage: "<key> <value>",
+				Action: func(cCtx *ucli.Context) error {
+					args, err := settingsArgs(cCtx, 2, "<key> <value>") //nolint:gomnd
+					if err != nil {
+						return err
+					}
+
+					settingsPath, err := settingsLocation(cCtx)
+					if err != nil {
+						return err
+					}
+
+					return editSettings(settingsPath, func(settings *SettingsFile) error {
+						return setSetting(settings, args[0], args[1])
+					})
+				},
+			},
+			{
+				Name:      "unset",
+				Usage:     "Reset the key to its default, map entries are removed",
+				ArgsUsage: "<key>",
+				Action: func(cCtx *ucli.Context) error {
+					args, err := settingsArgs(cCtx, 1, "<key>")
+					if err != nil {
+						return err
+					}
+
+					settingsPath, err := settingsLocation(cCtx)
+					if err != nil {
+						return err
+					}
+
+					return editSettings(settingsPath, func(settings *SettingsFile) error {
+						return unsetSetting(settings, args[0])
+					})
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func writeTestSettings(t *testing.T, content string) string {
+	settingsPath := filepath.Join(t.TempDir(), cli.SettingsFileName)
+	assert.NoError(t, os.WriteFile(settingsPath, []byte(content), 0o600))
+
+	return settingsPath
+}
+
+func TestGetSettingDefaultsAndOptionsPrefix(t *testing.T) {
+	settings, err := cli.ReadSettingsFile(writeTestSettings(t, "prefix: dyo-test\n"))
+	assert.NoError(t, err)
+
+	value, err := cli.GetSetting(settings, "prefix")
+	assert.NoError(t, err)
+	assert.Equal(t, "dyo-test", value)
+
+	value, err = cli.GetSetting(settings, "options.crux-ui-port")
+	assert.NoError(t, err)
+	assert.Equal(t, "3000", value)
+
+	value, err = cli.GetSetting(settings, "crux-ui-port")
+	assert.NoError(t, err)
+	assert.Equal(t, "3000", value)
+
+	_, err = cli.GetSetting(settings, "options.unknown")
+	assert.ErrorIs(t, err, cli.ErrSettingsKeyNotFound)
+
+	_, err = cli.GetSetting(settings, "stopGracePeriods.crux")
+	assert.ErrorIs(t, err, cli.ErrSettingsKeyNotSet)
+}
+
+func TestSetSettingTypeChecks(t *testing.T) {
+	settings, err := cli.ReadSettingsFile(writeTestSettings(t, "prefix: dyo-test\n"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, cli.SetSetting(settings, "traefikWebPort", "8001"))
+	assert.Equal(t, uint(8001), settings.TraefikWebPort)
+
+	assert.NoError(t, cli.SetSetting(settings, "stopGracePeriods.crux", "1m"))
+	assert.Equal(t, time.Minute, settings.StopGracePeriods["crux"])
+
+	assert.NoError(t, cli.SetSetting(settings, "notifications.webhooks", "[https://a.example, https://b.example]"))
+	assert.Equal(t, []string{"https://a.example", "https://b.example"}, settings.Notifications.Webhooks)
+
+	assert.ErrorIs(t, cli.SetSetting(settings, "traefikWebPort", "web"), cli.ErrInvalidSettings)
+	assert.ErrorIs(t, cli.SetSetting(settings, "traefikWebPort", "70000"), cli.ErrInvalidSettings)
+	assert.ErrorIs(t, cli.SetSetting(settings, "stopGracePeriods.crux", "soon"), cli.ErrInvalidSettings)
+}
+
+func TestSetSettingRejectsPortConflicts(t *testing.T) {
+	settings, err := cli.ReadSettingsFile(writeTestSettings(t, "prefix: dyo-test\n"))
+	assert.NoError(t, err)
+
+	err = cli.SetSetting(settings, "traefikWebPort", "3000")
+	assert.ErrorIs(t, err, cli.ErrInvalidSettings)
+	assert.ErrorContains(t, err, "already used by")
+}
+
+func TestUnsetSettingRestoresDefaults(t *testing.T) {
+	settings, err := cli.ReadSettingsFile(writeTestSettings(t,
+		"options:\n  crux-ui-port: 3100\n  stopGracePeriods:\n    crux: 1m\n"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, cli.UnsetSetting(settings, "crux-ui-port"))
+	assert.Equal(t, uint(3000), settings.CruxUIPort)
+
+	assert.NoError(t, cli.UnsetSetting(settings, "stopGracePeriods.crux"))
+	assert.NotContains(t, settings.StopGracePeriods, "crux")
+}
+
+func TestWriteSettingsFileRoundTrip(t *testing.T) {
+	settingsPath := writeTestSettings(t, "prefix: dyo-test\n")
+	settings, err := cli.ReadSettingsFile(settingsPath)
+	assert.NoError(t, err)
+
+	assert.NoError(t, cli.SetSetting(settings, "crux-ui-port", "3100"))
+	assert.NoError(t, cli.WriteSettingsFile(settingsPath, settings))
+
+	written, err := cli.ReadSettingsFile(settingsPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "dyo-test", written.Prefix)
+	assert.Equal(t, uint(3100), written.CruxUIPort)
+
+	_, err = cli.ReadSettingsFile(filepath.Join(t.TempDir(), cli.SettingsFileName))
+	assert.ErrorIs(t, err, cli.ErrSettingsNotFound)
+}
@@ -10,7 +10,9 @@
 	"os"
 	"path"
 	"strings"
+	"time"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/mirror"
 	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 
@@ -19,6 +21,7 @@
 	"github.com/docker/docker/client"
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
 	"gopkg.in/yaml.v3"
 )
 
@@ -27,9 +30,17 @@
 type State struct {
 	Ctx context.Context
 	*Containers
+	// digest pinned images from the lockfile, only in --locked mode
+	LockedImages       map[string]string
 	InternalHostDomain string
-	EnvFile            []string
-	SettingsFile       SettingsFile
+	// ContainerRuntime is the detected runtime of the daemon, docker or podman
+	ContainerRuntime string
+	EnvFile          []string
+	Result           any
+	// fileSettings is the settings file before the overrides, the overridden keys are saved from it
+	fileSettings      *SettingsFile
+	settingsOverrides []settingsOverride
+	SettingsFile      SettingsFile
 }
 
 // ArgsFlags are commandline arguments
@@ -40,6 +51,21 @@ type ArgsFlags struct {
 	Command            string
 	ImageTag           string
 	Prefix             string
+	WatchRestartPolicy string
+	NotifyWebhook      string
+	Output             string
+	Bundle             string
+	Backup             string
+	BackupDir          string
+	// Runtime is the one selected with the flag, auto, docker or podman
+	Runtime            string
+	GenerateOutput     string
+	KubeHost           string
+	KubeNamespace      string
+	KubeStorage        string
+	SettingsOverrides  []string
+	WatchInterval      time.Duration
+	WatchMaxRestarts   uint
 	CruxDisabled       bool
 	CruxUIDisabled     bool
 	LocalAgent         bool
@@ -48,6 +74,15 @@ type ArgsFlags struct {
 	FullyContainerized bool
 	SettingsExists     bool
 	Silent             bool
+	MacOS              bool
+	DesktopNotify      bool
+	Locked             bool
+	SudoHelper         bool
+	NoHosts            bool
+	Rollback           bool
+	Open               bool
+	RemoveVolumes      bool
+	Offline            bool
 }
 
 // Containers contain container/service specific settings
@@ -82,32 +117,84 @@ type SettingsFile struct {
 
 // Options are "globals" for the SettingsFile struct
 type Options struct {
-	KratosPostgresUser             string `yaml:"kratosPostgresUser" env-default:"kratos"`
-	KratosPostgresPassword         string `yaml:"kratosPostgresPassword"`
-	TraefikDockerSocket            string `yaml:"traefikDockerSocket" env-default:"/var/run/docker.sock"`
-	MailFromName                   string `yaml:"mailFromName" env-default:"dyrector.io - Platform"`
-	CruxSecret                     string `yaml:"crux-secret"`
-	CruxEncryptionKey              string `yaml:"crux-encryption-key"`
-	KratosSecret                   string `yaml:"kratosSecret"`
-	CruxPostgresDB                 string `yaml:"cruxPostgresDB" env-default:"crux"`
-	CruxPostgresUser               string `yaml:"cruxPostgresUser" env-default:"crux"`
-	CruxPostgresPassword           string `yaml:"cruxPostgresPassword"`
-	TimeZone                       string `yaml:"timezone" env-default:"UTC"`
-	KratosPostgresDB               string `yaml:"kratosPostgresDB" env-default:"kratos"`
-	MailFromEmail                  string `yaml:"mailFromEmail" env-default:"noreply@example.com"`
-	TraefikWebPort                 uint   `yaml:"traefikWebPort" env-default:"8000"`
-	CruxUIPort                     uint   `yaml:"crux-ui-port" env-default:"3000"`
-	KratosPublicPort               uint   `yaml:"kratosPublicPort" env-default:"4433"`
-	KratosPostgresPort             uint   `yaml:"kratosPostgresPort" env-default:"5433"`
-	TraefikUIPort                  uint   `yaml:"traefikUIPort" env-default:"8080"`
-	CruxHTTPPort                   uint   `yaml:"crux-http-port" env-default:"1848"`
-	CruxAgentGrpcPort              uint   `yaml:"crux-agentgrpc-port" env-default:"5000"`
-	MailSlurperUIPort              uint   `yaml:"mailSlurperUIPort" env-default:"4436"`
-	MailSlurperSMTPPort            uint   `yaml:"mailSlurperSMTPPort" env-default:"1025"`
-	CruxPostgresPort               uint   `yaml:"cruxPostgresPort" env-default:"5432"`
-	MailSlurperAPIPort             uint   `yaml:"mailSlurperAPIPort" env-default:"4437"`
-	KratosAdminPort                uint   `yaml:"kratosAdminPort" env-default:"4434"`
-	TraefikIsDockerSocketNamedPipe bool   `yaml:"traefikIsDockerSocketNamedPipe" env-default:"false"`
+	// stop timeouts of the stack members by their name without the prefix, eg. crux-postgres: 30s
+	StopGracePeriods map[string]time.Duration `yaml:"stopGracePeriods"`
+	// how long the stack members have to become ready after they are started, by their name, eg. crux: 5m
+	StartTimeouts map[string]time.Duration `yaml:"startTimeouts"`
+	// images of the services by their name, crux, crux-ui, kratos, traefik, mailslurper or postgres, a tag or a whole
+	// reference, eg. crux: 0.11.2, the services without a pin follow the version
+	Images ImagePins `yaml:"images"`
+	// the images of the stack are redirected to mirrors by these rules, eg. match: ghcr.io/dyrector-io, replace: mirror.local/dyo
+	ImageRewrite mirror.Rules `yaml:"imageRewrite"`
+	// LANG of the stack containers besides the TZ of the timezone, eg. en_US.UTF-8, empty keeps the default of the images
+	Locale string `yaml:"locale"`
+	// the yaml and toml files of the directory are uploaded next to the generated dynamic configuration of traefik,
+	// eg. middlewares, auth or custom routers, relative paths are resolved next to the settings file
+	TraefikExtraConfigDir string `yaml:"traefikExtraConfigDir"`
+	// HTTPS of the UI and the API on the traefikTLSPort, eg. mode: self-signed, or mode: acme, domain: dyo.example.com,
+	// acmeEmail: admin@example.com, empty mode serves them over HTTP only
+	TLS TLSSettings `yaml:"tls"`
+	// traefik or external, external skips traefik, crux, crux-ui and kratos are bound on their ports for a reverse proxy
+	// of the host, its rules are printed on start, externalURL is the address it serves the stack at, http://localhost by default
+	Proxy       string `yaml:"proxy"`
+	ExternalURL string `yaml:"externalURL"`
+	// existing Postgres servers of crux and kratos, eg. host: db.example.com, password: secret, sslMode: verify-full,
+	// the bundled database of the service is not started if the host is set, its migrations run against the external one
+	CruxExternalPostgres   ExternalPostgres `yaml:"cruxExternalPostgres"`
+	KratosExternalPostgres ExternalPostgres `yaml:"kratosExternalPostgres"`
+
+	KratosPostgresUser             string               `yaml:"kratosPostgresUser" env-default:"kratos"`
+	KratosPostgresPassword         string               `yaml:"kratosPostgresPassword"`
+	TraefikDockerSocket            string               `yaml:"traefikDockerSocket" env-default:"/var/run/docker.sock"`
+	TraefikConfigTemplate          string               `yaml:"traefikConfigTemplate"`
+	MailFromName                   string               `yaml:"mailFromName" env-default:"dyrector.io - Platform"`
+	CruxSecret                     string               `yaml:"crux-secret"`
+	CruxEncryptionKey              string               `yaml:"crux-encryption-key"`
+	KratosSecret                   string               `yaml:"kratosSecret"`
+	CruxPostgresDB                 string               `yaml:"cruxPostgresDB" env-default:"crux"`
+	CruxPostgresUser               string               `yaml:"cruxPostgresUser" env-default:"crux"`
+	CruxPostgresPassword           string               `yaml:"cruxPostgresPassword"`
+	TimeZone                       string               `yaml:"timezone" env-default:"UTC"`
+	KratosPostgresDB               string               `yaml:"kratosPostgresDB" env-default:"kratos"`
+	MailFromEmail                  string               `yaml:"mailFromEmail" env-default:"noreply@example.com"`
+	CruxPostgresVolume             VolumeSettings       `yaml:"cruxPostgresVolume"`
+	KratosPostgresVolume           VolumeSettings       `yaml:"kratosPostgresVolume"`
+	CruxPostgresTuning             PostgresTuning       `yaml:"cruxPostgresTuning"`
+	KratosPostgresTuning           PostgresTuning       `yaml:"kratosPostgresTuning"`
+	Notifications                  NotificationSettings `yaml:"notifications"`
+	ExternalSMTP                   ExternalSMTP         `yaml:"externalSMTP"`
+	TraefikWebPort                 uint                 `yaml:"traefikWebPort" env-default:"8000"`
+	CruxUIPort                     uint                 `yaml:"crux-ui-port" env-default:"3000"`
+	KratosPublicPort               uint                 `yaml:"kratosPublicPort" env-default:"4433"`
+	KratosPostgresPort             uint                 `yaml:"kratosPostgresPort" env-default:"5433"`
+	TraefikUIPort                  uint                 `yaml:"traefikUIPort" env-default:"8080"`
+	CruxHTTPPort                   uint                 `yaml:"crux-http-port" env-default:"1848"`
+	CruxAgentGrpcPort              uint                 `yaml:"crux-agentgrpc-port" env-default:"5000"`
+	MailSlurperUIPort              uint                 `yaml:"mailSlurperUIPort" env-default:"4436"`
+	MailSlurperSMTPPort            uint                 `yaml:"mailSlurperSMTPPort" env-default:"1025"`
+	CruxPostgresPort               uint                 `yaml:"cruxPostgresPort" env-default:"5432"`
+	MailSlurperAPIPort             uint                 `yaml:"mailSlurperAPIPort" env-default:"4437"`
+	KratosAdminPort                uint                 `yaml:"kratosAdminPort" env-default:"4434"`
+	UpgradeBackupRetention         uint                 `yaml:"upgradeBackupRetention" env-default:"3"`
+	TraefikTLSPort                 uint                 `yaml:"traefikTLSPort" env-default:"8443"`
+	TraefikIsDockerSocketNamedPipe bool                 `yaml:"traefikIsDockerSocketNamedPipe" env-default:"false"`
+	MountLocaltime                 bool                 `yaml:"mountLocaltime" env-default:"false"`
+}
+
+// VolumeSettings configure a named volume of the stack, the name defaults to the container name with a -data suffix
+type VolumeSettings struct {
+	Labels     map[string]string `yaml:"labels"`
+	DriverOpts map[string]string `yaml:"driverOpts"`
+	Name       string            `yaml:"name"`
+	Driver     string            `yaml:"driver" env-default:"local"`
+}
+
+// NotificationSettings are the endpoints called when the stack is started, stopped, upgraded or fails
+type NotificationSettings struct {
+	// incoming webhook URL of a Slack channel
+	SlackWebhook string `yaml:"slackWebhook"`
+	// URLs receiving the events as JSON POST requests
+	Webhooks []string `yaml:"webhooks"`
 }
 
 const (
@@ -115,6 +202,8 @@ type Options struct {
 	SettingsFileName = "settings.yaml"
 	// CLIDirName is the directory where we save the configuration file under the users configuration directory
 	CLIDirName = "dyo-cli"
+	// DefaultPrefix is the prefix of the stack if none is given, its settings are in SettingsFileName
+	DefaultPrefix = "dyo-stable"
 )
 
 const (
@@ -127,54 +216,138 @@ type Options struct {
 )
 
 // SettingsExists is a check if the settings file is exists
-func SettingsExists(settingsPath string) bool {
-	settingsFilePath := SettingsFileLocation(settingsPath)
-
+func SettingsExists(settingsFilePath string) (bool, error) {
 	_, err := os.Stat(settingsFilePath)
 	if err == nil {
-		return true
+		return true, nil
 	}
 	if errors.Is(err, os.ErrNotExist) {
-		return false
+		return false, nil
 	}
-	log.Fatal().Err(err).Stack().Send()
-	return false
+
+	return false, fmt.Errorf("failed to check the settings file: %w", err)
 }
 
-// SettingsFileLocation is assembling the location of the settings file
-func SettingsFileLocation(settingsPath string) string {
+// SettingsFileLocation is assembling the location of the settings file, the stacks of other prefixes
+// have their own settings next to the default one, so they can run side by side
+func SettingsFileLocation(settingsPath, prefix string) (string, error) {
 	if settingsPath == "" {
 		userConfDir, err := os.UserConfigDir()
 		if err != nil {
-			log.Fatal().Err(err).Stack().Msg("Couldn't determine the user's configuration dir")
+			return "", fmt.Errorf("couldn't determine the user's configuration dir: %w", err)
 		}
 
-		settingsPath = path.Join(userConfDir, CLIDirName, SettingsFileName)
+		settingsPath = path.Join(userConfDir, CLIDirName, settingsFileName(prefix))
+		if legacy := path.Join(userConfDir, CLIDirName, SettingsFileName); isLegacySettingsOf(settingsPath, legacy, prefix) {
+			return legacy, nil
+		}
 	}
 
-	return settingsPath
+	return settingsPath, nil
+}
+
+// isLegacySettingsOf is true if the stack of the prefix has no settings of its own yet, but it was created
+// before the prefixes had their own settings, so it is kept in the shared settings file
+func isLegacySettingsOf(settingsPath, legacyPath, prefix string) bool {
+	if settingsPath == legacyPath {
+		return false
+	}
+	if _, err := os.Stat(settingsPath); !errors.Is(err, os.ErrNotExist) {
+		return false
+	}
+
+	data, err := os.ReadFile(legacyPath)
+	if err != nil {
+		return false
+	}
+
+	legacy := struct {
+		Prefix string `yaml:"prefix"`
+	}{}
+	if err = yaml.Unmarshal(data, &legacy); err != nil {
+		return false
+	}
+
+	return legacy.Prefix == prefix
+}
+
+// settingsFileName is settings.yaml for the default prefix, eg. settings.dyo-feature.yaml for dyo-feature
+func settingsFileName(prefix string) string {
+	return prefixedFileName(SettingsFileName, prefix)
+}
+
+// prefixedFileName is the name of a file of the stack kept next to the settings, the name itself for the default prefix,
+// down can be given more prefixes, those use the default files
+func prefixedFileName(name, prefix string) string {
+	if prefix == "" || prefix == DefaultPrefix || strings.Contains(prefix, ",") {
+		return name
+	}
+
+	ext := path.Ext(name)
+
+	return strings.TrimSuffix(name, ext) + "." + fileNamePrefix(prefix) + ext
+}
+
+// fileNamePrefix is the prefix usable in a file name, the separators and the other unsafe characters are replaced,
+// so the files of the stack stay in the configuration directory
+func fileNamePrefix(prefix string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, prefix)
+}
+
+// settingsLocation is the settings file of the command, the one of its prefix unless a path is given
+func settingsLocation(cCtx *ucli.Context) (string, error) {
+	return SettingsFileLocation(cCtx.String(FlagConfigPath), cCtx.String(FlagPrefix))
+}
+
+// stackPrefix is the prefix given by the flag, or else the one in the settings, so a settings file given
+// by its path keeps its stack apart without repeating the prefix
+func stackPrefix(cCtx *ucli.Context, args *ArgsFlags) (string, error) {
+	if cCtx.IsSet(FlagPrefix) || !args.SettingsExists {
+		return cCtx.String(FlagPrefix), nil
+	}
+
+	settingsFile := &SettingsFile{}
+	if err := cleanenv.ReadConfig(args.SettingsFilePath, settingsFile); err != nil {
+		return "", fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if _, err := applySettingsOverrides(settingsFile, args); err != nil {
+		return "", fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	return util.Fallback(settingsFile.Prefix, cCtx.String(FlagPrefix)), nil
 }
 
 // SettingsFileDefaults creating, reading and parsing the settings.yaml
-func SettingsFileDefaults(initialState *State, args *ArgsFlags) *State {
+func SettingsFileDefaults(initialState *State, args *ArgsFlags) (*State, error) {
 	settingsFile := SettingsFile{}
 	if args.SettingsExists {
 		err := cleanenv.ReadConfig(args.SettingsFilePath, &settingsFile)
 		if err != nil {
-			log.Fatal().Err(err).Stack().Msg("Failed to load configuration")
+			return nil, fmt.Errorf("failed to load configuration: %w", err)
 		}
 	} else {
 		args.SettingsWrite = true
 		err := cleanenv.ReadEnv(&settingsFile)
 		if err != nil {
-			log.Fatal().Err(err).Stack().Msg("Failed to load configuration")
+			return nil, fmt.Errorf("failed to load configuration: %w", err)
 		}
+
+		// the network of a new stack is its own, so the stacks of the prefixes do not share one
+		settingsFile.Prefix = util.Fallback(args.Prefix, settingsFile.Prefix)
+		settingsFile.Network = settingsFile.Prefix
+	}
+	if err := initialState.layerSettings(settingsFile, args); err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
-	initialState.SettingsFile = settingsFile
 
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
-		log.Fatal().Stack().Err(err).Send()
+		return nil, fmt.Errorf("could not connect to docker socket: %w", err)
 	}
 
 	_, err = containerRuntime.VersionCheck(initialState.Ctx, cli)
@@ -185,43 +358,112 @@ func SettingsFileDefaults(initialState *State, args *ArgsFlags) *State {
 				log.Info().Stack().Err(err).Msg("There is a newer version of the container engine in use, please consider updating.")
 			})
 		case errors.Is(err, containerRuntime.ErrServerVersionIsNotSupported):
-			log.Fatal().Stack().Err(err).Msg("The container engine in use is not supported, please consider updating.")
+			return nil, fmt.Errorf("the container engine in use is not supported, please consider updating: %w", err)
 		default:
-			log.Fatal().Stack().Err(err).Send()
+			return nil, err
 		}
 	}
 
 	// Fill out data if empty
 	state := LoadDefaultsOnEmpty(initialState, args)
-	state.InternalHostDomain, err = containerRuntime.GetInternalHostDomain(initialState.Ctx, cli)
+	state.ContainerRuntime, err = containerRuntime.GetContainerRuntime(initialState.Ctx, cli)
 	if err != nil {
-		log.Fatal().Stack().Err(err).Send()
+		return nil, err
+	}
+	if err = checkRuntime(args.Runtime, state.ContainerRuntime); err != nil {
+		return nil, err
 	}
+	state.InternalHostDomain = internalHostDomain(state.ContainerRuntime, args)
+	warnRootlessPorts(initialState.Ctx, cli, &state.SettingsFile, args)
 
 	if args.EnvFile != "" {
-		state.EnvFile = LoadEnvFile(args.EnvFile)
+		if state.EnvFile, err = LoadEnvFile(args.EnvFile); err != nil {
+			return nil, err
+		}
 	}
 
-	EnsureNetworkExists(state)
+	if err = EnsureNetworkExists(initialState.Ctx, state); err != nil {
+		return nil, err
+	}
 
+	// the values of these flags are persisted with --write, unlike the overrides
 	if args.Network != "" {
 		state.SettingsFile.Network = args.Network
+		state.settingsOverrides = withoutSettingsOverride(state.settingsOverrides, "network-name")
 	}
 
 	if args.ImageTag != "" {
 		state.SettingsFile.Version = args.ImageTag
+		state.settingsOverrides = withoutSettingsOverride(state.settingsOverrides, "version")
 	}
 
 	// Set disabled stuff
 	state = DisabledServiceSettings(state, args)
 
 	// Settings Validation steps
+	if err = state.SettingsFile.ImageRewrite.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid imageRewrite setting: %w", err)
+	}
+	if err = state.SettingsFile.Images.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid images setting: %w", err)
+	}
+	if err = state.SettingsFile.CruxPostgresTuning.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cruxPostgresTuning setting: %w", err)
+	}
+	if err = state.SettingsFile.KratosPostgresTuning.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid kratosPostgresTuning setting: %w", err)
+	}
+	if err = state.SettingsFile.TLS.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid tls setting: %w", err)
+	}
+	if err = validateProxy(&state.SettingsFile.Options); err != nil {
+		return nil, err
+	}
+	if err = state.SettingsFile.CruxExternalPostgres.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cruxExternalPostgres setting: %w", err)
+	}
+	if err = state.SettingsFile.KratosExternalPostgres.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid kratosExternalPostgres setting: %w", err)
+	}
+	if err = state.SettingsFile.ExternalSMTP.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid externalSMTP setting: %w", err)
+	}
 
 	if args.SettingsWrite {
-		SaveSettings(state, args)
+		if err = SaveSettings(state, args); err != nil {
+			return nil, err
+		}
 	}
 
-	return state
+	return state, nil
+}
+
+// ReadExistingSettings is for the commands not loading the whole configuration, nothing is generated or written,
+// the environment variables and the flags override the settings file
+func ReadExistingSettings(args *ArgsFlags) *SettingsFile {
+	settingsFile := readSettingsLayer(args)
+	if _, err := applySettingsOverrides(settingsFile, args); err != nil {
+		log.Warn().Err(err).Msg("Failed to override the settings")
+	}
+
+	return settingsFile
+}
+
+// readSettingsLayer reads the settings file without the overrides, the defaults if it does not exist
+func readSettingsLayer(args *ArgsFlags) *SettingsFile {
+	settingsFile := &SettingsFile{}
+	if !args.SettingsExists {
+		if err := cleanenv.ReadEnv(settingsFile); err != nil {
+			log.Warn().Err(err).Msg("Failed to load configuration defaults")
+		}
+		return settingsFile
+	}
+
+	if err := cleanenv.ReadConfig(args.SettingsFilePath, settingsFile); err != nil {
+		log.Warn().Err(err).Msg("Failed to load configuration, using the defaults")
+	}
+
+	return settingsFile
 }
 
 // DisabledServiceSettings modifies the setting if the crux-ui is disabled
@@ -236,25 +478,29 @@ func DisabledServiceSettings(state *State, args *ArgsFlags) *State {
 }
 
 // SettingsPath returns the full path to the settingsfile
-func SettingsPath() string {
+func SettingsPath() (string, error) {
 	userConfDir, err := os.UserConfigDir()
 	if err != nil {
-		log.Fatal().Err(err).Stack().Send()
+		return "", fmt.Errorf("couldn't determine the user's configuration dir: %w", err)
 	}
 
-	return path.Join(userConfDir, CLIDirName, SettingsFileName)
+	return path.Join(userConfDir, CLIDirName, SettingsFileName), nil
 }
 
 // SaveSettings saves the settings
-func SaveSettings(state *State, args *ArgsFlags) {
-	settingsPath := SettingsPath()
+func SaveSettings(state *State, args *ArgsFlags) error {
+	settingsPath, err := SettingsPath()
+	if err != nil {
+		return err
+	}
+	settingsDir := path.Dir(settingsPath)
 
 	// If settingsPath is default, we create the directory for it
-	if args.SettingsFilePath == settingsPath {
-		if _, err := os.Stat(path.Dir(settingsPath)); errors.Is(err, os.ErrNotExist) {
-			err = os.MkdirAll(path.Dir(settingsPath), dirPerms)
+	if path.Dir(args.SettingsFilePath) == settingsDir {
+		if _, err = os.Stat(settingsDir); errors.Is(err, os.ErrNotExist) {
+			err = os.MkdirAll(settingsDir, dirPerms)
 			if err != nil {
-				log.Fatal().Err(err).Stack().Send()
+				return fmt.Errorf("failed to create the settings directory: %w", err)
 			}
 			if !args.Silent {
 				NotifyOnce("welcome", func() {
@@ -262,21 +508,27 @@ func SaveSettings(state *State, args *ArgsFlags) {
 				})
 			}
 		} else if err != nil {
-			log.Fatal().Err(err).Stack().Send()
+			return fmt.Errorf("failed to check the settings directory: %w", err)
 		}
 	}
 
-	filedata, err := yaml.Marshal(state.SettingsFile)
+	settings, err := savedSettings(state)
+	if err != nil {
+		return err
+	}
+
+	filedata, err := yaml.Marshal(settings)
 	if err != nil {
-		log.Fatal().Err(err).Stack().Send()
+		return fmt.Errorf("failed to marshal the settings: %w", err)
 	}
 
 	err = os.WriteFile(args.SettingsFilePath, filedata, filePerms)
 	if err != nil {
-		log.Fatal().Err(err).Stack().Send()
+		return fmt.Errorf("failed to write the settings file: %w", err)
 	}
 
 	args.SettingsWrite = false
+	return nil
 }
 
 // LoadDefaultsOnEmpty There are options which are not filled out by default, we need to initialize values
@@ -286,12 +538,12 @@ func LoadDefaultsOnEmpty(state *State, args *ArgsFlags) *State {
 	state.CruxUI.Image = "ghcr.io/dyrector-io/dyrectorio/web/crux-ui"
 	state.Kratos.Image = "ghcr.io/dyrector-io/dyrectorio/web/kratos"
 
-	// Load defaults
-	state.SettingsFile.CruxSecret = util.Fallback(state.SettingsFile.CruxSecret, randomChars())
-	state.SettingsFile.CruxEncryptionKey = util.Fallback(state.SettingsFile.CruxEncryptionKey, generateCruxEncryptionKey())
-	state.SettingsFile.CruxPostgresPassword = util.Fallback(state.SettingsFile.CruxPostgresPassword, randomChars())
-	state.SettingsFile.KratosPostgresPassword = util.Fallback(state.SettingsFile.KratosPostgresPassword, randomChars())
-	state.SettingsFile.KratosSecret = util.Fallback(state.SettingsFile.KratosSecret, randomChars())
+	// Load defaults, the secrets overridden on the first run are generated for the settings file too,
+	// so it is not saved without them
+	generateMissingSecrets(&state.SettingsFile)
+	if state.fileSettings != nil {
+		generateMissingSecrets(state.fileSettings)
+	}
 
 	// Generate names
 	state.Containers.Traefik.Name = fmt.Sprintf("%s_traefik", args.Prefix)
@@ -307,15 +559,24 @@ func LoadDefaultsOnEmpty(state *State, args *ArgsFlags) *State {
 	return state
 }
 
-func LoadEnvFile(envFile string) []string {
+// generateMissingSecrets fills the empty generatedSettings
+func generateMissingSecrets(settings *SettingsFile) {
+	settings.CruxSecret = util.Fallback(settings.CruxSecret, randomChars())
+	settings.CruxEncryptionKey = util.Fallback(settings.CruxEncryptionKey, generateCruxEncryptionKey())
+	settings.CruxPostgresPassword = util.Fallback(settings.CruxPostgresPassword, randomChars())
+	settings.KratosPostgresPassword = util.Fallback(settings.KratosPostgresPassword, randomChars())
+	settings.KratosSecret = util.Fallback(settings.KratosSecret, randomChars())
+}
+
+func LoadEnvFile(envFile string) ([]string, error) {
 	workDir, err := os.Getwd()
 	if err != nil {
-		log.Fatal().Err(err).Stack().Msg("Can not get the working directory, for the .env file")
+		return nil, fmt.Errorf("can not get the working directory, for the .env file: %w", err)
 	}
 
 	file, err := os.Open(path.Join(workDir, envFile)) //#nosec G304 -- secret path comes from an env
 	if err != nil {
-		log.Fatal().Err(err).Stack().Msg("Failed to open the specified .env file")
+		return nil, fmt.Errorf("failed to open the specified .env file: %w", err)
 	}
 	defer logdefer.LogDeferredErr(file.Close, log.Warn(), "error closing .env file")
 
@@ -329,14 +590,16 @@ func LoadEnvFile(envFile string) []string {
 		envs = append(envs, line)
 	}
 
-	return envs
+	return envs, nil
 }
 
 // CheckSettings makes sure your state is correct
-func CheckSettings(state *State, args *ArgsFlags) {
+func CheckSettings(state *State, args *ArgsFlags) error {
 	if args.SettingsWrite {
-		SaveSettings(state, args)
+		return SaveSettings(state, args)
 	}
+
+	return nil
 }
 
 func generateCruxEncryptionKey() string {
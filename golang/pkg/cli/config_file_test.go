@@ -0,0 +1,107 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func settingsDir(t *testing.T) string {
+	settingsPath, err := cli.SettingsPath()
+	assert.NoError(t, err)
+
+	return filepath.Dir(settingsPath)
+}
+
+func settingsFileLocation(t *testing.T, settingsPath, prefix string) string {
+	location, err := cli.SettingsFileLocation(settingsPath, prefix)
+	assert.NoError(t, err)
+
+	return location
+}
+
+func TestSettingsFileLocationByPrefix(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	dir := settingsDir(t)
+
+	assert.Equal(t, filepath.Join(dir, "settings.yaml"), settingsFileLocation(t, "", ""))
+	assert.Equal(t, filepath.Join(dir, "settings.yaml"), settingsFileLocation(t, "", cli.DefaultPrefix))
+	assert.Equal(t, filepath.Join(dir, "settings.dyo-feature.yaml"), settingsFileLocation(t, "", "dyo-feature"))
+	assert.Equal(t, filepath.Join(dir, "settings.yaml"), settingsFileLocation(t, "", "dyo-a,dyo-b"))
+	assert.Equal(t, "/etc/dyo.yaml", settingsFileLocation(t, "/etc/dyo.yaml", "dyo-feature"))
+	assert.Equal(t, filepath.Join(dir, "settings.______etc_passwd.yaml"), settingsFileLocation(t, "", "../../etc/passwd"))
+}
+
+func TestSettingsFileLocationOfLegacyStack(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	dir := settingsDir(t)
+	assert.NoError(t, os.MkdirAll(dir, 0o750))
+
+	// the stack created before the prefixes had their own settings keeps using the shared file
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "settings.yaml"), []byte("prefix: dyo-feature\n"), 0o600))
+	assert.Equal(t, filepath.Join(dir, "settings.yaml"), settingsFileLocation(t, "", "dyo-feature"))
+	assert.Equal(t, filepath.Join(dir, "settings.dyo-other.yaml"), settingsFileLocation(t, "", "dyo-other"))
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "settings.dyo-feature.yaml"), []byte("prefix: dyo-feature\n"), 0o600))
+	assert.Equal(t, filepath.Join(dir, "settings.dyo-feature.yaml"), settingsFileLocation(t, "", "dyo-feature"))
+}
+
+func TestStackFilesByPrefix(t *testing.T) {
+	args := &cli.ArgsFlags{SettingsFilePath: "/config/dyo-cli/settings.dyo-feature.yaml", Prefix: "dyo-feature"}
+	assert.Equal(t, "/config/dyo-cli/dyo-lock.dyo-feature.yaml", cli.LockFilePath(args))
+	assert.Equal(t, "/config/dyo-cli/backups.dyo-feature", cli.BackupRoot(args))
+
+	args = &cli.ArgsFlags{SettingsFilePath: "/config/dyo-cli/settings.yaml", Prefix: cli.DefaultPrefix}
+	assert.Equal(t, "/config/dyo-cli/dyo-lock.yaml", cli.LockFilePath(args))
+	assert.Equal(t, "/config/dyo-cli/backups", cli.BackupRoot(args))
+}
+
+func runStackPrefix(t *testing.T, settingsPath string, flags ...string) string {
+	prefix := ""
+	app := &ucli.App{
+		Flags: []ucli.Flag{&ucli.StringFlag{Name: cli.FlagPrefix, Value: cli.DefaultPrefix}},
+		Action: func(cCtx *ucli.Context) error {
+			exists, err := cli.SettingsExists(settingsPath)
+			if err != nil {
+				return err
+			}
+
+			args := &cli.ArgsFlags{SettingsFilePath: settingsPath, SettingsExists: exists}
+			prefix, err = cli.StackPrefix(cCtx, args)
+			return err
+		},
+	}
+	assert.NoError(t, app.Run(append([]string{"dyo"}, flags...)))
+
+	return prefix
+}
+
+func TestStackPrefix(t *testing.T) {
+	settingsPath := filepath.Join(t.TempDir(), "settings.yaml")
+	assert.Equal(t, cli.DefaultPrefix, runStackPrefix(t, settingsPath))
+	assert.Equal(t, "dyo-feature", runStackPrefix(t, settingsPath, "--prefix", "dyo-feature"))
+
+	assert.NoError(t, os.WriteFile(settingsPath, []byte("prefix: dyo-branch\n"), 0o600))
+	assert.Equal(t, "dyo-branch", runStackPrefix(t, settingsPath))
+	assert.Equal(t, "dyo-feature", runStackPrefix(t, settingsPath, "--prefix", "dyo-feature"))
+
+	assert.NoError(t, os.WriteFile(settingsPath, []byte("prefix: [\n"), 0o600))
+	app := &ucli.App{
+		Flags: []ucli.Flag{&ucli.StringFlag{Name: cli.FlagPrefix, Value: cli.DefaultPrefix}},
+		Action: func(cCtx *ucli.Context) error {
+			_, err := cli.StackPrefix(cCtx, &cli.ArgsFlags{SettingsFilePath: settingsPath, SettingsExists: true})
+			return err
+		},
+	}
+	assert.Error(t, app.Run([]string{"dyo"}))
+}
@@ -3,25 +3,26 @@
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
-	"net/http"
 	"net/url"
 	"os"
+	"runtime"
 	"strings"
-	"text/template"
 	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	nethelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
 	"github.com/dyrector-io/dyrectorio/golang/internal/label"
-	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 	dagentutils "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
 )
@@ -29,6 +30,7 @@
 const (
 	postgresImage    = "docker.io/library/postgres:13-alpine"
 	mailSlurperImage = "docker.io/oryd/mailslurper:smtps-latest"
+	traefikImage     = "docker.io/library/traefik:v2.9"
 )
 
 const (
@@ -45,6 +47,8 @@
 	defaultPostgresPort        = 5432
 	healhProbeTimeout          = 2 * time.Minute
 	healhProbeInterval         = time.Second
+	healthProbeMaxInterval     = 5 * time.Second
+	localtimePath              = "/etc/localtime"
 )
 
 func baseContainer(ctx context.Context, args *ArgsFlags) containerbuilder.Builder {
@@ -52,34 +56,42 @@ func baseContainer(ctx context.Context, args *ArgsFlags) containerbuilder.Builde
 		WithPullDisplayFunc(DockerPullProgressDisplayer).
 		WithLogWriter(nil).
 		WithoutConflict()
-	if args.PreferLocalImages {
+	switch {
+	case args.Offline:
+		builder.WithImagePriority(image.LocalOnly)
+	case args.PreferLocalImages:
 		builder.WithImagePriority(image.PreferLocal)
 	}
+	if args.MacOS {
+		builder.WithPreCreateHooks(warnUnsharedMounts)
+	}
 	return builder
 }
 
 // GetCrux services: db migrations and crux api service
 func GetCrux(state *State, args *ArgsFlags) containerbuilder.Builder {
 	crux := baseContainer(state.Ctx, args).
-		WithImage(fmt.Sprintf("%s:%s", state.Crux.Image, state.SettingsFile.Version)).
+		WithImage(state.serviceImage(string(crux))).
 		WithName(state.Containers.Crux.Name).
 		WithRestartPolicy(container.RestartPolicyAlways).
 		WithEnv(getCruxEnvs(state, args)).
+		WithMountPoints(localtimeMounts(state, args)).
 		WithNetworks([]string{state.SettingsFile.Network}).
 		WithNetworkAliases(state.Containers.Crux.Name).
 		WithCmd([]string{"serve"}).
-		WithLabels(map[string]string{
+		WithLabels(withTraefikRoutes(&state.SettingsFile.Options, map[string]string{
+			"com.docker.compose.project":                args.Prefix,
+			"com.docker.compose.service":                state.Containers.Crux.Name,
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		}, map[string]string{
 			"traefik.enable": "true",
-			"traefik.http.routers.crux.rule": fmt.Sprintf("(Host(`localhost`) || Host(`%s`) || Host(`%s`)) && "+
+			"traefik.http.routers.crux.rule": fmt.Sprintf("(%s) && "+
 				"PathPrefix(`/api`) && !PathPrefix(`/api/auth`) && !PathPrefix(`/api/status`) ",
-				state.Containers.Traefik.Name, state.InternalHostDomain),
-			"traefik.http.routers.crux.entrypoints":               "web",
+				traefikHostRule(&state.SettingsFile.TLS, localhost, state.Containers.Traefik.Name, state.InternalHostDomain)),
+			"traefik.http.routers.crux.entrypoints":               traefikEntrypoints(&state.SettingsFile.TLS),
 			"traefik.http.services.crux.loadbalancer.server.port": fmt.Sprintf("%d", defaultCruxHTTPPort),
-			"com.docker.compose.project":                          args.Prefix,
-			"com.docker.compose.service":                          state.Containers.Crux.Name,
-			label.DyrectorioOrg + label.ContainerPrefix:           args.Prefix,
-			label.DyrectorioOrg + label.ServiceCategory:           label.GetHiddenServiceCategory("internal"),
-		}).
+		})).
 		WithPreStartHooks(getCruxInitContainer(state, args))
 
 	if !args.FullyContainerized {
@@ -100,43 +112,36 @@ func GetCrux(state *State, args *ArgsFlags) containerbuilder.Builder {
 }
 
 func getCruxInitContainer(state *State, args *ArgsFlags) containerbuilder.LifecycleFunc {
-	envs := append([]string{
-		fmt.Sprintf("TZ=%s", state.SettingsFile.TimeZone),
-		fmt.Sprintf("DATABASE_URL=postgresql://%s:%s@%s:%d/%s?schema=public",
-			state.SettingsFile.CruxPostgresUser,
-			state.SettingsFile.CruxPostgresPassword,
-			state.Containers.CruxPostgres.Name,
-			defaultPostgresPort,
-			state.SettingsFile.CruxPostgresDB),
-		fmt.Sprintf("ENCRYPTION_SECRET_KEY=%s", state.SettingsFile.CruxEncryptionKey),
-	}, state.EnvFile...)
-
 	return func(ctx context.Context, _ client.APIClient,
 		_ containerbuilder.ParentContainer,
 	) error {
-		cruxMigrate := baseContainer(ctx, args).
-			WithImage(fmt.Sprintf("%s:%s", state.Crux.Image, state.SettingsFile.Version)).
-			WithName(state.Containers.CruxMigrate.Name).
-			WithEnv(envs).
-			WithNetworks([]string{state.SettingsFile.Network}).
-			WithNetworkAliases(state.Containers.CruxMigrate.Name).
-			WithCmd([]string{"migrate"}).
-			WithLabels(map[string]string{
-				"com.docker.compose.project":                args.Prefix,
-				"com.docker.compose.service":                state.Containers.CruxMigrate.Name,
-				label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
-				label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
-			})
-
-		cont, res, err := cruxMigrate.CreateAndStartWaitUntilExit()
-		if err != nil {
-			return errors.Join(err, fmt.Errorf("container %s exited with code: %d", cont.GetName(), res.StatusCode))
-		}
-		log.Info().Str("initContainer", cont.GetName()).Msgf("Started")
-		return nil
+		return runMigration(ctx, getCruxMigrate(ctx, state, args))
 	}
 }
 
+// getCruxMigrate returns the one-shot container migrating the database of crux
+func getCruxMigrate(ctx context.Context, state *State, args *ArgsFlags) containerbuilder.Builder {
+	envs := stackEnvs(state,
+		fmt.Sprintf("DATABASE_URL=%s", cruxPostgresConnection(state).prismaURL()),
+		fmt.Sprintf("ENCRYPTION_SECRET_KEY=%s", state.SettingsFile.CruxEncryptionKey),
+	)
+
+	return baseContainer(ctx, args).
+		WithImage(state.serviceImage(string(crux))).
+		WithName(state.Containers.CruxMigrate.Name).
+		WithEnv(envs).
+		WithMountPoints(localtimeMounts(state, args)).
+		WithNetworks([]string{state.SettingsFile.Network}).
+		WithNetworkAliases(state.Containers.CruxMigrate.Name).
+		WithCmd([]string{"migrate"}).
+		WithLabels(map[string]string{
+			"com.docker.compose.project":                args.Prefix,
+			"com.docker.compose.service":                state.Containers.CruxMigrate.Name,
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		})
+}
+
 func getCruxEnvs(state *State, args *ArgsFlags) []string {
 	envs := []string{}
 	host := localhost
@@ -158,35 +163,27 @@ func getCruxEnvs(state *State, args *ArgsFlags) []string {
 		cruxAgentAddr = fmt.Sprintf("%s:%d", host, state.SettingsFile.CruxAgentGrpcPort)
 	}
 	envs = append(envs,
-		fmt.Sprintf("TZ=%s", state.SettingsFile.TimeZone),
 		fmt.Sprintf("NODE_ENV=%s", "development"),
 		fmt.Sprintf("LOG_LEVEL=%s", "debug"),
-		fmt.Sprintf("DATABASE_URL=postgresql://%s:%s@%s:%d/%s?schema=public",
-			state.SettingsFile.CruxPostgresUser,
-			state.SettingsFile.CruxPostgresPassword,
-			state.Containers.CruxPostgres.Name,
-			defaultPostgresPort,
-			state.SettingsFile.CruxPostgresDB),
-		fmt.Sprintf("KRATOS_URL=http://%s:%d/kratos",
-			state.Containers.Traefik.Name,
-			defaultTraefikInternalPort),
+		fmt.Sprintf("DATABASE_URL=%s", cruxPostgresConnection(state).prismaURL()),
+		fmt.Sprintf("KRATOS_URL=%s", internalKratosURL(state)),
 		fmt.Sprintf("KRATOS_ADMIN_URL=http://%s:%d",
 			state.Containers.Kratos.Name,
 			state.SettingsFile.KratosAdminPort),
-		fmt.Sprintf("CRUX_UI_URL=http://%s:%d", traefikHost, state.SettingsFile.TraefikWebPort),
+		fmt.Sprintf("CRUX_UI_URL=%s", publicURL(&state.SettingsFile, traefikHost)),
 		fmt.Sprintf("CRUX_AGENT_ADDRESS=%s", cruxAgentAddr),
 		"LOCAL_DEPLOYMENT=true",
 		fmt.Sprintf("LOCAL_DEPLOYMENT_NETWORK=%s", state.SettingsFile.Network),
 		fmt.Sprintf("JWT_SECRET=%s", state.SettingsFile.CruxSecret),
 		fmt.Sprintf("FROM_NAME=%s", state.SettingsFile.MailFromName),
 		fmt.Sprintf("FROM_EMAIL=%s", state.SettingsFile.MailFromEmail),
-		fmt.Sprintf("SMTP_URI=%s:1025/?skip_ssl_verify=true&legacy_ssl=true", state.Containers.MailSlurper.Name),
+		fmt.Sprintf("SMTP_URI=%s", cruxSMTPURI(state)),
 		fmt.Sprintf("AGENT_INSTALL_SCRIPT_DISABLE_PULL=%t", true),
 		fmt.Sprintf("ENCRYPTION_SECRET_KEY=%s", state.SettingsFile.CruxEncryptionKey),
 		"DISABLE_RECAPTCHA=true",
 		"QA_OPT_OUT=true",
 	)
-	return append(envs, state.EnvFile...)
+	return stackEnvs(state, envs...)
 }
 
 // GetCruxUI returns a configured crux-ui service
@@ -196,39 +193,36 @@ func GetCruxUI(state *State, args *ArgsFlags) containerbuilder.Builder {
 		traefikHost = state.Containers.Traefik.Name
 	}
 
-	envs := append([]string{
-		fmt.Sprintf("TZ=%s", state.SettingsFile.TimeZone),
-		fmt.Sprintf("CRUX_UI_URL=http://%s:%d", traefikHost, state.SettingsFile.TraefikWebPort),
-		fmt.Sprintf("CRUX_URL=http://%s:%d",
-			state.Containers.Traefik.Name,
-			defaultTraefikInternalPort),
-		fmt.Sprintf("KRATOS_URL=http://%s:%d/kratos",
-			state.Containers.Traefik.Name,
-			defaultTraefikInternalPort),
+	envs := stackEnvs(state,
+		fmt.Sprintf("CRUX_UI_URL=%s", publicURL(&state.SettingsFile, traefikHost)),
+		fmt.Sprintf("CRUX_URL=%s", internalCruxURL(state)),
+		fmt.Sprintf("KRATOS_URL=%s", internalKratosURL(state)),
 		fmt.Sprintf("KRATOS_ADMIN_URL=http://%s:%d",
 			state.Containers.Kratos.Name,
 			state.SettingsFile.KratosAdminPort),
 		"DISABLE_RECAPTCHA=true",
-	}, state.EnvFile...)
+	)
 
 	cruxUI := baseContainer(state.Ctx, args).
-		WithImage(fmt.Sprintf("%s:%s", state.CruxUI.Image, state.SettingsFile.Version)).
+		WithImage(state.serviceImage(string(cruxUI))).
 		WithName(state.Containers.CruxUI.Name).
 		WithRestartPolicy(container.RestartPolicyAlways).
 		WithEnv(envs).
+		WithMountPoints(localtimeMounts(state, args)).
 		WithNetworks([]string{state.SettingsFile.Network}).
 		WithNetworkAliases(state.Containers.CruxUI.Name).
-		WithLabels(map[string]string{
+		WithLabels(withTraefikRoutes(&state.SettingsFile.Options, map[string]string{
+			"com.docker.compose.project":                args.Prefix,
+			"com.docker.compose.service":                state.Containers.CruxUI.Name,
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		}, map[string]string{
 			"traefik.enable": "true",
-			"traefik.http.routers.crux-ui.rule": fmt.Sprintf("Host(`%s`) || Host(`%s`) || Host(`%s`)", traefikHost, state.InternalHostDomain,
+			"traefik.http.routers.crux-ui.rule": traefikHostRule(&state.SettingsFile.TLS, traefikHost, state.InternalHostDomain,
 				state.Containers.Traefik.Name),
-			"traefik.http.routers.crux-ui.entrypoints":               "web",
+			"traefik.http.routers.crux-ui.entrypoints":               traefikEntrypoints(&state.SettingsFile.TLS),
 			"traefik.http.services.crux-ui.loadbalancer.server.port": fmt.Sprintf("%d", defaultCruxUIPort),
-			"com.docker.compose.project":                             args.Prefix,
-			"com.docker.compose.service":                             state.Containers.CruxUI.Name,
-			label.DyrectorioOrg + label.ContainerPrefix:              args.Prefix,
-			label.DyrectorioOrg + label.ServiceCategory:              label.GetHiddenServiceCategory("internal"),
-		})
+		}))
 
 	if !args.FullyContainerized {
 		cruxUI = cruxUI.
@@ -244,19 +238,20 @@ func GetCruxUI(state *State, args *ArgsFlags) containerbuilder.Builder {
 }
 
 // GetTraefik returns a traefik services container
-func GetTraefik(state *State, args *ArgsFlags) containerbuilder.Builder {
+func GetTraefik(state *State, args *ArgsFlags) (containerbuilder.Builder, error) {
 	envDockerHost := os.Getenv("DOCKER_HOST")
 
 	socket, err := url.Parse(client.DefaultDockerHost)
 	if err != nil {
-		log.Fatal().Err(err).Stack().Str("host", client.DefaultDockerHost).Msg("Failed to parse Docker host")
+		return nil, fmt.Errorf("failed to parse the docker host %s: %w", client.DefaultDockerHost, err)
 	}
 
-	// If traefik's socket is default, but we override it in the environment we prefer the environment
-	if state.SettingsFile.TraefikDockerSocket == socket.Path && envDockerHost != "" {
+	// If traefik's socket is default, but we override it in the environment we prefer the environment,
+	// except with Docker Desktop where the host side socket path is meaningless inside the VM
+	if state.SettingsFile.TraefikDockerSocket == socket.Path && envDockerHost != "" && !args.MacOS {
 		socket, err = url.Parse(envDockerHost)
 		if err != nil {
-			log.Fatal().Err(err).Stack().Str("host", envDockerHost).Msg("Failed to parse Docker host from environment")
+			return nil, fmt.Errorf("failed to parse the docker host %s from the environment: %w", envDockerHost, err)
 		}
 		state.SettingsFile.TraefikDockerSocket = socket.Path
 	}
@@ -269,27 +264,46 @@ func GetTraefik(state *State, args *ArgsFlags) containerbuilder.Builder {
 		"--providers.docker.exposedbydefault=false",
 		fmt.Sprintf("--entrypoints.web.address=:%d", defaultTraefikInternalPort),
 	}
+	commands = append(commands, traefikLifecycleArgs(state.SettingsFile.StopGracePeriods)...)
+	commands = append(commands, traefikTLSArgs(&state.SettingsFile.TLS)...)
 
-	if args.CruxUIDisabled {
+	if traefikFileProvider(state, args) || state.SettingsFile.TLS.Mode == TLSModeSelfSigned ||
+		state.SettingsFile.TraefikExtraConfigDir != "" {
 		commands = append(commands, "--providers.file.directory=/etc/traefik", "--providers.file.watch=true")
 	}
 
+	if args.MacOS && state.SettingsFile.TraefikDockerSocket != dockerDesktopSocket {
+		log.Warn().Str("socket", state.SettingsFile.TraefikDockerSocket).
+			Msgf("Docker Desktop only forwards its socket at %s, Traefik may not reach the engine", dockerDesktopSocket)
+	}
+
 	mountType := mount.TypeBind
 	if state.SettingsFile.TraefikIsDockerSocketNamedPipe {
 		mountType = mount.TypeNamedPipe
 	}
+	mounts := append(localtimeMounts(state, args), mount.Mount{
+		Type:   mountType,
+		Source: state.SettingsFile.TraefikDockerSocket,
+		Target: "/var/run/docker.sock",
+	})
+
+	// the issued certificates are kept, so they are not requested again on every start
+	volumes := []volume.CreateOptions{}
+	if state.SettingsFile.TLS.Mode == TLSModeACME {
+		acme := stackVolume(VolumeSettings{}, fmt.Sprintf("%s-acme", state.Containers.Traefik.Name), args)
+		volumes = append(volumes, acme)
+		mounts = append(mounts, mount.Mount{Type: mount.TypeVolume, Source: acme.Name, Target: traefikACMEStorage})
+	}
 
 	traefik := baseContainer(state.Ctx, args).
-		WithImage("docker.io/library/traefik:v2.9").
+		WithImage(state.serviceImage(string(traefik))).
 		WithName(state.Containers.Traefik.Name).
 		WithRestartPolicy(container.RestartPolicyAlways).
 		WithNetworks([]string{state.SettingsFile.Network}).
 		WithNetworkAliases(state.Containers.Traefik.Name).
-		WithMountPoints([]mount.Mount{{
-			Type:   mountType,
-			Source: state.SettingsFile.TraefikDockerSocket,
-			Target: "/var/run/docker.sock",
-		}}).
+		WithEnv(localeEnvs(&state.SettingsFile)).
+		WithVolumes(volumes...).
+		WithMountPoints(mounts).
 		WithCmd(commands).
 		WithLabels(map[string]string{
 			"com.docker.compose.project":                args.Prefix,
@@ -300,12 +314,25 @@ func GetTraefik(state *State, args *ArgsFlags) containerbuilder.Builder {
 		WithPostStartHooks(func(ctx context.Context, _ client.APIClient,
 			cont containerbuilder.ParentContainer,
 		) error {
+			if state.SettingsFile.TLS.Mode == TLSModeSelfSigned {
+				if err := copyTraefikTLS(ctx, cont.Name, args); err != nil {
+					return err
+				}
+			}
+			err := copyTraefikExtraConfig(ctx, cont.Name,
+				traefikTemplatePath(state.SettingsFile.TraefikExtraConfigDir, args.SettingsFilePath))
+			if err != nil {
+				return err
+			}
+
 			return CopyTraefikConfiguration(
 				ctx,
 				cont.Name,
 				state.InternalHostDomain,
+				traefikTemplatePath(state.SettingsFile.TraefikConfigTemplate, args.SettingsFilePath),
 				state.SettingsFile.CruxHTTPPort,
 				state.SettingsFile.CruxUIPort,
+				state.SettingsFile.TLS.Enabled(),
 			)
 		})
 
@@ -319,43 +346,56 @@ func GetTraefik(state *State, args *ArgsFlags) containerbuilder.Builder {
 				return healthProbe(ctx, addr)
 			})
 	} else {
-		traefik = traefik.
-			WithPortBindings([]containerbuilder.PortBinding{
-				{
-					ExposedPort: defaultTraefikInternalPort,
-					PortBinding: pointer.ToUint16(uint16(state.SettingsFile.TraefikWebPort)),
-				},
-				{
-					ExposedPort: defaultTraefikUIPort,
-					PortBinding: pointer.ToUint16(uint16(state.SettingsFile.TraefikUIPort)),
-				},
+		ports := []containerbuilder.PortBinding{
+			{
+				ExposedPort: defaultTraefikInternalPort,
+				PortBinding: pointer.ToUint16(uint16(state.SettingsFile.TraefikWebPort)),
+			},
+			{
+				ExposedPort: defaultTraefikUIPort,
+				PortBinding: pointer.ToUint16(uint16(state.SettingsFile.TraefikUIPort)),
+			},
+		}
+		if state.SettingsFile.TLS.Enabled() {
+			ports = append(ports, containerbuilder.PortBinding{
+				ExposedPort: defaultTraefikInternalTLSPort,
+				PortBinding: pointer.ToUint16(uint16(state.SettingsFile.TraefikTLSPort)),
 			})
+		}
+		traefik = traefik.WithPortBindings(ports)
 	}
-	return traefik
+	return traefik, nil
+}
+
+// traefikFileProvider tells if traefik loads the dynamic configuration copied into it, see CopyTraefikConfiguration
+func traefikFileProvider(state *State, args *ArgsFlags) bool {
+	return args.CruxUIDisabled || state.SettingsFile.TraefikConfigTemplate != ""
 }
 
 // GetKratos returns Kratos services' containers
 func GetKratos(state *State, args *ArgsFlags) containerbuilder.Builder {
 	kratos := baseContainer(state.Ctx, args).
-		WithImage(fmt.Sprintf("%s:%s", state.Kratos.Image, state.SettingsFile.Version)).
+		WithImage(state.serviceImage(string(kratos))).
 		WithName(state.Containers.Kratos.Name).
 		WithRestartPolicy(container.RestartPolicyAlways).
 		WithEnv(getKratosEnvs(state)).
+		WithMountPoints(localtimeMounts(state, args)).
 		WithNetworks([]string{state.SettingsFile.Network}).
 		WithNetworkAliases(state.Containers.Kratos.Name).
-		WithLabels(map[string]string{
+		WithLabels(withTraefikRoutes(&state.SettingsFile.Options, map[string]string{
+			"com.docker.compose.project":                args.Prefix,
+			"com.docker.compose.service":                state.Containers.Kratos.Name,
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		}, map[string]string{
 			"traefik.enable": "true",
-			"traefik.http.routers.kratos.rule": fmt.Sprintf("(Host(`localhost`) || Host(`%s`) || Host(`%s`)) && "+
-				"PathPrefix(`/kratos`)", state.Containers.Traefik.Name, state.InternalHostDomain),
-			"traefik.http.routers.kratos.entrypoints":                    "web",
+			"traefik.http.routers.kratos.rule": fmt.Sprintf("(%s) && PathPrefix(`/kratos`)",
+				traefikHostRule(&state.SettingsFile.TLS, localhost, state.Containers.Traefik.Name, state.InternalHostDomain)),
+			"traefik.http.routers.kratos.entrypoints":                    traefikEntrypoints(&state.SettingsFile.TLS),
 			"traefik.http.services.kratos.loadbalancer.server.port":      fmt.Sprintf("%d", defaultKratosPublicPort),
 			"traefik.http.middlewares.kratos-strip.stripprefix.prefixes": "/kratos",
 			"traefik.http.routers.kratos.middlewares":                    "kratos-strip",
-			"com.docker.compose.project":                                 args.Prefix,
-			"com.docker.compose.service":                                 state.Containers.Kratos.Name,
-			label.DyrectorioOrg + label.ContainerPrefix:                  args.Prefix,
-			label.DyrectorioOrg + label.ServiceCategory:                  label.GetHiddenServiceCategory("internal"),
-		}).
+		})).
 		WithPreStartHooks(getKratosInitContainer(state, args))
 
 	if !args.FullyContainerized {
@@ -376,38 +416,32 @@ func GetKratos(state *State, args *ArgsFlags) containerbuilder.Builder {
 }
 
 func getKratosInitContainer(state *State, args *ArgsFlags) containerbuilder.LifecycleFunc {
-	envs := append([]string{
+	return func(ctx context.Context, _ client.APIClient, _ containerbuilder.ParentContainer) error {
+		return runMigration(ctx, getKratosMigrate(state, args))
+	}
+}
+
+// getKratosMigrate returns the one-shot container migrating the database of kratos
+func getKratosMigrate(state *State, args *ArgsFlags) containerbuilder.Builder {
+	envs := stackEnvs(state,
 		"SQA_OPT_OUT=true",
-		fmt.Sprintf("DSN=postgresql://%s:%s@%s:%d/%s?sslmode=disable&max_conns=20&max_idle_conns=4",
-			state.SettingsFile.KratosPostgresUser,
-			state.SettingsFile.KratosPostgresPassword,
-			state.Containers.KratosPostgres.Name,
-			defaultPostgresPort,
-			state.SettingsFile.KratosPostgresDB),
-	}, state.EnvFile...)
-
-	return func(_ context.Context, _ client.APIClient, _ containerbuilder.ParentContainer) error {
-		kratosMigrate := baseContainer(state.Ctx, args).
-			WithImage(fmt.Sprintf("%s:%s", state.Kratos.Image, state.SettingsFile.Version)).
-			WithName(state.Containers.KratosMigrate.Name).
-			WithEnv(envs).
-			WithNetworks([]string{state.SettingsFile.Network}).
-			WithNetworkAliases(state.Containers.KratosMigrate.Name).
-			WithCmd([]string{"-c /etc/config/kratos/kratos.yaml", "migrate", "sql", "-e", "--yes"}).
-			WithLabels(map[string]string{
-				"com.docker.compose.project":                args.Prefix,
-				"com.docker.compose.service":                state.Containers.KratosMigrate.Name,
-				label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
-				label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
-			})
+		fmt.Sprintf("DSN=%s", kratosPostgresConnection(state).kratosDSN()),
+	)
 
-		cont, res, err := kratosMigrate.CreateAndStartWaitUntilExit()
-		if err != nil {
-			return errors.Join(err, fmt.Errorf("container %s exited with code: %d", cont.GetName(), res.StatusCode))
-		}
-		log.Info().Str("initContainer", cont.GetName()).Msgf("Started")
-		return nil
-	}
+	return baseContainer(state.Ctx, args).
+		WithImage(state.serviceImage(string(kratos))).
+		WithName(state.Containers.KratosMigrate.Name).
+		WithEnv(envs).
+		WithMountPoints(localtimeMounts(state, args)).
+		WithNetworks([]string{state.SettingsFile.Network}).
+		WithNetworkAliases(state.Containers.KratosMigrate.Name).
+		WithCmd([]string{"-c /etc/config/kratos/kratos.yaml", "migrate", "sql", "-e", "--yes"}).
+		WithLabels(map[string]string{
+			"com.docker.compose.project":                args.Prefix,
+			"com.docker.compose.service":                state.Containers.KratosMigrate.Name,
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		})
 }
 
 // getKratosEnvs returns kratos service's environmental variables
@@ -416,44 +450,34 @@ func getKratosEnvs(state *State) []string {
 
 	envs := []string{
 		"SQA_OPT_OUT=true",
-		fmt.Sprintf("DSN=postgresql://%s:%s@%s:%d/%s?sslmode=disable&max_conns=20&max_idle_conns=4",
-			state.SettingsFile.KratosPostgresUser,
-			state.SettingsFile.KratosPostgresPassword,
-			state.Containers.KratosPostgres.Name,
-			defaultPostgresPort,
-			state.SettingsFile.KratosPostgresDB),
-		fmt.Sprintf("KRATOS_URL=http://%s:%d/kratos",
-			traefikHost,
-			state.SettingsFile.TraefikWebPort),
+		fmt.Sprintf("DSN=%s", kratosPostgresConnection(state).kratosDSN()),
+		fmt.Sprintf("KRATOS_URL=%s/kratos", publicURL(&state.SettingsFile, traefikHost)),
 		fmt.Sprintf("KRATOS_ADMIN_URL=http://%s:%d",
 			state.Containers.Kratos.Name,
 			state.SettingsFile.KratosAdminPort),
-		fmt.Sprintf("AUTH_URL=http://%s:%d/auth",
-			traefikHost,
-			state.SettingsFile.TraefikWebPort),
-		fmt.Sprintf("CRUX_UI_URL=http://%s:%d",
-			traefikHost,
-			state.SettingsFile.TraefikWebPort),
+		fmt.Sprintf("AUTH_URL=%s/auth", publicURL(&state.SettingsFile, traefikHost)),
+		fmt.Sprintf("CRUX_UI_URL=%s", publicURL(&state.SettingsFile, traefikHost)),
 		"DEV=true",
 		"LOG_LEVEL=info",
 		"LOG_LEAK_SENSITIVE_VALUES=false",
 		fmt.Sprintf("SECRETS_COOKIE=%s", state.SettingsFile.KratosSecret),
-		fmt.Sprintf("SMTP_URI=%s:1025/?skip_ssl_verify=true&legacy_ssl=true", state.Containers.MailSlurper.Name),
-		fmt.Sprintf("COURIER_SMTP_CONNECTION_URI=smtps://test:test@%s:1025/?skip_ssl_verify=true&legacy_ssl=true",
-			state.Containers.MailSlurper.Name),
+		fmt.Sprintf("SMTP_URI=%s", cruxSMTPURI(state)),
+		fmt.Sprintf("COURIER_SMTP_CONNECTION_URI=%s", kratosSMTPURI(state)),
 		fmt.Sprintf("FROM_NAME=%s", state.SettingsFile.MailFromName),
 		fmt.Sprintf("FROM_EMAIL=%s", state.SettingsFile.MailFromEmail),
 	}
 
-	return append(envs, state.EnvFile...)
+	return stackEnvs(state, envs...)
 }
 
 // GetMailSlurper returns the mailslurper service's container
 func GetMailSlurper(state *State, args *ArgsFlags) containerbuilder.Builder {
 	mailslurper := baseContainer(state.Ctx, args).
-		WithImage(mailSlurperImage).
+		WithImage(state.serviceImage(string(mailSlurper))).
 		WithName(state.Containers.MailSlurper.Name).
 		WithRestartPolicy(container.RestartPolicyAlways).
+		WithEnv(localeEnvs(&state.SettingsFile)).
+		WithMountPoints(localtimeMounts(state, args)).
 		WithNetworks([]string{state.SettingsFile.Network}).
 		WithNetworkAliases(state.Containers.MailSlurper.Name).
 		WithLabels(map[string]string{
@@ -485,16 +509,17 @@ func GetMailSlurper(state *State, args *ArgsFlags) containerbuilder.Builder {
 
 // GetCruxPostgres returns crux's Postgres services' containers
 func GetCruxPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
-	envs := append([]string{
+	envs := stackEnvs(state,
 		fmt.Sprintf("POSTGRES_USER=%s", state.SettingsFile.CruxPostgresUser),
 		fmt.Sprintf("POSTGRES_PASSWORD=%s", state.SettingsFile.CruxPostgresPassword),
 		fmt.Sprintf("POSTGRES_DB=%s", state.SettingsFile.CruxPostgresDB),
-	}, state.EnvFile...)
+	)
 
 	cruxPostgres := getBasePostgres(state, args).
 		WithName(state.Containers.CruxPostgres.Name).
 		WithNetworkAliases(state.Containers.CruxPostgres.Name).
 		WithEnv(envs).
+		WithCmd(state.SettingsFile.CruxPostgresTuning.Cmd()).
 		WithLabels(map[string]string{
 			"com.docker.compose.project":                args.Prefix,
 			"com.docker.compose.service":                state.Containers.CruxPostgres.Name,
@@ -503,6 +528,7 @@ func GetCruxPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
 		})
 
 	if !args.FullyContainerized {
+		dataVolume := postgresVolume(state.SettingsFile.CruxPostgresVolume, state.Containers.CruxPostgres.Name, args)
 		cruxPostgres = cruxPostgres.
 			WithPortBindings([]containerbuilder.PortBinding{
 				{
@@ -510,11 +536,12 @@ func GetCruxPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
 					PortBinding: pointer.ToUint16(uint16(state.SettingsFile.CruxPostgresPort)),
 				},
 			}).
-			WithMountPoints([]mount.Mount{{
+			WithVolumes(dataVolume).
+			WithMountPoints(append(localtimeMounts(state, args), mount.Mount{
 				Type:   mount.TypeVolume,
-				Source: fmt.Sprintf("%s-data", state.Containers.CruxPostgres.Name),
+				Source: dataVolume.Name,
 				Target: "/var/lib/postgresql/data",
-			}})
+			}))
 	}
 
 	return cruxPostgres
@@ -522,16 +549,17 @@ func GetCruxPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
 
 // GetKratosPostgres returns crux's Postgres services' containers
 func GetKratosPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
-	envs := append([]string{
+	envs := stackEnvs(state,
 		fmt.Sprintf("POSTGRES_USER=%s", state.SettingsFile.KratosPostgresUser),
 		fmt.Sprintf("POSTGRES_PASSWORD=%s", state.SettingsFile.KratosPostgresPassword),
 		fmt.Sprintf("POSTGRES_DB=%s", state.SettingsFile.KratosPostgresDB),
-	}, state.EnvFile...)
+	)
 
 	kratosPostgres := getBasePostgres(state, args).
 		WithEnv(envs).
 		WithName(state.Containers.KratosPostgres.Name).
 		WithNetworkAliases(state.Containers.KratosPostgres.Name).
+		WithCmd(state.SettingsFile.KratosPostgresTuning.Cmd()).
 		WithLabels(map[string]string{
 			"com.docker.compose.project":                args.Prefix,
 			"com.docker.compose.service":                state.Containers.KratosPostgres.Name,
@@ -540,6 +568,7 @@ func GetKratosPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
 		})
 
 	if !args.FullyContainerized {
+		dataVolume := postgresVolume(state.SettingsFile.KratosPostgresVolume, state.Containers.KratosPostgres.Name, args)
 		kratosPostgres = kratosPostgres.
 			WithPortBindings([]containerbuilder.PortBinding{
 				{
@@ -547,52 +576,92 @@ func GetKratosPostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
 					PortBinding: pointer.ToUint16(uint16(state.SettingsFile.KratosPostgresPort)),
 				},
 			}).
-			WithMountPoints([]mount.Mount{
-				{
-					Type:   mount.TypeVolume,
-					Source: fmt.Sprintf("%s-data", state.Containers.KratosPostgres.Name),
-					Target: "/var/lib/postgresql/data",
-				},
-			})
+			WithVolumes(dataVolume).
+			WithMountPoints(append(localtimeMounts(state, args), mount.Mount{
+				Type:   mount.TypeVolume,
+				Source: dataVolume.Name,
+				Target: "/var/lib/postgresql/data",
+			}))
 	}
 
 	return kratosPostgres
 }
 
+// localeEnvs are the timezone and the locale of the stack containers, so the times in their logs
+// and e-mails match the ones shown by the UI
+func localeEnvs(settings *SettingsFile) []string {
+	envs := []string{fmt.Sprintf("TZ=%s", settings.TimeZone)}
+	if settings.Locale != "" {
+		envs = append(envs, fmt.Sprintf("LANG=%s", settings.Locale))
+	}
+
+	return envs
+}
+
+// stackEnvs are the locale, the given and the env file variables, the env file comes last to override the others
+func stackEnvs(state *State, envs ...string) []string {
+	return append(append(localeEnvs(&state.SettingsFile), envs...), state.EnvFile...)
+}
+
+// localtimeMounts bind the zone of the host into the containers, for images without the zone database,
+// it is skipped with Docker Desktop, where the containers run in a VM and only TZ is set
+func localtimeMounts(state *State, args *ArgsFlags) []mount.Mount {
+	if !state.SettingsFile.MountLocaltime {
+		return []mount.Mount{}
+	}
+	if args.MacOS || runtime.GOOS == "windows" {
+		return []mount.Mount{}
+	}
+
+	return []mount.Mount{{
+		Type:     mount.TypeBind,
+		Source:   localtimePath,
+		Target:   localtimePath,
+		ReadOnly: true,
+	}}
+}
+
 // getBasePostgres removes some code duplication
 func getBasePostgres(state *State, args *ArgsFlags) containerbuilder.Builder {
 	basePostgres := baseContainer(state.Ctx, args).
-		WithImage(postgresImage).
+		WithImage(state.serviceImage(postgresService)).
+		WithMountPoints(localtimeMounts(state, args)).
 		WithNetworks([]string{state.SettingsFile.Network}).
 		WithRestartPolicy(container.RestartPolicyAlways)
 	return basePostgres
 }
 
-// CopyTraefikConfiguration copies a config file to Traefik Container
-func CopyTraefikConfiguration(ctx context.Context, name, internalHostDomain string, cruxPort, cruxUIPort uint) error {
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-	if err != nil {
-		return err
+// postgresVolume keeps the implicit <container>-data name unless configured otherwise
+func postgresVolume(settings VolumeSettings, containerName string, args *ArgsFlags) volume.CreateOptions {
+	return stackVolume(settings, fmt.Sprintf("%s-data", containerName), args)
+}
+
+// stackVolume is a volume of the stack labelled with its prefix, named by the settings or the default name
+func stackVolume(settings VolumeSettings, defaultName string, args *ArgsFlags) volume.CreateOptions {
+	labels := map[string]string{
+		"com.docker.compose.project":                args.Prefix,
+		label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
 	}
-	traefikFileProviderTemplate, err := traefikTmpl.ReadFile("traefik.yaml.tmpl")
-	if err != nil {
-		log.Fatal().Err(err).Stack().Msg("couldn't read embedded file")
+	maps.Copy(labels, settings.Labels)
+
+	return volume.CreateOptions{
+		Name:       util.Fallback(settings.Name, defaultName),
+		Driver:     settings.Driver,
+		DriverOpts: settings.DriverOpts,
+		Labels:     labels,
 	}
+}
 
-	traefikConfig, err := template.New("traefikconfig").Parse(string(traefikFileProviderTemplate))
+// CopyTraefikConfiguration copies a config file to Traefik Container
+func CopyTraefikConfiguration(ctx context.Context, name, internalHostDomain, templatePath string, cruxPort, cruxUIPort uint,
+	tls bool,
+) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		return err
 	}
 
-	var result bytes.Buffer
-
-	traefikData := traefikFileProviderData{
-		InternalHost: internalHostDomain,
-		CruxUIPort:   cruxUIPort,
-		CruxPort:     cruxPort,
-	}
-
-	err = traefikConfig.Execute(&result, traefikData)
+	result, err := renderTraefikConfiguration(internalHostDomain, templatePath, cruxPort, cruxUIPort, tls)
 	if err != nil {
 		return err
 	}
@@ -609,50 +678,47 @@ func CopyTraefikConfiguration(ctx context.Context, name, internalHostDomain stri
 		name,
 		"traefik/dynamic_conf.yml",
 		data,
-		int64(len([]rune(result.String()))),
-		strings.NewReader(result.String()),
+		int64(len([]rune(result))),
+		strings.NewReader(result),
 	)
 
 	return err
 }
 
-func healthProbe(ctx context.Context, address string) error {
-	ctx, cancel := context.WithTimeout(ctx, healhProbeTimeout)
-	defer cancel()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, http.NoBody)
+// renderTraefikConfiguration renders the dynamic configuration of traefik from the template
+func renderTraefikConfiguration(internalHostDomain, templatePath string, cruxPort, cruxUIPort uint, tls bool) (string, error) {
+	traefikConfig, err := loadTraefikTemplate(templatePath)
 	if err != nil {
-		return fmt.Errorf("failed to create the http request for healh probe: %s", err.Error())
+		return "", err
 	}
 
-	log.Info().Str("address", address).Msg("Health probing")
+	var result bytes.Buffer
 
-	ticker := time.NewTicker(healhProbeInterval)
-	lastStatusCode := -1
-
-	for {
-		select {
-		case <-ticker.C:
-			//nolint:bodyclose //closed already
-			resp, reqErr := http.DefaultClient.Do(req)
-			err = reqErr
-			if reqErr != nil {
-				continue
-			}
+	traefikData := traefikFileProviderData{
+		InternalHost: internalHostDomain,
+		CruxUIPort:   cruxUIPort,
+		CruxPort:     cruxPort,
+		TLS:          tls,
+	}
 
-			logdefer.LogDeferredErr(resp.Body.Close, log.Debug(), "failed to close the response body while health probing")
+	err = traefikConfig.Execute(&result, traefikData)
+	if err != nil {
+		return "", err
+	}
 
-			if resp.StatusCode == http.StatusOK {
-				return nil
-			}
+	return result.String(), nil
+}
 
-			lastStatusCode = resp.StatusCode
-		case <-ctx.Done():
-			if err != nil {
-				return fmt.Errorf("failed to execute request for %s health probe: %s", address, err.Error())
-			}
+func healthProbe(ctx context.Context, address string) error {
+	ctx, cancel := context.WithTimeout(ctx, healhProbeTimeout)
+	defer cancel()
 
-			return fmt.Errorf("health probe timeout for %s, last status code: %d", address, lastStatusCode)
-		}
+	log.Info().Str("address", address).Msg("Health probing")
+
+	err := nethelper.WaitForHTTP(ctx, address, nethelper.Backoff{Initial: healhProbeInterval, Max: healthProbeMaxInterval})
+	if err != nil {
+		return fmt.Errorf("health probe failed for %s: %w", address, err)
 	}
+
+	return nil
 }
@@ -0,0 +1,57 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestPostgresVolumeDefaultName(t *testing.T) {
+	vol := cli.PostgresVolume(cli.VolumeSettings{Driver: "local"}, "dyo-stable_crux-postgres", &cli.ArgsFlags{Prefix: "dyo-stable"})
+
+	assert.Equal(t, "dyo-stable_crux-postgres-data", vol.Name)
+	assert.Equal(t, "local", vol.Driver)
+	assert.Equal(t, "dyo-stable", vol.Labels["org.dyrectorio.container.prefix"])
+}
+
+func TestPostgresVolumeFromSettings(t *testing.T) {
+	vol := cli.PostgresVolume(cli.VolumeSettings{
+		Name:       "crux-db",
+		Driver:     "local",
+		DriverOpts: map[string]string{"type": "nfs", "device": ":/exports/crux"},
+		Labels:     map[string]string{"backup": "daily"},
+	}, "dyo-stable_crux-postgres", &cli.ArgsFlags{Prefix: "dyo-stable"})
+
+	assert.Equal(t, "crux-db", vol.Name)
+	assert.Equal(t, "nfs", vol.DriverOpts["type"])
+	assert.Equal(t, "daily", vol.Labels["backup"])
+	assert.Equal(t, "dyo-stable", vol.Labels["com.docker.compose.project"])
+}
+
+func TestStackEnvs(t *testing.T) {
+	state := &cli.State{EnvFile: []string{"TZ=Europe/Budapest"}}
+	state.SettingsFile.TimeZone = "UTC"
+
+	assert.Equal(t, []string{"TZ=UTC", "NODE_ENV=production", "TZ=Europe/Budapest"}, cli.StackEnvs(state, "NODE_ENV=production"))
+
+	state.SettingsFile.Locale = "hu_HU.UTF-8"
+	assert.Equal(t, []string{"TZ=UTC", "LANG=hu_HU.UTF-8", "TZ=Europe/Budapest"}, cli.StackEnvs(state))
+}
+
+func TestLocaltimeMounts(t *testing.T) {
+	state := &cli.State{}
+	assert.Empty(t, cli.LocaltimeMounts(state, &cli.ArgsFlags{}))
+
+	state.SettingsFile.MountLocaltime = true
+	assert.Empty(t, cli.LocaltimeMounts(state, &cli.ArgsFlags{MacOS: true}))
+
+	mounts := cli.LocaltimeMounts(state, &cli.ArgsFlags{})
+	assert.Len(t, mounts, 1)
+	assert.Equal(t, "/etc/localtime", mounts[0].Source)
+	assert.True(t, mounts[0].ReadOnly)
+}
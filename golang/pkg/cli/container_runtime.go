@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/docker/docker/api/types/system"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	containerRuntime "github.com/dyrector-io/dyrectorio/golang/internal/runtime/container"
+)
+
+const (
+	RuntimeAuto   = "auto"
+	RuntimeDocker = containerRuntime.Docker
+	RuntimePodman = containerRuntime.Podman
+
+	rootfulPodmanSocket = "/run/podman/podman.sock"
+)
+
+var (
+	ErrInvalidRuntime       = errors.New("invalid container runtime")
+	ErrPodmanSocketNotFound = errors.New("podman socket not found")
+	ErrRuntimeMismatch      = errors.New("the daemon is not the selected container runtime")
+)
+
+// defaultNetworks are the networks the runtimes create, they do not resolve the names of the containers
+var defaultNetworks = map[string]string{
+	containerRuntime.Docker: "bridge",
+	containerRuntime.Podman: "podman",
+}
+
+// podmanSockets are the API sockets of podman, the rootless one of the user comes first unless dyo runs as root
+func podmanSockets(runtimeDir string, root bool) []string {
+	sockets := []string{}
+	if runtimeDir != "" && !root {
+		sockets = append(sockets, path.Join(runtimeDir, "podman", "podman.sock"))
+	}
+
+	return append(sockets, rootfulPodmanSocket)
+}
+
+// runtimeHost is the address of the daemon of the runtime, empty keeps the default of the client,
+// auto only falls back to podman if there is no docker socket, an address in DOCKER_HOST is always kept
+func runtimeHost(runtime, envHost string, sockets []string, exists func(string) bool) (string, error) {
+	switch runtime {
+	case RuntimeAuto, RuntimeDocker, RuntimePodman:
+	default:
+		return "", fmt.Errorf("%w: %s, use %s, %s or %s", ErrInvalidRuntime, runtime, RuntimeAuto, RuntimeDocker, RuntimePodman)
+	}
+
+	if envHost != "" || runtime == RuntimeDocker || (runtime == RuntimeAuto && exists(defaultDockerSocket)) {
+		return "", nil
+	}
+
+	for _, socket := range sockets {
+		if exists(socket) {
+			return "unix://" + socket, nil
+		}
+	}
+
+	if runtime == RuntimePodman {
+		return "", fmt.Errorf("%w at %v, enable it with: systemctl --user enable --now podman.socket", ErrPodmanSocketNotFound, sockets)
+	}
+
+	return "", nil
+}
+
+func socketExists(socket string) bool {
+	_, err := os.Stat(socket)
+	return err == nil
+}
+
+// selectRuntime points the clients to the daemon of the runtime, all of them are created from the environment,
+// so does the socket mounted into Traefik
+func selectRuntime(runtime string) error {
+	host, err := runtimeHost(runtime, os.Getenv(client.EnvOverrideHost),
+		podmanSockets(os.Getenv("XDG_RUNTIME_DIR"), os.Geteuid() == 0), socketExists)
+	if err != nil {
+		return err
+	}
+	if host == "" {
+		return nil
+	}
+
+	log.Info().Str("host", host).Msg("Using the podman socket")
+
+	return os.Setenv(client.EnvOverrideHost, host)
+}
+
+// checkRuntime fails if the daemon is not the runtime selected with the flag
+func checkRuntime(selected, detected string) error {
+	if selected == RuntimeAuto || selected == "" || selected == detected {
+		return nil
+	}
+
+	return fmt.Errorf("%w: --%s is %s, but the daemon is %s", ErrRuntimeMismatch, FlagRuntime, selected, detected)
+}
+
+// rootlessPortsWarning is the warning about the ports of the stack a rootless daemon can not bind, rootless podman
+// is the usual setup, the ports only work if the limit is lowered on the host, so it is not an error
+func rootlessPortsWarning(info *system.Info, ports map[uint]string) (string, bool) {
+	if !isRootlessDaemon(info) {
+		return "", false
+	}
+
+	privileged, lowest := privilegedPorts(ports)
+	if len(privileged) == 0 {
+		return "", false
+	}
+
+	return fmt.Sprintf("The rootless daemon can not bind the ports below %d: %s, change the ports in the settings, "+
+		"or allow them with: sudo sysctl net.ipv4.ip_unprivileged_port_start=%d",
+		privilegedPortLimit, strings.Join(privileged, ", "), lowest), true
+}
+
+// warnRootlessPorts warns once if the daemon is rootless and the stack has privileged ports
+func warnRootlessPorts(ctx context.Context, cli client.APIClient, settings *SettingsFile, args *ArgsFlags) {
+	info, err := cli.Info(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to get the information of the daemon for its rootless mode")
+		return
+	}
+
+	if warning, ok := rootlessPortsWarning(&info, stackPorts(settings, args)); ok {
+		NotifyOnce("rootlessports", func() {
+			log.Warn().Msg(warning)
+		})
+	}
+}
+
+// internalHostDomain is the name of the host inside the containers, Docker Desktop forwards host.docker.internal
+// even if the daemon is mistaken for podman, the podman machine of macOS resolves its own name
+func internalHostDomain(runtime string, args *ArgsFlags) string {
+	if runtime == containerRuntime.Podman && (!args.MacOS || args.Runtime == RuntimePodman) {
+		return containerRuntime.PodmanHost
+	}
+
+	return containerRuntime.DockerHost
+}
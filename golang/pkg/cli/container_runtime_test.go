@@ -0,0 +1,127 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/system"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestPodmanSockets(t *testing.T) {
+	assert.Equal(t, []string{"/run/user/1000/podman/podman.sock", "/run/podman/podman.sock"}, cli.PodmanSockets("/run/user/1000", false))
+	assert.Equal(t, []string{"/run/podman/podman.sock"}, cli.PodmanSockets("/run/user/1000", true))
+	assert.Equal(t, []string{"/run/podman/podman.sock"}, cli.PodmanSockets("", false))
+}
+
+func TestRuntimeHost(t *testing.T) {
+	sockets := cli.PodmanSockets("/run/user/1000", false)
+	existing := func(paths ...string) func(string) bool {
+		return func(socket string) bool {
+			for _, it := range paths {
+				if it == socket {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	testCases := []struct {
+		exists  func(string) bool
+		name    string
+		runtime string
+		envHost string
+		host    string
+		err     error
+	}{
+		{
+			name:    "docker",
+			runtime: cli.RuntimeDocker,
+			exists:  existing("/run/podman/podman.sock"),
+		},
+		{
+			name:    "auto prefers docker",
+			runtime: cli.RuntimeAuto,
+			exists:  existing("/var/run/docker.sock", "/run/user/1000/podman/podman.sock"),
+		},
+		{
+			name:    "auto falls back to rootless podman",
+			runtime: cli.RuntimeAuto,
+			exists:  existing("/run/user/1000/podman/podman.sock", "/run/podman/podman.sock"),
+			host:    "unix:///run/user/1000/podman/podman.sock",
+		},
+		{
+			name:    "auto without any socket",
+			runtime: cli.RuntimeAuto,
+			exists:  existing(),
+		},
+		{
+			name:    "rootful podman",
+			runtime: cli.RuntimePodman,
+			exists:  existing("/var/run/docker.sock", "/run/podman/podman.sock"),
+			host:    "unix:///run/podman/podman.sock",
+		},
+		{
+			name:    "podman keeps the environment",
+			runtime: cli.RuntimePodman,
+			envHost: "tcp://10.0.0.2:8080",
+			exists:  existing(),
+		},
+		{
+			name:    "podman without socket",
+			runtime: cli.RuntimePodman,
+			exists:  existing("/var/run/docker.sock"),
+			err:     cli.ErrPodmanSocketNotFound,
+		},
+		{
+			name:    "invalid",
+			runtime: "containerd",
+			exists:  existing(),
+			err:     cli.ErrInvalidRuntime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			host, err := cli.RuntimeHost(tc.runtime, tc.envHost, sockets, tc.exists)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.host, host)
+		})
+	}
+}
+
+func TestCheckRuntime(t *testing.T) {
+	assert.NoError(t, cli.CheckRuntime(cli.RuntimeAuto, cli.RuntimePodman))
+	assert.NoError(t, cli.CheckRuntime(cli.RuntimePodman, cli.RuntimePodman))
+	assert.ErrorIs(t, cli.CheckRuntime(cli.RuntimePodman, cli.RuntimeDocker), cli.ErrRuntimeMismatch)
+}
+
+func TestInternalHostDomain(t *testing.T) {
+	assert.Equal(t, "host.docker.internal", cli.InternalHostDomain(cli.RuntimeDocker, &cli.ArgsFlags{Runtime: cli.RuntimeAuto}))
+	assert.Equal(t, "host.containers.internal", cli.InternalHostDomain(cli.RuntimePodman, &cli.ArgsFlags{Runtime: cli.RuntimeAuto}))
+	assert.Equal(t, "host.docker.internal",
+		cli.InternalHostDomain(cli.RuntimePodman, &cli.ArgsFlags{Runtime: cli.RuntimeAuto, MacOS: true}))
+	assert.Equal(t, "host.containers.internal",
+		cli.InternalHostDomain(cli.RuntimePodman, &cli.ArgsFlags{Runtime: cli.RuntimePodman, MacOS: true}))
+}
+
+func TestRootlessPortsWarning(t *testing.T) {
+	rootless := &system.Info{SecurityOptions: []string{"name=seccomp,profile=default", "name=rootless"}}
+	ports := map[uint]string{8000: "traefik proxy", 443: "traefik TLS"}
+
+	warning, ok := cli.RootlessPortsWarning(rootless, ports)
+	assert.True(t, ok)
+	assert.Contains(t, warning, "can not bind the ports below 1024: 443 (traefik TLS)")
+	assert.Contains(t, warning, "net.ipv4.ip_unprivileged_port_start=443")
+
+	_, ok = cli.RootlessPortsWarning(rootless, map[uint]string{8000: "traefik proxy"})
+	assert.False(t, ok)
+
+	_, ok = cli.RootlessPortsWarning(&system.Info{}, ports)
+	assert.False(t, ok)
+}
@@ -0,0 +1,368 @@
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	"github.com/dyrector-io/dyrectorio/golang/internal/version"
+)
+
+const (
+	DebugCommand = "debug"
+)
+
+const (
+	FlagBundleOutput = "output"
+	FlagBundleTail   = "tail"
+	FlagBundleSince  = "since"
+	FlagBundleNoLogs = "no-logs"
+)
+
+const (
+	defaultBundleTail = 1000
+	redacted          = "[REDACTED]"
+)
+
+// env variables of the stack holding credentials, their values never leave the machine
+var sensitiveEnvPattern = regexp.MustCompile(`(?i)(SECRET|PASSWORD|PASS|KEY|TOKEN|DSN|DATABASE_URL|COOKIE)`)
+
+type bundleOptions struct {
+	Since  string
+	Output string
+	Prefix string
+	Tail   uint
+	NoLogs bool
+}
+
+type bundleVersions struct {
+	CLI      string `json:"cli"`
+	Go       string `json:"go"`
+	OS       string `json:"os"`
+	Docker   string `json:"docker"`
+	DockerOS string `json:"dockerOs"`
+}
+
+func GetDebugCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:   DebugCommand,
+		Action: ucli.ShowSubcommandHelp,
+		Usage:  "dyo debug bundle",
+		Subcommands: []*ucli.Command{{
+			Name:  "bundle",
+			Usage: "Collect the logs, inspect output, redacted settings and versions of the stack into an archive for bug reports",
+			Flags: []ucli.Flag{
+				&ucli.StringFlag{
+					Name:    FlagBundleOutput,
+					Aliases: []string{"o"},
+					Value:   "",
+					Usage:   "path of the archive, defaults to dyo-debug-<timestamp>.tar.gz in the working directory",
+				},
+				&ucli.UintFlag{
+					Name:  FlagBundleTail,
+					Value: defaultBundleTail,
+					Usage: "number of log lines collected per container, 0 collects every line",
+				},
+				&ucli.StringFlag{
+					Name:  FlagBundleSince,
+					Value: "",
+					Usage: "only collect logs newer than this, eg. 30m or an RFC 3339 timestamp",
+				},
+				&ucli.BoolFlag{
+					Name:  FlagBundleNoLogs,
+					Value: false,
+					Usage: "leave out container logs",
+				},
+			},
+			Action: func(cCtx *ucli.Context) error {
+				opts := bundleOptions{
+					Output: cCtx.String(FlagBundleOutput),
+					Tail:   cCtx.Uint(FlagBundleTail),
+					Since:  cCtx.String(FlagBundleSince),
+					NoLogs: cCtx.Bool(FlagBundleNoLogs),
+					Prefix: cCtx.String(FlagPrefix),
+				}
+				if opts.Output == "" {
+					opts.Output = fmt.Sprintf("dyo-debug-%s.tar.gz", time.Now().Format("20060102-150405"))
+				}
+
+				settingsPath, err := settingsLocation(cCtx)
+				if err != nil {
+					return err
+				}
+				if err := writeDebugBundle(cCtx.Context, settingsPath, &opts); err != nil {
+					return err
+				}
+
+				log.Info().Str("path", opts.Output).Msg("Debug bundle is ready, please check it before attaching it to a bug report")
+				return nil
+			},
+		}},
+	}
+}
+
+type bundleWriter struct {
+	tw      *tar.Writer
+	secrets []string
+}
+
+func writeDebugBundle(ctx context.Context, settingsPath string, opts *bundleOptions) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	file, err := os.OpenFile(opts.Output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePerms)
+	if err != nil {
+		return fmt.Errorf("failed to create debug bundle: %w", err)
+	}
+	defer logdefer.LogDeferredErr(file.Close, log.Warn(), "error closing debug bundle")
+
+	gz := gzip.NewWriter(file)
+	bundle := &bundleWriter{tw: tar.NewWriter(gz)}
+
+	settings, settingsErr := readBundleSettings(settingsPath)
+	if settingsErr != nil {
+		log.Warn().Err(settingsErr).Msg("Settings are left out of the bundle")
+	} else {
+		bundle.secrets = settingsSecrets(settings)
+		if err = bundle.addYAML("settings.yaml", redactSettings(settings)); err != nil {
+			return err
+		}
+	}
+
+	if err = bundle.addJSON("versions.json", collectVersions(ctx, cli)); err != nil {
+		return err
+	}
+
+	if err = bundle.addContainers(ctx, cli, opts); err != nil {
+		return err
+	}
+
+	if err = bundle.tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish debug bundle: %w", err)
+	}
+
+	return gz.Close()
+}
+
+func readBundleSettings(settingsPath string) (*SettingsFile, error) {
+	settings := &SettingsFile{}
+	if err := cleanenv.ReadConfig(settingsPath, settings); err != nil {
+		return nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func collectVersions(ctx context.Context, cli client.APIClient) bundleVersions {
+	versions := bundleVersions{
+		CLI: version.BuildVersion(),
+		Go:  runtime.Version(),
+		OS:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+
+	server, err := cli.ServerVersion(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to get the docker version")
+		return versions
+	}
+	versions.Docker = server.Version
+	versions.DockerOS = server.Os + "/" + server.Arch
+
+	return versions
+}
+
+func (b *bundleWriter) addContainers(ctx context.Context, cli client.APIClient, opts *bundleOptions) error {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(opts.Prefix))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the containers of the stack: %w", err)
+	}
+
+	for i := range containers {
+		name := strings.TrimPrefix(containers[i].Names[0], "/")
+
+		inspect, err := cli.ContainerInspect(ctx, containers[i].ID)
+		if err != nil {
+			log.Warn().Err(err).Str("container", name).Msg("Failed to inspect container")
+			continue
+		}
+		if inspect.Config != nil {
+			inspect.Config.Env = redactEnv(inspect.Config.Env)
+		}
+		if err = b.addJSON(name+"/inspect.json", inspect); err != nil {
+			return err
+		}
+
+		if opts.NoLogs {
+			continue
+		}
+
+		logs, err := containerLogs(ctx, cli, containers[i].ID, opts)
+		if err != nil {
+			log.Warn().Err(err).Str("container", name).Msg("Failed to read container logs")
+			continue
+		}
+		if err = b.add(name+"/logs.txt", []byte(redactValues(logs, b.secrets))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containerLogs(ctx context.Context, cli client.APIClient, id string, opts *bundleOptions) (string, error) {
+	tail := "all"
+	if opts.Tail > 0 {
+		tail = strconv.FormatUint(uint64(opts.Tail), 10)
+	}
+
+	reader, err := cli.ContainerLogs(ctx, id, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Tail:       tail,
+		Since:      opts.Since,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer logdefer.LogDeferredErr(reader.Close, log.Warn(), "error closing container log reader")
+
+	var buf bytes.Buffer
+	if _, err = stdcopy.StdCopy(&buf, &buf, reader); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (b *bundleWriter) add(name string, data []byte) error {
+	err := b.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    filePerms,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to the debug bundle: %w", name, err)
+	}
+
+	if _, err = b.tw.Write(data); err != nil {
+		return fmt.Errorf("failed to add %s to the debug bundle: %w", name, err)
+	}
+
+	return nil
+}
+
+func (b *bundleWriter) addJSON(name string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	return b.add(name, []byte(redactValues(string(data), b.secrets)))
+}
+
+func (b *bundleWriter) addYAML(name string, v any) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	return b.add(name, data)
+}
+
+// settingsSecrets are the values searched for in the logs and inspect output
+func settingsSecrets(settings *SettingsFile) []string {
+	secrets := []string{
+		settings.CruxSecret,
+		settings.CruxEncryptionKey,
+		settings.KratosSecret,
+		settings.CruxPostgresPassword,
+		settings.KratosPostgresPassword,
+		settings.CruxExternalPostgres.Password,
+		settings.KratosExternalPostgres.Password,
+		settings.ExternalSMTP.Password,
+		settings.Notifications.SlackWebhook,
+	}
+	secrets = append(secrets, settings.Notifications.Webhooks...)
+
+	return secrets
+}
+
+func redactSettings(settings *SettingsFile) *SettingsFile {
+	out := *settings
+	out.CruxSecret = redactIfSet(out.CruxSecret)
+	out.CruxEncryptionKey = redactIfSet(out.CruxEncryptionKey)
+	out.KratosSecret = redactIfSet(out.KratosSecret)
+	out.CruxPostgresPassword = redactIfSet(out.CruxPostgresPassword)
+	out.KratosPostgresPassword = redactIfSet(out.KratosPostgresPassword)
+	out.CruxExternalPostgres.Password = redactIfSet(out.CruxExternalPostgres.Password)
+	out.KratosExternalPostgres.Password = redactIfSet(out.KratosExternalPostgres.Password)
+	out.ExternalSMTP.Password = redactIfSet(out.ExternalSMTP.Password)
+	out.Notifications.SlackWebhook = redactIfSet(out.Notifications.SlackWebhook)
+
+	webhooks := make([]string, len(out.Notifications.Webhooks))
+	for i := range webhooks {
+		webhooks[i] = redacted
+	}
+	out.Notifications.Webhooks = webhooks
+
+	return &out
+}
+
+func redactIfSet(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return redacted
+}
+
+func redactEnv(envs []string) []string {
+	out := make([]string, len(envs))
+	for i, env := range envs {
+		key, _, found := strings.Cut(env, "=")
+		if found && sensitiveEnvPattern.MatchString(key) {
+			out[i] = key + "=" + redacted
+		} else {
+			out[i] = env
+		}
+	}
+
+	return out
+}
+
+func redactValues(text string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			text = strings.ReplaceAll(text, secret, redacted)
+		}
+	}
+
+	return text
+}
@@ -28,18 +28,27 @@ func PrintInfo(state *State, args *ArgsFlags) {
 
 	if args.CruxDisabled {
 		log.Info().Msg("Do not forget to add your environmental variables to your .env files or export them!")
-		log.Info().Msgf("DATABASE_URL=postgresql://%s:%s@localhost:%d/%s?schema=public",
-			state.SettingsFile.CruxPostgresUser,
-			state.SettingsFile.CruxPostgresPassword,
-			state.SettingsFile.CruxPostgresPort,
-			state.SettingsFile.CruxPostgresDB)
+		database := cruxPostgresConnection(state)
+		if !state.SettingsFile.CruxExternalPostgres.Enabled() {
+			database.host = localhost
+			database.port = state.SettingsFile.CruxPostgresPort
+		}
+		log.Info().Msgf("DATABASE_URL=%s", database.prismaURL())
 		log.Info().Msgf("ENCRYPTION_SECRET_KEY=%s", state.SettingsFile.CruxEncryptionKey)
 	}
 
-	log.Info().Msgf("Stack is ready. The UI should be available at http://localhost:%d location.",
-		state.SettingsFile.Options.TraefikWebPort)
-	log.Info().Msgf("The e-mail service should be available at http://localhost:%d location.",
-		state.SettingsFile.Options.MailSlurperUIPort)
+	if state.SettingsFile.externalProxy() {
+		printExternalProxyRules(&state.SettingsFile.Options)
+	}
+
+	log.Info().Msgf("Stack is ready. The UI should be available at %s location.",
+		publicURL(&state.SettingsFile, "localhost"))
+	if state.SettingsFile.ExternalSMTP.Enabled() {
+		log.Info().Msgf("The e-mails are sent with %s.", state.SettingsFile.ExternalSMTP.Host)
+	} else {
+		log.Info().Msgf("The e-mail service should be available at http://localhost:%d location.",
+			state.SettingsFile.Options.MailSlurperUIPort)
+	}
 	log.Info().Msg("Happy deploying! 🎬")
 }
 
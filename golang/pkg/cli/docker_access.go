@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultDockerSocket = "/var/run/docker.sock"
+	sudoBinary          = "sudo"
+)
+
+var ErrDockerSocketPermission = errors.New("permission denied while connecting to the docker socket")
+
+// dockerSocketPath returns the path of the unix socket the client is going to use, empty for other transports
+func dockerSocketPath() string {
+	host := os.Getenv(client.EnvOverrideHost)
+	if host == "" {
+		return defaultDockerSocket
+	}
+
+	u, err := url.Parse(host)
+	if err != nil || u.Scheme != "unix" {
+		return ""
+	}
+
+	return u.Path
+}
+
+// rootlessDockerSocket returns the socket of a rootless daemon of the current user, if there is one
+func rootlessDockerSocket() string {
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		return ""
+	}
+
+	socket := path.Join(runtimeDir, "docker.sock")
+	if _, err := os.Stat(socket); err != nil {
+		return ""
+	}
+
+	return socket
+}
+
+// checkDockerAccess pings the daemon, explaining socket permission problems instead of the client's error
+func checkDockerAccess(ctx context.Context, args *ArgsFlags) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	_, err = cli.Ping(ctx)
+	if err == nil {
+		return nil
+	}
+
+	socket := dockerSocketPath()
+	if socket == "" || !isPermissionError(err) {
+		return fmt.Errorf("could not connect to the docker daemon, make sure it is running: %w", err)
+	}
+
+	if args.SudoHelper {
+		return reexecWithSudo(args)
+	}
+
+	for _, hint := range dockerAccessHints(socket, socketGroupHint(socket), rootlessDockerSocket()) {
+		log.Warn().Msg(hint)
+	}
+	return fmt.Errorf("%w: %s", ErrDockerSocketPermission, socket)
+}
+
+func isPermissionError(err error) bool {
+	return errors.Is(err, os.ErrPermission) || errors.Is(err, syscall.EACCES) ||
+		strings.Contains(strings.ToLower(err.Error()), "permission denied")
+}
+
+func dockerAccessHints(socket, groupHint, rootlessSocket string) []string {
+	hints := []string{fmt.Sprintf("Your user can't access the docker socket at %s.", socket)}
+	if groupHint != "" {
+		hints = append(hints, groupHint)
+	}
+	if rootlessSocket != "" && rootlessSocket != socket {
+		hints = append(hints, fmt.Sprintf("A rootless docker daemon is running for your user, "+
+			"use it with: export DOCKER_HOST=unix://%s", rootlessSocket))
+	} else {
+		hints = append(hints, "Alternatively set up rootless docker: https://docs.docker.com/engine/security/rootless/")
+	}
+
+	return append(hints, fmt.Sprintf("Or run the command again with --%s to escalate using sudo.", FlagSudoHelper))
+}
+
+func sudoCommandLine(executable, settingsFilePath string, args []string) []string {
+	cmd := []string{sudoBinary, "--preserve-env", executable, "--" + FlagConfigPath, settingsFilePath}
+	for _, arg := range args {
+		if arg == "--"+FlagSudoHelper {
+			continue
+		}
+		cmd = append(cmd, arg)
+	}
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+//go:build !windows
+// +build !windows
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/slices"
+)
+
+// socketGroupHint tells which group owns the socket and whether the user is in it
+func socketGroupHint(socket string) string {
+	info, err := os.Stat(socket)
+	if err != nil {
+		return fmt.Sprintf("The socket doesn't exist, make sure the docker daemon is running: %s", err)
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return ""
+	}
+
+	gid := strconv.FormatUint(uint64(stat.Gid), 10)
+	groupName := gid
+	if group, err := user.LookupGroupId(gid); err == nil {
+		groupName = group.Name
+	}
+
+	current, err := user.Current()
+	if err != nil {
+		return ""
+	}
+
+	userGroups, err := current.GroupIds()
+	if err != nil || !slices.Contains(userGroups, gid) {
+		return fmt.Sprintf("Add your user to the '%s' group, then log in again: sudo usermod -aG %s %s",
+			groupName, groupName, current.Username)
+	}
+
+	sessionGroups, err := os.Getgroups()
+	if err == nil && !slices.Contains(sessionGroups, int(stat.Gid)) {
+		return fmt.Sprintf("Your user is in the '%s' group, but this session isn't, log in again or run: newgrp %s",
+			groupName, groupName)
+	}
+
+	return ""
+}
+
+// reexecWithSudo replaces the process with itself run by sudo, the settings path is pinned,
+// otherwise root's configuration directory would be used, it only returns if the escalation failed
+func reexecWithSudo(args *ArgsFlags) error {
+	if os.Geteuid() == 0 {
+		return fmt.Errorf("%w: running as root already, sudo won't help", ErrDockerSocketPermission)
+	}
+
+	sudo, err := exec.LookPath(sudoBinary)
+	if err != nil {
+		return fmt.Errorf("sudo is not available: %w", err)
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("could not determine the path of the executable: %w", err)
+	}
+
+	sudoArgs := sudoCommandLine(executable, args.SettingsFilePath, os.Args[1:])
+	log.Warn().Str("command", strings.Join(sudoArgs, " ")).
+		Msg("Escalating with sudo, files written by the command will be owned by root")
+
+	//#nosec G204 -- re-executing ourselves with the same arguments
+	if err = syscall.Exec(sudo, sudoArgs, os.Environ()); err != nil {
+		return fmt.Errorf("failed to escalate with sudo: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestDockerSocketPath(t *testing.T) {
+	t.Setenv("DOCKER_HOST", "")
+	assert.Equal(t, "/var/run/docker.sock", cli.DockerSocketPath())
+
+	t.Setenv("DOCKER_HOST", "unix:///run/user/1000/docker.sock")
+	assert.Equal(t, "/run/user/1000/docker.sock", cli.DockerSocketPath())
+
+	t.Setenv("DOCKER_HOST", "tcp://10.0.0.2:2376")
+	assert.Equal(t, "", cli.DockerSocketPath())
+}
+
+func TestDockerAccessHints(t *testing.T) {
+	hints := cli.DockerAccessHints("/var/run/docker.sock", "Add your user to the 'docker' group", "/run/user/1000/docker.sock")
+
+	assert.Len(t, hints, 4)
+	assert.Contains(t, hints[1], "'docker' group")
+	assert.Contains(t, hints[2], "export DOCKER_HOST=unix:///run/user/1000/docker.sock")
+	assert.Contains(t, hints[3], "--sudo-helper")
+
+	hints = cli.DockerAccessHints("/var/run/docker.sock", "", "")
+	assert.Len(t, hints, 3)
+	assert.Contains(t, hints[1], "rootless")
+}
+
+func TestSudoCommandLine(t *testing.T) {
+	cmd := cli.SudoCommandLine("/usr/local/bin/dyo", "/home/dev/.config/dyo-cli/settings.yaml",
+		[]string{"--sudo-helper", "--prefix", "dev", "up"})
+
+	assert.Equal(t, []string{
+		"sudo", "--preserve-env", "/usr/local/bin/dyo",
+		"--config", "/home/dev/.config/dyo-cli/settings.yaml",
+		"--prefix", "dev", "up",
+	}, cmd)
+}
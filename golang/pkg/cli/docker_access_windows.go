@@ -0,0 +1,12 @@
+package cli
+
+import "fmt"
+
+// socketGroupHint points to the group Docker Desktop uses for access control on Windows
+func socketGroupHint(_ string) string {
+	return "Make sure your user is a member of the docker-users group."
+}
+
+func reexecWithSudo(_ *ArgsFlags) error {
+	return fmt.Errorf("%w: sudo is not available on Windows, run the terminal as administrator instead", ErrDockerSocketPermission)
+}
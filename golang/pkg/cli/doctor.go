@@ -0,0 +1,679 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/system"
+	"github.com/docker/docker/client"
+	ucli "github.com/urfave/cli/v2"
+
+	dockerhelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	nethelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	containerRuntime "github.com/dyrector-io/dyrectorio/golang/internal/runtime/container"
+)
+
+const (
+	DoctorCommand = "doctor"
+)
+
+// severities of the findings
+const (
+	doctorOK      = "ok"
+	doctorWarning = "warning"
+	doctorFailure = "failure"
+)
+
+// the checks of the doctor
+const (
+	checkRuntimeName  = "runtime"
+	checkDaemonName   = "daemon"
+	checkPortsName    = "ports"
+	checkConflictName = "conflicts"
+	checkDiskName     = "disk"
+	checkDNSName      = "dns"
+	checkRegistryName = "registry"
+)
+
+const (
+	lowDiskSpace        = 5 << 30
+	criticalDiskSpace   = 1 << 30
+	privilegedPortLimit = 1024
+	registryTimeout     = 10 * time.Second
+	dockerHubDomain     = "docker.io"
+	dockerHubRegistry   = "registry-1.docker.io"
+)
+
+var ErrDoctorFailures = errors.New("some checks of the doctor failed")
+
+// runtimeVersions are the minimum and the recommended versions of the runtimes
+var runtimeVersions = map[string][2]string{
+	containerRuntime.Docker: {containerRuntime.MinimumDockerServerVersion, containerRuntime.RecommendedDockerServerVersion},
+	containerRuntime.Podman: {containerRuntime.MinimumPodmanServerVersion, containerRuntime.RecommendedPodmanServerVersion},
+}
+
+// doctorFinding is the outcome of a check, the fix is what the user can do about it
+type doctorFinding struct {
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Fix      string `json:"fix,omitempty"`
+}
+
+// doctorProbes are the checks of the machine outside of the daemon, the tests replace them
+type doctorProbes struct {
+	isPortFree    func(uint) bool
+	freeDiskSpace func(string) (uint64, error)
+	probeRegistry func(context.Context, string) error
+}
+
+func GetDoctorCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:  DoctorCommand,
+		Usage: "Check the container runtime, the ports, the disk space, the DNS and the registries before starting the stack",
+		Description: "Every finding comes with a fix, please include the output in bug reports. " +
+			"The exit code is non-zero if any check failed.",
+		Action: doctor,
+	}
+}
+
+func doctor(cCtx *ucli.Context) error {
+	settingsPath, err := settingsLocation(cCtx)
+	if err != nil {
+		return err
+	}
+
+	args := ArgsFlags{
+		SettingsFilePath: settingsPath,
+		CruxDisabled:     cCtx.Bool(FlagDisableCrux),
+		CruxUIDisabled:   cCtx.Bool(FlagDisableCruxUI),
+		MacOS:            cCtx.Bool(FlagMacOS),
+		Runtime:          cCtx.String(FlagRuntime),
+		Command:          DoctorCommand,
+		Output:           outputFormat(cCtx),
+	}
+	if args.SettingsExists, err = SettingsExists(args.SettingsFilePath); err != nil {
+		return err
+	}
+	prefix, err := stackPrefix(cCtx, &args)
+	if err != nil {
+		return err
+	}
+	args.Prefix = prefix
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	state := doctorState(cCtx.Context, &args)
+	result := runDoctor(cCtx.Context, cli, state, &args, &doctorProbes{
+		isPortFree:    nethelper.IsPortFree,
+		freeDiskSpace: freeDiskSpace,
+		probeRegistry: probeRegistry,
+	})
+
+	if result.Failures > 0 {
+		err = fmt.Errorf("%w: %d failures", ErrDoctorFailures, result.Failures)
+	}
+
+	// the findings are the result even if checks failed, the exit code tells the failures
+	if args.Output == OutputJSON {
+		if writeErr := writeCommandResult(os.Stdout, DoctorCommand, result, nil); writeErr != nil {
+			return writeErr
+		}
+
+		return err
+	}
+
+	if writeErr := writeDoctorReport(os.Stdout, result); writeErr != nil {
+		return writeErr
+	}
+
+	return err
+}
+
+// doctorState is the state of the stack as up would start it, without writing the settings or creating the network
+func doctorState(ctx context.Context, args *ArgsFlags) *State {
+	settings := ReadExistingSettings(args)
+	if !args.SettingsExists {
+		settings.Prefix = args.Prefix
+		settings.Network = args.Prefix
+	}
+
+	state := &State{
+		Ctx:          ctx,
+		Containers:   &Containers{},
+		SettingsFile: *settings,
+	}
+
+	return LoadDefaultsOnEmpty(state, args)
+}
+
+// runDoctor runs the checks, the ones needing the daemon are left out if it is not reachable
+func runDoctor(ctx context.Context, cli client.APIClient, state *State, args *ArgsFlags, probes *doctorProbes) *doctorResult {
+	findings, runtime := runtimeFindings(ctx, cli, args)
+
+	ports := stackPorts(&state.SettingsFile, args)
+	published := map[uint]bool{}
+	if runtime != "" {
+		info, err := cli.Info(ctx)
+		if err != nil {
+			findings = append(findings, doctorFinding{
+				Check: checkDaemonName, Severity: doctorWarning,
+				Message: fmt.Sprintf("failed to get the information of the daemon: %s", err),
+			})
+		} else {
+			findings = append(findings, daemonFindings(&info, ports)...)
+		}
+
+		published, err = stackPublishedPorts(ctx, cli, args.Prefix)
+		if err != nil {
+			findings = append(findings, doctorFinding{
+				Check: checkPortsName, Severity: doctorWarning,
+				Message: fmt.Sprintf("failed to list the ports of the running stack: %s", err),
+			})
+		}
+	}
+
+	findings = append(findings, portFindings(ports, published, probes.isPortFree, args.SettingsFilePath)...)
+
+	if runtime != "" {
+		findings = append(findings, conflictFindings(ctx, cli, state, args, runtime)...)
+	}
+
+	findings = append(findings, diskFindings(doctorDiskPaths(ctx, cli, args, runtime != ""), probes.freeDiskSpace)...)
+
+	if runtime != "" {
+		findings = append(findings, internalHostFindings(ctx, cli, state, args, runtime))
+	}
+
+	findings = append(findings, registryFindings(ctx, registryHosts(stackImages(state, args)), probes.probeRegistry)...)
+
+	return doctorResultOf(findings)
+}
+
+func doctorResultOf(findings []doctorFinding) *doctorResult {
+	result := &doctorResult{Findings: findings}
+	for _, it := range findings {
+		switch it.Severity {
+		case doctorFailure:
+			result.Failures++
+		case doctorWarning:
+			result.Warnings++
+		}
+	}
+
+	return result
+}
+
+// runtimeFindings checks the daemon, the runtime is empty if it is not usable
+func runtimeFindings(ctx context.Context, cli client.APIClient, args *ArgsFlags) ([]doctorFinding, string) {
+	if _, err := cli.Ping(ctx); err != nil {
+		fix := fmt.Sprintf("start docker or podman, or select the runtime with --%s", FlagRuntime)
+		if socket := dockerSocketPath(); socket != "" && isPermissionError(err) {
+			fix = strings.Join(dockerAccessHints(socket, socketGroupHint(socket), rootlessDockerSocket()), " ")
+		}
+
+		return []doctorFinding{{
+			Check: checkRuntimeName, Severity: doctorFailure,
+			Message: fmt.Sprintf("the daemon is not reachable: %s", err), Fix: fix,
+		}}, ""
+	}
+
+	runtime, err := containerRuntime.GetContainerRuntime(ctx, cli)
+	if err != nil {
+		return []doctorFinding{{
+			Check: checkRuntimeName, Severity: doctorFailure,
+			Message: fmt.Sprintf("the runtime of the daemon is unknown: %s", err), Fix: "use docker or podman",
+		}}, ""
+	}
+
+	if err = checkRuntime(args.Runtime, runtime); err != nil {
+		return []doctorFinding{{
+			Check: checkRuntimeName, Severity: doctorFailure,
+			Message: err.Error(), Fix: fmt.Sprintf("use --%s %s or point DOCKER_HOST to the daemon of %s", FlagRuntime, runtime, args.Runtime),
+		}}, ""
+	}
+
+	serverVersion, err := cli.ServerVersion(ctx)
+	if err != nil {
+		return []doctorFinding{{
+			Check: checkRuntimeName, Severity: doctorFailure,
+			Message: fmt.Sprintf("failed to get the version of the daemon: %s", err),
+		}}, ""
+	}
+
+	return []doctorFinding{runtimeVersionFinding(runtime, serverVersion.Version)}, runtime
+}
+
+func runtimeVersionFinding(runtime, serverVersion string) doctorFinding {
+	versions := runtimeVersions[runtime]
+	finding := doctorFinding{Check: checkRuntimeName, Severity: doctorOK, Message: fmt.Sprintf("%s %s", runtime, serverVersion)}
+
+	err := containerRuntime.SatisfyVersion(versions[0], versions[1], serverVersion)
+	switch {
+	case err == nil:
+	case errors.Is(err, containerRuntime.ErrServerIsOutdated):
+		finding.Severity = doctorWarning
+		finding.Message = fmt.Sprintf("%s %s is older than the recommended %s", runtime, serverVersion, versions[1])
+		finding.Fix = fmt.Sprintf("update %s", runtime)
+	default:
+		finding.Severity = doctorFailure
+		finding.Message = fmt.Sprintf("%s %s is not supported, the minimum is %s", runtime, serverVersion, versions[0])
+		finding.Fix = fmt.Sprintf("update %s to %s or newer", runtime, versions[1])
+	}
+
+	return finding
+}
+
+// isRootlessDaemon tells if the daemon runs without root, both docker and podman report it as a security option
+func isRootlessDaemon(info *system.Info) bool {
+	for _, it := range info.SecurityOptions {
+		if strings.Contains(it, "name=rootless") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// privilegedPorts are the ports below the privileged port limit with their services, and the lowest of them
+func privilegedPorts(ports map[uint]string) ([]string, uint) {
+	privileged := []string{}
+	lowest := uint(privilegedPortLimit)
+	for _, port := range sortedPorts(ports) {
+		if port < privilegedPortLimit {
+			privileged = append(privileged, fmt.Sprintf("%d (%s)", port, ports[port]))
+			lowest = min(lowest, port)
+		}
+	}
+
+	return privileged, lowest
+}
+
+// daemonFindings are the quirks of the daemon, the rootless daemons can not bind the privileged ports and can not
+// limit the resources of the containers on cgroup v1
+func daemonFindings(info *system.Info, ports map[uint]string) []doctorFinding {
+	rootless := isRootlessDaemon(info)
+
+	findings := []doctorFinding{}
+	if rootless {
+		privileged, lowest := privilegedPorts(ports)
+		if len(privileged) > 0 {
+			findings = append(findings, doctorFinding{
+				Check: checkDaemonName, Severity: doctorFailure,
+				Message: fmt.Sprintf("the rootless daemon can not bind the ports below %d: %s",
+					privilegedPortLimit, strings.Join(privileged, ", ")),
+				Fix: fmt.Sprintf("change the ports in the settings, or allow them with: sudo sysctl net.ipv4.ip_unprivileged_port_start=%d",
+					lowest),
+			})
+		}
+
+		if info.CgroupVersion == "1" {
+			findings = append(findings, doctorFinding{
+				Check: checkDaemonName, Severity: doctorWarning,
+				Message: "the rootless daemon can not limit the resources of the containers on cgroup v1",
+				Fix:     "boot with cgroup v2, eg. with the systemd.unified_cgroup_hierarchy=1 kernel parameter",
+			})
+		}
+	}
+
+	for _, it := range info.Warnings {
+		findings = append(findings, doctorFinding{Check: checkDaemonName, Severity: doctorWarning, Message: it})
+	}
+
+	if len(findings) == 0 {
+		mode := "rootful"
+		if rootless {
+			mode = "rootless"
+		}
+		findings = append(findings, doctorFinding{
+			Check: checkDaemonName, Severity: doctorOK,
+			Message: strings.TrimSpace(fmt.Sprintf("%s, cgroup v%s %s", mode, info.CgroupVersion, info.CgroupDriver)),
+		})
+	}
+
+	return findings
+}
+
+func sortedPorts(ports map[uint]string) []uint {
+	sorted := []uint{}
+	for port := range ports {
+		sorted = append(sorted, port)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return sorted
+}
+
+// stackPublishedPorts are the ports bound by the running containers of the stack, they are not conflicts
+func stackPublishedPorts(ctx context.Context, cli client.APIClient, prefix string) (map[uint]bool, error) {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(prefix))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	published := map[uint]bool{}
+	for i := range containers {
+		for _, port := range containers[i].Ports {
+			published[uint(port.PublicPort)] = true
+		}
+	}
+
+	return published, nil
+}
+
+func portFindings(ports map[uint]string, published map[uint]bool, isPortFree func(uint) bool, settingsPath string) []doctorFinding {
+	findings := []doctorFinding{}
+	for _, port := range sortedPorts(ports) {
+		if published[port] || isPortFree(port) {
+			continue
+		}
+
+		findings = append(findings, doctorFinding{
+			Check: checkPortsName, Severity: doctorFailure,
+			Message: fmt.Sprintf("port %d of %s is in use", port, ports[port]),
+			Fix:     fmt.Sprintf("stop the process using it, or change the port in %s", settingsPath),
+		})
+	}
+
+	if len(findings) == 0 {
+		findings = append(findings, doctorFinding{
+			Check: checkPortsName, Severity: doctorOK,
+			Message: fmt.Sprintf("the %d ports of the stack are available", len(ports)),
+		})
+	}
+
+	return findings
+}
+
+// conflictFindings are the networks the stack can not use and the containers of other owners taking the names of
+// the stack, up would remove them
+func conflictFindings(ctx context.Context, cli client.APIClient, state *State, args *ArgsFlags, runtime string) []doctorFinding {
+	findings := []doctorFinding{}
+
+	network := state.SettingsFile.Network
+	if network == defaultNetworks[runtime] {
+		findings = append(findings, doctorFinding{
+			Check: checkConflictName, Severity: doctorFailure,
+			Message: fmt.Sprintf("%s is the default network of %s, the containers could not reach each other by name", network, runtime),
+			Fix:     fmt.Sprintf("change network-name in %s", args.SettingsFilePath),
+		})
+	}
+
+	networks, err := cli.NetworkList(ctx, types.NetworkListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", fmt.Sprintf("^%s$", network))),
+	})
+	if err != nil {
+		return append(findings, doctorFinding{
+			Check: checkConflictName, Severity: doctorWarning, Message: fmt.Sprintf("failed to list the networks: %s", err),
+		})
+	}
+	for i := range networks {
+		if networks[i].Driver != containerNetDriver {
+			findings = append(findings, doctorFinding{
+				Check: checkConflictName, Severity: doctorFailure,
+				Message: fmt.Sprintf("network %s has the %s driver instead of %s", network, networks[i].Driver, containerNetDriver),
+				Fix:     fmt.Sprintf("remove it with: docker network rm %s, or change network-name in %s", network, args.SettingsFilePath),
+			})
+		}
+	}
+
+	for _, id := range stopOrder() {
+		name := fmt.Sprintf("%s_%s", args.Prefix, id)
+		cont, err := dockerhelper.GetContainerByName(ctx, cli, name)
+		if err != nil {
+			return append(findings, doctorFinding{
+				Check: checkConflictName, Severity: doctorWarning, Message: fmt.Sprintf("failed to list the containers: %s", err),
+			})
+		}
+		if cont == nil || cont.Labels[label.DyrectorioOrg+label.ContainerPrefix] == args.Prefix {
+			continue
+		}
+
+		findings = append(findings, doctorFinding{
+			Check: checkConflictName, Severity: doctorWarning,
+			Message: fmt.Sprintf("container %s is not part of the stack %s, up removes it", name, args.Prefix),
+			Fix:     fmt.Sprintf("rename it with: docker rename %s <name>, or use an other --%s", name, FlagPrefix),
+		})
+	}
+
+	if len(findings) == 0 {
+		findings = append(findings, doctorFinding{
+			Check: checkConflictName, Severity: doctorOK, Message: fmt.Sprintf("network %s and the container names are available", network),
+		})
+	}
+
+	return findings
+}
+
+// doctorDiskPaths are the directories of the settings and the backups, and the data of the daemon if it is on
+// this machine, keyed by what is stored there
+func doctorDiskPaths(ctx context.Context, cli client.APIClient, args *ArgsFlags, reachable bool) map[string]string {
+	paths := map[string]string{"settings and backups": existingParent(backupRoot(args))}
+
+	if !reachable || dockerSocketPath() == "" {
+		return paths
+	}
+
+	info, err := cli.Info(ctx)
+	if err != nil || info.DockerRootDir == "" {
+		return paths
+	}
+	// the daemons of Docker Desktop and podman machine store their data in a VM
+	if _, err = os.Stat(info.DockerRootDir); err == nil {
+		paths["images and volumes"] = info.DockerRootDir
+	}
+
+	return paths
+}
+
+// existingParent is the directory itself or its closest existing parent, the settings may not be written yet
+func existingParent(dir string) string {
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			return dir
+		}
+
+		parent := path.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
+
+func diskFindings(paths map[string]string, free func(string) (uint64, error)) []doctorFinding {
+	purposes := []string{}
+	for purpose := range paths {
+		purposes = append(purposes, purpose)
+	}
+	sort.Strings(purposes)
+
+	findings := []doctorFinding{}
+	for _, purpose := range purposes {
+		dir := paths[purpose]
+		available, err := free(dir)
+		if err != nil {
+			findings = append(findings, doctorFinding{
+				Check: checkDiskName, Severity: doctorWarning,
+				Message: fmt.Sprintf("failed to get the free space of %s: %s", dir, err),
+			})
+			continue
+		}
+
+		finding := doctorFinding{
+			Check: checkDiskName, Severity: doctorOK,
+			Message: fmt.Sprintf("%s free for the %s in %s", formatBytes(int64(available)), purpose, dir),
+		}
+		switch {
+		case available < criticalDiskSpace:
+			finding.Severity = doctorFailure
+		case available < lowDiskSpace:
+			finding.Severity = doctorWarning
+		}
+		if finding.Severity != doctorOK {
+			finding.Fix = fmt.Sprintf("free up space, eg. with: docker system prune, the images of the stack need about %s",
+				formatBytes(lowDiskSpace))
+		}
+		findings = append(findings, finding)
+	}
+
+	return findings
+}
+
+// internalHostFindings resolves the name of the host in a container, crux reaches the agents and the services
+// running on the host by it
+func internalHostFindings(ctx context.Context, cli client.APIClient, state *State, args *ArgsFlags, runtime string) doctorFinding {
+	domain := internalHostDomain(runtime, args)
+
+	res, err := baseContainer(ctx, args).
+		WithClient(cli).
+		WithImage(state.serviceImage(postgresService)).
+		WithImagePriority(image.PreferLocal).
+		WithName(fmt.Sprintf("%s_doctor", args.Prefix)).
+		WithEntrypoint([]string{"getent", "hosts", domain}).
+		WithLabels(map[string]string{
+			label.DyrectorioOrg + label.ContainerPrefix: args.Prefix,
+			label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+		}).
+		RunAndCapture(ctx)
+	if err != nil {
+		return doctorFinding{
+			Check: checkDNSName, Severity: doctorWarning,
+			Message: fmt.Sprintf("failed to resolve %s in a container: %s", domain, err),
+			Fix:     "check the findings of the registries, the image of the check is pulled first",
+		}
+	}
+
+	if res.ExitCode != 0 {
+		fix := fmt.Sprintf("update %s, the containers of the stack get the host gateway as %s", runtime, domain)
+		if runtime == containerRuntime.Docker && args.MacOS {
+			fix = "enable the host networking features of Docker Desktop, or update it"
+		}
+
+		return doctorFinding{
+			Check: checkDNSName, Severity: doctorFailure,
+			Message: fmt.Sprintf("%s does not resolve in the containers, crux can not reach the agents and the services of the host",
+				domain),
+			Fix: fix,
+		}
+	}
+
+	address := notAvailable
+	if fields := strings.Fields(res.Stdout); len(fields) > 0 {
+		address = fields[0]
+	}
+
+	return doctorFinding{
+		Check: checkDNSName, Severity: doctorOK, Message: fmt.Sprintf("%s resolves to %s in the containers", domain, address),
+	}
+}
+
+// registryHosts are the registries the images are pulled from, Docker Hub is served by its registry host
+func registryHosts(images []string) []string {
+	hosts := map[string]bool{}
+	for _, it := range images {
+		named, err := reference.ParseNormalizedNamed(it)
+		if err != nil {
+			continue
+		}
+
+		host := reference.Domain(named)
+		if host == dockerHubDomain {
+			host = dockerHubRegistry
+		}
+		hosts[host] = true
+	}
+
+	sorted := []string{}
+	for host := range hosts {
+		sorted = append(sorted, host)
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// probeRegistry calls the API root of the registry, it answers even without credentials
+func probeRegistry(ctx context.Context, host string) error {
+	ctx, cancel := context.WithTimeout(ctx, registryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/v2/", host), http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func registryFindings(ctx context.Context, hosts []string, probe func(context.Context, string) error) []doctorFinding {
+	findings := []doctorFinding{}
+	for _, host := range hosts {
+		if err := probe(ctx, host); err != nil {
+			findings = append(findings, doctorFinding{
+				Check: checkRegistryName, Severity: doctorFailure,
+				Message: fmt.Sprintf("%s is not reachable: %s", host, err),
+				Fix: fmt.Sprintf("check the connection and the proxy settings, pull from a mirror with imageRewrite, "+
+					"or start from an image bundle with --%s", FlagOffline),
+			})
+			continue
+		}
+
+		findings = append(findings, doctorFinding{Check: checkRegistryName, Severity: doctorOK, Message: fmt.Sprintf("%s is reachable", host)})
+	}
+
+	return findings
+}
+
+func writeDoctorReport(w io.Writer, result *doctorResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tFINDING")
+	for _, it := range result.Findings {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", it.Check, it.Severity, it.Message)
+		if it.Fix != "" {
+			fmt.Fprintf(tw, "\t\tfix: %s\n", it.Fix)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if result.Failures == 0 && result.Warnings == 0 {
+		_, err := fmt.Fprintln(w, "\nNo problems found")
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d failures, %d warnings\n", result.Failures, result.Warnings)
+	return err
+}
@@ -0,0 +1,17 @@
+//go:build !windows
+// +build !windows
+
+package cli
+
+import "syscall"
+
+// freeDiskSpace is the space of the file system of the directory available for the user
+func freeDiskSpace(dir string) (uint64, error) {
+	stat := syscall.Statfs_t{}
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+
+	//nolint:unconvert // the types of the fields differ by the platforms
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,148 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/system"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+const doctorPostgresImage = "docker.io/library/postgres:13-alpine"
+
+func doctorArgs(t *testing.T) *cli.ArgsFlags {
+	t.Helper()
+
+	return &cli.ArgsFlags{
+		SettingsFilePath: filepath.Join(t.TempDir(), "dyo-cli", cli.SettingsFileName),
+		Prefix:           "dyo-stable",
+		Runtime:          cli.RuntimeAuto,
+	}
+}
+
+func findingsOf(findings []cli.DoctorFinding, check string) []cli.DoctorFinding {
+	matching := []cli.DoctorFinding{}
+	for _, it := range findings {
+		if it.Check == check {
+			matching = append(matching, it)
+		}
+	}
+
+	return matching
+}
+
+func TestDoctorHealthyMachine(t *testing.T) {
+	docker := dockerfake.New()
+	docker.AddImage(doctorPostgresImage)
+	docker.SetProcess(doctorPostgresImage, dockerfake.Process{Exits: true, Stdout: "172.17.0.1      host.docker.internal\n"})
+
+	findings, failures := cli.RunDoctor(context.Background(), docker, doctorArgs(t), nil, nil, 100<<30)
+	assert.Zero(t, failures)
+	for _, it := range findings {
+		assert.Equal(t, "ok", it.Severity, it.Message)
+	}
+
+	assert.Equal(t, "docker "+dockerfake.ServerVersion, findingsOf(findings, "runtime")[0].Message)
+	assert.Equal(t, "host.docker.internal resolves to 172.17.0.1 in the containers", findingsOf(findings, "dns")[0].Message)
+	assert.Len(t, findingsOf(findings, "registry"), 2)
+}
+
+func TestDoctorFindings(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage(doctorPostgresImage)
+	docker.SetProcess(doctorPostgresImage, dockerfake.Process{Exits: true, ExitCode: 2})
+	docker.AddImage("nginx")
+
+	_, err := docker.ContainerCreate(ctx, &container.Config{Image: "nginx"}, &container.HostConfig{}, nil, nil, "dyo-stable_crux")
+	assert.NoError(t, err)
+	_, err = docker.NetworkCreate(ctx, "dyo-stable", types.NetworkCreate{Driver: "overlay"})
+	assert.NoError(t, err)
+
+	findings, failures := cli.RunDoctor(ctx, docker, doctorArgs(t), []uint{8000}, []string{"ghcr.io"}, 2<<30)
+
+	ports := findingsOf(findings, "ports")
+	assert.Len(t, ports, 1)
+	assert.Equal(t, "port 8000 of traefik proxy is in use", ports[0].Message)
+
+	conflicts := findingsOf(findings, "conflicts")
+	assert.Len(t, conflicts, 2)
+	assert.Equal(t, "failure", conflicts[0].Severity)
+	assert.Equal(t, "network dyo-stable has the overlay driver instead of bridge", conflicts[0].Message)
+	assert.Equal(t, "warning", conflicts[1].Severity)
+	assert.Equal(t, "container dyo-stable_crux is not part of the stack dyo-stable, up removes it", conflicts[1].Message)
+
+	assert.Equal(t, "warning", findingsOf(findings, "disk")[0].Severity)
+	assert.Equal(t, "failure", findingsOf(findings, "dns")[0].Severity)
+
+	registries := findingsOf(findings, "registry")
+	assert.Equal(t, "failure", registries[0].Severity)
+	assert.Equal(t, "ok", registries[1].Severity)
+
+	// the port, the network, the dns and the registry
+	assert.Equal(t, 4, failures)
+}
+
+func TestDoctorUnreachableDaemon(t *testing.T) {
+	docker := dockerfake.New()
+	docker.FailOn("Ping", errors.New("connection refused"))
+
+	findings, failures := cli.RunDoctor(context.Background(), docker, doctorArgs(t), nil, nil, 100<<30)
+	assert.Equal(t, 1, failures)
+	assert.Equal(t, "the daemon is not reachable: connection refused", findingsOf(findings, "runtime")[0].Message)
+
+	assert.Empty(t, findingsOf(findings, "conflicts"))
+	assert.Empty(t, findingsOf(findings, "dns"))
+	assert.NotEmpty(t, findingsOf(findings, "ports"))
+	assert.NotEmpty(t, findingsOf(findings, "registry"))
+}
+
+func TestDaemonFindingsOfRootlessDaemons(t *testing.T) {
+	ports := map[uint]string{80: "traefik proxy", 443: "traefik TLS", 3000: "crux-ui HTTP"}
+
+	findings := cli.DaemonFindings(&system.Info{
+		SecurityOptions: []string{"name=seccomp,profile=builtin", "name=rootless"},
+		CgroupVersion:   "1",
+		Warnings:        []string{"WARNING: No swap limit support"},
+	}, ports)
+
+	assert.Len(t, findings, 3)
+	assert.Equal(t, "the rootless daemon can not bind the ports below 1024: 80 (traefik proxy), 443 (traefik TLS)", findings[0].Message)
+	assert.Contains(t, findings[0].Fix, "net.ipv4.ip_unprivileged_port_start=80")
+	assert.Equal(t, "warning", findings[1].Severity)
+	assert.Equal(t, "WARNING: No swap limit support", findings[2].Message)
+
+	findings = cli.DaemonFindings(&system.Info{CgroupVersion: "2", CgroupDriver: "systemd"}, ports)
+	assert.Equal(t, []cli.DoctorFinding{{Check: "daemon", Severity: "ok", Message: "rootful, cgroup v2 systemd"}}, findings)
+}
+
+func TestDiskFindings(t *testing.T) {
+	free := map[string]uint64{"/backups": 512 << 20, "/var/lib/docker": 3 << 30, "/data": 50 << 30}
+	findings := cli.DiskFindings(map[string]string{"backups": "/backups", "images": "/var/lib/docker", "volumes": "/data"},
+		func(dir string) (uint64, error) { return free[dir], nil })
+
+	assert.Equal(t, "failure", findings[0].Severity)
+	assert.Equal(t, "warning", findings[1].Severity)
+	assert.Equal(t, "ok", findings[2].Severity)
+	assert.Empty(t, findings[2].Fix)
+}
+
+func TestRegistryHosts(t *testing.T) {
+	assert.Equal(t, []string{"ghcr.io", "registry-1.docker.io", "registry.example.com:5000"}, cli.RegistryHosts([]string{
+		"ghcr.io/dyrector-io/dyrectorio/web/crux:stable",
+		"postgres:13-alpine",
+		"docker.io/library/traefik:v2.9",
+		"registry.example.com:5000/team/crux-ui@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		"ghcr.io/dyrector-io/dyrectorio/web/kratos:stable",
+	}))
+}
@@ -0,0 +1,408 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+var (
+	UnsharedMounts          = unsharedMounts
+	LoadTraefikTemplate     = loadTraefikTemplate
+	TraefikTemplatePath     = traefikTemplatePath
+	TraefikExtraConfigFiles = traefikExtraConfigFiles
+
+	TailLogLines                = tailLogLines
+	IsRetryableMigrationFailure = isRetryableMigrationFailure
+	NewMigrationError           = newMigrationError
+
+	StackMemberHealth       = stackMemberHealth
+	ShouldRestart           = shouldRestart
+	ValidateWatchArgs       = validateWatchArgs
+	IsSupervisedStackMember = isSupervisedStackMember
+
+	FormatBar   = formatBar
+	FormatBytes = formatBytes
+	FormatETA   = formatETA
+
+	PinDigest      = pinDigest
+	VerifyLockFile = (*LockFile).verify
+	StateImage     = (*State).image
+	ServiceImage   = (*State).serviceImage
+
+	RedactEnv      = redactEnv
+	RedactValues   = redactValues
+	RedactSettings = redactSettings
+
+	DockerSocketPath  = dockerSocketPath
+	DockerAccessHints = dockerAccessHints
+
+	PodmanSockets      = podmanSockets
+	RuntimeHost        = runtimeHost
+	CheckRuntime       = checkRuntime
+	InternalHostDomain = internalHostDomain
+
+	RootlessPortsWarning = rootlessPortsWarning
+
+	RemoveStackVolumes = removeStackVolumes
+	WriteStackVolumes  = writeStackVolumes
+	SudoCommandLine    = sudoCommandLine
+
+	PostgresVolume  = postgresVolume
+	StackEnvs       = stackEnvs
+	LocaltimeMounts = localtimeMounts
+
+	TraefikLifecycleArgs = traefikLifecycleArgs
+
+	PruneBackups = pruneBackups
+
+	GetSetting        = getSetting
+	SetSetting        = setSetting
+	UnsetSetting      = unsetSetting
+	ReadSettingsFile  = readSettingsFile
+	WriteSettingsFile = writeSettingsFile
+
+	SettingsTemplate       = settingsTemplate
+	ImportSettingsTemplate = importSettingsTemplate
+
+	ServeToken = serveToken
+	IsLoopback = isLoopback
+
+	WaitForURL     = waitForURL
+	BrowserCommand = browserCommand
+
+	CPUPercent      = cpuPercent
+	MemoryUsage     = memoryUsage
+	WriteStackStats = writeStackStats
+
+	ValidateStatsInterval = validateStatsInterval
+
+	RenderNodeScript = renderNodeScript
+
+	SelectLogContainers = selectLogContainers
+	ParseLogLine        = parseLogLine
+	WriteLogLines       = writeLogLines
+
+	StackMemberOf    = stackMemberOf
+	WriteStackStatus = writeStackStatus
+
+	StackManifests           = stackManifests
+	WriteKubernetesManifests = writeKubernetesManifests
+
+	SetupOutput        = setupOutput
+	WriteCommandResult = writeCommandResult
+
+	SaveImageBundle = saveImageBundle
+	LoadImageBundle = loadImageBundle
+
+	StackPrefix  = stackPrefix
+	LockFilePath = lockFilePath
+	BackupRoot   = backupRoot
+
+	TraefikTLSArgs             = traefikTLSArgs
+	TraefikArgs                = traefikArgs
+	PublicURL                  = publicURL
+	EnsureSelfSignedCerts      = ensureSelfSignedCerts
+	RenderTraefikConfiguration = renderTraefikConfiguration
+
+	ValidateProxy      = validateProxy
+	ExternalProxyRules = externalProxyRules
+
+	UnresolvedLocalDomains = unresolvedLocalDomains
+	WithHostsEntries       = withHostsEntries
+	WithoutHostsEntries    = withoutHostsEntries
+
+	DaemonFindings = daemonFindings
+	DiskFindings   = diskFindings
+	RegistryHosts  = registryHosts
+
+	SettingsEnvName      = settingsEnvName
+	ResolvedSettingsYAML = resolvedSettingsYAML
+)
+
+type (
+	MemberStats = memberStats
+	VolumeStats = volumeStats
+	StackStats  = stackStats
+
+	NodeScriptData = nodeScriptData
+
+	LogContainer = logContainer
+	LogLine      = logLine
+
+	StackStatus       = stackStatus
+	StackMemberStatus = stackMemberStatus
+
+	DoctorFinding = doctorFinding
+)
+
+func LatestBackupVersion(root string) (string, string, error) {
+	dir, meta, err := latestBackup(root, backupOriginUpgrade)
+	if err != nil {
+		return "", "", err
+	}
+
+	return dir, meta.Version, nil
+}
+
+func ResolveBackupVersion(args *ArgsFlags) (string, string, error) {
+	dir, meta, err := resolveBackup(args)
+	if err != nil {
+		return "", "", err
+	}
+
+	return dir, meta.Version, nil
+}
+
+// PostgresClients are the client containers of the external databases with their libpq variables
+func PostgresClients(state *State) map[string][]string {
+	clients := map[string][]string{}
+	for _, it := range stackPostgresTargets(state) {
+		if it.external != nil {
+			clients[it.container] = postgresClientEnv(it.external)
+		}
+	}
+
+	return clients
+}
+
+// RunDoctor runs the checks of the stack with the prefix against the client, the busy ports and the unreachable
+// registries are given, every disk has the free space
+func RunDoctor(ctx context.Context, docker client.APIClient, args *ArgsFlags, busyPorts []uint, unreachable []string,
+	freeSpace uint64,
+) ([]DoctorFinding, int) {
+	state := doctorState(ctx, args)
+	result := runDoctor(ctx, docker, state, args, &doctorProbes{
+		isPortFree:    func(port uint) bool { return !slices.Contains(busyPorts, port) },
+		freeDiskSpace: func(string) (uint64, error) { return freeSpace, nil },
+		probeRegistry: func(_ context.Context, host string) error {
+			if slices.Contains(unreachable, host) {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+	})
+
+	return result.Findings, result.Failures
+}
+
+func StopOrder() []string {
+	order := []string{}
+	for _, id := range stopOrder() {
+		order = append(order, string(id))
+	}
+
+	return order
+}
+
+func StartOrder() []string {
+	order := []string{}
+	for _, id := range startOrder {
+		order = append(order, string(id))
+	}
+
+	return order
+}
+
+func StartDependencies(args *ArgsFlags) map[string][]string {
+	dependencies := map[string][]string{}
+	for id, items := range startDependencies(args) {
+		for _, it := range items {
+			dependencies[string(id)] = append(dependencies[string(id)], string(it))
+		}
+	}
+
+	return dependencies
+}
+
+// StartStack starts the builders in the start order, they are keyed by the ids of the stack items
+func StartStack(ctx context.Context, cli client.APIClient, builders map[string]containerbuilder.Builder, args *ArgsFlags) error {
+	return StartStackProbed(ctx, cli, builders, args, nil, nil)
+}
+
+// StartStackProbed is StartStack with readiness probes by their addresses, the URLs are probed by HTTP, the others by TCP
+func StartStackProbed(ctx context.Context, cli client.APIClient, builders map[string]containerbuilder.Builder, args *ArgsFlags,
+	probes map[string]string, timeouts map[string]time.Duration,
+) error {
+	stack := &dyrectorioStack{
+		builders:      map[stackItemID]containerbuilder.Builder{},
+		dependencies:  startDependencies(args),
+		readiness:     map[stackItemID]readinessProbe{},
+		startTimeouts: timeouts,
+	}
+	for id, builder := range builders {
+		stack.builders[stackItemID(id)] = builder
+	}
+	for id, address := range probes {
+		stack.readiness[stackItemID(id)] = readinessProbe{address: address, http: strings.HasPrefix(address, "http://")}
+	}
+
+	_, err := startStackItems(ctx, cli, stack, startOrder)
+	return err
+}
+
+// StartStackOnDashboard starts the builders like StartStack, rendering the startup dashboard to the writer
+func StartStackOnDashboard(ctx context.Context, cli client.APIClient, builders map[string]containerbuilder.Builder,
+	args *ArgsFlags, out io.Writer,
+) error {
+	stack := &dyrectorioStack{
+		builders:     map[stackItemID]containerbuilder.Builder{},
+		dependencies: startDependencies(args),
+		dashboardOut: out,
+	}
+	for id, builder := range builders {
+		stack.builders[stackItemID(id)] = builder
+	}
+
+	_, err := startStackItems(ctx, cli, stack, startOrder)
+	return err
+}
+
+// StartupDashboardLines are the lines of the dashboard of the items, the phases and the details are by the items
+func StartupDashboardLines(items []string, phases, details map[string]string) []string {
+	ids := []stackItemID{}
+	for _, it := range items {
+		ids = append(ids, stackItemID(it))
+	}
+
+	dashboard := newStartupDashboard(io.Discard, ids)
+	for id, phase := range phases {
+		dashboard.set(stackItemID(id), startPhase(phase), details[id])
+	}
+
+	return dashboard.lines(time.Now())
+}
+
+// StartStackWithRollback starts the builders like StartContainers, the started containers are removed on failure
+func StartStackWithRollback(ctx context.Context, cli client.APIClient, builders map[string]containerbuilder.Builder,
+	args *ArgsFlags,
+) error {
+	stack := &dyrectorioStack{
+		builders:     map[stackItemID]containerbuilder.Builder{},
+		dependencies: startDependencies(args),
+	}
+	for id, builder := range builders {
+		stack.builders[stackItemID(id)] = builder
+	}
+
+	return startStack(ctx, cli, stack)
+}
+
+// RecreateOutdated recreates the outdated builders, the containers are named by the ids of the stack items
+func RecreateOutdated(ctx context.Context, cli client.APIClient, builders map[string]containerbuilder.Builder,
+	images map[string]string, args *ArgsFlags,
+) ([]string, error) {
+	stack := &dyrectorioStack{
+		builders:     map[stackItemID]containerbuilder.Builder{},
+		dependencies: startDependencies(args),
+	}
+	containers := map[stackItemID]stackItemContainer{}
+	for id, builder := range builders {
+		stack.builders[stackItemID(id)] = builder
+		containers[stackItemID(id)] = stackItemContainer{name: id, image: images[id]}
+	}
+
+	outdated, err := recreateOutdated(ctx, cli, stack, containers)
+	recreated := []string{}
+	for _, id := range outdated {
+		recreated = append(recreated, string(id))
+	}
+
+	return recreated, err
+}
+
+// ReadinessProbes are the addresses of the readiness probes by the ids of the stack items
+func ReadinessProbes(state *State, args *ArgsFlags) map[string]string {
+	probes := map[string]string{}
+	for id, probe := range readinessProbes(state, args) {
+		probes[string(id)] = probe.address
+	}
+
+	return probes
+}
+
+func StartTimeout(timeouts map[string]time.Duration, id string) time.Duration {
+	return startTimeout(timeouts, stackItemID(id))
+}
+
+func StopGracePeriod(periods map[string]time.Duration, id string) time.Duration {
+	return stopGracePeriod(periods, stackItemID(id))
+}
+
+func NotifyWithSettings(ctx context.Context, settings NotificationSettings, prefix, event, message string) {
+	notifyAll(ctx, settingsNotifiers(settings), notification{Event: event, Message: message, Prefix: prefix})
+}
+
+func NewNotificationHook(ctx context.Context, settings NotificationSettings, prefix, command string) zerolog.Hook {
+	return &notificationHook{ctx: ctx, prefix: prefix, command: command, notifiers: settingsNotifiers(settings)}
+}
+
+func NewStackServerHandler(docker client.APIClient, executable string, args []string, prefix, settingsPath, token string) http.Handler {
+	return newStackServer(docker, executable, args, prefix, settingsPath, token).handler()
+}
+
+// SplitLogLines writes the chunks to a log line writer, the result are the lines it passed on
+func SplitLogLines(chunks ...string) []string {
+	lines := []string{}
+	writer := &logLineWriter{line: func(line string) { lines = append(lines, line) }}
+	for _, it := range chunks {
+		_, _ = writer.Write([]byte(it))
+	}
+	writer.flush()
+
+	return lines
+}
+
+// StackCompose is the compose file of the stack as it is written
+func StackCompose(state *State, args *ArgsFlags) ([]byte, error) {
+	compose, err := stackComposeFile(state, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(compose)
+}
+
+// SettingsEnvNames are the environment variables of every key of the settings
+func SettingsEnvNames() []string {
+	names := []string{}
+	for _, key := range settingsKeys(reflect.TypeOf(SettingsFile{}), "") {
+		names = append(names, settingsEnvName(key))
+	}
+
+	return names
+}
+
+// LayeredSettings are the effective settings and the ones written to the settings file by the state
+func LayeredSettings(args *ArgsFlags) (effective, saved *SettingsFile, err error) {
+	state := &State{}
+	if err = state.layerSettings(*readSettingsLayer(args), args); err != nil {
+		return nil, nil, err
+	}
+
+	saved, err = savedSettings(state)
+	return &state.SettingsFile, saved, err
+}
+
+// DefaultedSettings are the layered settings with the defaults, as they are loaded by the commands
+func DefaultedSettings(args *ArgsFlags) (effective, saved *SettingsFile, err error) {
+	state := &State{Containers: &Containers{}}
+	if err = state.layerSettings(*readSettingsLayer(args), args); err != nil {
+		return nil, nil, err
+	}
+	state = LoadDefaultsOnEmpty(state, args)
+
+	saved, err = savedSettings(state)
+	return &state.SettingsFile, saved, err
+}
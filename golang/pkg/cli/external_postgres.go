@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+)
+
+// TLS modes of the external databases, as in libpq
+const (
+	PostgresSSLDisable    = "disable"
+	PostgresSSLRequire    = "require"
+	PostgresSSLVerifyCA   = "verify-ca"
+	PostgresSSLVerifyFull = "verify-full"
+)
+
+var ErrInvalidPostgresSettings = errors.New("invalid external postgres setting")
+
+// ExternalPostgres is an existing Postgres server of a service, dyo runs no database for the service if the host is set,
+// the user and the database default to the ones of the bundled database, the password is required
+type ExternalPostgres struct {
+	Host     string `yaml:"host"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Database string `yaml:"database"`
+	// disable, require, verify-ca or verify-full, require if empty
+	SSLMode string `yaml:"sslMode"`
+	// image of the client the backups are taken by, its tools have to support the version of the server,
+	// the postgres image of the stack if empty
+	ClientImage string `yaml:"clientImage"`
+	Port        uint   `yaml:"port"`
+}
+
+// Enabled tells if the service uses the external database instead of the bundled one
+func (p *ExternalPostgres) Enabled() bool {
+	return p.Host != ""
+}
+
+// Validate checks the settings of an enabled database, the connection is checked by the migrations
+func (p *ExternalPostgres) Validate() error {
+	if !p.Enabled() {
+		return nil
+	}
+
+	if p.Password == "" {
+		return fmt.Errorf("%w: the password of %s is required", ErrInvalidPostgresSettings, p.Host)
+	}
+
+	switch p.SSLMode {
+	case "", PostgresSSLDisable, PostgresSSLRequire, PostgresSSLVerifyCA, PostgresSSLVerifyFull:
+		return nil
+	default:
+		return fmt.Errorf("%w: sslMode %q, use %s, %s, %s or %s", ErrInvalidPostgresSettings, p.SSLMode,
+			PostgresSSLDisable, PostgresSSLRequire, PostgresSSLVerifyCA, PostgresSSLVerifyFull)
+	}
+}
+
+// postgresConnection is where a service reaches its database, the bundled one is reached by its container name
+type postgresConnection struct {
+	host     string
+	user     string
+	password string
+	database string
+	// empty for the bundled databases
+	sslMode string
+	port    uint
+}
+
+func postgresConnectionOf(external *ExternalPostgres, containerName, user, password, database string) postgresConnection {
+	if !external.Enabled() {
+		return postgresConnection{
+			host:     containerName,
+			port:     defaultPostgresPort,
+			user:     user,
+			password: password,
+			database: database,
+		}
+	}
+
+	port := external.Port
+	if port == 0 {
+		port = defaultPostgresPort
+	}
+
+	return postgresConnection{
+		host:     external.Host,
+		port:     port,
+		user:     util.Fallback(external.User, user),
+		password: external.Password,
+		database: util.Fallback(external.Database, database),
+		sslMode:  util.Fallback(external.SSLMode, PostgresSSLRequire),
+	}
+}
+
+func cruxPostgresConnection(state *State) postgresConnection {
+	settings := &state.SettingsFile
+	return postgresConnectionOf(&settings.CruxExternalPostgres, state.Containers.CruxPostgres.Name,
+		settings.CruxPostgresUser, settings.CruxPostgresPassword, settings.CruxPostgresDB)
+}
+
+func kratosPostgresConnection(state *State) postgresConnection {
+	settings := &state.SettingsFile
+	return postgresConnectionOf(&settings.KratosExternalPostgres, state.Containers.KratosPostgres.Name,
+		settings.KratosPostgresUser, settings.KratosPostgresPassword, settings.KratosPostgresDB)
+}
+
+func (c postgresConnection) url(query url.Values) string {
+	postgresURL := url.URL{
+		Scheme:   "postgresql",
+		User:     url.UserPassword(c.user, c.password),
+		Host:     fmt.Sprintf("%s:%d", c.host, c.port),
+		Path:     "/" + c.database,
+		RawQuery: query.Encode(),
+	}
+
+	return postgresURL.String()
+}
+
+// prismaURL is the DATABASE_URL of crux, prisma only knows the require mode, the certificate is checked by sslaccept
+func (c postgresConnection) prismaURL() string {
+	query := url.Values{"schema": {"public"}}
+	switch c.sslMode {
+	case "":
+		// the bundled database
+	case PostgresSSLVerifyCA, PostgresSSLVerifyFull:
+		query.Set("sslmode", PostgresSSLRequire)
+		query.Set("sslaccept", "strict")
+	case PostgresSSLRequire:
+		query.Set("sslmode", PostgresSSLRequire)
+		query.Set("sslaccept", "accept_invalid_certs")
+	default:
+		query.Set("sslmode", c.sslMode)
+	}
+
+	return c.url(query)
+}
+
+// kratosDSN is the DSN of kratos, the bundled database is reached without TLS
+func (c postgresConnection) kratosDSN() string {
+	return c.url(url.Values{
+		"sslmode":        {util.Fallback(c.sslMode, PostgresSSLDisable)},
+		"max_conns":      {"20"},
+		"max_idle_conns": {"4"},
+	})
+}
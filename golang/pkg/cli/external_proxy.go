@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
+)
+
+// proxies of the stack
+const (
+	ProxyTraefik  = "traefik"
+	ProxyExternal = "external"
+)
+
+// the address of the external proxy if the externalURL is not set
+const defaultExternalURL = "http://localhost"
+
+var ErrInvalidProxySettings = errors.New("invalid proxy setting")
+
+// externalProxy tells if the UI and the API are fronted by a reverse proxy of the host instead of traefik
+func (o *Options) externalProxy() bool {
+	return o.Proxy == ProxyExternal
+}
+
+func validateProxy(options *Options) error {
+	switch options.Proxy {
+	case "", ProxyTraefik:
+		return nil
+	case ProxyExternal:
+		if options.TLS.Enabled() {
+			return fmt.Errorf("%w: the external proxy terminates TLS, remove the tls setting", ErrInvalidProxySettings)
+		}
+		if options.ExternalURL == "" {
+			return nil
+		}
+
+		externalURL, err := url.Parse(options.ExternalURL)
+		if err != nil || (externalURL.Scheme != "http" && externalURL.Scheme != "https") || externalURL.Host == "" {
+			return fmt.Errorf("%w: externalURL %q is not an http or https address", ErrInvalidProxySettings, options.ExternalURL)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: proxy %q, use %s or %s", ErrInvalidProxySettings, options.Proxy, ProxyTraefik, ProxyExternal)
+	}
+}
+
+// externalURL is the address the external proxy serves the stack at
+func externalURL(options *Options) string {
+	if options.ExternalURL == "" {
+		return defaultExternalURL
+	}
+
+	return strings.TrimSuffix(options.ExternalURL, "/")
+}
+
+// internalCruxURL is how the services reach the API of crux in the network of the stack
+func internalCruxURL(state *State) string {
+	if state.SettingsFile.externalProxy() {
+		return fmt.Sprintf("http://%s:%d", state.Containers.Crux.Name, defaultCruxHTTPPort)
+	}
+
+	return fmt.Sprintf("http://%s:%d", state.Containers.Traefik.Name, defaultTraefikInternalPort)
+}
+
+// internalKratosURL is how the services reach the public API of kratos in the network of the stack,
+// traefik strips the /kratos prefix of the path
+func internalKratosURL(state *State) string {
+	if state.SettingsFile.externalProxy() {
+		return fmt.Sprintf("http://%s:%d", state.Containers.Kratos.Name, defaultKratosPublicPort)
+	}
+
+	return fmt.Sprintf("http://%s:%d/kratos", state.Containers.Traefik.Name, defaultTraefikInternalPort)
+}
+
+// withTraefikRoutes adds the routing labels of traefik, they are left out with an external proxy,
+// so a traefik of the host does not pick them up
+func withTraefikRoutes(options *Options, labels, routes map[string]string) map[string]string {
+	if !options.externalProxy() {
+		maps.Copy(labels, routes)
+	}
+
+	return labels
+}
+
+// externalProxyRules are the routes the external proxy needs, the same ones traefik has, with an nginx
+// and a Caddy example of them
+func externalProxyRules(options *Options) []string {
+	kratosAddr := fmt.Sprintf("localhost:%d", options.KratosPublicPort)
+	cruxAddr := fmt.Sprintf("localhost:%d", options.CruxHTTPPort)
+	uiAddr := fmt.Sprintf("localhost:%d", options.CruxUIPort)
+
+	return []string{
+		fmt.Sprintf("Route the requests of %s to the stack:", externalURL(options)),
+		fmt.Sprintf("  /kratos/*  -> http://%s, without the /kratos prefix", kratosAddr),
+		fmt.Sprintf("  /api/*     -> http://%s, except /api/auth and /api/status", cruxAddr),
+		fmt.Sprintf("  /*         -> http://%s", uiAddr),
+		"nginx:",
+		fmt.Sprintf("  location /kratos/ { proxy_pass http://%s/; }", kratosAddr),
+		fmt.Sprintf("  location /api/auth { proxy_pass http://%s; }", uiAddr),
+		fmt.Sprintf("  location /api/status { proxy_pass http://%s; }", uiAddr),
+		fmt.Sprintf("  location /api/ { proxy_pass http://%s; }", cruxAddr),
+		fmt.Sprintf("  location / { proxy_pass http://%s; }", uiAddr),
+		"Caddy:",
+		fmt.Sprintf("  handle_path /kratos/* { reverse_proxy %s }", kratosAddr),
+		"  @crux {",
+		"    path /api/*",
+		"    not path /api/auth* /api/status*",
+		"  }",
+		fmt.Sprintf("  handle @crux { reverse_proxy %s }", cruxAddr),
+		fmt.Sprintf("  handle { reverse_proxy %s }", uiAddr),
+	}
+}
+
+// printExternalProxyRules logs the routes, so the proxy of the host can be configured after the start
+func printExternalProxyRules(options *Options) {
+	for _, line := range externalProxyRules(options) {
+		log.Info().Msg(line)
+	}
+}
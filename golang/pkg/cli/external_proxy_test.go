@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestValidateProxy(t *testing.T) {
+	assert.NoError(t, cli.ValidateProxy(&cli.Options{}))
+	assert.NoError(t, cli.ValidateProxy(&cli.Options{Proxy: cli.ProxyTraefik}))
+	assert.NoError(t, cli.ValidateProxy(&cli.Options{Proxy: cli.ProxyExternal}))
+	assert.NoError(t, cli.ValidateProxy(&cli.Options{Proxy: cli.ProxyExternal, ExternalURL: "https://dyo.example.com"}))
+
+	assert.ErrorIs(t, cli.ValidateProxy(&cli.Options{Proxy: "nginx"}), cli.ErrInvalidProxySettings)
+	assert.ErrorIs(t, cli.ValidateProxy(&cli.Options{Proxy: cli.ProxyExternal, ExternalURL: "dyo.example.com"}),
+		cli.ErrInvalidProxySettings)
+	assert.ErrorIs(t, cli.ValidateProxy(&cli.Options{Proxy: cli.ProxyExternal, TLS: cli.TLSSettings{Mode: cli.TLSModeSelfSigned}}),
+		cli.ErrInvalidProxySettings)
+}
+
+func TestExternalProxyPublicURL(t *testing.T) {
+	settings := &cli.SettingsFile{Options: cli.Options{Proxy: cli.ProxyExternal, TraefikWebPort: 8000}}
+	assert.Equal(t, "http://localhost", cli.PublicURL(settings, "localhost"))
+
+	settings.ExternalURL = "https://dyo.example.com/"
+	assert.Equal(t, "https://dyo.example.com", cli.PublicURL(settings, "localhost"))
+}
+
+func TestExternalProxyRules(t *testing.T) {
+	rules := cli.ExternalProxyRules(&cli.Options{
+		Proxy:            cli.ProxyExternal,
+		ExternalURL:      "https://dyo.example.com",
+		KratosPublicPort: 4433,
+		CruxHTTPPort:     1848,
+		CruxUIPort:       3000,
+	})
+
+	assert.Equal(t, "Route the requests of https://dyo.example.com to the stack:", rules[0])
+	assert.Contains(t, rules, "  location /kratos/ { proxy_pass http://localhost:4433/; }")
+	assert.Contains(t, rules, "  location /api/ { proxy_pass http://localhost:1848; }")
+	assert.Contains(t, rules, "  handle { reverse_proxy localhost:3000 }")
+}
+
+func TestStackComposeExternalProxy(t *testing.T) {
+	args := &cli.ArgsFlags{Prefix: "dyo-stable"}
+	state := &cli.State{Ctx: context.Background(), Containers: &cli.Containers{}}
+	state.SettingsFile.Version = "stable"
+	state.SettingsFile.Network = "dyo-stable"
+	state.SettingsFile.Proxy = cli.ProxyExternal
+	state.SettingsFile.ExternalURL = "https://dyo.example.com"
+	state = cli.LoadDefaultsOnEmpty(state, args)
+
+	data, err := cli.StackCompose(state, args)
+	assert.NoError(t, err)
+	compose := &composeFile{}
+	assert.NoError(t, yaml.Unmarshal(data, compose))
+
+	assert.NotContains(t, serviceNames(compose.Services), "traefik")
+	assert.NotContains(t, compose.Services["crux"].Labels, "traefik.enable")
+
+	ui := compose.Services["crux-ui"]
+	assert.Contains(t, ui.Environment, "CRUX_URL=http://dyo-stable_crux:1848")
+	assert.Contains(t, ui.Environment, "KRATOS_URL=http://dyo-stable_kratos:4433")
+	assert.Contains(t, ui.Environment, "CRUX_UI_URL=https://dyo.example.com")
+
+	_, err = cli.StackManifests(state, args)
+	assert.ErrorIs(t, err, cli.ErrInvalidProxySettings)
+}
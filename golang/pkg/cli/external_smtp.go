@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// TLS modes of the external SMTP server
+const (
+	SMTPTLSImplicit = "tls"
+	SMTPTLSStartTLS = "starttls"
+	SMTPTLSNone     = "none"
+)
+
+// the well-known ports of the TLS modes
+const (
+	defaultSMTPTLSPort      = 465
+	defaultSMTPStartTLSPort = 587
+	defaultSMTPPlainPort    = 25
+)
+
+var ErrInvalidSMTPSettings = errors.New("invalid external smtp setting")
+
+// ExternalSMTP is the mail server crux and the courier of kratos send the e-mails with, mailslurper is not started
+// if the host is set, so the invitations and the recoveries reach the real inboxes
+type ExternalSMTP struct {
+	Host     string `yaml:"host"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	// tls, starttls or none, starttls if empty
+	TLS string `yaml:"tls"`
+	// the port of the TLS mode if empty, 465, 587 or 25
+	Port          uint `yaml:"port"`
+	SkipTLSVerify bool `yaml:"skipTLSVerify"`
+}
+
+// Enabled tells if the stack sends its e-mails with the external server instead of mailslurper
+func (s *ExternalSMTP) Enabled() bool {
+	return s.Host != ""
+}
+
+// Validate checks the settings of an enabled server, the credentials are checked on the first e-mail
+func (s *ExternalSMTP) Validate() error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	if s.User != "" && s.Password == "" {
+		return fmt.Errorf("%w: the password of %s is required", ErrInvalidSMTPSettings, s.User)
+	}
+
+	switch s.TLS {
+	case "", SMTPTLSImplicit, SMTPTLSStartTLS, SMTPTLSNone:
+		return nil
+	default:
+		return fmt.Errorf("%w: tls %q, use %s, %s or %s", ErrInvalidSMTPSettings, s.TLS,
+			SMTPTLSImplicit, SMTPTLSStartTLS, SMTPTLSNone)
+	}
+}
+
+func (s *ExternalSMTP) tlsMode() string {
+	if s.TLS == "" {
+		return SMTPTLSStartTLS
+	}
+
+	return s.TLS
+}
+
+func (s *ExternalSMTP) port() uint {
+	if s.Port != 0 {
+		return s.Port
+	}
+
+	switch s.tlsMode() {
+	case SMTPTLSImplicit:
+		return defaultSMTPTLSPort
+	case SMTPTLSNone:
+		return defaultSMTPPlainPort
+	default:
+		return defaultSMTPStartTLSPort
+	}
+}
+
+func (s *ExternalSMTP) url(query url.Values) string {
+	scheme := "smtp"
+	if s.tlsMode() == SMTPTLSImplicit {
+		scheme = "smtps"
+	}
+	if s.SkipTLSVerify {
+		query.Set("skip_ssl_verify", "true")
+	}
+
+	smtpURL := url.URL{
+		Scheme:   scheme,
+		Host:     fmt.Sprintf("%s:%d", s.Host, s.port()),
+		Path:     "/",
+		RawQuery: query.Encode(),
+	}
+	if s.User != "" {
+		smtpURL.User = url.UserPassword(s.User, s.Password)
+	}
+
+	return smtpURL.String()
+}
+
+// cruxSMTPURI is the SMTP_URI of crux, nodemailer upgrades the plain connections opportunistically,
+// so starttls is required and none turns it off
+func cruxSMTPURI(state *State) string {
+	smtp := &state.SettingsFile.ExternalSMTP
+	if !smtp.Enabled() {
+		return fmt.Sprintf("%s:%d/?skip_ssl_verify=true&legacy_ssl=true", state.Containers.MailSlurper.Name, defaultMailSlurperSMTPPort)
+	}
+
+	query := url.Values{}
+	switch smtp.tlsMode() {
+	case SMTPTLSStartTLS:
+		query.Set("requireTLS", "true")
+	case SMTPTLSNone:
+		query.Set("ignoreTLS", "true")
+	}
+
+	return smtp.url(query)
+}
+
+// kratosSMTPURI is the connection URI of the courier of kratos, it uses starttls on the plain connections by default
+func kratosSMTPURI(state *State) string {
+	smtp := &state.SettingsFile.ExternalSMTP
+	if !smtp.Enabled() {
+		return fmt.Sprintf("smtps://test:test@%s:%d/?skip_ssl_verify=true&legacy_ssl=true",
+			state.Containers.MailSlurper.Name, defaultMailSlurperSMTPPort)
+	}
+
+	query := url.Values{}
+	if smtp.tlsMode() == SMTPTLSNone {
+		query.Set("disable_starttls", "true")
+	}
+
+	return smtp.url(query)
+}
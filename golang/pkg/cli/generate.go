@@ -4,12 +4,28 @@
 	"fmt"
 
 	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/systemd"
 )
 
 const (
 	GenerateCommand = "generate"
 )
 
+const (
+	FlagSystemdBinary       = "binary"
+	FlagSystemdEnvFile      = "env-file"
+	FlagSystemdUser         = "user"
+	FlagSystemdRestart      = "restart"
+	FlagSystemdRestartSec   = "restart-sec"
+	FlagSystemdDataPath     = "data-path"
+	FlagSystemdRWPaths      = "read-write-path"
+	FlagGenerateOutput      = "output"
+	FlagKubernetesHost      = "host"
+	FlagKubernetesNamespace = "namespace"
+	FlagKubernetesStorage   = "storage-size"
+)
+
 func GetGenerateCommand() *ucli.Command {
 	return &ucli.Command{
 		Name:        GenerateCommand,
@@ -18,18 +34,133 @@ func GetGenerateCommand() *ucli.Command {
 		Usage:       "dyo gen <component> <....>",
 		UsageText:   "dyo gen crux encryption-key",
 		Description: "Some components need tokens or keys, these helpers could be used to generate them",
+		Subcommands: []*ucli.Command{
+			{
+				Name:   "crux",
+				Action: ucli.ShowSubcommandHelp,
+				Usage:  "dyo gen crux encryption-key",
+				Subcommands: []*ucli.Command{{
+					Name: "encryption-key",
+					Action: func(_ *ucli.Context) error {
+						//nolint:forbidigo
+						fmt.Print(generateCruxEncryptionKey())
+						return nil
+					},
+				}},
+			},
+			getGenerateSystemdCommand(),
+			{
+				Name: ComposeCommand,
+				Usage: "Export the stack the up command would create as a compose file, " +
+					"the global flags of up apply, eg. dyo --prefix demo gen compose",
+				Flags: []ucli.Flag{
+					&ucli.StringFlag{
+						Name:    FlagGenerateOutput,
+						Aliases: []string{"o"},
+						Value:   defaultComposeOutput,
+						Usage:   "path of the compose file, it contains the secrets of the stack",
+					},
+				},
+				Action: run,
+			},
+			{
+				Name:    KubernetesCommand,
+				Aliases: []string{"k8s"},
+				Usage: "Export the stack the up command would create as Kubernetes manifests, " +
+					"the global flags of up apply, eg. dyo --prefix demo gen k8s --host dyo.example.com",
+				Flags: []ucli.Flag{
+					&ucli.StringFlag{
+						Name:    FlagGenerateOutput,
+						Aliases: []string{"o"},
+						Value:   defaultKubernetesOutput,
+						Usage:   "path of the manifests, they contain the secrets of the stack",
+					},
+					&ucli.StringFlag{
+						Name:  FlagKubernetesHost,
+						Usage: "host of the ingress, every host is routed if it is empty",
+					},
+					&ucli.StringFlag{
+						Name:  FlagKubernetesNamespace,
+						Usage: "namespace of the stack, the prefix by default",
+					},
+					&ucli.StringFlag{
+						Name:  FlagKubernetesStorage,
+						Value: defaultKubernetesStorage,
+						Usage: "requested size of the volume claims",
+					},
+				},
+				Action: run,
+			},
+		},
+	}
+}
+
+func getGenerateSystemdCommand() *ucli.Command {
+	defaults := systemd.DefaultUnitOptions()
+
+	return &ucli.Command{
+		Name:   "systemd",
+		Action: ucli.ShowSubcommandHelp,
+		Usage:  "dyo gen systemd dagent > /etc/systemd/system/dagent.service",
 		Subcommands: []*ucli.Command{{
-			Name:   "crux",
-			Action: ucli.ShowSubcommandHelp,
-			Usage:  "dyo gen crux encryption-key",
-			Subcommands: []*ucli.Command{{
-				Name: "encryption-key",
-				Action: func(_ *ucli.Context) error {
-					//nolint:forbidigo
-					fmt.Print(generateCruxEncryptionKey())
-					return nil
-				},
-			}},
+			Name:  "dagent",
+			Usage: "Hardened systemd unit for running dagent as a binary instead of a container",
+			Flags: []ucli.Flag{
+				&ucli.StringFlag{
+					Name:  FlagSystemdBinary,
+					Value: defaults.BinaryPath,
+					Usage: "Absolute path of the dagent binary",
+				},
+				&ucli.StringFlag{
+					Name:  FlagSystemdEnvFile,
+					Value: defaults.EnvFile,
+					Usage: "Absolute path of the environment file holding the agent configuration",
+				},
+				&ucli.StringFlag{
+					Name:  FlagSystemdUser,
+					Value: defaults.User,
+					Usage: "User running the agent, it has to be a member of the docker group",
+				},
+				&ucli.StringFlag{
+					Name:  FlagSystemdRestart,
+					Value: defaults.Restart,
+					Usage: "Restart policy of the service",
+				},
+				&ucli.UintFlag{
+					Name:  FlagSystemdRestartSec,
+					Value: defaults.RestartSec,
+					Usage: "Seconds to wait before restarting the service",
+				},
+				&ucli.StringFlag{
+					Name:  FlagSystemdDataPath,
+					Value: defaults.DataPath,
+					Usage: "Data mount path of the agent, the only writable path besides the state directory",
+				},
+				&ucli.StringSliceFlag{
+					Name:  FlagSystemdRWPaths,
+					Usage: "Additional writable paths",
+				},
+			},
+			Action: func(cCtx *ucli.Context) error {
+				opts := defaults
+				opts.BinaryPath = cCtx.String(FlagSystemdBinary)
+				opts.EnvFile = cCtx.String(FlagSystemdEnvFile)
+				opts.User = cCtx.String(FlagSystemdUser)
+				opts.Group = opts.User
+				opts.Restart = cCtx.String(FlagSystemdRestart)
+				opts.RestartSec = cCtx.Uint(FlagSystemdRestartSec)
+				opts.DataPath = cCtx.String(FlagSystemdDataPath)
+				opts.ReadWritePaths = cCtx.StringSlice(FlagSystemdRWPaths)
+
+				unit, err := systemd.RenderDagentUnit(opts)
+				if err != nil {
+					return err
+				}
+
+				//nolint:forbidigo
+				fmt.Print(unit)
+				return nil
+			},
 		}},
 	}
 }
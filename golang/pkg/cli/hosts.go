@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/term"
+)
+
+const (
+	FlagNoHosts = "no-hosts"
+)
+
+const (
+	unixHostsFile      = "/etc/hosts"
+	hostsLoopback      = "127.0.0.1"
+	hostsMarkerPrefix  = "# dyo "
+	hostsLookupTimeout = 2 * time.Second
+)
+
+// hostsFilePath is the hosts file of the machine, the one of the system root on Windows
+func hostsFilePath() string {
+	if runtime.GOOS == "windows" {
+		systemRoot := os.Getenv("SystemRoot")
+		if systemRoot == "" {
+			systemRoot = `C:\Windows`
+		}
+		return systemRoot + `\System32\drivers\etc\hosts`
+	}
+
+	return unixHostsFile
+}
+
+// hostsMarker tags the entries of the stack, so they are removed with the stack and not touched by the other stacks
+func hostsMarker(prefix string) string {
+	return hostsMarkerPrefix + prefix
+}
+
+// resolvesLocally tells if the name resolves already, by the hosts file or a DNS server
+func resolvesLocally(ctx context.Context, name string) bool {
+	ctx, cancel := context.WithTimeout(ctx, hostsLookupTimeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupHost(ctx, name)
+	return err == nil && len(addrs) > 0
+}
+
+// unresolvedLocalDomains are the domains of the traefik routes which do not resolve, so the URLs of the stack
+// would not open, the domains of acme are public ones and the external proxies have their own
+func unresolvedLocalDomains(settings *SettingsFile, resolves func(string) bool) []string {
+	domain := settings.TLS.Domain
+	if settings.externalProxy() || settings.TLS.Mode == TLSModeACME ||
+		domain == "" || domain == localhost || net.ParseIP(domain) != nil {
+		return nil
+	}
+
+	if resolves(domain) {
+		return nil
+	}
+
+	return []string{domain}
+}
+
+// withoutHostsEntries removes the entries of the stack from the content of the hosts file
+func withoutHostsEntries(content, prefix string) (string, bool) {
+	marker := hostsMarker(prefix)
+	lines := []string{}
+	removed := false
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasSuffix(strings.TrimSpace(line), marker) {
+			removed = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) == 0 {
+		return "", removed
+	}
+
+	return strings.Join(lines, "\n") + "\n", removed
+}
+
+// withHostsEntries replaces the entries of the stack in the content of the hosts file with the domains
+// pointing to the loopback address
+func withHostsEntries(content, prefix string, domains []string) string {
+	content, _ = withoutHostsEntries(content, prefix)
+
+	for _, domain := range domains {
+		content += fmt.Sprintf("%s\t%s %s\n", hostsLoopback, domain, hostsMarker(prefix))
+	}
+
+	return content
+}
+
+// confirmHostsChange asks before the hosts file is changed, there is nobody to ask if the input is not a terminal
+func confirmHostsChange(question string, in *os.File, out io.Writer) bool {
+	if !term.IsTerminal(int(in.Fd())) {
+		return false
+	}
+
+	fmt.Fprintf(out, "%s [Y/n] ", question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "" || answer == "y" || answer == "yes"
+}
+
+// writeHostsFile replaces the content of the hosts file, keeping its owner and mode, sudo is used if the user
+// can not write it
+func writeHostsFile(hostsPath, content string) error {
+	err := os.WriteFile(hostsPath, []byte(content), filePerms)
+	if err == nil || !errors.Is(err, os.ErrPermission) {
+		return err
+	}
+
+	sudo, lookErr := exec.LookPath(sudoBinary)
+	if lookErr != nil || os.Geteuid() == 0 {
+		return err
+	}
+
+	log.Info().Str("path", hostsPath).Msg("Escalating with sudo to write the hosts file, it may ask for your password")
+	//#nosec G204 -- the path of the hosts file is not given by the user
+	cmd := exec.Command(sudo, "tee", hostsPath)
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = io.Discard
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// ensureHostsEntries offers to point the local domains of the stack to this machine, failures are only warnings,
+// the stack is still reachable on localhost
+func ensureHostsEntries(ctx context.Context, state *State, args *ArgsFlags) {
+	if args.NoHosts {
+		return
+	}
+
+	domains := unresolvedLocalDomains(&state.SettingsFile, func(name string) bool { return resolvesLocally(ctx, name) })
+	if len(domains) == 0 {
+		return
+	}
+
+	hostsPath := hostsFilePath()
+	entries := withHostsEntries("", args.Prefix, domains)
+	question := fmt.Sprintf("%s does not resolve, add it to %s?", strings.Join(domains, ", "), hostsPath)
+	if !confirmHostsChange(question, os.Stdin, os.Stderr) {
+		log.Warn().Strs("domains", domains).Str("path", hostsPath).
+			Msgf("The domains do not resolve, add them to the hosts file: %s", strings.TrimSpace(entries))
+		return
+	}
+
+	content, err := os.ReadFile(hostsPath) //#nosec G304 -- the hosts file of the system
+	if err != nil {
+		log.Warn().Err(err).Str("path", hostsPath).Msg("Failed to read the hosts file")
+		return
+	}
+
+	if err = writeHostsFile(hostsPath, withHostsEntries(string(content), args.Prefix, domains)); err != nil {
+		log.Warn().Err(err).Str("path", hostsPath).
+			Msgf("Failed to write the hosts file, add the domains manually: %s", strings.TrimSpace(entries))
+		return
+	}
+
+	log.Info().Strs("domains", domains).Str("path", hostsPath).Msg("The domains point to this machine")
+}
+
+// removeHostsEntries removes the entries of the stack from the hosts file, if it has any
+func removeHostsEntries(args *ArgsFlags) {
+	if args.NoHosts {
+		return
+	}
+
+	hostsPath := hostsFilePath()
+	content, err := os.ReadFile(hostsPath) //#nosec G304 -- the hosts file of the system
+	if err != nil {
+		log.Debug().Err(err).Str("path", hostsPath).Msg("Failed to read the hosts file")
+		return
+	}
+
+	cleaned, removed := withoutHostsEntries(string(content), args.Prefix)
+	if !removed {
+		return
+	}
+
+	if err = writeHostsFile(hostsPath, cleaned); err != nil {
+		log.Warn().Err(err).Str("path", hostsPath).
+			Msgf("Failed to write the hosts file, remove the lines ending with %q manually", hostsMarker(args.Prefix))
+		return
+	}
+
+	log.Info().Str("path", hostsPath).Msg("The domains of the stack are removed from the hosts file")
+}
@@ -0,0 +1,59 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestUnresolvedLocalDomains(t *testing.T) {
+	resolves := func(name string) bool { return name == "resolved.example.com" }
+	settingsOf := func(options cli.Options) *cli.SettingsFile {
+		return &cli.SettingsFile{Options: options}
+	}
+
+	assert.Equal(t, []string{"dyo.test"},
+		cli.UnresolvedLocalDomains(settingsOf(cli.Options{TLS: cli.TLSSettings{Domain: "dyo.test"}}), resolves))
+
+	skipped := []cli.Options{
+		{},
+		{TLS: cli.TLSSettings{Domain: "localhost"}},
+		{TLS: cli.TLSSettings{Domain: "192.168.1.10"}},
+		{TLS: cli.TLSSettings{Domain: "resolved.example.com"}},
+		{TLS: cli.TLSSettings{Domain: "dyo.example.com", Mode: cli.TLSModeACME}},
+		{TLS: cli.TLSSettings{Domain: "dyo.test"}, Proxy: cli.ProxyExternal},
+	}
+	for _, it := range skipped {
+		assert.Empty(t, cli.UnresolvedLocalDomains(settingsOf(it), resolves), it.TLS.Domain)
+	}
+}
+
+func TestWithHostsEntriesReplacesTheEntriesOfTheStack(t *testing.T) {
+	content := "127.0.0.1\tlocalhost\n" +
+		"127.0.0.1\told.test # dyo dyo-stable\n" +
+		"127.0.0.1\tother.test # dyo other\n"
+
+	assert.Equal(t, "127.0.0.1\tlocalhost\n"+
+		"127.0.0.1\tother.test # dyo other\n"+
+		"127.0.0.1\tdyo.test # dyo dyo-stable\n",
+		cli.WithHostsEntries(content, "dyo-stable", []string{"dyo.test"}))
+}
+
+func TestWithoutHostsEntries(t *testing.T) {
+	content := "127.0.0.1\tlocalhost\n" +
+		"127.0.0.1\tdyo.test # dyo dyo-stable\n" +
+		"127.0.0.1\tother.test # dyo other-dyo-stable\n"
+
+	cleaned, removed := cli.WithoutHostsEntries(content, "dyo-stable")
+	assert.True(t, removed)
+	assert.Equal(t, "127.0.0.1\tlocalhost\n127.0.0.1\tother.test # dyo other-dyo-stable\n", cleaned)
+
+	cleaned, removed = cli.WithoutHostsEntries(cleaned, "dyo-stable")
+	assert.False(t, removed)
+	assert.Equal(t, "127.0.0.1\tlocalhost\n127.0.0.1\tother.test # dyo other-dyo-stable\n", cleaned)
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+)
+
+// postgresService is the key of the image of both databases, the other services are keyed by their stack item
+const postgresService = "postgres"
+
+var ErrInvalidImagePin = errors.New("invalid image pin")
+
+// ImagePins are the images of the services by their name, a bare tag is applied to the default image of the service,
+// anything else replaces the image, eg. crux: 0.11.2 or traefik: docker.io/library/traefik:v2.10
+type ImagePins map[string]string
+
+func pinnableServices() []string {
+	return []string{string(crux), string(cruxUI), string(kratos), string(traefik), string(mailSlurper), postgresService}
+}
+
+// isImageTag tells if the pin is only a tag, references always have a tag, a digest or a path
+func isImageTag(pin string) bool {
+	return !strings.ContainsAny(pin, "/:@")
+}
+
+// Validate checks the services and the references of the pins
+func (p ImagePins) Validate() error {
+	services := pinnableServices()
+	names := make([]string, 0, len(p))
+	for service := range p {
+		names = append(names, service)
+	}
+	sort.Strings(names)
+
+	for _, service := range names {
+		pin := p[service]
+		if !slices.Contains(services, service) {
+			return fmt.Errorf("%w: unknown service %s, it is one of %s", ErrInvalidImagePin, service, strings.Join(services, ", "))
+		}
+
+		ref := pin
+		if isImageTag(pin) {
+			ref = "example.com/image:" + pin
+		}
+		if _, err := reference.ParseNormalizedNamed(ref); err != nil {
+			return fmt.Errorf("%w: %s: %q: %w", ErrInvalidImagePin, service, pin, err)
+		}
+	}
+
+	return nil
+}
+
+// image is the pinned image of the service, or the default if it is not pinned
+func (p ImagePins) image(service, defaultImage string) string {
+	pin, ok := p[service]
+	if !ok {
+		return defaultImage
+	}
+	if !isImageTag(pin) {
+		return pin
+	}
+
+	named, err := reference.ParseNormalizedNamed(defaultImage)
+	if err != nil {
+		return defaultImage
+	}
+
+	return named.Name() + ":" + pin
+}
+
+// serviceImage is the image of the service, the pinned one or the default of the release channel set by the version
+func (s *State) serviceImage(service string) string {
+	defaults := map[string]string{
+		string(crux):        fmt.Sprintf("%s:%s", s.Crux.Image, s.SettingsFile.Version),
+		string(cruxUI):      fmt.Sprintf("%s:%s", s.CruxUI.Image, s.SettingsFile.Version),
+		string(kratos):      fmt.Sprintf("%s:%s", s.Kratos.Image, s.SettingsFile.Version),
+		string(traefik):     traefikImage,
+		string(mailSlurper): mailSlurperImage,
+		postgresService:     postgresImage,
+	}
+
+	return s.image(s.SettingsFile.Images.image(service, defaults[service]))
+}
@@ -0,0 +1,54 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/mirror"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func pinnedState(pins cli.ImagePins) *cli.State {
+	state := &cli.State{Containers: &cli.Containers{}}
+	state.Crux.Image = "ghcr.io/dyrector-io/dyrectorio/web/crux"
+	state.CruxUI.Image = "ghcr.io/dyrector-io/dyrectorio/web/crux-ui"
+	state.Kratos.Image = "ghcr.io/dyrector-io/dyrectorio/web/kratos"
+	state.SettingsFile.Version = "stable"
+	state.SettingsFile.Images = pins
+
+	return state
+}
+
+func TestServiceImage(t *testing.T) {
+	state := pinnedState(cli.ImagePins{
+		"crux":     "0.11.2",
+		"traefik":  "docker.io/library/traefik:v2.10",
+		"postgres": "13.14-alpine",
+	})
+
+	assert.Equal(t, "ghcr.io/dyrector-io/dyrectorio/web/crux:0.11.2", cli.ServiceImage(state, "crux"))
+	assert.Equal(t, "ghcr.io/dyrector-io/dyrectorio/web/crux-ui:stable", cli.ServiceImage(state, "crux-ui"))
+	assert.Equal(t, "docker.io/library/traefik:v2.10", cli.ServiceImage(state, "traefik"))
+	assert.Equal(t, "docker.io/library/postgres:13.14-alpine", cli.ServiceImage(state, "postgres"))
+	assert.Equal(t, "docker.io/oryd/mailslurper:smtps-latest", cli.ServiceImage(state, "mailslurper"))
+}
+
+func TestServiceImageRewritesPins(t *testing.T) {
+	state := pinnedState(cli.ImagePins{"kratos": "0.11.2"})
+	state.SettingsFile.ImageRewrite = mirror.Rules{{Match: "ghcr.io/dyrector-io", Replace: "mirror.local/dyo"}}
+
+	assert.Equal(t, "mirror.local/dyo/dyrectorio/web/kratos:0.11.2", cli.ServiceImage(state, "kratos"))
+}
+
+func TestImagePinsValidate(t *testing.T) {
+	assert.NoError(t, cli.ImagePins{}.Validate())
+	assert.NoError(t, cli.ImagePins{"crux-ui": "latest", "mailslurper": "oryd/mailslurper@" + testDigest}.Validate())
+
+	assert.ErrorIs(t, cli.ImagePins{"crux-postgres": "13"}.Validate(), cli.ErrInvalidImagePin)
+	assert.ErrorIs(t, cli.ImagePins{"crux": ""}.Validate(), cli.ErrInvalidImagePin)
+	assert.ErrorIs(t, cli.ImagePins{"crux": "Not A Tag"}.Validate(), cli.ErrInvalidImagePin)
+}
@@ -0,0 +1,551 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+const (
+	KubernetesCommand = "kubernetes"
+)
+
+const (
+	defaultKubernetesOutput  = "dyo-kubernetes.yaml"
+	defaultKubernetesStorage = "1Gi"
+	kubernetesAppLabel       = "app"
+	kubernetesPartOfLabel    = "app.kubernetes.io/part-of"
+	traefikConfigDirectory   = "/etc/traefik"
+	traefikRoutesFile        = "routes.yml"
+	traefikDynamicConfFile   = "dynamic_conf.yml"
+	traefikLabelPrefix       = "traefik.http."
+	traefikDockerProvider    = "--providers.docker"
+	dockerSocketTarget       = "/var/run/docker.sock"
+)
+
+// traefikDynamicConfig is the file provider configuration of the routes set by the labels of the containers
+type traefikDynamicConfig struct {
+	HTTP traefikHTTPConfig `yaml:"http"`
+}
+
+type traefikHTTPConfig struct {
+	Routers     map[string]*traefikRouter     `yaml:"routers"`
+	Services    map[string]*traefikService    `yaml:"services"`
+	Middlewares map[string]*traefikMiddleware `yaml:"middlewares,omitempty"`
+}
+
+type traefikRouter struct {
+	Rule        string   `yaml:"rule"`
+	Service     string   `yaml:"service"`
+	EntryPoints []string `yaml:"entryPoints,omitempty"`
+	Middlewares []string `yaml:"middlewares,omitempty"`
+}
+
+type traefikService struct {
+	LoadBalancer traefikLoadBalancer `yaml:"loadBalancer"`
+}
+
+type traefikLoadBalancer struct {
+	Servers []traefikServer `yaml:"servers"`
+}
+
+type traefikServer struct {
+	URL string `yaml:"url"`
+}
+
+type traefikMiddleware struct {
+	StripPrefix *traefikStripPrefix `yaml:"stripPrefix,omitempty"`
+}
+
+type traefikStripPrefix struct {
+	Prefixes []string `yaml:"prefixes"`
+}
+
+// kubernetesStack maps the containers of the stack to workloads, the container names are not valid
+// service names, so they are renamed everywhere, eg. in the connection strings of the environment
+type kubernetesStack struct {
+	stack     *stackSpecs
+	renamer   *strings.Replacer
+	storage   resource.Quantity
+	namespace string
+	host      string
+}
+
+// kubernetesName is the DNS label of the container name, eg. dyo-stable_crux is dyo-stable-crux
+func kubernetesName(containerName string) string {
+	return strings.ToLower(strings.ReplaceAll(containerName, "_", "-"))
+}
+
+// newKubernetesStack renames the containers, and the local address of the UI to the host of the ingress if it is set
+func newKubernetesStack(stack *stackSpecs, webPort uint, namespace, host, storage string) (*kubernetesStack, error) {
+	quantity, err := resource.ParseQuantity(storage)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage size %q: %w", storage, err)
+	}
+
+	names := []string{}
+	for id := range stack.specs {
+		names = append(names, stack.specs[id].Name)
+	}
+	// the longer names first, as the names of the stack are prefixes of each other
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+
+	pairs := []string{}
+	if host != "" {
+		pairs = append(pairs, fmt.Sprintf("http://%s:%d", localhost, webPort), "http://"+host)
+	}
+	for _, name := range names {
+		pairs = append(pairs, name, kubernetesName(name))
+	}
+
+	return &kubernetesStack{
+		stack:     stack,
+		renamer:   strings.NewReplacer(pairs...),
+		storage:   quantity,
+		namespace: namespace,
+		host:      host,
+	}, nil
+}
+
+func (k *kubernetesStack) objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: k.namespace,
+		Labels: map[string]string{
+			kubernetesAppLabel:    name,
+			kubernetesPartOfLabel: "dyrectorio",
+		},
+	}
+}
+
+func (k *kubernetesStack) renameAll(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	renamed := make([]string, 0, len(values))
+	for _, it := range values {
+		renamed = append(renamed, k.renamer.Replace(it))
+	}
+
+	return renamed
+}
+
+// container maps the spec to a container, the sensitive variables are read from the secret,
+// the docker socket is not mounted, it is only used by traefik, which reads its routes from a file instead
+func (k *kubernetesStack) container(spec *containerbuilder.Spec, secretName string) (
+	container corev1.Container, secret map[string]string, volumes []corev1.Volume,
+) {
+	name := kubernetesName(spec.Name)
+	container = corev1.Container{
+		Name:       name,
+		Image:      spec.Image,
+		Command:    k.renameAll(spec.Entrypoint),
+		Args:       k.renameAll(spec.Cmd),
+		WorkingDir: spec.WorkingDirectory,
+	}
+	if spec.User != nil {
+		container.SecurityContext = &corev1.SecurityContext{RunAsUser: spec.User}
+	}
+
+	secret = map[string]string{}
+	for _, env := range spec.Env {
+		key, value, _ := strings.Cut(env, "=")
+		value = k.renamer.Replace(value)
+		if !sensitiveEnvPattern.MatchString(key) {
+			container.Env = append(container.Env, corev1.EnvVar{Name: key, Value: value})
+			continue
+		}
+
+		secret[key] = value
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: key,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			}},
+		})
+	}
+
+	for _, it := range spec.PortBindings {
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			Name:          fmt.Sprintf("port-%d", it.ExposedPort),
+			ContainerPort: int32(it.ExposedPort),
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	for i, it := range spec.Mounts {
+		volumeName := fmt.Sprintf("%s-%d", name, i)
+		switch {
+		case it.Type == mount.TypeVolume:
+			volumes = append(volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: kubernetesName(it.Source),
+				}},
+			})
+		case it.Type == mount.TypeBind && it.Target != dockerSocketTarget:
+			volumes = append(volumes, corev1.Volume{
+				Name:         volumeName,
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: it.Source}},
+			})
+		default:
+			continue
+		}
+
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: it.Target,
+			ReadOnly:  it.ReadOnly,
+		})
+	}
+
+	return container, secret, volumes
+}
+
+// workload is the secret, the volume claims, the deployment and the service of a stack item,
+// its migration is an init container, like the pre-start hook it runs before every start
+func (k *kubernetesStack) workload(id stackItemID) []any {
+	spec := k.stack.specs[id]
+	name := kubernetesName(spec.Name)
+	secretName := name + "-env"
+
+	container, secret, volumes := k.container(&spec, secretName)
+	pod := corev1.PodSpec{
+		Containers: []corev1.Container{container},
+		Volumes:    volumes,
+	}
+
+	if migration, ok := k.stack.migrations[id]; ok {
+		migrationSpec := k.stack.specs[migration]
+		initContainer, initSecret, initVolumes := k.container(&migrationSpec, secretName)
+		maps.Copy(secret, initSecret)
+		pod.InitContainers = []corev1.Container{initContainer}
+		pod.Volumes = append(pod.Volumes, initVolumes...)
+	}
+
+	objects := []any{}
+	if len(secret) > 0 {
+		objects = append(objects, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: k.objectMeta(secretName),
+			Type:       corev1.SecretTypeOpaque,
+			StringData: secret,
+		})
+	}
+
+	strategy := appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	for i := range spec.Volumes {
+		// a claim is mounted by one pod at a time
+		strategy.Type = appsv1.RecreateDeploymentStrategyType
+		objects = append(objects, &corev1.PersistentVolumeClaim{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+			ObjectMeta: k.objectMeta(kubernetesName(spec.Volumes[i].Name)),
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: k.storage},
+				},
+			},
+		})
+	}
+
+	meta := k.objectMeta(name)
+	objects = append(objects, &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: meta,
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.ToInt32(1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{kubernetesAppLabel: name}},
+			Strategy: strategy,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: meta.Labels},
+				Spec:       pod,
+			},
+		},
+	})
+
+	if len(container.Ports) > 0 {
+		service := &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: meta,
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{kubernetesAppLabel: name},
+			},
+		}
+		for _, it := range container.Ports {
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name:     it.Name,
+				Port:     it.ContainerPort,
+				Protocol: corev1.ProtocolTCP,
+			})
+		}
+		objects = append(objects, service)
+	}
+
+	return objects
+}
+
+// rule adds the host of the ingress to the hosts of the rule, all the rules of the stack match localhost
+func (k *kubernetesStack) rule(rule string) string {
+	rule = k.renamer.Replace(rule)
+	if k.host == "" {
+		return rule
+	}
+
+	return strings.ReplaceAll(rule, "Host(`localhost`)", fmt.Sprintf("(Host(`localhost`) || Host(`%s`))", k.host))
+}
+
+// traefikRoutes translates the traefik labels of the containers to a file provider configuration,
+// the routers of a container use its service, like with the docker provider
+func (k *kubernetesStack) traefikRoutes() *traefikDynamicConfig {
+	config := &traefikDynamicConfig{HTTP: traefikHTTPConfig{
+		Routers:     map[string]*traefikRouter{},
+		Services:    map[string]*traefikService{},
+		Middlewares: map[string]*traefikMiddleware{},
+	}}
+
+	for id := range k.stack.specs {
+		spec := k.stack.specs[id]
+		routers := map[string]*traefikRouter{}
+		services := []string{}
+
+		for key, value := range spec.Labels {
+			path := strings.Split(strings.TrimPrefix(key, traefikLabelPrefix), ".")
+			if !strings.HasPrefix(key, traefikLabelPrefix) || len(path) < 3 {
+				continue
+			}
+
+			kind, name, option := path[0], path[1], strings.Join(path[2:], ".")
+			switch kind {
+			case "routers":
+				router, ok := routers[name]
+				if !ok {
+					router = &traefikRouter{}
+					routers[name] = router
+				}
+
+				switch option {
+				case "rule":
+					router.Rule = k.rule(value)
+				case "entrypoints":
+					// TLS is terminated by the ingress in the cluster
+					router.EntryPoints = slices.DeleteFunc(strings.Split(value, ","), func(it string) bool { return it == traefikTLSEntrypoint })
+				case "middlewares":
+					router.Middlewares = strings.Split(value, ",")
+				case "service":
+					router.Service = value
+				}
+			case "services":
+				if option == "loadbalancer.server.port" {
+					url := fmt.Sprintf("http://%s:%s", kubernetesName(spec.Name), value)
+					config.HTTP.Services[name] = &traefikService{LoadBalancer: traefikLoadBalancer{Servers: []traefikServer{{URL: url}}}}
+					services = append(services, name)
+				}
+			case "middlewares":
+				if option == "stripprefix.prefixes" {
+					config.HTTP.Middlewares[name] = &traefikMiddleware{StripPrefix: &traefikStripPrefix{Prefixes: strings.Split(value, ",")}}
+				}
+			}
+		}
+
+		for name, router := range routers {
+			if router.Service == "" && len(services) == 1 {
+				router.Service = services[0]
+			}
+			config.HTTP.Routers[name] = router
+		}
+	}
+
+	return config
+}
+
+// traefikArgs replace the docker provider with the file provider of the routes, the TLS of the stack is left
+// to the ingress
+func traefikArgs(args []string) []string {
+	result := []string{}
+	for _, it := range args {
+		if !strings.HasPrefix(it, traefikDockerProvider) && !strings.HasPrefix(it, "--providers.file.") &&
+			!strings.HasPrefix(it, "--entrypoints."+traefikTLSEntrypoint+".") && !strings.HasPrefix(it, "--certificatesresolvers.") {
+			result = append(result, it)
+		}
+	}
+
+	return append(result, "--providers.file.directory="+traefikConfigDirectory, "--providers.file.watch=true")
+}
+
+// traefik is the router of the stack in the cluster, the ingress sends everything to it, so the rules
+// of the stack and the addresses of it used by the other services stay the same
+func (k *kubernetesStack) traefik(state *State, args *ArgsFlags) ([]any, error) {
+	routes, err := yaml.Marshal(k.traefikRoutes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the Traefik routes: %w", err)
+	}
+
+	spec := k.stack.specs[traefik]
+	name := kubernetesName(spec.Name)
+	configName := name + "-config"
+	files := map[string]string{traefikRoutesFile: string(routes)}
+	if traefikFileProvider(state, args) {
+		content, err := renderTraefikConfiguration(state.InternalHostDomain,
+			traefikTemplatePath(state.SettingsFile.TraefikConfigTemplate, args.SettingsFilePath),
+			state.SettingsFile.CruxHTTPPort, state.SettingsFile.CruxUIPort, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the Traefik configuration: %w", err)
+		}
+		files[traefikDynamicConfFile] = content
+	}
+
+	extraFiles, err := traefikExtraConfigFiles(traefikTemplatePath(state.SettingsFile.TraefikExtraConfigDir, args.SettingsFilePath))
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(files, extraFiles)
+
+	objects := []any{&corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: k.objectMeta(configName),
+		Data:       files,
+	}}
+
+	workload := k.workload(traefik)
+	for _, it := range workload {
+		deployment, ok := it.(*appsv1.Deployment)
+		if !ok {
+			continue
+		}
+
+		pod := &deployment.Spec.Template.Spec
+		pod.Containers[0].Args = traefikArgs(pod.Containers[0].Args)
+		pod.Containers[0].VolumeMounts = append(pod.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      configName,
+			MountPath: traefikConfigDirectory,
+			ReadOnly:  true,
+		})
+		pod.Volumes = append(pod.Volumes, corev1.Volume{
+			Name: configName,
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configName},
+			}},
+		})
+	}
+
+	return append(objects, workload...), nil
+}
+
+func (k *kubernetesStack) ingress() *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	service := kubernetesName(k.stack.specs[traefik].Name)
+
+	return &networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+		ObjectMeta: k.objectMeta(service),
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: k.host,
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: service,
+							Port: networkingv1.ServiceBackendPort{Number: defaultTraefikInternalPort},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+// stackManifests are the manifests of the stack the runner would create, in the order of its start
+func stackManifests(state *State, args *ArgsFlags) ([]any, error) {
+	if state.SettingsFile.externalProxy() {
+		return nil, fmt.Errorf("%w: the ingress of the cluster routes to traefik, %s is only supported on docker",
+			ErrInvalidProxySettings, ProxyExternal)
+	}
+
+	// the ports and the volumes of the containers are only set if they are not fully containerized,
+	// the services and the volume claims of the cluster are made from them
+	hostArgs := *args
+	hostArgs.FullyContainerized = false
+	args = &hostArgs
+
+	namespace := util.Fallback(args.KubeNamespace, args.Prefix)
+	specs, err := getStackSpecs(state, args)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := newKubernetesStack(specs, state.SettingsFile.TraefikWebPort, namespace, args.KubeHost,
+		util.Fallback(args.KubeStorage, defaultKubernetesStorage))
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []any{&corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}}
+	for _, id := range startOrder {
+		if _, ok := k.stack.specs[id]; !ok {
+			continue
+		}
+
+		if id != traefik {
+			objects = append(objects, k.workload(id)...)
+			continue
+		}
+
+		routing, err := k.traefik(state, args)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, routing...)
+	}
+
+	return append(objects, k.ingress()), nil
+}
+
+// writeKubernetesManifests writes the manifests as one file, it contains the secrets of the stack
+func writeKubernetesManifests(output string, objects []any) error {
+	var manifests bytes.Buffer
+	for _, it := range objects {
+		data, err := k8syaml.Marshal(it)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the manifests: %w", err)
+		}
+
+		manifests.WriteString("---\n")
+		manifests.Write(data)
+	}
+
+	output = util.Fallback(output, defaultKubernetesOutput)
+	if err := os.WriteFile(output, manifests.Bytes(), filePerms); err != nil {
+		return fmt.Errorf("failed to write the manifests: %w", err)
+	}
+
+	log.Info().Str("path", output).Int("objects", len(objects)).
+		Msg("Kubernetes manifests written, they contain the secrets of the stack")
+
+	return nil
+}
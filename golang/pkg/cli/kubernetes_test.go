@@ -0,0 +1,160 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func stackManifests(t *testing.T, args *cli.ArgsFlags) []any {
+	objects, err := cli.StackManifests(composeState(args), args)
+	assert.NoError(t, err)
+
+	return objects
+}
+
+func manifestOf[T any](objects []any, name string) *T {
+	for _, it := range objects {
+		object, ok := it.(*T)
+		if !ok {
+			continue
+		}
+
+		if named, ok := any(object).(interface{ GetName() string }); ok && named.GetName() == name {
+			return object
+		}
+	}
+
+	return nil
+}
+
+func envOf(container *corev1.Container, name string) *corev1.EnvVar {
+	for i := range container.Env {
+		if container.Env[i].Name == name {
+			return &container.Env[i]
+		}
+	}
+
+	return nil
+}
+
+func TestStackManifestsWorkloads(t *testing.T) {
+	objects := stackManifests(t, &cli.ArgsFlags{Prefix: "dyo-stable"})
+
+	namespace, ok := objects[0].(*corev1.Namespace)
+	assert.True(t, ok)
+	assert.Equal(t, "dyo-stable", namespace.Name)
+	_, ok = objects[len(objects)-1].(*networkingv1.Ingress)
+	assert.True(t, ok)
+
+	crux := manifestOf[appsv1.Deployment](objects, "dyo-stable-crux")
+	assert.NotNil(t, crux)
+	assert.Equal(t, "dyo-stable", crux.Namespace)
+	pod := crux.Spec.Template.Spec
+	assert.Equal(t, []string{"serve"}, pod.Containers[0].Args)
+	assert.Equal(t, "ghcr.io/dyrector-io/dyrectorio/web/crux:stable", pod.Containers[0].Image)
+	assert.Len(t, pod.InitContainers, 1)
+	assert.Equal(t, "dyo-stable-crux-migrate", pod.InitContainers[0].Name)
+	assert.Equal(t, []string{"migrate"}, pod.InitContainers[0].Args)
+
+	// the connection strings are secrets, with the service names of the cluster
+	databaseURL := envOf(&pod.Containers[0], "DATABASE_URL")
+	assert.Empty(t, databaseURL.Value)
+	assert.Equal(t, "dyo-stable-crux-env", databaseURL.ValueFrom.SecretKeyRef.Name)
+	secret := manifestOf[corev1.Secret](objects, "dyo-stable-crux-env")
+	assert.Contains(t, secret.StringData["DATABASE_URL"], "@dyo-stable-crux-postgres:5432/")
+	assert.Equal(t, "development", envOf(&pod.Containers[0], "NODE_ENV").Value)
+
+	service := manifestOf[corev1.Service](objects, "dyo-stable-crux")
+	assert.Equal(t, map[string]string{"app": "dyo-stable-crux"}, service.Spec.Selector)
+	assert.Len(t, service.Spec.Ports, 2)
+}
+
+func TestStackManifestsPostgres(t *testing.T) {
+	objects := stackManifests(t, &cli.ArgsFlags{Prefix: "dyo-stable", KubeStorage: "5Gi"})
+
+	claim := manifestOf[corev1.PersistentVolumeClaim](objects, "dyo-stable-crux-postgres-data")
+	assert.NotNil(t, claim)
+	assert.Equal(t, "5Gi", claim.Spec.Resources.Requests.Storage().String())
+
+	postgres := manifestOf[appsv1.Deployment](objects, "dyo-stable-crux-postgres")
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, postgres.Spec.Strategy.Type)
+	volumes := postgres.Spec.Template.Spec.Volumes
+	assert.Equal(t, "dyo-stable-crux-postgres-data", volumes[len(volumes)-1].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, int32(5432), manifestOf[corev1.Service](objects, "dyo-stable-crux-postgres").Spec.Ports[0].Port)
+}
+
+func TestStackManifestsTraefik(t *testing.T) {
+	objects := stackManifests(t, &cli.ArgsFlags{Prefix: "dyo-stable", KubeHost: "dyo.example.com"})
+
+	traefik := manifestOf[appsv1.Deployment](objects, "dyo-stable-traefik")
+	container := traefik.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, container.Args, "--providers.file.directory=/etc/traefik")
+	for _, it := range container.Args {
+		assert.False(t, strings.HasPrefix(it, "--providers.docker"), it)
+	}
+	for _, it := range container.VolumeMounts {
+		assert.NotEqual(t, "/var/run/docker.sock", it.MountPath)
+	}
+
+	routes := manifestOf[corev1.ConfigMap](objects, "dyo-stable-traefik-config").Data["routes.yml"]
+	assert.Contains(t, routes, "url: http://dyo-stable-crux:1848")
+	assert.Contains(t, routes, "Host(`dyo.example.com`)")
+	assert.Contains(t, routes, "kratos-strip")
+
+	// the address of the UI is the one of the ingress
+	ui := manifestOf[appsv1.Deployment](objects, "dyo-stable-crux-ui")
+	assert.Equal(t, "http://dyo.example.com", envOf(&ui.Spec.Template.Spec.Containers[0], "CRUX_UI_URL").Value)
+
+	ingress := manifestOf[networkingv1.Ingress](objects, "dyo-stable-traefik")
+	assert.Equal(t, "dyo.example.com", ingress.Spec.Rules[0].Host)
+	backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+	assert.Equal(t, "dyo-stable-traefik", backend.Name)
+	assert.Equal(t, int32(8000), backend.Port.Number)
+}
+
+func TestStackManifestsTraefikExtraConfig(t *testing.T) {
+	args := &cli.ArgsFlags{Prefix: "dyo-stable"}
+	state := composeState(args)
+	state.SettingsFile.TraefikExtraConfigDir = writeExtraConfig(t, map[string]string{"auth.yml": "http: {}\n"})
+
+	objects, err := cli.StackManifests(state, args)
+	assert.NoError(t, err)
+	assert.Equal(t, "http: {}\n", manifestOf[corev1.ConfigMap](objects, "dyo-stable-traefik-config").Data["auth.yml"])
+}
+
+func TestStackManifestsInvalidStorage(t *testing.T) {
+	args := &cli.ArgsFlags{Prefix: "dyo-stable", KubeStorage: "a lot"}
+	_, err := cli.StackManifests(composeState(args), args)
+	assert.ErrorContains(t, err, "invalid storage size")
+}
+
+func TestWriteKubernetesManifests(t *testing.T) {
+	args := &cli.ArgsFlags{Prefix: "dyo-stable", KubeNamespace: "dyrectorio"}
+	objects := stackManifests(t, args)
+	output := filepath.Join(t.TempDir(), "dyo.yaml")
+	assert.NoError(t, cli.WriteKubernetesManifests(output, objects))
+
+	info, err := os.Stat(output)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	documents := strings.Split(strings.TrimPrefix(string(data), "---\n"), "\n---\n")
+	assert.Len(t, documents, len(objects))
+	assert.Contains(t, documents[0], "kind: Namespace")
+	assert.Contains(t, documents[0], "name: dyrectorio")
+	assert.Contains(t, documents[len(documents)-1], "kind: Ingress")
+}
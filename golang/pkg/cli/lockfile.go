@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/docker/distribution/reference"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"gopkg.in/yaml.v3"
+)
+
+// LockFileName is stored next to the settings file, the stacks of other prefixes have their own, eg. dyo-lock.dyo-feature.yaml
+const LockFileName = "dyo-lock.yaml"
+
+var ErrImageNotLocked = errors.New("image is missing from the lockfile, run `dyo lock` again")
+
+// LockFile pins the images of the stack to digests
+type LockFile struct {
+	// image references with tags mapped to their digest pinned references
+	Images  map[string]string `yaml:"images"`
+	Version string            `yaml:"version"`
+}
+
+func lockFilePath(args *ArgsFlags) string {
+	return path.Join(path.Dir(args.SettingsFilePath), prefixedFileName(LockFileName, args.Prefix))
+}
+
+// resolveLockFile looks up the current digest of every image of the stack
+func resolveLockFile(state *State, args *ArgsFlags) (*LockFile, error) {
+	lock := &LockFile{
+		Version: state.SettingsFile.Version,
+		Images:  map[string]string{},
+	}
+
+	for _, image := range stackImages(state, args) {
+		digest, err := crane.Digest(image, crane.WithAuthFromKeychain(authn.DefaultKeychain))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the digest of %s: %w", image, err)
+		}
+
+		pinned, err := pinDigest(image, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		lock.Images[image] = pinned
+	}
+
+	return lock, nil
+}
+
+// pinDigest drops the tag, docker ignores it anyway when a digest is present
+func pinDigest(image, digest string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %s: %w", image, err)
+	}
+
+	return fmt.Sprintf("%s@%s", reference.TrimNamed(named).String(), digest), nil
+}
+
+func writeLockFile(lockPath string, lock *LockFile) error {
+	data, err := yaml.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("failed to marshal lockfile: %w", err)
+	}
+
+	if err := os.WriteFile(lockPath, data, filePerms); err != nil {
+		return fmt.Errorf("failed to write lockfile: %w", err)
+	}
+
+	return nil
+}
+
+func readLockFile(lockPath string) (*LockFile, error) {
+	data, err := os.ReadFile(lockPath) //#nosec G304 -- the path is derived from the settings path
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lockfile: %w", err)
+	}
+
+	lock := &LockFile{}
+	if err := yaml.Unmarshal(data, lock); err != nil {
+		return nil, fmt.Errorf("failed to parse lockfile: %w", err)
+	}
+
+	return lock, nil
+}
+
+// verify makes sure every image used by the stack is pinned, for the same version
+func (l *LockFile) verify(images []string, version string) error {
+	if l.Version != version {
+		return fmt.Errorf("lockfile was created for version %s instead of %s, run `dyo lock` again", l.Version, version)
+	}
+
+	for _, image := range images {
+		if _, ok := l.Images[image]; !ok {
+			return fmt.Errorf("%w: %s", ErrImageNotLocked, image)
+		}
+	}
+
+	return nil
+}
+
+// image returns the reference of an image redirected by the rewrite rules, or its locked reference in --locked mode
+func (s *State) image(ref string) string {
+	ref = s.SettingsFile.ImageRewrite.Rewrite(ref)
+	if pinned, ok := s.LockedImages[ref]; ok {
+		return pinned
+	}
+
+	return ref
+}
@@ -0,0 +1,53 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/mirror"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+const testDigest = "sha256:2d9aa1e3f0a3f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7081920"
+
+func TestPinDigest(t *testing.T) {
+	pinned, err := cli.PinDigest("ghcr.io/dyrector-io/dyrectorio/web/crux:stable", testDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, "ghcr.io/dyrector-io/dyrectorio/web/crux@"+testDigest, pinned)
+
+	pinned, err = cli.PinDigest("traefik:v2.9", testDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, "docker.io/library/traefik@"+testDigest, pinned)
+}
+
+func TestVerifyLockFile(t *testing.T) {
+	lock := &cli.LockFile{
+		Version: "stable",
+		Images: map[string]string{
+			"docker.io/library/postgres:13-alpine": "docker.io/library/postgres@" + testDigest,
+		},
+	}
+
+	assert.NoError(t, cli.VerifyLockFile(lock, []string{"docker.io/library/postgres:13-alpine"}, "stable"))
+	assert.Error(t, cli.VerifyLockFile(lock, []string{"docker.io/library/postgres:13-alpine"}, "latest"))
+	assert.ErrorIs(t, cli.VerifyLockFile(lock, []string{"docker.io/library/traefik:v2.9"}, "stable"), cli.ErrImageNotLocked)
+}
+
+func TestStateImageRewritesBeforeLocking(t *testing.T) {
+	state := &cli.State{
+		LockedImages: map[string]string{
+			"mirror.local/dyo/dyrectorio/web/crux:stable": "mirror.local/dyo/dyrectorio/web/crux@" + testDigest,
+		},
+	}
+	state.SettingsFile.ImageRewrite = mirror.Rules{{Match: "ghcr.io/dyrector-io", Replace: "mirror.local/dyo"}}
+
+	assert.Equal(t, "mirror.local/dyo/dyrectorio/web/crux@"+testDigest,
+		cli.StateImage(state, "ghcr.io/dyrector-io/dyrectorio/web/crux:stable"))
+	assert.Equal(t, "mirror.local/dyo/dyrectorio/web/crux-ui:stable",
+		cli.StateImage(state, "ghcr.io/dyrector-io/dyrectorio/web/crux-ui:stable"))
+	assert.Equal(t, "traefik:v2.9", cli.StateImage(state, "traefik:v2.9"))
+}
@@ -0,0 +1,318 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+)
+
+const (
+	LogsCommand = "logs"
+)
+
+const (
+	FlagLogsFollow     = "follow"
+	FlagLogsTail       = "tail"
+	FlagLogsTimestamps = "timestamps"
+)
+
+// logsPostgresAlias selects both of the databases of the stack
+const logsPostgresAlias = "postgres"
+
+var ErrUnknownStackContainer = errors.New("unknown container of the stack")
+
+// logContainer is a container of the stack, named without the prefix of the stack
+type logContainer struct {
+	Name string
+	ID   string
+}
+
+// logLine is a line of a container log, Time is parsed from the timestamp docker puts in front of the line
+type logLine struct {
+	Time      time.Time
+	Container string
+	Text      string
+}
+
+type logsOptions struct {
+	Tail       uint
+	Follow     bool
+	Timestamps bool
+}
+
+func GetLogsCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:      LogsCommand,
+		Usage:     "Show the logs of the containers of the stack, interleaved and prefixed by the name of the container",
+		ArgsUsage: "[container...]",
+		Description: "Containers are named without the prefix of the stack, eg. crux, crux-ui, kratos, traefik or crux-postgres, " +
+			"postgres selects both of the databases. Every container of the stack is shown if none is given.",
+		Flags: []ucli.Flag{
+			&ucli.BoolFlag{
+				Name:    FlagLogsFollow,
+				Aliases: []string{"f"},
+				Value:   false,
+				Usage:   "keep streaming the new lines until interrupted",
+			},
+			&ucli.UintFlag{
+				Name:    FlagLogsTail,
+				Aliases: []string{"n"},
+				Value:   0,
+				Usage:   "number of lines shown from the end of the logs per container, 0 shows every line",
+			},
+			&ucli.BoolFlag{
+				Name:    FlagLogsTimestamps,
+				Aliases: []string{"t"},
+				Value:   false,
+				Usage:   "show the timestamps of the lines",
+			},
+		},
+		Action: logs,
+	}
+}
+
+func logs(cCtx *ucli.Context) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(cCtx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	prefix := cCtx.String(FlagPrefix)
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(prefix))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the containers of the stack: %w", err)
+	}
+
+	selected, err := selectLogContainers(containers, prefix, cCtx.Args().Slice())
+	if err != nil {
+		return err
+	}
+
+	opts := &logsOptions{
+		Tail:       cCtx.Uint(FlagLogsTail),
+		Follow:     cCtx.Bool(FlagLogsFollow),
+		Timestamps: cCtx.Bool(FlagLogsTimestamps),
+	}
+	if opts.Follow {
+		return followStackLogs(ctx, cli, selected, opts, os.Stdout)
+	}
+
+	return writeStackLogs(ctx, cli, selected, opts, os.Stdout)
+}
+
+// selectLogContainers picks the containers by their names without the prefix in name order,
+// every container of the stack if there are no names
+func selectLogContainers(containers []types.Container, prefix string, names []string) ([]logContainer, error) {
+	byName := map[string]string{}
+	for i := range containers {
+		name := strings.TrimPrefix(strings.TrimPrefix(containers[i].Names[0], "/"), prefix+"_")
+		byName[name] = containers[i].ID
+	}
+
+	available := make([]string, 0, len(byName))
+	for name := range byName {
+		available = append(available, name)
+	}
+	sort.Strings(available)
+
+	if len(names) == 0 {
+		names = available
+	}
+
+	selected := map[string]bool{}
+	for _, name := range names {
+		if name == logsPostgresAlias {
+			for _, it := range available {
+				if strings.HasSuffix(it, "-"+logsPostgresAlias) {
+					selected[it] = true
+				}
+			}
+			continue
+		}
+
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("%w: %s, the containers are: %s", ErrUnknownStackContainer, name, strings.Join(available, ", "))
+		}
+		selected[name] = true
+	}
+
+	result := []logContainer{}
+	for _, name := range available {
+		if selected[name] {
+			result = append(result, logContainer{Name: name, ID: byName[name]})
+		}
+	}
+
+	return result, nil
+}
+
+// logLineWriter splits the demultiplexed log stream into lines, a line is passed on when it is complete
+type logLineWriter struct {
+	line    func(string)
+	partial bytes.Buffer
+}
+
+func (w *logLineWriter) Write(p []byte) (int, error) {
+	w.partial.Write(p)
+	for {
+		data := w.partial.Bytes()
+		end := bytes.IndexByte(data, '\n')
+		if end < 0 {
+			return len(p), nil
+		}
+
+		w.line(strings.TrimSuffix(string(data[:end]), "\r"))
+		w.partial.Next(end + 1)
+	}
+}
+
+// flush passes on the last line, which has no line break
+func (w *logLineWriter) flush() {
+	if w.partial.Len() > 0 {
+		w.line(w.partial.String())
+		w.partial.Reset()
+	}
+}
+
+func streamContainerLogs(ctx context.Context, cli client.APIClient, id string, opts *logsOptions, line func(string)) error {
+	tail := "all"
+	if opts.Tail > 0 {
+		tail = strconv.FormatUint(uint64(opts.Tail), 10)
+	}
+
+	reader, err := cli.ContainerLogs(ctx, id, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+		Timestamps: true,
+		Tail:       tail,
+	})
+	if err != nil {
+		return err
+	}
+	defer logdefer.LogDeferredErr(reader.Close, log.Warn(), "error closing container log reader")
+
+	writer := &logLineWriter{line: line}
+	_, err = stdcopy.StdCopy(writer, writer, reader)
+	writer.flush()
+
+	return err
+}
+
+// parseLogLine splits the timestamp docker puts in front of the line
+func parseLogLine(containerName, text string) logLine {
+	line := logLine{Container: containerName, Text: text}
+
+	stamp, rest, found := strings.Cut(text, " ")
+	if !found {
+		return line
+	}
+	if t, err := time.Parse(time.RFC3339Nano, stamp); err == nil {
+		line.Time = t
+		line.Text = rest
+	}
+
+	return line
+}
+
+// formatLogLine aligns the lines of the containers like compose does, the width is of the longest container name
+func formatLogLine(line *logLine, width int, timestamps bool) string {
+	if timestamps && !line.Time.IsZero() {
+		return fmt.Sprintf("%-*s | %s %s\n", width, line.Container, line.Time.Format(time.RFC3339Nano), line.Text)
+	}
+
+	return fmt.Sprintf("%-*s | %s\n", width, line.Container, line.Text)
+}
+
+func logNameWidth(containers []logContainer) int {
+	width := 0
+	for _, it := range containers {
+		width = max(width, len(it.Name))
+	}
+
+	return width
+}
+
+// writeStackLogs reads the logs of the containers, then writes them interleaved by their timestamps
+func writeStackLogs(ctx context.Context, cli client.APIClient, containers []logContainer, opts *logsOptions, w io.Writer) error {
+	lines := []logLine{}
+	for _, it := range containers {
+		err := streamContainerLogs(ctx, cli, it.ID, opts, func(text string) {
+			lines = append(lines, parseLogLine(it.Name, text))
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read the logs of %s: %w", it.Name, err)
+		}
+	}
+
+	return writeLogLines(w, lines, logNameWidth(containers), opts.Timestamps)
+}
+
+func writeLogLines(w io.Writer, lines []logLine, width int, timestamps bool) error {
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].Time.Before(lines[j].Time) })
+
+	for i := range lines {
+		if _, err := io.WriteString(w, formatLogLine(&lines[i], width, timestamps)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// followStackLogs streams the logs of the containers concurrently, the lines are written as they arrive
+func followStackLogs(ctx context.Context, cli client.APIClient, containers []logContainer, opts *logsOptions, w io.Writer) error {
+	width := logNameWidth(containers)
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for _, it := range containers {
+		wg.Add(1)
+		go func(it logContainer) {
+			defer wg.Done()
+
+			err := streamContainerLogs(ctx, cli, it.ID, opts, func(text string) {
+				line := parseLogLine(it.Name, text)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				if _, err := io.WriteString(w, formatLogLine(&line, width, opts.Timestamps)); err != nil {
+					log.Warn().Err(err).Msg("Failed to write the logs")
+				}
+			})
+			if err != nil && ctx.Err() == nil {
+				log.Warn().Err(err).Str("container", it.Name).Msg("Failed to stream the logs of the container")
+			}
+		}(it)
+	}
+	wg.Wait()
+
+	return nil
+}
@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func testStackContainers() []types.Container {
+	return []types.Container{
+		{ID: "1", Names: []string{"/dyo-stable_crux"}},
+		{ID: "2", Names: []string{"/dyo-stable_crux-ui"}},
+		{ID: "3", Names: []string{"/dyo-stable_crux-postgres"}},
+		{ID: "4", Names: []string{"/dyo-stable_kratos-postgres"}},
+		{ID: "5", Names: []string{"/dyo-stable_traefik"}},
+	}
+}
+
+func TestSelectLogContainers(t *testing.T) {
+	selected, err := cli.SelectLogContainers(testStackContainers(), "dyo-stable", []string{"traefik", "crux"})
+	assert.NoError(t, err)
+	assert.Equal(t, []cli.LogContainer{{Name: "crux", ID: "1"}, {Name: "traefik", ID: "5"}}, selected)
+
+	selected, err = cli.SelectLogContainers(testStackContainers(), "dyo-stable", []string{"postgres"})
+	assert.NoError(t, err)
+	assert.Equal(t, []cli.LogContainer{{Name: "crux-postgres", ID: "3"}, {Name: "kratos-postgres", ID: "4"}}, selected)
+
+	selected, err = cli.SelectLogContainers(testStackContainers(), "dyo-stable", nil)
+	assert.NoError(t, err)
+	assert.Len(t, selected, 5)
+}
+
+func TestSelectLogContainersUnknown(t *testing.T) {
+	_, err := cli.SelectLogContainers(testStackContainers(), "dyo-stable", []string{"kratos"})
+	assert.ErrorIs(t, err, cli.ErrUnknownStackContainer)
+	assert.ErrorContains(t, err, "crux, crux-postgres, crux-ui, kratos-postgres, traefik")
+}
+
+func TestSplitLogLines(t *testing.T) {
+	assert.Equal(t, []string{"first", "second", "third"}, cli.SplitLogLines("fir", "st\nsecond\r\nth", "ird"))
+	assert.Empty(t, cli.SplitLogLines())
+}
+
+func TestParseLogLine(t *testing.T) {
+	line := cli.ParseLogLine("crux", "2024-05-01T10:00:00.123456789Z Listening on 1848")
+	assert.Equal(t, "Listening on 1848", line.Text)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 123456789, time.UTC), line.Time.UTC())
+
+	line = cli.ParseLogLine("crux", "no timestamp")
+	assert.Equal(t, "no timestamp", line.Text)
+	assert.True(t, line.Time.IsZero())
+}
+
+func TestWriteLogLinesInterleaved(t *testing.T) {
+	lines := []cli.LogLine{
+		cli.ParseLogLine("crux", "2024-05-01T10:00:02Z third"),
+		cli.ParseLogLine("crux", "2024-05-01T10:00:00Z first"),
+		cli.ParseLogLine("traefik", "2024-05-01T10:00:01Z second"),
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, cli.WriteLogLines(&out, lines, 7, false))
+	assert.Equal(t, "crux    | first\ntraefik | second\ncrux    | third\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, cli.WriteLogLines(&out, []cli.LogLine{cli.ParseLogLine("crux", "2024-05-01T10:00:02Z third")}, 4, true))
+	assert.Equal(t, "crux | 2024-05-01T10:00:02Z third\n", out.String())
+}
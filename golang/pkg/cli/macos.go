@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+// Docker Desktop runs the engine inside a VM, the socket is forwarded to this path inside the VM
+// regardless of what DOCKER_HOST points to on the host
+const dockerDesktopSocket = "/var/run/docker.sock"
+
+// dockerDesktopSharedPaths are the host directories Docker Desktop shares with its VM by default
+var dockerDesktopSharedPaths = []string{"/Users", "/Volumes", "/private", "/tmp", "/var/folders"}
+
+// unsharedMounts returns the bind mount sources which are not under any of the shared paths
+func unsharedMounts(mounts []mount.Mount, sharedPaths []string) []string {
+	unshared := []string{}
+
+	for i := range mounts {
+		if mounts[i].Type != mount.TypeBind || mounts[i].Source == dockerDesktopSocket {
+			continue
+		}
+
+		source := filepath.Clean(mounts[i].Source)
+		shared := false
+		for _, sharedPath := range sharedPaths {
+			if source == sharedPath || strings.HasPrefix(source, sharedPath+"/") {
+				shared = true
+				break
+			}
+		}
+
+		if !shared {
+			unshared = append(unshared, mounts[i].Source)
+		}
+	}
+
+	return unshared
+}
+
+// warnUnsharedMounts is a pre-create hook, Docker Desktop silently mounts an empty directory
+// in place of a path that is not shared with the VM
+func warnUnsharedMounts(_ context.Context, _ client.APIClient, cont containerbuilder.ParentContainer) error {
+	for _, source := range unsharedMounts(cont.MountList, dockerDesktopSharedPaths) {
+		log.Warn().Str("container", cont.Name).Str("path", source).
+			Msg("Path is not shared with Docker Desktop, add it under Settings > Resources > File sharing")
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestUnsharedMounts(t *testing.T) {
+	mounts := []mount.Mount{
+		{Type: mount.TypeBind, Source: "/var/run/docker.sock", Target: "/var/run/docker.sock"},
+		{Type: mount.TypeBind, Source: "/Users/dev/config", Target: "/config"},
+		{Type: mount.TypeBind, Source: "/Users", Target: "/users"},
+		{Type: mount.TypeBind, Source: "/UsersData", Target: "/data"},
+		{Type: mount.TypeBind, Source: "/etc/localtime", Target: "/etc/localtime"},
+		{Type: mount.TypeVolume, Source: "postgres-data", Target: "/var/lib/postgresql/data"},
+	}
+
+	assert.Equal(t, []string{"/UsersData", "/etc/localtime"}, cli.UnsharedMounts(mounts, []string{"/Users", "/tmp"}))
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+const (
+	migrationMaxAttempts     = 5
+	migrationInitialBackoff  = 2 * time.Second
+	migrationLogTailLines    = 20
+	migrationBackoffMultiple = 2
+)
+
+// log fragments of prisma, kratos and postgres meaning the database is not (yet) accepting connections,
+// anything else is considered a schema or configuration failure which a retry won't fix
+var retryableMigrationErrors = []string{
+	"connection refused",
+	"could not connect",
+	"can't reach database server",
+	"the database system is starting up",
+	"the database system is not yet accepting connections",
+	"no such host",
+	"i/o timeout",
+	"p1001",
+}
+
+// MigrationError is the failure of a migration container with its cause, the last lines of its logs
+// tell what went wrong if it exited by itself, the signal or the OOM kill if it was killed
+type MigrationError struct {
+	Container string
+	Signal    string
+	Logs      []string
+	ExitCode  int64
+	OOMKilled bool
+	Retryable bool
+}
+
+// Cause is the short reason of the failure
+func (e *MigrationError) Cause() string {
+	switch {
+	case e.OOMKilled:
+		return "killed for running out of memory"
+	case e.Signal != "":
+		return "killed by " + e.Signal
+	case e.Retryable:
+		return "database was not ready"
+	default:
+		return "schema migration failed"
+	}
+}
+
+func (e *MigrationError) Error() string {
+	return fmt.Sprintf("migration container %s exited with code %d (%s): %s",
+		e.Container, e.ExitCode, e.Cause(), strings.Join(e.Logs, "\n"))
+}
+
+// tailLogLines splits the log chunks into lines and keeps the last n non-empty ones
+func tailLogLines(logs []string, n int) []string {
+	lines := []string{}
+	for _, chunk := range logs {
+		for _, line := range strings.Split(chunk, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	if len(lines) > n {
+		return lines[len(lines)-n:]
+	}
+
+	return lines
+}
+
+func isRetryableMigrationFailure(logs []string) bool {
+	for _, line := range logs {
+		lower := strings.ToLower(line)
+		for _, fragment := range retryableMigrationErrors {
+			if strings.Contains(lower, fragment) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func newMigrationError(res *containerbuilder.RunResult) *MigrationError {
+	logs := tailLogLines(res.Lines(), migrationLogTailLines)
+
+	return &MigrationError{
+		Container: res.Name,
+		ExitCode:  res.ExitCode,
+		Signal:    res.Signal(),
+		OOMKilled: res.OOMKilled,
+		Logs:      logs,
+		// a killed container is not waiting for the database, running it again ends the same way
+		Retryable: !res.OOMKilled && res.Signal() == "" && isRetryableMigrationFailure(logs),
+	}
+}
+
+// runMigration runs the migration container until it exits, retrying with an exponential backoff
+// while the database is not ready
+func runMigration(ctx context.Context, migration containerbuilder.Builder) error {
+	backoff := migrationInitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		res, err := migration.RunAndCapture(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to run migration: %w", err)
+		}
+
+		if res.ExitCode == 0 {
+			log.Info().Str("initContainer", res.Name).Int("attempt", attempt).Msg("Migration finished")
+			return nil
+		}
+
+		migrationErr := newMigrationError(res)
+
+		if !migrationErr.Retryable || attempt == migrationMaxAttempts {
+			return migrationErr
+		}
+
+		log.Warn().Str("initContainer", res.Name).Int("attempt", attempt).Dur("backoff", backoff).
+			Msg("Database is not ready, retrying migration")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= migrationBackoffMultiple
+	}
+}
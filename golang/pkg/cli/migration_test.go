@@ -0,0 +1,65 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestTailLogLinesSplitsChunks(t *testing.T) {
+	logs := []string{"first\nsecond\n", "", "third\n\nfourth"}
+
+	assert.Equal(t, []string{"third", "fourth"}, cli.TailLogLines(logs, 2))
+	assert.Equal(t, []string{"first", "second", "third", "fourth"}, cli.TailLogLines(logs, 10))
+}
+
+func TestIsRetryableMigrationFailure(t *testing.T) {
+	assert.True(t, cli.IsRetryableMigrationFailure([]string{
+		"Error: P1001: Can't reach database server at `dyo-crux-postgres`:`5432`",
+	}))
+	assert.True(t, cli.IsRetryableMigrationFailure([]string{
+		"FATAL: the database system is starting up",
+	}))
+	assert.True(t, cli.IsRetryableMigrationFailure([]string{
+		"dial tcp 172.18.0.2:5432: connect: connection refused",
+	}))
+	assert.False(t, cli.IsRetryableMigrationFailure([]string{
+		"Error: P3009: migrate found failed migrations in the target database",
+	}))
+	assert.False(t, cli.IsRetryableMigrationFailure(nil))
+}
+
+func TestMigrationErrorCause(t *testing.T) {
+	notReady := cli.NewMigrationError(&containerbuilder.RunResult{
+		Name: "crux-migrate", ExitCode: 1, Stderr: "Error: P1001: Can't reach database server\n",
+	})
+	assert.True(t, notReady.Retryable)
+	assert.Equal(t, "database was not ready", notReady.Cause())
+
+	failed := cli.NewMigrationError(&containerbuilder.RunResult{
+		Name: "crux-migrate", ExitCode: 1, Stderr: "Error: P3009: migrate found failed migrations\n",
+	})
+	assert.False(t, failed.Retryable)
+	assert.Equal(t, "schema migration failed", failed.Cause())
+
+	killed := cli.NewMigrationError(&containerbuilder.RunResult{
+		Name: "crux-migrate", ExitCode: 143, Stderr: "Error: P1001: Can't reach database server\n",
+	})
+	assert.False(t, killed.Retryable)
+	assert.Equal(t, "killed by SIGTERM", killed.Cause())
+
+	oom := cli.NewMigrationError(&containerbuilder.RunResult{Name: "kratos-migrate", ExitCode: 137, OOMKilled: true})
+	assert.False(t, oom.Retryable)
+	assert.Equal(t, "killed for running out of memory", oom.Cause())
+	assert.EqualError(t, oom, "migration container kratos-migrate exited with code 137 (killed for running out of memory): ")
+
+	var migrationErr *cli.MigrationError
+	assert.ErrorAs(t, fmt.Errorf("failed to start: %w", oom), &migrationErr)
+}
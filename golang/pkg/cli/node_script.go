@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+const (
+	NodeCommand = "node"
+)
+
+const (
+	FlagNodeType             = "type"
+	FlagNodeTarget           = "target"
+	FlagNodeToken            = "token"
+	FlagNodeName             = "name"
+	FlagNodeWorkloadName     = "workload-name"
+	FlagNodeRootPath         = "root-path"
+	FlagNodeTraefikAcmeEmail = "traefik-acme-email"
+	FlagNodeAgentTag         = "agent-tag"
+	FlagNodeNetwork          = "network-name"
+	FlagNodeDebug            = "debug-agent"
+	FlagNodeDisablePull      = "disable-pull"
+	FlagNodeOutput           = "output"
+)
+
+// node types and script targets of dyo node script
+const (
+	NodeTypeDagent = "dagent"
+	NodeTypeCrane  = "crane"
+
+	NodeTargetShell      = "shell"
+	NodeTargetPowerShell = "powershell"
+	NodeTargetCompose    = "compose"
+	NodeTargetManifest   = "manifest"
+)
+
+const (
+	defaultAgentTag = "stable"
+	// defaultPersistencePath is where the install script keeps the data of dagent on Linux when it runs as root
+	defaultPersistencePath = "/srv/dyrectorio-persistence"
+)
+
+var (
+	ErrUnknownNodeScript = errors.New("unknown node script")
+	ErrInvalidNodeScript = errors.New("invalid node script parameter")
+)
+
+var (
+	// the token is a signed JWT, its characters never need quoting
+	nodeTokenPattern = regexp.MustCompile(`^[A-Za-z0-9_=.-]+$`)
+	// container, k8s workload and network names
+	nodeIdentifierPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	// characters breaking out of the quoted values of the scripts
+	nodeUnsafeChars = "'\"`$\n\r"
+)
+
+//go:embed node-script/*.tmpl
+var nodeScriptTmpl embed.FS
+
+// nodeScriptData are the parameters of the install scripts, the same ones Crux renders its scripts with
+type nodeScriptData struct {
+	Name             string
+	Token            string
+	NetworkName      string
+	WorkloadName     string
+	RootPath         string
+	TraefikAcmeEmail string
+	AgentImageTag    string
+	DebugMode        bool
+	DisableForcePull bool
+}
+
+// PersistencePath is the data folder of the compose file, which has no platform detection like the scripts
+func (d *nodeScriptData) PersistencePath() string {
+	if d.RootPath != "" {
+		return d.RootPath
+	}
+
+	return defaultPersistencePath
+}
+
+func GetNodeCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:   NodeCommand,
+		Action: ucli.ShowSubcommandHelp,
+		Usage:  "dyo node script",
+		Subcommands: []*ucli.Command{{
+			Name: "script",
+			Usage: "Print the install script of an agent, the same one Crux generates, " +
+				"so nodes can be prepared offline or by configuration management",
+			Description: "Targets: shell, powershell and compose for dagent, shell and manifest for crane. " +
+				"The token is the one of the node, generated by Crux.",
+			Flags: []ucli.Flag{
+				&ucli.StringFlag{
+					Name:     FlagNodeType,
+					Usage:    "type of the agent, dagent or crane",
+					Required: true,
+				},
+				&ucli.StringFlag{
+					Name:  FlagNodeTarget,
+					Value: NodeTargetShell,
+					Usage: "form of the script, shell, powershell, compose or manifest",
+				},
+				&ucli.StringFlag{
+					Name:     FlagNodeToken,
+					Usage:    "token of the node",
+					EnvVars:  []string{"DYO_NODE_TOKEN"},
+					Required: true,
+				},
+				&ucli.StringFlag{
+					Name:     FlagNodeName,
+					Usage:    "name of the node",
+					Required: true,
+				},
+				&ucli.StringFlag{
+					Name:  FlagNodeWorkloadName,
+					Usage: "name of the agent container or deployment, defaults to the type of the agent",
+				},
+				&ucli.StringFlag{
+					Name:  FlagNodeRootPath,
+					Usage: "data folder of dagent, it is detected by the platform if empty",
+				},
+				&ucli.StringFlag{
+					Name:  FlagNodeTraefikAcmeEmail,
+					Usage: "enables the traefik of dagent with TLS certificates requested for this e-mail",
+				},
+				&ucli.StringFlag{
+					Name:  FlagNodeAgentTag,
+					Value: defaultAgentTag,
+					Usage: "image tag of the agent",
+				},
+				&ucli.StringFlag{
+					Name:  FlagNodeNetwork,
+					Usage: "network of dagent, for agents next to a local stack",
+				},
+				&ucli.BoolFlag{
+					Name:  FlagNodeDebug,
+					Usage: "enable the debug logs of the agent",
+				},
+				&ucli.BoolFlag{
+					Name:  FlagNodeDisablePull,
+					Usage: "use the local image of the agent instead of pulling it",
+				},
+				&ucli.StringFlag{
+					Name:    FlagNodeOutput,
+					Aliases: []string{"o"},
+					Usage:   "file the script is written to, the standard output by default",
+				},
+			},
+			Action: nodeScript,
+		}},
+	}
+}
+
+func nodeScript(cCtx *ucli.Context) error {
+	nodeType := cCtx.String(FlagNodeType)
+	data := &nodeScriptData{
+		Name:             strings.Join(strings.Fields(strings.ToLower(cCtx.String(FlagNodeName))), ""),
+		Token:            cCtx.String(FlagNodeToken),
+		NetworkName:      cCtx.String(FlagNodeNetwork),
+		WorkloadName:     cCtx.String(FlagNodeWorkloadName),
+		RootPath:         cCtx.String(FlagNodeRootPath),
+		TraefikAcmeEmail: cCtx.String(FlagNodeTraefikAcmeEmail),
+		AgentImageTag:    cCtx.String(FlagNodeAgentTag),
+		DebugMode:        cCtx.Bool(FlagNodeDebug),
+		DisableForcePull: cCtx.Bool(FlagNodeDisablePull),
+	}
+	if data.WorkloadName == "" {
+		data.WorkloadName = nodeType
+	}
+
+	script, err := renderNodeScript(nodeType, cCtx.String(FlagNodeTarget), data)
+	if err != nil {
+		return err
+	}
+
+	if output := cCtx.String(FlagNodeOutput); output != "" {
+		return os.WriteFile(output, script, filePerms)
+	}
+
+	_, err = os.Stdout.Write(script)
+	return err
+}
+
+func (d *nodeScriptData) validate() error {
+	if !nodeTokenPattern.MatchString(d.Token) {
+		return fmt.Errorf("%w: the token is not a JWT", ErrInvalidNodeScript)
+	}
+
+	for name, value := range map[string]string{"name": d.Name, "workload name": d.WorkloadName} {
+		if !nodeIdentifierPattern.MatchString(value) {
+			return fmt.Errorf("%w: %s %q can only have letters, digits, '_', '.' and '-'", ErrInvalidNodeScript, name, value)
+		}
+	}
+	if d.NetworkName != "" && !nodeIdentifierPattern.MatchString(d.NetworkName) {
+		return fmt.Errorf("%w: network name %q can only have letters, digits, '_', '.' and '-'", ErrInvalidNodeScript, d.NetworkName)
+	}
+	if !nodeIdentifierPattern.MatchString(d.AgentImageTag) {
+		return fmt.Errorf("%w: %q is not an image tag", ErrInvalidNodeScript, d.AgentImageTag)
+	}
+
+	for name, value := range map[string]string{"root path": d.RootPath, "traefik e-mail": d.TraefikAcmeEmail} {
+		if strings.ContainsAny(value, nodeUnsafeChars) {
+			return fmt.Errorf("%w: %s can not contain quotes, '$' or line breaks", ErrInvalidNodeScript, name)
+		}
+	}
+
+	return nil
+}
+
+// renderNodeScript renders the script of the agent type and target, the shell script of crane applies its manifest
+func renderNodeScript(nodeType, target string, data *nodeScriptData) ([]byte, error) {
+	var file string
+	switch {
+	case nodeType == NodeTypeDagent && target == NodeTargetShell:
+		file = "dagent.sh.tmpl"
+	case nodeType == NodeTypeDagent && target == NodeTargetPowerShell:
+		file = "dagent.ps1.tmpl"
+	case nodeType == NodeTypeDagent && target == NodeTargetCompose:
+		file = "dagent.compose.yaml.tmpl"
+	case nodeType == NodeTypeCrane && (target == NodeTargetShell || target == NodeTargetManifest):
+		file = "crane.yaml.tmpl"
+	default:
+		return nil, fmt.Errorf("%w: %s has no %s script", ErrUnknownNodeScript, nodeType, target)
+	}
+
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(file).Option("missingkey=error").ParseFS(nodeScriptTmpl, "node-script/"+file)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse node script template: %w", err)
+	}
+
+	var script bytes.Buffer
+	if nodeType == NodeTypeCrane && target == NodeTargetShell {
+		script.WriteString("#!/bin/sh\nset -e\n\ncat <<EOF | kubectl apply -f -\n")
+	}
+	if err = tmpl.Execute(&script, data); err != nil {
+		return nil, fmt.Errorf("couldn't render node script: %w", err)
+	}
+	if nodeType == NodeTypeCrane && target == NodeTargetShell {
+		script.WriteString("EOF\n")
+	}
+
+	return script.Bytes(), nil
+}
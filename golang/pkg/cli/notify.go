@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	dockerhelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+)
+
+const notificationTimeout = 10 * time.Second
+
+// notification events
+const (
+	eventUp      = "up"
+	eventUpgrade = "upgrade"
+	eventDown    = "down"
+	eventFailure = "failure"
+)
+
+type notification struct {
+	Event     string `json:"event"`
+	Container string `json:"container,omitempty"`
+	Message   string `json:"message"`
+	Prefix    string `json:"prefix"`
+}
+
+type notifier interface {
+	Notify(ctx context.Context, n notification) error
+}
+
+type webhookNotifier struct {
+	client *http.Client
+	url    string
+}
+
+type slackNotifier struct {
+	webhook *webhookNotifier
+}
+
+type desktopNotifier struct{}
+
+// notificationHook reports fatal errors, which would exit the process before reaching any other notification
+type notificationHook struct {
+	ctx       context.Context
+	prefix    string
+	command   string
+	notifiers []notifier
+}
+
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: notificationTimeout},
+	}
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n notification) error {
+	return w.post(ctx, n)
+}
+
+func (w *webhookNotifier) post(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("notification endpoint responded with status: %s", res.Status)
+	}
+
+	return nil
+}
+
+// Notify posts the notification as a plain Slack message
+func (s *slackNotifier) Notify(ctx context.Context, n notification) error {
+	return s.webhook.post(ctx, map[string]string{
+		"text": fmt.Sprintf("*dyo %s* %s: %s", n.Prefix, n.Event, n.Message),
+	})
+}
+
+// Notify shows the notification using notify-send on Linux and osascript on macOS
+func (desktopNotifier) Notify(ctx context.Context, n notification) error {
+	title := fmt.Sprintf("dyo %s: %s", n.Prefix, n.Event)
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "linux":
+		cmd = exec.CommandContext(ctx, "notify-send", title, n.Message)
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "osascript", "-e",
+			fmt.Sprintf("display notification %q with title %q", n.Message, title))
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to show desktop notification: %w: %s", err, out)
+	}
+
+	return nil
+}
+
+// notifyAll sends the notification to every notifier, failures are only logged
+func notifyAll(ctx context.Context, notifiers []notifier, n notification) {
+	for _, it := range notifiers {
+		if err := it.Notify(ctx, n); err != nil {
+			log.Warn().Err(err).Str("event", n.Event).Msg("Failed to send notification")
+		}
+	}
+}
+
+func (h *notificationHook) Run(_ *zerolog.Event, level zerolog.Level, msg string) {
+	if level != zerolog.FatalLevel {
+		return
+	}
+
+	if msg == "" {
+		msg = "unexpected error"
+	}
+
+	// the fatal log comes after the command returned and canceled its context, the failure is still sent
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(h.ctx), notificationTimeout)
+	defer cancel()
+
+	notifyAll(ctx, h.notifiers, notification{
+		Event:   eventFailure,
+		Message: fmt.Sprintf("%s failed: %s", h.command, msg),
+		Prefix:  h.prefix,
+	})
+}
+
+// settingsNotifiers creates the notifiers configured in the settings file
+func settingsNotifiers(settings NotificationSettings) []notifier {
+	notifiers := []notifier{}
+	if settings.SlackWebhook != "" {
+		notifiers = append(notifiers, &slackNotifier{webhook: newWebhookNotifier(settings.SlackWebhook)})
+	}
+	for _, url := range settings.Webhooks {
+		notifiers = append(notifiers, newWebhookNotifier(url))
+	}
+
+	return notifiers
+}
+
+func notifyOnFatal(ctx context.Context, notifiers []notifier, args *ArgsFlags) {
+	if len(notifiers) == 0 {
+		return
+	}
+
+	log.Logger = log.Logger.Hook(&notificationHook{
+		ctx:       ctx,
+		prefix:    args.Prefix,
+		command:   args.Command,
+		notifiers: notifiers,
+	})
+}
+
+// stackStartEvent tells apart an upgrade from a plain start by the image of the already running kratos container
+func stackStartEvent(ctx context.Context, state *State, args *ArgsFlags) notification {
+	image := state.serviceImage(string(kratos))
+	n := notification{
+		Event:   eventUp,
+		Message: fmt.Sprintf("stack is up, running version %s", state.SettingsFile.Version),
+		Prefix:  args.Prefix,
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return n
+	}
+
+	cont, err := dockerhelper.GetContainerByName(ctx, cli, state.Containers.Kratos.Name)
+	if err != nil || cont == nil || cont.Image == image || strings.HasPrefix(cont.Image, "sha256:") {
+		return n
+	}
+
+	n.Event = eventUpgrade
+	n.Message = fmt.Sprintf("stack is upgraded from %s to %s", cont.Image, image)
+
+	return n
+}
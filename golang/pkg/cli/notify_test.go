@@ -0,0 +1,70 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestNotifyWithSettings(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		mu.Lock()
+		received[r.URL.Path] = body
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cli.NotifyWithSettings(context.Background(), cli.NotificationSettings{
+		SlackWebhook: server.URL + "/slack",
+		Webhooks:     []string{server.URL + "/generic"},
+	}, "dyo-stable", "down", "stack is stopped")
+
+	assert.Equal(t, map[string]string{"text": "*dyo dyo-stable* down: stack is stopped"}, received["/slack"])
+	assert.Equal(t, map[string]string{
+		"event":   "down",
+		"message": "stack is stopped",
+		"prefix":  "dyo-stable",
+	}, received["/generic"])
+}
+
+func TestNotificationHookAfterCancel(t *testing.T) {
+	received := make(chan map[string]string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received <- body
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hook := cli.NewNotificationHook(ctx, cli.NotificationSettings{Webhooks: []string{server.URL}}, "dyo-stable", "up")
+
+	// the command returns and cancels its context before main logs the fatal error
+	cancel()
+	hook.Run(nil, zerolog.FatalLevel, "port 8000 is allocated")
+
+	assert.Equal(t, map[string]string{
+		"event":   "failure",
+		"message": "up failed: port 8000 is allocated",
+		"prefix":  "dyo-stable",
+	}, <-received)
+}
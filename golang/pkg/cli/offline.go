@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+)
+
+const (
+	SaveCommand = "save"
+)
+
+const (
+	FlagOffline = "offline"
+	FlagBundle  = "bundle"
+)
+
+const (
+	defaultBundlePath = "dyo-images.tar"
+	bundlePerms       = 0o644
+)
+
+var ErrImagesNotInBundle = errors.New("images are missing from the bundle")
+
+// offlineFlags are the flags of the commands starting the stack, up and upgrade
+func offlineFlags() []ucli.Flag {
+	return []ucli.Flag{
+		&ucli.BoolFlag{
+			Name:  FlagOffline,
+			Value: false,
+			Usage: "load the images from the bundle written by the save command instead of pulling them",
+		},
+		&ucli.StringFlag{
+			Name:  FlagBundle,
+			Value: defaultBundlePath,
+			Usage: "path of the image bundle, used with --offline",
+		},
+	}
+}
+
+func GetSaveCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:  SaveCommand,
+		Usage: "Pull the images of the stack and export them into a bundle, so the stack can be started offline with up --offline",
+		Flags: []ucli.Flag{
+			&ucli.StringFlag{
+				Name:  FlagBundle,
+				Value: defaultBundlePath,
+				Usage: "path of the image bundle",
+			},
+		},
+		Action: run,
+	}
+}
+
+// saveStackImages pulls the images of the stack, so the bundle has the current ones, then writes the bundle
+func saveStackImages(ctx context.Context, state *State, args *ArgsFlags) (*bundleResult, error) {
+	images := stackImages(state, args)
+	if err := prePullImages(ctx, images, args); err != nil {
+		return nil, fmt.Errorf("failed to pull the images of the stack: %w", err)
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	path := util.Fallback(args.Bundle, defaultBundlePath)
+	size, err := saveImageBundle(ctx, cli, images, path)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("path", path).Int("images", len(images)).Str("size", formatBytes(size)).
+		Msg("Image bundle written, copy it next to the settings of the offline machine and start the stack with up --offline")
+
+	return &bundleResult{Path: path, Images: images, Size: size}, nil
+}
+
+// saveImageBundle writes the images into a tarball loadable by docker load, the bundle is replaced only if the save succeeds
+func saveImageBundle(ctx context.Context, cli client.APIClient, images []string, path string) (int64, error) {
+	archive, err := cli.ImageSave(ctx, images)
+	if err != nil {
+		return 0, fmt.Errorf("failed to export the images: %w", err)
+	}
+	defer logdefer.LogDeferredErr(archive.Close, log.Warn(), "error in defer when closing the exported images")
+
+	partial := path + ".partial"
+	file, err := os.OpenFile(partial, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, bundlePerms)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create the image bundle: %w", err)
+	}
+
+	size, err := io.Copy(file, archive)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(partial)
+		return 0, fmt.Errorf("failed to write the image bundle: %w", err)
+	}
+
+	if err = os.Rename(partial, path); err != nil {
+		return 0, fmt.Errorf("failed to write the image bundle: %w", err)
+	}
+
+	return size, nil
+}
+
+// loadStackImages loads the bundle instead of pulling, the builders use the local images only in offline mode
+// ensureStackImages loads the images of the stack from the bundle in offline mode, pulls them otherwise
+func ensureStackImages(ctx context.Context, state *State, args *ArgsFlags) error {
+	if args.Offline {
+		return loadStackImages(ctx, stackImages(state, args), args)
+	}
+
+	if err := prePullImages(ctx, stackImages(state, args), args); err != nil {
+		return fmt.Errorf("failed to pull the images of the stack: %w", err)
+	}
+
+	return nil
+}
+
+func loadStackImages(ctx context.Context, images []string, args *ArgsFlags) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	path := util.Fallback(args.Bundle, defaultBundlePath)
+	if err = loadImageBundle(ctx, cli, path, images); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	log.Info().Str("path", path).Int("images", len(images)).Msg("Images loaded from the bundle")
+
+	return nil
+}
+
+// loadImageBundle loads the images of the bundle into the daemon, then checks that the stack has every image it needs
+func loadImageBundle(ctx context.Context, cli client.APIClient, path string, images []string) error {
+	file, err := os.Open(path) //#nosec G304 -- the path of the bundle is given by the user
+	if err != nil {
+		return fmt.Errorf("failed to open the image bundle, write it with the save command: %w", err)
+	}
+	defer logdefer.LogDeferredErr(file.Close, log.Warn(), "error in defer when closing the image bundle")
+
+	resp, err := cli.ImageLoad(ctx, file, true)
+	if err != nil {
+		return fmt.Errorf("failed to load the image bundle: %w", err)
+	}
+	defer logdefer.LogDeferredErr(resp.Body.Close, log.Warn(), "error in defer when closing the load response")
+
+	if err = readLoadResponse(resp.Body, resp.JSON); err != nil {
+		return fmt.Errorf("failed to load the image bundle: %w", err)
+	}
+
+	missing, err := missingImages(ctx, cli, images)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w, save it again with the settings of the stack: %s", ErrImagesNotInBundle, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func readLoadResponse(body io.Reader, isJSON bool) error {
+	if !isJSON {
+		_, err := io.Copy(io.Discard, body)
+		return err
+	}
+
+	dec := json.NewDecoder(body)
+	for {
+		var jm jsonmessage.JSONMessage
+		if err := dec.Decode(&jm); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if jm.Error != nil {
+			return jm.Error
+		}
+
+		if stream := strings.TrimSpace(jm.Stream); stream != "" {
+			log.Debug().Msg(stream)
+		}
+	}
+}
+
+func missingImages(ctx context.Context, cli client.APIClient, images []string) ([]string, error) {
+	missing := []string{}
+	for _, image := range images {
+		_, _, err := cli.ImageInspectWithRaw(ctx, image)
+		if errdefs.IsNotFound(err) {
+			missing = append(missing, image)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect the image %s: %w", image, err)
+		}
+	}
+
+	return missing, nil
+}
@@ -0,0 +1,70 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+var bundleImages = []string{
+	"ghcr.io/dyrector-io/dyrectorio/web/crux:stable",
+	"docker.io/library/postgres:14.2-alpine",
+}
+
+func saveBundle(t *testing.T) string {
+	online := dockerfake.New()
+	for _, it := range bundleImages {
+		online.AddImage(it)
+	}
+
+	path := filepath.Join(t.TempDir(), "dyo-images.tar")
+	size, err := cli.SaveImageBundle(context.Background(), online, bundleImages, path)
+	assert.NoError(t, err)
+	assert.Positive(t, size)
+
+	return path
+}
+
+func TestImageBundleRoundTrip(t *testing.T) {
+	path := saveBundle(t)
+	_, err := os.Stat(path + ".partial")
+	assert.True(t, os.IsNotExist(err))
+
+	offline := dockerfake.New()
+	assert.NoError(t, cli.LoadImageBundle(context.Background(), offline, path, bundleImages))
+	assert.Equal(t, 1, offline.CallCount("ImageLoad"))
+	assert.Equal(t, 0, offline.CallCount("ImagePull"))
+	for _, it := range bundleImages {
+		_, _, err = offline.ImageInspectWithRaw(context.Background(), it)
+		assert.NoError(t, err)
+	}
+}
+
+func TestImageBundleMissingImage(t *testing.T) {
+	path := saveBundle(t)
+
+	images := append([]string{"docker.io/library/traefik:v2.10"}, bundleImages...)
+	err := cli.LoadImageBundle(context.Background(), dockerfake.New(), path, images)
+	assert.ErrorIs(t, err, cli.ErrImagesNotInBundle)
+	assert.ErrorContains(t, err, "docker.io/library/traefik:v2.10")
+}
+
+func TestImageBundleNotSaved(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dyo-images.tar")
+	assert.Error(t, cli.LoadImageBundle(context.Background(), dockerfake.New(), path, bundleImages))
+
+	// the images are not pulled, nothing is written
+	_, err := cli.SaveImageBundle(context.Background(), dockerfake.New(), bundleImages, path)
+	assert.Error(t, err)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
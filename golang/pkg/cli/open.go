@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	uiReadyTimeout   = 3 * time.Minute
+	uiReadyInterval  = 2 * time.Second
+	uiRequestTimeout = 5 * time.Second
+)
+
+// urlReady is true if the url answers without an error, traefik answers 404 until crux-ui is routed
+// and 502 until it is listening, crux-ui itself redirects to the login page
+func urlReady(ctx context.Context, client *http.Client, url string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return false
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode < http.StatusBadRequest
+}
+
+// waitForURL polls the url until it is ready or the context is done
+func waitForURL(ctx context.Context, url string, interval time.Duration) error {
+	client := &http.Client{
+		Timeout: uiRequestTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	for {
+		if urlReady(ctx, client, url) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s is not ready: %w", url, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// browserCommand is the command opening the url in the default browser of the OS
+func browserCommand(goos, url string) ([]string, error) {
+	switch goos {
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return []string{"xdg-open", url}, nil
+	case "darwin":
+		return []string{"open", url}, nil
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler", url}, nil
+	default:
+		return nil, fmt.Errorf("opening a browser is not supported on %s", goos)
+	}
+}
+
+func openBrowser(ctx context.Context, url string) error {
+	command, err := browserCommand(runtime.GOOS, url)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec // the command is one of the browser openers above
+	if out, err := exec.CommandContext(ctx, command[0], command[1:]...).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to open the browser: %w: %s", err, out)
+	}
+
+	return nil
+}
+
+// OpenStack waits for the UI of the started stack, then opens it in the browser and prints the inbox
+// of mailslurper, where the verification e-mail of the first sign up arrives, unless an external SMTP server is used
+func OpenStack(ctx context.Context, state *State, args *ArgsFlags) {
+	if args.CruxUIDisabled {
+		log.Warn().Msg("Crux UI is disabled, there is nothing to open")
+		return
+	}
+
+	// the readiness is checked over HTTP, the local CA is not trusted by dyo itself
+	readyURL := fmt.Sprintf("http://localhost:%d", state.SettingsFile.TraefikWebPort)
+	if state.SettingsFile.externalProxy() {
+		readyURL = fmt.Sprintf("http://localhost:%d", state.SettingsFile.CruxUIPort)
+	}
+	uiURL := publicURL(&state.SettingsFile, "localhost")
+
+	readyCtx, cancel := context.WithTimeout(ctx, uiReadyTimeout)
+	defer cancel()
+
+	log.Info().Str("url", uiURL).Msg("Waiting for the UI to be ready")
+	if err := waitForURL(readyCtx, readyURL, uiReadyInterval); err != nil {
+		log.Warn().Err(err).Msg("The UI did not become ready in time, open it manually later")
+	} else if err = openBrowser(ctx, uiURL); err != nil {
+		log.Warn().Err(err).Str("url", uiURL).Msg("Open the UI manually")
+	}
+
+	if state.SettingsFile.ExternalSMTP.Enabled() {
+		return
+	}
+
+	inboxURL := fmt.Sprintf("http://localhost:%d", state.SettingsFile.MailSlurperUIPort)
+	log.Info().Str("url", inboxURL).Msg("The e-mails of the stack, like the verification of the first sign up, arrive in this inbox")
+}
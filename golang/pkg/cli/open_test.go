@@ -0,0 +1,67 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestWaitForURLUntilUIIsRouted(t *testing.T) {
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			http.Redirect(w, r, "/auth/login", http.StatusTemporaryRedirect)
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	assert.NoError(t, cli.WaitForURL(ctx, server.URL, 10*time.Millisecond))
+	assert.EqualValues(t, 3, requests.Load())
+}
+
+func TestWaitForURLTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, cli.WaitForURL(ctx, server.URL, 10*time.Millisecond), context.DeadlineExceeded)
+}
+
+func TestBrowserCommand(t *testing.T) {
+	command, err := cli.BrowserCommand("linux", "http://localhost:8000")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"xdg-open", "http://localhost:8000"}, command)
+
+	command, err = cli.BrowserCommand("darwin", "http://localhost:8000")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"open", "http://localhost:8000"}, command)
+
+	command, err = cli.BrowserCommand("windows", "http://localhost:8000")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rundll32", "url.dll,FileProtocolHandler", "http://localhost:8000"}, command)
+
+	_, err = cli.BrowserCommand("plan9", "http://localhost:8000")
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+const (
+	// FlagFormat is not named output, the generate, settings and debug commands have an output path flag of their own
+	FlagFormat = "format"
+)
+
+// output formats
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+// types of the lines in json output mode
+const (
+	outputEvent  = "event"
+	outputResult = "result"
+)
+
+var ErrInvalidOutput = errors.New("invalid output format")
+
+// commandResult is the last line of a command in json output mode, scripts can tell the outcome without parsing the events
+type commandResult struct {
+	Result  any    `json:"result,omitempty"`
+	Type    string `json:"type"`
+	Command string `json:"command"`
+	Error   string `json:"error,omitempty"`
+	// the envelope of the error shared with the agents, the scripts retry by it instead of the message
+	ErrorCode     string `json:"errorCode,omitempty"`
+	ErrorCategory string `json:"errorCategory,omitempty"`
+	Retryable     bool   `json:"retryable,omitempty"`
+	Success       bool   `json:"success"`
+}
+
+type stackUpResult struct {
+	Prefix  string `json:"prefix"`
+	Version string `json:"version"`
+	UIURL   string `json:"uiUrl"`
+	// empty if the e-mails are sent with an external server
+	MailURL string `json:"mailUrl,omitempty"`
+}
+
+type stackDownResult struct {
+	Prefix string `json:"prefix"`
+}
+
+type lockResult struct {
+	Path   string `json:"path"`
+	Images int    `json:"images"`
+}
+
+type backupResult struct {
+	Path      string   `json:"path"`
+	Databases []string `json:"databases"`
+}
+
+type bundleResult struct {
+	Path   string   `json:"path"`
+	Images []string `json:"images"`
+	Size   int64    `json:"size"`
+}
+
+type generateResult struct {
+	Path string `json:"path"`
+}
+
+// doctorResult are the findings of the checks, they are the result even if some of them failed
+type doctorResult struct {
+	Findings []doctorFinding `json:"findings"`
+	Failures int             `json:"failures"`
+	Warnings int             `json:"warnings"`
+}
+
+type versionResult struct {
+	CLI string `json:"cli"`
+}
+
+// outputFormat is the global format flag, the --json of the status command is its shorthand
+func outputFormat(cCtx *ucli.Context) string {
+	if cCtx.Bool(FlagStatusJSON) {
+		return OutputJSON
+	}
+
+	lineage := cCtx.Lineage()
+
+	return lineage[len(lineage)-1].String(FlagFormat)
+}
+
+// setupOutput replaces the console logger with a json one in json output mode, each event is a line
+func setupOutput(format string, w io.Writer) error {
+	switch format {
+	case OutputText:
+		return nil
+	case OutputJSON:
+		log.Logger = zerolog.New(w).With().Timestamp().Str("type", outputEvent).Logger()
+		return nil
+	default:
+		return fmt.Errorf("%w: %s, use %s or %s", ErrInvalidOutput, format, OutputText, OutputJSON)
+	}
+}
+
+// writeCommandResult writes the outcome of the command, the error of the command is returned,
+// so the exit code still tells the failure
+func writeCommandResult(w io.Writer, command string, result any, err error) error {
+	line := commandResult{
+		Type:    outputResult,
+		Command: command,
+		Success: err == nil,
+	}
+	if err != nil {
+		class := grpc.ClassifyError(err)
+		line.Error = err.Error()
+		line.ErrorCode = class.Code
+		line.ErrorCategory = class.Category.String()
+		line.Retryable = class.Retryable
+	} else {
+		line.Result = result
+	}
+
+	if encodeErr := json.NewEncoder(w).Encode(&line); encodeErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to write the result of the command: %w", encodeErr))
+	}
+
+	return err
+}
+
+func stackUpResultOf(state *State, args *ArgsFlags) *stackUpResult {
+	result := &stackUpResult{
+		Prefix:  args.Prefix,
+		Version: state.SettingsFile.Version,
+		UIURL:   publicURL(&state.SettingsFile, "localhost"),
+	}
+	if !state.SettingsFile.ExternalSMTP.Enabled() {
+		result.MailURL = fmt.Sprintf("http://localhost:%d", state.SettingsFile.Options.MailSlurperUIPort)
+	}
+
+	return result
+}
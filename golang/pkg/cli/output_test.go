@@ -0,0 +1,74 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestSetupOutput(t *testing.T) {
+	logger := log.Logger
+	t.Cleanup(func() { log.Logger = logger })
+
+	assert.ErrorIs(t, cli.SetupOutput("yaml", &bytes.Buffer{}), cli.ErrInvalidOutput)
+	assert.NoError(t, cli.SetupOutput(cli.OutputText, &bytes.Buffer{}))
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, cli.SetupOutput(cli.OutputJSON, out))
+	log.Info().Str("path", "dyo.yaml").Msg("Compose file written")
+
+	event := map[string]any{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &event))
+	assert.Equal(t, "event", event["type"])
+	assert.Equal(t, "info", event["level"])
+	assert.Equal(t, "Compose file written", event["message"])
+	assert.Equal(t, "dyo.yaml", event["path"])
+	assert.Contains(t, event, "time")
+}
+
+func TestWriteCommandResult(t *testing.T) {
+	out := &bytes.Buffer{}
+	status := &cli.StackStatus{Prefix: "dyo-stable", Containers: []cli.StackMemberStatus{{Name: "dyo-stable_crux"}}}
+	assert.NoError(t, cli.WriteCommandResult(out, cli.StatusCommand, status, nil))
+
+	result := map[string]any{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Equal(t, "result", result["type"])
+	assert.Equal(t, "status", result["command"])
+	assert.Equal(t, true, result["success"])
+	assert.NotContains(t, result, "error")
+	assert.Equal(t, "dyo-stable", result["result"].(map[string]any)["prefix"])
+}
+
+func TestWriteCommandResultFailure(t *testing.T) {
+	out := &bytes.Buffer{}
+	failure := errors.New("could not connect to docker socket")
+	assert.Same(t, failure, cli.WriteCommandResult(out, cli.UpCommand, nil, failure))
+
+	result := map[string]any{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Equal(t, "up", result["command"])
+	assert.Equal(t, false, result["success"])
+	assert.Equal(t, "could not connect to docker socket", result["error"])
+	assert.Equal(t, "INTERNAL", result["errorCode"])
+	assert.Equal(t, "ERROR_CATEGORY_INTERNAL", result["errorCategory"])
+	assert.NotContains(t, result, "result")
+
+	out.Reset()
+	_ = cli.WriteCommandResult(out, cli.UpCommand, nil, fmt.Errorf("failed to start: %w", context.DeadlineExceeded))
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Equal(t, "DEADLINE_EXCEEDED", result["errorCode"])
+	assert.Equal(t, "ERROR_CATEGORY_TIMEOUT", result["errorCategory"])
+	assert.Equal(t, true, result["retryable"])
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var ErrInvalidPostgresParameter = errors.New("invalid postgres parameter")
+
+var postgresParameterPattern = regexp.MustCompile(`^[a-z][a-z0-9_.]*$`)
+
+// PostgresTuning are the server parameters of a Postgres of the stack, the empty ones keep the defaults of the image
+type PostgresTuning struct {
+	// any other parameter by its name in postgresql.conf, eg. effective_cache_size: 1GB
+	Parameters    map[string]string `yaml:"parameters"`
+	SharedBuffers string            `yaml:"sharedBuffers"`
+	WalBuffers    string            `yaml:"walBuffers"`
+	MinWalSize    string            `yaml:"minWalSize"`
+	MaxWalSize    string            `yaml:"maxWalSize"`
+	// synchronous_commit, off trades the durability of the last commits for write speed
+	SynchronousCommit string `yaml:"synchronousCommit"`
+	MaxConnections    uint   `yaml:"maxConnections"`
+}
+
+// parameters are the configured parameters by their postgresql.conf name, the named fields override Parameters
+func (t *PostgresTuning) parameters() map[string]string {
+	params := map[string]string{}
+	for name, value := range t.Parameters {
+		params[name] = value
+	}
+
+	named := map[string]string{
+		"shared_buffers":     t.SharedBuffers,
+		"wal_buffers":        t.WalBuffers,
+		"min_wal_size":       t.MinWalSize,
+		"max_wal_size":       t.MaxWalSize,
+		"synchronous_commit": t.SynchronousCommit,
+	}
+	if t.MaxConnections > 0 {
+		named["max_connections"] = strconv.FormatUint(uint64(t.MaxConnections), 10)
+	}
+	for name, value := range named {
+		if value != "" {
+			params[name] = value
+		}
+	}
+
+	return params
+}
+
+// Validate checks the parameter names, the values are checked by Postgres when it starts
+func (t *PostgresTuning) Validate() error {
+	for name, value := range t.Parameters {
+		if !postgresParameterPattern.MatchString(name) {
+			return fmt.Errorf("%w: %q is not a parameter name", ErrInvalidPostgresParameter, name)
+		}
+		if value == "" {
+			return fmt.Errorf("%w: %s has no value", ErrInvalidPostgresParameter, name)
+		}
+	}
+
+	return nil
+}
+
+// Cmd is the command of the Postgres container, the parameters are passed as -c name=value flags in name order.
+// It is nil without parameters, so the command of the image is kept.
+func (t *PostgresTuning) Cmd() []string {
+	params := t.parameters()
+	if len(params) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cmd := []string{"postgres"}
+	for _, name := range names {
+		cmd = append(cmd, "-c", fmt.Sprintf("%s=%s", name, params[name]))
+	}
+
+	return cmd
+}
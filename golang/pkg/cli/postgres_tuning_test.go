@@ -0,0 +1,42 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestPostgresTuningCmd(t *testing.T) {
+	tuning := cli.PostgresTuning{
+		SharedBuffers:  "512MB",
+		MaxConnections: 200,
+		MaxWalSize:     "2GB",
+		Parameters: map[string]string{
+			"effective_cache_size": "1GB",
+			"shared_buffers":       "128MB",
+		},
+	}
+
+	assert.Equal(t, []string{
+		"postgres",
+		"-c", "effective_cache_size=1GB",
+		"-c", "max_connections=200",
+		"-c", "max_wal_size=2GB",
+		"-c", "shared_buffers=512MB",
+	}, tuning.Cmd())
+
+	assert.Nil(t, (&cli.PostgresTuning{}).Cmd())
+}
+
+func TestPostgresTuningValidate(t *testing.T) {
+	assert.NoError(t, (&cli.PostgresTuning{Parameters: map[string]string{"auto_explain.log_min_duration": "1s"}}).Validate())
+	assert.ErrorIs(t, (&cli.PostgresTuning{Parameters: map[string]string{"shared buffers": "1GB"}}).Validate(),
+		cli.ErrInvalidPostgresParameter)
+	assert.ErrorIs(t, (&cli.PostgresTuning{Parameters: map[string]string{"work_mem": ""}}).Validate(),
+		cli.ErrInvalidPostgresParameter)
+}
@@ -0,0 +1,296 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/term"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+)
+
+const (
+	progressBarWidth       = 30
+	progressRenderInterval = 100 * time.Millisecond
+	bytesUnit              = 1024
+)
+
+type pullBar struct {
+	started time.Time
+	layers  map[string]*status
+	err     error
+	image   string
+	done    bool
+	matched bool
+}
+
+// pullProgress renders a bar per image while they are pulled in parallel,
+// or a line per finished image when the output is not a terminal
+type pullProgress struct {
+	lastRender time.Time
+	out        io.Writer
+	bars       []*pullBar
+	rendered   int
+	mu         sync.Mutex
+	tty        bool
+}
+
+func newPullProgress(out *os.File) *pullProgress {
+	return &pullProgress{
+		out: out,
+		tty: term.IsTerminal(int(out.Fd())),
+	}
+}
+
+// track adds the bar of an image, pulls not reaching the display function are up-to-date
+func (p *pullProgress) track(image string) *pullBar {
+	bar := &pullBar{image: image, layers: map[string]*status{}, started: time.Now()}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.bars = append(p.bars, bar)
+
+	return bar
+}
+
+func (p *pullProgress) display(bar *pullBar) imageHelper.PullDisplayFn {
+	return func(_ string, respIn io.ReadCloser) error {
+		return p.consume(bar, respIn)
+	}
+}
+
+func (p *pullProgress) finish(bar *pullBar, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bar.done = true
+	bar.err = err
+	if len(bar.layers) == 0 {
+		bar.matched = true
+	}
+
+	p.render(true)
+	if !p.tty {
+		log.Info().Msg(bar.summary())
+	}
+}
+
+func (p *pullProgress) consume(bar *pullBar, respIn io.ReadCloser) error {
+	if respIn == nil {
+		bar.matched = true
+		return nil
+	}
+
+	dec := json.NewDecoder(respIn)
+	for {
+		var jm jsonmessage.JSONMessage
+		if err := dec.Decode(&jm); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to decode pull progress: %w", err)
+		}
+		if jm.Error != nil {
+			return jm.Error
+		}
+
+		p.mu.Lock()
+		bar.update(&jm)
+		p.render(false)
+		p.mu.Unlock()
+	}
+}
+
+func (b *pullBar) update(jm *jsonmessage.JSONMessage) {
+	phase := imageHelper.LpsFromString(jm.Status)
+	if phase == imageHelper.LayerProgressStatusUnknown || jm.ID == "" {
+		return
+	}
+	if phase == imageHelper.LayerProgressStatusMatching {
+		b.matched = true
+		return
+	}
+
+	layer, ok := b.layers[jm.ID]
+	if !ok {
+		layer = &status{}
+		b.layers[jm.ID] = layer
+	}
+
+	switch phase {
+	case imageHelper.LayerProgressStatusDownloading:
+		if jm.Progress != nil {
+			layer.Current = jm.Progress.Current
+			layer.Total = jm.Progress.Total
+		}
+	case imageHelper.LayerProgressStatusDownloaded, imageHelper.LayerProgressStatusExtracting,
+		imageHelper.LayerProgressStatusComplete:
+		layer.Current = layer.Total
+	}
+}
+
+func (b *pullBar) progress() (current, total int64) {
+	for _, layer := range b.layers {
+		current += layer.Current
+		total += layer.Total
+	}
+
+	return current, total
+}
+
+func (b *pullBar) summary() string {
+	switch {
+	case b.err != nil:
+		return fmt.Sprintf("%s ✗ %s", b.image, b.err)
+	case b.matched:
+		return fmt.Sprintf("%s ✓ up-to-date", b.image)
+	default:
+		_, total := b.progress()
+		return fmt.Sprintf("%s ✓ pull complete (%s in %s)", b.image, formatBytes(total),
+			time.Since(b.started).Round(time.Second))
+	}
+}
+
+func (b *pullBar) line(now time.Time) string {
+	if b.done || b.matched {
+		return b.summary()
+	}
+
+	current, total := b.progress()
+	elapsed := now.Sub(b.started)
+
+	return fmt.Sprintf("%s %s %s/%s %s/s ETA %s", b.image, formatBar(progressBarWidth, current, total),
+		formatBytes(current), formatBytes(total), formatBytes(bytesPerSecond(current, elapsed)),
+		formatETA(current, total, elapsed))
+}
+
+// render redraws every bar in place, the caller must hold the lock
+func (p *pullProgress) render(force bool) {
+	if !p.tty || (!force && time.Since(p.lastRender) < progressRenderInterval) {
+		return
+	}
+	p.lastRender = time.Now()
+
+	var sb strings.Builder
+	if p.rendered > 0 {
+		fmt.Fprintf(&sb, "\033[%dA", p.rendered)
+	}
+	for _, bar := range p.bars {
+		sb.WriteString("\033[2K")
+		sb.WriteString(bar.line(p.lastRender))
+		sb.WriteByte('\n')
+	}
+	p.rendered = len(p.bars)
+
+	if _, err := io.WriteString(p.out, sb.String()); err != nil {
+		log.Trace().Err(err).Msg("Failed to render the pull progress")
+	}
+}
+
+// prePullImages pulls every image of the stack in parallel, so the containers can be started right after each other
+func prePullImages(ctx context.Context, images []string, args *ArgsFlags) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	priority := imageHelper.PullIfNewer
+	if args.PreferLocalImages {
+		priority = imageHelper.PreferLocal
+	}
+
+	progress := newPullProgress(os.Stdout)
+	// the bars would break the lines of the events
+	progress.tty = progress.tty && args.Output != OutputJSON
+	errs := make([]error, len(images))
+
+	var wg sync.WaitGroup
+	for i, image := range images {
+		wg.Add(1)
+		go func(i int, image string, bar *pullBar) {
+			defer wg.Done()
+			errs[i] = imageHelper.CustomImagePull(ctx, cli, image, "", priority, progress.display(bar), nil)
+			progress.finish(bar, errs[i])
+		}(i, image, progress.track(image))
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// stackImages lists the images used by the enabled services, pinned to digests in --locked mode
+func stackImages(state *State, args *ArgsFlags) []string {
+	services := []string{string(kratos)}
+	if !state.SettingsFile.ExternalSMTP.Enabled() {
+		services = append(services, string(mailSlurper))
+	}
+	if !state.SettingsFile.CruxExternalPostgres.Enabled() || !state.SettingsFile.KratosExternalPostgres.Enabled() {
+		services = append(services, postgresService)
+	}
+	if !state.SettingsFile.externalProxy() {
+		services = append(services, string(traefik))
+	}
+	if !args.CruxDisabled {
+		services = append(services, string(crux))
+	}
+	if !args.CruxUIDisabled {
+		services = append(services, string(cruxUI))
+	}
+
+	images := []string{}
+	for _, service := range services {
+		images = append(images, state.serviceImage(service))
+	}
+
+	return images
+}
+
+func formatBar(width int, current, total int64) string {
+	filled := 0
+	if total > 0 {
+		filled = int(int64(width) * min(current, total) / total)
+	}
+
+	return "[" + strings.Repeat("=", filled) + strings.Repeat(" ", width-filled) + "]"
+}
+
+func formatBytes(n int64) string {
+	if n < bytesUnit {
+		return fmt.Sprintf("%dB", n)
+	}
+
+	div, exp := int64(bytesUnit), 0
+	for m := n / bytesUnit; m >= bytesUnit; m /= bytesUnit {
+		div *= bytesUnit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func bytesPerSecond(current int64, elapsed time.Duration) int64 {
+	if elapsed < time.Second {
+		return 0
+	}
+
+	return int64(float64(current) / elapsed.Seconds())
+}
+
+func formatETA(current, total int64, elapsed time.Duration) string {
+	speed := bytesPerSecond(current, elapsed)
+	if speed == 0 || total <= current {
+		return "--"
+	}
+
+	return (time.Duration((total-current)/speed) * time.Second).String()
+}
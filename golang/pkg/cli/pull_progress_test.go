@@ -0,0 +1,31 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestFormatBar(t *testing.T) {
+	assert.Equal(t, "[     ]", cli.FormatBar(5, 0, 0))
+	assert.Equal(t, "[==   ]", cli.FormatBar(5, 40, 100))
+	assert.Equal(t, "[=====]", cli.FormatBar(5, 120, 100))
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512B", cli.FormatBytes(512))
+	assert.Equal(t, "1.5KiB", cli.FormatBytes(1536))
+	assert.Equal(t, "20.0MiB", cli.FormatBytes(20*1024*1024))
+}
+
+func TestFormatETA(t *testing.T) {
+	assert.Equal(t, "--", cli.FormatETA(0, 100, 0))
+	assert.Equal(t, "--", cli.FormatETA(100, 100, 10*time.Second))
+	assert.Equal(t, "10s", cli.FormatETA(1000, 2000, 10*time.Second))
+}
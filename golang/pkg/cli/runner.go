@@ -3,18 +3,24 @@
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
-	"net"
-	"strings"
+	"io"
+	"os"
+	"time"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/term"
 
 	dockerhelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	nethelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
 	"github.com/dyrector-io/dyrectorio/golang/internal/label"
-	"github.com/dyrector-io/dyrectorio/golang/internal/runtime/container"
+	containerRuntime "github.com/dyrector-io/dyrectorio/golang/internal/runtime/container"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	"github.com/dyrector-io/dyrectorio/golang/internal/version"
 	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 )
@@ -29,25 +35,42 @@
 	cruxPostgres   stackItemID = "crux-postgres"
 	kratosPostgres stackItemID = "kratos-postgres"
 	mailSlurper    stackItemID = "mailslurper"
+	cruxMigrate    stackItemID = "crux-migrate"
+	kratosMigrate  stackItemID = "kratos-migrate"
 )
 
+// startOrder is a serial order of the start dependencies, see startDependencies
 var startOrder = []stackItemID{
 	cruxPostgres, kratosPostgres, kratos, crux, mailSlurper, cruxUI, traefik,
 }
 
 type dyrectorioStack struct {
-	Containers *Containers
-	builders   map[stackItemID]containerbuilder.Builder
+	Containers    *Containers
+	builders      map[stackItemID]containerbuilder.Builder
+	dependencies  map[stackItemID][]stackItemID
+	readiness     map[stackItemID]readinessProbe
+	startTimeouts map[string]time.Duration
+	// the items are rendered on a live dashboard while they start if it is set, logged line by line otherwise
+	dashboardOut io.Writer
 }
 
 const (
 	containerNetDriver = "bridge"
 )
 
+var (
+	ErrInvalidCommand   = errors.New("invalid command")
+	ErrInvalidNetwork   = errors.New("network exists, but it can not be used")
+	ErrPortNotAvailable = errors.New("there's at least one port that is not available")
+)
+
 // commands
 const (
 	UpCommand      = "up"
 	DownCommand    = "down"
+	WatchCommand   = "watch"
+	LockCommand    = "lock"
+	UpgradeCommand = "upgrade"
 	VersionCommand = "version"
 )
 
@@ -55,108 +78,398 @@ type traefikFileProviderData struct {
 	InternalHost string
 	CruxUIPort   uint
 	CruxPort     uint
+	// TLS tells that the routers are on the TLS entrypoint too
+	TLS bool
 }
 
 //go:embed traefik.yaml.tmpl
 var traefikTmpl embed.FS
 
-// ProcessCommand is the main control function
-func ProcessCommand(ctx context.Context, initialState *State, args *ArgsFlags) {
+// ProcessCommand is the main control function, the errors are returned wrapped, so the caller decides how to exit
+func ProcessCommand(ctx context.Context, initialState *State, args *ArgsFlags) error {
 	stack := dyrectorioStack{
 		Containers: initialState.Containers,
 		builders:   map[stackItemID]containerbuilder.Builder{},
 	}
 
 	switch args.Command {
-	case UpCommand:
-		state := SettingsFileDefaults(initialState, args)
+	case UpCommand, WatchCommand:
+		if args.Command == WatchCommand {
+			if err := validateWatchArgs(args); err != nil {
+				return err
+			}
+		}
 
-		CheckSettings(state, args)
-		checkForBoundPorts(state, args)
+		state, err := SettingsFileDefaults(initialState, args)
+		if err != nil {
+			return err
+		}
+		notifiers := settingsNotifiers(state.SettingsFile.Notifications)
+		notifyOnFatal(ctx, notifiers, args)
 
-		stack.builders[traefik] = GetTraefik(state, args)
-		stack.builders[kratos] = GetKratos(state, args)
-		stack.builders[cruxPostgres] = GetCruxPostgres(state, args)
-		stack.builders[kratosPostgres] = GetKratosPostgres(state, args)
-		stack.builders[mailSlurper] = GetMailSlurper(state, args)
-		if !args.CruxDisabled {
-			stack.builders[crux] = GetCrux(state, args)
+		if err = CheckSettings(state, args); err != nil {
+			return err
+		}
+		if err = checkForBoundPorts(state, args); err != nil {
+			return err
+		}
+		if err = checkTraefikTemplate(state, args); err != nil {
+			return err
 		}
-		if !args.CruxUIDisabled {
-			stack.builders[cruxUI] = GetCruxUI(state, args)
+		if err = prepareTraefikTLS(state, args); err != nil {
+			return err
+		}
+		if args.Locked {
+			if err = loadLockedImages(state, args); err != nil {
+				return err
+			}
+		}
+		ensureHostsEntries(ctx, state, args)
+
+		if err = addStackBuilders(&stack, state, args); err != nil {
+			return err
+		}
+
+		if err = ensureStackImages(ctx, state, args); err != nil {
+			return err
 		}
 
-		StartContainers(&stack)
+		started := stackStartEvent(ctx, state, args)
+		if err = StartContainers(ctx, &stack); err != nil {
+			return err
+		}
 		PrintInfo(state, args)
+		state.Result = stackUpResultOf(state, args)
+		notifyAll(ctx, notifiers, started)
+
+		if args.Open {
+			OpenStack(ctx, state, args)
+		}
+
+		if args.Command == WatchCommand {
+			return WatchStack(ctx, args, notifiers)
+		}
+	case UpgradeCommand:
+		return upgradeStack(ctx, initialState, &stack, args)
 	case DownCommand:
-		StopContainers(ctx, args)
+		settings := ReadExistingSettings(args)
+		notifiers := settingsNotifiers(settings.Notifications)
+		notifyOnFatal(ctx, notifiers, args)
+
+		if err := StopContainers(ctx, args, settings.StopGracePeriods); err != nil {
+			return err
+		}
+		removeHostsEntries(args)
 		log.Info().Msg("Stack is stopped. Hope you had fun! 🎬")
+		initialState.Result = &stackDownResult{Prefix: args.Prefix}
+		notifyAll(ctx, notifiers, notification{Event: eventDown, Message: "stack is stopped", Prefix: args.Prefix})
+	case LockCommand:
+		state, err := SettingsFileDefaults(initialState, args)
+		if err != nil {
+			return err
+		}
+		lockPath := lockFilePath(args)
+
+		lock, err := resolveLockFile(state, args)
+		if err != nil {
+			return fmt.Errorf("failed to resolve the images of the stack: %w", err)
+		}
+		if err = writeLockFile(lockPath, lock); err != nil {
+			return err
+		}
+
+		log.Info().Str("path", lockPath).Int("images", len(lock.Images)).Msg("Lockfile written, use --locked to start from it")
+		state.Result = &lockResult{Path: lockPath, Images: len(lock.Images)}
+	case BackupCommand:
+		result, err := backupStack(ctx, initialState, args)
+		if err != nil {
+			return err
+		}
+		initialState.Result = result
+	case RestoreCommand:
+		result, err := restoreStack(ctx, initialState, &stack, args)
+		if err != nil {
+			return err
+		}
+		initialState.Result = result
+	case SaveCommand:
+		state, err := SettingsFileDefaults(initialState, args)
+		if err != nil {
+			return err
+		}
+		if args.Locked {
+			if err = loadLockedImages(state, args); err != nil {
+				return err
+			}
+		}
+
+		result, err := saveStackImages(ctx, state, args)
+		if err != nil {
+			return err
+		}
+		state.Result = result
+	case StatusCommand:
+		status, err := getStackStatus(ctx, args)
+		if err != nil {
+			return err
+		}
+		if args.Output == OutputJSON {
+			initialState.Result = status
+			return nil
+		}
+
+		return printStackStatus(status)
+	case ComposeCommand:
+		state, err := SettingsFileDefaults(initialState, args)
+		if err != nil {
+			return err
+		}
+		if args.Locked {
+			if err = loadLockedImages(state, args); err != nil {
+				return err
+			}
+		}
+
+		if err = prepareTraefikTLS(state, args); err != nil {
+			return err
+		}
+
+		compose, err := stackComposeFile(state, args)
+		if err != nil {
+			return err
+		}
+
+		state.Result = &generateResult{Path: util.Fallback(args.GenerateOutput, defaultComposeOutput)}
+
+		return writeComposeFile(args.GenerateOutput, compose)
+	case KubernetesCommand:
+		state, err := SettingsFileDefaults(initialState, args)
+		if err != nil {
+			return err
+		}
+		if args.Locked {
+			if err = loadLockedImages(state, args); err != nil {
+				return err
+			}
+		}
+
+		objects, err := stackManifests(state, args)
+		if err != nil {
+			return err
+		}
+
+		state.Result = &generateResult{Path: util.Fallback(args.GenerateOutput, defaultKubernetesOutput)}
+
+		return writeKubernetesManifests(args.GenerateOutput, objects)
 	case VersionCommand:
 		cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 		if err != nil {
-			log.Fatal().Err(err).Msg("Could not connect to docker socket.")
+			return fmt.Errorf("could not connect to docker socket: %w", err)
 		}
-		out, err := container.VersionCheck(ctx, cli)
+		out, err := containerRuntime.VersionCheck(ctx, cli)
 		if err != nil {
-			log.Fatal().Err(err).Msg("Version error")
+			return fmt.Errorf("version error: %w", err)
 		}
 		out.Str("CLI version", version.Version)
 		out.Msg("")
+		initialState.Result = &versionResult{CLI: version.Version}
 	default:
-		log.Fatal().Msg("Invalid command")
+		return fmt.Errorf("%w: %s", ErrInvalidCommand, args.Command)
 	}
+
+	return nil
 }
 
-// StartContainers is for creating and starting containers
-func StartContainers(stack *dyrectorioStack) {
-	for _, stackItem := range startOrder {
-		if item, ok := stack.builders[stackItem]; ok {
-			cont, err := item.CreateAndStart()
-			if err != nil {
-				log.Error().Str("container", string(stackItem)).Msg("Failed to start dyrector.io stack")
-				log.Fatal().Err(err).Stack().Send()
-			}
+func addStackBuilders(stack *dyrectorioStack, state *State, args *ArgsFlags) error {
+	stack.dependencies = startDependencies(args)
+	stack.readiness = readinessProbes(state, args)
+	stack.startTimeouts = state.SettingsFile.StartTimeouts
+	// the dashboard would break the lines of the events, the terminals of CI are not interactive
+	if args.Output != OutputJSON && term.IsTerminal(int(os.Stdout.Fd())) {
+		stack.dashboardOut = os.Stdout
+	}
+	if !state.SettingsFile.externalProxy() {
+		traefikBuilder, err := GetTraefik(state, args)
+		if err != nil {
+			return err
+		}
+		stack.builders[traefik] = traefikBuilder
+	}
+	stack.builders[kratos] = GetKratos(state, args)
+	if !state.SettingsFile.CruxExternalPostgres.Enabled() {
+		stack.builders[cruxPostgres] = GetCruxPostgres(state, args)
+	}
+	if !state.SettingsFile.KratosExternalPostgres.Enabled() {
+		stack.builders[kratosPostgres] = GetKratosPostgres(state, args)
+	}
+	if !state.SettingsFile.ExternalSMTP.Enabled() {
+		stack.builders[mailSlurper] = GetMailSlurper(state, args)
+	}
+	if !args.CruxDisabled {
+		stack.builders[crux] = GetCrux(state, args)
+	}
+	if !args.CruxUIDisabled {
+		stack.builders[cruxUI] = GetCruxUI(state, args)
+	}
 
-			log.Info().Str("container", cont.GetName()).Msg("Started")
+	return nil
+}
+
+// stackSpecs are the containers of the stack with their migrations, for exporting the stack to other tools
+type stackSpecs struct {
+	specs        map[stackItemID]containerbuilder.Spec
+	dependencies map[stackItemID][]stackItemID
+	// migrations are the one-shot containers run by the pre-start hooks of the items
+	migrations map[stackItemID]stackItemID
+}
+
+func getStackSpecs(state *State, args *ArgsFlags) (*stackSpecs, error) {
+	stack := dyrectorioStack{
+		builders: map[stackItemID]containerbuilder.Builder{},
+	}
+	if err := addStackBuilders(&stack, state, args); err != nil {
+		return nil, err
+	}
+
+	migrations := map[stackItemID]stackItemID{}
+	if _, ok := stack.builders[crux]; ok {
+		stack.builders[cruxMigrate] = getCruxMigrate(state.Ctx, state, args)
+		migrations[crux] = cruxMigrate
+		stack.dependencies[cruxMigrate] = []stackItemID{cruxPostgres}
+	}
+	stack.builders[kratosMigrate] = getKratosMigrate(state, args)
+	migrations[kratos] = kratosMigrate
+	stack.dependencies[kratosMigrate] = []stackItemID{kratosPostgres}
+
+	specs := map[stackItemID]containerbuilder.Spec{}
+	for id, builder := range stack.builders {
+		specs[id] = builder.Spec()
+	}
+
+	return &stackSpecs{specs: specs, dependencies: stack.dependencies, migrations: migrations}, nil
+}
+
+// StartContainers creates and starts the containers of the stack, the independent ones concurrently,
+// the containers started by it are removed if the stack fails to start
+func StartContainers(ctx context.Context, stack *dyrectorioStack) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	return startStack(ctx, cli, stack)
+}
+
+func startStack(ctx context.Context, cli client.APIClient, stack *dyrectorioStack) error {
+	started, err := startStackItems(ctx, cli, stack, startOrder)
+	if err != nil {
+		if ctx.Err() != nil {
+			log.Warn().Msg("Start of the stack is interrupted, removing the containers created by it")
 		}
+		rollbackContainers(ctx, cli, started)
+		return startFailure(err)
 	}
+
+	return nil
 }
 
-// StopContainers is a cleanup for "down" command, prefix can be provided with for multi removal
-func StopContainers(ctx context.Context, args *ArgsFlags) {
-	var prefixes string
+// rollbackContainers removes the containers in reverse order, so the stack is not left half started,
+// the failures are only logged, as the error of the start is the one to report
+func rollbackContainers(ctx context.Context, cli client.APIClient, names []string) {
+	// the rollback is done even if the start was interrupted
+	ctx = context.WithoutCancel(ctx)
 
-	if args.Prefix != "" {
-		prefixes = args.Prefix
-	} else {
-		prefixes = "dyo-stable"
+	for i := len(names) - 1; i >= 0; i-- {
+		err := cli.ContainerRemove(ctx, names[i], container.RemoveOptions{Force: true})
+		if err != nil {
+			log.Warn().Err(err).Str("container", names[i]).Msg("Failed to remove the container of the failed start")
+			continue
+		}
+
+		log.Info().Str("container", names[i]).Msg("Removed")
+	}
+}
+
+// startFailure logs the logs of a failed migration, the result is its cause instead of the whole error chain
+func startFailure(err error) error {
+	var migrationErr *MigrationError
+	if !errors.As(err, &migrationErr) {
+		return fmt.Errorf("failed to start dyrector.io stack: %w", err)
 	}
 
-	for _, prefix := range strings.Split(prefixes, ",") {
+	for _, line := range migrationErr.Logs {
+		log.Error().Str("container", migrationErr.Container).Msg(line)
+	}
+
+	event := log.Error().Str("container", migrationErr.Container).Int64("exitCode", migrationErr.ExitCode)
+	if migrationErr.Signal != "" {
+		event = event.Str("signal", migrationErr.Signal)
+	}
+	if migrationErr.OOMKilled {
+		event = event.Bool("oomKilled", true)
+	}
+	event.Msg("Migration failed")
+
+	return fmt.Errorf("migration failed: %s: %w", migrationErr.Cause(), migrationErr)
+}
+
+// StopContainers is a cleanup for "down" command, prefix can be provided with for multi removal
+func StopContainers(ctx context.Context, args *ArgsFlags, gracePeriods map[string]time.Duration) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	for _, prefix := range stackPrefixes(args) {
 		log.Info().Msgf("Removing prefix: %s", prefix)
-		err := dockerhelper.DeleteContainersByLabel(ctx, label.GetPrefixLabelFilter(prefix))
+		if err = stopStack(ctx, cli, prefix, gracePeriods); err != nil {
+			log.Warn().Err(err).Str("prefix", prefix).Msg("Failed to stop the stack in order, removing it anyway")
+		}
+
+		if args.RemoveVolumes {
+			if err = removeContainerVolumes(ctx, cli, prefix); err != nil {
+				return err
+			}
+		}
+
+		err = dockerhelper.DeleteContainersByLabel(ctx, label.GetPrefixLabelFilter(prefix))
 		if err != nil {
-			log.Fatal().Err(err).Msg("container delete error")
+			return fmt.Errorf("failed to delete the containers of %s: %w", prefix, err)
+		}
+
+		if args.RemoveVolumes {
+			log.Warn().Str("prefix", prefix).Msg("Removing the volumes of the stack, its data is lost")
+			if err = removeStackVolumes(ctx, cli, prefix, nil); err != nil {
+				return err
+			}
 		}
 	}
+
+	return nil
 }
 
 // EnsureNetworkExists makes sure the container network exists
-func EnsureNetworkExists(state *State) {
+func EnsureNetworkExists(ctx context.Context, state *State) error {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
-		log.Fatal().Err(err).Stack().Send()
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	// the default network of the runtime does not resolve the names of the containers
+	if state.SettingsFile.Network == defaultNetworks[state.ContainerRuntime] {
+		return fmt.Errorf("%w: %s is the default network of %s, the containers could not reach each other by name",
+			ErrInvalidNetwork, state.SettingsFile.Network, state.ContainerRuntime)
 	}
 
 	filter := filters.NewArgs()
 	filter.Add("name", fmt.Sprintf("^%s$", state.SettingsFile.Network))
 
-	networks, err := cli.NetworkList(context.Background(),
+	networks, err := cli.NetworkList(ctx,
 		types.NetworkListOptions{
 			Filters: filter,
 		})
 	if err != nil {
-		log.Fatal().Err(err).Stack().Send()
+		return fmt.Errorf("failed to list the networks: %w", err)
 	}
 
 	if len(networks) == 0 {
@@ -164,77 +477,118 @@ func EnsureNetworkExists(state *State) {
 			Driver: containerNetDriver,
 		}
 
-		resp, err := cli.NetworkCreate(context.Background(), state.SettingsFile.Network, opts)
-		log.Info().Str("id", resp.ID).Msg("Network created")
+		resp, err := cli.NetworkCreate(ctx, state.SettingsFile.Network, opts)
 		if err != nil {
-			log.Fatal().Err(err).Stack().Send()
+			return fmt.Errorf("failed to create the network %s: %w", state.SettingsFile.Network, err)
 		}
-		return
+		log.Info().Str("id", resp.ID).Msg("Network created")
+		return nil
 	}
 
 	for i := range networks {
 		if networks[i].Driver != containerNetDriver {
-			log.Fatal().
-				Str("network", state.SettingsFile.Network).
-				Str("driver", containerNetDriver).
-				Msg("network exists, but doesn't have the correct driver")
-		} else {
-			return
+			return fmt.Errorf("%w: %s doesn't have the %s driver", ErrInvalidNetwork, state.SettingsFile.Network, containerNetDriver)
 		}
 	}
 
-	log.Fatal().Msg("unknown network error")
+	return nil
 }
 
-func checkForBoundPorts(state *State, args *ArgsFlags) {
-	hasUnavailablePort := false
-
+// stackPorts are the ports the enabled services of the stack bind on the host, by their services
+func stackPorts(settings *SettingsFile, args *ArgsFlags) map[uint]string {
 	portServiceMap := map[uint]string{
-		state.SettingsFile.CruxPostgresPort:   "crux's Postgres",
-		state.SettingsFile.KratosPostgresPort: "kratos' Postgres",
-		state.SettingsFile.KratosPublicPort:   "kratos public",
-		state.SettingsFile.KratosAdminPort:    "kratos admin",
-		state.SettingsFile.MailSlurperUIPort:  "mailslurper SMTP",
-		state.SettingsFile.MailSlurperUIPort:  "mailslurper UI",
-		state.SettingsFile.MailSlurperAPIPort: "mailslurper API",
-		state.SettingsFile.TraefikWebPort:     "traefik proxy",
-		state.SettingsFile.TraefikUIPort:      "traefik dashboard",
+		settings.KratosPublicPort: "kratos public",
+		settings.KratosAdminPort:  "kratos admin",
+	}
+
+	if !settings.ExternalSMTP.Enabled() {
+		portServiceMap[settings.MailSlurperSMTPPort] = "mailslurper SMTP"
+		portServiceMap[settings.MailSlurperUIPort] = "mailslurper UI"
+		portServiceMap[settings.MailSlurperAPIPort] = "mailslurper API"
+	}
+
+	if !settings.CruxExternalPostgres.Enabled() {
+		portServiceMap[settings.CruxPostgresPort] = "crux's Postgres"
+	}
+	if !settings.KratosExternalPostgres.Enabled() {
+		portServiceMap[settings.KratosPostgresPort] = "kratos' Postgres"
+	}
+
+	if !settings.externalProxy() {
+		portServiceMap[settings.TraefikWebPort] = "traefik proxy"
+		portServiceMap[settings.TraefikUIPort] = "traefik dashboard"
+	}
+
+	if settings.TLS.Enabled() {
+		portServiceMap[settings.TraefikTLSPort] = "traefik TLS"
 	}
 
 	if !args.CruxDisabled {
-		portServiceMap[state.SettingsFile.CruxHTTPPort] = "crux HTTP"
-		portServiceMap[state.SettingsFile.CruxAgentGrpcPort] = "crux gRPC"
+		portServiceMap[settings.CruxHTTPPort] = "crux HTTP"
+		portServiceMap[settings.CruxAgentGrpcPort] = "crux gRPC"
 	}
 
 	if !args.CruxUIDisabled {
-		portServiceMap[state.SettingsFile.CruxUIPort] = "crux-ui HTTP"
+		portServiceMap[settings.CruxUIPort] = "crux-ui HTTP"
 	}
 
+	return portServiceMap
+}
+
+func checkForBoundPorts(state *State, args *ArgsFlags) error {
+	hasUnavailablePort := false
+
+	portServiceMap := stackPorts(&state.SettingsFile, args)
 	for portNum, service := range portServiceMap {
-		err := checkPort(portNum, service)
-		if err != nil {
+		if !nethelper.IsPortFree(portNum) {
+			log.Error().Str("service", service).Uint("port", portNum).Msg("Couldn't bind to port for the service")
 			hasUnavailablePort = true
 		}
 	}
 
 	if hasUnavailablePort {
-		log.Fatal().Msg(fmt.Sprintf("There's at least one port that is not available. See the configuration %s file for "+
-			"the necessary settings. Please change the ports of the mentioned services or make sure the necessary ports "+
-			"are available for use.", args.SettingsFilePath))
+		return fmt.Errorf("%w: see the configuration %s file for the necessary settings. Please change the ports of the "+
+			"mentioned services or make sure the necessary ports are available for use", ErrPortNotAvailable, args.SettingsFilePath)
 	}
+
+	return nil
 }
 
-func checkPort(portNum uint, servicePort string) error {
-	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", portNum))
-	if err != nil {
-		log.Error().Str("service", servicePort).Uint("port", portNum).Msg("Couldn't bind to port for the service")
-		return fmt.Errorf("can`t bind, %w", err)
+// checkTraefikTemplate fails early, instead of after the stack is half started
+func checkTraefikTemplate(state *State, args *ArgsFlags) error {
+	extraDir := traefikTemplatePath(state.SettingsFile.TraefikExtraConfigDir, args.SettingsFilePath)
+	if _, err := traefikExtraConfigFiles(extraDir); err != nil {
+		return fmt.Errorf("traefik extra configuration %s can't be used: %w", extraDir, err)
 	}
 
-	err = ln.Close()
+	templatePath := traefikTemplatePath(state.SettingsFile.TraefikConfigTemplate, args.SettingsFilePath)
+	if templatePath == "" {
+		return nil
+	}
+
+	if _, err := loadTraefikTemplate(templatePath); err != nil {
+		return fmt.Errorf("custom Traefik template %s can't be used: %w", templatePath, err)
+	}
+
+	log.Info().Str("path", templatePath).Msg("Using custom Traefik template")
+
+	return nil
+}
+
+func loadLockedImages(state *State, args *ArgsFlags) error {
+	lockPath := lockFilePath(args)
+
+	lock, err := readLockFile(lockPath)
 	if err != nil {
-		log.Error().Str("service", servicePort).Uint("port", portNum).Msg("Couldn't close the port for the service")
-		return fmt.Errorf("can`t close, %w", err)
+		return fmt.Errorf("locked mode needs a lockfile, run `dyo lock` first: %w", err)
+	}
+
+	if err = lock.verify(stackImages(state, args), state.SettingsFile.Version); err != nil {
+		return fmt.Errorf("%s: %w", lockPath, err)
 	}
+
+	state.LockedImages = lock.Images
+	log.Info().Str("path", lockPath).Msg("Using locked image digests")
+
 	return nil
 }
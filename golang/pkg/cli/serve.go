@@ -0,0 +1,461 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+)
+
+const (
+	ServeCommand = "serve"
+)
+
+const (
+	FlagServeListen = "listen"
+	FlagServeToken  = "token"
+)
+
+const (
+	defaultServeListen = "127.0.0.1:8855"
+	// serveTokenFileName is written next to the settings file when no token is given, so local tools can read it
+	serveTokenFileName     = "serve-token"
+	serveTokenBytes        = 32
+	serveReadHeaderTimeout = 10 * time.Second
+	defaultServeLogTail    = 100
+	// operationOutputLimit is the size of the kept output of an operation, the beginning is dropped above it
+	operationOutputLimit = 1 << 20
+)
+
+var (
+	ErrOperationRunning  = errors.New("an operation is already running")
+	ErrNoOperation       = errors.New("no operation was started yet")
+	ErrStackMemberAbsent = errors.New("no such container in the stack")
+)
+
+type operationState string
+
+const (
+	operationRunning   operationState = "running"
+	operationSucceeded operationState = "succeeded"
+	operationFailed    operationState = "failed"
+)
+
+// stackOperation is an up or down started over the API. It runs the dyo executable itself, so a fatal error
+// of the runner ends the operation, not the server.
+type stackOperation struct {
+	StartedAt  time.Time      `json:"startedAt"`
+	FinishedAt *time.Time     `json:"finishedAt,omitempty"`
+	Command    string         `json:"command"`
+	State      operationState `json:"state"`
+	Output     string         `json:"output"`
+	ID         int            `json:"id"`
+	ExitCode   int            `json:"exitCode"`
+}
+
+// operationOutput keeps the last operationOutputLimit bytes of the output of an operation
+type operationOutput struct {
+	buffer []byte
+	mutex  sync.Mutex
+}
+
+func (o *operationOutput) Write(p []byte) (int, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.buffer = append(o.buffer, p...)
+	if len(o.buffer) > operationOutputLimit {
+		o.buffer = slices.Clone(o.buffer[len(o.buffer)-operationOutputLimit:])
+	}
+
+	return len(p), nil
+}
+
+func (o *operationOutput) String() string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return string(o.buffer)
+}
+
+type stackServer struct {
+	docker       client.APIClient
+	current      *stackOperation
+	output       *operationOutput
+	executable   string
+	token        string
+	prefix       string
+	settingsPath string
+	args         []string
+	running      sync.WaitGroup
+	mutex        sync.Mutex
+}
+
+func newStackServer(docker client.APIClient, executable string, args []string, prefix, settingsPath, token string) *stackServer {
+	return &stackServer{
+		docker:       docker,
+		executable:   executable,
+		args:         args,
+		prefix:       prefix,
+		settingsPath: settingsPath,
+		token:        token,
+	}
+}
+
+func (s *stackServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/up", s.handleOperation(UpCommand))
+	mux.HandleFunc("/down", s.handleOperation(DownCommand))
+	mux.HandleFunc("/operation", s.handleCurrentOperation)
+	mux.HandleFunc("/status", s.handleStatus)
+	mux.HandleFunc("/logs/", s.handleLogs)
+
+	return s.authenticate(mux)
+}
+
+func (s *stackServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeJSONError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+
+	w.Header().Set("Allow", method)
+	writeJSONError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warn().Err(err).Msg("Failed to write the response")
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func (s *stackServer) handleOperation(command string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+
+		op, err := s.start(command)
+		switch {
+		case errors.Is(err, ErrOperationRunning):
+			writeJSONError(w, http.StatusConflict, err)
+		case err != nil:
+			writeJSONError(w, http.StatusInternalServerError, err)
+		default:
+			writeJSON(w, http.StatusAccepted, op)
+		}
+	}
+}
+
+func (s *stackServer) handleCurrentOperation(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	op := s.snapshot()
+	if op == nil {
+		writeJSONError(w, http.StatusNotFound, ErrNoOperation)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, op)
+}
+
+// start runs the command in the background, only one operation runs at a time
+func (s *stackServer) start(command string) (*stackOperation, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.current != nil && s.current.State == operationRunning {
+		return nil, fmt.Errorf("%w: %s", ErrOperationRunning, s.current.Command)
+	}
+
+	output := &operationOutput{}
+	//nolint:gosec // the executable is dyo itself, the command is one of the constants of the handlers
+	cmd := exec.Command(s.executable, append(slices.Clone(s.args), command)...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", command, err)
+	}
+
+	id := 1
+	if s.current != nil {
+		id = s.current.ID + 1
+	}
+	s.current = &stackOperation{ID: id, Command: command, State: operationRunning, StartedAt: time.Now()}
+	s.output = output
+	log.Info().Int("id", id).Str("command", command).Msg("Operation started")
+
+	s.running.Add(1)
+	go s.wait(s.current, cmd)
+
+	return s.snapshotLocked(), nil
+}
+
+func (s *stackServer) wait(op *stackOperation, cmd *exec.Cmd) {
+	defer s.running.Done()
+
+	err := cmd.Wait()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	finished := time.Now()
+	op.FinishedAt = &finished
+	op.ExitCode = cmd.ProcessState.ExitCode()
+	op.State = operationSucceeded
+	if err != nil {
+		op.State = operationFailed
+	}
+
+	log.Info().Int("id", op.ID).Str("command", op.Command).Str("state", string(op.State)).Msg("Operation finished")
+}
+
+func (s *stackServer) snapshot() *stackOperation {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.snapshotLocked()
+}
+
+func (s *stackServer) snapshotLocked() *stackOperation {
+	if s.current == nil {
+		return nil
+	}
+
+	op := *s.current
+	op.Output = s.output.String()
+	return &op
+}
+
+func (s *stackServer) stackMembers(ctx context.Context) ([]stackMemberStatus, error) {
+	return listStackMembers(ctx, s.docker, s.prefix)
+}
+
+func (s *stackServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	members, err := s.stackMembers(r.Context())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &stackStatus{Prefix: s.prefix, Containers: members})
+}
+
+// handleLogs returns the redacted logs of a container of the stack, eg. /logs/dyo-stable_crux?tail=100
+func (s *stackServer) handleLogs(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	tail := uint64(defaultServeLogTail)
+	if value := r.URL.Query().Get("tail"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, fmt.Errorf("invalid tail: %w", err))
+			return
+		}
+		tail = parsed
+	}
+
+	logs, err := s.memberLogs(r.Context(), strings.TrimPrefix(r.URL.Path, "/logs/"), uint(tail))
+	switch {
+	case errors.Is(err, ErrStackMemberAbsent):
+		writeJSONError(w, http.StatusNotFound, err)
+	case err != nil:
+		writeJSONError(w, http.StatusInternalServerError, err)
+	default:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if _, err = w.Write([]byte(logs)); err != nil {
+			log.Warn().Err(err).Msg("Failed to write the response")
+		}
+	}
+}
+
+// memberLogs only reads the logs of the containers of the stack, not any container of the host
+func (s *stackServer) memberLogs(ctx context.Context, name string, tail uint) (string, error) {
+	members, err := s.stackMembers(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.ContainsFunc(members, func(member stackMemberStatus) bool { return member.Name == name }) {
+		return "", fmt.Errorf("%w: %s", ErrStackMemberAbsent, name)
+	}
+
+	logs, err := containerLogs(ctx, s.docker, name, &bundleOptions{Tail: tail})
+	if err != nil {
+		return "", err
+	}
+
+	secrets := []string{}
+	if settings, settingsErr := readBundleSettings(s.settingsPath); settingsErr == nil {
+		secrets = settingsSecrets(settings)
+	}
+
+	return redactValues(logs, secrets), nil
+}
+
+// forwardedFlags are the global flags given to serve, the operations run with them
+func forwardedFlags(cCtx *ucli.Context, settingsPath string) []string {
+	args := []string{"--" + FlagConfigPath, settingsPath}
+	for _, flag := range cCtx.App.Flags {
+		name := flag.Names()[0]
+		if name == FlagConfigPath || name == FlagSudoHelper || !cCtx.IsSet(name) {
+			continue
+		}
+
+		args = append(args, fmt.Sprintf("--%s=%v", name, cCtx.Value(name)))
+	}
+
+	return args
+}
+
+// serveToken returns the given token, or generates one and writes it next to the settings file
+func serveToken(token, settingsPath string) (string, error) {
+	if token != "" {
+		return token, nil
+	}
+
+	random := make([]byte, serveTokenBytes)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	token = hex.EncodeToString(random)
+
+	tokenPath := filepath.Join(filepath.Dir(settingsPath), serveTokenFileName)
+	if err := os.MkdirAll(filepath.Dir(tokenPath), dirPerms); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(tokenPath, []byte(token), filePerms); err != nil {
+		return "", err
+	}
+
+	log.Info().Str("path", tokenPath).Msg("Generated the API token")
+	return token, nil
+}
+
+func isLoopback(listen string) bool {
+	host, _, err := net.SplitHostPort(listen)
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+	return host == "localhost" || (ip != nil && ip.IsLoopback())
+}
+
+func serve(cCtx *ucli.Context) error {
+	settingsPath, err := settingsLocation(cCtx)
+	if err != nil {
+		return err
+	}
+
+	token, err := serveToken(cCtx.String(FlagServeToken), settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to set up the API token: %w", err)
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	docker, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	listen := cCtx.String(FlagServeListen)
+	if !isLoopback(listen) {
+		log.Warn().Str("listen", listen).Msg("The API is reachable from other machines, only the token protects the stack")
+	}
+
+	stack := newStackServer(docker, executable, forwardedFlags(cCtx, settingsPath), cCtx.String(FlagPrefix), settingsPath, token)
+	server := &http.Server{Addr: listen, Handler: stack.handler(), ReadHeaderTimeout: serveReadHeaderTimeout}
+
+	ctx, stop := signal.NotifyContext(cCtx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		if shutdownErr := server.Shutdown(context.Background()); shutdownErr != nil {
+			log.Warn().Err(shutdownErr).Msg("Failed to shut down the API")
+		}
+	}()
+
+	log.Info().Str("listen", listen).Msg("Serving the stack API, press Ctrl+C to stop")
+	if err = server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	// an interrupted up or down would leave the stack half started, so it is allowed to finish
+	stack.running.Wait()
+	return nil
+}
+
+// GetServeCommand returns the command serving the operations of the stack over a local HTTP API
+func GetServeCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:  ServeCommand,
+		Usage: "Serve up, down, status and logs of the stack over an authenticated local HTTP API",
+		Description: "Endpoints: POST /up, POST /down, GET /operation, GET /status and GET /logs/<container>?tail=100. " +
+			"Requests are authenticated by the 'Authorization: Bearer <token>' header.",
+		Flags: []ucli.Flag{
+			&ucli.StringFlag{
+				Name:  FlagServeListen,
+				Value: defaultServeListen,
+				Usage: "address of the API, it is only reachable from this machine by default",
+			},
+			&ucli.StringFlag{
+				Name:    FlagServeToken,
+				Value:   "",
+				Usage:   "token of the API, it is generated into serve-token next to the settings file if empty",
+				EnvVars: []string{"DYO_SERVE_TOKEN"},
+			},
+		},
+		Action: serve,
+	}
+}
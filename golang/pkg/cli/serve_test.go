@@ -0,0 +1,156 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+const testServeToken = "test-token"
+
+func serveRequest(t *testing.T, handler http.Handler, method, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, http.NoBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeServeResponse(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	body := map[string]any{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+// shellHandler runs the operations with sh, the command of the operation is $0 of the script
+func shellHandler(docker *dockerfake.Client, script string) http.Handler {
+	return cli.NewStackServerHandler(docker, "sh", []string{"-c", script}, "dyo-test", "", testServeToken)
+}
+
+func waitForOperation(t *testing.T, handler http.Handler) map[string]any {
+	var body map[string]any
+	assert.Eventually(t, func() bool {
+		body = decodeServeResponse(t, serveRequest(t, handler, http.MethodGet, "/operation", testServeToken))
+		return body["state"] != "running"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return body
+}
+
+func TestServeRequiresToken(t *testing.T) {
+	handler := shellHandler(dockerfake.New(), "true")
+
+	assert.Equal(t, http.StatusUnauthorized, serveRequest(t, handler, http.MethodGet, "/status", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serveRequest(t, handler, http.MethodGet, "/status", "wrong").Code)
+	assert.Equal(t, http.StatusOK, serveRequest(t, handler, http.MethodGet, "/status", testServeToken).Code)
+}
+
+func TestServeRunsOperations(t *testing.T) {
+	handler := shellHandler(dockerfake.New(), "echo $0 done")
+
+	assert.Equal(t, http.StatusNotFound, serveRequest(t, handler, http.MethodGet, "/operation", testServeToken).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serveRequest(t, handler, http.MethodGet, "/up", testServeToken).Code)
+
+	rec := serveRequest(t, handler, http.MethodPost, "/up", testServeToken)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+
+	body := waitForOperation(t, handler)
+	assert.Equal(t, "succeeded", body["state"])
+	assert.Equal(t, "up done\n", body["output"])
+	assert.EqualValues(t, 1, body["id"])
+
+	assert.Equal(t, http.StatusAccepted, serveRequest(t, handler, http.MethodPost, "/down", testServeToken).Code)
+	body = waitForOperation(t, handler)
+	assert.Equal(t, "down", body["command"])
+	assert.EqualValues(t, 2, body["id"])
+}
+
+func TestServeRunsOneOperationAtATime(t *testing.T) {
+	handler := shellHandler(dockerfake.New(), "sleep 0.3; exit 2")
+
+	assert.Equal(t, http.StatusAccepted, serveRequest(t, handler, http.MethodPost, "/up", testServeToken).Code)
+	assert.Equal(t, http.StatusConflict, serveRequest(t, handler, http.MethodPost, "/down", testServeToken).Code)
+
+	body := waitForOperation(t, handler)
+	assert.Equal(t, "failed", body["state"])
+	assert.EqualValues(t, 2, body["exitCode"])
+}
+
+func TestServeStatusAndLogs(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+	docker.SetProcess("nginx:latest", dockerfake.Process{Stdout: "password=secret-value\n"})
+
+	for name, prefix := range map[string]string{"dyo-test_crux": "dyo-test", "other_crux": "other"} {
+		created, err := docker.ContainerCreate(ctx, &container.Config{
+			Image:  "nginx:latest",
+			Labels: map[string]string{label.DyrectorioOrg + label.ContainerPrefix: prefix},
+		}, nil, nil, nil, name)
+		assert.NoError(t, err)
+		assert.NoError(t, docker.ContainerStart(ctx, created.ID, container.StartOptions{}))
+	}
+
+	settingsPath := filepath.Join(t.TempDir(), cli.SettingsFileName)
+	assert.NoError(t, os.WriteFile(settingsPath, []byte("options:\n  crux-secret: secret-value\n"), 0o600))
+	handler := cli.NewStackServerHandler(docker, "sh", nil, "dyo-test", settingsPath, testServeToken)
+
+	rec := serveRequest(t, handler, http.MethodGet, "/status", testServeToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	containers := decodeServeResponse(t, rec)["containers"].([]any)
+	assert.Len(t, containers, 1)
+	assert.Equal(t, "dyo-test_crux", containers[0].(map[string]any)["name"])
+	assert.Equal(t, "healthy", containers[0].(map[string]any)["health"])
+
+	rec = serveRequest(t, handler, http.MethodGet, "/logs/dyo-test_crux?tail=10", testServeToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	logs, _ := io.ReadAll(rec.Body)
+	assert.True(t, strings.Contains(string(logs), "password=[REDACTED]"), string(logs))
+
+	assert.Equal(t, http.StatusNotFound, serveRequest(t, handler, http.MethodGet, "/logs/other_crux", testServeToken).Code)
+	assert.Equal(t, http.StatusBadRequest, serveRequest(t, handler, http.MethodGet, "/logs/dyo-test_crux?tail=x", testServeToken).Code)
+}
+
+func TestServeToken(t *testing.T) {
+	settingsPath := filepath.Join(t.TempDir(), cli.SettingsFileName)
+
+	token, err := cli.ServeToken("given", settingsPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "given", token)
+
+	token, err = cli.ServeToken("", settingsPath)
+	assert.NoError(t, err)
+	assert.Len(t, token, 64)
+
+	written, err := os.ReadFile(filepath.Join(filepath.Dir(settingsPath), "serve-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, token, string(written))
+}
+
+func TestIsLoopback(t *testing.T) {
+	assert.True(t, cli.IsLoopback("127.0.0.1:8855"))
+	assert.True(t, cli.IsLoopback("localhost:8855"))
+	assert.True(t, cli.IsLoopback("[::1]:8855"))
+	assert.False(t, cli.IsLoopback("0.0.0.0:8855"))
+	assert.False(t, cli.IsLoopback(":8855"))
+}
@@ -0,0 +1,302 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	ucli "github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// the settings are layered, the settings file is overridden by the environment variables, those by the --set flags,
+// the overrides are never written back to the settings file
+const (
+	FlagSettingsOverride = "set"
+	FlagResolved         = "resolved"
+)
+
+// settingsEnvPrefix is the prefix of the environment variables of the settings, eg. DYO_TRAEFIK_WEB_PORT
+const settingsEnvPrefix = "DYO_"
+
+// settingsEnvAliases are the keys set by the environment variables of the flags already, they keep those names
+var settingsEnvAliases = map[string]string{
+	"version":      "DYO_IMAGE_TAG",
+	"network-name": "DYO_NETWORK",
+	"prefix":       "PREFIX",
+}
+
+var ErrInvalidSettingsOverride = errors.New("invalid settings override, expected key=value")
+
+// settingsOverride is a value of the settings given by an environment variable or a flag instead of the settings file
+type settingsOverride struct {
+	key    string
+	value  string
+	source string
+}
+
+// settingsOverrideFlag collects the repeated --set flags, the values are not split at the commas,
+// so lists and maps can be given in YAML flow style
+type settingsOverrideFlag []string
+
+func (f *settingsOverrideFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func (f *settingsOverrideFlag) String() string {
+	return strings.Join(*f, " ")
+}
+
+func settingsOverrideFlags(cCtx *ucli.Context) []string {
+	if flag, ok := cCtx.Generic(FlagSettingsOverride).(*settingsOverrideFlag); ok {
+		return *flag
+	}
+
+	return nil
+}
+
+// settingsKeys are the key paths of the settings, the structs are walked into,
+// everything else is a single value, maps and lists included
+func settingsKeys(settingsType reflect.Type, parent string) []string {
+	keys := []string{}
+	for i := 0; i < settingsType.NumField(); i++ {
+		field := settingsType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := yamlName(&field)
+		if parent != "" {
+			key = parent + "." + key
+		}
+
+		if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Duration(0)) {
+			keys = append(keys, settingsKeys(field.Type, key)...)
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// settingsEnvName is the environment variable of the key, the options section is left out,
+// eg. DYO_TRAEFIK_WEB_PORT for options.traefikWebPort and DYO_TLS_DOMAIN for options.tls.domain
+func settingsEnvName(key string) string {
+	if alias, ok := settingsEnvAliases[key]; ok {
+		return alias
+	}
+
+	runes := []rune(strings.TrimPrefix(key, optionsSection+"."))
+
+	var sb strings.Builder
+	sb.WriteString(settingsEnvPrefix)
+	for i, r := range runes {
+		if r == '.' || r == '-' {
+			sb.WriteByte('_')
+			continue
+		}
+
+		// a new word starts after a lowercase letter, or at the last capital of an acronym, eg. SMTPPort
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) ||
+			unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+
+	return sb.String()
+}
+
+// envSettingsOverrides are the settings given by environment variables, the empty ones are ignored
+func envSettingsOverrides(lookup func(string) (string, bool)) []settingsOverride {
+	overrides := []settingsOverride{}
+	for _, key := range settingsKeys(reflect.TypeOf(SettingsFile{}), "") {
+		name := settingsEnvName(key)
+		if value, ok := lookup(name); ok && value != "" {
+			overrides = append(overrides, settingsOverride{key: key, value: value, source: name})
+		}
+	}
+
+	return overrides
+}
+
+// flagSettingsOverrides parses the key=value pairs of the --set flags
+func flagSettingsOverrides(values []string) ([]settingsOverride, error) {
+	overrides := []settingsOverride{}
+	for _, it := range values {
+		key, value, ok := strings.Cut(it, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSettingsOverride, it)
+		}
+
+		overrides = append(overrides, settingsOverride{key: key, value: value, source: "--" + FlagSettingsOverride})
+	}
+
+	return overrides, nil
+}
+
+// applySettingsOverrides applies the environment variables, then the flags over the settings,
+// the applied overrides are returned with the full key paths
+func applySettingsOverrides(settings *SettingsFile, args *ArgsFlags) ([]settingsOverride, error) {
+	flagOverrides, err := flagSettingsOverrides(args.SettingsOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := append(envSettingsOverrides(os.LookupEnv), flagOverrides...)
+	for i, it := range overrides {
+		field, err := resolveSettingsKey(settings, it.key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", it.source, err)
+		}
+
+		parsed, err := parseSettingsValue(field.valueType(), it.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidSettings, it.source, err)
+		}
+
+		field.set(parsed)
+		overrides[i].key = field.key
+	}
+
+	if len(overrides) == 0 {
+		return overrides, nil
+	}
+
+	return overrides, validateSettings(settings)
+}
+
+// layerSettings overrides the settings file of the state, the settings file is kept for saving
+func (s *State) layerSettings(settingsFile SettingsFile, args *ArgsFlags) error {
+	fileSettings, err := copySettings(&settingsFile)
+	if err != nil {
+		return err
+	}
+
+	overrides, err := applySettingsOverrides(&settingsFile, args)
+	if err != nil {
+		return err
+	}
+
+	s.SettingsFile = settingsFile
+	s.fileSettings = fileSettings
+	s.settingsOverrides = overrides
+
+	return nil
+}
+
+// withoutSettingsOverride drops the override of the key, for the values given by the flags persisting them
+func withoutSettingsOverride(overrides []settingsOverride, key string) []settingsOverride {
+	kept := []settingsOverride{}
+	for _, it := range overrides {
+		if it.key != key {
+			kept = append(kept, it)
+		}
+	}
+
+	return kept
+}
+
+// copySettings is a deep copy of the settings, so the maps are not shared
+func copySettings(settings *SettingsFile) (*SettingsFile, error) {
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := &SettingsFile{}
+	if err = yaml.Unmarshal(data, copied); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
+// savedSettings are the settings to write, the overridden keys keep their values of the settings file
+func savedSettings(state *State) (*SettingsFile, error) {
+	if len(state.settingsOverrides) == 0 || state.fileSettings == nil {
+		return &state.SettingsFile, nil
+	}
+
+	saved, err := copySettings(&state.SettingsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, it := range state.settingsOverrides {
+		field, err := resolveSettingsKey(saved, it.key)
+		if err != nil {
+			return nil, err
+		}
+		fileField, err := resolveSettingsKey(state.fileSettings, it.key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := fileField.get()
+		if errors.Is(err, ErrSettingsKeyNotSet) {
+			field.value.SetMapIndex(*field.mapKey, reflect.Value{})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		field.set(value)
+	}
+
+	return saved, nil
+}
+
+// resolvedSettingsYAML is the effective settings, the overridden values are commented with their source
+func resolvedSettingsYAML(args *ArgsFlags) (string, error) {
+	settings := readSettingsLayer(args)
+	overrides, err := applySettingsOverrides(settings, args)
+	if err != nil {
+		return "", err
+	}
+
+	doc := &yaml.Node{}
+	if err = doc.Encode(settings); err != nil {
+		return "", err
+	}
+
+	for _, it := range overrides {
+		if key := settingsKeyNode(doc, it.key); key != nil {
+			key.LineComment = it.source
+		}
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// settingsKeyNode finds the key node of the key path in the encoded settings
+func settingsKeyNode(node *yaml.Node, key string) *yaml.Node {
+	var found *yaml.Node
+	for _, segment := range strings.Split(key, ".") {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		current := node
+		node, found = nil, nil
+		for i := 0; i+1 < len(current.Content); i += 2 {
+			if current.Content[i].Value == segment {
+				found, node = current.Content[i], current.Content[i+1]
+				break
+			}
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,108 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func layeredArgs(t *testing.T, content string, overrides ...string) *cli.ArgsFlags {
+	t.Helper()
+
+	return &cli.ArgsFlags{SettingsFilePath: writeTestSettings(t, content), SettingsExists: true, SettingsOverrides: overrides}
+}
+
+func TestSettingsEnvName(t *testing.T) {
+	assert.Equal(t, "DYO_IMAGE_TAG", cli.SettingsEnvName("version"))
+	assert.Equal(t, "DYO_NETWORK", cli.SettingsEnvName("network-name"))
+	assert.Equal(t, "PREFIX", cli.SettingsEnvName("prefix"))
+	assert.Equal(t, "DYO_TRAEFIK_WEB_PORT", cli.SettingsEnvName("options.traefikWebPort"))
+	assert.Equal(t, "DYO_CRUX_UI_PORT", cli.SettingsEnvName("options.crux-ui-port"))
+	assert.Equal(t, "DYO_MAIL_SLURPER_SMTP_PORT", cli.SettingsEnvName("options.mailSlurperSMTPPort"))
+	assert.Equal(t, "DYO_EXTERNAL_URL", cli.SettingsEnvName("options.externalURL"))
+	assert.Equal(t, "DYO_TLS_DOMAIN", cli.SettingsEnvName("options.tls.domain"))
+}
+
+func TestSettingsEnvNamesAreUnique(t *testing.T) {
+	names := cli.SettingsEnvNames()
+	seen := map[string]bool{}
+	for _, it := range names {
+		assert.False(t, seen[it], it)
+		seen[it] = true
+	}
+
+	// the environment variables of the other flags are not taken by the settings, the ones of the flags
+	// setting the same keys are shared
+	for _, it := range []string{"DYO_CONFIG", "DYO_OUTPUT", "DYO_RUNTIME", "DYO_LOCKED"} {
+		assert.False(t, seen[it], it)
+	}
+	for _, it := range []string{"DYO_VERSION", "DYO_NETWORK_NAME", "DYO_PREFIX"} {
+		assert.False(t, seen[it], it)
+	}
+}
+
+func TestSettingsOverridePrecedence(t *testing.T) {
+	t.Setenv("DYO_TRAEFIK_WEB_PORT", "9000")
+	t.Setenv("DYO_CRUX_UI_PORT", "9001")
+	t.Setenv("DYO_TLS_DOMAIN", "dyo.test")
+	t.Setenv("DYO_IMAGE_TAG", "")
+
+	args := layeredArgs(t, "version: latest\noptions:\n  traefikWebPort: 8001\n", "traefikWebPort=9100", "stopGracePeriods.crux=45s")
+	effective, saved, err := cli.LayeredSettings(args)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "latest", effective.Version)
+	assert.Equal(t, uint(9100), effective.TraefikWebPort)
+	assert.Equal(t, uint(9001), effective.CruxUIPort)
+	assert.Equal(t, "dyo.test", effective.TLS.Domain)
+	assert.Equal(t, 45*time.Second, effective.StopGracePeriods["crux"])
+
+	assert.Equal(t, uint(8001), saved.TraefikWebPort)
+	assert.Equal(t, uint(3000), saved.CruxUIPort)
+	assert.Empty(t, saved.TLS.Domain)
+	assert.NotContains(t, saved.StopGracePeriods, "crux")
+
+	assert.Equal(t, effective, cli.ReadExistingSettings(args))
+}
+
+func TestOverriddenSecretIsGeneratedForSettingsFile(t *testing.T) {
+	t.Setenv("DYO_CRUX_SECRET", "from-env")
+
+	effective, saved, err := cli.DefaultedSettings(layeredArgs(t, "prefix: dyo-test\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "from-env", effective.CruxSecret)
+	assert.NotEmpty(t, saved.CruxSecret)
+	assert.NotEqual(t, "from-env", saved.CruxSecret)
+	assert.Equal(t, effective.CruxPostgresPassword, saved.CruxPostgresPassword)
+}
+
+func TestSettingsOverrideErrors(t *testing.T) {
+	_, _, err := cli.LayeredSettings(layeredArgs(t, "prefix: dyo-test\n", "traefikWebPort"))
+	assert.ErrorIs(t, err, cli.ErrInvalidSettingsOverride)
+
+	_, _, err = cli.LayeredSettings(layeredArgs(t, "prefix: dyo-test\n", "unknown=1"))
+	assert.ErrorIs(t, err, cli.ErrSettingsKeyNotFound)
+
+	_, _, err = cli.LayeredSettings(layeredArgs(t, "prefix: dyo-test\n", "crux-ui-port=8000"))
+	assert.ErrorIs(t, err, cli.ErrInvalidSettings)
+
+	t.Setenv("DYO_CRUX_UI_PORT", "ui")
+	_, _, err = cli.LayeredSettings(layeredArgs(t, "prefix: dyo-test\n"))
+	assert.ErrorContains(t, err, "DYO_CRUX_UI_PORT")
+}
+
+func TestResolvedSettingsYAML(t *testing.T) {
+	t.Setenv("DYO_NETWORK", "ci")
+
+	out, err := cli.ResolvedSettingsYAML(layeredArgs(t, "prefix: dyo-test\n", "tls.domain=dyo.test"))
+	assert.NoError(t, err)
+	assert.Contains(t, out, "network-name: ci # DYO_NETWORK\n")
+	assert.Contains(t, out, "domain: dyo.test # --set\n")
+	assert.Contains(t, out, "prefix: dyo-test\n")
+}
@@ -0,0 +1,288 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+
+	nethelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
+)
+
+// SettingsCommand shares the settings of a stack between machines
+const SettingsCommand = "settings"
+
+const (
+	FlagSettingsTemplate = "template"
+	FlagSettingsOutput   = "output"
+	FlagSettingsForce    = "force"
+)
+
+var (
+	ErrSettingsExist    = errors.New("settings file already exists, use --force to replace it")
+	ErrNoPortAvailable  = errors.New("no free port is available")
+	ErrInvalidTemplate  = errors.New("invalid settings template")
+	errTemplateKeyShape = errors.New("not a map")
+)
+
+// credentialSettings are given by the users of the template on their own machines
+var credentialSettings = []string{
+	"options.cruxExternalPostgres.password", "options.kratosExternalPostgres.password", "options.externalSMTP.password",
+	"options.notifications.slackWebhook", "options.notifications.webhooks",
+}
+
+// isPortSetting tells if the field of the options is a port bound on the host
+func isPortSetting(field *reflect.StructField) bool {
+	return field.Type.Kind() == reflect.Uint && strings.HasSuffix(field.Name, "Port")
+}
+
+// machineSettings are the keys left out of the templates, the ports are chosen and the secrets are generated
+// on every machine
+func machineSettings() []string {
+	keys := []string{}
+
+	options := reflect.TypeOf(Options{})
+	for i := 0; i < options.NumField(); i++ {
+		field := options.Field(i)
+		if isPortSetting(&field) {
+			keys = append(keys, optionsSection+"."+yamlName(&field))
+		}
+	}
+
+	keys = append(keys, generatedSettings...)
+	return append(keys, credentialSettings...)
+}
+
+// deleteSettingsKey removes the value of the key path from the document, the missing keys are skipped
+func deleteSettingsKey(doc map[string]any, key string) error {
+	segments := strings.Split(key, ".")
+	current := doc
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment]
+		if !ok {
+			return nil
+		}
+
+		current, ok = next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: %w", key, errTemplateKeyShape)
+		}
+	}
+	delete(current, segments[len(segments)-1])
+
+	return nil
+}
+
+// settingsTemplate is the settings file without the machine specific values, they get their defaults or are
+// generated when the template is imported
+func settingsTemplate(settings *SettingsFile) ([]byte, error) {
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := map[string]any{}
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	for _, key := range machineSettings() {
+		if err = deleteSettingsKey(doc, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return yaml.Marshal(doc)
+}
+
+// materializePorts moves the ports bound on the machine or taken by an other setting to the next free one
+func materializePorts(settings *SettingsFile, isPortFree func(uint) bool) error {
+	taken := map[uint]bool{}
+
+	options := reflect.ValueOf(&settings.Options).Elem()
+	for i := 0; i < options.NumField(); i++ {
+		field := options.Type().Field(i)
+		if !isPortSetting(&field) {
+			continue
+		}
+
+		wanted := uint(options.Field(i).Uint())
+		port := wanted
+		for taken[port] || !isPortFree(port) {
+			if port >= math.MaxUint16 {
+				return fmt.Errorf("%w: %s from %d", ErrNoPortAvailable, yamlName(&field), wanted)
+			}
+			port++
+		}
+
+		if port != wanted {
+			log.Info().Str("key", yamlName(&field)).Uint("port", port).Uint("default", wanted).
+				Msg("The port is not available on this machine, the next free one is used")
+			options.Field(i).SetUint(uint64(port))
+		}
+		taken[port] = true
+	}
+
+	return nil
+}
+
+// importSettingsTemplate reads the template, the missing values get their defaults, then the secrets are generated
+// and the ports are moved if they are not available on this machine
+func importSettingsTemplate(templatePath string, isPortFree func(uint) bool) (*SettingsFile, error) {
+	settings := &SettingsFile{}
+	if err := cleanenv.ReadConfig(templatePath, settings); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	generateMissingSecrets(settings)
+	if err := materializePorts(settings, isPortFree); err != nil {
+		return nil, err
+	}
+
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	warnMissingCredentials(settings)
+
+	return settings, nil
+}
+
+// warnMissingCredentials lists the external services of the template which need the credentials of this machine
+func warnMissingCredentials(settings *SettingsFile) {
+	services := []struct {
+		validate func() error
+		key      string
+	}{
+		{key: "cruxExternalPostgres.password", validate: settings.CruxExternalPostgres.Validate},
+		{key: "kratosExternalPostgres.password", validate: settings.KratosExternalPostgres.Validate},
+		{key: "externalSMTP.password", validate: settings.ExternalSMTP.Validate},
+	}
+
+	for _, it := range services {
+		if err := it.validate(); err != nil {
+			log.Warn().Err(err).Str("key", it.key).Msgf("Set it with dyo config set %s before starting the stack", it.key)
+		}
+	}
+}
+
+func exportSettings(cCtx *ucli.Context) error {
+	settingsPath, err := settingsLocation(cCtx)
+	if err != nil {
+		return err
+	}
+
+	settings, err := readSettingsFile(settingsPath)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if cCtx.Bool(FlagSettingsTemplate) {
+		data, err = settingsTemplate(settings)
+	} else {
+		data, err = yaml.Marshal(settings)
+	}
+	if err != nil {
+		return err
+	}
+
+	output := cCtx.String(FlagSettingsOutput)
+	if output == "" {
+		//nolint:forbidigo
+		fmt.Print(string(data))
+		return nil
+	}
+
+	return os.WriteFile(output, data, filePerms)
+}
+
+func importSettings(cCtx *ucli.Context) error {
+	if cCtx.NArg() != 1 {
+		return errors.New("usage: dyo settings import <file>")
+	}
+
+	settingsPath, err := settingsLocation(cCtx)
+	if err != nil {
+		return err
+	}
+	exists, err := SettingsExists(settingsPath)
+	if err != nil {
+		return err
+	}
+	if exists && !cCtx.Bool(FlagSettingsForce) {
+		return fmt.Errorf("%w: %s", ErrSettingsExist, settingsPath)
+	}
+
+	settings, err := importSettingsTemplate(cCtx.Args().First(), nethelper.IsPortFree)
+	if err != nil {
+		return err
+	}
+
+	if cCtx.IsSet(FlagPrefix) {
+		settings.Prefix = cCtx.String(FlagPrefix)
+		settings.Network = settings.Prefix
+	}
+
+	if err = os.MkdirAll(path.Dir(settingsPath), dirPerms); err != nil {
+		return err
+	}
+	if err = writeSettingsFile(settingsPath, settings); err != nil {
+		return err
+	}
+
+	log.Info().Str("path", settingsPath).Msg("Settings imported, start the stack with dyo up")
+	return nil
+}
+
+// GetSettingsCommand returns the export and import subcommands of the settings file
+func GetSettingsCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:   SettingsCommand,
+		Action: ucli.ShowSubcommandHelp,
+		Usage:  "dyo settings export|import",
+		Description: "Templates are the settings without the ports, the generated secrets and the credentials, " +
+			"so a team can start the same stack on every machine.",
+		Subcommands: []*ucli.Command{
+			{
+				Name:  "export",
+				Usage: "Print the settings file, or write it to a file",
+				Flags: []ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagSettingsTemplate,
+						Value: false,
+						Usage: "leave out the ports, the generated secrets and the credentials",
+					},
+					&ucli.StringFlag{
+						Name:    FlagSettingsOutput,
+						Aliases: []string{"o"},
+						Value:   "",
+						Usage:   "path of the written file instead of the standard output",
+					},
+				},
+				Action: exportSettings,
+			},
+			{
+				Name:      "import",
+				Usage:     "Create the settings file from a template, the secrets are generated and the busy ports are moved",
+				ArgsUsage: "<file>",
+				Flags: []ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagSettingsForce,
+						Value: false,
+						Usage: "replace the existing settings file, the existing databases keep the old secrets",
+					},
+				},
+				Action: importSettings,
+			},
+		},
+	}
+}
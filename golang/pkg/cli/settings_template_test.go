@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func allPortsFree(uint) bool {
+	return true
+}
+
+func TestSettingsTemplateStripsMachineSettings(t *testing.T) {
+	settings, err := cli.ReadSettingsFile(writeTestSettings(t, `
+prefix: team
+options:
+  crux-secret: secret
+  cruxPostgresPassword: password
+  crux-ui-port: 3100
+  timezone: Europe/Budapest
+  cruxExternalPostgres:
+    host: db.example.com
+    password: db-password
+  notifications:
+    slackWebhook: https://hooks.slack.com/services/secret
+`))
+	assert.NoError(t, err)
+
+	data, err := cli.SettingsTemplate(settings)
+	assert.NoError(t, err)
+
+	template := string(data)
+	for _, value := range []string{"secret", "password", "3100", "crux-ui-port", "traefikWebPort", "hooks.slack.com"} {
+		assert.NotContains(t, template, value)
+	}
+
+	doc := map[string]any{}
+	assert.NoError(t, yaml.Unmarshal(data, &doc))
+	assert.Equal(t, "team", doc["prefix"])
+	options := doc["options"].(map[string]any)
+	assert.Equal(t, "Europe/Budapest", options["timezone"])
+	assert.Equal(t, "db.example.com", options["cruxExternalPostgres"].(map[string]any)["host"])
+}
+
+func TestImportSettingsTemplate(t *testing.T) {
+	settings, err := cli.ReadSettingsFile(writeTestSettings(t, "prefix: team\noptions:\n  crux-secret: secret\n  crux-ui-port: 3100\n"))
+	assert.NoError(t, err)
+	data, err := cli.SettingsTemplate(settings)
+	assert.NoError(t, err)
+
+	templatePath := filepath.Join(t.TempDir(), "template.yaml")
+	assert.NoError(t, os.WriteFile(templatePath, data, 0o600))
+
+	imported, err := cli.ImportSettingsTemplate(templatePath, allPortsFree)
+	assert.NoError(t, err)
+	assert.Equal(t, "team", imported.Prefix)
+	assert.Equal(t, uint(3000), imported.CruxUIPort)
+	assert.NotEmpty(t, imported.CruxSecret)
+	assert.NotEqual(t, "secret", imported.CruxSecret)
+	assert.NotEmpty(t, imported.CruxEncryptionKey)
+	assert.NotEmpty(t, imported.KratosPostgresPassword)
+}
+
+func TestImportSettingsTemplateMovesBusyPorts(t *testing.T) {
+	// the default UI port and the next one are bound, the default of traefik is taken by the UI port in the template
+	busy := map[uint]bool{3000: true, 3001: true}
+	templatePath := writeTestSettings(t, "options:\n  traefikWebPort: 3002\n")
+
+	imported, err := cli.ImportSettingsTemplate(templatePath, func(port uint) bool { return !busy[port] })
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3002), imported.TraefikWebPort)
+	assert.Equal(t, uint(3003), imported.CruxUIPort)
+}
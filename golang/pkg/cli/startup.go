@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	nethelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/net"
+)
+
+const defaultStartTimeout = healhProbeTimeout
+
+var ErrStackItemUnhealthy = errors.New("container is unhealthy")
+
+// readinessProbe is waited for after the health check of the container, the dependents of the item need the service
+// to accept connections, which the postgres and kratos images do not tell with a health check
+type readinessProbe struct {
+	address string
+	http    bool
+}
+
+func (p readinessProbe) wait(ctx context.Context) error {
+	backoff := nethelper.Backoff{Initial: healhProbeInterval, Max: healthProbeMaxInterval}
+	if p.http {
+		return nethelper.WaitForHTTP(ctx, p.address, backoff)
+	}
+
+	return nethelper.WaitForTCP(ctx, p.address, backoff)
+}
+
+// readinessProbes are the probes of the items by their addresses reachable from dyo, the container names on the network
+// if it is fully containerized, the published ports otherwise
+func readinessProbes(state *State, args *ArgsFlags) map[stackItemID]readinessProbe {
+	address := func(name string, port, published uint) string {
+		if args.FullyContainerized {
+			return fmt.Sprintf("%s:%d", name, port)
+		}
+
+		return fmt.Sprintf("%s:%d", localhost, published)
+	}
+
+	settings := &state.SettingsFile
+	probes := map[stackItemID]readinessProbe{
+		cruxPostgres: {
+			address: address(state.Containers.CruxPostgres.Name, defaultPostgresPort, settings.CruxPostgresPort),
+		},
+		kratosPostgres: {
+			address: address(state.Containers.KratosPostgres.Name, defaultPostgresPort, settings.KratosPostgresPort),
+		},
+		kratos: {
+			address: "http://" + address(state.Containers.Kratos.Name, defaultKratosAdminPort, settings.KratosAdminPort) +
+				"/admin/health/ready",
+			http: true,
+		},
+	}
+	if !args.CruxDisabled {
+		probes[crux] = readinessProbe{
+			address: "http://" + address(state.Containers.Crux.Name, defaultCruxHTTPPort, settings.CruxHTTPPort) + "/api/health",
+			http:    true,
+		}
+	}
+
+	return probes
+}
+
+// startTimeout is how long the item has to become ready after it is started
+func startTimeout(timeouts map[string]time.Duration, id stackItemID) time.Duration {
+	if timeout, ok := timeouts[string(id)]; ok {
+		return timeout
+	}
+
+	return defaultStartTimeout
+}
+
+// startDependencies are the items which have to be ready before the item is started, the migrations are run
+// by the services before they start, the items not depending on each other are started concurrently
+func startDependencies(args *ArgsFlags) map[stackItemID][]stackItemID {
+	dependencies := map[stackItemID][]stackItemID{
+		kratos: {kratosPostgres},
+		crux:   {cruxPostgres},
+		cruxUI: {crux},
+	}
+	if args.FullyContainerized {
+		// traefik probes the route of the UI after it starts
+		dependencies[traefik] = []stackItemID{cruxUI}
+	}
+
+	return dependencies
+}
+
+// startStackItems starts the items once their dependencies among them are ready, the dependents of a failed
+// item are not started, the first failure is returned after the others are done with the names of the started containers
+func startStackItems(ctx context.Context, cli client.APIClient, stack *dyrectorioStack, items []stackItemID) ([]string, error) {
+	ready := map[stackItemID]chan struct{}{}
+	for _, id := range items {
+		if _, ok := stack.builders[id]; ok {
+			ready[id] = make(chan struct{})
+		}
+	}
+
+	var dashboard *startupDashboard
+	if stack.dashboardOut != nil {
+		ids := []stackItemID{}
+		for _, id := range items {
+			if _, ok := ready[id]; ok {
+				ids = append(ids, id)
+			}
+		}
+		dashboard = newStartupDashboard(stack.dashboardOut, ids)
+		dashboard.start()
+		defer dashboard.close()
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	failed := map[stackItemID]bool{}
+	started := []string{}
+	var startErr error
+
+	for id := range ready {
+		wg.Add(1)
+		go func(id stackItemID) {
+			defer wg.Done()
+			defer close(ready[id])
+
+			for _, dependency := range stack.dependencies[id] {
+				if dependencyReady, ok := ready[dependency]; ok {
+					dashboard.set(id, phasePulled, fmt.Sprintf("waiting for %s", dependency))
+					<-dependencyReady
+				}
+
+				mutex.Lock()
+				dependencyFailed := failed[dependency]
+				if dependencyFailed {
+					failed[id] = true
+				}
+				mutex.Unlock()
+
+				if dependencyFailed {
+					dashboard.set(id, phaseSkipped, fmt.Sprintf("%s failed", dependency))
+					log.Warn().Str("container", string(id)).Str("dependency", string(dependency)).Msg("Not started, the dependency failed")
+					return
+				}
+			}
+
+			name, err := startStackItem(ctx, cli, stack, id, dashboard)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if name != "" {
+				started = append(started, name)
+			}
+			if err == nil {
+				return
+			}
+
+			dashboard.set(id, phaseFailed, err.Error())
+			log.Error().Str("container", string(id)).Msg("Failed to start dyrector.io stack")
+			failed[id] = true
+			if startErr == nil {
+				startErr = err
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	return started, startErr
+}
+
+// startStackItem is the name of the container with the failure, if it was created before the failure
+func startStackItem(ctx context.Context, cli client.APIClient, stack *dyrectorioStack, id stackItemID,
+	dashboard *startupDashboard,
+) (string, error) {
+	begin := time.Now()
+
+	cont, err := stack.builders[id].Create()
+	if err != nil {
+		return "", err
+	}
+	dashboard.set(id, phaseCreated, "starting")
+
+	if err = cont.Start(ctx, cli); err != nil {
+		return cont.GetName(), err
+	}
+	containerID := *cont.GetContainerID()
+	dashboard.set(id, phaseRunning, "waiting for the health check")
+
+	timeout := startTimeout(stack.startTimeouts, id)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err = waitUntilHealthy(ctx, cli, containerID); err != nil {
+		return cont.GetName(), fmt.Errorf("failed to wait for %s: %w", cont.GetName(), err)
+	}
+
+	if probe, ok := stack.readiness[id]; ok {
+		log.Debug().Str("container", cont.GetName()).Str("address", probe.address).Msg("Waiting for readiness")
+		dashboard.set(id, phaseRunning, fmt.Sprintf("waiting for %s", probe.address))
+
+		if err = probe.wait(ctx); err != nil {
+			return cont.GetName(), fmt.Errorf("%s is not ready in %s at %s: %w", cont.GetName(), timeout, probe.address, err)
+		}
+	}
+
+	if dashboard == nil {
+		log.Info().Str("container", cont.GetName()).Msg("Started")
+	}
+	dashboard.set(id, phaseHealthy, fmt.Sprintf("in %s", time.Since(begin).Round(time.Second)))
+
+	return cont.GetName(), nil
+}
+
+// waitUntilHealthy waits while the health check of the container is starting,
+// the containers without a health check are ready once they are started
+func waitUntilHealthy(ctx context.Context, cli client.APIClient, id string) error {
+	for {
+		inspect, err := cli.ContainerInspect(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		health := inspect.State.Health
+		switch {
+		case health == nil || health.Status == types.Healthy || health.Status == types.NoHealthcheck:
+			return nil
+		case health.Status == types.Unhealthy:
+			return ErrStackItemUnhealthy
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("health check is still starting: %w", ctx.Err())
+		case <-time.After(healhProbeInterval):
+		}
+	}
+}
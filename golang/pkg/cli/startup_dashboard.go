@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// phases of the items on the startup dashboard, the images are pulled before the items are started
+type startPhase string
+
+const (
+	phasePulled  startPhase = "pulled"
+	phaseCreated startPhase = "created"
+	phaseRunning startPhase = "running"
+	phaseHealthy startPhase = "healthy"
+	phaseFailed  startPhase = "failed"
+	phaseSkipped startPhase = "skipped"
+)
+
+const (
+	spinnerInterval      = 100 * time.Millisecond
+	dashboardIDWidth     = 16
+	dashboardDetailWidth = 60
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+type dashboardRow struct {
+	since  time.Time
+	id     stackItemID
+	phase  startPhase
+	detail string
+}
+
+func (r *dashboardRow) finished() bool {
+	return r.phase == phaseHealthy || r.phase == phaseFailed || r.phase == phaseSkipped
+}
+
+// startupDashboard renders a line per item of the stack in place while they are started, the logs are written
+// above it, so they do not break the lines
+type startupDashboard struct {
+	out      io.Writer
+	stop     chan struct{}
+	stopped  chan struct{}
+	rows     []*dashboardRow
+	logger   zerolog.Logger
+	rendered int
+	frame    int
+	mu       sync.Mutex
+}
+
+func newStartupDashboard(out io.Writer, items []stackItemID) *startupDashboard {
+	now := time.Now()
+	d := &startupDashboard{out: out}
+	for _, id := range items {
+		d.rows = append(d.rows, &dashboardRow{id: id, phase: phasePulled, since: now})
+	}
+
+	return d
+}
+
+// start renders the dashboard until it is closed, the logs are written through it meanwhile
+func (d *startupDashboard) start() {
+	d.logger = log.Logger
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: d})
+
+	d.stop = make(chan struct{})
+	d.stopped = make(chan struct{})
+	go func() {
+		defer close(d.stopped)
+
+		ticker := time.NewTicker(spinnerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.stop:
+				return
+			case <-ticker.C:
+				d.mu.Lock()
+				d.frame++
+				d.render()
+				d.mu.Unlock()
+			}
+		}
+	}()
+}
+
+// close renders the final state of the items and gives the logs back to the logger
+func (d *startupDashboard) close() {
+	close(d.stop)
+	<-d.stopped
+
+	d.mu.Lock()
+	d.render()
+	d.mu.Unlock()
+
+	log.Logger = d.logger
+}
+
+// set moves the item to the phase, it is a no-op without a dashboard
+func (d *startupDashboard) set(id stackItemID, phase startPhase, detail string) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, row := range d.rows {
+		if row.id == id {
+			if row.phase != phase {
+				row.since = time.Now()
+			}
+			row.phase = phase
+			row.detail = detail
+		}
+	}
+	d.render()
+}
+
+// Write writes the log line above the dashboard, then renders the dashboard again below it
+func (d *startupDashboard) Write(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.rendered > 0 {
+		if _, err := fmt.Fprintf(d.out, "\033[%dA\033[J", d.rendered); err != nil {
+			return 0, err
+		}
+		d.rendered = 0
+	}
+
+	n, err := d.out.Write(p)
+	if err != nil {
+		return n, err
+	}
+	d.render()
+
+	return n, nil
+}
+
+func (d *startupDashboard) lines(now time.Time) []string {
+	lines := []string{}
+	for _, row := range d.rows {
+		icon := spinnerFrames[d.frame%len(spinnerFrames)]
+		switch row.phase {
+		case phaseHealthy:
+			icon = "✓"
+		case phaseFailed:
+			icon = "✗"
+		case phaseSkipped:
+			icon = "-"
+		}
+
+		elapsed := ""
+		if !row.finished() {
+			elapsed = now.Sub(row.since).Round(time.Second).String()
+		}
+
+		detail := []rune(row.detail)
+		if len(detail) > dashboardDetailWidth {
+			detail = append(detail[:dashboardDetailWidth-1], '…')
+		}
+
+		lines = append(lines, strings.TrimRight(fmt.Sprintf("%s %-*s %-8s %5s %s",
+			icon, dashboardIDWidth, row.id, row.phase, elapsed, string(detail)), " "))
+	}
+
+	return lines
+}
+
+// render redraws every line in place, the caller must hold the lock
+func (d *startupDashboard) render() {
+	var sb strings.Builder
+	if d.rendered > 0 {
+		fmt.Fprintf(&sb, "\033[%dA", d.rendered)
+	}
+	for _, line := range d.lines(time.Now()) {
+		sb.WriteString("\033[2K")
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	d.rendered = len(d.rows)
+
+	if _, err := io.WriteString(d.out, sb.String()); err != nil {
+		d.logger.Trace().Err(err).Msg("Failed to render the startup dashboard")
+	}
+}
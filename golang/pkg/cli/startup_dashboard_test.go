@@ -0,0 +1,85 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+// finalDashboard is the last render of the dashboard, the renders start with moving the cursor up
+func finalDashboard(output string) []string {
+	renders := strings.Split(output, "\033[2K")
+	last := renders[len(renders)-len(stackItems):]
+
+	lines := []string{}
+	for _, it := range last {
+		lines = append(lines, strings.TrimSpace(strings.Split(it, "\n")[0]))
+	}
+
+	return lines
+}
+
+func TestStartupDashboardLines(t *testing.T) {
+	lines := cli.StartupDashboardLines([]string{"crux-postgres", "crux", "crux-ui", "traefik"},
+		map[string]string{"crux-postgres": "healthy", "crux": "failed", "crux-ui": "skipped"},
+		map[string]string{
+			"crux-postgres": "in 3s",
+			"crux":          strings.Repeat("x", 100),
+			"crux-ui":       "crux failed",
+		})
+
+	assert.Equal(t, "✓ crux-postgres    healthy        in 3s", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "✗ crux             failed         xxx"))
+	assert.True(t, strings.HasSuffix(lines[1], "x…"))
+	assert.Equal(t, "- crux-ui          skipped        crux failed", lines[2])
+	assert.Equal(t, "⠋ traefik          pulled      0s", lines[3])
+}
+
+func TestStartStackOnDashboard(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	var out bytes.Buffer
+	assert.NoError(t, cli.StartStackOnDashboard(ctx, docker, stackBuilders(ctx, docker), &cli.ArgsFlags{}, &out))
+
+	for _, line := range finalDashboard(out.String()) {
+		assert.Contains(t, line, "healthy", line)
+	}
+}
+
+func TestStartStackOnDashboardFailure(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	builders := stackBuilders(ctx, docker)
+	builders["crux-postgres"] = containerbuilder.NewDockerBuilder(ctx).
+		WithClient(docker).
+		WithImage("missing:latest").
+		WithImagePriority(imageHelper.LocalOnly).
+		WithName("crux-postgres")
+
+	var out bytes.Buffer
+	assert.Error(t, cli.StartStackOnDashboard(ctx, docker, builders, &cli.ArgsFlags{}, &out))
+
+	lines := finalDashboard(out.String())
+	assert.True(t, strings.HasPrefix(lines[0], "✗ crux-postgres    failed"), lines[0])
+	assert.Equal(t, "- crux             skipped        crux-postgres failed", lines[3])
+	assert.Equal(t, "- crux-ui          skipped        crux failed", lines[5])
+	assert.Contains(t, lines[1], "healthy")
+
+	// the logs of the failure are written above the dashboard
+	assert.Contains(t, out.String(), "Failed to start dyrector.io stack")
+}
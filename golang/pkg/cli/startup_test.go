@@ -0,0 +1,258 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/stretchr/testify/assert"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+var stackItems = []string{"crux-postgres", "kratos-postgres", "kratos", "crux", "mailslurper", "crux-ui", "traefik"}
+
+func stackBuilders(ctx context.Context, docker *dockerfake.Client) map[string]containerbuilder.Builder {
+	builders := map[string]containerbuilder.Builder{}
+	for _, it := range stackItems {
+		builders[it] = containerbuilder.NewDockerBuilder(ctx).
+			WithClient(docker).
+			WithImage("nginx:latest").
+			WithImagePriority(imageHelper.PreferLocal).
+			WithName(it)
+	}
+
+	return builders
+}
+
+// startedOrder is the order of the start calls of the containers
+func startedOrder(docker *dockerfake.Client) []string {
+	started := []string{}
+	for _, it := range docker.Calls() {
+		if it.Method == "ContainerStart" {
+			started = append(started, it.Target)
+		}
+	}
+
+	return started
+}
+
+func containerID(t *testing.T, docker client.APIClient, name string) string {
+	t.Helper()
+
+	inspect, err := docker.ContainerInspect(context.Background(), name)
+	assert.NoError(t, err)
+
+	return inspect.ID
+}
+
+func TestStartOrderFollowsDependencies(t *testing.T) {
+	for _, containerized := range []bool{false, true} {
+		order := cli.StartOrder()
+		assert.ElementsMatch(t, stackItems, order)
+
+		for id, dependencies := range cli.StartDependencies(&cli.ArgsFlags{FullyContainerized: containerized}) {
+			for _, dependency := range dependencies {
+				assert.Less(t, slices.Index(order, dependency), slices.Index(order, id), "%s depends on %s", id, dependency)
+			}
+		}
+	}
+}
+
+func TestStartStack(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+	docker.StartDelay = 50 * time.Millisecond
+
+	err := cli.StartStack(ctx, docker, stackBuilders(ctx, docker), &cli.ArgsFlags{})
+	assert.NoError(t, err)
+
+	ids := map[string]string{}
+	for _, it := range stackItems {
+		ids[containerID(t, docker, it)] = it
+	}
+
+	started := []string{}
+	for _, it := range startedOrder(docker) {
+		started = append(started, ids[it])
+	}
+	assert.ElementsMatch(t, stackItems, started)
+
+	// the ones without dependencies are started together
+	assert.ElementsMatch(t, []string{"crux-postgres", "kratos-postgres", "mailslurper", "traefik"}, started[:4])
+	assert.Less(t, slices.Index(started, "crux"), slices.Index(started, "crux-ui"))
+	assert.Less(t, slices.Index(started, "kratos-postgres"), slices.Index(started, "kratos"))
+}
+
+func TestStartStackDependencyFailed(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	errMigration := errors.New("migration failed")
+	builders := stackBuilders(ctx, docker)
+	builders["crux"] = builders["crux"].WithPreStartHooks(func(context.Context, client.APIClient, containerbuilder.ParentContainer) error {
+		return errMigration
+	})
+
+	err := cli.StartStack(ctx, docker, builders, &cli.ArgsFlags{FullyContainerized: true})
+	assert.ErrorIs(t, err, errMigration)
+
+	running := []string{}
+	for _, it := range stackItems {
+		inspect, err := docker.ContainerInspect(ctx, it)
+		if err == nil && inspect.State.Running {
+			running = append(running, it)
+		}
+	}
+	assert.ElementsMatch(t, []string{"crux-postgres", "kratos-postgres", "kratos", "mailslurper"}, running)
+}
+
+func TestReadinessProbes(t *testing.T) {
+	state := &cli.State{Containers: &cli.Containers{}}
+	state.Containers.CruxPostgres.Name = "dyo-crux-postgres"
+	state.Containers.KratosPostgres.Name = "dyo-kratos-postgres"
+	state.Containers.Kratos.Name = "dyo-kratos"
+	state.Containers.Crux.Name = "dyo-crux"
+	state.SettingsFile.CruxPostgresPort = 5432
+	state.SettingsFile.KratosPostgresPort = 5433
+	state.SettingsFile.KratosAdminPort = 4434
+	state.SettingsFile.CruxHTTPPort = 1848
+
+	assert.Equal(t, map[string]string{
+		"crux-postgres":   "localhost:5432",
+		"kratos-postgres": "localhost:5433",
+		"kratos":          "http://localhost:4434/admin/health/ready",
+		"crux":            "http://localhost:1848/api/health",
+	}, cli.ReadinessProbes(state, &cli.ArgsFlags{}))
+
+	assert.Equal(t, map[string]string{
+		"crux-postgres":   "dyo-crux-postgres:5432",
+		"kratos-postgres": "dyo-kratos-postgres:5432",
+		"kratos":          "http://dyo-kratos:4434/admin/health/ready",
+	}, cli.ReadinessProbes(state, &cli.ArgsFlags{FullyContainerized: true, CruxDisabled: true}))
+}
+
+func TestStartTimeout(t *testing.T) {
+	timeouts := map[string]time.Duration{"crux": 5 * time.Minute}
+
+	assert.Equal(t, 5*time.Minute, cli.StartTimeout(timeouts, "crux"))
+	assert.Equal(t, 2*time.Minute, cli.StartTimeout(timeouts, "kratos"))
+	assert.Equal(t, 2*time.Minute, cli.StartTimeout(nil, "crux"))
+}
+
+func TestStartStackWaitsForReadiness(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	// the database accepts connections only a while after its container is started
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := listener.Addr().String()
+	assert.NoError(t, listener.Close())
+
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		listener, err := net.Listen("tcp", address)
+		if err == nil {
+			t.Cleanup(func() { listener.Close() })
+		}
+	}()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var databaseErr error
+	builders := stackBuilders(ctx, docker)
+	builders["crux"] = builders["crux"].WithPreStartHooks(func(context.Context, client.APIClient, containerbuilder.ParentContainer) error {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			conn.Close()
+		}
+		databaseErr = err
+		return nil
+	})
+
+	err = cli.StartStackProbed(ctx, docker, builders, &cli.ArgsFlags{},
+		map[string]string{"crux-postgres": address, "crux": server.URL}, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, databaseErr, "crux is started before its database is ready")
+}
+
+func TestStartStackReadinessTimeout(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := cli.StartStackProbed(ctx, docker, stackBuilders(ctx, docker), &cli.ArgsFlags{},
+		map[string]string{"kratos": server.URL}, map[string]time.Duration{"kratos": 200 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = docker.ContainerInspect(ctx, "crux-ui")
+	assert.NoError(t, err, "the items not depending on kratos are started")
+}
+
+func TestStartStackRollsBackOnFailure(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	errMigration := errors.New("migration failed")
+	builders := stackBuilders(ctx, docker)
+	builders["crux"] = builders["crux"].WithPreStartHooks(func(context.Context, client.APIClient, containerbuilder.ParentContainer) error {
+		return errMigration
+	})
+
+	err := cli.StartStackWithRollback(ctx, docker, builders, &cli.ArgsFlags{FullyContainerized: true})
+	assert.ErrorIs(t, err, errMigration)
+
+	for _, it := range stackItems {
+		_, err := docker.ContainerInspect(ctx, it)
+		assert.Error(t, err, "%s is left behind", it)
+	}
+}
+
+func TestStartStackInterrupted(t *testing.T) {
+	ctx, interrupt := context.WithCancel(context.Background())
+	defer interrupt()
+
+	docker := dockerfake.New()
+	docker.AddImage("nginx:latest")
+
+	// interrupted while the migration of crux runs
+	builders := stackBuilders(ctx, docker)
+	builders["crux"] = builders["crux"].WithPreStartHooks(func(ctx context.Context, _ client.APIClient, _ containerbuilder.ParentContainer) error {
+		interrupt()
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := cli.StartStackWithRollback(ctx, docker, builders, &cli.ArgsFlags{})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	for _, it := range stackItems {
+		_, err := docker.ContainerInspect(context.Background(), it)
+		assert.Error(t, err, "%s is left behind", it)
+	}
+}
@@ -0,0 +1,325 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	tm "github.com/buger/goterm"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+)
+
+const (
+	StatsCommand = "stats"
+)
+
+const (
+	FlagStatsWatch    = "watch"
+	FlagStatsInterval = "interval"
+)
+
+const (
+	defaultStatsInterval = 5 * time.Second
+	percent              = 100
+	notAvailable         = "-"
+)
+
+var ErrInvalidStatsInterval = errors.New("invalid stats interval")
+
+// memberStats is the footprint of a container of the stack, the CPU and the memory are only known while it runs
+type memberStats struct {
+	Name        string
+	State       string
+	CPUPercent  float64
+	MemoryUsage uint64
+	MemoryLimit uint64
+	DiskUsage   int64
+	Running     bool
+}
+
+// volumeStats is the size of a volume of the stack, it is negative if the daemon could not tell it
+type volumeStats struct {
+	Name string
+	Size int64
+}
+
+type stackStats struct {
+	Members []memberStats
+	Volumes []volumeStats
+}
+
+func GetStatsCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:  StatsCommand,
+		Usage: "Show the CPU, memory and disk usage of the containers and the volumes of the stack",
+		Flags: []ucli.Flag{
+			&ucli.BoolFlag{
+				Name:    FlagStatsWatch,
+				Aliases: []string{"w"},
+				Value:   false,
+				Usage:   "refresh the report until interrupted",
+			},
+			&ucli.DurationFlag{
+				Name:  FlagStatsInterval,
+				Value: defaultStatsInterval,
+				Usage: "refresh interval of the watch mode",
+			},
+		},
+		Action: stats,
+	}
+}
+
+// validateStatsInterval rejects the intervals the ticker of the watch mode can not tick with
+func validateStatsInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("%w: --%s must be positive, got %s", ErrInvalidStatsInterval, FlagStatsInterval, interval)
+	}
+
+	return nil
+}
+
+func stats(cCtx *ucli.Context) error {
+	if cCtx.Bool(FlagStatsWatch) {
+		if err := validateStatsInterval(cCtx.Duration(FlagStatsInterval)); err != nil {
+			return err
+		}
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	prefix := cCtx.String(FlagPrefix)
+	if !cCtx.Bool(FlagStatsWatch) {
+		report, err := collectStackStats(cCtx.Context, cli, prefix)
+		if err != nil {
+			return err
+		}
+
+		return writeStackStats(os.Stdout, report)
+	}
+
+	ctx, stop := signal.NotifyContext(cCtx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(cCtx.Duration(FlagStatsInterval))
+	defer ticker.Stop()
+
+	for {
+		report, err := collectStackStats(ctx, cli, prefix)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if ctx.Err() == nil {
+			tm.Clear()
+			tm.MoveCursor(1, 1)
+			tm.Flush()
+			if err = writeStackStats(os.Stdout, report); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func collectStackStats(ctx context.Context, cli client.APIClient, prefix string) (*stackStats, error) {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Size:    true,
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(prefix))),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the containers of the stack: %w", err)
+	}
+
+	report := &stackStats{Members: make([]memberStats, len(containers))}
+	volumes := map[string]bool{}
+
+	var wg sync.WaitGroup
+	for i := range containers {
+		cont := &containers[i]
+		report.Members[i] = memberStats{
+			Name:      strings.TrimPrefix(cont.Names[0], "/"),
+			State:     cont.State,
+			DiskUsage: cont.SizeRw,
+			Running:   cont.State == "running",
+		}
+		for _, it := range cont.Mounts {
+			if it.Type == mount.TypeVolume {
+				volumes[it.Name] = true
+			}
+		}
+
+		if !report.Members[i].Running {
+			continue
+		}
+
+		wg.Add(1)
+		go func(member *memberStats, id string) {
+			defer wg.Done()
+
+			usage, err := containerUsage(ctx, cli, id)
+			if err != nil {
+				log.Warn().Err(err).Str("container", member.Name).Msg("Failed to get the stats of the container")
+				return
+			}
+			member.CPUPercent = cpuPercent(usage)
+			member.MemoryUsage = memoryUsage(&usage.MemoryStats)
+			member.MemoryLimit = usage.MemoryStats.Limit
+		}(&report.Members[i], cont.ID)
+	}
+	wg.Wait()
+
+	sort.Slice(report.Members, func(i, j int) bool { return report.Members[i].Name < report.Members[j].Name })
+
+	report.Volumes, err = stackVolumeSizes(ctx, cli, volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// containerUsage samples the container twice, so the CPU usage can be calculated from the difference
+func containerUsage(ctx context.Context, cli client.APIClient, id string) (*types.StatsJSON, error) {
+	resp, err := cli.ContainerStats(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	defer logdefer.LogDeferredErr(resp.Body.Close, log.Warn(), "error closing container stats")
+
+	usage := &types.StatsJSON{}
+	if err = json.NewDecoder(resp.Body).Decode(usage); err != nil {
+		return nil, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+
+	return usage, nil
+}
+
+// stackVolumeSizes asks the daemon for the size of the named volumes, which takes a while as it walks them
+func stackVolumeSizes(ctx context.Context, cli client.APIClient, names map[string]bool) ([]volumeStats, error) {
+	volumes := []volumeStats{}
+	if len(names) == 0 {
+		return volumes, nil
+	}
+
+	usage, err := cli.DiskUsage(ctx, types.DiskUsageOptions{Types: []types.DiskUsageObject{types.VolumeObject}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the size of the volumes: %w", err)
+	}
+
+	for _, it := range usage.Volumes {
+		if !names[it.Name] {
+			continue
+		}
+
+		size := int64(-1)
+		if it.UsageData != nil {
+			size = it.UsageData.Size
+		}
+		volumes = append(volumes, volumeStats{Name: it.Name, Size: size})
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Name < volumes[j].Name })
+
+	return volumes, nil
+}
+
+// cpuPercent is calculated like docker stats does, 100% is one fully used core
+func cpuPercent(usage *types.StatsJSON) float64 {
+	cpuDelta := float64(usage.CPUStats.CPUUsage.TotalUsage) - float64(usage.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(usage.CPUStats.SystemUsage) - float64(usage.PreCPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+
+	cpus := float64(usage.CPUStats.OnlineCPUs)
+	if cpus == 0 {
+		cpus = float64(len(usage.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	return cpuDelta / systemDelta * cpus * percent
+}
+
+// memoryUsage leaves out the inactive page cache, which the kernel reclaims under pressure,
+// its key is total_inactive_file with cgroup v1 and inactive_file with cgroup v2
+func memoryUsage(mem *types.MemoryStats) uint64 {
+	for _, key := range []string{"total_inactive_file", "inactive_file"} {
+		if cache, ok := mem.Stats[key]; ok && cache < mem.Usage {
+			return mem.Usage - cache
+		}
+	}
+
+	return mem.Usage
+}
+
+func formatSize(n int64) string {
+	if n < 0 {
+		return notAvailable
+	}
+
+	return formatBytes(n)
+}
+
+func writeStackStats(w io.Writer, report *stackStats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	var cpu float64
+	var memory uint64
+	var disk, volumes int64
+
+	fmt.Fprintln(tw, "CONTAINER\tSTATE\tCPU %\tMEM USAGE / LIMIT\tDISK")
+	for i := range report.Members {
+		member := &report.Members[i]
+		disk += member.DiskUsage
+
+		if !member.Running {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", member.Name, member.State, notAvailable, notAvailable, formatSize(member.DiskUsage))
+			continue
+		}
+
+		cpu += member.CPUPercent
+		memory += member.MemoryUsage
+		fmt.Fprintf(tw, "%s\t%s\t%.2f%%\t%s / %s\t%s\n", member.Name, member.State, member.CPUPercent,
+			formatBytes(int64(member.MemoryUsage)), formatBytes(int64(member.MemoryLimit)), formatSize(member.DiskUsage))
+	}
+
+	if len(report.Volumes) > 0 {
+		fmt.Fprintln(tw, "\nVOLUME\tSIZE")
+		for _, it := range report.Volumes {
+			if it.Size > 0 {
+				volumes += it.Size
+			}
+			fmt.Fprintf(tw, "%s\t%s\n", it.Name, formatSize(it.Size))
+		}
+	}
+
+	fmt.Fprintf(tw, "\nTOTAL\tCPU %.2f%%\tMEM %s\tDISK %s\tVOLUMES %s\n",
+		cpu, formatBytes(int64(memory)), formatBytes(disk), formatBytes(volumes))
+
+	return tw.Flush()
+}
@@ -0,0 +1,64 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestCPUPercent(t *testing.T) {
+	usage := &types.StatsJSON{}
+	usage.PreCPUStats.CPUUsage.TotalUsage = 1000
+	usage.PreCPUStats.SystemUsage = 10000
+	usage.CPUStats.CPUUsage.TotalUsage = 1500
+	usage.CPUStats.SystemUsage = 20000
+	usage.CPUStats.OnlineCPUs = 4
+
+	assert.InDelta(t, 20.0, cli.CPUPercent(usage), 0.001)
+
+	usage.CPUStats.OnlineCPUs = 0
+	usage.CPUStats.CPUUsage.PercpuUsage = []uint64{1, 2}
+	assert.InDelta(t, 10.0, cli.CPUPercent(usage), 0.001)
+
+	assert.Zero(t, cli.CPUPercent(&types.StatsJSON{}))
+}
+
+func TestMemoryUsageWithoutCache(t *testing.T) {
+	assert.EqualValues(t, 700, cli.MemoryUsage(&types.MemoryStats{Usage: 1000, Stats: map[string]uint64{"inactive_file": 300}}))
+	assert.EqualValues(t, 600, cli.MemoryUsage(&types.MemoryStats{Usage: 1000, Stats: map[string]uint64{"total_inactive_file": 400}}))
+	assert.EqualValues(t, 1000, cli.MemoryUsage(&types.MemoryStats{Usage: 1000}))
+}
+
+func TestWriteStackStats(t *testing.T) {
+	var out bytes.Buffer
+	err := cli.WriteStackStats(&out, &cli.StackStats{
+		Members: []cli.MemberStats{
+			{Name: "dyo-stable_crux", State: "running", Running: true, CPUPercent: 1.5, MemoryUsage: 2 << 20, MemoryLimit: 1 << 30, DiskUsage: 1024},
+			{Name: "dyo-stable_crux-migrate", State: "exited", DiskUsage: 2048},
+		},
+		Volumes: []cli.VolumeStats{{Name: "dyo-stable_crux-postgres-data", Size: 3 << 20}, {Name: "unknown", Size: -1}},
+	})
+	assert.NoError(t, err)
+
+	report := out.String()
+	assert.Contains(t, report, "1.50%")
+	assert.Contains(t, report, "2.0MiB / 1.0GiB")
+	assert.Contains(t, report, "dyo-stable_crux-postgres-data  3.0MiB")
+	assert.Contains(t, report, "unknown                        -")
+	assert.Contains(t, report, "DISK 3.0KiB")
+	assert.Contains(t, report, "VOLUMES 3.0MiB")
+}
+
+func TestValidateStatsInterval(t *testing.T) {
+	assert.NoError(t, cli.ValidateStatsInterval(time.Second))
+	assert.ErrorIs(t, cli.ValidateStatsInterval(0), cli.ErrInvalidStatsInterval)
+	assert.ErrorIs(t, cli.ValidateStatsInterval(-time.Second), cli.ErrInvalidStatsInterval)
+}
@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+)
+
+const (
+	StatusCommand = "status"
+)
+
+const (
+	FlagStatusJSON = "json"
+)
+
+// imageVersionLabel is the version of the image, set by the images following the OCI annotations
+const imageVersionLabel = "org.opencontainers.image.version"
+
+type stackMemberStatus struct {
+	Name    string   `json:"name"`
+	Image   string   `json:"image"`
+	Version string   `json:"version"`
+	State   string   `json:"state"`
+	Status  string   `json:"status"`
+	Health  string   `json:"health"`
+	Ports   []string `json:"ports"`
+}
+
+type stackStatus struct {
+	Prefix     string              `json:"prefix"`
+	Containers []stackMemberStatus `json:"containers"`
+}
+
+// listStackMembers lists every container of the stack in name order, the one-off ones included
+func listStackMembers(ctx context.Context, cli client.APIClient, prefix string) ([]stackMemberStatus, error) {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(prefix))),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the containers of the stack: %w", err)
+	}
+
+	members := []stackMemberStatus{}
+	for i := range containers {
+		members = append(members, stackMemberOf(&containers[i]))
+	}
+
+	slices.SortFunc(members, func(a, b stackMemberStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return members, nil
+}
+
+func stackMemberOf(cont *types.Container) stackMemberStatus {
+	health := string(stackMemberHealth(cont))
+	if health == "" {
+		health = "healthy"
+	}
+
+	return stackMemberStatus{
+		Name:    strings.TrimPrefix(cont.Names[0], "/"),
+		Image:   cont.Image,
+		Version: imageVersion(cont),
+		State:   cont.State,
+		Status:  cont.Status,
+		Health:  health,
+		Ports:   publishedPorts(cont.Ports),
+	}
+}
+
+// imageVersion is the version label of the image, or its tag if the image has no label
+func imageVersion(cont *types.Container) string {
+	if version := cont.Labels[imageVersionLabel]; version != "" {
+		return version
+	}
+
+	expanded, err := image.ExpandImageName(cont.Image)
+	if err != nil {
+		return ""
+	}
+
+	_, tag, err := image.SplitImageName(expanded)
+	if err != nil {
+		return ""
+	}
+
+	return tag
+}
+
+// publishedPorts are the ports published on the host, formatted like docker ps does
+func publishedPorts(ports []types.Port) []string {
+	published := []string{}
+	for _, it := range ports {
+		if it.PublicPort == 0 {
+			continue
+		}
+
+		port := fmt.Sprintf("%s->%d/%s", net.JoinHostPort(it.IP, strconv.Itoa(int(it.PublicPort))), it.PrivatePort, it.Type)
+		if !slices.Contains(published, port) {
+			published = append(published, port)
+		}
+	}
+	slices.Sort(published)
+
+	return published
+}
+
+func getStackStatus(ctx context.Context, args *ArgsFlags) (*stackStatus, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	members, err := listStackMembers(ctx, cli, args.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stackStatus{Prefix: args.Prefix, Containers: members}, nil
+}
+
+// printStackStatus prints the status as a table, in json output mode the status is the result of the command
+func printStackStatus(status *stackStatus) error {
+	if err := writeStackStatus(os.Stdout, status); err != nil {
+		return fmt.Errorf("failed to write the status of the stack: %w", err)
+	}
+
+	return nil
+}
+
+func writeStackStatus(w io.Writer, status *stackStatus) error {
+	if len(status.Containers) == 0 {
+		_, err := fmt.Fprintf(w, "The stack %s has no containers, start it with dyo up\n", status.Prefix)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "CONTAINER\tSTATE\tHEALTH\tVERSION\tPORTS\tSTATUS")
+	for i := range status.Containers {
+		member := &status.Containers[i]
+
+		version := member.Version
+		if version == "" {
+			version = notAvailable
+		}
+		ports := strings.Join(member.Ports, ", ")
+		if ports == "" {
+			ports = notAvailable
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", member.Name, member.State, member.Health, version, ports, member.Status)
+	}
+
+	return tw.Flush()
+}
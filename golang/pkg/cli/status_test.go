@@ -0,0 +1,66 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestStackMemberOf(t *testing.T) {
+	member := cli.StackMemberOf(&types.Container{
+		Names:  []string{"/dyo-stable_crux-ui"},
+		Image:  "ghcr.io/dyrector-io/dyrectorio/web/crux-ui:0.12.0",
+		State:  "running",
+		Status: "Up 2 hours (unhealthy)",
+		Ports: []types.Port{
+			{IP: "0.0.0.0", PrivatePort: 3000, PublicPort: 3000, Type: "tcp"},
+			{IP: "0.0.0.0", PrivatePort: 3000, PublicPort: 3000, Type: "tcp"},
+			{IP: "::", PrivatePort: 3000, PublicPort: 3000, Type: "tcp"},
+			{PrivatePort: 9229, Type: "tcp"},
+		},
+	})
+
+	assert.Equal(t, "dyo-stable_crux-ui", member.Name)
+	assert.Equal(t, "0.12.0", member.Version)
+	assert.Equal(t, "unhealthy", member.Health)
+	assert.Equal(t, []string{"0.0.0.0:3000->3000/tcp", "[::]:3000->3000/tcp"}, member.Ports)
+}
+
+func TestStackMemberVersionLabel(t *testing.T) {
+	member := cli.StackMemberOf(&types.Container{
+		Names:  []string{"/dyo-stable_traefik"},
+		Image:  "traefik:latest",
+		State:  "exited",
+		Labels: map[string]string{"org.opencontainers.image.version": "v2.10.5"},
+	})
+
+	assert.Equal(t, "v2.10.5", member.Version)
+	assert.Equal(t, "crashed", member.Health)
+	assert.Empty(t, member.Ports)
+}
+
+func TestWriteStackStatus(t *testing.T) {
+	status := &cli.StackStatus{
+		Prefix: "dyo-stable",
+		Containers: []cli.StackMemberStatus{
+			{Name: "dyo-stable_crux", State: "running", Health: "healthy", Version: "0.12.0", Status: "Up 2 hours"},
+			{Name: "dyo-stable_traefik", State: "running", Health: "healthy", Ports: []string{"0.0.0.0:8000->80/tcp"}},
+		},
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, cli.WriteStackStatus(&out, status))
+	assert.Contains(t, out.String(), "dyo-stable_crux     running  healthy  0.12.0   -")
+	assert.Contains(t, out.String(), "dyo-stable_traefik  running  healthy  -        0.0.0.0:8000->80/tcp")
+
+	out.Reset()
+	assert.NoError(t, cli.WriteStackStatus(&out, &cli.StackStatus{Prefix: "dyo-stable"}))
+	assert.Contains(t, out.String(), "has no containers")
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	dockerhelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+)
+
+const (
+	defaultStopGracePeriod    = 10 * time.Second
+	defaultPostgresStopPeriod = 30 * time.Second
+	// docker kills traefik only after it had the chance to finish its own graceful shutdown
+	traefikDrainMargin = 5 * time.Second
+)
+
+var defaultStopGracePeriods = map[stackItemID]time.Duration{
+	cruxPostgres:   defaultPostgresStopPeriod,
+	kratosPostgres: defaultPostgresStopPeriod,
+}
+
+// stopOrder is the reverse of the start order, except traefik is drained first,
+// so no request reaches a service being stopped
+func stopOrder() []stackItemID {
+	order := []stackItemID{traefik}
+	for i := len(startOrder) - 1; i >= 0; i-- {
+		if startOrder[i] != traefik {
+			order = append(order, startOrder[i])
+		}
+	}
+
+	return order
+}
+
+func stopGracePeriod(periods map[string]time.Duration, id stackItemID) time.Duration {
+	if period, ok := periods[string(id)]; ok {
+		return period
+	}
+	if period, ok := defaultStopGracePeriods[id]; ok {
+		return period
+	}
+
+	return defaultStopGracePeriod
+}
+
+// stopStack stops the members of a stack in order, the containers are removed afterwards by their label
+func stopStack(ctx context.Context, cli client.APIClient, prefix string, periods map[string]time.Duration) error {
+	for _, id := range stopOrder() {
+		name := fmt.Sprintf("%s_%s", prefix, id)
+
+		cont, err := dockerhelper.GetContainerByName(ctx, cli, name)
+		if err != nil {
+			return err
+		}
+		if cont == nil || cont.State != "running" {
+			continue
+		}
+
+		grace := stopGracePeriod(periods, id)
+		if id == traefik {
+			grace += traefikDrainMargin
+			log.Info().Str("container", name).Msg("Draining")
+		}
+
+		timeout := int(grace.Seconds())
+		if err = cli.ContainerStop(ctx, cont.ID, container.StopOptions{Timeout: &timeout}); err != nil {
+			return fmt.Errorf("failed to stop %s: %w", name, err)
+		}
+		log.Info().Str("container", name).Dur("gracePeriod", grace).Msg("Stopped")
+	}
+
+	return nil
+}
+
+// traefikLifecycleArgs makes traefik refuse new connections on SIGTERM and wait for the in-flight requests
+func traefikLifecycleArgs(periods map[string]time.Duration) []string {
+	return []string{
+		"--entrypoints.web.transport.lifecycle.requestacceptgracetimeout=0s",
+		fmt.Sprintf("--entrypoints.web.transport.lifecycle.gracetimeout=%s", stopGracePeriod(periods, traefik)),
+	}
+}
+
+func stackPrefixes(args *ArgsFlags) []string {
+	if args.Prefix != "" {
+		return strings.Split(args.Prefix, ",")
+	}
+
+	return []string{DefaultPrefix}
+}
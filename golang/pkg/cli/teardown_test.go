@@ -0,0 +1,33 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestStopOrderDrainsTraefikFirst(t *testing.T) {
+	assert.Equal(t, []string{
+		"traefik", "crux-ui", "mailslurper", "crux", "kratos", "kratos-postgres", "crux-postgres",
+	}, cli.StopOrder())
+}
+
+func TestStopGracePeriod(t *testing.T) {
+	periods := map[string]time.Duration{"crux": time.Minute}
+
+	assert.Equal(t, time.Minute, cli.StopGracePeriod(periods, "crux"))
+	assert.Equal(t, 30*time.Second, cli.StopGracePeriod(periods, "crux-postgres"))
+	assert.Equal(t, 10*time.Second, cli.StopGracePeriod(nil, "traefik"))
+}
+
+func TestTraefikLifecycleArgs(t *testing.T) {
+	args := cli.TraefikLifecycleArgs(map[string]time.Duration{"traefik": 20 * time.Second})
+
+	assert.Contains(t, args, "--entrypoints.web.transport.lifecycle.gracetimeout=20s")
+}
@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/docker/docker/client"
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	dagentutils "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+const traefikEmbeddedTemplate = "traefik.yaml.tmpl"
+
+// the file provider of traefik loads these, the other files of the extra directory are skipped
+var traefikExtraConfigExtensions = []string{".yml", ".yaml", ".toml"}
+
+// the files generated by dyo, the extra ones can't replace them
+var traefikReservedConfigFiles = []string{traefikDynamicConfFile, path.Base(traefikTLSConfigName), traefikRoutesFile}
+
+var ErrInvalidTraefikExtraConfig = errors.New("invalid traefik extra configuration")
+
+// placeholders a template has to use, otherwise crux and crux-ui are not routed
+var traefikRequiredPlaceholders = []string{"InternalHost", "CruxUIPort", "CruxPort"}
+
+// traefikTemplatePath resolves relative paths next to the settings file
+func traefikTemplatePath(templatePath, settingsFilePath string) string {
+	if templatePath == "" || filepath.IsAbs(templatePath) {
+		return templatePath
+	}
+
+	return filepath.Join(filepath.Dir(settingsFilePath), templatePath)
+}
+
+// loadTraefikTemplate parses the user provided template if there is one, the embedded one otherwise
+func loadTraefikTemplate(templatePath string) (*template.Template, error) {
+	var content []byte
+	var err error
+
+	if templatePath == "" {
+		content, err = traefikTmpl.ReadFile(traefikEmbeddedTemplate)
+	} else {
+		content, err = os.ReadFile(templatePath) //#nosec G304 -- path comes from the settings file
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read traefik template: %w", err)
+	}
+
+	traefikConfig, err := template.New("traefikconfig").Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse traefik template %s: %w", templatePath, err)
+	}
+
+	if err := validateTraefikTemplate(traefikConfig); err != nil {
+		return nil, fmt.Errorf("invalid traefik template %s: %w", templatePath, err)
+	}
+
+	return traefikConfig, nil
+}
+
+func validateTraefikTemplate(tmpl *template.Template) error {
+	used := map[string]bool{}
+	collectTemplateFields(tmpl.Tree.Root, used)
+
+	missing := []string{}
+	for _, placeholder := range traefikRequiredPlaceholders {
+		if !used[placeholder] {
+			missing = append(missing, fmt.Sprintf("{{.%s}}", placeholder))
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("missing placeholders: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func collectTemplateFields(node parse.Node, used map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateFields(child, used)
+		}
+	case *parse.ActionNode:
+		collectTemplateFields(n.Pipe, used)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				collectTemplateFields(arg, used)
+			}
+		}
+	case *parse.FieldNode:
+		if len(n.Ident) > 0 {
+			used[n.Ident[0]] = true
+		}
+	case *parse.IfNode:
+		collectBranchFields(&n.BranchNode, used)
+	case *parse.RangeNode:
+		collectBranchFields(&n.BranchNode, used)
+	case *parse.WithNode:
+		collectBranchFields(&n.BranchNode, used)
+	case *parse.TemplateNode:
+		collectTemplateFields(n.Pipe, used)
+	}
+}
+
+func collectBranchFields(n *parse.BranchNode, used map[string]bool) {
+	collectTemplateFields(n.Pipe, used)
+	collectTemplateFields(n.List, used)
+	collectTemplateFields(n.ElseList, used)
+}
+
+// traefikExtraConfigFiles are the contents of the extra configuration files by their name, the yaml ones are checked,
+// so a typo fails before the stack starts instead of traefik skipping the file
+func traefikExtraConfigFiles(dir string) (map[string]string, error) {
+	files := map[string]string{}
+	if dir == "" {
+		return files, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read the traefik extra configuration directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := filepath.Ext(name)
+		if !entry.Type().IsRegular() || !slices.Contains(traefikExtraConfigExtensions, ext) {
+			continue
+		}
+		if slices.Contains(traefikReservedConfigFiles, name) {
+			return nil, fmt.Errorf("%w: %s is generated by dyo, rename the file", ErrInvalidTraefikExtraConfig, name)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, name)) //#nosec G304 -- path comes from the settings file
+		if err != nil {
+			return nil, err
+		}
+		if ext != ".toml" {
+			if err = yaml.Unmarshal(content, &map[string]any{}); err != nil {
+				return nil, fmt.Errorf("%w: %s: %w", ErrInvalidTraefikExtraConfig, name, err)
+			}
+		}
+
+		files[name] = string(content)
+	}
+
+	return files, nil
+}
+
+// sortedFileNames keeps the generated files stable
+func sortedFileNames(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// copyTraefikExtraConfig uploads the extra configuration files next to the generated one, the file provider picks them up
+func copyTraefikExtraConfig(ctx context.Context, name, dir string) error {
+	files, err := traefikExtraConfigFiles(dir)
+	if err != nil || len(files) == 0 {
+		return err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	for _, file := range sortedFileNames(files) {
+		content := files[file]
+		err = dagentutils.WriteContainerFile(ctx, cli, name, path.Join("traefik", file), v1.UploadFileData{FilePath: "/etc"},
+			int64(len(content)), strings.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("failed to copy %s into traefik: %w", file, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func writeTemplate(t *testing.T, content string) string {
+	t.Helper()
+
+	templatePath := filepath.Join(t.TempDir(), "traefik.yaml.tmpl")
+	assert.NoError(t, os.WriteFile(templatePath, []byte(content), 0o600))
+
+	return templatePath
+}
+
+func TestLoadTraefikTemplateEmbedded(t *testing.T) {
+	_, err := cli.LoadTraefikTemplate("")
+	assert.NoError(t, err)
+}
+
+func TestLoadTraefikTemplateCustom(t *testing.T) {
+	templatePath := writeTemplate(t, `http:
+  services:
+    crux-ui:
+      loadBalancer:
+        servers:
+          - url: http://{{.InternalHost}}:{{.CruxUIPort}}
+{{- if .CruxPort }}
+    crux:
+      loadBalancer:
+        servers:
+          - url: http://{{.InternalHost}}:{{.CruxPort}}
+{{- end }}
+`)
+
+	_, err := cli.LoadTraefikTemplate(templatePath)
+	assert.NoError(t, err)
+}
+
+func TestLoadTraefikTemplateMissingPlaceholder(t *testing.T) {
+	templatePath := writeTemplate(t, "url: http://{{.InternalHost}}:{{.CruxUIPort}}\n")
+
+	_, err := cli.LoadTraefikTemplate(templatePath)
+	assert.ErrorContains(t, err, "{{.CruxPort}}")
+}
+
+func TestLoadTraefikTemplateInvalid(t *testing.T) {
+	templatePath := writeTemplate(t, "url: http://{{.InternalHost")
+
+	_, err := cli.LoadTraefikTemplate(templatePath)
+	assert.Error(t, err)
+}
+
+func TestTraefikTemplatePath(t *testing.T) {
+	assert.Equal(t, "", cli.TraefikTemplatePath("", "/home/dev/.config/dyo-cli/settings.yaml"))
+	assert.Equal(t, "/etc/traefik.tmpl", cli.TraefikTemplatePath("/etc/traefik.tmpl", "/home/dev/.config/dyo-cli/settings.yaml"))
+	assert.Equal(t, "/home/dev/.config/dyo-cli/traefik.tmpl",
+		cli.TraefikTemplatePath("traefik.tmpl", "/home/dev/.config/dyo-cli/settings.yaml"))
+}
+
+func writeExtraConfig(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	return dir
+}
+
+func TestTraefikExtraConfigFiles(t *testing.T) {
+	files, err := cli.TraefikExtraConfigFiles("")
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+
+	dir := writeExtraConfig(t, map[string]string{
+		"auth.yml":     "http:\n  middlewares:\n    auth:\n      basicAuth:\n        users: [\"admin:hash\"]\n",
+		"routers.toml": "[http.routers.docs]\n  rule = \"PathPrefix(`/docs`)\"\n",
+		"README.md":    "not a configuration",
+	})
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "nested.yml"), 0o700))
+
+	files, err = cli.TraefikExtraConfigFiles(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Contains(t, files["auth.yml"], "basicAuth")
+	assert.Contains(t, files, "routers.toml")
+
+	_, err = cli.TraefikExtraConfigFiles(writeExtraConfig(t, map[string]string{"broken.yaml": "http: [routers"}))
+	assert.ErrorIs(t, err, cli.ErrInvalidTraefikExtraConfig)
+
+	_, err = cli.TraefikExtraConfigFiles(writeExtraConfig(t, map[string]string{"dynamic_conf.yml": "http: {}"}))
+	assert.ErrorIs(t, err, cli.ErrInvalidTraefikExtraConfig)
+
+	_, err = cli.TraefikExtraConfigFiles(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,419 @@
+package cli
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	dagentutils "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+// TLS modes of traefik
+const (
+	TLSModeSelfSigned = "self-signed"
+	TLSModeACME       = "acme"
+)
+
+const (
+	defaultTraefikInternalTLSPort = 8443
+	traefikTLSEntrypoint          = "websecure"
+	traefikACMEResolver           = "acme"
+	traefikACMEStorage            = "/letsencrypt"
+	// the certificates are loaded by the file provider from the dynamic configuration directory
+	traefikCertsDir      = "traefik/certs"
+	traefikTLSConfigName = "traefik/tls.yml"
+	tlsDirName           = "certs"
+	caCertFileName       = "ca.crt"
+	caKeyFileName        = "ca.key"
+	serverCertFileName   = "tls.crt"
+	serverKeyFileName    = "tls.key"
+	caValidity           = 10 * 365 * 24 * time.Hour
+	// the longest validity the platforms accept for the certificates of a locally trusted CA
+	serverCertValidity = 825 * 24 * time.Hour
+	serverCertRenewal  = 30 * 24 * time.Hour
+	serialNumberBits   = 128
+	tlsKeyPerms        = 0o600
+)
+
+const traefikTLSConfig = `tls:
+  stores:
+    default:
+      defaultCertificate:
+        certFile: /etc/traefik/certs/tls.crt
+        keyFile: /etc/traefik/certs/tls.key
+`
+
+var ErrInvalidTLSSettings = errors.New("invalid tls setting")
+
+// TLSSettings enable HTTPS on traefik, the UI and the API are served on the TLS port besides the web one,
+// with a certificate of a local CA in self-signed mode or one issued by Let's Encrypt in acme mode
+type TLSSettings struct {
+	// self-signed or acme, empty disables HTTPS
+	Mode string `yaml:"mode"`
+	// host name the stack is reached by, it is in the self-signed certificate, acme needs a public one
+	Domain string `yaml:"domain"`
+	// account of the certificates in acme mode, Let's Encrypt sends the expiry notices to it
+	ACMEEmail string `yaml:"acmeEmail"`
+	// directory of the ACME server, eg. the staging one of Let's Encrypt, the production one if empty
+	ACMECAServer string `yaml:"acmeCAServer"`
+}
+
+func (t *TLSSettings) Enabled() bool {
+	return t.Mode != ""
+}
+
+func (t *TLSSettings) Validate() error {
+	switch t.Mode {
+	case "", TLSModeSelfSigned:
+		return nil
+	case TLSModeACME:
+		if t.ACMEEmail == "" {
+			return fmt.Errorf("%w: acme needs acmeEmail", ErrInvalidTLSSettings)
+		}
+		if t.Domain == "" || t.Domain == localhost || net.ParseIP(t.Domain) != nil {
+			return fmt.Errorf("%w: acme needs the public domain of the stack, not %q", ErrInvalidTLSSettings, t.Domain)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: mode %q, use %s or %s", ErrInvalidTLSSettings, t.Mode, TLSModeSelfSigned, TLSModeACME)
+	}
+}
+
+// traefikTLSArgs add the TLS entrypoint, the routers of it are served over TLS only
+func traefikTLSArgs(settings *TLSSettings) []string {
+	if !settings.Enabled() {
+		return []string{}
+	}
+
+	args := []string{
+		fmt.Sprintf("--entrypoints.%s.address=:%d", traefikTLSEntrypoint, defaultTraefikInternalTLSPort),
+		fmt.Sprintf("--entrypoints.%s.http.tls=true", traefikTLSEntrypoint),
+	}
+	if settings.Mode == TLSModeACME {
+		args = append(args,
+			fmt.Sprintf("--entrypoints.%s.http.tls.certresolver=%s", traefikTLSEntrypoint, traefikACMEResolver),
+			// the certificate is requested for the public domain only, the internal host names of the routers
+			// can not be validated by Let's Encrypt
+			fmt.Sprintf("--entrypoints.%s.http.tls.domains[0].main=%s", traefikTLSEntrypoint, settings.Domain),
+			fmt.Sprintf("--certificatesresolvers.%s.acme.email=%s", traefikACMEResolver, settings.ACMEEmail),
+			fmt.Sprintf("--certificatesresolvers.%s.acme.storage=%s/acme.json", traefikACMEResolver, traefikACMEStorage),
+			fmt.Sprintf("--certificatesresolvers.%s.acme.tlschallenge=true", traefikACMEResolver),
+		)
+		if settings.ACMECAServer != "" {
+			args = append(args, fmt.Sprintf("--certificatesresolvers.%s.acme.caserver=%s", traefikACMEResolver, settings.ACMECAServer))
+		}
+	}
+
+	return args
+}
+
+// traefikEntrypoints are the entrypoints of the routers of the stack
+func traefikEntrypoints(settings *TLSSettings) string {
+	if !settings.Enabled() {
+		return "web"
+	}
+
+	return "web," + traefikTLSEntrypoint
+}
+
+// traefikHostRule matches the hosts and the domain of the TLS settings
+func traefikHostRule(settings *TLSSettings, hosts ...string) string {
+	if settings.Domain != "" && !slices.Contains(hosts, settings.Domain) {
+		hosts = append(hosts, settings.Domain)
+	}
+
+	rules := []string{}
+	for _, it := range hosts {
+		rules = append(rules, fmt.Sprintf("Host(`%s`)", it))
+	}
+
+	return strings.Join(rules, " || ")
+}
+
+// publicURL is the address the browser reaches the stack at, the TLS one if HTTPS is enabled
+func publicURL(settings *SettingsFile, host string) string {
+	if settings.externalProxy() {
+		return externalURL(&settings.Options)
+	}
+	if !settings.TLS.Enabled() {
+		return fmt.Sprintf("http://%s:%d", host, settings.TraefikWebPort)
+	}
+
+	if settings.TLS.Domain != "" {
+		host = settings.TLS.Domain
+	}
+	if settings.TraefikTLSPort == 443 { //nolint:gomnd
+		return "https://" + host
+	}
+
+	return fmt.Sprintf("https://%s:%d", host, settings.TraefikTLSPort)
+}
+
+// tlsDir keeps the local CA and the certificate of the stack next to the settings, so the CA is trusted only once
+func tlsDir(args *ArgsFlags) string {
+	return path.Join(path.Dir(args.SettingsFilePath), prefixedFileName(tlsDirName, args.Prefix))
+}
+
+// selfSignedHosts are the names the certificate is valid for
+func selfSignedHosts(state *State) []string {
+	hosts := []string{localhost, "127.0.0.1", state.InternalHostDomain, state.Containers.Traefik.Name}
+	if state.SettingsFile.TLS.Domain != "" {
+		hosts = append(hosts, state.SettingsFile.TLS.Domain)
+	}
+
+	unique := []string{}
+	for _, it := range hosts {
+		if it != "" && !slices.Contains(unique, it) {
+			unique = append(unique, it)
+		}
+	}
+
+	return unique
+}
+
+// prepareTraefikTLS fails early on invalid settings, and issues the self-signed certificate before the stack is started
+func prepareTraefikTLS(state *State, args *ArgsFlags) error {
+	settings := &state.SettingsFile.TLS
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	switch settings.Mode {
+	case TLSModeSelfSigned:
+		dir := tlsDir(args)
+		if err := ensureSelfSignedCerts(dir, selfSignedHosts(state), time.Now()); err != nil {
+			return fmt.Errorf("failed to issue the self-signed certificate: %w", err)
+		}
+		log.Info().Str("ca", path.Join(dir, caCertFileName)).
+			Msg("HTTPS uses the certificate of a local CA, trust the CA to open the UI without warnings")
+	case TLSModeACME:
+		if state.SettingsFile.TraefikTLSPort != 443 { //nolint:gomnd
+			log.Warn().Uint("port", state.SettingsFile.TraefikTLSPort).
+				Msg("The TLS challenge of ACME is answered on port 443 only, forward it to the TLS port of traefik")
+		}
+	}
+
+	return nil
+}
+
+// ensureSelfSignedCerts creates the CA once, and the certificate of the stack if it is missing, expiring
+// or not valid for the hosts
+func ensureSelfSignedCerts(dir string, hosts []string, now time.Time) error {
+	if err := os.MkdirAll(dir, dirPerms); err != nil {
+		return err
+	}
+
+	caCert, caKey, err := loadCA(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		caCert, caKey, err = createCA(dir, now)
+	}
+	if err != nil {
+		return err
+	}
+
+	current, err := readCertificate(path.Join(dir, serverCertFileName))
+	if err == nil && current.NotAfter.After(now.Add(serverCertRenewal)) && sameHosts(current, hosts) &&
+		current.CheckSignatureFrom(caCert) == nil {
+		return nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	template, err := certificateTemplate(now, serverCertValidity)
+	if err != nil {
+		return err
+	}
+	template.Subject = pkix.Name{CommonName: hosts[0], Organization: []string{"dyrector.io"}}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	for _, it := range hosts {
+		if ip := net.ParseIP(it); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, it)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	if err = writeKeyPair(dir, serverCertFileName, serverKeyFileName, der, key); err != nil {
+		return err
+	}
+
+	log.Info().Strs("hosts", hosts).Msg("Self-signed certificate issued")
+
+	return nil
+}
+
+func certificateTemplate(now time.Time, validity time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, err
+	}
+
+	return &x509.Certificate{
+		SerialNumber:          serial,
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		BasicConstraintsValid: true,
+	}, nil
+}
+
+func createCA(dir string, now time.Time) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := certificateTemplate(now, caValidity)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.Subject = pkix.Name{CommonName: "dyrector.io local CA", Organization: []string{"dyrector.io"}}
+	template.IsCA = true
+	template.MaxPathLenZero = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = writeKeyPair(dir, caCertFileName, caKeyFileName, der, key); err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Info().Str("path", path.Join(dir, caCertFileName)).Msg("Local CA created")
+
+	return cert, key, nil
+}
+
+func loadCA(dir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	cert, err := readCertificate(path.Join(dir, caCertFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := os.ReadFile(path.Join(dir, caKeyFileName)) //#nosec G304 -- the directory of the settings
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no key in %s", path.Join(dir, caKeyFileName))
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+func readCertificate(certPath string) (*x509.Certificate, error) {
+	content, err := os.ReadFile(certPath) //#nosec G304 -- the directory of the settings
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("no certificate in %s", certPath)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func writeKeyPair(dir, certName, keyName string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(path.Join(dir, keyName), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), tlsKeyPerms)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path.Join(dir, certName), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), filePerms)
+}
+
+func sameHosts(cert *x509.Certificate, hosts []string) bool {
+	names := slices.Clone(cert.DNSNames)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+
+	wanted := slices.Clone(hosts)
+	slices.Sort(names)
+	slices.Sort(wanted)
+
+	return slices.Equal(names, wanted)
+}
+
+// traefikTLSFiles are the certificate, its key and the dynamic configuration loading them by their path in traefik
+func traefikTLSFiles(args *ArgsFlags) (map[string]string, error) {
+	dir := tlsDir(args)
+	files := map[string]string{traefikTLSConfigName: traefikTLSConfig}
+	for _, name := range []string{serverCertFileName, serverKeyFileName} {
+		content, err := os.ReadFile(path.Join(dir, name)) //#nosec G304 -- the directory of the settings
+		if err != nil {
+			return nil, err
+		}
+		files[path.Join(traefikCertsDir, name)] = string(content)
+	}
+
+	return files, nil
+}
+
+// copyTraefikTLS copies the self-signed certificate into traefik, the file provider picks it up
+func copyTraefikTLS(ctx context.Context, name string, args *ArgsFlags) error {
+	files, err := traefikTLSFiles(args)
+	if err != nil {
+		return fmt.Errorf("failed to read the self-signed certificate: %w", err)
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	// the certificates first, so the configuration refers to existing files when it is loaded
+	for _, file := range []string{path.Join(traefikCertsDir, serverCertFileName), path.Join(traefikCertsDir, serverKeyFileName),
+		traefikTLSConfigName} {
+		content := files[file]
+		err = dagentutils.WriteContainerFile(ctx, cli, name, file, v1.UploadFileData{FilePath: "/etc"},
+			int64(len(content)), strings.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("failed to copy %s into traefik: %w", file, err)
+		}
+	}
+
+	return nil
+}
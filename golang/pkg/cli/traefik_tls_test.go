@@ -0,0 +1,126 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func readTestCertificate(t *testing.T, certPath string) *x509.Certificate {
+	t.Helper()
+
+	content, err := os.ReadFile(certPath)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(content)
+	assert.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+
+	return cert
+}
+
+func TestTLSSettingsValidate(t *testing.T) {
+	assert.NoError(t, (&cli.TLSSettings{}).Validate())
+	assert.NoError(t, (&cli.TLSSettings{Mode: cli.TLSModeSelfSigned}).Validate())
+	assert.NoError(t, (&cli.TLSSettings{Mode: cli.TLSModeACME, Domain: "dyo.example.com", ACMEEmail: "admin@example.com"}).Validate())
+
+	assert.ErrorIs(t, (&cli.TLSSettings{Mode: "letsencrypt"}).Validate(), cli.ErrInvalidTLSSettings)
+	assert.ErrorIs(t, (&cli.TLSSettings{Mode: cli.TLSModeACME, Domain: "dyo.example.com"}).Validate(), cli.ErrInvalidTLSSettings)
+	assert.ErrorIs(t, (&cli.TLSSettings{Mode: cli.TLSModeACME, Domain: "localhost", ACMEEmail: "admin@example.com"}).Validate(),
+		cli.ErrInvalidTLSSettings)
+	assert.ErrorIs(t, (&cli.TLSSettings{Mode: cli.TLSModeACME, Domain: "10.0.0.1", ACMEEmail: "admin@example.com"}).Validate(),
+		cli.ErrInvalidTLSSettings)
+}
+
+func TestTraefikTLSArgs(t *testing.T) {
+	assert.Empty(t, cli.TraefikTLSArgs(&cli.TLSSettings{}))
+
+	selfSigned := cli.TraefikTLSArgs(&cli.TLSSettings{Mode: cli.TLSModeSelfSigned})
+	assert.Contains(t, selfSigned, "--entrypoints.websecure.address=:8443")
+	assert.Contains(t, selfSigned, "--entrypoints.websecure.http.tls=true")
+	assert.NotContains(t, selfSigned, "--certificatesresolvers.acme.acme.email=admin@example.com")
+
+	acme := cli.TraefikTLSArgs(&cli.TLSSettings{
+		Mode:         cli.TLSModeACME,
+		Domain:       "dyo.example.com",
+		ACMEEmail:    "admin@example.com",
+		ACMECAServer: "https://acme-staging-v02.api.letsencrypt.org/directory",
+	})
+	assert.Contains(t, acme, "--entrypoints.websecure.http.tls.certresolver=acme")
+	assert.Contains(t, acme, "--entrypoints.websecure.http.tls.domains[0].main=dyo.example.com")
+	assert.Contains(t, acme, "--certificatesresolvers.acme.acme.email=admin@example.com")
+	assert.Contains(t, acme, "--certificatesresolvers.acme.acme.storage=/letsencrypt/acme.json")
+	assert.Contains(t, acme, "--certificatesresolvers.acme.acme.caserver=https://acme-staging-v02.api.letsencrypt.org/directory")
+
+	// the ingress terminates TLS in the cluster
+	for _, it := range cli.TraefikArgs(append([]string{"--entrypoints.web.address=:8000"}, acme...)) {
+		assert.NotContains(t, it, "websecure")
+		assert.NotContains(t, it, "certificatesresolvers")
+	}
+}
+
+func TestPublicURL(t *testing.T) {
+	settings := &cli.SettingsFile{Options: cli.Options{TraefikWebPort: 8000, TraefikTLSPort: 8443}}
+	assert.Equal(t, "http://localhost:8000", cli.PublicURL(settings, "localhost"))
+
+	settings.TLS = cli.TLSSettings{Mode: cli.TLSModeSelfSigned}
+	assert.Equal(t, "https://localhost:8443", cli.PublicURL(settings, "localhost"))
+
+	settings.TLS = cli.TLSSettings{Mode: cli.TLSModeACME, Domain: "dyo.example.com", ACMEEmail: "admin@example.com"}
+	settings.TraefikTLSPort = 443
+	assert.Equal(t, "https://dyo.example.com", cli.PublicURL(settings, "localhost"))
+}
+
+func TestRenderTraefikConfigurationTLS(t *testing.T) {
+	plain, err := cli.RenderTraefikConfiguration("host.docker.internal", "", 1848, 3000, false)
+	assert.NoError(t, err)
+	assert.NotContains(t, plain, "websecure")
+
+	secure, err := cli.RenderTraefikConfiguration("host.docker.internal", "", 1848, 3000, true)
+	assert.NoError(t, err)
+	assert.Contains(t, secure, "- websecure")
+}
+
+func TestEnsureSelfSignedCerts(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	assert.NoError(t, cli.EnsureSelfSignedCerts(dir, []string{"localhost", "127.0.0.1"}, now))
+
+	ca := readTestCertificate(t, path.Join(dir, "ca.crt"))
+	cert := readTestCertificate(t, path.Join(dir, "tls.crt"))
+	assert.True(t, ca.IsCA)
+	assert.NoError(t, cert.CheckSignatureFrom(ca))
+	assert.Equal(t, []string{"localhost"}, cert.DNSNames)
+	assert.Len(t, cert.IPAddresses, 1)
+
+	info, err := os.Stat(path.Join(dir, "ca.key"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the valid certificate is kept
+	assert.NoError(t, cli.EnsureSelfSignedCerts(dir, []string{"localhost", "127.0.0.1"}, now))
+	assert.Equal(t, cert.SerialNumber, readTestCertificate(t, path.Join(dir, "tls.crt")).SerialNumber)
+
+	// a new host reissues the certificate by the same CA
+	assert.NoError(t, cli.EnsureSelfSignedCerts(dir, []string{"localhost", "127.0.0.1", "dyo.local"}, now))
+	reissued := readTestCertificate(t, path.Join(dir, "tls.crt"))
+	assert.NotEqual(t, cert.SerialNumber, reissued.SerialNumber)
+	assert.Equal(t, []string{"localhost", "dyo.local"}, reissued.DNSNames)
+	assert.Equal(t, ca.SerialNumber, readTestCertificate(t, path.Join(dir, "ca.crt")).SerialNumber)
+	assert.NoError(t, reissued.CheckSignatureFrom(ca))
+
+	// an expiring certificate is renewed
+	assert.NoError(t, cli.EnsureSelfSignedCerts(dir, []string{"localhost", "127.0.0.1", "dyo.local"}, now.Add(800*24*time.Hour)))
+	assert.NotEqual(t, reissued.SerialNumber, readTestCertificate(t, path.Join(dir, "tls.crt")).SerialNumber)
+}
@@ -0,0 +1,560 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+const (
+	FlagRollback = "rollback"
+)
+
+const (
+	backupDirName  = "backups"
+	backupMetaFile = "backup.yaml"
+	// the backup is written into a hidden directory until it is complete
+	partialBackupPrefix = ".partial-"
+	backupTimeFormat    = "20060102T150405Z"
+
+	shortImageIDLength = 12
+)
+
+// the origin of a backup, only the ones taken by the upgrade are pruned, the backups of the older versions
+// have no origin, those were all taken by the upgrade
+const (
+	backupOriginUpgrade = "upgrade"
+	backupOriginManual  = "manual"
+)
+
+var ErrNoBackup = errors.New("there is no database backup to roll back to")
+
+// backupMeta describes a backup, the hash of the crux-encryption-key is empty in the backups of the older versions
+type backupMeta struct {
+	CreatedAt         time.Time `yaml:"createdAt"`
+	Version           string    `yaml:"version"`
+	EncryptionKeyHash string    `yaml:"encryptionKeyHash,omitempty"`
+	Origin            string    `yaml:"origin,omitempty"`
+}
+
+// origin is the command the backup was taken by
+func (m *backupMeta) origin() string {
+	if m.Origin == "" {
+		return backupOriginUpgrade
+	}
+
+	return m.Origin
+}
+
+type postgresTarget struct {
+	// the connection of an external database, it is reached by a client container instead of the bundled one
+	external *postgresConnection
+	id       stackItemID
+	// the image of the client container, the postgres image of the stack if empty
+	clientImage string
+	container   string
+	user        string
+	db          string
+}
+
+func postgresTargetOf(id stackItemID, container string, external *ExternalPostgres, connection postgresConnection) postgresTarget {
+	target := postgresTarget{id: id, container: container, user: connection.user, db: connection.database}
+	if external.Enabled() {
+		target.external = &connection
+		target.container = container + "-client"
+		target.clientImage = external.ClientImage
+	}
+
+	return target
+}
+
+// stackPostgresTargets are the databases of crux and kratos, bundled or external
+func stackPostgresTargets(state *State) []postgresTarget {
+	return []postgresTarget{
+		postgresTargetOf(cruxPostgres, state.Containers.CruxPostgres.Name,
+			&state.SettingsFile.CruxExternalPostgres, cruxPostgresConnection(state)),
+		postgresTargetOf(kratosPostgres, state.Containers.KratosPostgres.Name,
+			&state.SettingsFile.KratosExternalPostgres, kratosPostgresConnection(state)),
+	}
+}
+
+// postgresTargets are the bundled databases, the external ones are backed up by their operators or by dyo backup
+func postgresTargets(state *State) []postgresTarget {
+	targets := []postgresTarget{}
+	for _, target := range stackPostgresTargets(state) {
+		if target.external != nil {
+			log.Warn().Str("database", string(target.id)).Str("host", target.external.host).
+				Msg("The external database is not backed up by the upgrade, use dyo backup")
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+// backupRoot is the directory of the backups, the one next to the settings unless an other is given
+func backupRoot(args *ArgsFlags) string {
+	if args.BackupDir != "" {
+		return args.BackupDir
+	}
+
+	return path.Join(path.Dir(args.SettingsFilePath), prefixedFileName(backupDirName, args.Prefix))
+}
+
+// upgradeStack dumps the databases before the migrations of the new version run,
+// or restores the latest dumps with the version they were taken from in rollback mode
+func upgradeStack(ctx context.Context, initialState *State, stack *dyrectorioStack, args *ArgsFlags) error {
+	previousVersion := ReadExistingSettings(args).Version
+
+	var backupDir string
+	if args.Rollback {
+		dir, meta, err := latestBackup(backupRoot(args), backupOriginUpgrade)
+		if err != nil {
+			return fmt.Errorf("%s: %w", backupRoot(args), err)
+		}
+		backupDir = dir
+		args.ImageTag = meta.Version
+		log.Info().Str("backup", dir).Str("version", meta.Version).Msg("Rolling back")
+	}
+
+	// the new version is persisted, the next up shouldn't downgrade silently
+	args.SettingsWrite = true
+	state, err := SettingsFileDefaults(initialState, args)
+	if err != nil {
+		return err
+	}
+	notifiers := settingsNotifiers(state.SettingsFile.Notifications)
+	notifyOnFatal(ctx, notifiers, args)
+
+	for _, service := range pinnableServices() {
+		if pin, ok := state.SettingsFile.Images[service]; ok {
+			log.Info().Str("service", service).Str("image", pin).Msg("Pinned in the settings, the version does not change it")
+		}
+	}
+
+	if err = checkTraefikTemplate(state, args); err != nil {
+		return err
+	}
+	if args.Locked {
+		if err = loadLockedImages(state, args); err != nil {
+			return err
+		}
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	if !args.Rollback {
+		_, err = backupDatabases(ctx, cli, state, args, postgresTargets(state), previousVersion, backupOriginUpgrade)
+		if err != nil {
+			return fmt.Errorf("failed to back up the databases, the upgrade is aborted: %w", err)
+		}
+		if err = pruneBackups(backupRoot(args), state.SettingsFile.UpgradeBackupRetention); err != nil {
+			return err
+		}
+	}
+
+	if err = addStackBuilders(stack, state, args); err != nil {
+		return err
+	}
+	if err = ensureStackImages(ctx, state, args); err != nil {
+		return err
+	}
+
+	started := stackStartEvent(ctx, state, args)
+	if args.Rollback {
+		// the services are stopped, so nothing holds a connection while the dumps are restored
+		if err = stopStack(ctx, cli, args.Prefix, state.SettingsFile.StopGracePeriods); err != nil {
+			return fmt.Errorf("failed to stop the stack before restoring the databases: %w", err)
+		}
+		if _, err = startStackItems(ctx, cli, stack, []stackItemID{cruxPostgres, kratosPostgres}); err != nil {
+			return startFailure(err)
+		}
+		if _, err = restoreDatabases(ctx, cli, state, args, postgresTargets(state), backupDir); err != nil {
+			return fmt.Errorf("failed to restore the databases from %s: %w", backupDir, err)
+		}
+		if err = StartContainers(ctx, stack); err != nil {
+			return err
+		}
+	} else if _, err = recreateOutdated(ctx, cli, stack, stackItemContainers(state)); err != nil {
+		return err
+	}
+	PrintInfo(state, args)
+	state.Result = stackUpResultOf(state, args)
+	notifyAll(ctx, notifiers, started)
+
+	return nil
+}
+
+// stackItemContainer is the container of a stack item with the image it has to run
+type stackItemContainer struct {
+	name  string
+	image string
+}
+
+func stackItemContainers(state *State) map[stackItemID]stackItemContainer {
+	return map[stackItemID]stackItemContainer{
+		traefik:        {name: state.Containers.Traefik.Name, image: state.serviceImage(string(traefik))},
+		crux:           {name: state.Containers.Crux.Name, image: state.serviceImage(string(crux))},
+		cruxUI:         {name: state.Containers.CruxUI.Name, image: state.serviceImage(string(cruxUI))},
+		kratos:         {name: state.Containers.Kratos.Name, image: state.serviceImage(string(kratos))},
+		cruxPostgres:   {name: state.Containers.CruxPostgres.Name, image: state.serviceImage(postgresService)},
+		kratosPostgres: {name: state.Containers.KratosPostgres.Name, image: state.serviceImage(postgresService)},
+		mailSlurper:    {name: state.Containers.MailSlurper.Name, image: state.serviceImage(string(mailSlurper))},
+	}
+}
+
+// outdatedStackItems are the items in start order whose container is missing, not running,
+// or was created from an other image than the one the reference points to after the pull
+func outdatedStackItems(ctx context.Context, cli client.APIClient, stack *dyrectorioStack,
+	containers map[stackItemID]stackItemContainer,
+) ([]stackItemID, error) {
+	outdated := []stackItemID{}
+	for _, id := range startOrder {
+		if _, ok := stack.builders[id]; !ok {
+			continue
+		}
+		cont := containers[id]
+
+		inspect, err := cli.ContainerInspect(ctx, cont.name)
+		if errdefs.IsNotFound(err) {
+			log.Info().Str("container", cont.name).Msg("Missing, it will be created")
+			outdated = append(outdated, id)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect %s: %w", cont.name, err)
+		}
+
+		image, _, err := cli.ImageInspectWithRaw(ctx, cont.image)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect the image %s: %w", cont.image, err)
+		}
+
+		switch {
+		case inspect.Image != image.ID:
+			log.Info().Str("container", cont.name).Str("image", cont.image).
+				Str("from", shortImageID(inspect.Image)).Str("to", shortImageID(image.ID)).Msg("Outdated, it will be recreated")
+		case !inspect.State.Running:
+			log.Info().Str("container", cont.name).Str("state", inspect.State.Status).Msg("Not running, it will be recreated")
+		default:
+			log.Debug().Str("container", cont.name).Str("image", cont.image).Msg("Up to date")
+			continue
+		}
+		outdated = append(outdated, id)
+	}
+
+	return outdated, nil
+}
+
+func shortImageID(id string) string {
+	id = strings.TrimPrefix(id, "sha256:")
+	if len(id) > shortImageIDLength {
+		return id[:shortImageIDLength]
+	}
+
+	return id
+}
+
+// recreateOutdated recreates only the outdated items in dependency order, crux and kratos run their migrations
+// before they start, so the migrations only run again if their images changed, the result is the recreated items
+func recreateOutdated(ctx context.Context, cli client.APIClient, stack *dyrectorioStack,
+	containers map[stackItemID]stackItemContainer,
+) ([]stackItemID, error) {
+	outdated, err := outdatedStackItems(ctx, cli, stack, containers)
+	if err != nil {
+		return nil, err
+	}
+	if len(outdated) == 0 {
+		log.Info().Msg("Every container runs the latest image, there is nothing to recreate")
+		return outdated, nil
+	}
+
+	if _, err = startStackItems(ctx, cli, stack, outdated); err != nil {
+		// the old containers are already replaced, removing the new ones would leave the stack without them
+		log.Warn().Msg("The stack is partially upgraded, use --rollback to restore the previous version")
+		return outdated, startFailure(err)
+	}
+
+	return outdated, nil
+}
+
+// backupDatabases dumps the targets into a new timestamped directory of the backup root, the result is the names
+// of the dumped databases, nothing is written if there is no database to dump, the dumps are written into a hidden
+// directory renamed only when all of them succeeded, so an interrupted backup is never restored
+func backupDatabases(ctx context.Context, cli *client.Client, state *State, args *ArgsFlags,
+	targets []postgresTarget, version, origin string,
+) (*backupResult, error) {
+	name := fmt.Sprintf("%s-%s", time.Now().UTC().Format(backupTimeFormat), version)
+	dir := path.Join(backupRoot(args), name)
+	partialDir := path.Join(backupRoot(args), partialBackupPrefix+name)
+	defer logdefer.LogDeferredErr(func() error {
+		return os.RemoveAll(partialDir)
+	}, log.Warn(), "failed to remove the partial backup")
+
+	result := &backupResult{Path: dir, Databases: []string{}}
+	for _, target := range targets {
+		dumped, err := withPostgres(ctx, cli, state, args, target, func(containerID string) error {
+			if err := os.MkdirAll(partialDir, dirPerms); err != nil {
+				return fmt.Errorf("failed to create backup directory: %w", err)
+			}
+
+			return dumpDatabase(ctx, cli, containerID, target, path.Join(partialDir, string(target.id)+".dump"))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if dumped {
+			result.Databases = append(result.Databases, string(target.id))
+		}
+	}
+
+	if len(result.Databases) == 0 {
+		return result, nil
+	}
+
+	meta, err := yaml.Marshal(backupMeta{
+		Version:           version,
+		CreatedAt:         time.Now().UTC(),
+		EncryptionKeyHash: encryptionKeyHash(state.SettingsFile.CruxEncryptionKey),
+		Origin:            origin,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(path.Join(partialDir, backupMetaFile), meta, filePerms); err != nil {
+		return nil, fmt.Errorf("failed to write backup metadata: %w", err)
+	}
+	if err = os.Rename(partialDir, dir); err != nil {
+		return nil, fmt.Errorf("failed to complete the backup: %w", err)
+	}
+	log.Info().Str("path", dir).Strs("databases", result.Databases).Msg("Databases are backed up")
+
+	return result, nil
+}
+
+func dumpDatabase(ctx context.Context, cli *client.Client, containerID string, target postgresTarget, dumpPath string) error {
+	file, err := os.OpenFile(dumpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePerms)
+	if err != nil {
+		return fmt.Errorf("failed to create dump file: %w", err)
+	}
+	defer logdefer.LogDeferredErr(file.Close, log.Warn(), "error closing dump file")
+
+	cmd := []string{"pg_dump", "--format=custom", "-U", target.user, "-d", target.db}
+	if err = execPostgres(ctx, cli, containerID, cmd, nil, file); err != nil {
+		return fmt.Errorf("failed to dump %s: %w", target.container, err)
+	}
+
+	return nil
+}
+
+// restoreDatabases restores the dumps of the directory into the targets, the result is the names of the restored
+// databases, the targets without a dump are skipped
+func restoreDatabases(ctx context.Context, cli *client.Client, state *State, args *ArgsFlags,
+	targets []postgresTarget, dir string,
+) (*backupResult, error) {
+	result := &backupResult{Path: dir, Databases: []string{}}
+	for _, target := range targets {
+		dumpPath := path.Join(dir, string(target.id)+".dump")
+		if _, err := os.Stat(dumpPath); errors.Is(err, os.ErrNotExist) {
+			log.Warn().Str("database", string(target.id)).Msg("There is no dump for the database, skipping")
+			continue
+		}
+
+		restored, err := withPostgres(ctx, cli, state, args, target, func(containerID string) error {
+			if err := waitForPostgres(ctx, cli, containerID, target); err != nil {
+				return err
+			}
+
+			return restoreDatabase(ctx, cli, containerID, target, dumpPath)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !restored {
+			return nil, fmt.Errorf("database container %s is not found", target.container)
+		}
+
+		log.Info().Str("database", string(target.id)).Msg("Database restored")
+		result.Databases = append(result.Databases, string(target.id))
+	}
+
+	return result, nil
+}
+
+func restoreDatabase(ctx context.Context, cli *client.Client, containerID string, target postgresTarget, dumpPath string) error {
+	file, err := os.Open(dumpPath) //#nosec G304 -- backups are created by the upgrade command
+	if err != nil {
+		return fmt.Errorf("failed to open dump file: %w", err)
+	}
+	defer logdefer.LogDeferredErr(file.Close, log.Warn(), "error closing dump file")
+
+	cmd := []string{"pg_restore", "--clean", "--if-exists", "--no-owner", "-U", target.user, "-d", target.db}
+	if err = execPostgres(ctx, cli, containerID, cmd, file, io.Discard); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", target.container, err)
+	}
+
+	return nil
+}
+
+func waitForPostgres(ctx context.Context, cli *client.Client, containerID string, target postgresTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, healhProbeTimeout)
+	defer cancel()
+
+	cmd := []string{"pg_isready", "-U", target.user, "-d", target.db}
+	for {
+		err := execPostgres(ctx, cli, containerID, cmd, nil, io.Discard)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database %s is not ready: %w", target.container, err)
+		case <-time.After(healhProbeInterval):
+		}
+	}
+}
+
+// execPostgres runs a postgres client tool in the container, stdin is streamed to it when given
+func execPostgres(ctx context.Context, cli *client.Client, containerID string, cmd []string, stdin io.Reader, stdout io.Writer) error {
+	builder := containerbuilder.NewExecBuilder(ctx, &containerID).
+		WithClient(cli).
+		WithCmd(cmd).
+		WithAttachStdout().
+		WithAttachStderr()
+	if stdin != nil {
+		builder = builder.WithAttachStdin()
+	}
+
+	exec, err := builder.Create()
+	if err != nil {
+		return err
+	}
+
+	resp, err := exec.Attach()
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	if stdin != nil {
+		if _, err = io.Copy(resp.Conn, stdin); err != nil {
+			return err
+		}
+		if err = resp.CloseWrite(); err != nil {
+			return err
+		}
+	}
+
+	var stderr bytes.Buffer
+	if _, err = stdcopy.StdCopy(stdout, &stderr, resp.Reader); err != nil {
+		return err
+	}
+
+	exitCode, err := exec.ExitCode()
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", cmd[0], exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+// backupDirs returns the backups from the oldest to the newest, the names start with a sortable timestamp,
+// the partial backups and the directories without metadata are left out
+func backupDirs(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), partialBackupPrefix) {
+			continue
+		}
+		if _, err = os.Stat(path.Join(root, entry.Name(), backupMetaFile)); err != nil {
+			log.Debug().Str("dir", entry.Name()).Msg("Not a backup, it has no metadata")
+			continue
+		}
+		dirs = append(dirs, entry.Name())
+	}
+	sort.Strings(dirs)
+
+	return dirs, nil
+}
+
+// latestBackup is the newest backup taken by the command of the origin
+func latestBackup(root, origin string) (string, *backupMeta, error) {
+	dirs, err := backupDirs(root)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for i := len(dirs) - 1; i >= 0; i-- {
+		dir := path.Join(root, dirs[i])
+		meta, err := readBackupMeta(dir)
+		if err != nil {
+			return "", nil, err
+		}
+		if meta.origin() == origin {
+			return dir, meta, nil
+		}
+	}
+
+	return "", nil, ErrNoBackup
+}
+
+// pruneBackups keeps the newest backups of the upgrade, the ones taken by dyo backup are kept until removed by hand
+func pruneBackups(root string, keep uint) error {
+	dirs, err := backupDirs(root)
+	if err != nil {
+		return err
+	}
+
+	upgradeDirs := []string{}
+	for _, it := range dirs {
+		meta, err := readBackupMeta(path.Join(root, it))
+		if err != nil {
+			return err
+		}
+		if meta.origin() == backupOriginUpgrade {
+			upgradeDirs = append(upgradeDirs, it)
+		}
+	}
+
+	for len(upgradeDirs) > int(keep) {
+		if err = os.RemoveAll(path.Join(root, upgradeDirs[0])); err != nil {
+			return fmt.Errorf("failed to remove old backup: %w", err)
+		}
+		log.Info().Str("backup", upgradeDirs[0]).Msg("Old backup removed")
+		upgradeDirs = upgradeDirs[1:]
+	}
+
+	return nil
+}
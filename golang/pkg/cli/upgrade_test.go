@@ -0,0 +1,107 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/stretchr/testify/assert"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+func createBackup(t *testing.T, root, name, version string) {
+	t.Helper()
+
+	assert.NoError(t, os.MkdirAll(path.Join(root, name), 0o750))
+	assert.NoError(t, os.WriteFile(path.Join(root, name, "backup.yaml"), []byte("version: "+version+"\n"), 0o600))
+}
+
+func TestLatestBackup(t *testing.T) {
+	root := t.TempDir()
+
+	_, _, err := cli.LatestBackupVersion(root)
+	assert.ErrorIs(t, err, cli.ErrNoBackup)
+
+	createBackup(t, root, "20240101T100000Z-0.10.0", "0.10.0")
+	createBackup(t, root, "20240301T100000Z-0.11.0", "0.11.0")
+	createManualBackup(t, root, "20240401T100000Z-0.11.0", "0.11.0")
+
+	// an interrupted backup and a directory without metadata are not backups
+	assert.NoError(t, os.MkdirAll(path.Join(root, ".partial-20240501T100000Z-0.11.0"), 0o750))
+	assert.NoError(t, os.MkdirAll(path.Join(root, "20240601T100000Z-0.11.0"), 0o750))
+
+	dir, version, err := cli.LatestBackupVersion(root)
+	assert.NoError(t, err)
+	assert.Equal(t, path.Join(root, "20240301T100000Z-0.11.0"), dir)
+	assert.Equal(t, "0.11.0", version)
+}
+
+func TestPruneBackups(t *testing.T) {
+	root := t.TempDir()
+	createBackup(t, root, "20240101T100000Z-0.9.0", "0.9.0")
+	createBackup(t, root, "20240201T100000Z-0.10.0", "0.10.0")
+	createBackup(t, root, "20240301T100000Z-0.11.0", "0.11.0")
+	createManualBackup(t, root, "20231201T100000Z-0.9.0", "0.9.0")
+
+	assert.NoError(t, cli.PruneBackups(root, 2))
+
+	entries, err := os.ReadDir(root)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	// the backups of dyo backup are not pruned
+	assert.Equal(t, "20231201T100000Z-0.9.0", entries[0].Name())
+	assert.Equal(t, "20240201T100000Z-0.10.0", entries[1].Name())
+}
+
+func TestRecreateOutdated(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+
+	images := map[string]string{}
+	builders := map[string]containerbuilder.Builder{}
+	for _, it := range stackItems {
+		images[it] = "example.com/" + it + ":latest"
+		docker.AddImage(images[it])
+		builders[it] = containerbuilder.NewDockerBuilder(ctx).
+			WithClient(docker).
+			WithImage(images[it]).
+			WithImagePriority(imageHelper.PreferLocal).
+			WithName(it).
+			WithoutConflict()
+	}
+	assert.NoError(t, cli.StartStack(ctx, docker, builders, &cli.ArgsFlags{}))
+
+	recreated, err := cli.RecreateOutdated(ctx, docker, builders, images, &cli.ArgsFlags{})
+	assert.NoError(t, err)
+	assert.Empty(t, recreated)
+
+	// a newer crux image is pulled and mailslurper was removed
+	_, err = docker.ImageRemove(ctx, images["crux"], image.RemoveOptions{Force: true})
+	assert.NoError(t, err)
+	docker.AddImage(images["crux"])
+	assert.NoError(t, docker.ContainerRemove(ctx, "mailslurper", container.RemoveOptions{Force: true}))
+	uiID := containerID(t, docker, "crux-ui")
+	startsBefore := len(startedOrder(docker))
+
+	recreated, err = cli.RecreateOutdated(ctx, docker, builders, images, &cli.ArgsFlags{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"crux", "mailslurper"}, recreated)
+	assert.Len(t, startedOrder(docker)[startsBefore:], 2)
+	assert.Equal(t, uiID, containerID(t, docker, "crux-ui"))
+
+	inspect, err := docker.ContainerInspect(ctx, "crux")
+	assert.NoError(t, err)
+	newImage, _, err := docker.ImageInspectWithRaw(ctx, images["crux"])
+	assert.NoError(t, err)
+	assert.Equal(t, newImage.ID, inspect.Image)
+}
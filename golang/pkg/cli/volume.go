@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	ucli "github.com/urfave/cli/v2"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+)
+
+const (
+	VolumeCommand       = "volume"
+	VolumeListCommand   = "ls"
+	VolumeRemoveCommand = "rm"
+)
+
+const (
+	FlagVolumes   = "volumes"
+	FlagVolumeAll = "all"
+)
+
+var ErrNotStackVolume = errors.New("the volume does not belong to the stack")
+
+func GetVolumeCommand() *ucli.Command {
+	return &ucli.Command{
+		Name:   VolumeCommand,
+		Usage:  "Manage the volumes created for the stack, which keep its data between runs",
+		Action: ucli.ShowSubcommandHelp,
+		Subcommands: []*ucli.Command{
+			{
+				Name:   VolumeListCommand,
+				Usage:  "List the volumes of the stack with their size",
+				Action: volumeList,
+			},
+			{
+				Name:      VolumeRemoveCommand,
+				Usage:     "Remove volumes of the stack, their containers have to be removed first with down",
+				ArgsUsage: "[volume...]",
+				Flags: []ucli.Flag{
+					&ucli.BoolFlag{
+						Name:  FlagVolumeAll,
+						Value: false,
+						Usage: "remove every volume of the stack",
+					},
+				},
+				Action: volumeRemove,
+			},
+		},
+	}
+}
+
+// legacyStackVolumeNames are the volumes of the databases created before the volumes were labeled
+func legacyStackVolumeNames(prefix string) []string {
+	return []string{
+		fmt.Sprintf("%s_crux-postgres-data", prefix),
+		fmt.Sprintf("%s_kratos-postgres-data", prefix),
+	}
+}
+
+// stackVolumes are the volumes created by the CLI for the stack, they are labeled with its prefix,
+// except the database volumes of the stacks created before the labels, these are matched by their names
+func stackVolumes(ctx context.Context, cli client.APIClient, prefix string) ([]*volume.Volume, error) {
+	resp, err := cli.VolumeList(ctx, volume.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(prefix))),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the volumes of the stack: %w", err)
+	}
+
+	legacy, err := cli.VolumeList(ctx, volume.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", prefix+"_")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the volumes of the stack: %w", err)
+	}
+
+	volumes := resp.Volumes
+	for _, it := range legacy.Volumes {
+		if _, labeled := it.Labels[label.DyrectorioOrg+label.ContainerPrefix]; !labeled &&
+			slices.Contains(legacyStackVolumeNames(prefix), it.Name) {
+			volumes = append(volumes, it)
+		}
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Name < volumes[j].Name })
+
+	return volumes, nil
+}
+
+// removeStackVolumes removes the named volumes, every volume of the stack if none is given
+func removeStackVolumes(ctx context.Context, cli client.APIClient, prefix string, names []string) error {
+	volumes, err := stackVolumes(ctx, cli, prefix)
+	if err != nil {
+		return err
+	}
+
+	owned := map[string]bool{}
+	for _, it := range volumes {
+		owned[it.Name] = true
+	}
+	if len(names) == 0 {
+		for _, it := range volumes {
+			names = append(names, it.Name)
+		}
+	}
+
+	var errs error
+	for _, name := range names {
+		if !owned[name] {
+			errs = errors.Join(errs, fmt.Errorf("%w %s: %s", ErrNotStackVolume, prefix, name))
+			continue
+		}
+
+		if err = cli.VolumeRemove(ctx, name, false); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to remove the volume %s: %w", name, err))
+			continue
+		}
+		log.Info().Str("volume", name).Msg("Volume removed")
+	}
+
+	return errs
+}
+
+// removeContainerVolumes removes the containers of the stack together with their anonymous volumes,
+// the stack has no anonymous volumes, unless it is fully containerized
+func removeContainerVolumes(ctx context.Context, cli client.APIClient, prefix string) error {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(prefix))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the containers of %s: %w", prefix, err)
+	}
+
+	var errs error
+	for i := range containers {
+		err = cli.ContainerRemove(ctx, containers[i].ID, container.RemoveOptions{Force: true, RemoveVolumes: true})
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to remove the container %s: %w", containers[i].ID, err))
+		}
+	}
+
+	return errs
+}
+
+func writeStackVolumes(w io.Writer, volumes []*volume.Volume, sizes []volumeStats) error {
+	sizeOf := map[string]int64{}
+	for _, it := range sizes {
+		sizeOf[it.Name] = it.Size
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VOLUME\tDRIVER\tSIZE")
+	for _, it := range volumes {
+		size, ok := sizeOf[it.Name]
+		if !ok {
+			size = -1
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", it.Name, it.Driver, formatSize(size))
+	}
+
+	return tw.Flush()
+}
+
+func volumeList(cCtx *ucli.Context) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	volumes, err := stackVolumes(cCtx.Context, cli, cCtx.String(FlagPrefix))
+	if err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+	for _, it := range volumes {
+		names[it.Name] = true
+	}
+	sizes, err := stackVolumeSizes(cCtx.Context, cli, names)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to get the size of the volumes")
+	}
+
+	return writeStackVolumes(os.Stdout, volumes, sizes)
+}
+
+func volumeRemove(cCtx *ucli.Context) error {
+	names := cCtx.Args().Slice()
+	all := cCtx.Bool(FlagVolumeAll)
+	if len(names) == 0 && !all {
+		return fmt.Errorf("give the volumes to remove or use --%s", FlagVolumeAll)
+	}
+	if len(names) > 0 && all {
+		return fmt.Errorf("--%s removes every volume, the volumes %s are not needed", FlagVolumeAll, strings.Join(names, ", "))
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	return removeStackVolumes(cCtx.Context, cli, cCtx.String(FlagPrefix), names)
+}
@@ -0,0 +1,87 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+func createStackVolumes(t *testing.T, docker *dockerfake.Client) {
+	t.Helper()
+
+	for name, prefix := range map[string]string{
+		"dyo-stable_crux-postgres-data":   "dyo-stable",
+		"dyo-stable_kratos-postgres-data": "dyo-stable",
+		"dyo-latest_crux-postgres-data":   "dyo-latest",
+	} {
+		_, err := docker.VolumeCreate(context.Background(), volume.CreateOptions{
+			Name:   name,
+			Labels: map[string]string{label.DyrectorioOrg + label.ContainerPrefix: prefix},
+		})
+		assert.NoError(t, err)
+	}
+	_, err := docker.VolumeCreate(context.Background(), volume.CreateOptions{Name: "unrelated"})
+	assert.NoError(t, err)
+}
+
+func volumeNames(t *testing.T, docker *dockerfake.Client) []string {
+	t.Helper()
+
+	resp, err := docker.VolumeList(context.Background(), volume.ListOptions{})
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, it := range resp.Volumes {
+		names = append(names, it.Name)
+	}
+
+	return names
+}
+
+func TestRemoveStackVolumes(t *testing.T) {
+	docker := dockerfake.New()
+	createStackVolumes(t, docker)
+
+	err := cli.RemoveStackVolumes(context.Background(), docker, "dyo-stable", []string{"dyo-stable_crux-postgres-data", "unrelated"})
+	assert.ErrorIs(t, err, cli.ErrNotStackVolume)
+	assert.Equal(t, []string{"dyo-latest_crux-postgres-data", "dyo-stable_kratos-postgres-data", "unrelated"}, volumeNames(t, docker))
+
+	assert.NoError(t, cli.RemoveStackVolumes(context.Background(), docker, "dyo-stable", nil))
+	assert.Equal(t, []string{"dyo-latest_crux-postgres-data", "unrelated"}, volumeNames(t, docker))
+}
+
+func TestRemoveLegacyStackVolumes(t *testing.T) {
+	docker := dockerfake.New()
+	// the database volumes of the stacks created before the labels have no labels
+	for _, name := range []string{"dyo-stable_crux-postgres-data", "dyo-stable_kratos-postgres-data", "dyo-stable_custom-data"} {
+		_, err := docker.VolumeCreate(context.Background(), volume.CreateOptions{Name: name})
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, cli.RemoveStackVolumes(context.Background(), docker, "dyo-stable", nil))
+	assert.Equal(t, []string{"dyo-stable_custom-data"}, volumeNames(t, docker))
+}
+
+func TestWriteStackVolumes(t *testing.T) {
+	volumes := []*volume.Volume{
+		{Name: "dyo-stable_crux-postgres-data", Driver: "local"},
+		{Name: "dyo-stable_kratos-postgres-data", Driver: "local"},
+	}
+	sizes := []cli.VolumeStats{{Name: "dyo-stable_crux-postgres-data", Size: 2048}}
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, cli.WriteStackVolumes(out, volumes, sizes))
+	assert.Equal(t, "VOLUME                           DRIVER  SIZE\n"+
+		"dyo-stable_crux-postgres-data    local   2.0KiB\n"+
+		"dyo-stable_kratos-postgres-data  local   -\n", out.String())
+}
@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+)
+
+// restart policies of the watch command
+const (
+	RestartPolicyNever     = "never"
+	RestartPolicyOnFailure = "on-failure"
+	RestartPolicyUnhealthy = "unhealthy"
+)
+
+var (
+	ErrInvalidRestartPolicy = errors.New("invalid restart policy")
+	ErrInvalidWatchInterval = errors.New("the watch interval has to be positive")
+)
+
+type memberHealth string
+
+const (
+	memberHealthy   memberHealth = ""
+	memberCrashed   memberHealth = "crashed"
+	memberUnhealthy memberHealth = "unhealthy"
+)
+
+type stackWatcher struct {
+	cli       client.APIClient
+	args      *ArgsFlags
+	restarts  map[string]uint
+	reported  map[string]memberHealth
+	notifiers []notifier
+}
+
+// validateWatchArgs checks the flags of the watch command before the stack is started
+func validateWatchArgs(args *ArgsFlags) error {
+	switch args.WatchRestartPolicy {
+	case RestartPolicyNever, RestartPolicyOnFailure, RestartPolicyUnhealthy:
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidRestartPolicy, args.WatchRestartPolicy)
+	}
+
+	if args.WatchInterval <= 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidWatchInterval, args.WatchInterval)
+	}
+
+	return nil
+}
+
+// WatchStack supervises the containers of the stack until interrupted
+func WatchStack(ctx context.Context, args *ArgsFlags, notifiers []notifier) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("could not connect to docker socket: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	watcher := &stackWatcher{
+		cli:       cli,
+		args:      args,
+		restarts:  map[string]uint{},
+		reported:  map[string]memberHealth{},
+		notifiers: append(notifiers, watchNotifiers(args)...),
+	}
+
+	log.Info().Dur("interval", args.WatchInterval).Str("restartPolicy", args.WatchRestartPolicy).
+		Msg("Watching the stack, press Ctrl+C to stop")
+
+	ticker := time.NewTicker(args.WatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Stopped watching the stack, containers are left running")
+			return nil
+		case <-ticker.C:
+			watcher.check(ctx)
+		}
+	}
+}
+
+func watchNotifiers(args *ArgsFlags) []notifier {
+	notifiers := []notifier{}
+	if args.NotifyWebhook != "" {
+		notifiers = append(notifiers, newWebhookNotifier(args.NotifyWebhook))
+	}
+	if args.DesktopNotify {
+		notifiers = append(notifiers, desktopNotifier{})
+	}
+
+	return notifiers
+}
+
+func (w *stackWatcher) check(ctx context.Context) {
+	containers, err := w.cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.GetPrefixLabelFilter(w.args.Prefix))),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to list the containers of the stack")
+		return
+	}
+
+	for i := range containers {
+		if !isSupervisedStackMember(&containers[i], w.args.Prefix) {
+			continue
+		}
+
+		w.handle(ctx, &containers[i])
+	}
+}
+
+func (w *stackWatcher) handle(ctx context.Context, cont *types.Container) {
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	health := stackMemberHealth(cont)
+
+	if health == memberHealthy {
+		if _, ok := w.reported[name]; ok {
+			delete(w.reported, name)
+			w.notify(ctx, "recovered", name, fmt.Sprintf("%s is running again", name))
+		}
+		return
+	}
+
+	if !shouldRestart(w.args.WatchRestartPolicy, health) {
+		if w.reported[name] != health {
+			w.reported[name] = health
+			w.notify(ctx, string(health), name, fmt.Sprintf("%s is %s: %s", name, health, cont.Status))
+		}
+		return
+	}
+
+	if w.restarts[name] >= w.args.WatchMaxRestarts {
+		if w.reported[name] != health {
+			w.reported[name] = health
+			w.notify(ctx, "restart-limit", name,
+				fmt.Sprintf("%s is %s and was restarted %d times already, giving up", name, health, w.restarts[name]))
+		}
+		return
+	}
+
+	w.restarts[name]++
+	w.reported[name] = health
+	log.Warn().Str("container", name).Str("health", string(health)).Uint("restarts", w.restarts[name]).Msg("Restarting")
+
+	if err := w.cli.ContainerRestart(ctx, cont.ID, container.StopOptions{}); err != nil {
+		w.notify(ctx, "restart-failed", name, fmt.Sprintf("failed to restart %s: %s", name, err))
+		return
+	}
+
+	w.notify(ctx, "restarted", name, fmt.Sprintf("%s was %s and has been restarted", name, health))
+}
+
+func (w *stackWatcher) notify(ctx context.Context, event, name, message string) {
+	log.Info().Str("event", event).Str("container", name).Msg(message)
+	notifyAll(ctx, w.notifiers, notification{
+		Event:     event,
+		Container: name,
+		Message:   message,
+		Prefix:    w.args.Prefix,
+	})
+}
+
+// oneOffStackMembers are expected to exit after they did their job, they are not supervised
+var oneOffStackMembers = []string{"crux-migrate", "kratos-migrate"}
+
+// isSupervisedStackMember is a long running container of the stack, the one-off ones like the migrations
+// and the containers of other categories sharing the prefix are left alone
+func isSupervisedStackMember(cont *types.Container, prefix string) bool {
+	if cont.Labels[label.DyrectorioOrg+label.ServiceCategory] != label.GetHiddenServiceCategory("internal") {
+		return false
+	}
+
+	for _, name := range cont.Names {
+		for _, member := range oneOffStackMembers {
+			if strings.TrimPrefix(name, "/") == prefix+"_"+member {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func stackMemberHealth(cont *types.Container) memberHealth {
+	switch cont.State {
+	case "exited", "dead":
+		return memberCrashed
+	}
+
+	if strings.Contains(cont.Status, "(unhealthy)") {
+		return memberUnhealthy
+	}
+
+	return memberHealthy
+}
+
+func shouldRestart(policy string, health memberHealth) bool {
+	switch policy {
+	case RestartPolicyOnFailure:
+		return health == memberCrashed
+	case RestartPolicyUnhealthy:
+		return health == memberCrashed || health == memberUnhealthy
+	default:
+		return false
+	}
+}
@@ -0,0 +1,56 @@
+//go:build unit
+// +build unit
+
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/cli"
+)
+
+func TestStackMemberHealth(t *testing.T) {
+	running := cli.StackMemberHealth(&types.Container{State: "running", Status: "Up 5 minutes (healthy)"})
+	crashed := cli.StackMemberHealth(&types.Container{State: "exited", Status: "Exited (1) 2 seconds ago"})
+	unhealthy := cli.StackMemberHealth(&types.Container{State: "running", Status: "Up 5 minutes (unhealthy)"})
+
+	assert.False(t, cli.ShouldRestart(cli.RestartPolicyUnhealthy, running))
+	assert.True(t, cli.ShouldRestart(cli.RestartPolicyOnFailure, crashed))
+	assert.False(t, cli.ShouldRestart(cli.RestartPolicyOnFailure, unhealthy))
+	assert.True(t, cli.ShouldRestart(cli.RestartPolicyUnhealthy, unhealthy))
+	assert.False(t, cli.ShouldRestart(cli.RestartPolicyNever, crashed))
+}
+
+func TestIsSupervisedStackMember(t *testing.T) {
+	stackMember := func(name string) *types.Container {
+		return &types.Container{
+			Names: []string{"/" + name},
+			Labels: map[string]string{
+				label.DyrectorioOrg + label.ContainerPrefix: "dyo-stable",
+				label.DyrectorioOrg + label.ServiceCategory: label.GetHiddenServiceCategory("internal"),
+			},
+		}
+	}
+
+	assert.True(t, cli.IsSupervisedStackMember(stackMember("dyo-stable_crux"), "dyo-stable"))
+	assert.True(t, cli.IsSupervisedStackMember(stackMember("dyo-stable_kratos-postgres"), "dyo-stable"))
+	assert.False(t, cli.IsSupervisedStackMember(stackMember("dyo-stable_crux-migrate"), "dyo-stable"))
+	assert.False(t, cli.IsSupervisedStackMember(stackMember("dyo-stable_kratos-migrate"), "dyo-stable"))
+
+	deployed := stackMember("dyo-stable_nginx")
+	deployed.Labels[label.DyrectorioOrg+label.ServiceCategory] = "web"
+	assert.False(t, cli.IsSupervisedStackMember(deployed, "dyo-stable"))
+	assert.False(t, cli.IsSupervisedStackMember(&types.Container{Names: []string{"/dyo-stable_crux"}}, "dyo-stable"))
+}
+
+func TestValidateWatchArgs(t *testing.T) {
+	assert.NoError(t, cli.ValidateWatchArgs(&cli.ArgsFlags{WatchRestartPolicy: cli.RestartPolicyOnFailure, WatchInterval: time.Second}))
+	assert.ErrorIs(t, cli.ValidateWatchArgs(&cli.ArgsFlags{WatchRestartPolicy: "always", WatchInterval: time.Second}),
+		cli.ErrInvalidRestartPolicy)
+	assert.ErrorIs(t, cli.ValidateWatchArgs(&cli.ArgsFlags{WatchRestartPolicy: cli.RestartPolicyNever}), cli.ErrInvalidWatchInterval)
+}
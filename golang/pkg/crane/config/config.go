@@ -15,9 +15,26 @@ type Configuration struct {
 	OwnNamespace     string `yaml:"ownNamespace"           env:"CRANE_DEPLOYMENT_NAMESPACE"`
 	SecretName       string `yaml:"secretName"  env:"SECRET_NAME"         env-default:"dyrectorio-secret"`
 	Namespace        string `yaml:"namespace"   env:"SECRET_NAMESPACE"    env-default:"dyrectorio"`
+
+	// PullSecretServiceAccount gets the image pull secrets instead of the pod specs if it is set, eg. default
+	PullSecretServiceAccount string `yaml:"pullSecretServiceAccount" env:"PULL_SECRET_SERVICE_ACCOUNT" env-default:""`
+
 	config.CommonConfiguration
 	DefaultKubeTimeout  time.Duration `yaml:"defaultKubeTimeout"    env:"DEFAULT_KUBE_TIMEOUT"      env-default:"2m"`
 	TestTimeoutDuration time.Duration `yaml:"testTimeout"           env:"TEST_TIMEOUT"              env-default:"15s"`
 	CraneInCluster      bool          `yaml:"craneInCluster"        env:"CRANE_IN_CLUSTER"          env-default:"false"`
 	ForceOnConflicts    bool          `yaml:"forceOnConflicts"      env:"FORCE_ON_CONFLICTS"        env-default:"true"`
+
+	// AdoptExistingResources takes over objects with fields of other managers, it matters only without forced conflicts
+	AdoptExistingResources bool `yaml:"adoptExistingResources" env:"ADOPT_EXISTING_RESOURCES" env-default:"false"`
+
+	// RevisionHistoryLimit is the number of revisions kept for rollbacks, both in the history and as replica sets
+	RevisionHistoryLimit int32 `yaml:"revisionHistoryLimit" env:"REVISION_HISTORY_LIMIT" env-default:"10"`
+
+	// ResourceSampleInterval is the period of the usage samples of the resource recommendations, 0 disables sampling
+	ResourceSampleInterval time.Duration `yaml:"resourceSampleInterval" env:"RESOURCE_SAMPLE_INTERVAL" env-default:"1m"`
+	ResourceSampleWindow   time.Duration `yaml:"resourceSampleWindow"   env:"RESOURCE_SAMPLE_WINDOW"   env-default:"24h"`
+
+	// ClusterDiscoveryInterval is the period of the discovery of the capabilities of the cluster, 0 disables reporting the changes
+	ClusterDiscoveryInterval time.Duration `yaml:"clusterDiscoveryInterval" env:"CLUSTER_DISCOVERY_INTERVAL" env-default:"1h"`
 }
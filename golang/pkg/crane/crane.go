@@ -7,6 +7,7 @@
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/cache"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/crux"
@@ -30,13 +31,25 @@ func preflightChecks(cfg *config.Configuration) {
 }
 
 func Serve(cfg *config.Configuration, secretStore commonConfig.SecretStore) {
+	ServeContext(context.Background(), cfg, secretStore)
+}
+
+// ServeContext runs the agent until the context is done
+func ServeContext(ctx context.Context, cfg *config.Configuration, secretStore commonConfig.SecretStore) {
 	preflightChecks(cfg)
 	log.Info().Msg("Starting dyrector.io crane service.")
 
 	// TODO(robot9706): Implement updater
 	log.Debug().Msg("No update was set up")
 
-	grpcContext := grpc.WithGRPCConfig(context.Background(), cfg)
+	grpcContext := grpc.WithGRPCConfig(ctx, cfg)
+
+	sampler := k8s.NewResourceUsageSampler(cfg)
+	go sampler.Start(grpcContext)
+
+	caches := cache.NewRegistry()
+	grpc.RegisterCaches(caches)
+
 	grpc.Init(grpcContext, &cfg.CommonConfiguration, secretStore, &grpc.WorkerFunctions{
 		Deploy:               k8s.Deploy,
 		DeploySharedSecrets:  k8s.DeploySharedSecrets,
@@ -46,7 +59,17 @@ func Serve(cfg *config.Configuration, secretStore commonConfig.SecretStore) {
 		DeleteContainers:     k8s.DeleteMultiple,
 		SecretList:           crux.GetSecretsList,
 		ContainerLog:         k8s.PodLog,
+		RollbackToRevision:   k8s.RollbackToRevision,
+		RecommendResources:   sampler.Recommend,
+		WorkloadOperation:    k8s.WorkloadOperation,
+		DebugContainer:       k8s.DebugContainer,
+		InvalidateCache:      caches.Invalidate,
 		Close:                grpcClose,
+		RuntimeCheck:         k8s.APIServerReady(cfg),
+		ClassifyError:        k8s.ClassifyError,
+
+		ClusterCapabilities:      k8s.ClusterCapabilities,
+		WatchClusterCapabilities: k8s.WatchClusterCapabilities,
 	})
 }
 
@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/rs/zerolog/log"
@@ -111,3 +112,15 @@ func (c *Client) VerifyAPIResourceExists(targetGroup, targetKind string) bool {
 
 	return found
 }
+
+// APIServerReady returns the runtime check of the readiness probe, asking the readiness of the API server
+func APIServerReady(cfg *config.Configuration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		clientset, err := NewClient(cfg).GetClientSet()
+		if err != nil {
+			return err
+		}
+
+		return clientset.Discovery().RESTClient().Get().AbsPath("/readyz").Do(ctx).Error()
+	}
+}
@@ -0,0 +1,368 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const (
+	defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	ingressClassAnnotation        = "kubernetes.io/ingress.class"
+	defaultIngressClass           = "nginx"
+	ingressAPIVersion             = "networking.k8s.io/v1"
+	// defaultClusterCacheAge is how long the discovered capabilities are used if their changes are not watched
+	defaultClusterCacheAge = time.Hour
+)
+
+// clusterCache is the last discovery of the cluster, so a deployment does not discover it again,
+// the warnings of a container are only given once until the capabilities change
+type clusterCache struct {
+	discovered time.Time
+	cluster    *agent.ClusterCapabilitiesMessage
+	warned     map[string]bool
+	mu         sync.Mutex
+}
+
+var discoveredCluster = &clusterCache{warned: map[string]bool{}}
+
+func (c *clusterCache) store(cluster *agent.ClusterCapabilitiesMessage, discovered time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !sameCapabilities(c.cluster, cluster) {
+		c.warned = map[string]bool{}
+	}
+	c.cluster = cluster
+	c.discovered = discovered
+}
+
+// get is the cached capabilities if they are not older than the max age
+func (c *clusterCache) get(maxAge time.Duration, now time.Time) (*agent.ClusterCapabilitiesMessage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cluster == nil || now.Sub(c.discovered) > maxAge {
+		return nil, false
+	}
+
+	return c.cluster, true
+}
+
+// newWarnings are the warnings not given yet for the container
+func (c *clusterCache) newWarnings(container string, warnings []string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := []string{}
+	for _, it := range warnings {
+		key := container + "\x00" + it
+		if !c.warned[key] {
+			c.warned[key] = true
+			result = append(result, it)
+		}
+	}
+
+	return result
+}
+
+// versionedFeature is a field of the container config the API server supports from a minor version
+type versionedFeature struct {
+	used  func(*v1.ContainerConfig) bool
+	name  string
+	minor int
+}
+
+var versionedFeatures = []versionedFeature{
+	{
+		name:  "startup probe",
+		minor: 20,
+		used:  func(c *v1.ContainerConfig) bool { return c.HealthCheckConfig.StartupProbe != nil },
+	},
+	{
+		name:  "projected service account tokens",
+		minor: 20,
+		used:  func(c *v1.ContainerConfig) bool { return len(c.ProjectedTokens) > 0 },
+	},
+}
+
+// discoverCluster lists what the cluster serves, the classes the service account can not list are only warnings
+func discoverCluster(ctx context.Context, clientset kubernetes.Interface) (*agent.ClusterCapabilitiesMessage, error) {
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the version of the API server: %w", err)
+	}
+
+	groups, err := clientset.Discovery().ServerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the API groups: %w", err)
+	}
+
+	cluster := &agent.ClusterCapabilitiesMessage{
+		ServerVersion: version.GitVersion,
+		DiscoveredAt:  timestamppb.Now(),
+	}
+	for i := range groups.Groups {
+		for _, it := range groups.Groups[i].Versions {
+			cluster.ApiVersions = append(cluster.ApiVersions, it.GroupVersion)
+		}
+	}
+	sort.Strings(cluster.ApiVersions)
+
+	ingressClasses, err := clientset.NetworkingV1().IngressClasses().List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		cluster.Warnings = append(cluster.Warnings, fmt.Sprintf("ingress classes: %s", err))
+	} else {
+		for i := range ingressClasses.Items {
+			class := &ingressClasses.Items[i]
+			cluster.IngressClasses = append(cluster.IngressClasses, &agent.ClusterIngressClass{
+				Name:       class.Name,
+				Controller: class.Spec.Controller,
+				Default:    class.Annotations[defaultIngressClassAnnotation] == "true",
+			})
+		}
+	}
+
+	storageClasses, err := clientset.StorageV1().StorageClasses().List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		cluster.Warnings = append(cluster.Warnings, fmt.Sprintf("storage classes: %s", err))
+	} else {
+		for i := range storageClasses.Items {
+			class := &storageClasses.Items[i]
+			cluster.StorageClasses = append(cluster.StorageClasses, &agent.ClusterStorageClass{
+				Name:            class.Name,
+				Provisioner:     class.Provisioner,
+				Default:         class.Annotations[defaultStorageClassAnnotation] == "true",
+				VolumeExpansion: class.AllowVolumeExpansion != nil && *class.AllowVolumeExpansion,
+			})
+		}
+	}
+
+	return cluster, nil
+}
+
+// sameCapabilities compares the capabilities without the time of their discovery
+func sameCapabilities(one, other *agent.ClusterCapabilitiesMessage) bool {
+	if one == nil || other == nil {
+		return one == other
+	}
+
+	one = proto.Clone(one).(*agent.ClusterCapabilitiesMessage)
+	other = proto.Clone(other).(*agent.ClusterCapabilitiesMessage)
+	one.DiscoveredAt = nil
+	other.DiscoveredAt = nil
+
+	return proto.Equal(one, other)
+}
+
+// ClusterCapabilities discovers the cluster for the info of the connection, the result is cached for the deployments
+func ClusterCapabilities(ctx context.Context) (*agent.ClusterCapabilitiesMessage, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	clientset, err := NewClient(cfg).GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := discoverCluster(ctx, clientset)
+	if err != nil {
+		return nil, err
+	}
+	discoveredCluster.store(cluster, time.Now())
+
+	return cluster, nil
+}
+
+// cachedClusterCapabilities is the last discovery while it is not older than the period of the discovery,
+// the watch keeps it fresh, the cluster is only discovered again if it is not watched
+func cachedClusterCapabilities(ctx context.Context) (*agent.ClusterCapabilitiesMessage, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	maxAge := cfg.ClusterDiscoveryInterval
+	if maxAge <= 0 {
+		maxAge = defaultClusterCacheAge
+	}
+	if cluster, ok := discoveredCluster.get(maxAge, time.Now()); ok {
+		return cluster, nil
+	}
+
+	return ClusterCapabilities(ctx)
+}
+
+// WatchClusterCapabilities discovers the cluster periodically and reports the changes, eg. an upgrade of the cluster
+func WatchClusterCapabilities(ctx context.Context, report grpc.ClusterCapabilitiesReportFunc) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	if cfg.ClusterDiscoveryInterval <= 0 {
+		log.Info().Msg("Reporting the changes of the cluster capabilities is disabled")
+		return
+	}
+
+	// the ones of the connection are the baseline
+	last, err := ClusterCapabilities(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to discover the capabilities of the cluster")
+	}
+
+	ticker := time.NewTicker(cfg.ClusterDiscoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current, err := ClusterCapabilities(ctx)
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to discover the capabilities of the cluster")
+				continue
+			}
+
+			if !sameCapabilities(last, current) {
+				log.Info().Str("version", current.ServerVersion).Msg("Cluster capabilities changed")
+				report(current)
+				last = current
+			}
+		}
+	}
+}
+
+// serverMinorVersion is the minor version of the git version, eg. 28 of v1.28.3-eks-4f4795d, 0 if it can not be parsed
+func serverMinorVersion(gitVersion string) int {
+	parts := strings.SplitN(strings.TrimPrefix(gitVersion, "v"), ".", 3)
+	if len(parts) < 2 || parts[0] != "1" {
+		return 0
+	}
+
+	minor, err := strconv.Atoi(strings.TrimRight(parts[1], "+"))
+	if err != nil {
+		return 0
+	}
+
+	return minor
+}
+
+func servesAPIVersion(cluster *agent.ClusterCapabilitiesMessage, apiVersion string) bool {
+	for _, it := range cluster.ApiVersions {
+		if it == apiVersion {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ingressWarnings(containerConfig *v1.ContainerConfig, cluster *agent.ClusterCapabilitiesMessage) []string {
+	if !servesAPIVersion(cluster, ingressAPIVersion) {
+		return []string{fmt.Sprintf("%s is not served by the cluster %s, the container will not be exposed",
+			ingressAPIVersion, cluster.ServerVersion)}
+	}
+
+	// the classes could not be listed
+	if len(cluster.IngressClasses) == 0 && len(cluster.Warnings) > 0 {
+		return nil
+	}
+
+	className := defaultIngressClass
+	if class, ok := containerConfig.Annotations.Ingress[ingressClassAnnotation]; ok {
+		className = class
+	}
+
+	names := []string{}
+	for _, it := range cluster.IngressClasses {
+		if it.Name == className {
+			return nil
+		}
+		names = append(names, it.Name)
+	}
+
+	if len(names) == 0 {
+		return []string{fmt.Sprintf("ingress class %s is not available, the cluster has no ingress classes", className)}
+	}
+
+	return []string{fmt.Sprintf("ingress class %s is not available, set the %s ingress annotation to one of %s",
+		className, ingressClassAnnotation, strings.Join(names, ", "))}
+}
+
+func storageWarnings(containerConfig *v1.ContainerConfig, cluster *agent.ClusterCapabilitiesMessage) []string {
+	volumes := safeMergeVolumeMaps(mapShortNotationToVolumeMap(containerConfig.Mounts), volumeSliceToMap(containerConfig.Volumes))
+	// the classes could not be listed
+	if len(volumes) == 0 || (len(cluster.StorageClasses) == 0 && len(cluster.Warnings) > 0) {
+		return nil
+	}
+
+	classes := map[string]bool{}
+	hasDefault := false
+	for _, it := range cluster.StorageClasses {
+		classes[it.Name] = true
+		hasDefault = hasDefault || it.Default
+	}
+
+	keys := []string{}
+	for key := range volumes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	warnings := []string{}
+	for _, key := range keys {
+		volume := volumes[key]
+		switch volume.Type {
+		case v1.ReadOnlyVolumeType, v1.ReadWriteOnceVolumeType, v1.ReadWriteManyVolumeType, "":
+		default:
+			// not a claim
+			continue
+		}
+
+		if volume.Class != "" && !classes[volume.Class] {
+			warnings = append(warnings, fmt.Sprintf("storage class %s of volume %s is not available, the claim stays Pending",
+				volume.Class, volume.Name))
+		}
+		if volume.Class == "" && !hasDefault {
+			warnings = append(warnings, fmt.Sprintf("volume %s has no storage class and the cluster has no default one, "+
+				"the claim stays Pending unless a volume is provisioned for it", volume.Name))
+		}
+	}
+
+	return warnings
+}
+
+// deploymentWarnings are the parts of the deployment the cluster does not support, they are applied anyway,
+// as a cluster may be upgraded or given the missing classes, but the objects would not work until then
+func deploymentWarnings(containerConfig *v1.ContainerConfig, cluster *agent.ClusterCapabilitiesMessage) []string {
+	warnings := []string{}
+
+	if minor := serverMinorVersion(cluster.ServerVersion); minor > 0 {
+		for _, it := range versionedFeatures {
+			if it.used(containerConfig) && minor < it.minor {
+				warnings = append(warnings, fmt.Sprintf("%s needs Kubernetes 1.%d, the cluster is %s", it.name, it.minor, cluster.ServerVersion))
+			}
+		}
+	}
+
+	if containerConfig.Expose {
+		warnings = append(warnings, ingressWarnings(containerConfig, cluster)...)
+	}
+
+	if containerConfig.Metrics != nil && !servesAPIVersion(cluster, ServiceMonitorVersion) {
+		warnings = append(warnings, fmt.Sprintf("%s is not served by the cluster, the metrics will not be scraped, "+
+			"install the Prometheus operator", ServiceMonitorVersion))
+	}
+
+	return append(warnings, storageWarnings(containerConfig, cluster)...)
+}
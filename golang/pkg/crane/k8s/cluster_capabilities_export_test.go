@@ -0,0 +1,31 @@
+package k8s
+
+import (
+	"time"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+var (
+	DeploymentWarnings = deploymentWarnings
+	ServerMinorVersion = serverMinorVersion
+	SameCapabilities   = sameCapabilities
+)
+
+type ClusterCache = clusterCache
+
+func NewClusterCache() *ClusterCache {
+	return &clusterCache{warned: map[string]bool{}}
+}
+
+func (c *clusterCache) Store(cluster *agent.ClusterCapabilitiesMessage, discovered time.Time) {
+	c.store(cluster, discovered)
+}
+
+func (c *clusterCache) Get(maxAge time.Duration, now time.Time) (*agent.ClusterCapabilitiesMessage, bool) {
+	return c.get(maxAge, now)
+}
+
+func (c *clusterCache) NewWarnings(container string, warnings []string) []string {
+	return c.newWarnings(container, warnings)
+}
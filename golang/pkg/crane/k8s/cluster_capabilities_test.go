@@ -0,0 +1,140 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func testCluster() *agent.ClusterCapabilitiesMessage {
+	return &agent.ClusterCapabilitiesMessage{
+		ServerVersion: "v1.28.3-eks-4f4795d",
+		ApiVersions:   []string{"apps/v1", "monitoring.coreos.com/v1", "networking.k8s.io/v1", "v1"},
+		IngressClasses: []*agent.ClusterIngressClass{
+			{Name: "nginx", Controller: "k8s.io/ingress-nginx"},
+			{Name: "traefik", Controller: "traefik.io/ingress-controller"},
+		},
+		StorageClasses: []*agent.ClusterStorageClass{
+			{Name: "gp3", Provisioner: "ebs.csi.aws.com", Default: true, VolumeExpansion: true},
+		},
+	}
+}
+
+func TestServerMinorVersion(t *testing.T) {
+	assert.Equal(t, 28, k8s.ServerMinorVersion("v1.28.3-eks-4f4795d"))
+	assert.Equal(t, 19, k8s.ServerMinorVersion("v1.19+"))
+	assert.Equal(t, 0, k8s.ServerMinorVersion("v2.0.0"))
+	assert.Equal(t, 0, k8s.ServerMinorVersion("unknown"))
+}
+
+func TestDeploymentWarningsSupported(t *testing.T) {
+	containerConfig := &v1.ContainerConfig{
+		Expose:  true,
+		Metrics: &v1.Metrics{Port: 9090},
+		Volumes: []v1.Volume{{Name: "data", Path: "/data", Size: "1Gi", Class: "gp3"}, {Name: "cache", Path: "/cache"}},
+		HealthCheckConfig: v1.HealthCheckConfig{
+			StartupProbe: &v1.Probe{},
+		},
+	}
+
+	assert.Empty(t, k8s.DeploymentWarnings(containerConfig, testCluster()))
+}
+
+func TestDeploymentWarningsIngress(t *testing.T) {
+	cluster := testCluster()
+	containerConfig := &v1.ContainerConfig{
+		Expose: true,
+		Annotations: v1.Markers{
+			Ingress: map[string]string{"kubernetes.io/ingress.class": "haproxy"},
+		},
+	}
+
+	warnings := k8s.DeploymentWarnings(containerConfig, cluster)
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "ingress class haproxy is not available")
+	assert.Contains(t, warnings[0], "nginx, traefik")
+
+	// the classes could not be listed, the annotation may be right
+	cluster.IngressClasses = nil
+	cluster.Warnings = []string{"ingress classes: forbidden"}
+	assert.Empty(t, k8s.DeploymentWarnings(containerConfig, cluster))
+
+	cluster.ApiVersions = []string{"v1"}
+	warnings = k8s.DeploymentWarnings(containerConfig, cluster)
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "networking.k8s.io/v1 is not served")
+}
+
+func TestDeploymentWarningsUnsupported(t *testing.T) {
+	cluster := testCluster()
+	cluster.ServerVersion = "v1.19.16"
+	cluster.ApiVersions = []string{"apps/v1", "networking.k8s.io/v1", "v1"}
+	cluster.StorageClasses = []*agent.ClusterStorageClass{{Name: "standard"}}
+	containerConfig := &v1.ContainerConfig{
+		Metrics:         &v1.Metrics{Port: 9090},
+		ProjectedTokens: []v1.ProjectedToken{{Path: "/var/run/secrets/tokens/vault", Audience: "vault"}},
+		Volumes: []v1.Volume{
+			{Name: "data", Path: "/data", Class: "gp3"},
+			{Name: "cache", Path: "/cache", Type: v1.ReadWriteManyVolumeType},
+			{Name: "scratch", Path: "/tmp", Type: v1.EmptyDirVolumeType},
+		},
+	}
+
+	warnings := k8s.DeploymentWarnings(containerConfig, cluster)
+	assert.Len(t, warnings, 4)
+	assert.Contains(t, warnings[0], "projected service account tokens needs Kubernetes 1.20")
+	assert.Contains(t, warnings[1], "monitoring.coreos.com/v1 is not served")
+	assert.Contains(t, warnings[2], "volume cache has no storage class")
+	assert.Contains(t, warnings[3], "storage class gp3 of volume data is not available")
+}
+
+func TestSameCapabilities(t *testing.T) {
+	one := testCluster()
+	one.DiscoveredAt = timestamppb.Now()
+	other := testCluster()
+	assert.True(t, k8s.SameCapabilities(one, other))
+	assert.NotNil(t, one.DiscoveredAt)
+
+	other.ServerVersion = "v1.29.0"
+	assert.False(t, k8s.SameCapabilities(one, other))
+	assert.False(t, k8s.SameCapabilities(nil, other))
+	assert.True(t, k8s.SameCapabilities(nil, nil))
+}
+
+func TestClusterCache(t *testing.T) {
+	cache := k8s.NewClusterCache()
+	now := time.Now()
+
+	_, ok := cache.Get(time.Hour, now)
+	assert.False(t, ok)
+
+	cache.Store(testCluster(), now)
+	cluster, ok := cache.Get(time.Hour, now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, "v1.28.3-eks-4f4795d", cluster.ServerVersion)
+	_, ok = cache.Get(time.Hour, now.Add(2*time.Hour))
+	assert.False(t, ok)
+
+	warnings := []string{"ingress class haproxy is not available"}
+	assert.Equal(t, warnings, cache.NewWarnings("prefix/web", warnings))
+	assert.Empty(t, cache.NewWarnings("prefix/web", warnings))
+	assert.Equal(t, warnings, cache.NewWarnings("prefix/api", warnings))
+
+	// the same capabilities discovered again keep the warnings given, changed ones reset them
+	cache.Store(testCluster(), now.Add(time.Hour))
+	assert.Empty(t, cache.NewWarnings("prefix/web", warnings))
+
+	upgraded := testCluster()
+	upgraded.ServerVersion = "v1.29.0"
+	cache.Store(upgraded, now.Add(time.Hour))
+	assert.Equal(t, warnings, cache.NewWarnings("prefix/web", warnings))
+}
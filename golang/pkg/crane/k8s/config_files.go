@@ -0,0 +1,231 @@
+package k8s
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	apicorev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+)
+
+// ConfigFilesHashAnnotation is set on the pod template, a changed file changes the template, so the pods are rolled
+const ConfigFilesHashAnnotation = "crane.dyrector.io/config-hash"
+
+const (
+	configFilesVolumeName = "crane-config-files"
+	secretFilesVolumeName = "crane-secret-files"
+)
+
+var (
+	ErrConfigFilePathNotAbsolute = errors.New("config file path must be absolute")
+
+	invalidConfigFileKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+)
+
+// configFilesName is the name of both the ConfigMap and the Secret of the files of a container
+func configFilesName(containerName string) string {
+	return fmt.Sprintf("%s-files", containerName)
+}
+
+// configFileKey maps the path of a file to a valid ConfigMap and Secret key, eg. /etc/app/config.yaml -> etc_app_config.yaml
+func configFileKey(filePath string) string {
+	key := strings.ReplaceAll(strings.TrimPrefix(path.Clean(filePath), "/"), "/", "_")
+	return invalidConfigFileKeyChars.ReplaceAllString(key, "-")
+}
+
+// configFiles are the config file assets of a container by their key, plain files go
+// to the ConfigMap and secret files to the Secret of the container's files
+type configFiles struct {
+	data       map[string]string
+	secretData map[string][]byte
+	paths      map[string]string
+	hash       string
+}
+
+func newConfigFiles(files []v1.ConfigFile, decrypt func(map[string]string) (map[string][]byte, error)) (*configFiles, error) {
+	result := &configFiles{
+		data:       map[string]string{},
+		secretData: map[string][]byte{},
+		paths:      map[string]string{},
+	}
+
+	encrypted := map[string]string{}
+	for _, file := range files {
+		if !path.IsAbs(file.Path) {
+			return nil, fmt.Errorf("%s: %w", file.Path, ErrConfigFilePathNotAbsolute)
+		}
+
+		key := configFileKey(file.Path)
+		if other, ok := result.paths[key]; ok {
+			return nil, fmt.Errorf("config files %s and %s have the same key %s", other, file.Path, key)
+		}
+		result.paths[key] = path.Clean(file.Path)
+
+		if file.Secret {
+			encrypted[key] = file.Content
+		} else {
+			result.data[key] = file.Content
+		}
+	}
+
+	if len(encrypted) > 0 {
+		decrypted, err := decrypt(encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt secret config files: %w", err)
+		}
+		result.secretData = decrypted
+	}
+
+	result.hash = result.contentHash()
+
+	return result, nil
+}
+
+// contentHash is empty without files, otherwise it covers the paths and the contents sorted by their keys
+func (f *configFiles) contentHash() string {
+	if len(f.paths) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(f.paths))
+	for key := range f.paths {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		content, ok := f.secretData[key]
+		if !ok {
+			content = []byte(f.data[key])
+		}
+
+		fmt.Fprintf(hash, "%s\x00%s\x00%d\x00", key, f.paths[key], len(content))
+		hash.Write(content)
+	}
+
+	return fmt.Sprintf("sha256:%x", hash.Sum(nil))
+}
+
+func (f *configFiles) volumes(containerName string) []*corev1.VolumeApplyConfiguration {
+	volumes := []*corev1.VolumeApplyConfiguration{}
+	if len(f.data) > 0 {
+		volumes = append(volumes, corev1.Volume().
+			WithName(configFilesVolumeName).
+			WithConfigMap(corev1.ConfigMapVolumeSource().WithName(configFilesName(containerName))))
+	}
+
+	if len(f.secretData) > 0 {
+		volumes = append(volumes, corev1.Volume().
+			WithName(secretFilesVolumeName).
+			WithSecret(corev1.SecretVolumeSource().WithSecretName(configFilesName(containerName))))
+	}
+
+	return volumes
+}
+
+// volumeMounts mounts every file on its own using subPath, so the rest of the directory is left as is
+func (f *configFiles) volumeMounts() []*corev1.VolumeMountApplyConfiguration {
+	keys := make([]string, 0, len(f.paths))
+	for key := range f.paths {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	mounts := []*corev1.VolumeMountApplyConfiguration{}
+	for _, key := range keys {
+		volumeName := configFilesVolumeName
+		if _, ok := f.secretData[key]; ok {
+			volumeName = secretFilesVolumeName
+		}
+
+		mounts = append(mounts, corev1.VolumeMount().
+			WithName(volumeName).
+			WithMountPath(f.paths[key]).
+			WithSubPath(key).
+			WithReadOnly(true))
+	}
+
+	return mounts
+}
+
+// deployConfigFiles applies the ConfigMap of the plain config files, it is removed
+// if there is none, unlike the env config maps, it is not added to the avail list
+func (cm *configmap) deployConfigFiles(namespace, containerName string, files *configFiles) error {
+	client, err := getConfigMapClient(namespace, cm.appConfig)
+	if err != nil {
+		return err
+	}
+
+	name := configFilesName(containerName)
+	if len(files.data) == 0 {
+		err = client.Delete(cm.ctx, name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	_, err = applyAdopting(cm.appConfig, "configmap", name, func(opts metav1.ApplyOptions) (*apicorev1.ConfigMap, error) {
+		return client.Apply(cm.ctx,
+			corev1.ConfigMap(name, namespace).
+				WithLabels(ownerLabels(cm.appConfig, namespace, containerName)).
+				WithAnnotations(map[string]string{ConfigFilesHashAnnotation: files.hash}).
+				WithData(files.data),
+			opts,
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("configMap", name).Int("files", len(files.data)).Msg("Config files updated")
+
+	return nil
+}
+
+// deploySecretFiles applies the Secret of the secret config files, it is removed if there is none
+func (s *Secret) deploySecretFiles(namespace, containerName string, files *configFiles) error {
+	cli, err := s.getSecretClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	name := configFilesName(containerName)
+	if len(files.secretData) == 0 {
+		err = cli.Delete(s.ctx, name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	_, err = applyAdopting(s.appConfig, "secret", name, func(opts metav1.ApplyOptions) (*apicorev1.Secret, error) {
+		return cli.Apply(s.ctx,
+			corev1.Secret(name, namespace).
+				WithLabels(ownerLabels(s.appConfig, namespace, containerName)).
+				WithAnnotations(map[string]string{ConfigFilesHashAnnotation: files.hash}).
+				WithData(files.secretData),
+			opts,
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("name", name).Int("files", len(files.secretData)).Msg("Secret files updated")
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package k8s
+
+import (
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+)
+
+var (
+	ConfigFilesName = configFilesName
+	ConfigFileKey   = configFileKey
+)
+
+// ConfigFileSet is the result of newConfigFiles in a comparable form
+type ConfigFileSet struct {
+	Data       map[string]string
+	SecretData map[string][]byte
+	Paths      map[string]string
+	Hash       string
+	Mounts     []string
+}
+
+func NewConfigFileSet(files []v1.ConfigFile, decrypt func(map[string]string) (map[string][]byte, error)) (*ConfigFileSet, error) {
+	result, err := newConfigFiles(files, decrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := []string{}
+	for _, mount := range result.volumeMounts() {
+		mounts = append(mounts, *mount.Name+":"+*mount.SubPath+":"+*mount.MountPath)
+	}
+
+	return &ConfigFileSet{
+		Data:       result.data,
+		SecretData: result.secretData,
+		Paths:      result.paths,
+		Hash:       result.hash,
+		Mounts:     mounts,
+	}, nil
+}
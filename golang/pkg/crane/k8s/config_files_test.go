@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func plainDecrypt(values map[string]string) (map[string][]byte, error) {
+	result := map[string][]byte{}
+	for key, value := range values {
+		result[key] = []byte("decrypted " + value)
+	}
+
+	return result, nil
+}
+
+func TestConfigFileNames(t *testing.T) {
+	assert.Equal(t, "api-files", k8s.ConfigFilesName("api"))
+	assert.Equal(t, "etc_app_config.yaml", k8s.ConfigFileKey("/etc/app/config.yaml"))
+	assert.Equal(t, "etc_app_my-settings-.json", k8s.ConfigFileKey("/etc//app/my settings+.json"))
+}
+
+func TestNewConfigFiles(t *testing.T) {
+	files, err := k8s.NewConfigFileSet([]v1.ConfigFile{
+		{Path: "/etc/app/config.yaml", Content: "level: debug"},
+		{Path: "/etc/app/key.pem", Content: "key", Secret: true},
+	}, plainDecrypt)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"etc_app_config.yaml": "level: debug"}, files.Data)
+	assert.Equal(t, map[string][]byte{"etc_app_key.pem": []byte("decrypted key")}, files.SecretData)
+	assert.Equal(t, []string{
+		"crane-config-files:etc_app_config.yaml:/etc/app/config.yaml",
+		"crane-secret-files:etc_app_key.pem:/etc/app/key.pem",
+	}, files.Mounts)
+	assert.NotEmpty(t, files.Hash)
+}
+
+func TestNewConfigFilesInvalid(t *testing.T) {
+	_, err := k8s.NewConfigFileSet([]v1.ConfigFile{{Path: "config.yaml"}}, plainDecrypt)
+	assert.ErrorIs(t, err, k8s.ErrConfigFilePathNotAbsolute)
+
+	_, err = k8s.NewConfigFileSet([]v1.ConfigFile{
+		{Path: "/etc/app/config.yaml"},
+		{Path: "/etc/app_config.yaml"},
+	}, plainDecrypt)
+	assert.ErrorContains(t, err, "have the same key")
+}
+
+func TestConfigFilesHash(t *testing.T) {
+	empty, err := k8s.NewConfigFileSet(nil, plainDecrypt)
+	assert.NoError(t, err)
+	assert.Empty(t, empty.Hash)
+
+	first, err := k8s.NewConfigFileSet([]v1.ConfigFile{
+		{Path: "/a.conf", Content: "a"},
+		{Path: "/b.conf", Content: "b", Secret: true},
+	}, plainDecrypt)
+	assert.NoError(t, err)
+
+	reordered, err := k8s.NewConfigFileSet([]v1.ConfigFile{
+		{Path: "/b.conf", Content: "b", Secret: true},
+		{Path: "/a.conf", Content: "a"},
+	}, plainDecrypt)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Hash, reordered.Hash)
+
+	changed, err := k8s.NewConfigFileSet([]v1.ConfigFile{
+		{Path: "/a.conf", Content: "a"},
+		{Path: "/b.conf", Content: "c", Secret: true},
+	}, plainDecrypt)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Hash, changed.Hash)
+}
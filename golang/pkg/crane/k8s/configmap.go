@@ -5,6 +5,7 @@
 	"fmt"
 
 	"github.com/rs/zerolog/log"
+	apicorev1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -44,18 +45,21 @@ func (cm *configmap) loadSharedConfig(namespace string) error {
 }
 
 // deployConfigMapData creates the config map object and adds it to the avail list
-// that is used by the deployment later on
-func (cm *configmap) deployConfigMapData(namespace, name string, envList map[string]string) error {
+// that is used by the deployment later on, containerName is empty for shared config maps
+func (cm *configmap) deployConfigMapData(namespace, name, containerName string, envList map[string]string) error {
 	client, err := getConfigMapClient(namespace, cm.appConfig)
 	if err != nil {
 		return err
 	}
 
-	result, err := client.Apply(cm.ctx,
-		corev1.ConfigMap(name, namespace).
-			WithData(envList),
-		metaV1.ApplyOptions{FieldManager: cm.appConfig.FieldManagerName, Force: cm.appConfig.ForceOnConflicts},
-	)
+	result, err := applyAdopting(cm.appConfig, "configmap", name, func(opts metaV1.ApplyOptions) (*apicorev1.ConfigMap, error) {
+		return client.Apply(cm.ctx,
+			corev1.ConfigMap(name, namespace).
+				WithLabels(ownerLabels(cm.appConfig, namespace, containerName)).
+				WithData(envList),
+			opts,
+		)
+	})
 	if err != nil {
 		return err
 	}
@@ -75,7 +79,7 @@ func (cm *configmap) deployConfigMapRuntime(runtimeType v1.RuntimeConfigType, na
 		if err != nil {
 			return err
 		}
-		err = cm.deployConfigMapData(namespace, fmt.Sprintf("%v-%v", containerName, runtimeType), envList)
+		err = cm.deployConfigMapData(namespace, fmt.Sprintf("%v-%v", containerName, runtimeType), containerName, envList)
 		if err != nil {
 			return err
 		}
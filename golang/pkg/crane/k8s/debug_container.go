@@ -0,0 +1,243 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedCoreV1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const (
+	debugContainerPrefix     = "debugger-"
+	debugContainerNameSuffix = 5
+)
+
+var (
+	ErrDebugImageRequired  = errors.New("image of the debug container is required")
+	ErrNoRunningPod        = errors.New("the deployment has no running pod")
+	ErrPodNotRunning       = errors.New("pod is not running")
+	ErrDebugContainerEnded = errors.New("debug container terminated before the session started")
+)
+
+func validateDebugContainer(req *agent.DebugContainerRequest) error {
+	if req.GetImage() == "" {
+		return ErrDebugImageRequired
+	}
+
+	return nil
+}
+
+func isPodRunning(pod *coreV1.Pod) bool {
+	return pod.Status.Phase == coreV1.PodRunning && pod.DeletionTimestamp == nil
+}
+
+// debugTargetPod is the requested pod of the deployment, or its newest running pod if none is requested
+func debugTargetPod(pods []coreV1.Pod, name, requested string) (*coreV1.Pod, error) {
+	if requested != "" {
+		for i := range pods {
+			if pods[i].Name != requested {
+				continue
+			}
+			if pods[i].Labels["app"] != name {
+				return nil, fmt.Errorf("%s: %w", requested, ErrPodNotInDeployment)
+			}
+			if !isPodRunning(&pods[i]) {
+				return nil, fmt.Errorf("%s: %w", requested, ErrPodNotRunning)
+			}
+
+			return &pods[i], nil
+		}
+
+		return nil, fmt.Errorf("%s: %w", requested, ErrPodNotInDeployment)
+	}
+
+	running := []*coreV1.Pod{}
+	for i := range pods {
+		if pods[i].Labels["app"] == name && isPodRunning(&pods[i]) {
+			running = append(running, &pods[i])
+		}
+	}
+	if len(running) == 0 {
+		return nil, fmt.Errorf("%s: %w", name, ErrNoRunningPod)
+	}
+
+	sort.SliceStable(running, func(i, j int) bool {
+		if !running[i].CreationTimestamp.Equal(&running[j].CreationTimestamp) {
+			return running[j].CreationTimestamp.Before(&running[i].CreationTimestamp)
+		}
+
+		return running[i].Name < running[j].Name
+	})
+
+	return running[0], nil
+}
+
+// debugContainerName is a name not used by the containers of the pod, like the ones of kubectl debug
+func debugContainerName(pod *coreV1.Pod) string {
+	used := map[string]bool{}
+	for i := range pod.Spec.Containers {
+		used[pod.Spec.Containers[i].Name] = true
+	}
+	for i := range pod.Spec.EphemeralContainers {
+		used[pod.Spec.EphemeralContainers[i].Name] = true
+	}
+
+	for {
+		name := debugContainerPrefix + utilrand.String(debugContainerNameSuffix)
+		if !used[name] {
+			return name
+		}
+	}
+}
+
+// ephemeralDebugContainer targets the container of the deployment, so the processes of it are visible for the debugger
+func ephemeralDebugContainer(req *agent.DebugContainerRequest, target, name string) coreV1.EphemeralContainer {
+	return coreV1.EphemeralContainer{
+		EphemeralContainerCommon: coreV1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    req.GetImage(),
+			Command:                  req.GetCommand(),
+			ImagePullPolicy:          coreV1.PullIfNotPresent,
+			Stdin:                    true,
+			StdinOnce:                true,
+			TTY:                      req.GetTty(),
+			TerminationMessagePolicy: coreV1.TerminationMessageFallbackToLogsOnError,
+		},
+		TargetContainerName: target,
+	}
+}
+
+// ephemeralContainerRunning tells if the container is running, it is an error if it has terminated
+func ephemeralContainerRunning(pod *coreV1.Pod, name string) (bool, error) {
+	for i := range pod.Status.EphemeralContainerStatuses {
+		status := &pod.Status.EphemeralContainerStatuses[i]
+		if status.Name != name {
+			continue
+		}
+
+		if terminated := status.State.Terminated; terminated != nil {
+			return false, fmt.Errorf("%w: %s: exit code %d: %s", ErrDebugContainerEnded, name, terminated.ExitCode, terminated.Reason)
+		}
+
+		return status.State.Running != nil, nil
+	}
+
+	return false, nil
+}
+
+func waitForEphemeralContainer(ctx context.Context, pods typedCoreV1.PodInterface, podName, name string, cfg *config.Configuration) error {
+	err := wait.PollUntilContextTimeout(ctx, rolloutPollInterval, cfg.DefaultKubeTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			pod, err := pods.Get(ctx, podName, metaV1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+
+			return ephemeralContainerRunning(pod, name)
+		})
+	if err != nil {
+		return fmt.Errorf("debug container %s did not start in %s: %w", name, cfg.DefaultKubeTimeout, err)
+	}
+
+	return nil
+}
+
+// terminalSizeQueue passes the sizes of the terminal of the session to the attached container
+type terminalSizeQueue <-chan *agent.TerminalSize
+
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{Width: uint16(size.Width), Height: uint16(size.Height)}
+}
+
+// DebugContainer adds an ephemeral debug container to a pod of the deployment of the container and attaches
+// the session to it, ephemeral containers can not be removed, it stays in the pod stopped after the session
+func DebugContainer(ctx context.Context, req *agent.DebugContainerRequest, session *grpc.DebugSession) error {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	namespace := req.GetContainer().GetPrefix()
+	name := req.GetContainer().GetName()
+
+	if err := validateDebugContainer(req); err != nil {
+		return err
+	}
+
+	client := NewClient(cfg)
+	clientset, err := client.GetClientSet()
+	if err != nil {
+		return err
+	}
+	restConfig, err := client.GetRestConfig()
+	if err != nil {
+		return err
+	}
+
+	pods := clientset.CoreV1().Pods(namespace)
+	list, err := pods.List(ctx, metaV1.ListOptions{LabelSelector: "app=" + name})
+	if err != nil {
+		return err
+	}
+
+	pod, err := debugTargetPod(list.Items, name, req.GetPod())
+	if err != nil {
+		return err
+	}
+
+	debugger := ephemeralDebugContainer(req, name, debugContainerName(pod))
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, debugger)
+	if _, err = pods.UpdateEphemeralContainers(ctx, pod.Name, pod, metaV1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to add the debug container to %s: %w", pod.Name, err)
+	}
+
+	if err = waitForEphemeralContainer(ctx, pods, pod.Name, debugger.Name, cfg); err != nil {
+		return err
+	}
+	session.Started(pod.Name, debugger.Name)
+
+	attach := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod.Name).
+		SubResource("attach").
+		VersionedParams(&coreV1.PodAttachOptions{
+			Container: debugger.Name,
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    !req.GetTty(),
+			TTY:       req.GetTty(),
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(restConfig, "POST", attach.URL())
+	if err != nil {
+		return err
+	}
+
+	options := remotecommand.StreamOptions{
+		Stdin:  session.Stdin,
+		Stdout: session.Stdout,
+		Tty:    req.GetTty(),
+	}
+	if req.GetTty() {
+		// the output of a terminal is one stream
+		options.TerminalSizeQueue = terminalSizeQueue(session.Resize)
+	} else {
+		options.Stderr = session.Stderr
+	}
+
+	return executor.StreamWithContext(ctx, options)
+}
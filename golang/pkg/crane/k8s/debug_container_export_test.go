@@ -0,0 +1,9 @@
+package k8s
+
+var (
+	ValidateDebugContainer    = validateDebugContainer
+	DebugTargetPod            = debugTargetPod
+	DebugContainerName        = debugContainerName
+	EphemeralDebugContainer   = ephemeralDebugContainer
+	EphemeralContainerRunning = ephemeralContainerRunning
+)
@@ -0,0 +1,128 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func debugPod(name, app string, phase coreV1.PodPhase, created time.Time) coreV1.Pod {
+	return coreV1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{"app": app},
+			CreationTimestamp: metaV1.NewTime(created),
+		},
+		Status: coreV1.PodStatus{Phase: phase},
+	}
+}
+
+func TestValidateDebugContainer(t *testing.T) {
+	assert.NoError(t, k8s.ValidateDebugContainer(&agent.DebugContainerRequest{Image: "busybox"}))
+	assert.ErrorIs(t, k8s.ValidateDebugContainer(&agent.DebugContainerRequest{}), k8s.ErrDebugImageRequired)
+}
+
+func TestDebugTargetPodNewestRunning(t *testing.T) {
+	now := time.Now()
+	pods := []coreV1.Pod{
+		debugPod("api-old", "api", coreV1.PodRunning, now.Add(-time.Hour)),
+		debugPod("api-new", "api", coreV1.PodRunning, now),
+		debugPod("api-pending", "api", coreV1.PodPending, now.Add(time.Minute)),
+		debugPod("web-newest", "web", coreV1.PodRunning, now.Add(time.Hour)),
+	}
+
+	pod, err := k8s.DebugTargetPod(pods, "api", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "api-new", pod.Name)
+}
+
+func TestDebugTargetPodRequested(t *testing.T) {
+	now := time.Now()
+	pods := []coreV1.Pod{
+		debugPod("api-old", "api", coreV1.PodRunning, now.Add(-time.Hour)),
+		debugPod("api-new", "api", coreV1.PodRunning, now),
+		debugPod("api-pending", "api", coreV1.PodPending, now),
+		debugPod("web", "web", coreV1.PodRunning, now),
+	}
+
+	pod, err := k8s.DebugTargetPod(pods, "api", "api-old")
+	assert.NoError(t, err)
+	assert.Equal(t, "api-old", pod.Name)
+
+	_, err = k8s.DebugTargetPod(pods, "api", "api-pending")
+	assert.ErrorIs(t, err, k8s.ErrPodNotRunning)
+
+	_, err = k8s.DebugTargetPod(pods, "api", "web")
+	assert.ErrorIs(t, err, k8s.ErrPodNotInDeployment)
+
+	_, err = k8s.DebugTargetPod(pods, "api", "missing")
+	assert.ErrorIs(t, err, k8s.ErrPodNotInDeployment)
+}
+
+func TestDebugTargetPodNoRunningPod(t *testing.T) {
+	pods := []coreV1.Pod{debugPod("api", "api", coreV1.PodFailed, time.Now())}
+
+	_, err := k8s.DebugTargetPod(pods, "api", "")
+	assert.ErrorIs(t, err, k8s.ErrNoRunningPod)
+}
+
+func TestDebugContainerNameUnused(t *testing.T) {
+	pod := &coreV1.Pod{Spec: coreV1.PodSpec{
+		Containers:          []coreV1.Container{{Name: "api"}},
+		EphemeralContainers: []coreV1.EphemeralContainer{{EphemeralContainerCommon: coreV1.EphemeralContainerCommon{Name: "debugger-abcde"}}},
+	}}
+
+	name := k8s.DebugContainerName(pod)
+	assert.True(t, strings.HasPrefix(name, "debugger-"))
+	assert.NotEqual(t, "debugger-abcde", name)
+}
+
+func TestEphemeralDebugContainer(t *testing.T) {
+	req := &agent.DebugContainerRequest{
+		Image:   "nicolaka/netshoot",
+		Command: []string{"sh"},
+		Tty:     true,
+	}
+
+	debugger := k8s.EphemeralDebugContainer(req, "api", "debugger-abcde")
+	assert.Equal(t, "debugger-abcde", debugger.Name)
+	assert.Equal(t, "api", debugger.TargetContainerName)
+	assert.Equal(t, "nicolaka/netshoot", debugger.Image)
+	assert.Equal(t, []string{"sh"}, debugger.Command)
+	assert.True(t, debugger.Stdin)
+	assert.True(t, debugger.StdinOnce)
+	assert.True(t, debugger.TTY)
+}
+
+func TestEphemeralContainerRunning(t *testing.T) {
+	pod := &coreV1.Pod{Status: coreV1.PodStatus{EphemeralContainerStatuses: []coreV1.ContainerStatus{
+		{Name: "debugger-waits", State: coreV1.ContainerState{Waiting: &coreV1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+		{Name: "debugger-runs", State: coreV1.ContainerState{Running: &coreV1.ContainerStateRunning{}}},
+		{Name: "debugger-ended", State: coreV1.ContainerState{Terminated: &coreV1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}},
+	}}}
+
+	running, err := k8s.EphemeralContainerRunning(pod, "debugger-waits")
+	assert.NoError(t, err)
+	assert.False(t, running)
+
+	running, err = k8s.EphemeralContainerRunning(pod, "debugger-runs")
+	assert.NoError(t, err)
+	assert.True(t, running)
+
+	running, err = k8s.EphemeralContainerRunning(pod, "debugger-missing")
+	assert.NoError(t, err)
+	assert.False(t, running)
+
+	_, err = k8s.EphemeralContainerRunning(pod, "debugger-ended")
+	assert.ErrorIs(t, err, k8s.ErrDebugContainerEnded)
+}
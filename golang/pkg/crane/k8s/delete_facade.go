@@ -21,6 +21,8 @@ type DeleteFacade struct {
 	configmap  *configmap
 	ingress    *ingress
 	pvc        *PVC
+	secret     *Secret
+	account    *serviceAccount
 	appConfig  *config.Configuration
 	name       string
 }
@@ -37,6 +39,8 @@ func NewDeleteFacade(ctx context.Context, namespace, name string, cfg *config.Co
 		service:    NewService(ctx, k8sClient),
 		ingress:    newIngress(ctx, k8sClient),
 		pvc:        NewPVC(ctx, k8sClient),
+		secret:     NewSecret(ctx, k8sClient),
+		account:    newServiceAccount(ctx, cfg),
 		appConfig:  cfg,
 	}
 }
@@ -53,6 +57,24 @@ func (d *DeleteFacade) DeleteConfigMaps() error {
 	return d.configmap.deleteConfigMaps(d.namespace.name, d.name)
 }
 
+// DeleteConfigFiles removes the ConfigMap and the Secret of the config files, missing ones are not errors
+func (d *DeleteFacade) DeleteConfigFiles() error {
+	files := &configFiles{}
+	if err := d.configmap.deployConfigFiles(d.namespace.name, d.name, files); err != nil {
+		return err
+	}
+
+	return d.secret.deploySecretFiles(d.namespace.name, d.name, files)
+}
+
+func (d *DeleteFacade) DeleteRevisionHistory() error {
+	return d.configmap.deleteConfigMaps(d.namespace.name, historyName(d.name))
+}
+
+func (d *DeleteFacade) DeleteWorkloadIdentity() error {
+	return d.account.deleteWorkloadIdentity(d.namespace.name, d.name)
+}
+
 func (d *DeleteFacade) DeleteServices() error {
 	return d.service.deleteServices(d.namespace.name, d.name)
 }
@@ -61,6 +83,10 @@ func (d *DeleteFacade) DeleteIngresses() error {
 	return d.ingress.deleteIngress(d.namespace.name, d.name)
 }
 
+func (d *DeleteFacade) DeletePullSecret() error {
+	return d.secret.deletePullSecret(d.namespace.name, d.name)
+}
+
 // hard-delete if called with prefix name only without container name
 func DeleteMultiple(c context.Context, request *common.DeleteContainersRequest) error {
 	cfg := grpc.GetConfigFromContext(c).(*config.Configuration)
@@ -78,7 +104,8 @@ func DeleteMultiple(c context.Context, request *common.DeleteContainersRequest)
 	return del.DeleteNamespace(prefix)
 }
 
-// soft-delete: deployment,services,configmaps, ingresses
+// soft-delete: deployment,services,configmaps, config files, revision history, ingresses, image pull secret,
+// workload identity
 func Delete(c context.Context, prefix, name string) error {
 	cfg := grpc.GetConfigFromContext(c).(*config.Configuration)
 
@@ -112,10 +139,30 @@ func Delete(c context.Context, prefix, name string) error {
 		log.Error().Err(err).Stack().Msg("Delete configmaps error")
 	}
 
+	err = del.DeleteConfigFiles()
+	if err != nil {
+		log.Error().Err(err).Stack().Msg("Delete config files error")
+	}
+
+	err = del.DeleteRevisionHistory()
+	if !errors.IsNotFound(err) && err != nil {
+		log.Error().Err(err).Stack().Msg("Delete revision history error")
+	}
+
 	err = del.DeleteIngresses()
 	if !errors.IsNotFound(err) && err != nil {
 		log.Error().Err(err).Stack().Msg("Delete ingress error")
 	}
 
+	err = del.DeletePullSecret()
+	if err != nil {
+		log.Error().Err(err).Stack().Msg("Delete image pull secret error")
+	}
+
+	err = del.DeleteWorkloadIdentity()
+	if err != nil {
+		log.Error().Err(err).Stack().Msg("Delete workload identity error")
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@
 	"github.com/rs/zerolog/log"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/crypt"
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
@@ -28,9 +29,14 @@ type DeployFacade struct {
 	ingress        *ingress
 	params         *DeployFacadeParams
 	pvc            *PVC
+	configFiles    *configFiles
+	serviceAccount *serviceAccount
+	tokens         *projectedTokens
 	ServiceMonitor *ServiceMonitor
 	appConfig      *config.Configuration
 	image          string
+	// serviceAccountName is the service account of the pods, empty for the default one
+	serviceAccountName string
 }
 
 type DeployFacadeParams struct {
@@ -63,6 +69,7 @@ func NewDeployFacade(params *DeployFacadeParams, cfg *config.Configuration) *Dep
 		ingress:        newIngress(params.Ctx, k8sClient),
 		secret:         NewSecret(params.Ctx, k8sClient),
 		pvc:            NewPVC(params.Ctx, k8sClient),
+		serviceAccount: newServiceAccount(params.Ctx, cfg),
 		ServiceMonitor: serviceMonitor,
 		appConfig:      cfg,
 	}
@@ -103,6 +110,7 @@ func (d *DeployFacade) PreDeploy() error {
 			if err := d.configmap.deployConfigMapData(
 				d.namespace.name,
 				d.params.InstanceConfig.ContainerPreName+"-shared",
+				"",
 				d.params.InstanceConfig.SharedEnvironment,
 			); err != nil {
 				log.Error().Err(err).Stack().Msg("Namespace global config map error")
@@ -115,6 +123,7 @@ func (d *DeployFacade) PreDeploy() error {
 		if err := d.configmap.deployConfigMapData(
 			d.namespace.name,
 			d.params.InstanceConfig.Name+"-common",
+			"",
 			d.params.InstanceConfig.Environment,
 		); err != nil {
 			log.Error().Err(err).Stack().Msg("Common config map error")
@@ -126,6 +135,7 @@ func (d *DeployFacade) PreDeploy() error {
 		if err := d.configmap.deployConfigMapData(
 			d.namespace.name,
 			d.params.ContainerConfig.Container,
+			d.params.ContainerConfig.Container,
 			d.params.ContainerConfig.Environment,
 		); err != nil {
 			log.Error().Err(err).Stack().Msg("Container config map error")
@@ -142,6 +152,16 @@ func (d *DeployFacade) PreDeploy() error {
 		return err
 	}
 
+	if err := d.deployConfigFiles(); err != nil {
+		log.Error().Err(err).Stack().Msg("Config files error")
+		return err
+	}
+
+	if err := d.deployWorkloadIdentity(); err != nil {
+		log.Error().Err(err).Stack().Msg("Workload identity error")
+		return err
+	}
+
 	if err := d.pvc.DeployPVC(
 		d.namespace.name,
 		d.params.ContainerConfig.Container,
@@ -155,9 +175,48 @@ func (d *DeployFacade) PreDeploy() error {
 	return d.secret.applySecrets(
 		d.namespace.name,
 		d.params.ContainerConfig.Container,
+		d.params.ContainerConfig.Container,
 		d.params.ContainerConfig.Secrets)
 }
 
+// deployConfigFiles stores the config files of the container, the files are mounted by the deployment
+func (d *DeployFacade) deployConfigFiles() error {
+	files, err := newConfigFiles(d.params.ContainerConfig.ConfigFiles, func(values map[string]string) (map[string][]byte, error) {
+		return crypt.DecryptSecrets(values, &d.appConfig.CommonConfiguration)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = d.configmap.deployConfigFiles(d.namespace.name, d.params.ContainerConfig.Container, files); err != nil {
+		return err
+	}
+
+	if err = d.secret.deploySecretFiles(d.namespace.name, d.params.ContainerConfig.Container, files); err != nil {
+		return err
+	}
+
+	d.configFiles = files
+	return nil
+}
+
+// deployWorkloadIdentity validates the projected tokens before the service account of the pods is applied
+func (d *DeployFacade) deployWorkloadIdentity() error {
+	tokens, err := newProjectedTokens(d.params.ContainerConfig.ProjectedTokens)
+	if err != nil {
+		return err
+	}
+
+	d.serviceAccountName, err = d.serviceAccount.deployWorkloadIdentity(d.namespace.name,
+		d.params.ContainerConfig.Container, d.params.ContainerConfig.WorkloadIdentity)
+	if err != nil {
+		return err
+	}
+
+	d.tokens = tokens
+	return nil
+}
+
 func (d *DeployFacade) Deploy() error {
 	var portList []builder.PortBinding
 	if d.params.ContainerConfig.Ports != nil {
@@ -171,6 +230,7 @@ func (d *DeployFacade) Deploy() error {
 			portBindings:  portList,
 			portRanges:    d.params.ContainerConfig.PortRanges,
 			useLB:         d.params.ContainerConfig.UseLoadBalancer,
+			serviceType:   d.params.ContainerConfig.ServiceType,
 			LBAnnotations: d.params.ContainerConfig.ExtraLBAnnotations,
 			annotations:   d.params.ContainerConfig.Annotations.Service,
 			labels:        d.params.ContainerConfig.Labels.Service,
@@ -180,23 +240,22 @@ func (d *DeployFacade) Deploy() error {
 		return err
 	}
 
-	imagePullSecretName := ""
+	imagePullSecretName, err := d.secret.syncPullSecret(d.params.InstanceConfig.ContainerPreName,
+		d.params.ContainerConfig.Container, d.params.imagePullSecrets)
+	if err != nil {
+		return err
+	}
 
-	if d.params.imagePullSecrets != nil {
-		imagePullSecretName = fmt.Sprintf("%s-reg", d.params.ContainerConfig.Container)
-		if err := d.secret.ApplyRegistryAuthSecret(d.ctx,
-			d.params.InstanceConfig.ContainerPreName,
-			imagePullSecretName,
-			d.params.imagePullSecrets,
-			d.appConfig); err != nil {
-			return err
-		}
+	// the pods of another service account do not get the secrets of PULL_SECRET_SERVICE_ACCOUNT
+	if imagePullSecretName == "" && d.serviceAccountName != "" && d.params.imagePullSecrets != nil {
+		imagePullSecretName = pullSecretName(d.params.ContainerConfig.Container)
 	}
 
 	if err := d.deployment.DeployDeployment(&DeploymentParams{
 		image:           d.params.Image,
 		namespace:       d.params.InstanceConfig.ContainerPreName,
 		containerConfig: &d.params.ContainerConfig,
+		configFiles:     d.configFiles,
 		configMapsEnv:   d.configmap.avail,
 		secrets:         d.secret.avail,
 		volumes:         d.pvc.avail,
@@ -207,6 +266,8 @@ func (d *DeployFacade) Deploy() error {
 		annotations:     d.params.ContainerConfig.Annotations.Deployment,
 		labels:          d.params.ContainerConfig.Labels.Deployment,
 		pullSecretName:  imagePullSecretName,
+		serviceAccount:  d.serviceAccountName,
+		projectedTokens: d.tokens,
 	}); err != nil {
 		log.Error().Err(err).Stack().Msg("Error with deployment")
 		return err
@@ -268,7 +329,7 @@ func DeploySharedSecrets(c context.Context, prefix string, secrets map[string]st
 	k8sClient := NewClient(cfg)
 	secret := NewSecret(c, k8sClient)
 
-	err := secret.applySecrets(prefix, prefix+"-shared", secrets)
+	err := secret.applySecrets(prefix, prefix+"-shared", "", secrets)
 	if err != nil {
 		return fmt.Errorf("could not write secrets, aborting: %w", err)
 	}
@@ -280,10 +341,26 @@ func Deploy(c context.Context, dog *dogger.DeploymentLogger, deployImageRequest
 	_ *v1.VersionData,
 ) error {
 	cfg := grpc.GetConfigFromContext(c).(*config.Configuration)
+	if err := validateDeployRequest(deployImageRequest); err != nil {
+		return err
+	}
+
 	dog.WriteInfo(deployImageRequest.Strings(&cfg.CommonConfiguration)...)
 	dog.WriteInfo(deployImageRequest.InstanceConfig.Strings()...)
 	dog.WriteInfo(deployImageRequest.ContainerConfig.Strings(&cfg.CommonConfiguration)...)
 
+	// the deployment is applied anyway, the warnings tell why parts of it may not work, they are given once per container
+	cluster, err := cachedClusterCapabilities(c)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to discover the capabilities of the cluster")
+	} else {
+		container := deployImageRequest.InstanceConfig.ContainerPreName + "/" + deployImageRequest.ContainerConfig.Container
+		warnings := discoveredCluster.newWarnings(container, deploymentWarnings(&deployImageRequest.ContainerConfig, cluster))
+		if len(warnings) > 0 {
+			dog.Write(dogger.Warning, warnings...)
+		}
+	}
+
 	imageName := util.JoinV(":", deployImageRequest.ImageName, deployImageRequest.Tag)
 	if deployImageRequest.Registry != nil {
 		imageName = util.JoinV("/",
@@ -294,6 +371,7 @@ func Deploy(c context.Context, dog *dogger.DeploymentLogger, deployImageRequest
 	if err != nil {
 		return err
 	}
+	expandedImageName = cfg.ImageRewriteRules.Rewrite(expandedImageName)
 
 	log.Info().Str("name", imageName).Str("full", expandedImageName).Msg("Image name parsed")
 
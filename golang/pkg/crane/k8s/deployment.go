@@ -5,6 +5,8 @@
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +36,12 @@
 
 const CraneUpdatedAnnotation = "crane.dyrector.io/restartedAt"
 
+// the defaults of the probes given only by their path
+const (
+	defaultProbeDelay            int32 = 30
+	defaultProbeFailureThreshold int32 = 1
+)
+
 var ErrPodHasNoOwner = errors.New("pod has no owner")
 
 // facade object for Deployment management
@@ -51,8 +59,11 @@ type DeploymentParams struct {
 	volumes         map[string]v1.Volume
 	annotations     map[string]string
 	containerConfig *v1.ContainerConfig
+	configFiles     *configFiles
+	projectedTokens *projectedTokens
 	labels          map[string]string
 	pullSecretName  string
+	serviceAccount  string
 	namespace       string
 	image           string
 	issuer          string
@@ -79,25 +90,49 @@ func (d *Deployment) DeployDeployment(p *DeploymentParams) error {
 	}
 
 	annot[CraneUpdatedAnnotation] = time.Now().Format(time.RFC3339)
+	if p.configFiles != nil && p.configFiles.hash != "" {
+		annot[ConfigFilesHashAnnotation] = p.configFiles.hash
+	}
 	maps.Copy(annot, p.annotations)
 
 	labels := map[string]string{
 		"app": name,
 	}
 	maps.Copy(labels, p.labels)
+	labels = withOwnerLabels(labels, d.appConfig, p.namespace, name)
 
 	podSpec := corev1.PodSpec().WithContainers(containerConfig).
 		WithInitContainers(getInitContainers(p, d.appConfig)...).
 		WithVolumes(getVolumesFromMap(p.volumes, d.appConfig)...)
 
+	if p.configFiles != nil {
+		podSpec.WithVolumes(p.configFiles.volumes(name)...)
+	}
+
+	if p.projectedTokens != nil {
+		podSpec.WithVolumes(p.projectedTokens.volumes()...)
+	}
+
+	if p.serviceAccount != "" {
+		podSpec.WithServiceAccountName(p.serviceAccount)
+	}
+
 	if p.pullSecretName != "" {
 		podSpec.WithImagePullSecrets(corev1.LocalObjectReference().WithName(p.pullSecretName))
 	}
 
+	history, revision, err := d.nextRevision(p, labels, annot, podSpec)
+	if err != nil {
+		return err
+	}
+	annot[RevisionAnnotation] = strconv.FormatInt(revision.Revision, 10)
+
 	deployment := appsv1.Deployment(name, p.namespace).
+		WithLabels(ownerLabels(d.appConfig, p.namespace, name)).
 		WithSpec(
 			appsv1.DeploymentSpec().
 				WithReplicas(1).
+				WithRevisionHistoryLimit(d.appConfig.RevisionHistoryLimit).
 				WithStrategy(appsv1.DeploymentStrategy().WithType(kappsv1.DeploymentStrategyType(p.containerConfig.DeploymentStrategy))).
 				WithSelector(metav1.LabelSelector().WithMatchLabels(map[string]string{
 					"app": name,
@@ -107,20 +142,46 @@ func (d *Deployment) DeployDeployment(p *DeploymentParams) error {
 					WithAnnotations(annot).
 					WithSpec(podSpec)),
 		)
-	result, err := client.Apply(d.ctx, deployment, metaV1.ApplyOptions{
-		FieldManager: d.appConfig.FieldManagerName,
-		Force:        d.appConfig.ForceOnConflicts,
+	result, err := applyAdopting(d.appConfig, "deployment", name, func(opts metaV1.ApplyOptions) (*kappsv1.Deployment, error) {
+		return client.Apply(d.ctx, deployment, opts)
 	})
 	if err != nil {
 		log.Error().Err(err).Stack().Msg("Deployment error")
 		return errors.New("deployment error: " + err.Error())
 	}
 
-	log.Info().Str("name", result.Name).Msg("Deployment succeeded")
+	log.Info().Str("name", result.Name).Int64("revision", revision.Revision).Msg("Deployment succeeded")
+
+	if err = d.recordRevision(p.namespace, name, history, revision); err != nil {
+		log.Warn().Err(err).Str("name", name).Msg("Could not record the revision of the deployment")
+	}
 
 	return nil
 }
 
+// nextRevision describes the revision of the pod template before it is applied
+func (d *Deployment) nextRevision(p *DeploymentParams, labels, annotations map[string]string,
+	podSpec *corev1.PodSpecApplyConfiguration,
+) (*revisionHistory, *deploymentRevision, error) {
+	history, err := d.loadHistory(p.namespace, p.containerConfig.Container)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load the revision history: %w", err)
+	}
+
+	hash, err := podTemplateHash(labels, annotations, podSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return history, &deploymentRevision{
+		CreatedAt:  time.Now().UTC(),
+		Images:     podSpecImages(podSpec),
+		ConfigHash: hash,
+		Actor:      p.issuer,
+		Revision:   history.nextRevision(),
+	}, nil
+}
+
 func (d *Deployment) deleteDeployment(namespace, name string) error {
 	client := getDeploymentsClient(namespace, d.appConfig)
 
@@ -280,54 +341,34 @@ func (d *Deployment) GetPodDeployment(namespace, name string) (*kappsv1.Deployme
 func buildContainer(p *DeploymentParams,
 	cfg *config.Configuration,
 ) (*corev1.ContainerApplyConfiguration, error) {
-	healthCheckConfig := p.containerConfig.HealthCheckConfig
-	var healthCheckDelay int32 = 30
-	var healthCheckThreshold int32 = 1
-
-	var livenessProbe *corev1.ProbeApplyConfiguration
-	var readinessProbe *corev1.ProbeApplyConfiguration
-	var startupProbe *corev1.ProbeApplyConfiguration
+	healthCheckConfig := &p.containerConfig.HealthCheckConfig
 
 	resources, err := getResourceManagement(p.containerConfig.ResourceConfig, cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check all Probes to represented in the deploymentParams or not
-	if healthCheckConfig.LivenessProbe != nil {
-		livenessProbe = corev1.Probe().
-			WithHTTPGet(getProbes(healthCheckConfig.LivenessProbe.Path, healthCheckConfig.Port)).
-			WithInitialDelaySeconds(healthCheckDelay).
-			WithFailureThreshold(healthCheckThreshold)
-	}
-
-	if healthCheckConfig.ReadinessProbe != nil {
-		readinessProbe = corev1.Probe().
-			WithHTTPGet(getProbes(healthCheckConfig.ReadinessProbe.Path, healthCheckConfig.Port)).
-			WithInitialDelaySeconds(healthCheckDelay).
-			WithFailureThreshold(healthCheckThreshold)
-	}
-
-	if healthCheckConfig.StartupProbe != nil {
-		startupProbe = corev1.Probe().
-			WithHTTPGet(getProbes(healthCheckConfig.ReadinessProbe.Path, healthCheckConfig.Port)).
-			WithInitialDelaySeconds(healthCheckDelay).
-			WithFailureThreshold(healthCheckThreshold)
-	}
-
 	container := corev1.Container().
 		WithName(p.containerConfig.Container).
 		WithImage(p.image).
 		WithEnvFrom(getEnvConfigMapsAndSecrets(p.configMapsEnv, p.secrets)...).
 		WithVolumeMounts(getVolumeMountsFromMap(p.volumes)...).
 		WithPorts(getContainerPorts(p.portList)...).
-		WithLivenessProbe(livenessProbe).
-		WithReadinessProbe(readinessProbe).
-		WithStartupProbe(startupProbe).
+		WithLivenessProbe(getProbe(healthCheckConfig.LivenessProbe, healthCheckConfig.Port)).
+		WithReadinessProbe(getProbe(healthCheckConfig.ReadinessProbe, healthCheckConfig.Port)).
+		WithStartupProbe(getProbe(healthCheckConfig.StartupProbe, healthCheckConfig.Port)).
 		WithResources(resources).
 		WithTTY(p.containerConfig.TTY).
 		WithWorkingDir(p.containerConfig.WorkingDirectory)
 
+	if p.configFiles != nil {
+		container.WithVolumeMounts(p.configFiles.volumeMounts()...)
+	}
+
+	if p.projectedTokens != nil {
+		container.WithVolumeMounts(p.projectedTokens.volumeMounts()...)
+	}
+
 	if p.containerConfig.User != nil {
 		container.WithSecurityContext(
 			corev1.SecurityContext().
@@ -425,22 +466,22 @@ func getInitContainers(params *DeploymentParams, cfg *config.Configuration) []*c
 	initContainers := []*corev1.ContainerApplyConfiguration{}
 
 	if params != nil && params.containerConfig != nil {
-		initContainers = addConfigContainer(initContainers, params.containerConfig)
+		initContainers = addConfigContainer(initContainers, params.containerConfig, cfg)
 		initContainers = addImportContainer(initContainers, params.containerConfig, cfg)
-		initContainers = addInitContainers(initContainers, params)
+		initContainers = addInitContainers(initContainers, params, cfg)
 	}
 
 	return initContainers
 }
 
 func addConfigContainer(initContainers []*corev1.ContainerApplyConfiguration,
-	containerConfig *v1.ContainerConfig,
+	containerConfig *v1.ContainerConfig, cfg *config.Configuration,
 ) []*corev1.ContainerApplyConfiguration {
 	if containerConfig.ConfigContainer != nil {
 		initContainers = append(initContainers,
 			corev1.Container().
 				WithName("config-loader").
-				WithImage(containerConfig.ConfigContainer.Image).
+				WithImage(cfg.ImageRewriteRules.Rewrite(containerConfig.ConfigContainer.Image)).
 				WithImagePullPolicy(coreV1.PullAlways).
 				WithCommand([]string{
 					"sh",
@@ -468,7 +509,7 @@ func addImportContainer(initContainers []*corev1.ContainerApplyConfiguration,
 		initContainers = append(initContainers,
 			corev1.Container().
 				WithName("import").
-				WithImage(cfg.ImportContainerImage).
+				WithImage(cfg.ImageRewriteRules.Rewrite(cfg.ImportContainerImage)).
 				WithImagePullPolicy(coreV1.PullAlways).
 				WithEnv(getEnvs(containerConfig.ImportContainer.Environments)...).
 				WithArgs(
@@ -486,12 +527,12 @@ func addImportContainer(initContainers []*corev1.ContainerApplyConfiguration,
 }
 
 func addInitContainers(initContainers []*corev1.ContainerApplyConfiguration,
-	params *DeploymentParams,
+	params *DeploymentParams, cfg *config.Configuration,
 ) []*corev1.ContainerApplyConfiguration {
 	for _, iCont := range params.containerConfig.InitContainers {
 		container := corev1.Container().
 			WithName(iCont.Name).
-			WithImage(iCont.Image).
+			WithImage(cfg.ImageRewriteRules.Rewrite(iCont.Image)).
 			WithCommand(iCont.Command...).
 			WithArgs(iCont.Args...).
 			WithImagePullPolicy(coreV1.PullAlways)
@@ -548,10 +589,61 @@ func getEnvConfigMapsAndSecrets(configs, secrets []string) []*corev1.EnvFromSour
 	return envs
 }
 
-func getProbes(path string, port uint16) *corev1.HTTPGetActionApplyConfiguration {
-	return corev1.HTTPGetAction().
-		WithPath(path).
-		WithPort(intstr.FromInt(int(port))) // exposed port
+// getProbe maps the probe to the one of the container, the port of the health check is used
+// if the probe has none, the unset timings and thresholds are the defaults of the path only probes
+func getProbe(probe *v1.Probe, defaultPort uint16) *corev1.ProbeApplyConfiguration {
+	if probe == nil {
+		return nil
+	}
+
+	port := intstr.FromInt(int(probe.Port))
+	if probe.Port == 0 {
+		port = intstr.FromInt(int(defaultPort))
+	}
+
+	delay, threshold := defaultProbeDelay, defaultProbeFailureThreshold
+	if probe.InitialDelaySeconds != nil {
+		delay = *probe.InitialDelaySeconds
+	}
+	if probe.FailureThreshold != nil {
+		threshold = *probe.FailureThreshold
+	}
+
+	result := corev1.Probe().
+		WithInitialDelaySeconds(delay).
+		WithFailureThreshold(threshold)
+
+	switch probe.Type {
+	case v1.ProbeTCP:
+		result.WithTCPSocket(corev1.TCPSocketAction().WithPort(port))
+	case v1.ProbeExec:
+		result.WithExec(corev1.ExecAction().WithCommand(probe.Command...))
+	default:
+		action := corev1.HTTPGetAction().
+			WithPath(probe.Path).
+			WithPort(port)
+		if probe.Scheme != "" {
+			action.WithScheme(coreV1.URIScheme(strings.ToUpper(probe.Scheme)))
+		}
+		headers := maps.Keys(probe.Headers)
+		sort.Strings(headers)
+		for _, name := range headers {
+			action.WithHTTPHeaders(corev1.HTTPHeader().WithName(name).WithValue(probe.Headers[name]))
+		}
+		result.WithHTTPGet(action)
+	}
+
+	if probe.PeriodSeconds != nil {
+		result.WithPeriodSeconds(*probe.PeriodSeconds)
+	}
+	if probe.TimeoutSeconds != nil {
+		result.WithTimeoutSeconds(*probe.TimeoutSeconds)
+	}
+	if probe.SuccessThreshold != nil {
+		result.WithSuccessThreshold(*probe.SuccessThreshold)
+	}
+
+	return result
 }
 
 func getContainerPorts(portList []builder.PortBinding) []*corev1.ContainerPortApplyConfiguration {
@@ -13,3 +13,5 @@ func GetResourceManagementForTest(resourceConfig v1.ResourceConfig,
 ) (*corev1.ResourceRequirementsApplyConfiguration, error) {
 	return getResourceManagement(resourceConfig, cfg)
 }
+
+var GetProbe = getProbe
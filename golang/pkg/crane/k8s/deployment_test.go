@@ -6,6 +6,10 @@
 import (
 	"testing"
 
+	"github.com/AlekSi/pointer"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 
 	"github.com/stretchr/testify/assert"
@@ -268,3 +272,51 @@ func TestResourceParsingFallbackRequestsMemoryError(t *testing.T) {
 
 	assert.ErrorIs(t, err, k8s.NewResourceError(k8s.FieldMemory, k8s.GroupRequests, true))
 }
+
+func TestGetProbePathOnly(t *testing.T) {
+	assert.Nil(t, k8s.GetProbe(nil, 8080))
+
+	probe := k8s.GetProbe(&v1.Probe{Path: "/health"}, 8080)
+	assert.Equal(t, "/health", *probe.HTTPGet.Path)
+	assert.Equal(t, intstr.FromInt(8080), *probe.HTTPGet.Port)
+	assert.Equal(t, int32(30), *probe.InitialDelaySeconds)
+	assert.Equal(t, int32(1), *probe.FailureThreshold)
+	assert.Nil(t, probe.PeriodSeconds)
+	assert.Nil(t, probe.HTTPGet.Scheme)
+}
+
+func TestGetProbeHTTP(t *testing.T) {
+	probe := k8s.GetProbe(&v1.Probe{
+		Type:                v1.ProbeHTTP,
+		Path:                "/ready",
+		Port:                9090,
+		Scheme:              "https",
+		Headers:             map[string]string{"X-Probe": "crane", "Accept": "application/json"},
+		InitialDelaySeconds: pointer.ToInt32(5),
+		PeriodSeconds:       pointer.ToInt32(10),
+		TimeoutSeconds:      pointer.ToInt32(2),
+		SuccessThreshold:    pointer.ToInt32(2),
+		FailureThreshold:    pointer.ToInt32(3),
+	}, 8080)
+
+	assert.Equal(t, intstr.FromInt(9090), *probe.HTTPGet.Port)
+	assert.Equal(t, coreV1.URISchemeHTTPS, *probe.HTTPGet.Scheme)
+	assert.Len(t, probe.HTTPGet.HTTPHeaders, 2)
+	assert.Equal(t, "Accept", *probe.HTTPGet.HTTPHeaders[0].Name)
+	assert.Equal(t, int32(5), *probe.InitialDelaySeconds)
+	assert.Equal(t, int32(10), *probe.PeriodSeconds)
+	assert.Equal(t, int32(2), *probe.TimeoutSeconds)
+	assert.Equal(t, int32(2), *probe.SuccessThreshold)
+	assert.Equal(t, int32(3), *probe.FailureThreshold)
+}
+
+func TestGetProbeTCPAndExec(t *testing.T) {
+	tcp := k8s.GetProbe(&v1.Probe{Type: v1.ProbeTCP}, 5432)
+	assert.Nil(t, tcp.HTTPGet)
+	assert.Equal(t, intstr.FromInt(5432), *tcp.TCPSocket.Port)
+
+	exec := k8s.GetProbe(&v1.Probe{Type: v1.ProbeExec, Command: []string{"pg_isready"}, InitialDelaySeconds: pointer.ToInt32(0)}, 0)
+	assert.Nil(t, exec.HTTPGet)
+	assert.Equal(t, []string{"pg_isready"}, exec.Exec.Command)
+	assert.Equal(t, int32(0), *exec.InitialDelaySeconds)
+}
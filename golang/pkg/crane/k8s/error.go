@@ -1,6 +1,12 @@
 package k8s
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
 
 const (
 	FieldCPU    = "CPU"
@@ -30,3 +36,46 @@ func (resourceError ResourceError) Error() string {
 	}
 	return fmt.Sprintf("failed to parse '%s' in '%s'", resourceError.Field, resourceError.Group)
 }
+
+// the errors of crane by their class, the errors of the API server are classified by the shared classification
+var errorClasses = []struct {
+	err      error
+	code     string
+	category agent.ErrorCategory
+}{
+	{ErrRevisionNotFound, "REVISION_NOT_FOUND", agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND},
+	{ErrPodHasNoOwner, "POD_HAS_NO_OWNER", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	{ErrReplicasShareVolume, "REPLICAS_SHARE_VOLUME", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	{ErrDebugContainerEnded, "DEBUG_CONTAINER_ENDED", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	// the pods can be starting, so these are retried
+	{ErrNoRunningPod, "POD_NOT_RUNNING", agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE},
+	{ErrPodNotRunning, "POD_NOT_RUNNING", agent.ErrorCategory_ERROR_CATEGORY_UNAVAILABLE},
+	{ErrPodNotInDeployment, "POD_NOT_IN_DEPLOYMENT", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrUnspecifiedWorkloadOperation, "INVALID_WORKLOAD_OPERATION", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrUnknownWorkloadOperation, "INVALID_WORKLOAD_OPERATION", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrPodNameRequired, "INVALID_WORKLOAD_OPERATION", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrReplicaCountRequired, "INVALID_WORKLOAD_OPERATION", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrNegativeReplicaCount, "INVALID_WORKLOAD_OPERATION", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrDebugImageRequired, "INVALID_DEBUG_CONTAINER", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrConfigFilePathNotAbsolute, "INVALID_CONFIG_FILE", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrProjectedTokenPathNotAbsolute, "INVALID_WORKLOAD_IDENTITY", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrProjectedTokenAudienceMissing, "INVALID_WORKLOAD_IDENTITY", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+}
+
+// ClassifyError classifies the errors of crane for the platform, nil if it is not one of them
+func ClassifyError(err error) *grpc.ErrorClass {
+	resourceErr := ResourceError{}
+	if errors.As(err, &resourceErr) {
+		class := grpc.ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, "INVALID_RESOURCES")
+		class.Details = map[string]string{"field": resourceErr.Field, "group": resourceErr.Group}
+		return class
+	}
+
+	for _, it := range errorClasses {
+		if errors.Is(err, it.err) {
+			return grpc.ErrorClassOf(it.category, it.code)
+		}
+	}
+
+	return nil
+}
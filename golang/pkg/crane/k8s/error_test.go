@@ -4,9 +4,12 @@
 package k8s_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,3 +34,20 @@ func TestErrorWithFallback(t *testing.T) {
 	// THEN
 	assert.Equal(t, "failed to parse default 'ErrorField' in 'ErrorGroup'", result)
 }
+
+func TestClassifyError(t *testing.T) {
+	resources := k8s.ClassifyError(fmt.Errorf("deploy: %w", k8s.NewResourceError(k8s.FieldCPU, k8s.GroupLimits, false)))
+	assert.Equal(t, "INVALID_RESOURCES", resources.Code)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT, resources.Category)
+	assert.Equal(t, map[string]string{"field": "CPU", "group": "Limits"}, resources.Details)
+
+	notRunning := k8s.ClassifyError(fmt.Errorf("debug: %w", k8s.ErrNoRunningPod))
+	assert.Equal(t, "POD_NOT_RUNNING", notRunning.Code)
+	assert.True(t, notRunning.Retryable)
+
+	revision := k8s.ClassifyError(k8s.ErrRevisionNotFound)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND, revision.Category)
+	assert.False(t, revision.Retryable)
+
+	assert.Nil(t, k8s.ClassifyError(errors.New("unknown")))
+}
@@ -120,16 +120,15 @@ func (ing *ingress) deployIngress(options *DeployIngressOptions) error {
 		TypeMetaApplyConfiguration: *applymetav1.TypeMeta().WithKind("Ingress").WithAPIVersion("networking.k8s.io/v1"),
 		ObjectMetaApplyConfiguration: applymetav1.ObjectMeta().
 			WithName(options.containerName).
-			WithAnnotations(annot).WithLabels(labels),
+			WithAnnotations(annot).WithLabels(withOwnerLabels(labels, ing.appConfig, options.namespace, options.containerName)),
 		Spec: spec,
 	}
 
-	ingress, err := client.Apply(ing.ctx, applyConfig, metav1.ApplyOptions{
-		FieldManager: ing.appConfig.FieldManagerName,
-		Force:        ing.appConfig.ForceOnConflicts,
+	_, err = applyAdopting(ing.appConfig, "ingress", options.containerName, func(opts metav1.ApplyOptions) (*v1.Ingress, error) {
+		return client.Apply(ing.ctx, applyConfig, opts)
 	})
 	if err != nil {
-		log.Error().Err(err).Str("ingress", ingress.ObjectMeta.Name).Send()
+		log.Error().Err(err).Str("ingress", options.containerName).Send()
 	}
 
 	return err
@@ -157,7 +156,7 @@ func getIngressAnnotations(opts *DeployIngressOptions) map[string]string {
 	corsHeaders := []string{}
 
 	annotations := map[string]string{
-		"kubernetes.io/ingress.class": "nginx",
+		ingressClassAnnotation: defaultIngressClass,
 	}
 
 	if opts.tls {
@@ -2,12 +2,15 @@
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
@@ -15,6 +18,30 @@
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
+var (
+	ErrNamespaceProtected   = goerrors.New("namespace is protected")
+	ErrNamespaceNotManaged  = goerrors.New("namespace is not managed by crane")
+	ErrNamespaceTerminating = goerrors.New("namespace is being deleted")
+	ErrNamespaceStuck       = goerrors.New("namespace deletion is stuck")
+)
+
+// systemNamespaces are never deleted, regardless of their labels
+var systemNamespaces = []string{
+	metav1.NamespaceDefault,
+	metav1.NamespaceSystem,
+	metav1.NamespacePublic,
+	apiv1.NamespaceNodeLease,
+}
+
+// namespaceDeletionConditions are the conditions the namespace controller sets while the deletion is blocked
+var namespaceDeletionConditions = []apiv1.NamespaceConditionType{
+	apiv1.NamespaceDeletionDiscoveryFailure,
+	apiv1.NamespaceDeletionContentFailure,
+	apiv1.NamespaceDeletionGVParsingFailure,
+	apiv1.NamespaceContentRemaining,
+	apiv1.NamespaceFinalizersRemaining,
+}
+
 // Namespace wrapper for the facade
 type Namespace struct {
 	ctx       context.Context
@@ -58,6 +85,8 @@ func (n *Namespace) EnsureExists(namespace string) error {
 	return nil
 }
 
+// DeployNamespace creates the namespace, an existing one is labeled only if crane manages it already
+// or adopting is enabled, so shared namespaces can not become deletable by deploying into them
 func (n *Namespace) DeployNamespace(name string) error {
 	clientSet, err := n.getNamespaceClient()
 	if err != nil {
@@ -68,12 +97,25 @@ func (n *Namespace) DeployNamespace(name string) error {
 		n.name = "default"
 	}
 
-	_, err = clientSet.Apply(n.ctx,
-		corev1.Namespace(name),
-		metav1.ApplyOptions{
-			FieldManager: n.appConfig.FieldManagerName,
-			Force:        n.appConfig.ForceOnConflicts,
-		})
+	existing, err := clientSet.Get(n.ctx, name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if err == nil {
+		if existing.DeletionTimestamp != nil {
+			return fmt.Errorf("%s: %w: %s", name, ErrNamespaceTerminating, strings.Join(namespaceDeletionBlockers(existing), "; "))
+		}
+
+		if !isManagedNamespace(existing, n.appConfig) && !n.appConfig.AdoptExistingResources {
+			log.Info().Str("namespace", name).Msg("Using existing namespace, it is not managed by crane")
+			return nil
+		}
+	}
+
+	_, err = applyAdopting(n.appConfig, "namespace", name, func(opts metav1.ApplyOptions) (*apiv1.Namespace, error) {
+		return clientSet.Apply(n.ctx, corev1.Namespace(name).WithLabels(ownerLabels(n.appConfig, name, "")), opts)
+	})
 	if err != nil {
 		return err
 	}
@@ -111,19 +153,124 @@ func (n *Namespace) Exists() (bool, error) {
 	return true, nil
 }
 
+// DeleteNamespace deletes a namespace crane manages and waits until it is gone, if the deletion does not finish
+// in time, the error lists the finalizers and the conditions blocking it
 func (n *Namespace) DeleteNamespace(name string) error {
+	if isProtectedNamespace(name, n.appConfig) {
+		return fmt.Errorf("%s: %w", name, ErrNamespaceProtected)
+	}
+
 	client, err := n.getNamespaceClient()
 	if err != nil {
 		return err
 	}
 
-	err = client.Delete(
-		n.ctx,
-		name,
-		metav1.DeleteOptions{},
-	)
+	namespace, err := client.Get(n.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if !isManagedNamespace(namespace, n.appConfig) {
+		return fmt.Errorf("%s: %w, only namespaces labeled %s=%s or applied by it are deleted",
+			name, ErrNamespaceNotManaged, ManagedByLabel, n.appConfig.FieldManagerName)
+	}
+
+	if namespace.DeletionTimestamp == nil {
+		err = client.Delete(n.ctx, name, metav1.DeleteOptions{Preconditions: metav1.NewUIDPreconditions(string(namespace.UID))})
+		if err != nil {
+			return err
+		}
+
+		log.Info().Str("namespace", name).Msg("Namespace deletion requested")
+	}
+
+	return n.waitForNamespaceDeletion(client, name)
+}
+
+func (n *Namespace) waitForNamespaceDeletion(client v1.NamespaceInterface, name string) error {
+	var terminating *apiv1.Namespace
+	err := wait.PollUntilContextTimeout(n.ctx, rolloutPollInterval, n.appConfig.DefaultKubeTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			current, getErr := client.Get(ctx, name, metav1.GetOptions{})
+			if errors.IsNotFound(getErr) {
+				return true, nil
+			}
+			if getErr != nil {
+				return false, getErr
+			}
+
+			terminating = current
+			return false, nil
+		})
+	if err == nil {
+		log.Info().Str("namespace", name).Msg("Namespace deleted")
+		return nil
+	}
+
+	if terminating == nil {
+		return err
+	}
+
+	blockers := namespaceDeletionBlockers(terminating)
+	log.Warn().Str("namespace", name).Strs("blockers", blockers).Msg("Namespace deletion is stuck")
+
+	return fmt.Errorf("%s: %w after %s: %s", name, ErrNamespaceStuck, n.appConfig.DefaultKubeTimeout, strings.Join(blockers, "; "))
+}
+
+func isProtectedNamespace(name string, cfg *config.Configuration) bool {
+	for _, system := range systemNamespaces {
+		if name == system {
+			return true
+		}
+	}
+
+	return name == cfg.Namespace || name == cfg.OwnNamespace
+}
+
+// isManagedNamespace is true for the namespaces labeled by crane, and for the unlabeled ones crane applied
+// before the owner labels existed, these are labeled by the next deployment into them
+func isManagedNamespace(namespace *apiv1.Namespace, cfg *config.Configuration) bool {
+	managedBy, labeled := namespace.Labels[ManagedByLabel]
+	if labeled {
+		return managedBy == cfg.FieldManagerName
+	}
+
+	for i := range namespace.ManagedFields {
+		entry := &namespace.ManagedFields[i]
+		if entry.Manager == cfg.FieldManagerName && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+
+	return false
+}
+
+// namespaceDeletionBlockers lists the finalizers left on the namespace and the messages
+// of the deletion conditions, the finalizers of the spec are removed by the namespace controller last
+func namespaceDeletionBlockers(namespace *apiv1.Namespace) []string {
+	blockers := []string{}
+	for _, finalizer := range namespace.Finalizers {
+		blockers = append(blockers, fmt.Sprintf("finalizer %s", finalizer))
+	}
+
+	for _, finalizer := range namespace.Spec.Finalizers {
+		blockers = append(blockers, fmt.Sprintf("finalizer %s", finalizer))
+	}
+
+	for i := range namespace.Status.Conditions {
+		condition := &namespace.Status.Conditions[i]
+		if condition.Status != apiv1.ConditionTrue {
+			continue
+		}
+
+		for _, conditionType := range namespaceDeletionConditions {
+			if condition.Type == conditionType {
+				blockers = append(blockers, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+			}
+		}
+	}
 
-	return err
+	return blockers
 }
 
 func extractName(in *apiv1.NamespaceList) []NamespaceResponse {
@@ -0,0 +1,7 @@
+package k8s
+
+var (
+	IsProtectedNamespace      = isProtectedNamespace
+	IsManagedNamespace        = isManagedNamespace
+	NamespaceDeletionBlockers = namespaceDeletionBlockers
+)
@@ -0,0 +1,90 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func TestProtectedNamespaces(t *testing.T) {
+	cfg := &config.Configuration{Namespace: "dyrectorio", OwnNamespace: "crane"}
+
+	for _, name := range []string{"default", "kube-system", "kube-public", "kube-node-lease", "dyrectorio", "crane"} {
+		assert.True(t, k8s.IsProtectedNamespace(name, cfg), name)
+	}
+	assert.False(t, k8s.IsProtectedNamespace("prefix", cfg))
+}
+
+func TestManagedNamespace(t *testing.T) {
+	cfg := &config.Configuration{FieldManagerName: "crane-dyrector-io"}
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shared"}}
+	assert.False(t, k8s.IsManagedNamespace(namespace, cfg))
+
+	namespace.Labels = map[string]string{k8s.ManagedByLabel: "helm"}
+	assert.False(t, k8s.IsManagedNamespace(namespace, cfg))
+
+	namespace.Labels[k8s.ManagedByLabel] = "crane-dyrector-io"
+	assert.True(t, k8s.IsManagedNamespace(namespace, cfg))
+}
+
+func TestManagedNamespaceAppliedBeforeLabels(t *testing.T) {
+	cfg := &config.Configuration{FieldManagerName: "crane-dyrector-io"}
+
+	// the namespaces created by crane before the owner labels were applied without them
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "prefix",
+		ManagedFields: []metav1.ManagedFieldsEntry{
+			{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate},
+			{Manager: "crane-dyrector-io", Operation: metav1.ManagedFieldsOperationApply},
+		},
+	}}
+	assert.True(t, k8s.IsManagedNamespace(namespace, cfg))
+
+	namespace.ManagedFields = namespace.ManagedFields[:1]
+	assert.False(t, k8s.IsManagedNamespace(namespace, cfg))
+
+	// a label of an other manager wins over the fields applied by crane
+	namespace.ManagedFields = append(namespace.ManagedFields,
+		metav1.ManagedFieldsEntry{Manager: "crane-dyrector-io", Operation: metav1.ManagedFieldsOperationApply})
+	namespace.Labels = map[string]string{k8s.ManagedByLabel: "helm"}
+	assert.False(t, k8s.IsManagedNamespace(namespace, cfg))
+}
+
+func TestNamespaceDeletionBlockers(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"example.com/cleanup"}},
+		Spec:       corev1.NamespaceSpec{Finalizers: []corev1.FinalizerName{corev1.FinalizerKubernetes}},
+		Status: corev1.NamespaceStatus{
+			Phase: corev1.NamespaceTerminating,
+			Conditions: []corev1.NamespaceCondition{
+				{
+					Type:    corev1.NamespaceDeletionDiscoveryFailure,
+					Status:  corev1.ConditionFalse,
+					Message: "All resources successfully discovered",
+				},
+				{
+					Type:    corev1.NamespaceFinalizersRemaining,
+					Status:  corev1.ConditionTrue,
+					Message: "Some content in the namespace has finalizers remaining: example.com/protect in 1 resource instances",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"finalizer example.com/cleanup",
+		"finalizer kubernetes",
+		"NamespaceFinalizersRemaining: Some content in the namespace has finalizers remaining: example.com/protect in 1 resource instances",
+	}, k8s.NamespaceDeletionBlockers(namespace))
+
+	assert.Empty(t, k8s.NamespaceDeletionBlockers(&corev1.Namespace{}))
+}
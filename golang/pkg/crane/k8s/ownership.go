@@ -0,0 +1,67 @@
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+)
+
+const (
+	// ManagedByLabel is set to the field manager name of crane on every object it creates
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	PrefixLabel    = "crane.dyrector.io/prefix"
+	// ContainerLabel is only set on the objects of a single container
+	ContainerLabel = "crane.dyrector.io/container"
+)
+
+// ownerLabels is the label set of the objects crane creates, containerName is empty for the shared objects of a prefix
+func ownerLabels(cfg *config.Configuration, prefix, containerName string) map[string]string {
+	labels := map[string]string{
+		ManagedByLabel: cfg.FieldManagerName,
+		PrefixLabel:    prefix,
+	}
+
+	if containerName != "" {
+		labels[ContainerLabel] = containerName
+	}
+
+	return labels
+}
+
+// withOwnerLabels copies the labels and sets the owner labels, user defined labels can not override them
+func withOwnerLabels(labels map[string]string, cfg *config.Configuration, prefix, containerName string) map[string]string {
+	result := map[string]string{}
+	maps.Copy(result, labels)
+	maps.Copy(result, ownerLabels(cfg, prefix, containerName))
+
+	return result
+}
+
+// applyAdopting runs a server-side apply, if the object has fields owned by another manager, the apply fails
+// with a conflict unless conflicts are forced, then the object is adopted only if it is enabled explicitly
+func applyAdopting[T any](cfg *config.Configuration, kind, name string, apply func(metav1.ApplyOptions) (T, error)) (T, error) {
+	result, err := apply(metav1.ApplyOptions{
+		FieldManager: cfg.FieldManagerName,
+		Force:        cfg.ForceOnConflicts,
+	})
+	if err == nil || !errors.IsConflict(err) || cfg.ForceOnConflicts {
+		return result, err
+	}
+
+	if !cfg.AdoptExistingResources {
+		return result, fmt.Errorf("%s %s already exists with fields of other managers, "+
+			"set ADOPT_EXISTING_RESOURCES to take it over: %w", kind, name, err)
+	}
+
+	log.Warn().Str("kind", kind).Str("name", name).Msg("Adopting existing resource")
+
+	return apply(metav1.ApplyOptions{
+		FieldManager: cfg.FieldManagerName,
+		Force:        true,
+	})
+}
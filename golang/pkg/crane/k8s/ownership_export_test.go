@@ -0,0 +1,16 @@
+package k8s
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+)
+
+var (
+	OwnerLabels     = ownerLabels
+	WithOwnerLabels = withOwnerLabels
+)
+
+func ApplyAdopting(cfg *config.Configuration, apply func(metav1.ApplyOptions) (string, error)) (string, error) {
+	return applyAdopting(cfg, "configmap", "api", apply)
+}
@@ -0,0 +1,85 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func TestOwnerLabels(t *testing.T) {
+	cfg := &config.Configuration{FieldManagerName: "crane-dyrector-io"}
+
+	assert.Equal(t, map[string]string{
+		k8s.ManagedByLabel: "crane-dyrector-io",
+		k8s.PrefixLabel:    "prefix",
+	}, k8s.OwnerLabels(cfg, "prefix", ""))
+
+	labels := map[string]string{"team": "core", k8s.ManagedByLabel: "helm"}
+	assert.Equal(t, map[string]string{
+		"team":             "core",
+		k8s.ManagedByLabel: "crane-dyrector-io",
+		k8s.PrefixLabel:    "prefix",
+		k8s.ContainerLabel: "api",
+	}, k8s.WithOwnerLabels(labels, cfg, "prefix", "api"))
+	assert.Equal(t, "helm", labels[k8s.ManagedByLabel], "the original labels are left as is")
+}
+
+func TestApplyAdopting(t *testing.T) {
+	conflict := errors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "api", nil)
+
+	testCases := []struct {
+		desc    string
+		cfg     config.Configuration
+		forced  []bool
+		wantErr bool
+	}{
+		{
+			desc:   "forced conflicts are applied once",
+			cfg:    config.Configuration{ForceOnConflicts: true},
+			forced: []bool{true},
+		},
+		{
+			desc:    "conflicts fail without adoption",
+			cfg:     config.Configuration{},
+			forced:  []bool{false},
+			wantErr: true,
+		},
+		{
+			desc:   "conflicts are forced with adoption",
+			cfg:    config.Configuration{AdoptExistingResources: true},
+			forced: []bool{false, true},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			cfg := tC.cfg
+			forced := []bool{}
+			result, err := k8s.ApplyAdopting(&cfg, func(opts metav1.ApplyOptions) (string, error) {
+				forced = append(forced, opts.Force)
+				if !opts.Force {
+					return "", conflict
+				}
+				return "applied", nil
+			})
+
+			assert.Equal(t, tC.forced, forced)
+			if tC.wantErr {
+				assert.ErrorContains(t, err, "ADOPT_EXISTING_RESOURCES")
+				assert.True(t, errors.IsConflict(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "applied", result)
+		})
+	}
+}
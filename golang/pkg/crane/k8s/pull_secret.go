@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/slices"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+func pullSecretName(containerName string) string {
+	return fmt.Sprintf("%s-reg", containerName)
+}
+
+// syncPullSecret creates or updates the image pull secret of a container from the registry credentials of
+// the deployment, the returned name is referenced from the pod spec, it is empty if the secret is attached
+// to the service account instead or if there are no credentials, then the secret of an earlier deployment is removed
+func (s *Secret) syncPullSecret(namespace, containerName string, credentials *imageHelper.RegistryAuth) (string, error) {
+	name := pullSecretName(containerName)
+	if credentials == nil {
+		return "", s.deletePullSecret(namespace, containerName)
+	}
+
+	labels := ownerLabels(s.appConfig, namespace, containerName)
+	if err := s.ApplyRegistryAuthSecret(s.ctx, namespace, name, labels, credentials, s.appConfig); err != nil {
+		return "", fmt.Errorf("could not apply image pull secret %s: %w", name, err)
+	}
+
+	if s.appConfig.PullSecretServiceAccount == "" {
+		return name, nil
+	}
+
+	if err := s.updateServiceAccountPullSecrets(namespace, name, true); err != nil {
+		return "", fmt.Errorf("could not attach image pull secret %s to service account %s: %w",
+			name, s.appConfig.PullSecretServiceAccount, err)
+	}
+
+	return "", nil
+}
+
+// deletePullSecret removes the image pull secret of a container, a missing secret is not an error
+func (s *Secret) deletePullSecret(namespace, containerName string) error {
+	name := pullSecretName(containerName)
+
+	if s.appConfig.PullSecretServiceAccount != "" {
+		if err := s.updateServiceAccountPullSecrets(namespace, name, false); err != nil {
+			return fmt.Errorf("could not detach image pull secret %s from service account %s: %w",
+				name, s.appConfig.PullSecretServiceAccount, err)
+		}
+	}
+
+	cli, err := s.getSecretClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	err = cli.Delete(s.ctx, name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		log.Info().Str("namespace", namespace).Str("name", name).Msg("Image pull secret removed")
+	}
+
+	return nil
+}
+
+// updateServiceAccountPullSecrets adds or removes the secret from the service account, the list is updated
+// instead of applied, because it is owned as a whole and other managers may have their own secrets in it
+func (s *Secret) updateServiceAccountPullSecrets(namespace, secretName string, attach bool) error {
+	clientset, err := s.client.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	cli := clientset.CoreV1().ServiceAccounts(namespace)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		account, getErr := cli.Get(s.ctx, s.appConfig.PullSecretServiceAccount, metav1.GetOptions{})
+		if getErr != nil {
+			if !attach && errors.IsNotFound(getErr) {
+				return nil
+			}
+
+			return getErr
+		}
+
+		refs, changed := withPullSecret(account.ImagePullSecrets, secretName)
+		if !attach {
+			refs, changed = withoutPullSecret(account.ImagePullSecrets, secretName)
+		}
+		if !changed {
+			return nil
+		}
+
+		account.ImagePullSecrets = refs
+		_, updateErr := cli.Update(s.ctx, account, metav1.UpdateOptions{FieldManager: s.appConfig.FieldManagerName})
+		return updateErr
+	})
+}
+
+func withPullSecret(refs []apicorev1.LocalObjectReference, name string) ([]apicorev1.LocalObjectReference, bool) {
+	if slices.ContainsFunc(refs, func(ref apicorev1.LocalObjectReference) bool { return ref.Name == name }) {
+		return refs, false
+	}
+
+	return append(slices.Clone(refs), apicorev1.LocalObjectReference{Name: name}), true
+}
+
+func withoutPullSecret(refs []apicorev1.LocalObjectReference, name string) ([]apicorev1.LocalObjectReference, bool) {
+	result := []apicorev1.LocalObjectReference{}
+	for _, ref := range refs {
+		if ref.Name != name {
+			result = append(result, ref)
+		}
+	}
+
+	return result, len(result) != len(refs)
+}
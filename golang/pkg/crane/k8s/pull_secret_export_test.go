@@ -0,0 +1,7 @@
+package k8s
+
+var (
+	PullSecretName    = pullSecretName
+	WithPullSecret    = withPullSecret
+	WithoutPullSecret = withoutPullSecret
+)
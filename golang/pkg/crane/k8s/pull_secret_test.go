@@ -0,0 +1,41 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func TestPullSecretName(t *testing.T) {
+	assert.Equal(t, "api-reg", k8s.PullSecretName("api"))
+}
+
+func TestWithPullSecret(t *testing.T) {
+	refs := []corev1.LocalObjectReference{{Name: "other-registry"}}
+
+	attached, changed := k8s.WithPullSecret(refs, "api-reg")
+	assert.True(t, changed)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "other-registry"}, {Name: "api-reg"}}, attached)
+	assert.Len(t, refs, 1, "the original list is left as is")
+
+	again, changed := k8s.WithPullSecret(attached, "api-reg")
+	assert.False(t, changed)
+	assert.Equal(t, attached, again)
+}
+
+func TestWithoutPullSecret(t *testing.T) {
+	refs := []corev1.LocalObjectReference{{Name: "other-registry"}, {Name: "api-reg"}}
+
+	detached, changed := k8s.WithoutPullSecret(refs, "api-reg")
+	assert.True(t, changed)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "other-registry"}}, detached)
+
+	_, changed = k8s.WithoutPullSecret(detached, "api-reg")
+	assert.False(t, changed)
+}
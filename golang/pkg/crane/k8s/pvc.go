@@ -125,12 +125,13 @@ func (p *PVC) ApplyVolume(client typedv1.PersistentVolumeClaimInterface,
 		claimSpec.WithStorageClassName(volume.Class)
 	}
 	claim := corev1.PersistentVolumeClaim(fullVolumeName, namespace).
+		WithLabels(ownerLabels(p.appConfig, namespace, name)).
 		WithSpec(claimSpec)
 
-	result, err := client.Apply(p.ctx, claim, metaV1.ApplyOptions{
-		FieldManager: p.appConfig.FieldManagerName,
-		Force:        p.appConfig.ForceOnConflicts,
-	})
+	result, err := applyAdopting(p.appConfig, "pvc", fullVolumeName,
+		func(opts metaV1.ApplyOptions) (*coreV1.PersistentVolumeClaim, error) {
+			return client.Apply(p.ctx, claim, opts)
+		})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,328 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/rs/zerolog/log"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+const (
+	recommendationPercentile = 0.95
+	// recommendationMargin is added on top of the percentile, so the usual peaks fit into the requests
+	recommendationMargin = 1.15
+	minRecommendedCPU    = 10       // millicores
+	minRecommendedMemory = 32 << 20 // bytes
+	// maxMetricsBackoff is the longest delay of the samples while the metrics API is missing
+	maxMetricsBackoff = 30 * time.Minute
+)
+
+// podMetricsList is the part of the metrics.k8s.io PodMetricsList used for the samples, the API is served
+// by the metrics server, so its client is not a dependency
+type podMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		Containers []struct {
+			Usage map[string]resource.Quantity `json:"usage"`
+			Name  string                       `json:"name"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+type sampledContainer struct {
+	prefix string
+	name   string
+}
+
+type usageSamples struct {
+	requests *common.Resource
+	cpu      []int64 // millicores
+	memory   []int64 // bytes
+}
+
+// add keeps the last size samples
+func (s *usageSamples) add(cpu, memory int64, size int) {
+	s.cpu = append(s.cpu, cpu)
+	s.memory = append(s.memory, memory)
+	if size > 0 && len(s.cpu) > size {
+		s.cpu = s.cpu[len(s.cpu)-size:]
+		s.memory = s.memory[len(s.memory)-size:]
+	}
+}
+
+// ResourceUsageSampler samples the usage of the main containers of the managed pods from the metrics server,
+// the samples of the window are kept in memory, so the recommendations start over after a restart
+type ResourceUsageSampler struct {
+	metricsRetry   time.Time
+	samples        map[sampledContainer]*usageSamples
+	appConfig      *config.Configuration
+	metricsBackoff time.Duration
+	mutex          sync.Mutex
+}
+
+func NewResourceUsageSampler(cfg *config.Configuration) *ResourceUsageSampler {
+	return &ResourceUsageSampler{
+		samples:   map[sampledContainer]*usageSamples{},
+		appConfig: cfg,
+	}
+}
+
+// windowSize is the number of samples kept, at least the last one, even if the window is shorter than the interval
+func (s *ResourceUsageSampler) windowSize() int {
+	if s.appConfig.ResourceSampleInterval <= 0 {
+		return 0
+	}
+
+	return max(1, int(s.appConfig.ResourceSampleWindow/s.appConfig.ResourceSampleInterval))
+}
+
+// Start samples until the context is done, it is a no-op if the interval is not positive
+func (s *ResourceUsageSampler) Start(ctx context.Context) {
+	if s.appConfig.ResourceSampleInterval <= 0 {
+		log.Info().Msg("Resource usage sampling is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.appConfig.ResourceSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if now.Before(s.metricsRetry) {
+				continue
+			}
+			if err := s.sample(ctx); err != nil {
+				log.Warn().Err(err).Msg("Failed to sample resource usage")
+			}
+		}
+	}
+}
+
+func (s *ResourceUsageSampler) sample(ctx context.Context) error {
+	clientset, err := NewClient(s.appConfig).GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	pods, err := clientset.CoreV1().Pods(coreV1.NamespaceAll).List(ctx, metaV1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", ManagedByLabel, s.appConfig.FieldManagerName),
+	})
+	if err != nil {
+		return err
+	}
+
+	namespaces := map[string]bool{}
+	live := map[sampledContainer]bool{}
+	for i := range pods.Items {
+		namespaces[pods.Items[i].Namespace] = true
+		live[sampledContainer{prefix: pods.Items[i].Namespace, name: pods.Items[i].Labels[ContainerLabel]}] = true
+	}
+	s.prune(live)
+
+	for namespace := range namespaces {
+		metrics, err := getPodMetrics(ctx, clientset, namespace)
+		if errors.IsNotFound(err) || errors.IsForbidden(err) {
+			s.backOffMetrics(err)
+			return nil
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("namespace", namespace).Msg("Failed to get pod metrics")
+			continue
+		}
+
+		s.record(pods.Items, metrics)
+	}
+
+	if s.metricsBackoff > 0 {
+		log.Info().Msg("Pod metrics are available, resource usage sampling resumed")
+		s.metricsBackoff = 0
+	}
+
+	return nil
+}
+
+// backOffMetrics delays the samples while the metrics API is missing or forbidden, doubling the delay
+// up to maxMetricsBackoff, the warning is logged only when the backoff starts
+func (s *ResourceUsageSampler) backOffMetrics(err error) {
+	if s.metricsBackoff == 0 {
+		log.Warn().Err(err).Msg("Pod metrics are not available, make sure the metrics server is installed " +
+			"and crane can read metrics.k8s.io, sampling is backing off")
+		s.metricsBackoff = s.appConfig.ResourceSampleInterval
+	} else {
+		s.metricsBackoff = min(2*s.metricsBackoff, maxMetricsBackoff)
+	}
+
+	s.metricsRetry = time.Now().Add(s.metricsBackoff)
+}
+
+func getPodMetrics(ctx context.Context, clientset *kubernetes.Clientset, namespace string) (*podMetricsList, error) {
+	content, err := clientset.RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", namespace, "pods").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pod metrics, is the metrics server installed: %w", err)
+	}
+
+	metrics := &podMetricsList{}
+	if err = json.Unmarshal(content, metrics); err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// prune drops the samples of the containers without pods
+func (s *ResourceUsageSampler) prune(live map[sampledContainer]bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id := range s.samples {
+		if !live[id] {
+			delete(s.samples, id)
+		}
+	}
+}
+
+// record adds the usage of the main container of each pod, the main container is named after the deployment
+func (s *ResourceUsageSampler) record(pods []coreV1.Pod, metrics *podMetricsList) {
+	podsByName := map[string]*coreV1.Pod{}
+	for i := range pods {
+		podsByName[pods[i].Namespace+"/"+pods[i].Name] = &pods[i]
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, item := range metrics.Items {
+		pod, ok := podsByName[item.Metadata.Namespace+"/"+item.Metadata.Name]
+		if !ok {
+			continue
+		}
+
+		name := pod.Labels[ContainerLabel]
+		for _, cont := range item.Containers {
+			if cont.Name != name {
+				continue
+			}
+
+			id := sampledContainer{prefix: pod.Namespace, name: name}
+			samples, ok := s.samples[id]
+			if !ok {
+				samples = &usageSamples{}
+				s.samples[id] = samples
+			}
+
+			samples.requests = podContainerRequests(pod, name)
+			cpu, memory := cont.Usage[string(coreV1.ResourceCPU)], cont.Usage[string(coreV1.ResourceMemory)]
+			samples.add(cpu.MilliValue(), memory.Value(), s.windowSize())
+		}
+	}
+}
+
+func podContainerRequests(pod *coreV1.Pod, containerName string) *common.Resource {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name != containerName {
+			continue
+		}
+
+		requests := &common.Resource{}
+		if cpu, ok := pod.Spec.Containers[i].Resources.Requests[coreV1.ResourceCPU]; ok {
+			requests.Cpu = pointer.ToString(cpu.String())
+		}
+		if memory, ok := pod.Spec.Containers[i].Resources.Requests[coreV1.ResourceMemory]; ok {
+			requests.Memory = pointer.ToString(memory.String())
+		}
+
+		return requests
+	}
+
+	return nil
+}
+
+// percentile uses the nearest-rank method, values are sorted in place
+func percentile(values []int64, p float64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	rank := int(math.Ceil(p*float64(len(values)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+
+	return values[rank]
+}
+
+// recommendResources is the percentile of the samples with a margin, memory is rounded up to MiB
+func recommendResources(samples *usageSamples) *common.Resource {
+	cpu := int64(math.Ceil(float64(percentile(append([]int64{}, samples.cpu...), recommendationPercentile)) * recommendationMargin))
+	if cpu < minRecommendedCPU {
+		cpu = minRecommendedCPU
+	}
+
+	memory := int64(math.Ceil(float64(percentile(append([]int64{}, samples.memory...), recommendationPercentile)) * recommendationMargin))
+	if memory < minRecommendedMemory {
+		memory = minRecommendedMemory
+	}
+
+	const mebibyte = 1 << 20
+	memory = (memory + mebibyte - 1) / mebibyte
+
+	return &common.Resource{
+		Cpu:    pointer.ToString(fmt.Sprintf("%dm", cpu)),
+		Memory: pointer.ToString(fmt.Sprintf("%dMi", memory)),
+	}
+}
+
+// Recommend returns the recommendations of the sampled containers, limited to the prefix if it is set
+func (s *ResourceUsageSampler) Recommend(_ context.Context, req *agent.ResourceRecommendationRequest,
+) ([]*agent.ResourceRecommendation, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	recommendations := []*agent.ResourceRecommendation{}
+	for id, samples := range s.samples {
+		if req.Prefix != nil && id.prefix != req.GetPrefix() {
+			continue
+		}
+		if len(samples.cpu) == 0 {
+			continue
+		}
+
+		recommendations = append(recommendations, &agent.ResourceRecommendation{
+			Container:   &common.ContainerIdentifier{Prefix: id.prefix, Name: id.name},
+			Requests:    samples.requests,
+			Recommended: recommendResources(samples),
+			Samples:     int32(len(samples.cpu)), //#nosec G115 -- limited by the window
+		})
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		return recommendations[i].Container.Prefix+"/"+recommendations[i].Container.Name <
+			recommendations[j].Container.Prefix+"/"+recommendations[j].Container.Name
+	})
+
+	return recommendations, nil
+}
@@ -0,0 +1,29 @@
+package k8s
+
+import (
+	"encoding/json"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+var (
+	Percentile     = percentile
+	WindowSize     = (*ResourceUsageSampler).windowSize
+	BackOffMetrics = (*ResourceUsageSampler).backOffMetrics
+)
+
+func (s *ResourceUsageSampler) MetricsBackoff() time.Duration {
+	return s.metricsBackoff
+}
+
+// RecordForTest records the pod metrics JSON of the metrics server
+func (s *ResourceUsageSampler) RecordForTest(pods []coreV1.Pod, metricsJSON string) error {
+	metrics := &podMetricsList{}
+	if err := json.Unmarshal([]byte(metricsJSON), metrics); err != nil {
+		return err
+	}
+
+	s.record(pods, metrics)
+	return nil
+}
@@ -0,0 +1,106 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []int64{}
+	for i := int64(100); i > 0; i-- {
+		values = append(values, i)
+	}
+
+	assert.Equal(t, int64(95), k8s.Percentile(values, 0.95))
+	assert.Equal(t, int64(7), k8s.Percentile([]int64{7}, 0.95))
+	assert.Equal(t, int64(0), k8s.Percentile(nil, 0.95))
+}
+
+func TestResourceRecommendation(t *testing.T) {
+	sampler := k8s.NewResourceUsageSampler(&config.Configuration{
+		ResourceSampleInterval: time.Minute,
+		ResourceSampleWindow:   3 * time.Minute,
+	})
+
+	pods := []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api-1",
+			Namespace: "prefix",
+			Labels:    map[string]string{k8s.ContainerLabel: "api"},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "api",
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("500m"),
+			}},
+		}}},
+	}}
+
+	// the first sample is dropped by the window of 3 samples
+	for _, usage := range []string{"900m", "100m", "200m", "400m"} {
+		metrics := fmt.Sprintf(`{"items":[{"metadata":{"name":"api-1","namespace":"prefix"},"containers":[
+			{"name":"api","usage":{"cpu":%q,"memory":"100Mi"}},
+			{"name":"sidecar","usage":{"cpu":"1","memory":"1Gi"}}]}]}`, usage)
+		assert.NoError(t, sampler.RecordForTest(pods, metrics))
+	}
+
+	recommendations, err := sampler.Recommend(context.Background(), &agent.ResourceRecommendationRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, recommendations, 1)
+
+	recommendation := recommendations[0]
+	assert.Equal(t, "prefix", recommendation.Container.Prefix)
+	assert.Equal(t, "api", recommendation.Container.Name)
+	assert.Equal(t, int32(3), recommendation.Samples)
+	assert.Equal(t, "500m", recommendation.Requests.GetCpu())
+	assert.Nil(t, recommendation.Requests.Memory)
+	assert.Equal(t, "460m", recommendation.Recommended.GetCpu())
+	assert.Equal(t, "115Mi", recommendation.Recommended.GetMemory())
+
+	other, err := sampler.Recommend(context.Background(), &agent.ResourceRecommendationRequest{Prefix: pointer.ToString("other")})
+	assert.NoError(t, err)
+	assert.Empty(t, other)
+}
+
+func TestResourceSampleWindowSize(t *testing.T) {
+	sampler := k8s.NewResourceUsageSampler(&config.Configuration{
+		ResourceSampleInterval: time.Minute,
+		ResourceSampleWindow:   time.Hour,
+	})
+	assert.Equal(t, 60, k8s.WindowSize(sampler))
+
+	// the window shorter than the interval keeps the last sample instead of every sample
+	sampler = k8s.NewResourceUsageSampler(&config.Configuration{
+		ResourceSampleInterval: time.Minute,
+		ResourceSampleWindow:   time.Second,
+	})
+	assert.Equal(t, 1, k8s.WindowSize(sampler))
+}
+
+func TestResourceSampleMetricsBackoff(t *testing.T) {
+	sampler := k8s.NewResourceUsageSampler(&config.Configuration{ResourceSampleInterval: 10 * time.Minute})
+
+	missing := errors.New("the server could not find the requested resource")
+	k8s.BackOffMetrics(sampler, missing)
+	assert.Equal(t, 10*time.Minute, sampler.MetricsBackoff())
+	k8s.BackOffMetrics(sampler, missing)
+	assert.Equal(t, 20*time.Minute, sampler.MetricsBackoff())
+	k8s.BackOffMetrics(sampler, missing)
+	assert.Equal(t, 30*time.Minute, sampler.MetricsBackoff())
+}
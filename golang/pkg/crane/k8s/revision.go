@@ -0,0 +1,385 @@
+package k8s
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	kappsv1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// RevisionAnnotation identifies the pod template, so the ReplicaSet of a revision can be found for rollbacks
+const RevisionAnnotation = "crane.dyrector.io/revision"
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// deploymentRevision is an applied pod template of a container, stored in the history ConfigMap
+type deploymentRevision struct {
+	CreatedAt  time.Time         `json:"createdAt"`
+	Images     map[string]string `json:"images"`
+	Digests    map[string]string `json:"digests,omitempty"`
+	ConfigHash string            `json:"configHash"`
+	Actor      string            `json:"actor,omitempty"`
+	Revision   int64             `json:"revision"`
+	RollbackOf int64             `json:"rollbackOf,omitempty"`
+}
+
+// revisionHistory holds the revisions of a container in ascending order
+type revisionHistory struct {
+	revisions []deploymentRevision
+}
+
+func historyName(containerName string) string {
+	return fmt.Sprintf("%s-history", containerName)
+}
+
+func revisionKey(revision int64) string {
+	return fmt.Sprintf("revision-%010d", revision)
+}
+
+func parseRevisionHistory(data map[string]string) (*revisionHistory, error) {
+	history := &revisionHistory{revisions: []deploymentRevision{}}
+	for key, value := range data {
+		revision := deploymentRevision{}
+		if err := json.Unmarshal([]byte(value), &revision); err != nil {
+			return nil, fmt.Errorf("invalid revision %s: %w", key, err)
+		}
+
+		history.revisions = append(history.revisions, revision)
+	}
+
+	sort.Slice(history.revisions, func(i, j int) bool {
+		return history.revisions[i].Revision < history.revisions[j].Revision
+	})
+
+	return history, nil
+}
+
+func (h *revisionHistory) data() (map[string]string, error) {
+	data := map[string]string{}
+	for i := range h.revisions {
+		content, err := json.Marshal(&h.revisions[i])
+		if err != nil {
+			return nil, err
+		}
+
+		data[revisionKey(h.revisions[i].Revision)] = string(content)
+	}
+
+	return data, nil
+}
+
+func (h *revisionHistory) nextRevision() int64 {
+	if len(h.revisions) == 0 {
+		return 1
+	}
+
+	return h.revisions[len(h.revisions)-1].Revision + 1
+}
+
+// add appends the revision, the oldest ones are dropped over the limit
+func (h *revisionHistory) add(revision *deploymentRevision, limit int) {
+	h.revisions = append(h.revisions, *revision)
+	if limit > 0 && len(h.revisions) > limit {
+		h.revisions = h.revisions[len(h.revisions)-limit:]
+	}
+}
+
+// rollbackTarget is the requested revision, or the one before the latest if it is 0
+func (h *revisionHistory) rollbackTarget(requested int64) (*deploymentRevision, error) {
+	if requested == 0 {
+		if len(h.revisions) < 2 {
+			return nil, fmt.Errorf("there is no revision before the current one: %w", ErrRevisionNotFound)
+		}
+
+		return &h.revisions[len(h.revisions)-2], nil
+	}
+
+	for i := range h.revisions {
+		if h.revisions[i].Revision == requested {
+			return &h.revisions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("revision %d: %w", requested, ErrRevisionNotFound)
+}
+
+// fillDigests completes the revisions with the digests of the images the pods run, as the
+// digests are only known after the pods of a revision started
+func (h *revisionHistory) fillDigests(pods []coreV1.Pod) {
+	for i := range pods {
+		revision, err := strconv.ParseInt(pods[i].Annotations[RevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		for j := range h.revisions {
+			if h.revisions[j].Revision != revision {
+				continue
+			}
+
+			for _, statuses := range [][]coreV1.ContainerStatus{pods[i].Status.InitContainerStatuses, pods[i].Status.ContainerStatuses} {
+				h.revisions[j].addDigests(statuses)
+			}
+		}
+	}
+}
+
+func (r *deploymentRevision) addDigests(statuses []coreV1.ContainerStatus) {
+	for _, status := range statuses {
+		digest := imageDigest(status.ImageID)
+		if digest == "" {
+			continue
+		}
+
+		if r.Digests == nil {
+			r.Digests = map[string]string{}
+		}
+		r.Digests[status.Name] = digest
+	}
+}
+
+// imageDigest turns the image ID of a container status into a pullable reference, empty if it has no digest
+func imageDigest(imageID string) string {
+	if _, ref, ok := strings.Cut(imageID, "://"); ok {
+		imageID = ref
+	}
+
+	if !strings.Contains(imageID, "@sha256:") {
+		return ""
+	}
+
+	return imageID
+}
+
+// podTemplateHash is the hash of the template without the annotations changing on every deployment
+func podTemplateHash(labels, annotations map[string]string, spec *corev1.PodSpecApplyConfiguration) (string, error) {
+	stable := map[string]string{}
+	for key, value := range annotations {
+		if key != CraneUpdatedAnnotation && key != RevisionAnnotation {
+			stable[key] = value
+		}
+	}
+
+	content, err := json.Marshal(struct {
+		Labels      map[string]string                 `json:"labels"`
+		Annotations map[string]string                 `json:"annotations"`
+		Spec        *corev1.PodSpecApplyConfiguration `json:"spec"`
+	}{labels, stable, spec})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content)), nil
+}
+
+func podSpecImages(spec *corev1.PodSpecApplyConfiguration) map[string]string {
+	images := map[string]string{}
+	for _, containers := range [][]corev1.ContainerApplyConfiguration{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			if containers[i].Name != nil && containers[i].Image != nil {
+				images[*containers[i].Name] = *containers[i].Image
+			}
+		}
+	}
+
+	return images
+}
+
+// rollbackTemplate is the pod template of the revision as a new one, the images are pinned to the digests they ran with
+func rollbackTemplate(template *coreV1.PodTemplateSpec, target *deploymentRevision, revision int64) coreV1.PodTemplateSpec {
+	result := *template.DeepCopy()
+	delete(result.Labels, kappsv1.DefaultDeploymentUniqueLabelKey)
+
+	if result.Annotations == nil {
+		result.Annotations = map[string]string{}
+	}
+	result.Annotations[CraneUpdatedAnnotation] = time.Now().Format(time.RFC3339)
+	result.Annotations[RevisionAnnotation] = strconv.FormatInt(revision, 10)
+
+	for _, containers := range [][]coreV1.Container{result.Spec.InitContainers, result.Spec.Containers} {
+		for i := range containers {
+			if digest, ok := target.Digests[containers[i].Name]; ok {
+				containers[i].Image = digest
+			}
+		}
+	}
+
+	return result
+}
+
+func mapDeploymentRevision(revision *deploymentRevision) *agent.DeploymentRevision {
+	result := &agent.DeploymentRevision{
+		Revision:   revision.Revision,
+		ConfigHash: revision.ConfigHash,
+		CreatedAt:  timestamppb.New(revision.CreatedAt),
+		Images:     revision.Images,
+		Digests:    revision.Digests,
+	}
+
+	if revision.Actor != "" {
+		result.Actor = &revision.Actor
+	}
+	if revision.RollbackOf != 0 {
+		result.RollbackOf = &revision.RollbackOf
+	}
+
+	return result
+}
+
+func (d *Deployment) loadHistory(namespace, name string) (*revisionHistory, error) {
+	client, err := getConfigMapClient(namespace, d.appConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap, err := client.Get(d.ctx, historyName(name), metaV1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return &revisionHistory{revisions: []deploymentRevision{}}, nil
+		}
+
+		return nil, err
+	}
+
+	return parseRevisionHistory(configMap.Data)
+}
+
+// recordRevision stores the revision in the history, the missing digests of the earlier ones are taken from the running pods
+func (d *Deployment) recordRevision(namespace, name string, history *revisionHistory, revision *deploymentRevision) error {
+	pods, err := d.GetPods(namespace, name)
+	if err != nil {
+		log.Warn().Err(err).Str("name", name).Msg("Could not list pods for image digests")
+	} else {
+		history.fillDigests(pods)
+	}
+
+	history.add(revision, int(d.appConfig.RevisionHistoryLimit))
+
+	data, err := history.data()
+	if err != nil {
+		return err
+	}
+
+	client, err := getConfigMapClient(namespace, d.appConfig)
+	if err != nil {
+		return err
+	}
+
+	historyConfigMap := corev1.ConfigMap(historyName(name), namespace).
+		WithLabels(ownerLabels(d.appConfig, namespace, name)).
+		WithData(data)
+
+	_, err = applyAdopting(d.appConfig, "configmap", historyName(name), func(opts metaV1.ApplyOptions) (*coreV1.ConfigMap, error) {
+		return client.Apply(d.ctx, historyConfigMap, opts)
+	})
+
+	return err
+}
+
+func (d *Deployment) findRevisionReplicaSet(namespace, name string, revision int64) (*kappsv1.ReplicaSet, error) {
+	client, err := NewClient(d.appConfig).GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSets, err := client.AppsV1().ReplicaSets(namespace).List(d.ctx, metaV1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range replicaSets.Items {
+		if replicaSets.Items[i].Spec.Template.Annotations[RevisionAnnotation] == strconv.FormatInt(revision, 10) {
+			return &replicaSets.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("replica set of revision %d is no longer available: %w", revision, ErrRevisionNotFound)
+}
+
+// RollbackToRevision restores the pod template of an earlier revision as a new revision,
+// config maps and secrets referenced by the template are not restored, they keep their current content
+func RollbackToRevision(ctx context.Context, req *agent.RollbackToRevisionRequest) (*agent.RollbackToRevisionResponse, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	namespace := req.Container.Prefix
+	name := req.Container.Name
+
+	deployment := NewDeployment(ctx, cfg)
+	history, err := deployment.loadHistory(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := history.rollbackTarget(req.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSet, err := deployment.findRevisionReplicaSet(namespace, name, target.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &deploymentRevision{
+		CreatedAt:  time.Now().UTC(),
+		Images:     target.Images,
+		Digests:    target.Digests,
+		ConfigHash: target.ConfigHash,
+		Actor:      req.GetActor(),
+		Revision:   history.nextRevision(),
+		RollbackOf: target.Revision,
+	}
+	template := rollbackTemplate(&replicaSet.Spec.Template, target, revision.Revision)
+
+	client := getDeploymentsClient(namespace, cfg)
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := client.Get(ctx, name, metaV1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+
+		current.Spec.Template = template
+		_, updateErr := client.Update(ctx, current, metaV1.UpdateOptions{FieldManager: cfg.FieldManagerName})
+		return updateErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not roll back to revision %d: %w", target.Revision, err)
+	}
+
+	log.Info().Str("namespace", namespace).Str("name", name).Int64("revision", target.Revision).Msg("Rolled back")
+
+	if err = deployment.recordRevision(namespace, name, history, revision); err != nil {
+		return nil, fmt.Errorf("rolled back, but the revision could not be recorded: %w", err)
+	}
+
+	resp := &agent.RollbackToRevisionResponse{
+		Container: &common.ContainerIdentifier{Prefix: namespace, Name: name},
+		Revision:  mapDeploymentRevision(revision),
+		History:   []*agent.DeploymentRevision{},
+	}
+	for i := range history.revisions {
+		resp.History = append(resp.History, mapDeploymentRevision(&history.revisions[i]))
+	}
+
+	return resp, nil
+}
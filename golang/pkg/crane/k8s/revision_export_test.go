@@ -0,0 +1,40 @@
+package k8s
+
+import (
+	coreV1 "k8s.io/api/core/v1"
+)
+
+type DeploymentRevision = deploymentRevision
+
+var (
+	ImageDigest = imageDigest
+	HistoryName = historyName
+)
+
+// RevisionHistoryForTest runs the steps of recording a revision on the stored history data
+func RevisionHistoryForTest(data map[string]string, pods []coreV1.Pod, revision *DeploymentRevision, limit int) (
+	map[string]string, error,
+) {
+	history, err := parseRevisionHistory(data)
+	if err != nil {
+		return nil, err
+	}
+
+	history.fillDigests(pods)
+	history.add(revision, limit)
+
+	return history.data()
+}
+
+func RollbackTargetForTest(data map[string]string, requested int64) (*DeploymentRevision, error) {
+	history, err := parseRevisionHistory(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.rollbackTarget(requested)
+}
+
+func RollbackTemplateForTest(template *coreV1.PodTemplateSpec, target *DeploymentRevision, revision int64) coreV1.PodTemplateSpec {
+	return rollbackTemplate(template, target, revision)
+}
@@ -0,0 +1,96 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func revisionData(t *testing.T, revisions ...k8s.DeploymentRevision) map[string]string {
+	data := map[string]string{}
+	for i := range revisions {
+		content, err := json.Marshal(&revisions[i])
+		assert.NoError(t, err)
+		data[fmt.Sprintf("revision-%010d", revisions[i].Revision)] = string(content)
+	}
+
+	return data
+}
+
+func TestImageDigest(t *testing.T) {
+	assert.Equal(t, "nginx@sha256:abc", k8s.ImageDigest("docker-pullable://nginx@sha256:abc"))
+	assert.Equal(t, "docker.io/library/nginx@sha256:abc", k8s.ImageDigest("docker.io/library/nginx@sha256:abc"))
+	assert.Empty(t, k8s.ImageDigest("sha256:abc"))
+	assert.Equal(t, "api-history", k8s.HistoryName("api"))
+}
+
+func TestRevisionHistoryRecord(t *testing.T) {
+	data := revisionData(t,
+		k8s.DeploymentRevision{Revision: 1, Images: map[string]string{"api": "api:1"}},
+		k8s.DeploymentRevision{Revision: 2, Images: map[string]string{"api": "api:2"}},
+	)
+	pods := []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{k8s.RevisionAnnotation: "2"}},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "api", ImageID: "docker-pullable://api@sha256:2"}},
+		},
+	}}
+
+	next := &k8s.DeploymentRevision{Revision: 3, Images: map[string]string{"api": "api:3"}, CreatedAt: time.Now().UTC()}
+	result, err := k8s.RevisionHistoryForTest(data, pods, next, 2)
+	assert.NoError(t, err)
+	assert.Len(t, result, 2, "the oldest revision is dropped over the limit")
+	assert.NotContains(t, result, "revision-0000000001")
+
+	previous, err := k8s.RollbackTargetForTest(result, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), previous.Revision)
+	assert.Equal(t, map[string]string{"api": "api@sha256:2"}, previous.Digests)
+}
+
+func TestRollbackTarget(t *testing.T) {
+	data := revisionData(t, k8s.DeploymentRevision{Revision: 4}, k8s.DeploymentRevision{Revision: 5})
+
+	target, err := k8s.RollbackTargetForTest(data, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), target.Revision)
+
+	_, err = k8s.RollbackTargetForTest(data, 3)
+	assert.ErrorIs(t, err, k8s.ErrRevisionNotFound)
+
+	_, err = k8s.RollbackTargetForTest(revisionData(t, k8s.DeploymentRevision{Revision: 1}), 0)
+	assert.ErrorIs(t, err, k8s.ErrRevisionNotFound)
+}
+
+func TestRollbackTemplate(t *testing.T) {
+	template := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app": "api", "pod-template-hash": "abc"},
+			Annotations: map[string]string{k8s.RevisionAnnotation: "2"},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate:2"}},
+			Containers:     []corev1.Container{{Name: "api", Image: "api:2"}},
+		},
+	}
+	target := &k8s.DeploymentRevision{Revision: 2, Digests: map[string]string{"api": "api@sha256:2"}}
+
+	result := k8s.RollbackTemplateForTest(template, target, 6)
+
+	assert.Equal(t, map[string]string{"app": "api"}, result.Labels)
+	assert.Equal(t, "6", result.Annotations[k8s.RevisionAnnotation])
+	assert.NotEmpty(t, result.Annotations[k8s.CraneUpdatedAnnotation])
+	assert.Equal(t, "api@sha256:2", result.Spec.Containers[0].Image)
+	assert.Equal(t, "migrate:2", result.Spec.InitContainers[0].Image, "images without a digest are kept")
+	assert.Equal(t, "api:2", template.Spec.Containers[0].Image, "the replica set template is left as is")
+}
@@ -52,7 +52,8 @@ func NewSecret(ctx context.Context, client *Client) *Secret {
 	return &secr
 }
 
-func (s *Secret) applySecrets(namespace, name string, values map[string]string) error {
+// applySecrets creates the secret of the values, containerName is empty for shared secrets
+func (s *Secret) applySecrets(namespace, name, containerName string, values map[string]string) error {
 	cli, err := s.getSecretClient(namespace)
 	if err != nil {
 		return err
@@ -63,11 +64,12 @@ func (s *Secret) applySecrets(namespace, name string, values map[string]string)
 		return err
 	}
 
-	secrets := corev1.Secret(name, namespace).WithData(data)
+	secrets := corev1.Secret(name, namespace).
+		WithLabels(ownerLabels(s.appConfig, namespace, containerName)).
+		WithData(data)
 
-	result, err := cli.Apply(s.ctx, secrets, metav1.ApplyOptions{
-		FieldManager: s.appConfig.FieldManagerName,
-		Force:        s.appConfig.ForceOnConflicts,
+	result, err := applyAdopting(s.appConfig, "secret", name, func(opts metav1.ApplyOptions) (*apicorev1.Secret, error) {
+		return cli.Apply(s.ctx, secrets, opts)
 	})
 
 	if result != nil {
@@ -148,11 +150,12 @@ func (s *Secret) applyOpaqueSecrets(ctx context.Context, values map[string][]byt
 		return "", err
 	}
 
-	secrets := corev1.Secret(name, namespace).WithData(values)
+	secrets := corev1.Secret(name, namespace).
+		WithLabels(ownerLabels(s.appConfig, namespace, "")).
+		WithData(values)
 
-	result, err := cli.Apply(ctx, secrets, metav1.ApplyOptions{
-		FieldManager: s.appConfig.FieldManagerName,
-		Force:        s.appConfig.ForceOnConflicts,
+	result, err := applyAdopting(s.appConfig, "secret", name, func(opts metav1.ApplyOptions) (*apicorev1.Secret, error) {
+		return cli.Apply(ctx, secrets, opts)
 	})
 	if err != nil {
 		return "", err
@@ -164,6 +167,7 @@ func (s *Secret) applyOpaqueSecrets(ctx context.Context, values map[string][]byt
 func (s *Secret) ApplyRegistryAuthSecret(ctx context.Context,
 	namespace,
 	name string,
+	labels map[string]string,
 	credentials *imageHelper.RegistryAuth,
 	appConfig *config.Configuration,
 ) error {
@@ -177,15 +181,14 @@ func (s *Secret) ApplyRegistryAuthSecret(ctx context.Context,
 		return err
 	}
 
-	secrets := corev1.Secret(name, namespace).WithType(apicorev1.SecretTypeDockerConfigJson).WithData(
+	secrets := corev1.Secret(name, namespace).WithType(apicorev1.SecretTypeDockerConfigJson).WithLabels(labels).WithData(
 		map[string][]byte{
 			".dockerconfigjson": data,
 		},
 	)
 
-	_, err = cli.Apply(ctx, secrets, metav1.ApplyOptions{
-		FieldManager: appConfig.FieldManagerName,
-		Force:        appConfig.ForceOnConflicts,
+	_, err = applyAdopting(appConfig, "secret", name, func(opts metav1.ApplyOptions) (*apicorev1.Secret, error) {
+		return cli.Apply(ctx, secrets, opts)
 	})
 	if err != nil {
 		return err
@@ -4,11 +4,14 @@
 	"context"
 	"fmt"
 
+	"github.com/AlekSi/pointer"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
@@ -41,39 +44,46 @@ type ServiceParams struct {
 	namespace     string
 	name          string
 	selector      string
+	serviceType   v1.ServiceType
 	portBindings  []builder.PortBinding
 	portRanges    []builder.PortRangeBinding
 	useLB         bool
 }
 
+// effectiveServiceType falls back to the load balancer flag if no type is requested
+func (p *ServiceParams) effectiveServiceType() v1.ServiceType {
+	if p.serviceType != "" {
+		return p.serviceType
+	}
+
+	if p.useLB {
+		return v1.LoadBalancerServiceType
+	}
+
+	return v1.ClusterIPServiceType
+}
+
 func (s *Service) DeployService(params *ServiceParams) error {
 	client, err := s.getServiceClient(params.namespace)
 	if err != nil {
 		return err
 	}
 
-	if len(params.portBindings) == 0 {
+	serviceType := params.effectiveServiceType()
+	// a headless service is useful without ports too, its DNS name resolves to the pods
+	if len(params.portBindings) == 0 && serviceType != v1.HeadlessServiceType {
 		return nil
 	}
 
-	ports := getServicePorts(params.portBindings, params.portRanges)
-
-	svcSpec := acorev1.ServiceSpec().
-		WithSelector(map[string]string{"app": params.selector}).
-		WithPorts(ports...)
-
-	if params.useLB {
-		svcSpec.WithType(corev1.ServiceTypeLoadBalancer).
-			WithExternalTrafficPolicy(corev1.ServiceExternalTrafficPolicyTypeLocal)
-	} else {
-		svcSpec.WithType(corev1.ServiceTypeClusterIP)
+	if err = s.replaceOnHeadlessChange(client, params.name, serviceType == v1.HeadlessServiceType); err != nil {
+		return err
 	}
 
 	svc := acorev1.Service(params.name, params.namespace).
-		WithSpec(svcSpec)
+		WithSpec(getServiceSpec(params))
 
 	annot := map[string]string{}
-	if params.useLB {
+	if serviceType == v1.LoadBalancerServiceType {
 		maps.Copy(annot, params.LBAnnotations)
 	}
 	maps.Copy(annot, params.annotations)
@@ -83,19 +93,19 @@ func (s *Service) DeployService(params *ServiceParams) error {
 		"app": params.name,
 	}
 	maps.Copy(labels, params.labels)
-	svc.WithLabels(labels)
+	svc.WithLabels(withOwnerLabels(labels, s.appConfig, params.namespace, params.name))
 
-	res, err := client.Apply(s.ctx, svc, metav1.ApplyOptions{
-		FieldManager: s.appConfig.FieldManagerName,
-		Force:        s.appConfig.ForceOnConflicts,
+	res, err := applyAdopting(s.appConfig, "service", params.name, func(opts metav1.ApplyOptions) (*corev1.Service, error) {
+		return client.Apply(s.ctx, svc, opts)
 	})
 
 	if err != nil {
 		log.Error().Err(err).Stack().Msg("Service deploy error")
-	} else {
-		log.Info().Str("name", res.Name).Msg("Service deployed")
+		return err
 	}
 
+	log.Info().Str("name", res.Name).Str("type", string(serviceType)).Msg("Service deployed")
+
 	for _, servicePort := range res.Spec.Ports {
 		s.portsBound = append(s.portsBound, servicePort.Port)
 		s.portNames = append(s.portNames, servicePort.Name)
@@ -103,6 +113,54 @@ func (s *Service) DeployService(params *ServiceParams) error {
 	return nil
 }
 
+func getServiceSpec(params *ServiceParams) *acorev1.ServiceSpecApplyConfiguration {
+	serviceType := params.effectiveServiceType()
+
+	svcSpec := acorev1.ServiceSpec().
+		WithSelector(map[string]string{"app": params.selector}).
+		WithPorts(getServicePorts(params.portBindings, params.portRanges, serviceType == v1.NodePortServiceType)...)
+
+	switch serviceType {
+	case v1.LoadBalancerServiceType:
+		svcSpec.WithType(corev1.ServiceTypeLoadBalancer).
+			WithExternalTrafficPolicy(corev1.ServiceExternalTrafficPolicyTypeLocal)
+	case v1.NodePortServiceType:
+		svcSpec.WithType(corev1.ServiceTypeNodePort)
+	case v1.HeadlessServiceType:
+		svcSpec.WithType(corev1.ServiceTypeClusterIP).
+			WithClusterIP(corev1.ClusterIPNone)
+	default:
+		svcSpec.WithType(corev1.ServiceTypeClusterIP)
+	}
+
+	return svcSpec
+}
+
+// replaceOnHeadlessChange deletes the existing service if it has to become headless or stop being one,
+// the cluster IP of a service is immutable, so it could not be applied
+func (s *Service) replaceOnHeadlessChange(client typedcorev1.ServiceInterface, name string, headless bool) error {
+	existing, err := client.Get(s.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if (existing.Spec.ClusterIP == corev1.ClusterIPNone) == headless {
+		return nil
+	}
+
+	log.Info().Str("name", name).Bool("headless", headless).Msg("Replacing service")
+	err = client.Delete(s.ctx, name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) GetServices(namespace string) (*corev1.ServiceList, error) {
 	client, err := s.getServiceClient(namespace)
 	if err != nil {
@@ -121,15 +179,22 @@ func (s *Service) deleteServices(namespace, name string) error {
 	return client.Delete(s.ctx, name, metav1.DeleteOptions{})
 }
 
-func getServicePorts(portBindings []builder.PortBinding, portRanges []builder.PortRangeBinding) []*acorev1.ServicePortApplyConfiguration {
+// getServicePorts maps the bindings to service ports, with nodePorts the bound ports are used as node ports
+func getServicePorts(portBindings []builder.PortBinding, portRanges []builder.PortRangeBinding,
+	nodePorts bool,
+) []*acorev1.ServicePortApplyConfiguration {
 	ports := []*acorev1.ServicePortApplyConfiguration{}
 
 	for i := range portBindings {
-		ports = append(ports,
-			acorev1.ServicePort().
-				WithName(fmt.Sprintf("tcp-%v", portBindings[i].ExposedPort)).
-				WithProtocol(corev1.ProtocolTCP).
-				WithPort(int32(portBindings[i].ExposedPort)))
+		port := acorev1.ServicePort().
+			WithName(fmt.Sprintf("tcp-%v", portBindings[i].ExposedPort)).
+			WithProtocol(corev1.ProtocolTCP).
+			WithPort(int32(portBindings[i].ExposedPort))
+		if nodePorts && pointer.GetUint16(portBindings[i].PortBinding) != 0 {
+			port.WithNodePort(int32(*portBindings[i].PortBinding))
+		}
+
+		ports = append(ports, port)
 	}
 
 	for i := range portRanges {
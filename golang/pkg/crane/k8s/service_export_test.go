@@ -0,0 +1,19 @@
+package k8s
+
+import (
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+
+	acorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+func GetServiceSpecForTest(serviceType v1.ServiceType, useLB bool,
+	portBindings []builder.PortBinding,
+) *acorev1.ServiceSpecApplyConfiguration {
+	return getServiceSpec(&ServiceParams{
+		selector:     "api",
+		serviceType:  serviceType,
+		useLB:        useLB,
+		portBindings: portBindings,
+	})
+}
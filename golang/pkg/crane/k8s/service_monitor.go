@@ -9,6 +9,7 @@
 
 	versionedv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 
+	apismonitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	smv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -72,6 +73,7 @@ func (sm *ServiceMonitor) Deploy(namespace, serviceName string, metricParams v1.
 	}
 
 	smApplyConfig := smv1.ServiceMonitor(serviceName, namespace).
+		WithLabels(ownerLabels(sm.appConfig, namespace, serviceName)).
 		WithSpec(smv1.ServiceMonitorSpec().
 			WithEndpoints(endpoint).
 			WithSelector(
@@ -82,10 +84,10 @@ func (sm *ServiceMonitor) Deploy(namespace, serviceName string, metricParams v1.
 				},
 			))
 
-	_, err = sm.ClientSet.MonitoringV1().ServiceMonitors(namespace).Apply(sm.Ctx, smApplyConfig, metav1.ApplyOptions{
-		FieldManager: sm.appConfig.FieldManagerName,
-		Force:        sm.appConfig.ForceOnConflicts,
-	})
+	_, err = applyAdopting(sm.appConfig, "servicemonitor", serviceName,
+		func(opts metav1.ApplyOptions) (*apismonitoringv1.ServiceMonitor, error) {
+			return sm.ClientSet.MonitoringV1().ServiceMonitors(namespace).Apply(sm.Ctx, smApplyConfig, opts)
+		})
 
 	return err
 }
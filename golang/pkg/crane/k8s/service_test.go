@@ -0,0 +1,63 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func TestServiceSpecTypes(t *testing.T) {
+	ports := []builder.PortBinding{{ExposedPort: 8080, PortBinding: pointer.ToUint16(30080)}}
+
+	testCases := []struct {
+		desc        string
+		serviceType v1.ServiceType
+		useLB       bool
+		expected    corev1.ServiceType
+		clusterIP   *string
+	}{
+		{desc: "default is cluster IP", expected: corev1.ServiceTypeClusterIP},
+		{desc: "default with load balancer flag", useLB: true, expected: corev1.ServiceTypeLoadBalancer},
+		{desc: "explicit type wins over the flag", serviceType: v1.ClusterIPServiceType, useLB: true, expected: corev1.ServiceTypeClusterIP},
+		{
+			desc:        "headless",
+			serviceType: v1.HeadlessServiceType,
+			expected:    corev1.ServiceTypeClusterIP,
+			clusterIP:   pointer.ToString(corev1.ClusterIPNone),
+		},
+		{desc: "node port", serviceType: v1.NodePortServiceType, expected: corev1.ServiceTypeNodePort},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			spec := k8s.GetServiceSpecForTest(tC.serviceType, tC.useLB, ports)
+
+			assert.Equal(t, tC.expected, *spec.Type)
+			assert.Equal(t, tC.clusterIP, spec.ClusterIP)
+		})
+	}
+}
+
+func TestServiceSpecNodePorts(t *testing.T) {
+	ports := []builder.PortBinding{
+		{ExposedPort: 8080, PortBinding: pointer.ToUint16(30080)},
+		{ExposedPort: 9090},
+	}
+
+	spec := k8s.GetServiceSpecForTest(v1.NodePortServiceType, false, ports)
+	assert.Len(t, spec.Ports, 2)
+	assert.Equal(t, int32(30080), *spec.Ports[0].NodePort)
+	assert.Nil(t, spec.Ports[1].NodePort, "the node port is allocated by the cluster")
+
+	spec = k8s.GetServiceSpecForTest(v1.ClusterIPServiceType, false, ports)
+	assert.Nil(t, spec.Ports[0].NodePort)
+}
@@ -0,0 +1,141 @@
+package k8s
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+)
+
+// the default node port range of the API server
+const (
+	minNodePort = 30000
+	maxNodePort = 32767
+)
+
+// validateDeployRequest checks the kubernetes specific fields on top of the common rules
+func validateDeployRequest(deployImageRequest *v1.DeployImageRequest) error {
+	return v1.ValidateDeployImageRequest(deployImageRequest, validateNodePorts, validateWorkloadIdentity, validateProjectedTokens,
+		validateProbes)
+}
+
+func validateNodePorts(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	if deployImageRequest.ContainerConfig.ServiceType != v1.NodePortServiceType {
+		return
+	}
+
+	for i, port := range deployImageRequest.ContainerConfig.Ports {
+		if port.PortBinding == nil || (*port.PortBinding >= minNodePort && *port.PortBinding <= maxNodePort) {
+			continue
+		}
+
+		result.Add(fmt.Sprintf("ContainerConfig.port[%d].portBinding", i),
+			fmt.Sprintf("node port %d is out of the range %d-%d", *port.PortBinding, minNodePort, maxNodePort),
+			"use a port from the range or leave it empty to let the cluster allocate one")
+	}
+}
+
+func validateWorkloadIdentity(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	identity := deployImageRequest.ContainerConfig.WorkloadIdentity
+	if identity == nil {
+		return
+	}
+
+	if identity.ServiceAccount != "" {
+		if errs := validation.IsDNS1123Subdomain(identity.ServiceAccount); len(errs) > 0 {
+			result.Add("ContainerConfig.workloadIdentity.serviceAccount", strings.Join(errs, ", "), "")
+		}
+	}
+
+	keys := maps.Keys(identity.Annotations)
+	sort.Strings(keys)
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			result.Add(fmt.Sprintf("ContainerConfig.workloadIdentity.annotations[%q]", key), strings.Join(errs, ", "),
+				"use the key the provider documents, eg. eks.amazonaws.com/role-arn")
+		}
+	}
+}
+
+func validateProjectedTokens(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	if _, err := newProjectedTokens(deployImageRequest.ContainerConfig.ProjectedTokens); err != nil {
+		result.Add("ContainerConfig.projectedTokens", err.Error(), "")
+	}
+}
+
+// validateProbes checks the rules of the API server, so a deployment is not rejected half applied
+func validateProbes(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	healthCheck := &deployImageRequest.ContainerConfig.HealthCheckConfig
+	probes := []struct {
+		probe *v1.Probe
+		name  string
+		// only the readiness probe may need more than one success
+		anySuccess bool
+	}{
+		{probe: healthCheck.LivenessProbe, name: "livenessProbe"},
+		{probe: healthCheck.ReadinessProbe, name: "readinessProbe", anySuccess: true},
+		{probe: healthCheck.StartupProbe, name: "startupProbe"},
+	}
+
+	for _, it := range probes {
+		if it.probe == nil {
+			continue
+		}
+
+		fieldPath := "ContainerConfig.healthCheck." + it.name
+		validateProbe(it.probe, healthCheck.Port, fieldPath, result)
+
+		if !it.anySuccess && it.probe.SuccessThreshold != nil && *it.probe.SuccessThreshold != 1 {
+			result.Add(fieldPath+".successThreshold", "must be 1", "only the readiness probe may need more successes")
+		}
+	}
+}
+
+func validateProbe(probe *v1.Probe, defaultPort uint16, fieldPath string, result *v1.ValidationError) {
+	switch probe.Type {
+	case "", v1.ProbeHTTP:
+		if probe.Path == "" {
+			result.Add(fieldPath+".path", "is required", "")
+		}
+		if scheme := strings.ToUpper(probe.Scheme); scheme != "" && scheme != "HTTP" && scheme != "HTTPS" {
+			result.Add(fieldPath+".scheme", fmt.Sprintf("%q is not a valid scheme", probe.Scheme), "use HTTP or HTTPS")
+		}
+	case v1.ProbeTCP:
+	case v1.ProbeExec:
+		if len(probe.Command) == 0 {
+			result.Add(fieldPath+".command", "is required", "")
+		}
+	default:
+		result.Add(fieldPath+".type", fmt.Sprintf("%q is not a probe type", probe.Type),
+			fmt.Sprintf("use %s, %s or %s", v1.ProbeHTTP, v1.ProbeTCP, v1.ProbeExec))
+	}
+
+	if probe.Type != v1.ProbeExec && probe.Port == 0 && defaultPort == 0 {
+		result.Add(fieldPath+".port", "is required", "set the port of the probe or the one of the health check")
+	}
+	if probe.Port < 0 || probe.Port > math.MaxUint16 {
+		result.Add(fieldPath+".port", fmt.Sprintf("%d is out of the range 1-%d", probe.Port, math.MaxUint16), "")
+	}
+
+	minimums := []struct {
+		value *int32
+		name  string
+		min   int32
+	}{
+		{value: probe.InitialDelaySeconds, name: "initialDelaySeconds", min: 0},
+		{value: probe.PeriodSeconds, name: "periodSeconds", min: 1},
+		{value: probe.TimeoutSeconds, name: "timeoutSeconds", min: 1},
+		{value: probe.SuccessThreshold, name: "successThreshold", min: 1},
+		{value: probe.FailureThreshold, name: "failureThreshold", min: 1},
+	}
+	for _, it := range minimums {
+		if it.value != nil && *it.value < it.min {
+			result.Add(fmt.Sprintf("%s.%s", fieldPath, it.name), fmt.Sprintf("must be at least %d", it.min), "")
+		}
+	}
+}
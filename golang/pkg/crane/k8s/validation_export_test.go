@@ -0,0 +1,3 @@
+package k8s
+
+var ValidateDeployRequest = validateDeployRequest
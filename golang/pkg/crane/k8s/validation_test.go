@@ -0,0 +1,103 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func TestValidateDeployRequest(t *testing.T) {
+	req := &v1.DeployImageRequest{
+		RequestID:      "request-id",
+		ImageName:      "library/nginx",
+		Tag:            "1.25",
+		InstanceConfig: v1.InstanceConfig{ContainerPreName: "prefix"},
+		ContainerConfig: v1.ContainerConfig{
+			Container:   "web",
+			ServiceType: v1.NodePortServiceType,
+			Ports: []builder.PortBinding{
+				{ExposedPort: 80, PortBinding: pointer.ToUint16(30080)},
+				{ExposedPort: 443},
+			},
+			WorkloadIdentity: &v1.WorkloadIdentity{
+				Annotations: map[string]string{"iam.gke.io/gcp-service-account": "web@project.iam.gserviceaccount.com"},
+			},
+		},
+	}
+	assert.NoError(t, k8s.ValidateDeployRequest(req))
+
+	req.ContainerConfig.Ports[1].PortBinding = pointer.ToUint16(8443)
+	req.ContainerConfig.WorkloadIdentity.ServiceAccount = "Web_Account"
+	req.ContainerConfig.WorkloadIdentity.Annotations["not a key"] = "value"
+	req.ContainerConfig.ProjectedTokens = []v1.ProjectedToken{{Path: "/var/run/secrets/token"}}
+
+	validationErr := &v1.ValidationError{}
+	assert.ErrorAs(t, k8s.ValidateDeployRequest(req), &validationErr)
+
+	paths := []string{}
+	for _, field := range validationErr.Fields {
+		paths = append(paths, field.Path)
+	}
+	assert.Equal(t, []string{
+		"ContainerConfig.projectedTokens[0].audience",
+		"ContainerConfig.port[1].portBinding",
+		"ContainerConfig.workloadIdentity.serviceAccount",
+		`ContainerConfig.workloadIdentity.annotations["not a key"]`,
+		"ContainerConfig.projectedTokens",
+	}, paths)
+}
+
+func TestValidateDeployRequestProbes(t *testing.T) {
+	req := &v1.DeployImageRequest{
+		RequestID:      "request-id",
+		ImageName:      "library/nginx",
+		Tag:            "1.25",
+		InstanceConfig: v1.InstanceConfig{ContainerPreName: "prefix"},
+		ContainerConfig: v1.ContainerConfig{
+			Container: "web",
+			HealthCheckConfig: v1.HealthCheckConfig{
+				LivenessProbe:  &v1.Probe{Type: v1.ProbeTCP, Port: 80},
+				ReadinessProbe: &v1.Probe{Path: "/ready", SuccessThreshold: pointer.ToInt32(2)},
+				StartupProbe:   &v1.Probe{Type: v1.ProbeExec, Command: []string{"cat", "/tmp/started"}},
+			},
+		},
+	}
+
+	validationErr := &v1.ValidationError{}
+	assert.ErrorAs(t, k8s.ValidateDeployRequest(req), &validationErr)
+	assert.Len(t, validationErr.Fields, 1)
+	assert.Equal(t, "ContainerConfig.healthCheck.readinessProbe.port", validationErr.Fields[0].Path)
+
+	req.ContainerConfig.HealthCheckConfig.Port = 8080
+	assert.NoError(t, k8s.ValidateDeployRequest(req))
+
+	req.ContainerConfig.HealthCheckConfig.LivenessProbe = &v1.Probe{Type: "grpc"}
+	req.ContainerConfig.HealthCheckConfig.ReadinessProbe = &v1.Probe{Scheme: "ftp", PeriodSeconds: pointer.ToInt32(0)}
+	req.ContainerConfig.HealthCheckConfig.StartupProbe = &v1.Probe{Type: v1.ProbeExec, SuccessThreshold: pointer.ToInt32(2)}
+	req.ContainerConfig.HealthCheckConfig.LivenessProbe.Port = 70000
+
+	validationErr = &v1.ValidationError{}
+	assert.ErrorAs(t, k8s.ValidateDeployRequest(req), &validationErr)
+
+	paths := []string{}
+	for _, field := range validationErr.Fields {
+		paths = append(paths, field.Path)
+	}
+	assert.Equal(t, []string{
+		"ContainerConfig.healthCheck.livenessProbe.type",
+		"ContainerConfig.healthCheck.livenessProbe.port",
+		"ContainerConfig.healthCheck.readinessProbe.path",
+		"ContainerConfig.healthCheck.readinessProbe.scheme",
+		"ContainerConfig.healthCheck.readinessProbe.periodSeconds",
+		"ContainerConfig.healthCheck.startupProbe.command",
+		"ContainerConfig.healthCheck.startupProbe.successThreshold",
+	}, paths)
+}
@@ -0,0 +1,178 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+	apicorev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+)
+
+// minTokenExpirationSeconds is the shortest expiration the API server accepts for projected tokens
+const minTokenExpirationSeconds = 600
+
+var (
+	ErrProjectedTokenPathNotAbsolute = errors.New("projected token path must be absolute")
+	ErrProjectedTokenAudienceMissing = errors.New("projected token audience is required")
+	ErrProjectedTokenExpiration      = fmt.Errorf("projected token expiration must be at least %d seconds", minTokenExpirationSeconds)
+)
+
+type serviceAccount struct {
+	ctx       context.Context
+	appConfig *config.Configuration
+}
+
+func newServiceAccount(ctx context.Context, cfg *config.Configuration) *serviceAccount {
+	return &serviceAccount{ctx: ctx, appConfig: cfg}
+}
+
+// deployWorkloadIdentity returns the service account of the pods, the one crane created for an earlier
+// deployment is removed if the container uses an existing one or no identity at all
+func (sa *serviceAccount) deployWorkloadIdentity(namespace, containerName string, identity *v1.WorkloadIdentity) (string, error) {
+	if identity == nil || identity.ServiceAccount != "" {
+		if err := sa.deleteWorkloadIdentity(namespace, containerName); err != nil {
+			return "", err
+		}
+
+		if identity == nil {
+			return "", nil
+		}
+
+		return identity.ServiceAccount, nil
+	}
+
+	clientset, err := NewClient(sa.appConfig).GetClientSet()
+	if err != nil {
+		return "", err
+	}
+
+	client := clientset.CoreV1().ServiceAccounts(namespace)
+	_, err = applyAdopting(sa.appConfig, "serviceaccount", containerName,
+		func(opts metav1.ApplyOptions) (*apicorev1.ServiceAccount, error) {
+			return client.Apply(sa.ctx,
+				corev1.ServiceAccount(containerName, namespace).
+					WithLabels(ownerLabels(sa.appConfig, namespace, containerName)).
+					WithAnnotations(identity.Annotations),
+				opts,
+			)
+		})
+	if err != nil {
+		return "", fmt.Errorf("could not apply service account %s: %w", containerName, err)
+	}
+
+	log.Info().Str("namespace", namespace).Str("name", containerName).Msg("Workload identity updated")
+
+	return containerName, nil
+}
+
+// deleteWorkloadIdentity removes the service account only if crane created it for the container
+func (sa *serviceAccount) deleteWorkloadIdentity(namespace, containerName string) error {
+	clientset, err := NewClient(sa.appConfig).GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	client := clientset.CoreV1().ServiceAccounts(namespace)
+	account, err := client.Get(sa.ctx, containerName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if account.Labels[ManagedByLabel] != sa.appConfig.FieldManagerName || account.Labels[ContainerLabel] != containerName {
+		return nil
+	}
+
+	err = client.Delete(sa.ctx, containerName, metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	log.Info().Str("namespace", namespace).Str("name", containerName).Msg("Workload identity removed")
+
+	return nil
+}
+
+// projectedTokens are grouped by their directories, every directory is a projected volume mounted
+// as a whole, because the files mounted using subPath do not get the rotated tokens
+type projectedTokens struct {
+	sources map[string][]*corev1.VolumeProjectionApplyConfiguration
+	dirs    []string
+}
+
+func newProjectedTokens(tokens []v1.ProjectedToken) (*projectedTokens, error) {
+	result := &projectedTokens{sources: map[string][]*corev1.VolumeProjectionApplyConfiguration{}}
+
+	paths := map[string]bool{}
+	for _, token := range tokens {
+		tokenPath := path.Clean(token.Path)
+		if !path.IsAbs(token.Path) || tokenPath == "/" {
+			return nil, fmt.Errorf("%s: %w", token.Path, ErrProjectedTokenPathNotAbsolute)
+		}
+		if token.Audience == "" {
+			return nil, fmt.Errorf("%s: %w", token.Path, ErrProjectedTokenAudienceMissing)
+		}
+		if token.ExpirationSeconds != nil && *token.ExpirationSeconds < minTokenExpirationSeconds {
+			return nil, fmt.Errorf("%s: %w", token.Path, ErrProjectedTokenExpiration)
+		}
+		if paths[tokenPath] {
+			return nil, fmt.Errorf("projected token %s is defined more than once", tokenPath)
+		}
+		paths[tokenPath] = true
+
+		source := corev1.ServiceAccountTokenProjection().
+			WithAudience(token.Audience).
+			WithPath(path.Base(tokenPath))
+		if token.ExpirationSeconds != nil {
+			source.WithExpirationSeconds(*token.ExpirationSeconds)
+		}
+
+		dir := path.Dir(tokenPath)
+		if _, ok := result.sources[dir]; !ok {
+			result.dirs = append(result.dirs, dir)
+		}
+		result.sources[dir] = append(result.sources[dir], corev1.VolumeProjection().WithServiceAccountToken(source))
+	}
+
+	sort.Strings(result.dirs)
+
+	return result, nil
+}
+
+func projectedTokenVolumeName(index int) string {
+	return fmt.Sprintf("crane-token-%d", index)
+}
+
+func (t *projectedTokens) volumes() []*corev1.VolumeApplyConfiguration {
+	volumes := []*corev1.VolumeApplyConfiguration{}
+	for i, dir := range t.dirs {
+		volumes = append(volumes, corev1.Volume().
+			WithName(projectedTokenVolumeName(i)).
+			WithProjected(corev1.ProjectedVolumeSource().WithSources(t.sources[dir]...)))
+	}
+
+	return volumes
+}
+
+func (t *projectedTokens) volumeMounts() []*corev1.VolumeMountApplyConfiguration {
+	mounts := []*corev1.VolumeMountApplyConfiguration{}
+	for i, dir := range t.dirs {
+		mounts = append(mounts, corev1.VolumeMount().
+			WithName(projectedTokenVolumeName(i)).
+			WithMountPath(dir).
+			WithReadOnly(true))
+	}
+
+	return mounts
+}
@@ -0,0 +1,30 @@
+package k8s
+
+import (
+	"fmt"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+)
+
+// ProjectedTokenMounts renders the volumes of newProjectedTokens as name:dir:file@audience lines
+func ProjectedTokenMounts(tokens []v1.ProjectedToken) ([]string, error) {
+	result, err := newProjectedTokens(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := result.volumeMounts()
+	lines := []string{}
+	for i, volume := range result.volumes() {
+		for _, source := range volume.Projected.Sources {
+			token := source.ServiceAccountToken
+			line := fmt.Sprintf("%s:%s:%s@%s", *volume.Name, *mounts[i].MountPath, *token.Path, *token.Audience)
+			if token.ExpirationSeconds != nil {
+				line += fmt.Sprintf(":%d", *token.ExpirationSeconds)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,55 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func TestProjectedTokens(t *testing.T) {
+	mounts, err := k8s.ProjectedTokenMounts([]v1.ProjectedToken{
+		{Path: "/var/run/secrets/vault/token", Audience: "vault"},
+		{Path: "/var/run/secrets/aws/token", Audience: "sts.amazonaws.com", ExpirationSeconds: pointer.ToInt64(3600)},
+		{Path: "/var/run/secrets/vault/../vault/backup-token", Audience: "backup"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"crane-token-0:/var/run/secrets/aws:token@sts.amazonaws.com:3600",
+		"crane-token-1:/var/run/secrets/vault:token@vault",
+		"crane-token-1:/var/run/secrets/vault:backup-token@backup",
+	}, mounts)
+
+	mounts, err = k8s.ProjectedTokenMounts(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, mounts)
+}
+
+func TestProjectedTokensInvalid(t *testing.T) {
+	_, err := k8s.ProjectedTokenMounts([]v1.ProjectedToken{{Path: "token", Audience: "vault"}})
+	assert.ErrorIs(t, err, k8s.ErrProjectedTokenPathNotAbsolute)
+
+	_, err = k8s.ProjectedTokenMounts([]v1.ProjectedToken{{Path: "/", Audience: "vault"}})
+	assert.ErrorIs(t, err, k8s.ErrProjectedTokenPathNotAbsolute)
+
+	_, err = k8s.ProjectedTokenMounts([]v1.ProjectedToken{{Path: "/var/run/secrets/token"}})
+	assert.ErrorIs(t, err, k8s.ErrProjectedTokenAudienceMissing)
+
+	_, err = k8s.ProjectedTokenMounts([]v1.ProjectedToken{
+		{Path: "/var/run/secrets/token", Audience: "vault", ExpirationSeconds: pointer.ToInt64(60)},
+	})
+	assert.ErrorIs(t, err, k8s.ErrProjectedTokenExpiration)
+
+	_, err = k8s.ProjectedTokenMounts([]v1.ProjectedToken{
+		{Path: "/var/run/secrets/token", Audience: "vault"},
+		{Path: "/var/run/secrets//token", Audience: "backup"},
+	})
+	assert.ErrorContains(t, err, "defined more than once")
+}
@@ -0,0 +1,324 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/rs/zerolog/log"
+	kappsv1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+const (
+	rolloutPollInterval = 2 * time.Second
+	hostnameTopologyKey = "kubernetes.io/hostname"
+)
+
+var (
+	ErrUnspecifiedWorkloadOperation = errors.New("unspecified workload operation")
+	ErrUnknownWorkloadOperation     = errors.New("unknown workload operation")
+	ErrPodNameRequired              = errors.New("pod name is required")
+	ErrReplicaCountRequired         = errors.New("replica count is required")
+	ErrNegativeReplicaCount         = errors.New("replica count must not be negative")
+	ErrPodNotInDeployment           = errors.New("pod does not belong to the deployment")
+	ErrReplicasShareVolume          = errors.New("the replicas can not mount the same volume")
+)
+
+func validateWorkloadOperation(req *agent.WorkloadOperationRequest) error {
+	switch req.Operation {
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_ROLLOUT_RESTART:
+		return nil
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_DELETE_POD:
+		if req.GetPod() == "" {
+			return ErrPodNameRequired
+		}
+		return nil
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_SCALE:
+		if req.Replicas == nil {
+			return ErrReplicaCountRequired
+		}
+		if req.GetReplicas() < 0 {
+			return ErrNegativeReplicaCount
+		}
+		return nil
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_UNSPECIFIED:
+		return ErrUnspecifiedWorkloadOperation
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownWorkloadOperation, req.Operation)
+	}
+}
+
+// rolloutStatus maps the status of the deployment to a progress message, the rollout is done
+// when every replica is updated and available, and no old replica is left
+func rolloutStatus(deployment *kappsv1.Deployment) (*agent.WorkloadOperationMessage, bool) {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	status := &deployment.Status
+	message := &agent.WorkloadOperationMessage{
+		Status:          common.DeploymentStatus_IN_PROGRESS,
+		Replicas:        pointer.ToInt32(desired),
+		UpdatedReplicas: pointer.ToInt32(status.UpdatedReplicas),
+		ReadyReplicas:   pointer.ToInt32(status.AvailableReplicas),
+	}
+
+	if status.ObservedGeneration < deployment.Generation {
+		message.Message = "Waiting for the rollout to start."
+		return message, false
+	}
+
+	message.Message = fmt.Sprintf("%d of %d replicas updated, %d available.", status.UpdatedReplicas, desired, status.AvailableReplicas)
+
+	return message, status.UpdatedReplicas == desired && status.Replicas == desired && status.AvailableReplicas == desired
+}
+
+// scaleReplicas only patches the replica count, unlike Scale, the running pods are not restarted
+func (d *Deployment) scaleReplicas(namespace, name string, replicas int32) error {
+	marshaled, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = getDeploymentsClient(namespace, d.appConfig).Patch(d.ctx, name, types.MergePatchType, marshaled,
+		metaV1.PatchOptions{})
+
+	return err
+}
+
+// pinnedNode is the node every pod of the template is scheduled to, or empty if they could be scheduled anywhere
+func pinnedNode(spec *coreV1.PodSpec) string {
+	if spec.NodeName != "" {
+		return spec.NodeName
+	}
+	if node := spec.NodeSelector[hostnameTopologyKey]; node != "" {
+		return node
+	}
+
+	if spec.Affinity == nil || spec.Affinity.NodeAffinity == nil ||
+		spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+
+	// the terms are ORed, so each of them has to select the same single node
+	node := ""
+	for _, term := range spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		termNode := ""
+		for _, it := range term.MatchExpressions {
+			if it.Key == hostnameTopologyKey && it.Operator == coreV1.NodeSelectorOpIn && len(it.Values) == 1 {
+				termNode = it.Values[0]
+			}
+		}
+		if termNode == "" || (node != "" && node != termNode) {
+			return ""
+		}
+		node = termNode
+	}
+
+	return node
+}
+
+func hasAccessMode(claim *coreV1.PersistentVolumeClaim, mode coreV1.PersistentVolumeAccessMode) bool {
+	for _, it := range claim.Spec.AccessModes {
+		if it == mode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unshareableClaims are the claims of the template more replicas can not mount, a ReadWriteOncePod claim is mounted
+// by one pod, a ReadWriteOnce one by the pods of one node, the pods scheduled to any other node stay Pending
+func unshareableClaims(spec *coreV1.PodSpec, claims map[string]*coreV1.PersistentVolumeClaim) []string {
+	pinned := pinnedNode(spec) != ""
+
+	names := []string{}
+	for i := range spec.Volumes {
+		source := spec.Volumes[i].PersistentVolumeClaim
+		if source == nil {
+			continue
+		}
+
+		claim, ok := claims[source.ClaimName]
+		if !ok || hasAccessMode(claim, coreV1.ReadWriteMany) || (source.ReadOnly && hasAccessMode(claim, coreV1.ReadOnlyMany)) {
+			continue
+		}
+
+		if hasAccessMode(claim, coreV1.ReadWriteOncePod) || !pinned {
+			names = append(names, source.ClaimName)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// checkReplicaVolumes is the dry run of scaling up, the replicas of a deployment mount the same claims,
+// per replica claims would need a stateful set
+func (d *Deployment) checkReplicaVolumes(namespace, name string, replicas int32) error {
+	if replicas <= 1 {
+		return nil
+	}
+
+	deployment, err := getDeploymentsClient(namespace, d.appConfig).Get(d.ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(d.appConfig).GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	list, err := client.CoreV1().PersistentVolumeClaims(namespace).List(d.ctx, metaV1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	claims := map[string]*coreV1.PersistentVolumeClaim{}
+	for i := range list.Items {
+		claims[list.Items[i].Name] = &list.Items[i]
+	}
+
+	if names := unshareableClaims(&deployment.Spec.Template.Spec, claims); len(names) > 0 {
+		return fmt.Errorf("%w: %s of %s can not be mounted by %d replicas on more nodes, use the %s volume type "+
+			"with a storage class supporting ReadWriteMany, or keep a single replica",
+			ErrReplicasShareVolume, strings.Join(names, ", "), name, replicas, v1.ReadWriteManyVolumeType)
+	}
+
+	return nil
+}
+
+// deletePod deletes a pod of the deployment and waits until it is gone, the replica set replaces it
+func (d *Deployment) deletePod(namespace, name, podName string, force bool, progress grpc.WorkloadProgressFunc) error {
+	client, err := NewClient(d.appConfig).GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	pods := client.CoreV1().Pods(namespace)
+	pod, err := pods.Get(d.ctx, podName, metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if pod.Labels["app"] != name {
+		return fmt.Errorf("%s: %w", podName, ErrPodNotInDeployment)
+	}
+
+	options := metaV1.DeleteOptions{Preconditions: metaV1.NewUIDPreconditions(string(pod.UID))}
+	if force {
+		options.GracePeriodSeconds = pointer.ToInt64(0)
+	}
+
+	if err = pods.Delete(d.ctx, podName, options); err != nil {
+		return err
+	}
+
+	progress(&agent.WorkloadOperationMessage{
+		Status:  common.DeploymentStatus_IN_PROGRESS,
+		Message: fmt.Sprintf("Pod %s deleted, waiting for it to terminate.", podName),
+	})
+
+	err = wait.PollUntilContextTimeout(d.ctx, rolloutPollInterval, d.appConfig.DefaultKubeTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			current, getErr := pods.Get(ctx, podName, metaV1.GetOptions{})
+			if kerrors.IsNotFound(getErr) {
+				return true, nil
+			}
+			if getErr != nil {
+				return false, getErr
+			}
+
+			return current.UID != pod.UID, nil
+		})
+	if err != nil {
+		return fmt.Errorf("pod %s did not terminate in %s: %w", podName, d.appConfig.DefaultKubeTimeout, err)
+	}
+
+	return nil
+}
+
+// waitForRollout reports the progress of the deployment whenever it changes until the rollout is done
+func (d *Deployment) waitForRollout(namespace, name string, progress grpc.WorkloadProgressFunc) error {
+	client := getDeploymentsClient(namespace, d.appConfig)
+
+	lastMessage := ""
+	err := wait.PollUntilContextTimeout(d.ctx, rolloutPollInterval, d.appConfig.DefaultKubeTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			deployment, err := client.Get(ctx, name, metaV1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+
+			message, done := rolloutStatus(deployment)
+			if message.Message != lastMessage {
+				lastMessage = message.Message
+				progress(message)
+			}
+
+			return done, nil
+		})
+	if err != nil {
+		return fmt.Errorf("rollout of %s did not finish in %s: %w", name, d.appConfig.DefaultKubeTimeout, err)
+	}
+
+	return nil
+}
+
+// WorkloadOperation executes the operation on the deployment of the container, then waits for the rollout
+func WorkloadOperation(ctx context.Context, req *agent.WorkloadOperationRequest, progress grpc.WorkloadProgressFunc) error {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	namespace := req.Container.Prefix
+	name := req.Container.Name
+
+	if err := validateWorkloadOperation(req); err != nil {
+		return err
+	}
+
+	deployment := NewDeployment(ctx, cfg)
+
+	var err error
+	switch req.Operation {
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_ROLLOUT_RESTART:
+		err = deployment.Restart(namespace, name)
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_DELETE_POD:
+		err = deployment.deletePod(namespace, name, req.GetPod(), req.GetForce(), progress)
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_SCALE:
+		err = deployment.checkReplicaVolumes(namespace, name, req.GetReplicas())
+		if err == nil {
+			err = deployment.scaleReplicas(namespace, name, req.GetReplicas())
+		}
+	case agent.WorkloadOperation_WORKLOAD_OPERATION_UNSPECIFIED:
+		err = ErrUnspecifiedWorkloadOperation
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("namespace", namespace).Str("name", name).Str("operation", req.Operation.String()).Msg("Workload operation applied")
+
+	return deployment.waitForRollout(namespace, name, progress)
+}
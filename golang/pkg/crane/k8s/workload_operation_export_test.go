@@ -0,0 +1,8 @@
+package k8s
+
+var (
+	ValidateWorkloadOperation = validateWorkloadOperation
+	RolloutStatus             = rolloutStatus
+	PinnedNode                = pinnedNode
+	UnshareableClaims         = unshareableClaims
+)
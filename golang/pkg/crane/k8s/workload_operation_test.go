@@ -0,0 +1,127 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestValidateWorkloadOperation(t *testing.T) {
+	restart := &agent.WorkloadOperationRequest{Operation: agent.WorkloadOperation_WORKLOAD_OPERATION_ROLLOUT_RESTART}
+	assert.NoError(t, k8s.ValidateWorkloadOperation(restart))
+
+	deletePod := &agent.WorkloadOperationRequest{Operation: agent.WorkloadOperation_WORKLOAD_OPERATION_DELETE_POD}
+	assert.ErrorIs(t, k8s.ValidateWorkloadOperation(deletePod), k8s.ErrPodNameRequired)
+	deletePod.Pod = pointer.ToString("api-6d4cf56db6-x7kqz")
+	assert.NoError(t, k8s.ValidateWorkloadOperation(deletePod))
+
+	scale := &agent.WorkloadOperationRequest{Operation: agent.WorkloadOperation_WORKLOAD_OPERATION_SCALE}
+	assert.ErrorIs(t, k8s.ValidateWorkloadOperation(scale), k8s.ErrReplicaCountRequired)
+	scale.Replicas = pointer.ToInt32(-1)
+	assert.ErrorIs(t, k8s.ValidateWorkloadOperation(scale), k8s.ErrNegativeReplicaCount)
+	scale.Replicas = pointer.ToInt32(0)
+	assert.NoError(t, k8s.ValidateWorkloadOperation(scale))
+
+	assert.ErrorIs(t, k8s.ValidateWorkloadOperation(&agent.WorkloadOperationRequest{}), k8s.ErrUnspecifiedWorkloadOperation)
+	assert.ErrorIs(t, k8s.ValidateWorkloadOperation(&agent.WorkloadOperationRequest{Operation: 42}), k8s.ErrUnknownWorkloadOperation)
+}
+
+func TestRolloutStatus(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: pointer.ToInt32(3)},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+	}
+
+	message, done := k8s.RolloutStatus(deployment)
+	assert.False(t, done)
+	assert.Equal(t, "Waiting for the rollout to start.", message.Message)
+
+	deployment.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 2, AvailableReplicas: 3}
+	message, done = k8s.RolloutStatus(deployment)
+	assert.False(t, done)
+	assert.Equal(t, "2 of 3 replicas updated, 3 available.", message.Message)
+	assert.Equal(t, int32(3), message.GetReplicas())
+	assert.Equal(t, int32(2), message.GetUpdatedReplicas())
+
+	// the old replica is still terminating
+	deployment.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 3, AvailableReplicas: 3}
+	_, done = k8s.RolloutStatus(deployment)
+	assert.False(t, done)
+
+	deployment.Status.Replicas = 3
+	_, done = k8s.RolloutStatus(deployment)
+	assert.True(t, done)
+
+	deployment.Spec.Replicas = nil
+	deployment.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	_, done = k8s.RolloutStatus(deployment)
+	assert.True(t, done)
+}
+
+func TestPinnedNode(t *testing.T) {
+	assert.Empty(t, k8s.PinnedNode(&corev1.PodSpec{}))
+	assert.Equal(t, "node-1", k8s.PinnedNode(&corev1.PodSpec{NodeName: "node-1"}))
+	assert.Equal(t, "node-1", k8s.PinnedNode(&corev1.PodSpec{NodeSelector: map[string]string{"kubernetes.io/hostname": "node-1"}}))
+	assert.Empty(t, k8s.PinnedNode(&corev1.PodSpec{NodeSelector: map[string]string{"topology.kubernetes.io/zone": "eu-west-1a"}}))
+
+	term := func(values ...string) corev1.NodeSelectorTerm {
+		return corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "kubernetes.io/hostname", Operator: corev1.NodeSelectorOpIn, Values: values},
+		}}
+	}
+	affinity := func(terms ...corev1.NodeSelectorTerm) *corev1.PodSpec {
+		return &corev1.PodSpec{Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: terms},
+		}}}
+	}
+	assert.Equal(t, "node-1", k8s.PinnedNode(affinity(term("node-1"))))
+	assert.Empty(t, k8s.PinnedNode(affinity(term("node-1", "node-2"))))
+	// the terms are alternatives
+	assert.Empty(t, k8s.PinnedNode(affinity(term("node-1"), term("node-2"))))
+}
+
+func TestUnshareableClaims(t *testing.T) {
+	claim := func(name string, modes ...corev1.PersistentVolumeAccessMode) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.PersistentVolumeClaimSpec{AccessModes: modes},
+		}
+	}
+	claims := map[string]*corev1.PersistentVolumeClaim{
+		"api-data":    claim("api-data", corev1.ReadWriteOnce),
+		"api-shared":  claim("api-shared", corev1.ReadWriteMany),
+		"api-assets":  claim("api-assets", corev1.ReadOnlyMany),
+		"api-session": claim("api-session", corev1.ReadWriteOncePod),
+	}
+	volume := func(claimName string, readOnly bool) corev1.Volume {
+		return corev1.Volume{Name: claimName, VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName, ReadOnly: readOnly},
+		}}
+	}
+
+	spec := &corev1.PodSpec{Volumes: []corev1.Volume{
+		volume("api-session", false),
+		volume("api-data", false),
+		volume("api-shared", false),
+		volume("api-assets", true),
+		{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}}
+	assert.Equal(t, []string{"api-data", "api-session"}, k8s.UnshareableClaims(spec, claims))
+
+	// the pods of one node share the ReadWriteOnce claim
+	spec.NodeName = "node-1"
+	assert.Equal(t, []string{"api-session"}, k8s.UnshareableClaims(spec, claims))
+
+	assert.Empty(t, k8s.UnshareableClaims(&corev1.PodSpec{Volumes: []corev1.Volume{volume("api-shared", false)}}, claims))
+}
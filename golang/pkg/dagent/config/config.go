@@ -1,24 +1,73 @@
 package config
 
 import (
+	"time"
+
+	"golang.org/x/time/rate"
+
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
 )
 
 // Dagent(docker)-specific configuration options
 type Configuration struct {
+	// BandwidthLimiter is shared by the transfers of the agent, it is set from BandwidthLimit on startup
+	BandwidthLimiter *rate.Limiter
+
 	WebhookToken       string `yaml:"webhookToken"         env:"WEBHOOK_TOKEN"          env-default:""`
 	TraefikAcmeMail    string `yaml:"traefikAcmeMail"        env:"TRAEFIK_ACME_MAIL"      env-default:""`
 	HostDockerSockPath string `yaml:"hostDockerSockPath"     env:"HOST_DOCKER_SOCK_PATH" env-default:"/var/run/docker.sock"`
 	InternalMountPath  string `yaml:"internalMountPath"      env:"INTERNAL_MOUNT_PATH"   env-default:"/srv/dagent"`
 	DataMountPath      string `yaml:"dataMountPath"          env:"DATA_MOUNT_PATH"       env-default:"/srv/dagent"`
 	TraefikLogLevel    string `yaml:"traefikLogLevel"      env:"TRAEFIK_LOG_LEVEL"      env-default:"INFO"`
+	// BandwidthLimit caps the image pulls and the volume imports in bytes per second, eg. 10MiB, unlimited if empty
+	BandwidthLimit string `yaml:"bandwidthLimit" env:"BANDWIDTH_LIMIT" env-default:""`
+	// CheckpointDir keeps the checkpoints of the containers on the host, so they can be copied to another node,
+	// the container runtime keeps them if empty
+	CheckpointDir string `yaml:"checkpointDir" env:"CHECKPOINT_DIR" env-default:""`
+	// NodeIP is the address of the node the containers can refer to as ${node.ip} in their environment and arguments
+	NodeIP string `yaml:"nodeIP" env:"NODE_IP" env-default:""`
+	// HostMetricsProc is the proc filesystem the host metrics are read from, the one of the host mounted into the container
+	HostMetricsProc string `yaml:"hostMetricsProc" env:"HOST_METRICS_PROC" env-default:"/proc"`
+	// HostMetricsDisks are the paths the usage of the filesystems is reported for
+	HostMetricsDisks []string `yaml:"hostMetricsDisks" env:"HOST_METRICS_DISKS" env-default:"/"`
 	config.CommonConfiguration
 	LogDefaultSkip uint64 `yaml:"logDefaultSkip"         env:"LOG_DEFAULT_SKIP"      env-default:"0"`
 	LogDefaultTake uint64 `yaml:"logDefaultTake"         env:"LOG_DEFAULT_TAKE"      env-default:"100"`
+
+	// StartupGracePeriod is the time a started container has to keep running for a successful deployment
+	StartupGracePeriod time.Duration `yaml:"startupGracePeriod" env:"STARTUP_GRACE_PERIOD" env-default:"3s"`
+	// ExitLogTailLines is the number of output lines attached to the state of a container exiting on deploy
+	ExitLogTailLines uint64 `yaml:"exitLogTailLines" env:"EXIT_LOG_TAIL_LINES" env-default:"50"`
+
+	// LoadGuardMaxLoad defers the deployments while the 1 minute load average per CPU is above it, 0 disables it
+	LoadGuardMaxLoad float64 `yaml:"loadGuardMaxLoad" env:"LOAD_GUARD_MAX_LOAD" env-default:"0"`
+	// LoadGuardMaxMemory defers the deployments while the used fraction of the memory is above it, 0 disables it
+	LoadGuardMaxMemory float64 `yaml:"loadGuardMaxMemory" env:"LOAD_GUARD_MAX_MEMORY" env-default:"0"`
+	// LoadGuardInterval is the time between the load checks of a deferred deployment, 15s if 0
+	LoadGuardInterval time.Duration `yaml:"loadGuardInterval" env:"LOAD_GUARD_INTERVAL" env-default:"15s"`
+	// LoadGuardTimeout is the longest time a deployment is deferred for, then it fails, 10m if 0
+	LoadGuardTimeout time.Duration `yaml:"loadGuardTimeout" env:"LOAD_GUARD_TIMEOUT" env-default:"10m"`
+
+	// TrafficSampleInterval is the period of the network traffic samples of the managed containers, 0 disables sampling
+	TrafficSampleInterval time.Duration `yaml:"trafficSampleInterval" env:"TRAFFIC_SAMPLE_INTERVAL" env-default:"1m"`
+	// TrafficHistoryDays is the number of days the daily traffic totals are kept for
+	TrafficHistoryDays uint64 `yaml:"trafficHistoryDays" env:"TRAFFIC_HISTORY_DAYS" env-default:"31"`
+
+	// HostMetricsInterval is the period of the host metrics streamed to the platform, 0 disables streaming
+	HostMetricsInterval time.Duration `yaml:"hostMetricsInterval" env:"HOST_METRICS_INTERVAL" env-default:"15s"`
+
 	TraefikPort    uint16 `yaml:"traefikPort"          env:"TRAEFIK_PORT"           env-default:"80"`
 	TraefikTLSPort uint16 `yaml:"traefikTLSPort"       env:"TRAEFIK_TLS_PORT"       env-default:"443"`
 	TraefikEnabled bool   `yaml:"traefikEnabled"         env:"TRAEFIK_ENABLED"        env-default:"false"`
 	TraefikTLS     bool   `yaml:"traefikTLS"           env:"TRAEFIK_TLS"            env-default:"false"`
+	// TraefikManaged runs traefik with the configuration of the platform, the other traefik settings are ignored
+	TraefikManaged bool `yaml:"traefikManaged" env:"TRAEFIK_MANAGED" env-default:"false"`
+	// CheckpointEnabled turns on the experimental checkpoint and restore of the containers
+	CheckpointEnabled bool `yaml:"checkpointEnabled" env:"CHECKPOINT_ENABLED" env-default:"false"`
+	// ServiceDiscoveryEnabled attaches the containers to the network of their prefix, so they resolve each other by service name
+	ServiceDiscoveryEnabled bool `yaml:"serviceDiscoveryEnabled" env:"SERVICE_DISCOVERY_ENABLED" env-default:"false"`
+	// HostMetricsEnabled streams the metrics of the host to the platform and serves them on the metrics endpoint of the health server
+	HostMetricsEnabled bool `yaml:"hostMetricsEnabled" env:"HOST_METRICS_ENABLED" env-default:"false"`
 }
 
 const filePermReadWriteOnlyByOwner = 0o600
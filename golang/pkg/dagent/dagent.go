@@ -6,7 +6,9 @@
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/cache"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/bandwidth"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/update"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
@@ -14,10 +16,25 @@
 )
 
 func Serve(cfg *config.Configuration) {
+	ServeContext(context.Background(), cfg)
+}
+
+// ServeContext runs the agent until the context is done
+func ServeContext(ctx context.Context, cfg *config.Configuration) {
 	utils.PreflightChecks()
 	log.Info().Msg("Starting dyrector.io DAgent service")
 
-	if cfg.TraefikEnabled {
+	limiter, err := bandwidth.ParseLimit(cfg.BandwidthLimit)
+	if err != nil {
+		log.Panic().Err(err).Msg("Failed to parse the bandwidth limit")
+	}
+	cfg.BandwidthLimiter = limiter
+
+	var ingress *utils.IngressManager
+	if cfg.TraefikManaged {
+		ingress = utils.NewIngressManager(cfg)
+		ingress.Restore(ctx)
+	} else if cfg.TraefikEnabled {
 		params := utils.TraefikDeployRequest{
 			LogLevel: cfg.TraefikLogLevel,
 			TLS:      cfg.TraefikTLS,
@@ -26,15 +43,20 @@ func Serve(cfg *config.Configuration) {
 			TLSPort:  cfg.TraefikTLSPort,
 		}
 
-		err := utils.ExecTraefik(context.Background(), params, cfg)
+		err := utils.ExecTraefik(ctx, params, cfg)
 		if err != nil {
 			// we wanted to start traefik, but something is not ok, thus panic!
 			log.Panic().Err(err).Msg("Failed to start Traefik")
 		}
 	}
 
-	grpcContext := grpc.WithGRPCConfig(context.Background(), cfg)
-	grpc.Init(grpcContext, &cfg.CommonConfiguration, cfg, &grpc.WorkerFunctions{
+	trafficSampler := utils.NewTrafficSampler(cfg)
+	go trafficSampler.Start(ctx)
+
+	caches := cache.NewRegistry()
+	grpc.RegisterCaches(caches)
+
+	workerFuncs := &grpc.WorkerFunctions{
 		Deploy:               utils.DeployImage,
 		DeploySharedSecrets:  utils.DeploySharedSecrets,
 		WatchContainerStatus: utils.ContainerStateStream,
@@ -47,7 +69,35 @@ func Serve(cfg *config.Configuration) {
 		DeleteContainers:     utils.DeleteContainers,
 		ContainerLog:         utils.ContainerLog,
 		ContainerInspect:     utils.ContainerInspect,
-	})
+		DeploymentDiff:       utils.DiffDeployment,
+		VolumeUsage:          utils.VolumeUsage,
+		PruneReport:          utils.PruneReport,
+		TrafficUsage:         trafficSampler.Usage,
+		InvalidateCache:      caches.Invalidate,
+		RuntimeCheck:         utils.DockerReady,
+		ClassifyError:        utils.ClassifyError,
+	}
+	if cfg.CheckpointEnabled {
+		log.Warn().Msg("Checkpoints are experimental, they need CRIU and the experimental mode of the docker daemon")
+		workerFuncs.ContainerCheckpoint = utils.ContainerCheckpoint
+		workerFuncs.ContainerRestore = utils.ContainerRestore
+	}
+	if ingress != nil {
+		workerFuncs.IngressConfig = ingress.Apply
+	}
+	if cfg.HostMetricsEnabled {
+		hostMetrics := utils.NewHostMetricsCollector(cfg)
+		workerFuncs.WatchHostMetrics = hostMetrics.Watch
+		workerFuncs.HostMetrics = hostMetrics.WritePrometheus
+	}
+
+	go utils.WatchRestartBudgets(ctx)
+	if cfg.ServiceDiscoveryEnabled {
+		go utils.SyncServiceDiscovery(ctx)
+	}
+
+	grpcContext := grpc.WithGRPCConfig(ctx, cfg)
+	grpc.Init(grpcContext, &cfg.CommonConfiguration, cfg, workerFuncs)
 }
 
 func grpcClose(ctx context.Context, reason agent.CloseReason, options grpc.UpdateOptions) error {
@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/docker/docker/api/types/checkpoint"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+var (
+	ErrCheckpointNotSupported = errors.New("checkpoints need CRIU and the experimental mode of the docker daemon")
+	ErrInvalidCheckpoint      = errors.New("invalid checkpoint")
+)
+
+// the checkpoint names accepted by docker
+var checkpointIDPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// checkpointDir is the directory of the checkpoints of the container, empty if the container runtime keeps them
+func checkpointDir(cfg *config.Configuration, container *common.ContainerIdentifier) string {
+	if cfg.CheckpointDir == "" {
+		return ""
+	}
+
+	return filepath.Join(cfg.CheckpointDir, fmt.Sprintf("%s-%s", container.Prefix, container.Name))
+}
+
+// checkpointID is the requested id or one generated from the name of the container and the time
+func checkpointID(requested string, container *common.ContainerIdentifier, now time.Time) (string, error) {
+	if requested == "" {
+		return fmt.Sprintf("%s-%s", container.Name, now.UTC().Format("20060102T150405Z")), nil
+	}
+
+	if !checkpointIDPattern.MatchString(requested) {
+		return "", fmt.Errorf("%w: %q can only have letters, digits, '_', '.' and '-'", ErrInvalidCheckpoint, requested)
+	}
+
+	return requested, nil
+}
+
+// checkpointClient connects to the docker daemon, the checkpoint API is only served in experimental mode
+func checkpointClient(ctx context.Context) (*client.Client, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	ping, err := cli.Ping(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ping.Experimental {
+		return nil, ErrCheckpointNotSupported
+	}
+
+	return cli, nil
+}
+
+// ContainerCheckpoint checkpoints the state of a running container with CRIU, the container stops unless it is left running
+func ContainerCheckpoint(ctx context.Context, request *agent.ContainerCheckpointRequest) (*agent.ContainerCheckpointResponse, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	id, err := checkpointID(request.GetCheckpointId(), request.Container, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := checkpointClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cont, err := GetContainerByPrefixAndName(ctx, cli, request.Container.Prefix, request.Container.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cont == nil {
+		return nil, internalCommon.ErrContainerNotFound
+	}
+
+	dir := checkpointDir(cfg, request.Container)
+	err = cli.CheckpointCreate(ctx, cont.ID, checkpoint.CreateOptions{
+		CheckpointID:  id,
+		CheckpointDir: dir,
+		Exit:          !request.LeaveRunning,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to checkpoint the container: %w", err)
+	}
+	log.Info().Str("container", cont.ID).Str("checkpoint", id).Msg("Container checkpointed")
+
+	checkpoints, err := cli.CheckpointList(ctx, cont.ID, checkpoint.ListOptions{CheckpointDir: dir})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the checkpoints of the container: %w", err)
+	}
+
+	resp := &agent.ContainerCheckpointResponse{
+		Container:    request.Container,
+		CheckpointId: id,
+		Checkpoints:  make([]string, 0, len(checkpoints)),
+	}
+	if dir != "" {
+		resp.CheckpointDir = &dir
+	}
+	for _, it := range checkpoints {
+		resp.Checkpoints = append(resp.Checkpoints, it.Name)
+	}
+
+	return resp, nil
+}
+
+// ContainerRestore starts a stopped container from a checkpoint, the directory of the request is used
+// for checkpoints copied from another node
+func ContainerRestore(ctx context.Context, request *agent.ContainerRestoreRequest) (*agent.ContainerRestoreResponse, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	if !checkpointIDPattern.MatchString(request.CheckpointId) {
+		return nil, fmt.Errorf("%w: %q is not a checkpoint id", ErrInvalidCheckpoint, request.CheckpointId)
+	}
+
+	cli, err := checkpointClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cont, err := GetContainerByPrefixAndName(ctx, cli, request.Container.Prefix, request.Container.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cont == nil {
+		return nil, internalCommon.ErrContainerNotFound
+	}
+	if cont.State == "running" {
+		return nil, fmt.Errorf("%w: the container has to be stopped before it is restored", ErrInvalidCheckpoint)
+	}
+
+	dir := request.GetCheckpointDir()
+	if dir == "" {
+		dir = checkpointDir(cfg, request.Container)
+	}
+
+	err = cli.ContainerStart(ctx, cont.ID, container.StartOptions{
+		CheckpointID:  request.CheckpointId,
+		CheckpointDir: dir,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore the container: %w", err)
+	}
+	log.Info().Str("container", cont.ID).Str("checkpoint", request.CheckpointId).Msg("Container restored")
+
+	return &agent.ContainerRestoreResponse{
+		Container:    request.Container,
+		CheckpointId: request.CheckpointId,
+	}, nil
+}
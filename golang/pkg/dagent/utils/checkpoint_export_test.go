@@ -0,0 +1,6 @@
+package utils
+
+var (
+	CheckpointDir = checkpointDir
+	CheckpointID  = checkpointID
+)
@@ -0,0 +1,37 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dagentConfig "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestCheckpointID(t *testing.T) {
+	cont := &common.ContainerIdentifier{Prefix: "prod", Name: "api"}
+
+	id, err := utils.CheckpointID("", cont, time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, "api-20240501T103000Z", id)
+
+	id, err = utils.CheckpointID("warm-cache", cont, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "warm-cache", id)
+
+	_, err = utils.CheckpointID("../escape", cont, time.Now())
+	assert.ErrorIs(t, err, utils.ErrInvalidCheckpoint)
+}
+
+func TestCheckpointDir(t *testing.T) {
+	cont := &common.ContainerIdentifier{Prefix: "prod", Name: "api"}
+
+	assert.Empty(t, utils.CheckpointDir(&dagentConfig.Configuration{}, cont))
+	assert.Equal(t, "/srv/checkpoints/prod-api", utils.CheckpointDir(&dagentConfig.Configuration{CheckpointDir: "/srv/checkpoints"}, cont))
+}
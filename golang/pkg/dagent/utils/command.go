@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	dockerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+// shells are the executables running the argument after -c as a script
+var shells = []string{"sh", "bash", "ash", "dash", "zsh"}
+
+func isShell(executable string) bool {
+	return slices.Contains(shells, path.Base(executable))
+}
+
+// isShellForm tells if the command runs a script with a shell, eg. /bin/sh -c "npm start"
+func isShellForm(command []string) bool {
+	return len(command) >= 2 && isShell(command[0]) && command[1] == "-c"
+}
+
+func formatCommand(command []string) string {
+	formatted, err := json.Marshal(command)
+	if err != nil {
+		return strings.Join(command, " ")
+	}
+
+	return string(formatted)
+}
+
+// shellFormWarning warns about a command written in shell form, the command is run in exec form, so the whole
+// string is looked up as the executable, it is not rejected as an image may have an executable with spaces in
+// its name, windows paths are left alone as they often do
+func shellFormWarning(command []string) (string, bool) {
+	if len(command) == 0 || strings.Contains(command[0], `\`) {
+		return "", false
+	}
+
+	fields := strings.Fields(command[0])
+	if len(fields) < 2 {
+		return "", false
+	}
+
+	return fmt.Sprintf("The executable %q contains spaces, the command is run in exec form, split it into %s or run it with a shell as %s",
+		command[0], formatCommand(append(fields, command[1:]...)),
+		formatCommand(append([]string{"/bin/sh", "-c"}, strings.Join(command, " ")))), true
+}
+
+// effectiveCommand is what the container runs, the entrypoint of the deployment replaces the one of the image
+// together with its default args, the same way the daemon merges them
+func effectiveCommand(image *container.Config, command, args []string) []string {
+	entrypoint, cmd := image.Entrypoint, image.Cmd
+	if len(command) > 0 {
+		entrypoint, cmd = command, nil
+	}
+	if len(args) > 0 {
+		cmd = args
+	}
+
+	return append(slices.Clone(entrypoint), cmd...)
+}
+
+// commandWarnings are the overrides of the deployment which are valid, but likely not what was meant
+func commandWarnings(image *container.Config, command, args []string) []string {
+	warnings := []string{}
+	if warning, ok := shellFormWarning(command); ok {
+		warnings = append(warnings, warning)
+	}
+
+	switch {
+	case len(command) > 0 && len(image.Entrypoint) > 0:
+		warning := fmt.Sprintf("The command replaces the entrypoint %s of the image", formatCommand(image.Entrypoint))
+		if len(args) == 0 && len(image.Cmd) > 0 {
+			warning += fmt.Sprintf(", its default args %s are not used", formatCommand(image.Cmd))
+		}
+		warnings = append(warnings, warning)
+	case len(command) == 0 && len(args) > 0 && isShellForm(image.Entrypoint):
+		warnings = append(warnings, fmt.Sprintf("The entrypoint %s of the image is in shell form, the args are not passed to it",
+			formatCommand(image.Entrypoint)))
+	case len(command) == 0 && len(args) > 0 && len(image.Entrypoint) > 0 && (isShell(args[0]) || path.IsAbs(args[0])):
+		warnings = append(warnings, fmt.Sprintf("The args %s are passed to the entrypoint %s of the image, set the command to replace it",
+			formatCommand(args), formatCommand(image.Entrypoint)))
+	}
+
+	effective := effectiveCommand(image, command, args)
+	if len(effective) == 0 {
+		warnings = append(warnings, "Neither the image nor the deployment has a command")
+	} else if len(command) > 0 && isShellForm(effective) && len(effective) > 3 {
+		warnings = append(warnings, fmt.Sprintf("Only %q is run by the shell, the rest of %s are its positional parameters",
+			effective[2], formatCommand(effective)))
+	}
+
+	return warnings
+}
+
+// checkCommand writes the warnings of the command against the config of the image, it runs before the container
+// is created, so the image is already pulled
+func checkCommand(dog *dogger.DeploymentLogger, image string, command, args []string) dockerbuilder.LifecycleFunc {
+	return func(ctx context.Context, cli client.APIClient, _ dockerbuilder.ParentContainer) error {
+		inspect, _, err := cli.ImageInspectWithRaw(ctx, image)
+		if err != nil {
+			return fmt.Errorf("failed to inspect the image for its command: %w", err)
+		}
+
+		imageConfig := inspect.Config
+		if imageConfig == nil {
+			imageConfig = &container.Config{}
+		}
+
+		for _, warning := range commandWarnings(imageConfig, command, args) {
+			dog.Write(dogger.Warning, warning)
+		}
+
+		return nil
+	}
+}
+
+// containerCommand is the command the daemon started the container with
+func containerCommand(ctx context.Context, cli client.APIClient, containerID string) []string {
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil || inspect.ContainerJSONBase == nil {
+		log.Debug().Err(err).Str("containerId", containerID).Msg("Failed to inspect the command of the container")
+		return nil
+	}
+
+	return append([]string{inspect.Path}, inspect.Args...)
+}
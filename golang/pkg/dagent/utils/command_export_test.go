@@ -0,0 +1,11 @@
+package utils
+
+import "github.com/docker/docker/api/types/container"
+
+func EffectiveCommand(image *container.Config, command, args []string) []string {
+	return effectiveCommand(image, command, args)
+}
+
+func CommandWarnings(image *container.Config, command, args []string) []string {
+	return commandWarnings(image, command, args)
+}
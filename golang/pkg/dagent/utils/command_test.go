@@ -0,0 +1,118 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestEffectiveCommand(t *testing.T) {
+	image := &container.Config{
+		Entrypoint: []string{"docker-entrypoint.sh"},
+		Cmd:        []string{"postgres"},
+	}
+
+	assert.Equal(t, []string{"docker-entrypoint.sh", "postgres"}, utils.EffectiveCommand(image, nil, nil))
+	assert.Equal(t, []string{"docker-entrypoint.sh", "postgres", "-c", "fsync=off"},
+		utils.EffectiveCommand(image, nil, []string{"postgres", "-c", "fsync=off"}))
+	assert.Equal(t, []string{"/bin/bash"}, utils.EffectiveCommand(image, []string{"/bin/bash"}, nil))
+	assert.Equal(t, []string{"/bin/bash", "-c", "sleep 1"}, utils.EffectiveCommand(image, []string{"/bin/bash", "-c"}, []string{"sleep 1"}))
+}
+
+func TestCommandWarnings(t *testing.T) {
+	wrapped := &container.Config{Entrypoint: []string{"docker-entrypoint.sh"}, Cmd: []string{"postgres"}}
+	shellForm := &container.Config{Entrypoint: []string{"/bin/sh", "-c", "exec nginx"}}
+	plain := &container.Config{Cmd: []string{"nginx", "-g", "daemon off;"}}
+
+	testCases := []struct {
+		image    *container.Config
+		name     string
+		command  []string
+		args     []string
+		warnings []string
+	}{
+		{
+			name:     "image command",
+			image:    wrapped,
+			warnings: []string{},
+		},
+		{
+			name:     "args of the entrypoint",
+			image:    wrapped,
+			args:     []string{"postgres", "-c", "fsync=off"},
+			warnings: []string{},
+		},
+		{
+			name:    "entrypoint replaced",
+			image:   wrapped,
+			command: []string{"postgres"},
+			warnings: []string{
+				`The command replaces the entrypoint ["docker-entrypoint.sh"] of the image, its default args ["postgres"] are not used`,
+			},
+		},
+		{
+			name:  "executable passed to the entrypoint",
+			image: wrapped,
+			args:  []string{"/usr/bin/pg_ctl", "start"},
+			warnings: []string{
+				`The args ["/usr/bin/pg_ctl","start"] are passed to the entrypoint ["docker-entrypoint.sh"] of the image, ` +
+					`set the command to replace it`,
+			},
+		},
+		{
+			name:  "args of a shell form entrypoint",
+			image: shellForm,
+			args:  []string{"-g", "daemon off;"},
+			warnings: []string{
+				`The entrypoint ["/bin/sh","-c","exec nginx"] of the image is in shell form, the args are not passed to it`,
+			},
+		},
+		{
+			name:    "script split into args",
+			image:   plain,
+			command: []string{"sh", "-c"},
+			args:    []string{"echo", "hello"},
+			warnings: []string{
+				`Only "echo" is run by the shell, the rest of ["sh","-c","echo","hello"] are its positional parameters`,
+			},
+		},
+		{
+			name:    "shell form command",
+			image:   plain,
+			command: []string{"npm run start"},
+			warnings: []string{
+				`The executable "npm run start" contains spaces, the command is run in exec form, ` +
+					`split it into ["npm","run","start"] or run it with a shell as ["/bin/sh","-c","npm run start"]`,
+			},
+		},
+		{
+			name:     "windows path",
+			image:    plain,
+			command:  []string{`C:\Program Files\app\app.exe`},
+			warnings: []string{},
+		},
+		{
+			name:     "no command",
+			image:    &container.Config{},
+			warnings: []string{"Neither the image nor the deployment has a command"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.warnings, utils.CommandWarnings(tc.image, tc.command, tc.args))
+		})
+	}
+}
+
+func TestShellFormCommandIsValid(t *testing.T) {
+	req := dagentDeployRequest()
+	req.ContainerConfig.Command = []string{"npm run start"}
+	assert.NoError(t, utils.ValidateDeployRequest(req))
+}
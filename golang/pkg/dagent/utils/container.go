@@ -5,8 +5,10 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -14,6 +16,7 @@
 	"github.com/docker/docker/client"
 
 	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
@@ -24,12 +27,27 @@
 	TraefikHTTPSPort = 443
 )
 
+const (
+	traefikContainerName  = "traefik"
+	defaultTraefikVersion = "v2.8.0"
+	// relative to the data mount path
+	defaultTraefikAcmeStorage = "traefik/letsencrypt"
+)
+
 type TraefikDeployRequest struct {
-	LogLevel string `json:"logLevel"`
-	AcmeMail string `json:"acmeMail" binding:"required_if=TLS true"`
-	Port     uint16 `json:"port"`
-	TLSPort  uint16 `json:"tlsPort"`
-	TLS      bool   `json:"TLS"`
+	// Entrypoints are the additional entrypoints by their name
+	Entrypoints map[string]uint16 `json:"entrypoints,omitempty"`
+	LogLevel    string            `json:"logLevel"`
+	AcmeMail    string            `json:"acmeMail" binding:"required_if=TLS true"`
+	// Version is the image tag of traefik, the default one if empty
+	Version string `json:"version,omitempty"`
+	// AcmeStorage is the directory of the certificates relative to the data mount path, the default one if empty
+	AcmeStorage string `json:"acmeStorage,omitempty"`
+	Port        uint16 `json:"port"`
+	TLSPort     uint16 `json:"tlsPort"`
+	// DashboardPort serves the dashboard if it is set, with the DEBUG log level it is served on 8080 anyway
+	DashboardPort uint16 `json:"dashboardPort,omitempty"`
+	TLS           bool   `json:"TLS"`
 }
 
 type UnknownContainerError struct{}
@@ -39,6 +57,21 @@ func (err *UnknownContainerError) Error() string {
 }
 
 func ExecTraefik(ctx context.Context, traefikDeployReq TraefikDeployRequest, cfg *config.Configuration) error {
+	builder, err := traefikBuilder(ctx, &traefikDeployReq, cfg)
+	if err != nil {
+		return err
+	}
+
+	_, err = builder.CreateAndStart()
+	return err
+}
+
+// traefikBuilder prepares the directories of traefik on the node and the builder of its container
+func traefikBuilder(ctx context.Context, traefikDeployReq *TraefikDeployRequest,
+	cfg *config.Configuration,
+) (containerbuilder.Builder, error) {
+	acmeStorage := util.Fallback(traefikDeployReq.AcmeStorage, defaultTraefikAcmeStorage)
+
 	mounts := []mount.Mount{}
 
 	mounts = append(mounts, mount.Mount{
@@ -54,7 +87,7 @@ func ExecTraefik(ctx context.Context, traefikDeployReq TraefikDeployRequest, cfg
 	if traefikDeployReq.TLS {
 		mounts = append(mounts, mount.Mount{
 			Type:   mount.TypeBind,
-			Source: filepath.Join(cfg.DataMountPath, "traefik", "letsencrypt"),
+			Source: filepath.Join(cfg.DataMountPath, acmeStorage),
 			Target: "/letsencrypt",
 		})
 	}
@@ -62,17 +95,39 @@ func ExecTraefik(ctx context.Context, traefikDeployReq TraefikDeployRequest, cfg
 	// ensure directories exist
 	err := os.MkdirAll(filepath.Join(cfg.InternalMountPath, "traefik", "logs"), os.ModePerm)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if traefikDeployReq.TLS {
-		err = os.MkdirAll(filepath.Join(cfg.InternalMountPath, "traefik", "letsencrypt"), os.ModePerm)
+		err = os.MkdirAll(filepath.Join(cfg.InternalMountPath, acmeStorage), os.ModePerm)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	// build the command
+	// check if "host" network mode is supported
+	if !container.NetworkMode("host").IsHost() {
+		log.Warn().Msg("Trying to start Traefik with unsupported 'host' network mode! Traefik will not work!")
+	}
+
+	image := "index.docker.io/library/traefik:" + util.Fallback(traefikDeployReq.Version, defaultTraefikVersion)
+
+	builder := containerbuilder.NewDockerBuilder(ctx).WithImage(cfg.ImageRewriteRules.Rewrite(image)).
+		WithName(traefikContainerName).
+		WithMountPoints(mounts).
+		WithRestartPolicy(container.RestartPolicyAlways).
+		WithAutoRemove(false).
+		WithoutConflict().
+		WithNetworkMode("host").
+		WithCmd(traefikCommand(traefikDeployReq)).
+		WithExtraHosts([]string{"host.docker.internal:host-gateway"}).
+		WithPullLimiter(cfg.BandwidthLimiter).
+		WithoutConflict()
+
+	return builder, nil
+}
+
+func traefikCommand(traefikDeployReq *TraefikDeployRequest) []string {
 	command := []string{
 		fmt.Sprintf("--entryPoints.web.address=:%d", traefikDeployReq.Port),
 		"--log.filePath=/var/log/traefik/traefik.log",
@@ -89,31 +144,25 @@ func ExecTraefik(ctx context.Context, traefikDeployReq TraefikDeployRequest, cfg
 		)
 	}
 
-	if traefikDeployReq.LogLevel == "DEBUG" {
+	// sorted, so the same request results in the same container
+	names := maps.Keys(traefikDeployReq.Entrypoints)
+	sort.Strings(names)
+	for _, name := range names {
+		command = append(command, fmt.Sprintf("--entryPoints.%s.address=:%d", name, traefikDeployReq.Entrypoints[name]))
+	}
+
+	if traefikDeployReq.DashboardPort != 0 {
+		command = append(command, fmt.Sprintf("--entryPoints.traefik.address=:%d", traefikDeployReq.DashboardPort))
+	}
+
+	if traefikDeployReq.LogLevel == "DEBUG" || traefikDeployReq.DashboardPort != 0 {
 		command = append(command,
 			"--api.insecure=true",
 			"--api.dashboard=true",
 		)
 	}
 
-	// check if "host" network mode is supported
-	if !container.NetworkMode("host").IsHost() {
-		log.Warn().Msg("Trying to start Traefik with unsupported 'host' network mode! Traefik will not work!")
-	}
-
-	builder := containerbuilder.NewDockerBuilder(ctx).WithImage("index.docker.io/library/traefik:v2.8.0").
-		WithName("traefik").
-		WithMountPoints(mounts).
-		WithRestartPolicy(container.RestartPolicyAlways).
-		WithAutoRemove(false).
-		WithoutConflict().
-		WithNetworkMode("host").
-		WithCmd(command).
-		WithExtraHosts([]string{"host.docker.internal:host-gateway"}).
-		WithoutConflict()
-
-	_, err = builder.CreateAndStart()
-	return err
+	return command
 }
 
 func GetOwnContainer(ctx context.Context, cli client.APIClient) (*types.Container, error) {
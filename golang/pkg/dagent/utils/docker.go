@@ -20,15 +20,16 @@
 
 	"github.com/rs/zerolog/log"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
 	"github.com/dyrector-io/dyrectorio/golang/internal/crypt"
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
-	"github.com/dyrector-io/dyrectorio/golang/internal/domain"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
 	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/mirror"
 	"github.com/dyrector-io/dyrectorio/golang/internal/label"
 	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
@@ -171,12 +172,15 @@ func logDeployInfo(
 	}
 }
 
-func buildMountList(cfg *config.Configuration, dog *dogger.DeploymentLogger, deployImageRequest *v1.DeployImageRequest) []mount.Mount {
+func buildMountList(cfg *config.Configuration, dog *dogger.DeploymentLogger, deployImageRequest *v1.DeployImageRequest,
+	windows bool,
+) []mount.Mount {
 	mountList := mountStrToDocker(
 		// volumes are mapped into the legacy format, until further support of different types is needed
 		append(deployImageRequest.ContainerConfig.Mounts, volumesToMounts(deployImageRequest.ContainerConfig.Volumes)...),
 		deployImageRequest.InstanceConfig.ContainerPreName,
 		deployImageRequest.ContainerConfig.Container,
+		windows,
 		cfg)
 	// dotnet specific magic
 	if containsConfig(mountList) {
@@ -200,13 +204,18 @@ func writeDoggerError(dog *dogger.DeploymentLogger, msg string, err error) {
 	dog.WriteContainerState(common.ContainerState_CONTAINER_STATE_UNSPECIFIED, err.Error(), dogger.Error, msg)
 }
 
-func getImageNameFromRequest(deployImageRequest *v1.DeployImageRequest) (string, error) {
+func getImageNameFromRequest(deployImageRequest *v1.DeployImageRequest, rules mirror.Rules) (string, error) {
 	imageName := util.JoinV(":", deployImageRequest.ImageName, deployImageRequest.Tag)
 	if deployImageRequest.Registry != nil && *deployImageRequest.Registry != "" {
 		imageName = util.JoinV("/", *deployImageRequest.Registry, imageName)
 	}
 
-	return imageHelper.ExpandImageName(imageName)
+	expandedImageName, err := imageHelper.ExpandImageName(imageName)
+	if err != nil {
+		return "", err
+	}
+
+	return rules.Rewrite(expandedImageName), nil
 }
 
 func expectedStateToProto(state *v1.ExpectedState) common.ContainerState {
@@ -237,6 +246,10 @@ func checkContainerState(ctx context.Context, cli *client.Client, expected *v1.E
 	currentState := mapper.MapDockerStateToCruxContainerState(matchedContainer.State)
 	expectedState := expectedStateToProto(expected)
 
+	if expectedState == common.ContainerState_RUNNING && currentState == common.ContainerState_EXITED {
+		return false, ErrContainerExited
+	}
+
 	if expectedState == common.ContainerState_EXITED {
 		if currentState != common.ContainerState_EXITED {
 			return false, nil
@@ -332,6 +345,53 @@ func DeploySharedSecrets(ctx context.Context,
 	return nil
 }
 
+// deploymentEnvironment merges the shared, instance and container environment and decrypts the secrets,
+// the shared environment of the prefix is only written to disk when persist is set
+func deploymentEnvironment(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest, prefix string, persist bool,
+) (environment, secrets map[string]string, err error) {
+	instanceConfig := &deployImageRequest.InstanceConfig
+	pf := NewSharedEnvPrefixFile(cfg.InternalMountPath, prefix)
+	if persist && len(instanceConfig.SharedEnvironment) > 0 {
+		err = pf.WriteVariables(instanceConfig.SharedEnvironment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not write shared environment variables: %w", err)
+		}
+	}
+
+	switch {
+	case !instanceConfig.UseSharedEnvs:
+		environment = MergeStringMapUnique(instanceConfig.SharedEnvironment, instanceConfig.Environment)
+	case !persist && len(instanceConfig.SharedEnvironment) > 0:
+		// the file would be overwritten by the deployment
+		environment = maps.Clone(instanceConfig.SharedEnvironment)
+	default:
+		environment, err = pf.ReadVariables()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not load shared environment variables, while useSharedEnvs is on: %w", err)
+		}
+	}
+	environment = MergeStringMapUnique(environment, deployImageRequest.ContainerConfig.Environment)
+
+	secret, err := crypt.DecryptSecrets(deployImageRequest.ContainerConfig.Secrets, &cfg.CommonConfiguration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("secret error: %w", err)
+	}
+
+	return environment, mapper.ByteMapToStringMap(secret), nil
+}
+
+func deployMetadata(deployImageRequest *v1.DeployImageRequest, versionData *v1.VersionData) dockerbuilder.Metadata {
+	metadata := dockerbuilder.Metadata{
+		DeploymentID: deployImageRequest.DeploymentID,
+		TriggeredBy:  deployImageRequest.Issuer,
+	}
+	if versionData != nil {
+		metadata.VersionName = versionData.Version
+	}
+
+	return metadata
+}
+
 //nolint:funlen,gocyclo // TODO(@nandor-magyar): refactor this function into smaller parts
 func DeployImage(ctx context.Context,
 	dog *dogger.DeploymentLogger,
@@ -340,22 +400,22 @@ func DeployImage(ctx context.Context,
 ) error {
 	containerName := getContainerName(deployImageRequest)
 	prefix := getContainerPrefix(deployImageRequest)
-	err := domain.IsCompliantDNS(prefix)
+	err := validateDeployRequest(deployImageRequest)
 	if err != nil {
-		return fmt.Errorf("deployment failed, invalid prefix: %w", err)
-	}
-	err = domain.IsCompliantDNS(containerName)
-	if err != nil {
-		return fmt.Errorf("deployment failed, invalid container name: %w", err)
+		return err
 	}
 	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
 
+	if err = waitForNodeLoad(ctx, cfg, dog, procPath); err != nil {
+		return fmt.Errorf("deployment failed: %w", err)
+	}
+
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		return err
 	}
 
-	expandedImageName, err := getImageNameFromRequest(deployImageRequest)
+	expandedImageName, err := getImageNameFromRequest(deployImageRequest, cfg.ImageRewriteRules)
 	if err != nil {
 		return fmt.Errorf("deployment failed, image name error: %w", err)
 	}
@@ -363,36 +423,60 @@ func DeployImage(ctx context.Context,
 	log.Debug().Str("name", deployImageRequest.ImageName).Str("full", expandedImageName).Msg("Image name parsed")
 	logDeployInfo(dog, deployImageRequest, expandedImageName, containerName)
 
-	pf := NewSharedEnvPrefixFile(cfg.InternalMountPath, prefix)
-	if len(deployImageRequest.InstanceConfig.SharedEnvironment) > 0 {
-		err = pf.WriteVariables(deployImageRequest.InstanceConfig.SharedEnvironment)
-		if err != nil {
-			dog.WriteError("could not write shared environment variables, aborting...", err.Error())
-			return err
-		}
+	environment, secrets, err := deploymentEnvironment(cfg, deployImageRequest, prefix, true)
+	if err != nil {
+		return fmt.Errorf("deployment failed, environment error: %w", err)
+	}
+
+	// the snapshot keeps the expressions, so the evaluated secrets are not written to the disk
+	expressions := newExpressionContext(cfg, deployImageRequest, secrets)
+	evaluatedEnvironment, err := expressions.evaluateEnvironment(environment)
+	if err != nil {
+		return fmt.Errorf("deployment failed: %w", err)
+	}
+	args, err := expressions.evaluateArgs(deployImageRequest.ContainerConfig.Args)
+	if err != nil {
+		return fmt.Errorf("deployment failed: %w", err)
+	}
+
+	envMap := MergeStringMapUnique(evaluatedEnvironment, maps.Clone(secrets))
+
+	windows, err := isWindowsDaemon(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("deployment failed, could not query the daemon: %w", err)
+	}
+
+	isolation, err := mapIsolation(deployImageRequest.ContainerConfig.Isolation, windows)
+	if err != nil {
+		return fmt.Errorf("deployment failed: %w", err)
 	}
 
-	var envMap map[string]string
+	if err = checkRuntime(ctx, cli, deployImageRequest.ContainerConfig.Runtime); err != nil {
+		return fmt.Errorf("deployment failed: %w", err)
+	}
 
-	if deployImageRequest.InstanceConfig.UseSharedEnvs {
-		envMap, err = pf.ReadVariables()
+	var resources *container.Resources
+	if windows {
+		resources, err = mapResourceConfig(deployImageRequest.ContainerConfig.ResourceConfig)
 		if err != nil {
-			dog.WriteError("could not load shared environment variables, while useSharedEnvs is on, aborting...", err.Error())
-			return err
+			return fmt.Errorf("deployment failed, resource config error: %w", err)
 		}
-	} else {
-		envMap = MergeStringMapUnique(deployImageRequest.InstanceConfig.SharedEnvironment,
-			deployImageRequest.InstanceConfig.Environment)
 	}
-	envMap = MergeStringMapUnique(envMap, deployImageRequest.ContainerConfig.Environment)
 
-	secret, err := crypt.DecryptSecrets(deployImageRequest.ContainerConfig.Secrets, &cfg.CommonConfiguration)
+	hostname, domainname, err := containerHostname(cfg, deployImageRequest)
 	if err != nil {
-		return fmt.Errorf("deployment failed, secret error: %w", err)
+		return fmt.Errorf("deployment failed: %w", err)
 	}
 
-	envMap = MergeStringMapUnique(envMap, mapper.ByteMapToStringMap(secret))
-	mountList := buildMountList(cfg, dog, deployImageRequest)
+	mountList := buildMountList(cfg, dog, deployImageRequest, windows)
+
+	if !container.NetworkMode(deployImageRequest.ContainerConfig.NetworkMode).IsHost() {
+		err = checkPortConflicts(ctx, cli, containerName,
+			deployImageRequest.ContainerConfig.Ports, deployImageRequest.ContainerConfig.PortRanges)
+		if err != nil {
+			return fmt.Errorf("deployment failed, port conflict: %w", err)
+		}
+	}
 
 	matchedContainer, err := dockerHelper.GetContainerByName(ctx, cli, containerName)
 	if err != nil {
@@ -400,6 +484,10 @@ func DeployImage(ctx context.Context,
 		return err
 	}
 
+	if skipUnchanged(ctx, cli, cfg, dog, deployImageRequest, matchedContainer, expandedImageName, environment, secrets) {
+		return finishDeployment(cfg, dog, deployImageRequest, versionData, expandedImageName, environment, secrets)
+	}
+
 	if matchedContainer != nil {
 		dog.WriteContainerState(mapper.MapDockerStateToCruxContainerState(matchedContainer.State), matchedContainer.State, dogger.Info)
 
@@ -412,6 +500,7 @@ func DeployImage(ctx context.Context,
 
 	builder := dockerbuilder.NewDockerBuilder(ctx)
 	networkMode, networks := setNetwork(deployImageRequest)
+	networks = withProjectNetwork(cfg, deployImageRequest.InstanceConfig.ContainerPreName, networkMode, networks)
 	labels, err := setImageLabels(expandedImageName, deployImageRequest, cfg)
 	if err != nil {
 		return fmt.Errorf("error building labels: %w", err)
@@ -425,6 +514,7 @@ func DeployImage(ctx context.Context,
 		WithPortRanges(deployImageRequest.ContainerConfig.PortRanges).
 		WithNetworkMode(networkMode).
 		WithNetworks(networks).
+		WithNetworkOptions(deployImageRequest.ContainerConfig.NetworkOptions).
 		WithNetworkAliases(containerName, deployImageRequest.ContainerConfig.Container).
 		WithRegistryAuth(deployImageRequest.RegistryAuth).
 		WithRestartPolicy(deployImageRequest.ContainerConfig.RestartPolicy).
@@ -433,17 +523,28 @@ func DeployImage(ctx context.Context,
 		WithLogConfig(deployImageRequest.ContainerConfig.LogConfig).
 		WithUser(deployImageRequest.ContainerConfig.User).
 		WithEntrypoint(deployImageRequest.ContainerConfig.Command).
-		WithCmd(deployImageRequest.ContainerConfig.Args).
+		WithCmd(args).
 		WithWorkingDirectory(deployImageRequest.ContainerConfig.WorkingDirectory).
+		WithIsolation(isolation).
+		WithRuntime(deployImageRequest.ContainerConfig.Runtime).
+		WithAnnotations(deployImageRequest.ContainerConfig.RuntimeOptions).
+		WithPID(deployImageRequest.ContainerConfig.PidMode).
+		WithIPC(deployImageRequest.ContainerConfig.IpcMode).
+		WithHostname(hostname).
+		WithDomainname(domainname).
+		WithResources(resources).
+		WithMetadata(deployMetadata(deployImageRequest, versionData)).
 		WithoutConflict().
 		WithLogWriter(dog).
-		WithPullDisplayFunc(dog.WriteDockerPull)
+		WithPullDisplayFunc(dog.WriteDockerPull).
+		WithPullLimiter(cfg.BandwidthLimiter)
 
 	if deployImageRequest.Registry == nil || *deployImageRequest.Registry == "" {
 		builder.WithImagePriority(imageHelper.LocalOnly)
 	}
 
-	WithInitContainers(builder, &deployImageRequest.ContainerConfig, dog, envMap, cfg)
+	WithInitContainers(builder, &deployImageRequest.ContainerConfig, expandedImageName, deployImageRequest.RegistryAuth, dog, envMap, cfg)
+	builder.WithPreCreateHooks(checkCommand(dog, expandedImageName, deployImageRequest.ContainerConfig.Command, args))
 
 	cont, err := builder.CreateAndStart()
 	if err != nil {
@@ -457,21 +558,44 @@ func DeployImage(ctx context.Context,
 		return err
 	}
 
+	command := containerCommand(ctx, cli, matchedContainer.ID)
+	dog.SetContainerCommand(command)
 	dog.WriteContainerState(mapper.MapDockerStateToCruxContainerState(matchedContainer.State),
-		matchedContainer.State, dogger.Info, "Started container: "+containerName)
+		matchedContainer.State, dogger.Info, "Started container: "+containerName, "Command: "+formatCommand(command))
 
 	err = waitForContainer(ctx, cli, matchedContainer.ID, deployImageRequest.ContainerConfig.ExpectedState)
 	if err != nil {
-		return fmt.Errorf("expected container state failed: %w", err)
+		return reportContainerFailure(ctx, cli, dog, matchedContainer.ID,
+			fmt.Errorf("expected container state failed: %w", err), cfg.ExitLogTailLines)
 	}
 
+	if expectedStateToProto(deployImageRequest.ContainerConfig.ExpectedState) == common.ContainerState_RUNNING {
+		err = watchEarlyExit(ctx, cli, matchedContainer.ID, cfg.StartupGracePeriod, cfg.ExitLogTailLines)
+		if err != nil {
+			return reportContainerFailure(ctx, cli, dog, matchedContainer.ID, err, cfg.ExitLogTailLines)
+		}
+	}
+
+	return finishDeployment(cfg, dog, deployImageRequest, versionData, expandedImageName, environment, secrets)
+}
+
+// finishDeployment records the release and the snapshot of a deployed container
+func finishDeployment(cfg *config.Configuration, dog *dogger.DeploymentLogger,
+	deployImageRequest *v1.DeployImageRequest, versionData *v1.VersionData,
+	image string, environment, secrets map[string]string,
+) error {
+	containerName := getContainerName(deployImageRequest)
 	if versionData != nil {
 		DraftRelease(deployImageRequest.InstanceConfig.ContainerPreName, *versionData, v1.DeployVersionResponse{}, cfg)
 	}
 
+	if err := saveDeploymentSnapshot(cfg, deployImageRequest, versionData, image, environment, secrets); err != nil {
+		log.Warn().Err(err).Str("container", containerName).Msg("Failed to save the deployment snapshot")
+	}
+
 	dog.WriteInfo(fmt.Sprintf("Container deployed: %s", containerName))
 
-	return err
+	return nil
 }
 
 func setNetwork(deployImageRequest *v1.DeployImageRequest) (networkMode string, networks []string) {
@@ -480,10 +604,22 @@ func setNetwork(deployImageRequest *v1.DeployImageRequest) (networkMode string,
 	} else {
 		networkMode = strings.ToLower(deployImageRequest.ContainerConfig.NetworkMode)
 	}
-	return networkMode, deployImageRequest.ContainerConfig.Networks
+
+	// networks having options are attached even if they are not listed
+	networks = slices.Clone(deployImageRequest.ContainerConfig.Networks)
+	configured := maps.Keys(deployImageRequest.ContainerConfig.NetworkOptions)
+	slices.Sort(configured)
+	for _, name := range configured {
+		if !slices.Contains(networks, name) {
+			networks = append(networks, name)
+		}
+	}
+
+	return networkMode, networks
 }
 
 func WithInitContainers(dc dockerbuilder.Builder, containerConfig *v1.ContainerConfig,
+	image string, registryAuth *imageHelper.RegistryAuth,
 	dog *dogger.DeploymentLogger, envMap map[string]string, cfg *config.Configuration,
 ) {
 	initFuncs := []dockerbuilder.LifecycleFunc{}
@@ -520,7 +656,9 @@ func(ctx context.Context, client client.APIClient,
 						ParentName: parentCont.Name,
 					}
 				}
-				err := spawnInitContainer(ctx, client, initContConfig, &containerConfig.InitContainers[i], dog)
+				initContConfig.DeploymentImage = image
+				initContConfig.RegistryAuth = registryAuth
+				err := spawnInitContainer(ctx, client, initContConfig, &containerConfig.InitContainers[i], dog, cfg)
 				if err != nil {
 					return err
 				}
@@ -578,7 +716,9 @@ func containsConfig(mounts []mount.Mount) bool {
 	return false
 }
 
-func mountStrToDocker(mountIn []string, containerPreName, containerName string, cfg *config.Configuration) []mount.Mount {
+func mountStrToDocker(mountIn []string, containerPreName, containerName string, windows bool,
+	cfg *config.Configuration,
+) []mount.Mount {
 	// bind mounts created this way
 	// volumes are also an option - not a bad one, host mount is not really
 	var mountList []mount.Mount
@@ -588,12 +728,17 @@ func mountStrToDocker(mountIn []string, containerPreName, containerName string,
 		if strings.ContainsRune(mountStr, '|') {
 			mountSplit := strings.Split(mountStr, "|")
 			if mountSplit[0] != "" && mountSplit[1] != "" {
+				if windows && isNamedPipe(mountSplit[0]) {
+					mountList = append(mountList, mount.Mount{Type: mount.TypeNamedPipe, Source: mountSplit[0], Target: mountSplit[1]})
+					continue
+				}
+
 				containerPath := path.Join(cfg.InternalMountPath, containerPreName, containerName, mountSplit[0])
 				hostPath := ""
-				if strings.HasPrefix(mountSplit[0], "/") {
+				if isAbsHostPath(mountSplit[0], windows) {
 					hostPath = mountSplit[0]
 				} else {
-					hostPath = path.Join(cfg.DataMountPath, containerPreName, containerName, mountSplit[0])
+					hostPath = joinHostPath(windows, cfg.DataMountPath, containerPreName, containerName, mountSplit[0])
 				}
 				_, err := os.Stat(containerPath)
 				if os.IsNotExist(err) {
@@ -723,6 +868,35 @@ func setImageLabels(expandedImageName string,
 		maps.Copy(labels, secretKeysList)
 	}
 
+	// the log files are tailed into the container log
+	if len(deployImageRequest.ContainerConfig.LogFiles) > 0 {
+		logFiles, err := SetOrganizationLabel(label.LogFiles, strings.Join(deployImageRequest.ContainerConfig.LogFiles, ","))
+		if err != nil {
+			return nil, fmt.Errorf("setting log files: %s", err.Error())
+		}
+		maps.Copy(labels, logFiles)
+	}
+
+	// the restart budget is applied by the agent, see WatchRestartBudgets
+	if budget := deployImageRequest.ContainerConfig.RestartBudget; budget != nil {
+		value, err := json.Marshal(budget)
+		if err != nil {
+			return nil, fmt.Errorf("setting restart budget: %w", err)
+		}
+		restartBudget, err := SetOrganizationLabel(label.RestartBudget, string(value))
+		if err != nil {
+			return nil, fmt.Errorf("setting restart budget: %s", err.Error())
+		}
+		maps.Copy(labels, restartBudget)
+
+		// the policy is restored from it if the agent restarts during a backoff
+		restartPolicy, err := SetOrganizationLabel(label.RestartPolicy, string(deployImageRequest.ContainerConfig.RestartPolicy))
+		if err != nil {
+			return nil, fmt.Errorf("setting restart policy: %s", err.Error())
+		}
+		maps.Copy(labels, restartPolicy)
+	}
+
 	maps.Copy(labels, deployImageRequest.ContainerConfig.DockerLabels)
 
 	return labels, nil
@@ -908,14 +1082,15 @@ func ContainerLog(ctx context.Context, request *agent.ContainerLogRequest) (*grp
 			return nil, err
 		}
 
-		cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
-		if !cfg.Debug {
+		if !grpc.GetConfigFromContext(ctx).(*config.Configuration).Debug {
 			return nil, err
 		}
 
 		self = &types.Container{}
 	}
 
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
 	prefix := request.Container.Prefix
 	name := request.Container.Name
 
@@ -962,11 +1137,15 @@ func ContainerLog(ctx context.Context, request *agent.ContainerLogRequest) (*grp
 		go streamDockerLog(reader, eventChannel)
 	}
 
-	logReader := &DockerContainerLogReader{
+	var logReader grpc.ContainerLogReader = &DockerContainerLogReader{
 		EventChannel: eventChannel,
 		Reader:       reader,
 	}
 
+	if globs := logFileGlobs(cfg, inspect.Config.Labels, inspect.Mounts); len(globs) > 0 {
+		logReader = mergeLogReaders(ctx, logReader, newFileLogReader(globs, request.GetTail(), streaming, logFilePollInterval))
+	}
+
 	logContext := &grpc.ContainerLogStream{
 		Reader: logReader,
 		Echo:   enableEcho,
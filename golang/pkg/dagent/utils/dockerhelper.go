@@ -12,6 +12,7 @@
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
 
 	internalCommon "github.com/dyrector-io/dyrectorio/golang/internal/common"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
@@ -77,8 +78,10 @@ func messageToStateItem(ctx context.Context, prefix string, event *events.Messag
 	}
 
 	containerState := mapper.MapDockerContainerEventToContainerState(string(event.Action))
+	// the restart budget updates the restart policy of the container, the state stays the same
+	updated := event.Action == events.ActionUpdate
 	// Ingored events are mapped to unspecified, for example tty, exec, oom, etc.
-	if containerState == common.ContainerState_CONTAINER_STATE_UNSPECIFIED {
+	if containerState == common.ContainerState_CONTAINER_STATE_UNSPECIFIED && !updated {
 		return nil, nil
 	}
 
@@ -92,10 +95,33 @@ func messageToStateItem(ctx context.Context, prefix string, event *events.Messag
 	}
 
 	newState := mapper.MapContainerState(container, prefix)
-	newState.State = containerState
+	if !updated {
+		newState.State = containerState
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+	withStateDetails(ctx, cli, newState, container.ID)
+
 	return newState, nil
 }
 
+// withStateDetails adds the details only the inspection has to the state, if the inspection fails they are left out
+func withStateDetails(ctx context.Context, cli client.APIClient, item *common.ContainerStateItem, containerID string) {
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		log.Debug().Err(err).Str("containerId", containerID).Msg("Failed to inspect container for its state details")
+		return
+	}
+
+	mapper.MapDockerContainerDetails(item, &inspect)
+	if inspect.Config != nil {
+		item.RestartBudget = containerRestartBudgets.status(containerID, inspect.Config.Labels)
+	}
+}
+
 func ContainerStateStream(ctx context.Context, prefix string, sendInitalStates bool) (*grpc.ContainerStatusStream, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -131,7 +157,12 @@ func WatchContainersByPrefix(ctx context.Context,
 
 	go func(ctx context.Context, prefix string, chanMessages <-chan events.Message, chanErrors <-chan error) {
 		if initialStates != nil {
-			eventChannel <- mapper.MapContainerStateList(initialStates, prefix)
+			states := mapper.MapContainerStateList(initialStates, prefix)
+			for i := range states {
+				withStateDetails(ctx, cli, states[i], initialStates[i].ID)
+			}
+
+			eventChannel <- states
 		}
 
 		for {
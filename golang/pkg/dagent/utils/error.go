@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// the errors of dagent by their class, the errors of the daemon are classified by the shared classification
+var errorClasses = []struct {
+	err      error
+	code     string
+	category agent.ErrorCategory
+}{
+	{ErrNodeOverloaded, "NODE_OVERLOADED", agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED},
+	{ErrRuntimeNotConfigured, "RUNTIME_NOT_CONFIGURED", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	{ErrIsolationNotSupported, "ISOLATION_NOT_SUPPORTED", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	{ErrCheckpointNotSupported, "CHECKPOINT_NOT_SUPPORTED", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	{ErrContainerExited, "CONTAINER_EXITED", agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION},
+	{ErrInvalidCheckpoint, "INVALID_CHECKPOINT", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrInvalidIngressConfig, "INVALID_INGRESS_CONFIG", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrInvalidHostname, "INVALID_HOSTNAME", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrInvalidExpression, "INVALID_EXPRESSION", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrInsufficientRoutingRules, "INVALID_ROUTING", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrExposedPortNotFound, "INVALID_ROUTING", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+	{ErrInvalidUploadLimit, "INVALID_ROUTING", agent.ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT},
+}
+
+// ClassifyError classifies the errors of dagent for the platform, nil if it is not one of them
+func ClassifyError(err error) *grpc.ErrorClass {
+	var exitErr *ContainerExitError
+	if errors.As(err, &exitErr) {
+		class := grpc.ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION, "CONTAINER_EXITED")
+		class.Details = map[string]string{"container": exitErr.Container, "exitCode": strconv.Itoa(exitErr.ExitCode)}
+		return class
+	}
+
+	var unknownErr *UnknownContainerError
+	if errors.As(err, &unknownErr) {
+		return grpc.ErrorClassOf(agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND, "UNKNOWN_CONTAINER")
+	}
+
+	for _, it := range errorClasses {
+		if errors.Is(err, it.err) {
+			return grpc.ErrorClassOf(it.category, it.code)
+		}
+	}
+
+	return nil
+}
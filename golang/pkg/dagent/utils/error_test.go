@@ -0,0 +1,32 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestClassifyError(t *testing.T) {
+	overloaded := utils.ClassifyError(fmt.Errorf("deploy: %w", utils.ErrNodeOverloaded))
+	assert.Equal(t, "NODE_OVERLOADED", overloaded.Code)
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED, overloaded.Category)
+	assert.True(t, overloaded.Retryable)
+
+	runtime := utils.ClassifyError(fmt.Errorf("%w: kata", utils.ErrRuntimeNotConfigured))
+	assert.Equal(t, "RUNTIME_NOT_CONFIGURED", runtime.Code)
+	assert.False(t, runtime.Retryable)
+
+	exited := utils.ClassifyError(&utils.ContainerExitError{Container: "api", ExitCode: 1, Cause: utils.ErrContainerExited})
+	assert.Equal(t, "CONTAINER_EXITED", exited.Code)
+	assert.Equal(t, map[string]string{"container": "api", "exitCode": "1"}, exited.Details)
+
+	assert.Nil(t, utils.ClassifyError(errors.New("unknown")))
+}
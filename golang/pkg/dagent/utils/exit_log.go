@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// ErrContainerExited is returned while waiting for a running container that has already stopped
+var ErrContainerExited = errors.New("container exited")
+
+// ContainerExitError describes a container that stopped during its deployment,
+// Logs holds the last lines of its output so the failure can be seen without accessing the node
+type ContainerExitError struct {
+	Cause     error
+	Container string
+	Logs      []string
+	ExitCode  int
+}
+
+func (e *ContainerExitError) Error() string {
+	msg := fmt.Sprintf("container %s exited with code %d", e.Container, e.ExitCode)
+	if e.Cause != nil && !errors.Is(e.Cause, ErrContainerExited) {
+		msg = fmt.Sprintf("%s: %s", e.Cause.Error(), msg)
+	}
+
+	return msg
+}
+
+func (e *ContainerExitError) Unwrap() error {
+	return e.Cause
+}
+
+// tailLines splits the output into lines and keeps the last count of them, count 0 keeps everything
+func tailLines(output string, count int) []string {
+	output = strings.TrimRight(strings.ReplaceAll(output, "\r\n", "\n"), "\n")
+	if output == "" {
+		return []string{}
+	}
+
+	lines := strings.Split(output, "\n")
+	if count > 0 && len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+
+	return lines
+}
+
+// containerLogTail reads the last lines of the output of a container, stdout and stderr are interleaved
+func containerLogTail(ctx context.Context, cli client.APIClient, containerID string, tty bool, count uint64) ([]string, error) {
+	const base = 10
+	reader, err := cli.ContainerLogs(ctx, containerID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       strconv.FormatUint(count, base),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer logdefer.LogDeferredErr(reader.Close, log.Warn(), "error closing container log reader")
+
+	output := strings.Builder{}
+	if tty {
+		_, err = io.Copy(&output, reader)
+	} else {
+		_, err = stdcopy.StdCopy(&output, &output, reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tailLines(output.String(), int(count)), nil
+}
+
+// stoppedContainer returns the inspection of the container if it is not running,
+// a container restarted by its restart policy also counts as stopped
+func stoppedContainer(ctx context.Context, cli client.APIClient, containerID string) (*types.ContainerJSON, error) {
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if inspect.State == nil || (inspect.State.Running && !inspect.State.Restarting) {
+		return nil, nil
+	}
+
+	return &inspect, nil
+}
+
+// newContainerExitError collects the exit code and the log tail of a stopped container,
+// nil is returned if the container is still running
+func newContainerExitError(ctx context.Context, cli client.APIClient, containerID string,
+	cause error, tailCount uint64,
+) *ContainerExitError {
+	inspect, err := stoppedContainer(ctx, cli, containerID)
+	if err != nil || inspect == nil {
+		return nil
+	}
+
+	exitErr := &ContainerExitError{
+		Cause:     cause,
+		Container: strings.TrimPrefix(inspect.Name, "/"),
+		ExitCode:  inspect.State.ExitCode,
+	}
+
+	tty := inspect.Config != nil && inspect.Config.Tty
+	exitErr.Logs, err = containerLogTail(ctx, cli, containerID, tty, tailCount)
+	if err != nil {
+		log.Warn().Err(err).Str("container", exitErr.Container).Msg("Failed to read the logs of the exited container")
+	}
+
+	return exitErr
+}
+
+// watchEarlyExit keeps an eye on a started container for the grace period,
+// a container stopping in this window fails the deployment
+func watchEarlyExit(ctx context.Context, cli client.APIClient, containerID string,
+	gracePeriod time.Duration, tailCount uint64,
+) error {
+	if gracePeriod <= 0 {
+		return nil
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, gracePeriod)
+	defer cancel()
+
+	waitChannel, errorChannel := cli.ContainerWait(watchCtx, containerID, container.WaitConditionNextExit)
+	select {
+	case <-waitChannel:
+	case <-watchCtx.Done():
+	case err := <-errorChannel:
+		if watchCtx.Err() == nil {
+			return err
+		}
+	}
+
+	// the container could have stopped before the wait started, so the state is checked either way
+	if exitErr := newContainerExitError(ctx, cli, containerID, ErrContainerExited, tailCount); exitErr != nil {
+		return exitErr
+	}
+
+	return nil
+}
+
+// reportContainerFailure attaches the exit code and the log tail to the failed container state
+// if the container is stopped, otherwise the original error is returned as is
+func reportContainerFailure(ctx context.Context, cli client.APIClient, dog *dogger.DeploymentLogger,
+	containerID string, cause error, tailCount uint64,
+) error {
+	exitErr := &ContainerExitError{}
+	if !errors.As(cause, &exitErr) {
+		exitErr = newContainerExitError(ctx, cli, containerID, cause, tailCount)
+		if exitErr == nil {
+			return cause
+		}
+	}
+
+	messages := []string{exitErr.Error()}
+	if len(exitErr.Logs) > 0 {
+		messages = append(messages, fmt.Sprintf("Last %d lines of the output of %s:", len(exitErr.Logs), exitErr.Container))
+		messages = append(messages, exitErr.Logs...)
+	}
+
+	dog.WriteContainerState(common.ContainerState_EXITED, exitErr.Error(), dogger.Error, messages...)
+
+	return exitErr
+}
@@ -0,0 +1,40 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestTailLines(t *testing.T) {
+	output := "starting\r\nreading config\nmissing DATABASE_URL\n\n"
+
+	assert.Equal(t, []string{"reading config", "missing DATABASE_URL"}, utils.TailLines(output, 2))
+	assert.Equal(t, []string{"starting", "reading config", "missing DATABASE_URL"}, utils.TailLines(output, 10))
+	assert.Equal(t, []string{"starting", "reading config", "missing DATABASE_URL"}, utils.TailLines(output, 0))
+	assert.Empty(t, utils.TailLines("\n", 5))
+}
+
+func TestContainerExitError(t *testing.T) {
+	exited := &utils.ContainerExitError{
+		Cause:     fmt.Errorf("expected container state failed: %w", utils.ErrContainerExited),
+		Container: "prefix-web",
+		ExitCode:  1,
+	}
+	assert.EqualError(t, exited, "container prefix-web exited with code 1")
+	assert.ErrorIs(t, exited, utils.ErrContainerExited)
+
+	mismatch := &utils.ContainerExitError{
+		Cause:     errors.New("unexpected exit code, actual: 2, expected: 0"),
+		Container: "prefix-migrate",
+		ExitCode:  2,
+	}
+	assert.EqualError(t, mismatch, "unexpected exit code, actual: 2, expected: 0: container prefix-migrate exited with code 2")
+}
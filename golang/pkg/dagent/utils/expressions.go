@@ -0,0 +1,238 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	dockerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+var ErrInvalidExpression = errors.New("invalid expression")
+
+// expressionPattern matches the expressions of the agent only, so the ${VAR} references evaluated by the images
+// themselves are left as they are, an expression with a $ in front of it is escaped, eg. $${node.ip}
+var expressionPattern = regexp.MustCompile(`\$?\$\{(port(?:\([^)}]*\))?|secret:[^}]*|node\.[^}]*|container\.[^}]*)\}`)
+
+// expressionContext is what the expressions of a container are evaluated with, the secrets are the decrypted ones
+type expressionContext struct {
+	secrets       map[string]string
+	nodeIP        string
+	nodeName      string
+	containerName string
+	prefix        string
+	ports         []dockerbuilder.PortBinding
+}
+
+func newExpressionContext(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest,
+	secrets map[string]string,
+) *expressionContext {
+	return &expressionContext{
+		secrets:       secrets,
+		nodeIP:        cfg.NodeIP,
+		nodeName:      cfg.Name,
+		containerName: getContainerName(deployImageRequest),
+		prefix:        getContainerPrefix(deployImageRequest),
+		ports:         deployImageRequest.ContainerConfig.Ports,
+	}
+}
+
+// publishedPort is the host port of the first published port, or of the one of the container port
+func publishedPort(ports []dockerbuilder.PortBinding, containerPort uint16) (uint16, bool) {
+	for _, it := range ports {
+		if it.PortBinding != nil && (containerPort == 0 || it.ExposedPort == containerPort) {
+			return *it.PortBinding, true
+		}
+	}
+
+	return 0, false
+}
+
+// evalPort evaluates port, port(+1) or port(-1) as the first published port with the offset,
+// and port(8080) as the published port of the container port
+func (c *expressionContext) evalPort(argument string) (string, error) {
+	if argument == "" {
+		port, ok := publishedPort(c.ports, 0)
+		if !ok {
+			return "", fmt.Errorf("%w: the container has no published port", ErrInvalidExpression)
+		}
+		return strconv.Itoa(int(port)), nil
+	}
+
+	value, err := strconv.Atoi(argument)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s is not a number", ErrInvalidExpression, argument)
+	}
+
+	if strings.HasPrefix(argument, "+") || strings.HasPrefix(argument, "-") {
+		port, ok := publishedPort(c.ports, 0)
+		if !ok {
+			return "", fmt.Errorf("%w: the container has no published port", ErrInvalidExpression)
+		}
+		if value += int(port); value <= 0 || value > math.MaxUint16 {
+			return "", fmt.Errorf("%w: port %d is out of range", ErrInvalidExpression, value)
+		}
+		return strconv.Itoa(value), nil
+	}
+
+	if value <= 0 || value > math.MaxUint16 {
+		return "", fmt.Errorf("%w: port %d is out of range", ErrInvalidExpression, value)
+	}
+	port, ok := publishedPort(c.ports, uint16(value))
+	if !ok {
+		return "", fmt.Errorf("%w: port %d of the container is not published", ErrInvalidExpression, value)
+	}
+
+	return strconv.Itoa(int(port)), nil
+}
+
+// passedThrough is whether the expression is one of the ${port}, ${node.*} and ${container.*} placeholders the
+// configurations could already contain before the expressions, they are left as they are unless they can be evaluated
+func (c *expressionContext) passedThrough(expression string) bool {
+	switch expression {
+	case "port":
+		_, published := publishedPort(c.ports, 0)
+		return !published
+	case "node.ip", "node.name", "container.name", "container.prefix":
+		return false
+	}
+
+	return strings.HasPrefix(expression, "node.") || strings.HasPrefix(expression, "container.")
+}
+
+// eval evaluates the expression between the braces
+func (c *expressionContext) eval(expression string) (string, error) {
+	if name, found := strings.CutPrefix(expression, "secret:"); found {
+		value, ok := c.secrets[name]
+		if !ok {
+			return "", fmt.Errorf("%w: the container has no secret %s", ErrInvalidExpression, name)
+		}
+		return value, nil
+	}
+
+	if argument, found := strings.CutPrefix(expression, "port"); found {
+		argument = strings.TrimSuffix(strings.TrimPrefix(argument, "("), ")")
+		return c.evalPort(strings.TrimSpace(argument))
+	}
+
+	switch expression {
+	case "node.ip":
+		if c.nodeIP == "" {
+			return "", fmt.Errorf("%w: node.ip needs NODE_IP to be set for the agent", ErrInvalidExpression)
+		}
+		return c.nodeIP, nil
+	case "node.name":
+		return c.nodeName, nil
+	case "container.name":
+		return c.containerName, nil
+	case "container.prefix":
+		return c.prefix, nil
+	}
+
+	return "", fmt.Errorf("%w: unknown expression ${%s}", ErrInvalidExpression, expression)
+}
+
+// evaluate replaces the expressions in the value, the first failing one is returned
+func (c *expressionContext) evaluate(value string) (string, error) {
+	var evalErr error
+	result := expressionPattern.ReplaceAllStringFunc(value, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		expression := match[len("${") : len(match)-len("}")]
+		if c.passedThrough(expression) {
+			return match
+		}
+
+		evaluated, err := c.eval(expression)
+		if err != nil && evalErr == nil {
+			evalErr = err
+		}
+
+		return evaluated
+	})
+
+	return result, evalErr
+}
+
+// evaluateEnvironment evaluates the values of the environment into a new map
+func (c *expressionContext) evaluateEnvironment(environment map[string]string) (map[string]string, error) {
+	evaluated := make(map[string]string, len(environment))
+	for key, value := range environment {
+		result, err := c.evaluate(value)
+		if err != nil {
+			return nil, fmt.Errorf("environment %s: %w", key, err)
+		}
+		evaluated[key] = result
+	}
+
+	return evaluated, nil
+}
+
+func (c *expressionContext) evaluateArgs(args []string) ([]string, error) {
+	if args == nil {
+		return nil, nil
+	}
+
+	evaluated := make([]string, 0, len(args))
+	for i, arg := range args {
+		result, err := c.evaluate(arg)
+		if err != nil {
+			return nil, fmt.Errorf("args[%d]: %w", i, err)
+		}
+		evaluated = append(evaluated, result)
+	}
+
+	return evaluated, nil
+}
+
+// validateExpressions checks the expressions of the request against what is known before the deployment,
+// the node dependent ones are only checked when they are evaluated, the placeholders passed through are accepted
+func validateExpressions(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	containerConfig := &deployImageRequest.ContainerConfig
+
+	secrets := map[string]string{}
+	for name := range containerConfig.Secrets {
+		secrets[name] = ""
+	}
+	expressions := &expressionContext{
+		secrets:  secrets,
+		nodeIP:   "node",
+		ports:    containerConfig.Ports,
+		nodeName: "node",
+	}
+
+	check := func(field, value string) {
+		if _, err := expressions.evaluate(value); err != nil {
+			result.Add(field, err.Error(), "see the expressions in the readme of the agent, escape it as $${...}")
+		}
+	}
+
+	for _, environment := range []struct {
+		values map[string]string
+		field  string
+	}{
+		{values: containerConfig.Environment, field: "ContainerConfig.environment"},
+		{values: deployImageRequest.InstanceConfig.Environment, field: "InstanceConfig.environment"},
+	} {
+		keys := maps.Keys(environment.values)
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			check(fmt.Sprintf("%s.%s", environment.field, key), environment.values[key])
+		}
+	}
+
+	for i, arg := range containerConfig.Args {
+		check(fmt.Sprintf("ContainerConfig.args[%d]", i), arg)
+	}
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+// EvaluateExpressions evaluates the expressions of the environment and the arguments of the request
+func EvaluateExpressions(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest,
+	secrets map[string]string,
+) (environment map[string]string, args []string, err error) {
+	expressions := newExpressionContext(cfg, deployImageRequest, secrets)
+
+	environment, err = expressions.evaluateEnvironment(deployImageRequest.ContainerConfig.Environment)
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err = expressions.evaluateArgs(deployImageRequest.ContainerConfig.Args)
+
+	return environment, args, err
+}
@@ -0,0 +1,94 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func expressionConfig() *config.Configuration {
+	cfg := &config.Configuration{NodeIP: "10.0.0.5"}
+	cfg.Name = "node-1"
+
+	return cfg
+}
+
+func TestEvaluateExpressions(t *testing.T) {
+	req := dagentDeployRequest()
+	req.ContainerConfig.Ports = []builder.PortBinding{
+		{ExposedPort: 80},
+		{ExposedPort: 8080, PortBinding: pointer.ToUint16(18080)},
+		{ExposedPort: 9090, PortBinding: pointer.ToUint16(19090)},
+	}
+	req.ContainerConfig.Environment = map[string]string{
+		"PUBLIC_URL":   "http://${node.ip}:${port}",
+		"METRICS_PORT": "${port(+1)}",
+		"ADMIN_PORT":   "${port(9090)}",
+		"DATABASE_URL": "postgres://app:${secret:DB_PASSWORD}@db/app",
+		"NAME":         "${container.name}@${node.name}",
+		"PREFIX":       "${container.prefix}",
+		"SHELL":        "${HOME}/bin:${PATH}",
+		"ESCAPED":      "$${node.ip}",
+		"ZONE":         "${node.zone}",
+	}
+	req.ContainerConfig.Args = []string{"--port", "${port(-80)}"}
+
+	environment, args, err := utils.EvaluateExpressions(expressionConfig(), req, map[string]string{"DB_PASSWORD": "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"PUBLIC_URL":   "http://10.0.0.5:18080",
+		"METRICS_PORT": "18081",
+		"ADMIN_PORT":   "19090",
+		"DATABASE_URL": "postgres://app:secret@db/app",
+		"NAME":         "prefix-web@node-1",
+		"PREFIX":       "prefix",
+		"SHELL":        "${HOME}/bin:${PATH}",
+		"ESCAPED":      "${node.ip}",
+		"ZONE":         "${node.zone}",
+	}, environment)
+	assert.Equal(t, []string{"--port", "18000"}, args)
+}
+
+func TestEvaluateExpressionsFailure(t *testing.T) {
+	req := dagentDeployRequest()
+	req.ContainerConfig.Environment = map[string]string{"URL": "http://${node.ip}"}
+
+	_, _, err := utils.EvaluateExpressions(&config.Configuration{}, req, nil)
+	assert.ErrorIs(t, err, utils.ErrInvalidExpression)
+}
+
+func TestValidateDeployRequestExpressions(t *testing.T) {
+	req := dagentDeployRequest()
+	req.ContainerConfig.Ports = []builder.PortBinding{{ExposedPort: 8080, PortBinding: pointer.ToUint16(65535)}}
+	req.ContainerConfig.Secrets = map[string]string{"TOKEN": "encrypted"}
+	req.ContainerConfig.Environment = map[string]string{"URL": "http://${node.ip}:${port}", "TOKEN": "${secret:TOKEN}"}
+	assert.NoError(t, utils.ValidateDeployRequest(req))
+
+	// the placeholders of the configurations before the expressions are passed through
+	req.ContainerConfig.Ports = []builder.PortBinding{{ExposedPort: 8080}}
+	req.ContainerConfig.Environment = map[string]string{"PORT": "${port}", "ZONE": "${node.zone}"}
+	req.ContainerConfig.Args = []string{"${container.id}"}
+	assert.NoError(t, utils.ValidateDeployRequest(req))
+
+	req.ContainerConfig.Args = nil
+	req.ContainerConfig.Environment = map[string]string{
+		"A": "${secret:MISSING}",
+		"B": "${port(+1)}",
+		"C": "${port(3000)}",
+		"D": "${port(x)}",
+	}
+	assert.Equal(t, []string{
+		"ContainerConfig.environment.A",
+		"ContainerConfig.environment.B",
+		"ContainerConfig.environment.C",
+		"ContainerConfig.environment.D",
+	}, validationPaths(t, utils.ValidateDeployRequest(req)))
+}
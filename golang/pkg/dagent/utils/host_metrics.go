@@ -0,0 +1,448 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// sysPath is the sys filesystem of the host, the thermal zones are not namespaced
+const sysPath = "/sys"
+
+const (
+	// userHz is the unit of the CPU times in /proc/stat
+	userHz          = 100
+	millidegrees    = 1000
+	loopbackDevice  = "lo"
+	netDevHeaders   = 2
+	netDevTxColumn  = 8
+	netDevMinFields = 11
+)
+
+// cpuModes are the columns of the cpu line of /proc/stat in order, the guest times are part of user and nice
+var cpuModes = []string{"user", "nice", "system", "idle", "iowait", "irq", "softirq", "steal"}
+
+// hostSample is a sample of the host with the CPU times it was taken with, as the CPU usage of the next one
+// is measured from them
+type hostSample struct {
+	message    *agent.HostMetricsMessage
+	cpuSeconds map[string]float64
+}
+
+// HostMetricsCollector samples the CPU, the memory, the disks, the network interfaces and the temperatures of the host,
+// node-exporter style, the network interfaces are the ones of the host if the agent runs in the host network or the proc
+// filesystem of the host is mounted for it, the disks are the ones mounted into the container of the agent
+type HostMetricsCollector struct {
+	last      *hostSample
+	appConfig *config.Configuration
+	proc      string
+	sys       string
+	mutex     sync.Mutex
+}
+
+func NewHostMetricsCollector(cfg *config.Configuration) *HostMetricsCollector {
+	return newHostMetricsCollector(cfg, util.Fallback(cfg.HostMetricsProc, procPath), sysPath)
+}
+
+func newHostMetricsCollector(cfg *config.Configuration, proc, sys string) *HostMetricsCollector {
+	return &HostMetricsCollector{
+		appConfig: cfg,
+		proc:      proc,
+		sys:       sys,
+	}
+}
+
+// Watch reports a sample in every interval until the context is done, it is a no-op if the interval is not positive
+func (c *HostMetricsCollector) Watch(ctx context.Context, report grpc.HostMetricsReportFunc) {
+	if c.appConfig.HostMetricsInterval <= 0 {
+		log.Info().Msg("Streaming the host metrics is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(c.appConfig.HostMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sample, err := c.sample()
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to sample the host")
+				continue
+			}
+
+			report(sample)
+		}
+	}
+}
+
+// WritePrometheus writes a fresh sample, so the counters are current for every scrape
+func (c *HostMetricsCollector) WritePrometheus(_ context.Context, w io.Writer) error {
+	sample, err := c.read(nil)
+	if err != nil {
+		return err
+	}
+
+	return writeHostMetrics(w, sample)
+}
+
+// sample reads the host, its CPU usage is the one since the previous sample
+func (c *HostMetricsCollector) sample() (*agent.HostMetricsMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var previous map[string]float64
+	if c.last != nil {
+		previous = c.last.cpuSeconds
+	}
+
+	sample, err := c.read(previous)
+	if err != nil {
+		return nil, err
+	}
+	c.last = sample
+
+	return sample.message, nil
+}
+
+func (c *HostMetricsCollector) read(previousCPU map[string]float64) (*hostSample, error) {
+	cpuSeconds, err := readCPUSeconds(c.proc)
+	if err != nil {
+		return nil, err
+	}
+
+	loads, err := readLoadAverages(c.proc)
+	if err != nil {
+		return nil, err
+	}
+
+	total, available, err := readMemory(c.proc)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces, err := readNetworkInterfaces(c.proc)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := []*agent.HostDisk{}
+	for _, path := range c.appConfig.HostMetricsDisks {
+		disk, diskErr := readDisk(path)
+		if diskErr != nil {
+			log.Debug().Err(diskErr).Str("path", path).Msg("Failed to read the usage of the disk")
+			continue
+		}
+		disks = append(disks, disk)
+	}
+
+	return &hostSample{
+		message: &agent.HostMetricsMessage{
+			CollectedAt:          timestamppb.Now(),
+			CpuUsage:             cpuUsage(previousCPU, cpuSeconds),
+			CpuCores:             uint32(runtime.NumCPU()),
+			Load1:                loads[0],
+			Load5:                loads[1],
+			Load15:               loads[2],
+			MemoryTotalBytes:     total,
+			MemoryAvailableBytes: available,
+			Disks:                disks,
+			Interfaces:           interfaces,
+			Temperatures:         readTemperatures(c.sys),
+		},
+		cpuSeconds: cpuSeconds,
+	}, nil
+}
+
+// readCPUSeconds reads the seconds the CPUs spent in each mode since the boot, summed up for every CPU
+func readCPUSeconds(proc string) (map[string]float64, error) {
+	file, err := os.Open(filepath.Join(proc, "stat"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// eg. cpu  4705 356 584 3699176 23060 0 277 0 0 0
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "cpu" {
+			continue
+		}
+
+		seconds := map[string]float64{}
+		for i, mode := range cpuModes {
+			if i+1 >= len(fields) {
+				break
+			}
+
+			ticks, parseErr := strconv.ParseUint(fields[i+1], 10, 64)
+			if parseErr != nil {
+				return nil, fmt.Errorf("invalid stat line %q: %w", scanner.Text(), parseErr)
+			}
+			seconds[mode] = float64(ticks) / userHz
+		}
+
+		return seconds, nil
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("no cpu line in %s", filepath.Join(proc, "stat"))
+}
+
+// cpuUsage is the busy fraction of the CPU time between the samples, the one since the boot without a previous sample
+func cpuUsage(previous, current map[string]float64) float64 {
+	var busy, total float64
+	for mode, seconds := range current {
+		delta := seconds - previous[mode]
+		total += delta
+		if mode != "idle" && mode != "iowait" {
+			busy += delta
+		}
+	}
+
+	if total <= 0 {
+		return 0
+	}
+
+	return busy / total
+}
+
+// readLoadAverages reads the 1, 5 and 15 minute load averages
+func readLoadAverages(proc string) ([3]float64, error) {
+	loads := [3]float64{}
+
+	content, err := os.ReadFile(filepath.Join(proc, "loadavg"))
+	if err != nil {
+		return loads, err
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) < len(loads) {
+		return loads, fmt.Errorf("invalid loadavg: %q", content)
+	}
+
+	for i := range loads {
+		if loads[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return loads, fmt.Errorf("invalid loadavg: %q: %w", content, err)
+		}
+	}
+
+	return loads, nil
+}
+
+// netDevPath is the net/dev of the first process, net/dev is the one of the network namespace of the reader,
+// the first process of the proc filesystem of the host is in the network of the host
+func netDevPath(proc string) string {
+	initNetDev := filepath.Join(proc, "1", "net", "dev")
+	if _, err := os.Stat(initNetDev); err == nil {
+		return initNetDev
+	}
+
+	return filepath.Join(proc, "net", "dev")
+}
+
+// readNetworkInterfaces reads the counters of the interfaces from net/dev, except the loopback one
+func readNetworkInterfaces(proc string) ([]*agent.HostNetworkInterface, error) {
+	file, err := os.Open(netDevPath(proc))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	interfaces := []*agent.HostNetworkInterface{}
+	scanner := bufio.NewScanner(file)
+	for line := 0; scanner.Scan(); line++ {
+		if line < netDevHeaders {
+			continue
+		}
+
+		// eg. eth0: 1296 16 0 0 0 0 0 0 1296 16 0 0 0 0 0 0
+		name, counters, found := strings.Cut(scanner.Text(), ":")
+		name = strings.TrimSpace(name)
+		if !found || name == loopbackDevice {
+			continue
+		}
+
+		fields := strings.Fields(counters)
+		if len(fields) < netDevMinFields {
+			return nil, fmt.Errorf("invalid net/dev line %q", scanner.Text())
+		}
+
+		values := make([]uint64, netDevMinFields)
+		for i := range values {
+			if values[i], err = strconv.ParseUint(fields[i], 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid net/dev line %q: %w", scanner.Text(), err)
+			}
+		}
+
+		interfaces = append(interfaces, &agent.HostNetworkInterface{
+			Name:      name,
+			RxBytes:   values[0],
+			RxPackets: values[1],
+			RxErrors:  values[2],
+			TxBytes:   values[netDevTxColumn],
+			TxPackets: values[netDevTxColumn+1],
+			TxErrors:  values[netDevTxColumn+2],
+		})
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].Name < interfaces[j].Name })
+
+	return interfaces, nil
+}
+
+// readTemperatures reads the thermal zones, the unreadable ones are left out, most virtual machines have none
+func readTemperatures(sys string) []*agent.HostTemperature {
+	zones, err := filepath.Glob(filepath.Join(sys, "class", "thermal", "thermal_zone*"))
+	if err != nil {
+		return nil
+	}
+	sort.Strings(zones)
+
+	temperatures := []*agent.HostTemperature{}
+	for _, zone := range zones {
+		temp, err := os.ReadFile(filepath.Join(zone, "temp")) //#nosec G304 -- the zones of the sys filesystem
+		if err != nil {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(string(temp)), 64)
+		if err != nil {
+			continue
+		}
+
+		zoneType, _ := os.ReadFile(filepath.Join(zone, "type")) //#nosec G304 -- the zones of the sys filesystem
+		temperatures = append(temperatures, &agent.HostTemperature{
+			Zone:    filepath.Base(zone),
+			Type:    strings.TrimSpace(string(zoneType)),
+			Celsius: value / millidegrees,
+		})
+	}
+
+	return temperatures
+}
+
+type metricSample struct {
+	labels map[string]string
+	value  float64
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// writeMetric writes a metric family in the Prometheus text format, the ones without samples are left out
+func writeMetric(sb *strings.Builder, name, metricType, help string, samples ...metricSample) {
+	if len(samples) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+	for _, sample := range samples {
+		sb.WriteString(name)
+
+		if len(sample.labels) > 0 {
+			keys := make([]string, 0, len(sample.labels))
+			for key := range sample.labels {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			labels := make([]string, 0, len(keys))
+			for _, key := range keys {
+				labels = append(labels, fmt.Sprintf(`%s="%s"`, key, labelEscaper.Replace(sample.labels[key])))
+			}
+			sb.WriteString("{" + strings.Join(labels, ",") + "}")
+		}
+
+		sb.WriteString(" " + strconv.FormatFloat(sample.value, 'g', -1, 64) + "\n")
+	}
+}
+
+func gauge(value float64) metricSample {
+	return metricSample{value: value}
+}
+
+// writeHostMetrics writes the sample with the names of node-exporter, so its dashboards work with the agent too
+func writeHostMetrics(w io.Writer, sample *hostSample) error {
+	message := sample.message
+	sb := &strings.Builder{}
+
+	cpu := []metricSample{}
+	for _, mode := range cpuModes {
+		if seconds, ok := sample.cpuSeconds[mode]; ok {
+			cpu = append(cpu, metricSample{labels: map[string]string{"mode": mode}, value: seconds})
+		}
+	}
+	writeMetric(sb, "node_cpu_seconds_total", "counter", "Seconds the CPUs spent in each mode.", cpu...)
+	writeMetric(sb, "node_load1", "gauge", "1m load average.", gauge(message.Load1))
+	writeMetric(sb, "node_load5", "gauge", "5m load average.", gauge(message.Load5))
+	writeMetric(sb, "node_load15", "gauge", "15m load average.", gauge(message.Load15))
+	writeMetric(sb, "node_memory_MemTotal_bytes", "gauge", "Memory information field MemTotal_bytes.",
+		gauge(float64(message.MemoryTotalBytes)))
+	writeMetric(sb, "node_memory_MemAvailable_bytes", "gauge", "Memory information field MemAvailable_bytes.",
+		gauge(float64(message.MemoryAvailableBytes)))
+
+	size, free, avail := []metricSample{}, []metricSample{}, []metricSample{}
+	for _, disk := range message.Disks {
+		labels := map[string]string{"mountpoint": disk.Path}
+		size = append(size, metricSample{labels: labels, value: float64(disk.TotalBytes)})
+		free = append(free, metricSample{labels: labels, value: float64(disk.FreeBytes)})
+		avail = append(avail, metricSample{labels: labels, value: float64(disk.AvailableBytes)})
+	}
+	writeMetric(sb, "node_filesystem_size_bytes", "gauge", "Filesystem size in bytes.", size...)
+	writeMetric(sb, "node_filesystem_free_bytes", "gauge", "Filesystem free space in bytes.", free...)
+	writeMetric(sb, "node_filesystem_avail_bytes", "gauge", "Filesystem space available to non-root users in bytes.", avail...)
+
+	counters := map[string][]metricSample{}
+	for _, it := range message.Interfaces {
+		labels := map[string]string{"device": it.Name}
+		for name, value := range map[string]uint64{
+			"receive_bytes":    it.RxBytes,
+			"transmit_bytes":   it.TxBytes,
+			"receive_packets":  it.RxPackets,
+			"transmit_packets": it.TxPackets,
+			"receive_errs":     it.RxErrors,
+			"transmit_errs":    it.TxErrors,
+		} {
+			counters[name] = append(counters[name], metricSample{labels: labels, value: float64(value)})
+		}
+	}
+	for _, name := range []string{"receive_bytes", "transmit_bytes", "receive_packets", "transmit_packets", "receive_errs", "transmit_errs"} {
+		writeMetric(sb, "node_network_"+name+"_total", "counter",
+			fmt.Sprintf("Network device statistic %s.", name), counters[name]...)
+	}
+
+	temperatures := []metricSample{}
+	for _, it := range message.Temperatures {
+		temperatures = append(temperatures, metricSample{labels: map[string]string{"zone": it.Zone, "type": it.Type}, value: it.Celsius})
+	}
+	writeMetric(sb, "node_thermal_zone_temp", "gauge", "Zone temperature in Celsius.", temperatures...)
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
@@ -0,0 +1,27 @@
+//go:build !windows
+// +build !windows
+
+package utils
+
+import (
+	"syscall"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// readDisk reads the usage of the filesystem of the path, the host ones need to be mounted into the agent
+func readDisk(path string) (*agent.HostDisk, error) {
+	stat := syscall.Statfs_t{}
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return nil, err
+	}
+
+	blockSize := uint64(stat.Bsize) //nolint:unconvert // int64 on linux, uint32 on darwin
+
+	return &agent.HostDisk{
+		Path:           path,
+		TotalBytes:     stat.Blocks * blockSize,
+		FreeBytes:      stat.Bfree * blockSize,
+		AvailableBytes: stat.Bavail * blockSize,
+	}, nil
+}
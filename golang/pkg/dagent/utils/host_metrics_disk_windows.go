@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package utils
+
+import (
+	"errors"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func readDisk(_ string) (*agent.HostDisk, error) {
+	return nil, errors.New("reading the usage of the disks is not supported on windows")
+}
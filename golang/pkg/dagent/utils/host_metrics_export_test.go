@@ -0,0 +1,7 @@
+package utils
+
+var (
+	NewHostMetricsCollectorAt = newHostMetricsCollector
+	SampleHostMetrics         = (*HostMetricsCollector).sample
+	CPUUsage                  = cpuUsage
+)
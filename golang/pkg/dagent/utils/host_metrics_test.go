@@ -0,0 +1,164 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const netDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1296      16    0    0    0     0          0         0     1296      16    0    0    0     0       0          0
+  eth0: 5000000    4000    2    0    0     0          0         0  3000000    2000    1    0    0     0       0          0
+docker0:     100       1    0    0    0     0          0         0      200       2    0    0    0     0       0          0
+`
+
+func writeHostProc(t *testing.T, proc string, user, idle int) {
+	stat := fmt.Sprintf("cpu  %d 0 100 %d 0 0 0 0 0 0\ncpu0 %d 0 100 %d 0 0 0 0 0 0\n", user, idle, user, idle)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(proc, "net"), 0o700))
+	for name, content := range map[string]string{
+		"stat":    stat,
+		"loadavg": "1.50 0.75 0.25 1/234 5678\n",
+		"meminfo": "MemTotal:        1000000 kB\nMemFree:          100000 kB\nMemAvailable:     250000 kB\n",
+		"net/dev": netDev,
+	} {
+		assert.NoError(t, os.WriteFile(filepath.Join(proc, name), []byte(content), 0o600))
+	}
+}
+
+func writeThermalZone(t *testing.T, sys, zone, zoneType, temp string) {
+	dir := filepath.Join(sys, "class", "thermal", zone)
+	assert.NoError(t, os.MkdirAll(dir, 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "type"), []byte(zoneType+"\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "temp"), []byte(temp+"\n"), 0o600))
+}
+
+func hostMetricsConfig(disks ...string) *config.Configuration {
+	return &config.Configuration{
+		HostMetricsInterval: 10 * time.Millisecond,
+		HostMetricsDisks:    disks,
+	}
+}
+
+func TestSampleHostMetrics(t *testing.T) {
+	proc, sys := t.TempDir(), t.TempDir()
+	writeHostProc(t, proc, 100, 800)
+	writeThermalZone(t, sys, "thermal_zone0", "x86_pkg_temp", "45500")
+	writeThermalZone(t, sys, "thermal_zone1", "acpitz", "not a number")
+
+	collector := utils.NewHostMetricsCollectorAt(hostMetricsConfig("/", "/no/such/disk"), proc, sys)
+
+	sample, err := utils.SampleHostMetrics(collector)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.2, sample.CpuUsage, 0.001)
+	assert.InDelta(t, 1.5, sample.Load1, 0.001)
+	assert.InDelta(t, 0.75, sample.Load5, 0.001)
+	assert.InDelta(t, 0.25, sample.Load15, 0.001)
+	assert.Equal(t, uint64(1000000*1024), sample.MemoryTotalBytes)
+	assert.Equal(t, uint64(250000*1024), sample.MemoryAvailableBytes)
+
+	assert.Len(t, sample.Interfaces, 2)
+	assert.Equal(t, "docker0", sample.Interfaces[0].Name)
+	assert.Equal(t, "eth0", sample.Interfaces[1].Name)
+	assert.Equal(t, uint64(5000000), sample.Interfaces[1].RxBytes)
+	assert.Equal(t, uint64(3000000), sample.Interfaces[1].TxBytes)
+	assert.Equal(t, uint64(2), sample.Interfaces[1].RxErrors)
+	assert.Equal(t, uint64(2000), sample.Interfaces[1].TxPackets)
+
+	assert.Len(t, sample.Disks, 1)
+	assert.Equal(t, "/", sample.Disks[0].Path)
+	assert.Greater(t, sample.Disks[0].TotalBytes, uint64(0))
+
+	assert.Len(t, sample.Temperatures, 1)
+	assert.Equal(t, "x86_pkg_temp", sample.Temperatures[0].Type)
+	assert.InDelta(t, 45.5, sample.Temperatures[0].Celsius, 0.001)
+
+	// measured from the previous sample, 300 busy of 400
+	writeHostProc(t, proc, 400, 900)
+	sample, err = utils.SampleHostMetrics(collector)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.75, sample.CpuUsage, 0.001)
+
+	_, err = utils.SampleHostMetrics(utils.NewHostMetricsCollectorAt(hostMetricsConfig(), t.TempDir(), sys))
+	assert.Error(t, err)
+}
+
+func TestCPUUsage(t *testing.T) {
+	assert.InDelta(t, 0.5, utils.CPUUsage(nil, map[string]float64{"user": 1, "iowait": 0.5, "idle": 0.5}), 0.001)
+	assert.Zero(t, utils.CPUUsage(map[string]float64{"user": 1, "idle": 1}, map[string]float64{"user": 1, "idle": 1}))
+}
+
+func TestHostMetricsWatch(t *testing.T) {
+	proc := t.TempDir()
+	writeHostProc(t, proc, 100, 800)
+	collector := utils.NewHostMetricsCollectorAt(hostMetricsConfig(), proc, t.TempDir())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	samples := make(chan *agent.HostMetricsMessage, 1)
+	go collector.Watch(ctx, func(message *agent.HostMetricsMessage) {
+		select {
+		case samples <- message:
+		default:
+		}
+	})
+
+	select {
+	case sample := <-samples:
+		assert.InDelta(t, 1.5, sample.Load1, 0.001)
+	case <-time.After(time.Second):
+		t.Fatal("no sample was reported")
+	}
+	cancel()
+}
+
+func TestHostMetricsWritePrometheus(t *testing.T) {
+	proc, sys := t.TempDir(), t.TempDir()
+	writeHostProc(t, proc, 100, 800)
+	writeThermalZone(t, sys, "thermal_zone0", `x86"pkg`, "45500")
+
+	collector := utils.NewHostMetricsCollectorAt(hostMetricsConfig("/"), proc, sys)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, collector.WritePrometheus(context.Background(), buffer))
+
+	metrics := buffer.String()
+	assert.Contains(t, metrics, "# TYPE node_cpu_seconds_total counter\n")
+	assert.Contains(t, metrics, `node_cpu_seconds_total{mode="user"} 1`+"\n")
+	assert.Contains(t, metrics, `node_cpu_seconds_total{mode="idle"} 8`+"\n")
+	assert.Contains(t, metrics, "node_load1 1.5\n")
+	assert.Contains(t, metrics, "node_memory_MemAvailable_bytes 2.56e+08\n")
+	assert.Contains(t, metrics, `node_network_receive_bytes_total{device="eth0"} 5e+06`+"\n")
+	assert.Contains(t, metrics, `node_filesystem_size_bytes{mountpoint="/"}`)
+	assert.Contains(t, metrics, `node_thermal_zone_temp{type="x86\"pkg",zone="thermal_zone0"} 45.5`+"\n")
+	assert.NotContains(t, metrics, `device="lo"`)
+}
+
+func TestHostMetricsNetworkOfTheHost(t *testing.T) {
+	proc := t.TempDir()
+	writeHostProc(t, proc, 100, 800)
+
+	// the net/dev of the first process of the proc of the host is the one of the host network
+	hostNetDev := strings.Replace(netDev, "eth0: 5000000", "eth0: 7000000", 1)
+	assert.NoError(t, os.MkdirAll(filepath.Join(proc, "1", "net"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(proc, "1", "net", "dev"), []byte(hostNetDev), 0o600))
+
+	buffer := &bytes.Buffer{}
+	collector := utils.NewHostMetricsCollectorAt(hostMetricsConfig(), proc, t.TempDir())
+	assert.NoError(t, collector.WritePrometheus(context.Background(), buffer))
+	assert.Contains(t, buffer.String(), `node_network_receive_bytes_total{device="eth0"} 7e+06`+"\n")
+}
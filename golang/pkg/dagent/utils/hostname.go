@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/docker/docker/api/types/container"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+var ErrInvalidHostname = errors.New("invalid hostname")
+
+// hostnameVariables are the node variables the hostname and the domain name templates can use,
+// so the names stay stable on a node across deployments
+type hostnameVariables struct {
+	Node       string
+	Prefix     string
+	Container  string
+	RootDomain string
+}
+
+func renderHostnameTemplate(field, text string, vars *hostnameVariables) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return strings.ToLower(text), nil
+	}
+
+	tmpl, err := template.New(field).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s template: %w", ErrInvalidHostname, field, err)
+	}
+
+	var rendered strings.Builder
+	if err = tmpl.Execute(&rendered, vars); err != nil {
+		return "", fmt.Errorf("%w: %s template: %w", ErrInvalidHostname, field, err)
+	}
+
+	return strings.ToLower(rendered.String()), nil
+}
+
+// containerHostname renders the hostname and the domain name of the container, an empty hostname
+// leaves the name of the container as the hostname
+func containerHostname(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest) (hostname, domainname string, err error) {
+	containerConfig := &deployImageRequest.ContainerConfig
+	vars := &hostnameVariables{
+		Node:       cfg.Name,
+		Prefix:     getContainerPrefix(deployImageRequest),
+		Container:  containerConfig.Container,
+		RootDomain: cfg.RootDomain,
+	}
+
+	hostname, err = renderHostnameTemplate("hostname", containerConfig.Hostname, vars)
+	if err != nil {
+		return "", "", err
+	}
+	domainname, err = renderHostnameTemplate("domainname", containerConfig.Domainname, vars)
+	if err != nil {
+		return "", "", err
+	}
+
+	if hostname != "" {
+		if errs := validation.IsDNS1123Label(hostname); len(errs) > 0 {
+			return "", "", fmt.Errorf("%w: %s: %s", ErrInvalidHostname, hostname, strings.Join(errs, ", "))
+		}
+	}
+	if domainname != "" {
+		if errs := validation.IsDNS1123Subdomain(domainname); len(errs) > 0 {
+			return "", "", fmt.Errorf("%w: domain name %s: %s", ErrInvalidHostname, domainname, strings.Join(errs, ", "))
+		}
+	}
+
+	if (hostname != "" || domainname != "") && container.NetworkMode(containerConfig.NetworkMode).IsContainer() {
+		return "", "", fmt.Errorf("%w: the container shares the network of another container, it can not have its own hostname",
+			ErrInvalidHostname)
+	}
+
+	return hostname, domainname, nil
+}
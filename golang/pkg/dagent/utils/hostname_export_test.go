@@ -0,0 +1,3 @@
+package utils
+
+var ContainerHostname = containerHostname
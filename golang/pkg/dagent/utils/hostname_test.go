@@ -0,0 +1,60 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	dagentConfig "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func hostnameRequest(hostname, domainname string) *v1.DeployImageRequest {
+	return &v1.DeployImageRequest{
+		InstanceConfig: v1.InstanceConfig{ContainerPreName: "prod"},
+		ContainerConfig: v1.ContainerConfig{
+			Container:  "license",
+			Hostname:   hostname,
+			Domainname: domainname,
+		},
+	}
+}
+
+func TestContainerHostname(t *testing.T) {
+	cfg := &dagentConfig.Configuration{CommonConfiguration: config.CommonConfiguration{Name: "Edge-1", RootDomain: "example.com"}}
+
+	hostname, domainname, err := utils.ContainerHostname(cfg, hostnameRequest("", ""))
+	assert.NoError(t, err)
+	assert.Empty(t, hostname)
+	assert.Empty(t, domainname)
+
+	hostname, domainname, err = utils.ContainerHostname(cfg,
+		hostnameRequest("{{ .Node }}-{{ .Container }}", "{{ .Prefix }}.{{ .RootDomain }}"))
+	assert.NoError(t, err)
+	assert.Equal(t, "edge-1-license", hostname)
+	assert.Equal(t, "prod.example.com", domainname)
+}
+
+func TestContainerHostnameValidation(t *testing.T) {
+	cfg := &dagentConfig.Configuration{CommonConfiguration: config.CommonConfiguration{Name: "edge"}}
+
+	cases := map[string]*v1.DeployImageRequest{
+		"unknown variable": hostnameRequest("{{ .Missing }}", ""),
+		"invalid template": hostnameRequest("{{ .Node", ""),
+		"dots in hostname": hostnameRequest("license.example.com", ""),
+		"invalid domain":   hostnameRequest("license", "example_com"),
+	}
+	shared := hostnameRequest("license", "")
+	shared.ContainerConfig.NetworkMode = "container:monitored"
+	cases["shared network"] = shared
+
+	for name, req := range cases {
+		_, _, err := utils.ContainerHostname(cfg, req)
+		assert.ErrorIs(t, err, utils.ErrInvalidHostname, name)
+	}
+}
@@ -8,17 +8,30 @@
 
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/client"
-	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
-	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 )
 
+// rcloneBandwidthLimitEnv is the transfer limit of the default import image in bytes per second
+const rcloneBandwidthLimitEnv = "RCLONE_BWLIMIT"
+
+// importEnvironment limits the transfer of the import container to the bandwidth of the agent,
+// unless the request sets its own limit
+func importEnvironment(environments map[string]string, limiter *rate.Limiter) []string {
+	env := EnvMapToSlice(environments)
+	if _, ok := environments[rcloneBandwidthLimitEnv]; ok || limiter == nil {
+		return env
+	}
+
+	return append(env, fmt.Sprintf("%s=%dB", rcloneBandwidthLimitEnv, int64(limiter.Limit())))
+}
+
 func checkIfTargetVolumeIsThere(mountList []mount.Mount, targetVolumeName string) (int, error) {
 	for i := range mountList {
 		if strings.Contains(mountList[i].Source, targetVolumeName) {
@@ -45,13 +58,14 @@ func spawnImportContainer(
 	importContainerName := util.JoinV("-", name, "import")
 	targetVolume := mount.Mount{Type: mount.TypeBind, Source: mountList[targetVolumeIndex].Source, Target: "/data/output"}
 
-	cont, waitResult, err := builder.
+	res, err := builder.
 		WithClient(cli).
-		WithImage(cfg.ImportContainerImage).
+		WithImage(cfg.ImageRewriteRules.Rewrite(cfg.ImportContainerImage)).
 		WithCmd(strings.Split(importContainer.Command, " ")).
 		WithName(importContainerName).
-		WithEnv(EnvMapToSlice(importContainer.Environments)).
+		WithEnv(importEnvironment(importContainer.Environments, cfg.BandwidthLimiter)).
 		WithMountPoints([]mount.Mount{targetVolume}).
+		WithPullLimiter(cfg.BandwidthLimiter).
 		WithoutConflict().
 		WithLogWriter(dog).
 		WithPreStartHooks(func(_ context.Context, _ client.APIClient,
@@ -60,19 +74,14 @@ func spawnImportContainer(
 			dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Waiting for import container to finish")
 			return nil
 		}).
-		CreateAndStartWaitUntilExit()
+		RunAndCapture(ctx)
 	if err != nil {
 		return err
 	}
 
-	if waitResult.StatusCode == 0 {
-		containerID := *cont.GetContainerID()
-		err = dockerHelper.DeleteContainerByID(ctx, dog, containerID)
-		if err != nil {
-			log.Warn().Msg("Failed to delete import container after completion")
-		}
-	} else {
-		return fmt.Errorf("import container exited with code: %v", waitResult.StatusCode)
+	if res.ExitCode != 0 {
+		dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, res.Lines()...)
+		return fmt.Errorf("import container exited with code: %v", res.ExitCode)
 	}
 	return nil
 }
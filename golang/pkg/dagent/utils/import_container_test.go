@@ -9,6 +9,8 @@
 	"github.com/docker/docker/api/types/mount"
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/helper/bandwidth"
 )
 
 func TestCheckIfTargetVolumeIsThere(t *testing.T) {
@@ -31,3 +33,15 @@ func TestCheckIfTargetVolumeIsThere_NotThere(t *testing.T) {
 	assert.Equal(t, -1, index)
 	assert.Error(t, err, "import container target volume is not enlisted")
 }
+
+func TestImportEnvironment(t *testing.T) {
+	environments := map[string]string{"RCLONE_CONFIG_S3_TYPE": "s3"}
+
+	assert.Equal(t, []string{"RCLONE_CONFIG_S3_TYPE=s3"}, importEnvironment(environments, nil))
+	assert.ElementsMatch(t, []string{"RCLONE_CONFIG_S3_TYPE=s3", "RCLONE_BWLIMIT=1048576B"},
+		importEnvironment(environments, bandwidth.NewLimiter(1<<20)))
+
+	environments["RCLONE_BWLIMIT"] = "10M"
+	assert.ElementsMatch(t, []string{"RCLONE_CONFIG_S3_TYPE=s3", "RCLONE_BWLIMIT=10M"},
+		importEnvironment(environments, bandwidth.NewLimiter(1<<20)))
+}
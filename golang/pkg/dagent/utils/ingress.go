@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// the last configuration of the platform, traefik is restored from it when the agent starts
+const ingressConfigFileName = "ingress.json"
+
+var ErrInvalidIngressConfig = errors.New("invalid ingress configuration")
+
+// IngressManager runs the traefik of the node with the configuration of the platform, instead of the environment
+// of the agent, the container is recreated when the configuration or the version changes
+type IngressManager struct {
+	appConfig *config.Configuration
+	cli       client.APIClient
+	mutex     sync.Mutex
+}
+
+func NewIngressManager(cfg *config.Configuration) *IngressManager {
+	return &IngressManager{appConfig: cfg}
+}
+
+func (m *IngressManager) client() (client.APIClient, error) {
+	if m.cli != nil {
+		return m.cli, nil
+	}
+
+	return client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+}
+
+// traefikDeployRequestOf maps the configuration of the platform, the unset ports fall back to the defaults of traefik
+func traefikDeployRequestOf(req *agent.IngressConfigRequest) (*TraefikDeployRequest, error) {
+	for _, port := range []uint32{req.GetPort(), req.GetTlsPort(), req.GetDashboardPort()} {
+		if port > math.MaxUint16 {
+			return nil, fmt.Errorf("%w: the port %d is out of range", ErrInvalidIngressConfig, port)
+		}
+	}
+
+	deployReq := &TraefikDeployRequest{
+		LogLevel:      util.Fallback(req.GetLogLevel(), "INFO"),
+		AcmeMail:      req.GetAcmeEmail(),
+		Version:       req.GetVersion(),
+		AcmeStorage:   req.GetAcmeStorage(),
+		Port:          TraefikHTTPPort,
+		DashboardPort: uint16(req.GetDashboardPort()), //#nosec G115 -- checked above
+	}
+	if req.GetPort() != 0 {
+		deployReq.Port = uint16(req.GetPort()) //#nosec G115 -- checked above
+	}
+	if req.TlsPort != nil {
+		deployReq.TLS = true
+		deployReq.TLSPort = uint16(req.GetTlsPort()) //#nosec G115 -- checked above
+		if deployReq.TLSPort == 0 {
+			deployReq.TLSPort = TraefikHTTPSPort
+		}
+		if deployReq.AcmeMail == "" {
+			return nil, fmt.Errorf("%w: the ACME email is required for TLS", ErrInvalidIngressConfig)
+		}
+	}
+	if filepath.IsAbs(deployReq.AcmeStorage) || !filepath.IsLocal(util.Fallback(deployReq.AcmeStorage, ".")) {
+		return nil, fmt.Errorf("%w: the ACME storage has to be inside the data path: %s", ErrInvalidIngressConfig, deployReq.AcmeStorage)
+	}
+
+	for _, it := range req.GetEntrypoints() {
+		if it.GetName() == "" || it.GetPort() == 0 {
+			return nil, fmt.Errorf("%w: entrypoint %q without a name or a port", ErrInvalidIngressConfig, it.GetName())
+		}
+		if it.GetPort() > math.MaxUint16 {
+			return nil, fmt.Errorf("%w: the port %d of the entrypoint %q is out of range", ErrInvalidIngressConfig, it.GetPort(), it.GetName())
+		}
+		if deployReq.Entrypoints == nil {
+			deployReq.Entrypoints = map[string]uint16{}
+		}
+		deployReq.Entrypoints[it.GetName()] = uint16(it.GetPort()) //#nosec G115 -- checked above
+	}
+
+	return deployReq, nil
+}
+
+// ingressHash is the content hash of the request, map keys are sorted by the encoder so it is stable
+func ingressHash(deployReq *TraefikDeployRequest) (string, error) {
+	content, err := json.Marshal(deployReq)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content)), nil
+}
+
+func (m *IngressManager) configPath() string {
+	return filepath.Join(m.appConfig.InternalMountPath, "traefik", ingressConfigFileName)
+}
+
+func (m *IngressManager) saveConfig(deployReq *TraefikDeployRequest) error {
+	content, err := json.MarshalIndent(deployReq, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filePath := m.configPath()
+	if err = os.MkdirAll(filepath.Dir(filePath), dirPerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, content, snapshotFilePerm)
+}
+
+func (m *IngressManager) loadConfig() (*TraefikDeployRequest, error) {
+	content, err := os.ReadFile(m.configPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	deployReq := &TraefikDeployRequest{}
+	if err = json.Unmarshal(content, deployReq); err != nil {
+		return nil, fmt.Errorf("invalid ingress configuration %s: %w", m.configPath(), err)
+	}
+
+	return deployReq, nil
+}
+
+// apply recreates traefik unless it runs with the same configuration, the result tells if it was recreated
+func (m *IngressManager) apply(ctx context.Context, cli client.APIClient, deployReq *TraefikDeployRequest) (string, bool, error) {
+	hash, err := ingressHash(deployReq)
+	if err != nil {
+		return "", false, err
+	}
+
+	current, err := dockerHelper.GetContainerByName(ctx, cli, traefikContainerName)
+	if err != nil {
+		return "", false, err
+	}
+	if current != nil && current.State == "running" && current.Labels[label.DyrectorioOrg+label.IngressHash] == hash {
+		return current.ID, false, nil
+	}
+
+	builder, err := traefikBuilder(ctx, deployReq, m.appConfig)
+	if err != nil {
+		return "", false, err
+	}
+
+	cont, err := builder.
+		WithClient(cli).
+		WithLabels(map[string]string{label.DyrectorioOrg + label.IngressHash: hash}).
+		CreateAndStart()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to start traefik: %w", err)
+	}
+
+	log.Info().Str("version", util.Fallback(deployReq.Version, defaultTraefikVersion)).Msg("Managed Traefik started")
+
+	return *cont.GetContainerID(), true, nil
+}
+
+// remove removes traefik and its configuration, so it is not restored, the result tells if it was running
+func (m *IngressManager) remove(ctx context.Context, cli client.APIClient) (bool, error) {
+	if err := os.Remove(m.configPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	current, err := dockerHelper.GetContainerByName(ctx, cli, traefikContainerName)
+	if err != nil || current == nil {
+		return false, err
+	}
+
+	if err = cli.ContainerRemove(ctx, current.ID, container.RemoveOptions{Force: true}); err != nil {
+		return false, fmt.Errorf("failed to remove traefik: %w", err)
+	}
+
+	log.Info().Msg("Managed Traefik removed")
+
+	return true, nil
+}
+
+// Apply runs traefik with the configuration of the platform, the configuration is kept for the restarts of the agent
+func (m *IngressManager) Apply(ctx context.Context, req *agent.IngressConfigRequest) (*agent.IngressConfigResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cli, err := m.client()
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.GetEnabled() {
+		changed, removeErr := m.remove(ctx, cli)
+		if removeErr != nil {
+			return nil, removeErr
+		}
+
+		return &agent.IngressConfigResponse{Changed: changed}, nil
+	}
+
+	deployReq, err := traefikDeployRequestOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	containerID, changed, err := m.apply(ctx, cli, deployReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.saveConfig(deployReq); err != nil {
+		return nil, fmt.Errorf("failed to save the ingress configuration: %w", err)
+	}
+
+	return &agent.IngressConfigResponse{
+		Enabled:     true,
+		Version:     util.Fallback(deployReq.Version, defaultTraefikVersion),
+		Changed:     changed,
+		ContainerId: &containerID,
+	}, nil
+}
+
+// Restore starts traefik with the last configuration of the platform, if there is one, before the agent connects
+func (m *IngressManager) Restore(ctx context.Context) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	deployReq, err := m.loadConfig()
+	if err != nil || deployReq == nil {
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to restore the managed Traefik")
+		}
+		return
+	}
+
+	cli, err := m.client()
+	if err == nil {
+		_, _, err = m.apply(ctx, cli, deployReq)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to restore the managed Traefik")
+	}
+}
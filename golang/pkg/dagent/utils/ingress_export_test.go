@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"github.com/docker/docker/client"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+var (
+	TraefikDeployRequestOf = traefikDeployRequestOf
+	TraefikCommand         = traefikCommand
+)
+
+func NewIngressManagerWithClient(cfg *config.Configuration, cli client.APIClient) *IngressManager {
+	return &IngressManager{appConfig: cfg, cli: cli}
+}
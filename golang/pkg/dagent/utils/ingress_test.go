@@ -0,0 +1,137 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestTraefikDeployRequestOf(t *testing.T) {
+	deployReq, err := utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{
+		Enabled:       true,
+		Version:       "v2.11",
+		TlsPort:       pointer.ToUint32(0),
+		AcmeEmail:     pointer.ToString("admin@example.com"),
+		AcmeStorage:   pointer.ToString("acme"),
+		DashboardPort: pointer.ToUint32(8080),
+		Entrypoints:   []*agent.IngressEntrypoint{{Name: "metrics", Port: 8082}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(80), deployReq.Port)
+	assert.Equal(t, uint16(443), deployReq.TLSPort)
+	assert.True(t, deployReq.TLS)
+	assert.Equal(t, "INFO", deployReq.LogLevel)
+
+	command := utils.TraefikCommand(deployReq)
+	assert.Contains(t, command, "--entryPoints.web.address=:80")
+	assert.Contains(t, command, "--entryPoints.websecure.address=:443")
+	assert.Contains(t, command, "--entryPoints.metrics.address=:8082")
+	assert.Contains(t, command, "--entryPoints.traefik.address=:8080")
+	assert.Contains(t, command, "--api.dashboard=true")
+
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{Enabled: true, TlsPort: pointer.ToUint32(443)})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{Enabled: true, AcmeStorage: pointer.ToString("../etc")})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{Enabled: true, AcmeStorage: pointer.ToString("/etc")})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{
+		Enabled:     true,
+		Entrypoints: []*agent.IngressEntrypoint{{Name: "metrics"}},
+	})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+
+	// the ports are not truncated to 16 bits
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{Enabled: true, Port: 65616})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{
+		Enabled: true, AcmeEmail: pointer.ToString("admin@example.com"), TlsPort: pointer.ToUint32(70000),
+	})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{Enabled: true, DashboardPort: pointer.ToUint32(1 << 16)})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+	_, err = utils.TraefikDeployRequestOf(&agent.IngressConfigRequest{
+		Enabled:     true,
+		Entrypoints: []*agent.IngressEntrypoint{{Name: "metrics", Port: 100000}},
+	})
+	assert.ErrorIs(t, err, utils.ErrInvalidIngressConfig)
+}
+
+func TestTraefikCommandWithoutDashboard(t *testing.T) {
+	command := utils.TraefikCommand(&utils.TraefikDeployRequest{LogLevel: "INFO", Port: 80})
+	assert.NotContains(t, command, "--api.dashboard=true")
+	assert.NotContains(t, command, "--entryPoints.websecure.address=:0")
+}
+
+func TestIngressManagerLifecycle(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cfg := &config.Configuration{InternalMountPath: dir, DataMountPath: dir, HostDockerSockPath: "/var/run/docker.sock"}
+	docker := dockerfake.New()
+	docker.AddImage("index.docker.io/library/traefik:v2.10")
+	docker.AddImage("index.docker.io/library/traefik:v2.11")
+	ingress := utils.NewIngressManagerWithClient(cfg, docker)
+
+	req := &agent.IngressConfigRequest{Enabled: true, Version: "v2.10", Port: 8000}
+	resp, err := ingress.Apply(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, resp.GetEnabled())
+	assert.True(t, resp.GetChanged())
+	assert.Equal(t, "v2.10", resp.GetVersion())
+	assert.FileExists(t, filepath.Join(dir, "traefik", "ingress.json"))
+
+	inspect, err := docker.ContainerInspect(ctx, "traefik")
+	assert.NoError(t, err)
+	assert.Equal(t, "index.docker.io/library/traefik:v2.10", inspect.Config.Image)
+	assert.NotEmpty(t, inspect.Config.Labels[label.DyrectorioOrg+label.IngressHash])
+
+	// the same configuration leaves the container as is
+	resp, err = ingress.Apply(ctx, req)
+	assert.NoError(t, err)
+	assert.False(t, resp.GetChanged())
+	assert.Equal(t, inspect.ID, resp.GetContainerId())
+	assert.Equal(t, 1, docker.CallCount("ContainerCreate"))
+
+	// an upgrade recreates it
+	req.Version = "v2.11"
+	resp, err = ingress.Apply(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, resp.GetChanged())
+	assert.NotEqual(t, inspect.ID, resp.GetContainerId())
+	upgraded, err := docker.ContainerInspect(ctx, "traefik")
+	assert.NoError(t, err)
+	assert.Equal(t, "index.docker.io/library/traefik:v2.11", upgraded.Config.Image)
+
+	// a new agent restores the last configuration
+	assert.NoError(t, docker.ContainerRemove(ctx, "traefik", container.RemoveOptions{Force: true}))
+	utils.NewIngressManagerWithClient(cfg, docker).Restore(ctx)
+	restored, err := docker.ContainerInspect(ctx, "traefik")
+	assert.NoError(t, err)
+	assert.Equal(t, "index.docker.io/library/traefik:v2.11", restored.Config.Image)
+
+	resp, err = ingress.Apply(ctx, &agent.IngressConfigRequest{Enabled: false})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetChanged())
+	_, err = docker.ContainerInspect(ctx, "traefik")
+	assert.Error(t, err)
+	_, err = os.Stat(filepath.Join(dir, "traefik", "ingress.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	resp, err = ingress.Apply(ctx, &agent.IngressConfigRequest{Enabled: false})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetChanged())
+}
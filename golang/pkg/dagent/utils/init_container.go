@@ -6,21 +6,24 @@
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
-	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/client"
-	"github.com/rs/zerolog/log"
 )
 
 type InitContainerConfig struct {
-	ParentName string
-	MountMap   map[string]mount.Mount
-	EnvList    map[string]string
-	Networks   []string
+	// RegistryAuth is the credentials of the deployment, used only for the images of the same registry
+	RegistryAuth    *imageHelper.RegistryAuth
+	MountMap        map[string]mount.Mount
+	EnvList         map[string]string
+	ParentName      string
+	DeploymentImage string
+	Networks        []string
 }
 
 // before application container starts, launches an init container
@@ -29,6 +32,7 @@ func spawnInitContainer(
 	initCont *InitContainerConfig,
 	config *v1.InitContainer,
 	dog *dogger.DeploymentLogger,
+	cfg *config.Configuration,
 ) error {
 	initContName := util.JoinV("-", initCont.ParentName, config.Name)
 	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, fmt.Sprintf("Spawning init container: %s", initContName))
@@ -50,37 +54,34 @@ func spawnInitContainer(
 		)
 	}
 
-	resultCont, waitResult, err := builder.
+	image := cfg.ImageRewriteRules.Rewrite(config.Image)
+	res, err := builder.
 		WithClient(cli).
-		WithImage(config.Image).
+		WithImage(image).
+		WithRegistryAuth(initContainerRegistryAuth(image, initCont.DeploymentImage, initCont.RegistryAuth)).
 		WithEntrypoint(config.Command).
 		WithCmd(config.Args).
 		WithName(initContName).
 		WithEnv(MergeStringMapToUniqueSlice(initCont.EnvList, config.Envs)).
 		WithMountPoints(targetVolumes).
 		WithNetworks(initCont.Networks).
+		WithPullLimiter(cfg.BandwidthLimiter).
 		WithoutConflict().
 		WithPreStartHooks(
 			func(_ context.Context, _ client.APIClient, _ containerbuilder.ParentContainer) error {
 				dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Waiting for init container to finish")
 				return nil
 			}).
-		WithLogWriter(dog).CreateAndStartWaitUntilExit()
+		WithLogWriter(dog).RunAndCapture(ctx)
 	if err != nil {
 		return err
 	}
 
 	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS,
-		fmt.Sprintf("Init container (%v) exited with status %v, output:", initContName, waitResult.StatusCode))
-	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, waitResult.Logs...)
-	if waitResult.StatusCode == 0 {
-		containerID := *resultCont.GetContainerID()
-		err = dockerHelper.DeleteContainerByID(ctx, dog, containerID)
-		if err != nil {
-			log.Warn().Msg("Failed to delete init container after completion")
-		}
-	} else {
-		return fmt.Errorf("init container exited with code: %v", waitResult.StatusCode)
+		fmt.Sprintf("Init container (%v) exited with status %v, output:", initContName, res.ExitCode))
+	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, res.Lines()...)
+	if res.ExitCode != 0 {
+		return fmt.Errorf("init container exited with code: %v", res.ExitCode)
 	}
 	return nil
 }
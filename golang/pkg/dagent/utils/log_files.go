@@ -0,0 +1,386 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/rs/zerolog/log"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+const (
+	logFilePollInterval = time.Second
+	// logFileTailBytes limits how far back the tail of a log file is read
+	logFileTailBytes = 1 << 20
+)
+
+// inMountTarget is true if the folder of the glob is in the mount target, so the files are written to the mount
+func inMountTarget(glob, target string) bool {
+	return strings.HasPrefix(path.Dir(glob)+"/", strings.TrimSuffix(path.Clean(target), "/")+"/")
+}
+
+// logFileMount is the source and the target of the longest mount target containing the folder of the glob,
+// the target is empty if there is none
+func logFileMount(mounts []string, glob string) (source, target string) {
+	for _, it := range mounts {
+		mountSource, mountTarget, found := strings.Cut(it, "|")
+		if found && mountTarget != "" && inMountTarget(glob, mountTarget) && len(mountTarget) > len(target) {
+			source, target = mountSource, path.Clean(mountTarget)
+		}
+	}
+
+	return source, target
+}
+
+// validateLogFiles checks that the log files are in the mounts of the container, so the agent can read them on the host
+func validateLogFiles(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	containerConfig := &deployImageRequest.ContainerConfig
+	mounts := append(append([]string{}, containerConfig.Mounts...), volumesToMounts(containerConfig.Volumes)...)
+
+	for i, glob := range containerConfig.LogFiles {
+		field := fmt.Sprintf("ContainerConfig.logFiles[%d]", i)
+
+		if !path.IsAbs(glob) || path.Clean(glob) != glob {
+			result.Add(field, fmt.Sprintf("%s is not a clean absolute path", glob), "eg. /app/logs/*.log")
+			continue
+		}
+		if strings.Contains(glob, ",") {
+			result.Add(field, fmt.Sprintf("%s contains a comma", glob), "match the comma with ? instead")
+			continue
+		}
+		if _, err := path.Match(glob, ""); err != nil {
+			result.Add(field, fmt.Sprintf("%s is not a valid glob: %s", glob, err.Error()), "")
+			continue
+		}
+		source, target := logFileMount(mounts, glob)
+		if target == "" {
+			result.Add(field, fmt.Sprintf("%s is not in a mount of the container", glob), "mount the folder of the log files")
+			continue
+		}
+		// the agent only reaches the volumes in its data folder, the host paths would be skipped silently
+		if isAbsHostPath(source, false) || isAbsHostPath(source, true) {
+			result.Add(field, fmt.Sprintf("%s is in the host path %s, the agent can only read the log files of the volumes", glob, source),
+				"mount a volume of the container to the folder of the log files")
+		}
+	}
+}
+
+// logFileGlobs maps the log file globs of the container to the mount of the data folder of the agent,
+// the ones in other mounts are left out, as the agent can not read them
+func logFileGlobs(cfg *config.Configuration, labels map[string]string, mounts []types.MountPoint) []string {
+	value, ok := GetOrganizationLabel(labels, label.LogFiles)
+	if !ok || value == "" {
+		return nil
+	}
+
+	dataPath := filepath.Clean(cfg.DataMountPath)
+	globs := []string{}
+	for _, glob := range strings.Split(value, ",") {
+		var source, target string
+		for _, it := range mounts {
+			if it.Type == mount.TypeBind && inMountTarget(glob, it.Destination) && len(it.Destination) > len(target) {
+				source, target = it.Source, path.Clean(it.Destination)
+			}
+		}
+
+		relative, err := filepath.Rel(dataPath, filepath.Clean(source))
+		if source == "" || err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			log.Warn().Str("glob", glob).Str("source", source).Msg("Log files are not in the data folder of the agent, skipping")
+			continue
+		}
+
+		globs = append(globs, filepath.Join(cfg.InternalMountPath, relative, filepath.FromSlash(strings.TrimPrefix(glob, target))))
+	}
+
+	return globs
+}
+
+// fileLogReader tails the log files matching the globs, the new files matching them are followed too.
+// A file shorter than its last read offset was truncated or rotated, so it is read from the start again.
+type fileLogReader struct {
+	events   chan grpc.ContainerLogEvent
+	done     chan struct{}
+	offsets  map[string]int64
+	globs    []string
+	interval time.Duration
+	once     sync.Once
+	tail     uint32
+	follow   bool
+}
+
+func newFileLogReader(globs []string, tail uint32, follow bool, interval time.Duration) *fileLogReader {
+	reader := &fileLogReader{
+		events:   make(chan grpc.ContainerLogEvent),
+		done:     make(chan struct{}),
+		offsets:  map[string]int64{},
+		globs:    globs,
+		interval: interval,
+		tail:     tail,
+		follow:   follow,
+	}
+
+	// the lines written after the reader is created are followed
+	files := reader.files()
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil && info.Mode().IsRegular() {
+			reader.offsets[file] = info.Size()
+		}
+	}
+
+	go reader.run(files)
+
+	return reader
+}
+
+func (r *fileLogReader) Next() <-chan grpc.ContainerLogEvent {
+	return r.events
+}
+
+func (r *fileLogReader) Close() error {
+	r.once.Do(func() {
+		close(r.done)
+	})
+
+	return nil
+}
+
+func (r *fileLogReader) send(event grpc.ContainerLogEvent) bool {
+	select {
+	case r.events <- event:
+		return true
+	case <-r.done:
+		return false
+	}
+}
+
+func (r *fileLogReader) files() []string {
+	files := []string{}
+	for _, glob := range r.globs {
+		matches, err := filepath.Glob(glob)
+		if err != nil {
+			continue
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	return files
+}
+
+func (r *fileLogReader) run(files []string) {
+	for _, file := range files {
+		if !r.readTail(file) {
+			return
+		}
+	}
+
+	if !r.follow {
+		r.send(grpc.ContainerLogEvent{Error: io.EOF})
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+
+		for _, file := range r.files() {
+			if !r.readNew(file) {
+				return
+			}
+		}
+	}
+}
+
+// readTail sends the last lines of the file before its offset, the result is false if the reader is closed
+func (r *fileLogReader) readTail(file string) bool {
+	size, known := r.offsets[file]
+	if !known || r.tail == 0 {
+		return true
+	}
+
+	start := max(size-logFileTailBytes, 0)
+	data, end, err := readLogFile(file, start, size)
+	if err != nil {
+		log.Warn().Err(err).Str("file", file).Msg("Failed to read log file")
+		return true
+	}
+	r.offsets[file] = end
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if start > 0 && len(lines) > 0 {
+		// the first line is cut
+		lines = lines[1:]
+	}
+	if len(lines) > int(r.tail) {
+		lines = lines[len(lines)-int(r.tail):]
+	}
+
+	return r.sendLines(file, lines)
+}
+
+// readNew sends the lines written to the file since the last read, the result is false if the reader is closed
+func (r *fileLogReader) readNew(file string) bool {
+	info, err := os.Stat(file)
+	if err != nil || !info.Mode().IsRegular() {
+		return true
+	}
+
+	offset, known := r.offsets[file]
+	if !known || info.Size() < offset {
+		offset = 0
+	}
+	if info.Size() == offset {
+		r.offsets[file] = offset
+		return true
+	}
+
+	data, end, err := readLogFile(file, offset, info.Size())
+	if err != nil {
+		log.Warn().Err(err).Str("file", file).Msg("Failed to read log file")
+		return true
+	}
+	r.offsets[file] = end
+	if len(data) == 0 {
+		return true
+	}
+
+	return r.sendLines(file, strings.Split(strings.TrimSuffix(string(data), "\n"), "\n"))
+}
+
+func (r *fileLogReader) sendLines(file string, lines []string) bool {
+	name := filepath.Base(file)
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		message := fmt.Sprintf("%s [%s] %s\n", time.Now().UTC().Format(time.RFC3339Nano), name, strings.TrimSuffix(line, "\r"))
+		if !r.send(grpc.ContainerLogEvent{Message: message}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readLogFile reads the complete lines between the offsets, end is the offset after the last line break
+func readLogFile(file string, start, size int64) (data []byte, end int64, err error) {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return nil, start, err
+	}
+	defer f.Close()
+
+	data = make([]byte, size-start)
+	n, err := f.ReadAt(data, start)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, start, err
+	}
+	data = data[:n]
+
+	last := bytes.LastIndexByte(data, '\n')
+	if last < 0 {
+		return nil, start, nil
+	}
+
+	return data[:last+1], start + int64(last) + 1, nil
+}
+
+// mergedLogReader sends the events of the readers as they come, it ends when every reader ended
+type mergedLogReader struct {
+	events  chan grpc.ContainerLogEvent
+	done    chan struct{}
+	readers []grpc.ContainerLogReader
+	once    sync.Once
+}
+
+func mergeLogReaders(ctx context.Context, readers ...grpc.ContainerLogReader) *mergedLogReader {
+	merged := &mergedLogReader{
+		events:  make(chan grpc.ContainerLogEvent),
+		done:    make(chan struct{}),
+		readers: readers,
+	}
+
+	var wg sync.WaitGroup
+	for _, it := range readers {
+		wg.Add(1)
+		go func(reader grpc.ContainerLogReader) {
+			defer wg.Done()
+			merged.forward(ctx, reader)
+		}(it)
+	}
+
+	go func() {
+		wg.Wait()
+		merged.send(grpc.ContainerLogEvent{Error: io.EOF})
+	}()
+
+	return merged
+}
+
+func (m *mergedLogReader) forward(ctx context.Context, reader grpc.ContainerLogReader) {
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ctx.Done():
+			m.send(grpc.ContainerLogEvent{Error: ctx.Err()})
+			return
+		case event := <-reader.Next():
+			if errors.Is(event.Error, io.EOF) {
+				return
+			}
+			if !m.send(event) || event.Error != nil {
+				return
+			}
+		}
+	}
+}
+
+func (m *mergedLogReader) send(event grpc.ContainerLogEvent) bool {
+	select {
+	case m.events <- event:
+		return true
+	case <-m.done:
+		return false
+	}
+}
+
+func (m *mergedLogReader) Next() <-chan grpc.ContainerLogEvent {
+	return m.events
+}
+
+func (m *mergedLogReader) Close() error {
+	m.once.Do(func() {
+		close(m.done)
+	})
+
+	errs := []error{}
+	for _, it := range m.readers {
+		errs = append(errs, it.Close())
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+var LogFileGlobs = logFileGlobs
+
+func NewFileLogReader(globs []string, tail uint32, follow bool, interval time.Duration) grpc.ContainerLogReader {
+	return newFileLogReader(globs, tail, follow, interval)
+}
+
+func MergeLogReaders(readers ...grpc.ContainerLogReader) grpc.ContainerLogReader {
+	return mergeLogReaders(context.Background(), readers...)
+}
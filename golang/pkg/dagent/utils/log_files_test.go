@@ -0,0 +1,124 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	dagentConfig "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestValidateDeployRequestLogFiles(t *testing.T) {
+	req := dagentDeployRequest()
+	req.ContainerConfig.Mounts = []string{"logs|/app/logs", "/var/log/nginx|/var/log/nginx", `C:\logs|/app/win`}
+	req.ContainerConfig.LogFiles = []string{"/app/logs/*.log", "/app/logs/nested/app.log"}
+	assert.NoError(t, utils.ValidateDeployRequest(req))
+
+	req.ContainerConfig.LogFiles = []string{"app/*.log", "/app/logs/a,b.log", "/app/logs/[.log", "/var/log/app.log", "/app/logsx/app.log",
+		"/var/log/nginx/access.log", "/app/win/*.log",
+	}
+	assert.Equal(t, []string{
+		"ContainerConfig.logFiles[0]",
+		"ContainerConfig.logFiles[1]",
+		"ContainerConfig.logFiles[2]",
+		"ContainerConfig.logFiles[3]",
+		"ContainerConfig.logFiles[4]",
+		"ContainerConfig.logFiles[5]",
+		"ContainerConfig.logFiles[6]",
+	}, validationPaths(t, utils.ValidateDeployRequest(req)))
+}
+
+func TestLogFileGlobs(t *testing.T) {
+	cfg := &dagentConfig.Configuration{DataMountPath: "/srv/dagent", InternalMountPath: "/internal"}
+	labels := map[string]string{label.DyrectorioOrg + label.LogFiles: "/app/logs/*.log,/var/log/app.log,/data/app.log"}
+	mounts := []types.MountPoint{
+		{Type: mount.TypeBind, Source: "/srv/dagent/prefix/api/logs", Destination: "/app/logs"},
+		{Type: mount.TypeBind, Source: "/var/log", Destination: "/var/log"},
+		{Type: mount.TypeVolume, Source: "/var/lib/docker/volumes/data/_data", Destination: "/data"},
+	}
+
+	assert.Equal(t, []string{"/internal/prefix/api/logs/*.log"}, utils.LogFileGlobs(cfg, labels, mounts))
+	assert.Empty(t, utils.LogFileGlobs(cfg, map[string]string{}, mounts))
+}
+
+func readLogEvents(t *testing.T, reader grpc.ContainerLogReader, count int) []string {
+	t.Helper()
+
+	messages := []string{}
+	for len(messages) < count {
+		select {
+		case event := <-reader.Next():
+			if !assert.NoError(t, event.Error) {
+				return messages
+			}
+			_, text, _ := strings.Cut(event.Message, " ")
+			messages = append(messages, text)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "timed out waiting for log lines")
+			return messages
+		}
+	}
+
+	return messages
+}
+
+func TestFileLogReaderTail(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "app.log"), []byte("first\nsecond\nthird\npartial"), 0o600))
+
+	reader := utils.NewFileLogReader([]string{filepath.Join(dir, "*.log")}, 2, false, time.Millisecond)
+	defer reader.Close()
+
+	assert.Equal(t, []string{"[app.log] second\n", "[app.log] third\n"}, readLogEvents(t, reader, 2))
+	assert.True(t, errors.Is((<-reader.Next()).Error, io.EOF))
+}
+
+func TestFileLogReaderFollow(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.log")
+	assert.NoError(t, os.WriteFile(file, []byte("old\n"), 0o600))
+
+	reader := utils.NewFileLogReader([]string{filepath.Join(dir, "*.log")}, 0, true, 10*time.Millisecond)
+	defer reader.Close()
+
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0o600)
+	assert.NoError(t, err)
+	_, err = f.WriteString("new\n")
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	assert.Equal(t, []string{"[app.log] new\n"}, readLogEvents(t, reader, 1))
+
+	// rotated, the file is read from the start again
+	assert.NoError(t, os.WriteFile(file, []byte("\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "other.log"), []byte("other\n"), 0o600))
+	assert.Equal(t, []string{"[other.log] other\n"}, readLogEvents(t, reader, 1))
+}
+
+func TestMergeLogReaders(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.log"), []byte("a\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "b.log"), []byte("b\n"), 0o600))
+
+	reader := utils.MergeLogReaders(
+		utils.NewFileLogReader([]string{filepath.Join(dir, "a.log")}, 10, false, time.Millisecond),
+		utils.NewFileLogReader([]string{filepath.Join(dir, "b.log")}, 10, false, time.Millisecond),
+	)
+	defer reader.Close()
+
+	assert.ElementsMatch(t, []string{"[a.log] a\n", "[b.log] b\n"}, readLogEvents(t, reader, 2))
+	assert.True(t, errors.Is((<-reader.Next()).Error, io.EOF))
+}
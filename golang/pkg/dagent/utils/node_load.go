@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// procPath is the proc filesystem of the host, the load average and the meminfo are not namespaced,
+// so the agent container sees the ones of the host
+const procPath = "/proc"
+
+const kibibyte = 1024
+
+// the defaults of the env-default tags, used if the load guard is configured with zero durations,
+// a zero timeout would fail the deployments on the first check and a zero interval would spin
+const (
+	defaultLoadGuardInterval = 15 * time.Second
+	defaultLoadGuardTimeout  = 10 * time.Minute
+)
+
+var ErrNodeOverloaded = errors.New("node is overloaded")
+
+// NodeLoad is the pressure of the host the deployments are started on
+type NodeLoad struct {
+	Load1           float64
+	MemoryTotal     uint64
+	MemoryAvailable uint64
+	CPUs            int
+}
+
+// LoadPerCPU is the 1 minute load average divided by the number of CPUs
+func (l *NodeLoad) LoadPerCPU() float64 {
+	return l.Load1 / float64(max(l.CPUs, 1))
+}
+
+// MemoryUsed is the used fraction of the memory, the page cache counts as available
+func (l *NodeLoad) MemoryUsed() float64 {
+	if l.MemoryTotal == 0 {
+		return 0
+	}
+
+	return 1 - float64(l.MemoryAvailable)/float64(l.MemoryTotal)
+}
+
+// overloaded returns why new deployments have to wait, it is empty if the load is under the thresholds
+func (l *NodeLoad) overloaded(cfg *config.Configuration) string {
+	reasons := []string{}
+	if cfg.LoadGuardMaxLoad > 0 && l.LoadPerCPU() > cfg.LoadGuardMaxLoad {
+		reasons = append(reasons, fmt.Sprintf("load per CPU %.2f is above %.2f", l.LoadPerCPU(), cfg.LoadGuardMaxLoad))
+	}
+	if cfg.LoadGuardMaxMemory > 0 && l.MemoryUsed() > cfg.LoadGuardMaxMemory {
+		reasons = append(reasons, fmt.Sprintf("memory usage %.0f%% is above %.0f%%",
+			l.MemoryUsed()*100, cfg.LoadGuardMaxMemory*100)) //nolint:gomnd
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
+func readLoadAverage(proc string) (float64, error) {
+	content, err := os.ReadFile(filepath.Join(proc, "loadavg"))
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid loadavg: %q", content)
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// readMemory returns the total and the available memory in bytes
+func readMemory(proc string) (total, available uint64, err error) {
+	file, err := os.Open(filepath.Join(proc, "meminfo"))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// eg. MemAvailable:   12345678 kB
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		var target *uint64
+		switch fields[0] {
+		case "MemTotal:":
+			target = &total
+		case "MemAvailable:":
+			target = &available
+		default:
+			continue
+		}
+
+		value, parseErr := strconv.ParseUint(fields[1], 10, 64)
+		if parseErr != nil {
+			return 0, 0, fmt.Errorf("invalid meminfo line %q: %w", scanner.Text(), parseErr)
+		}
+		*target = value * kibibyte
+	}
+
+	return total, available, scanner.Err()
+}
+
+// readNodeLoad reads the load from the proc filesystem, see procPath
+func readNodeLoad(proc string) (*NodeLoad, error) {
+	load1, err := readLoadAverage(proc)
+	if err != nil {
+		return nil, err
+	}
+
+	total, available, err := readMemory(proc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeLoad{Load1: load1, MemoryTotal: total, MemoryAvailable: available, CPUs: runtime.NumCPU()}, nil
+}
+
+func loadGuardEnabled(cfg *config.Configuration) bool {
+	return cfg.LoadGuardMaxLoad > 0 || cfg.LoadGuardMaxMemory > 0
+}
+
+// waitForNodeLoad defers the deployment while the load of the node is above the thresholds of the load guard,
+// it fails after LoadGuardTimeout, so a deployment does not wait for a node that never calms down
+func waitForNodeLoad(ctx context.Context, cfg *config.Configuration, dog *dogger.DeploymentLogger, proc string) error {
+	if !loadGuardEnabled(cfg) {
+		return nil
+	}
+
+	interval := cfg.LoadGuardInterval
+	if interval <= 0 {
+		interval = defaultLoadGuardInterval
+	}
+	timeout := cfg.LoadGuardTimeout
+	if timeout <= 0 {
+		timeout = defaultLoadGuardTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deferred := false
+	for {
+		load, err := readNodeLoad(proc)
+		if err != nil {
+			// the guard must not block the deployments of nodes without a readable proc filesystem
+			log.Warn().Err(err).Msg("Failed to read the node load, deploying without the load guard")
+			return nil
+		}
+
+		reason := load.overloaded(cfg)
+		if reason == "" {
+			if deferred {
+				dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Node load is back under the thresholds, resuming")
+			}
+			return nil
+		}
+
+		if !deferred {
+			dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Deferred due to load: "+reason)
+			deferred = true
+		} else {
+			log.Debug().Str("reason", reason).Msg("Deployment is still deferred due to load")
+		}
+
+		select {
+		case <-ctx.Done():
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ctx.Err()
+			}
+			return fmt.Errorf("%w, deferred for %s: %s", ErrNodeOverloaded, timeout, reason)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package utils
+
+var (
+	ReadNodeLoad    = readNodeLoad
+	WaitForNodeLoad = waitForNodeLoad
+)
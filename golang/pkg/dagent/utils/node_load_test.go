@@ -0,0 +1,105 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func writeProc(t *testing.T, proc string, load1 float64, availableKiB int) {
+	loadavg := fmt.Sprintf("%.2f 0.50 0.25 1/234 5678\n", load1)
+	meminfo := fmt.Sprintf("MemTotal:        1000000 kB\nMemFree:          100000 kB\nMemAvailable:    %7d kB\n", availableKiB)
+
+	// renamed into place, so the guard never reads a partially written file
+	for name, content := range map[string]string{"loadavg": loadavg, "meminfo": meminfo} {
+		assert.NoError(t, os.WriteFile(filepath.Join(proc, name+".tmp"), []byte(content), 0o600))
+		assert.NoError(t, os.Rename(filepath.Join(proc, name+".tmp"), filepath.Join(proc, name)))
+	}
+}
+
+func TestReadNodeLoad(t *testing.T) {
+	proc := t.TempDir()
+	writeProc(t, proc, float64(runtime.NumCPU()), 250000)
+
+	load, err := utils.ReadNodeLoad(proc)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, load.LoadPerCPU(), 0.01)
+	assert.Equal(t, uint64(1000000*1024), load.MemoryTotal)
+	assert.InDelta(t, 0.75, load.MemoryUsed(), 0.001)
+
+	_, err = utils.ReadNodeLoad(t.TempDir())
+	assert.Error(t, err)
+}
+
+func loadGuardConfig() *config.Configuration {
+	return &config.Configuration{
+		LoadGuardMaxLoad:   2,
+		LoadGuardMaxMemory: 0.9,
+		LoadGuardInterval:  10 * time.Millisecond,
+		LoadGuardTimeout:   time.Second,
+	}
+}
+
+func TestWaitForNodeLoad(t *testing.T) {
+	proc := t.TempDir()
+	writeProc(t, proc, 0.1, 50000)
+	dog := dogger.NewDeploymentLogger(context.Background(), nil, nil, nil)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		writeProc(t, proc, 0.1, 500000)
+	}()
+
+	assert.NoError(t, utils.WaitForNodeLoad(context.Background(), loadGuardConfig(), dog, proc))
+	assert.Contains(t, dog.GetLogs(), "Deferred due to load: memory usage 95% is above 90%")
+	assert.Contains(t, dog.GetLogs(), "Node load is back under the thresholds, resuming")
+}
+
+func TestWaitForNodeLoadTimeout(t *testing.T) {
+	proc := t.TempDir()
+	writeProc(t, proc, float64(3*runtime.NumCPU()), 500000)
+	cfg := loadGuardConfig()
+	cfg.LoadGuardTimeout = 50 * time.Millisecond
+
+	err := utils.WaitForNodeLoad(context.Background(), cfg, dogger.NewDeploymentLogger(context.Background(), nil, nil, nil), proc)
+	assert.ErrorIs(t, err, utils.ErrNodeOverloaded)
+}
+
+func TestWaitForNodeLoadZeroTimeout(t *testing.T) {
+	proc := t.TempDir()
+	writeProc(t, proc, 0.1, 50000)
+	cfg := loadGuardConfig()
+	cfg.LoadGuardTimeout = 0
+	dog := dogger.NewDeploymentLogger(context.Background(), nil, nil, nil)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		writeProc(t, proc, 0.1, 500000)
+	}()
+
+	// the default timeout applies, the deployment is not failed on the first check
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	assert.NoError(t, utils.WaitForNodeLoad(ctx, cfg, dog, proc))
+	assert.Contains(t, dog.GetLogs(), "Deferred due to load: memory usage 95% is above 90%")
+}
+
+func TestWaitForNodeLoadDisabled(t *testing.T) {
+	cfg := &config.Configuration{}
+
+	// the proc filesystem is not read without thresholds
+	assert.NoError(t, utils.WaitForNodeLoad(context.Background(), cfg, nil, t.TempDir()))
+}
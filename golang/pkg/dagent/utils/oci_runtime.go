@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"golang.org/x/exp/maps"
+)
+
+var ErrRuntimeNotConfigured = errors.New("runtime is not configured on the daemon")
+
+// daemonRuntimes lists the OCI runtimes configured on the daemon, eg. runc, nvidia or kata
+func daemonRuntimes(ctx context.Context, cli client.APIClient) ([]string, error) {
+	info, err := cli.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimes := maps.Keys(info.Runtimes)
+	sort.Strings(runtimes)
+
+	return runtimes, nil
+}
+
+// checkRuntime is the preflight of the runtime of the container, the daemon would only fail the create
+// with an unknown runtime, after the old container is removed
+func checkRuntime(ctx context.Context, cli client.APIClient, runtime string) error {
+	if runtime == "" {
+		return nil
+	}
+
+	runtimes, err := daemonRuntimes(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("could not query the runtimes of the daemon: %w", err)
+	}
+
+	if !slices.Contains(runtimes, runtime) {
+		return fmt.Errorf("%w: %s, the configured runtimes are: %s", ErrRuntimeNotConfigured, runtime, strings.Join(runtimes, ", "))
+	}
+
+	return nil
+}
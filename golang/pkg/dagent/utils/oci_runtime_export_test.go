@@ -0,0 +1,6 @@
+package utils
+
+var (
+	DaemonRuntimes = daemonRuntimes
+	CheckRuntime   = checkRuntime
+)
@@ -0,0 +1,31 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+func TestCheckRuntime(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.Runtimes = []string{"runc", "nvidia", "kata"}
+
+	runtimes, err := utils.DaemonRuntimes(ctx, docker)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kata", "nvidia", "runc"}, runtimes)
+
+	assert.NoError(t, utils.CheckRuntime(ctx, docker, ""))
+	assert.NoError(t, utils.CheckRuntime(ctx, docker, "nvidia"))
+
+	err = utils.CheckRuntime(ctx, docker, "runsc")
+	assert.ErrorIs(t, err, utils.ErrRuntimeNotConfigured)
+	assert.ErrorContains(t, err, "the configured runtimes are: kata, nvidia, runc")
+}
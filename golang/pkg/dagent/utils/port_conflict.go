@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+
+	dockerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+const firstUnprivilegedPort = 1024
+
+// publishedProtocol is the protocol of the host ports published by the container builder
+const publishedProtocol = "tcp"
+
+// PortConflictError is returned by the deployment preflight when a requested host port
+// is already published by another container, it carries everything needed to fix the config
+type PortConflictError struct {
+	Container     string
+	ContainerID   string
+	HostPort      uint16
+	SuggestedPort uint16
+	PID           int
+}
+
+func (e *PortConflictError) Error() string {
+	holder := fmt.Sprintf("container %s (%s)", e.Container, e.ContainerID)
+	if e.PID > 0 {
+		holder = fmt.Sprintf("%s, host pid %d", holder, e.PID)
+	}
+
+	suggestion := "no free port was found to suggest"
+	if e.SuggestedPort != 0 {
+		suggestion = fmt.Sprintf("port %d is free", e.SuggestedPort)
+	}
+
+	return fmt.Sprintf("host port %d is already allocated by %s, %s", e.HostPort, holder, suggestion)
+}
+
+// requestedHostPorts collects the host side of the port bindings and port ranges
+func requestedHostPorts(ports []dockerbuilder.PortBinding, portRanges []dockerbuilder.PortRangeBinding) []uint16 {
+	requested := []uint16{}
+	for _, port := range ports {
+		if port.PortBinding != nil && *port.PortBinding != 0 {
+			requested = append(requested, *port.PortBinding)
+		}
+	}
+
+	for _, portRange := range portRanges {
+		for port := uint32(portRange.External.From); port <= uint32(portRange.External.To); port++ {
+			requested = append(requested, uint16(port))
+		}
+	}
+
+	return requested
+}
+
+// findPortConflicts matches the requested host ports with the ports published by the running containers
+// on the same protocol, the suggested ports avoid every published and requested port, including the ones
+// suggested before
+func findPortConflicts(requested []uint16, running []types.Container, containerName string) []*PortConflictError {
+	holders := map[uint16]*types.Container{}
+	taken := map[uint16]bool{}
+	for i := range running {
+		if isNamed(&running[i], containerName) {
+			continue
+		}
+
+		for _, port := range running[i].Ports {
+			if port.PublicPort != 0 && port.Type == publishedProtocol {
+				holders[port.PublicPort] = &running[i]
+				taken[port.PublicPort] = true
+			}
+		}
+	}
+
+	for _, port := range requested {
+		taken[port] = true
+	}
+
+	conflicts := []*PortConflictError{}
+	for _, port := range requested {
+		holder, ok := holders[port]
+		if !ok {
+			continue
+		}
+
+		suggested := suggestFreePort(port, taken)
+		if suggested != 0 {
+			taken[suggested] = true
+		}
+
+		conflicts = append(conflicts, &PortConflictError{
+			Container:     containerDisplayName(holder),
+			ContainerID:   holder.ID,
+			HostPort:      port,
+			SuggestedPort: suggested,
+		})
+	}
+
+	return conflicts
+}
+
+// suggestFreePort returns the closest port above the conflicting one that is not taken,
+// wrapping around to the unprivileged range, 0 means there is none
+func suggestFreePort(port uint16, taken map[uint16]bool) uint16 {
+	for candidate := uint32(port) + 1; candidate <= math.MaxUint16; candidate++ {
+		if !taken[uint16(candidate)] {
+			return uint16(candidate)
+		}
+	}
+
+	for candidate := uint16(firstUnprivilegedPort); candidate < port; candidate++ {
+		if !taken[candidate] {
+			return candidate
+		}
+	}
+
+	return 0
+}
+
+func isNamed(cont *types.Container, name string) bool {
+	for _, containerName := range cont.Names {
+		if strings.TrimPrefix(containerName, "/") == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containerDisplayName(cont *types.Container) string {
+	if len(cont.Names) == 0 {
+		return cont.ID
+	}
+
+	return strings.TrimPrefix(cont.Names[0], "/")
+}
+
+// checkPortConflicts fails the deployment before creating the container if one of its host ports is
+// published by another running container
+func checkPortConflicts(ctx context.Context, cli client.APIClient, containerName string,
+	ports []dockerbuilder.PortBinding, portRanges []dockerbuilder.PortRangeBinding,
+) error {
+	requested := requestedHostPorts(ports, portRanges)
+	if len(requested) == 0 {
+		return nil
+	}
+
+	running, err := cli.ContainerList(ctx, container.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list containers for the port check: %w", err)
+	}
+
+	conflicts := findPortConflicts(requested, running, containerName)
+	errs := []error{}
+	for _, conflict := range conflicts {
+		inspect, inspectErr := cli.ContainerInspect(ctx, conflict.ContainerID)
+		if inspectErr == nil && inspect.State != nil {
+			conflict.PID = inspect.State.Pid
+		}
+
+		errs = append(errs, conflict)
+	}
+
+	return errors.Join(errs...)
+}
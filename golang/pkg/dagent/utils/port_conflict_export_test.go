@@ -0,0 +1,7 @@
+package utils
+
+var (
+	RequestedHostPorts = requestedHostPorts
+	FindPortConflicts  = findPortConflicts
+	SuggestFreePort    = suggestFreePort
+)
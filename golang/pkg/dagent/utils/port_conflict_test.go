@@ -0,0 +1,73 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	dockerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestRequestedHostPorts(t *testing.T) {
+	ports := []dockerbuilder.PortBinding{
+		{ExposedPort: 80, PortBinding: pointer.ToUint16(8080)},
+		{ExposedPort: 443},
+	}
+	portRanges := []dockerbuilder.PortRangeBinding{
+		{
+			Internal: dockerbuilder.PortRange{From: 9000, To: 9001},
+			External: dockerbuilder.PortRange{From: 19000, To: 19001},
+		},
+	}
+
+	assert.Equal(t, []uint16{8080, 19000, 19001}, utils.RequestedHostPorts(ports, portRanges))
+}
+
+func TestFindPortConflicts(t *testing.T) {
+	running := []types.Container{
+		{
+			ID:    "db",
+			Names: []string{"/prefix-db"},
+			Ports: []types.Port{{PrivatePort: 5432, PublicPort: 5432, Type: "tcp"}},
+		},
+		{
+			ID:    "proxy",
+			Names: []string{"/proxy"},
+			Ports: []types.Port{
+				{PrivatePort: 80, PublicPort: 8080, Type: "tcp"},
+				{PrivatePort: 81, PublicPort: 8081, Type: "tcp"},
+				{PrivatePort: 82, Type: "tcp"},
+			},
+		},
+		{
+			ID:    "dns",
+			Names: []string{"/dns"},
+			Ports: []types.Port{{PrivatePort: 53, PublicPort: 8084, Type: "udp"}},
+		},
+		{
+			ID:    "old",
+			Names: []string{"/prefix-web"},
+			Ports: []types.Port{{PrivatePort: 80, PublicPort: 9090, Type: "tcp"}},
+		},
+	}
+
+	conflicts := utils.FindPortConflicts([]uint16{8080, 8082, 8084, 9090}, running, "prefix-web")
+
+	assert.Len(t, conflicts, 1, "the container being replaced and the udp ports are not conflicts")
+	assert.Equal(t, "proxy", conflicts[0].Container)
+	assert.Equal(t, uint16(8080), conflicts[0].HostPort)
+	assert.Equal(t, uint16(8083), conflicts[0].SuggestedPort, "published and requested ports are skipped")
+	assert.EqualError(t, conflicts[0], "host port 8080 is already allocated by container proxy (proxy), port 8083 is free")
+}
+
+func TestSuggestFreePort(t *testing.T) {
+	assert.Equal(t, uint16(8081), utils.SuggestFreePort(8080, map[uint16]bool{8080: true}))
+	assert.Equal(t, uint16(1024), utils.SuggestFreePort(math.MaxUint16, map[uint16]bool{math.MaxUint16: true}))
+}
@@ -24,6 +24,22 @@ func PreflightChecks() {
 		log.Fatal().Stack().Err(err).Send()
 	}
 
+	windows, err := isWindowsDaemon(ctx, cli)
+	if err != nil {
+		log.Fatal().Stack().Err(err).Send()
+	}
+	if windows {
+		log.Info().Msg("Windows daemon detected, using Windows container options")
+	}
+
+	// the runtimes are only listed for information, the deployments check them again
+	runtimes, err := daemonRuntimes(ctx, cli)
+	if err != nil {
+		log.Warn().Stack().Err(err).Msg("Failed to list the container runtimes of the daemon")
+	} else {
+		log.Info().Strs("runtimes", runtimes).Msg("Container runtimes of the daemon")
+	}
+
 	_, err = containerRuntime.VersionCheck(ctx, cli)
 	if err != nil {
 		if errors.Is(err, containerRuntime.ErrServerIsOutdated) {
@@ -33,3 +49,15 @@ func PreflightChecks() {
 		}
 	}
 }
+
+// DockerReady pings the docker daemon, it is the runtime check of the readiness probe
+func DockerReady(ctx context.Context) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	_, err = cli.Ping(ctx)
+	return err
+}
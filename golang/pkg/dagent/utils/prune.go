@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const (
+	// staleCreatedContainer is how long a container may stay created before it counts as abandoned,
+	// deployments start their containers right after creating them
+	staleCreatedContainer = time.Hour
+	// anonymousVolumeLabel is set by the engine on the volumes it creates for a container
+	anonymousVolumeLabel = "com.docker.volume.anonymous"
+	danglingImageTag     = "<none>:<none>"
+	unknownPruneSize     = -1
+)
+
+var anonymousVolumeName = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func pruneTargetSet(targets []agent.PruneTarget) map[agent.PruneTarget]bool {
+	set := map[agent.PruneTarget]bool{}
+	for _, it := range targets {
+		set[it] = true
+	}
+
+	if len(set) == 0 {
+		set[agent.PruneTarget_PRUNE_TARGET_IMAGES] = true
+		set[agent.PruneTarget_PRUNE_TARGET_VOLUMES] = true
+		set[agent.PruneTarget_PRUNE_TARGET_ZOMBIE_CONTAINERS] = true
+	}
+
+	return set
+}
+
+func isDanglingImage(repoTags []string) bool {
+	for _, tag := range repoTags {
+		if tag != danglingImageTag {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAnonymousVolume(name string, labels map[string]string) bool {
+	if _, ok := labels[anonymousVolumeLabel]; ok {
+		return true
+	}
+
+	return anonymousVolumeName.MatchString(name)
+}
+
+// pruneImages are the dangling images without containers, only their own layers are freed up
+func pruneImages(usage *types.DiskUsage) []*agent.PruneCandidate {
+	candidates := []*agent.PruneCandidate{}
+	for _, image := range usage.Images {
+		if image == nil || image.Containers != 0 || !isDanglingImage(image.RepoTags) {
+			continue
+		}
+
+		size := int64(unknownPruneSize)
+		if image.SharedSize >= 0 {
+			size = image.Size - image.SharedSize
+		}
+
+		name := danglingImageTag
+		if len(image.RepoDigests) > 0 {
+			name = image.RepoDigests[0]
+		}
+
+		candidates = append(candidates, &agent.PruneCandidate{
+			Target: agent.PruneTarget_PRUNE_TARGET_IMAGES,
+			Id:     image.ID,
+			Name:   name,
+			Size:   size,
+			Reason: "dangling image, not used by any container",
+		})
+	}
+
+	return candidates
+}
+
+// pruneVolumes are the anonymous volumes no container refers to, named volumes are never pruned
+func pruneVolumes(usage *types.DiskUsage) []*agent.PruneCandidate {
+	candidates := []*agent.PruneCandidate{}
+	for _, vol := range usage.Volumes {
+		if vol == nil || vol.UsageData == nil || vol.UsageData.RefCount != 0 || !isAnonymousVolume(vol.Name, vol.Labels) {
+			continue
+		}
+
+		candidates = append(candidates, &agent.PruneCandidate{
+			Target: agent.PruneTarget_PRUNE_TARGET_VOLUMES,
+			Id:     vol.Name,
+			Name:   vol.Name,
+			Size:   vol.UsageData.Size,
+			Reason: "anonymous volume, not used by any container",
+		})
+	}
+
+	return candidates
+}
+
+// pruneZombieContainers are the managed containers which are dead, or were created but never started
+func pruneZombieContainers(usage *types.DiskUsage, now time.Time) []*agent.PruneCandidate {
+	candidates := []*agent.PruneCandidate{}
+	for _, cont := range usage.Containers {
+		if cont == nil {
+			continue
+		}
+		if _, managed := cont.Labels[label.DyrectorioOrg+label.ContainerPrefix]; !managed {
+			continue
+		}
+
+		reason := ""
+		switch cont.State {
+		case "dead":
+			reason = "dead container"
+		case "created":
+			age := now.Sub(time.Unix(cont.Created, 0))
+			if age < staleCreatedContainer {
+				continue
+			}
+			reason = fmt.Sprintf("created %s ago, never started", age.Truncate(time.Minute))
+		default:
+			continue
+		}
+
+		candidates = append(candidates, &agent.PruneCandidate{
+			Target:    agent.PruneTarget_PRUNE_TARGET_ZOMBIE_CONTAINERS,
+			Id:        cont.ID,
+			Name:      containerDisplayName(cont),
+			Size:      cont.SizeRw,
+			Reason:    reason,
+			Container: volumeUsageContainerIdentifier(cont),
+		})
+	}
+
+	return candidates
+}
+
+// pruneCandidates lists what the prunes of the targets would remove, grouped by target,
+// the largest candidate comes first in each group
+func pruneCandidates(usage *types.DiskUsage, targets []agent.PruneTarget, now time.Time) []*agent.PruneCandidate {
+	set := pruneTargetSet(targets)
+
+	candidates := []*agent.PruneCandidate{}
+	if set[agent.PruneTarget_PRUNE_TARGET_IMAGES] {
+		candidates = append(candidates, pruneImages(usage)...)
+	}
+	if set[agent.PruneTarget_PRUNE_TARGET_VOLUMES] {
+		candidates = append(candidates, pruneVolumes(usage)...)
+	}
+	if set[agent.PruneTarget_PRUNE_TARGET_ZOMBIE_CONTAINERS] {
+		candidates = append(candidates, pruneZombieContainers(usage, now)...)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Target != candidates[j].Target {
+			return candidates[i].Target < candidates[j].Target
+		}
+		if candidates[i].Size != candidates[j].Size {
+			return candidates[i].Size > candidates[j].Size
+		}
+
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	return candidates
+}
+
+// PruneReport is the dry run of the image, volume and zombie container prunes, nothing is removed
+func PruneReport(ctx context.Context, request *agent.PruneReportRequest) ([]*agent.PruneCandidate, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	diskUsage, err := cli.DiskUsage(ctx, types.DiskUsageOptions{
+		Types: []types.DiskUsageObject{types.ImageObject, types.VolumeObject, types.ContainerObject},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the disk usage: %w", err)
+	}
+
+	return pruneCandidates(&diskUsage, request.GetTargets(), time.Now()), nil
+}
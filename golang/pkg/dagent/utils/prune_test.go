@@ -0,0 +1,79 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const anonymousVolume = "0f6e3c8a52b7d1e94f0a6c3b8d2e7f1a5c9b4d8e2f6a0c3b7d1e5f9a3c7b2d6e"
+
+func pruneTestUsage(now time.Time) *types.DiskUsage {
+	prefixLabel := label.DyrectorioOrg + label.ContainerPrefix
+
+	return &types.DiskUsage{
+		Images: []*image.Summary{
+			{ID: "sha256:dangling", RepoDigests: []string{"nginx@sha256:old"}, Size: 300, SharedSize: 100},
+			{ID: "sha256:untagged", RepoTags: []string{"<none>:<none>"}, Size: 500, SharedSize: 0},
+			{ID: "sha256:in-use", Size: 900, SharedSize: 0, Containers: 1},
+			{ID: "sha256:tagged", RepoTags: []string{"nginx:latest"}, Size: 700, SharedSize: 0},
+		},
+		Volumes: []*volume.Volume{
+			{Name: anonymousVolume, UsageData: &volume.UsageData{Size: 40, RefCount: 0}},
+			{Name: "labeled", Labels: map[string]string{"com.docker.volume.anonymous": ""}, UsageData: &volume.UsageData{Size: 80}},
+			{Name: "shop-db-data", UsageData: &volume.UsageData{Size: 4000, RefCount: 0}},
+			{Name: "mounted", Labels: map[string]string{"com.docker.volume.anonymous": ""}, UsageData: &volume.UsageData{RefCount: 1}},
+		},
+		Containers: []*types.Container{
+			{ID: "dead", Names: []string{"/shop-api"}, State: "dead", SizeRw: 10, Labels: map[string]string{prefixLabel: "shop"}},
+			{
+				ID: "stale", Names: []string{"/shop-web"}, State: "created", SizeRw: 20,
+				Created: now.Add(-2 * time.Hour).Unix(), Labels: map[string]string{prefixLabel: "shop"},
+			},
+			{
+				ID: "fresh", Names: []string{"/shop-worker"}, State: "created",
+				Created: now.Add(-time.Minute).Unix(), Labels: map[string]string{prefixLabel: "shop"},
+			},
+			{ID: "exited", Names: []string{"/shop-job"}, State: "exited", Labels: map[string]string{prefixLabel: "shop"}},
+			{ID: "unmanaged", Names: []string{"/other"}, State: "dead"},
+		},
+	}
+}
+
+func TestPruneCandidatesAllTargets(t *testing.T) {
+	now := time.Now()
+	candidates := utils.PruneCandidates(pruneTestUsage(now), nil, now)
+
+	ids := []string{}
+	for _, it := range candidates {
+		ids = append(ids, it.Id)
+	}
+	assert.Equal(t, []string{"sha256:untagged", "sha256:dangling", "labeled", anonymousVolume, "stale", "dead"}, ids)
+
+	assert.Equal(t, int64(200), candidates[1].Size)
+	assert.Equal(t, "nginx@sha256:old", candidates[1].Name)
+	assert.Equal(t, "created 2h0m0s ago, never started", candidates[4].Reason)
+	assert.Equal(t, "shop", candidates[4].Container.Prefix)
+	assert.Equal(t, "web", candidates[4].Container.Name)
+}
+
+func TestPruneCandidatesSelectedTargets(t *testing.T) {
+	now := time.Now()
+	candidates := utils.PruneCandidates(pruneTestUsage(now), []agent.PruneTarget{agent.PruneTarget_PRUNE_TARGET_VOLUMES}, now)
+
+	assert.Len(t, candidates, 2)
+	for _, it := range candidates {
+		assert.Equal(t, agent.PruneTarget_PRUNE_TARGET_VOLUMES, it.Target)
+	}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"github.com/distribution/reference"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+)
+
+// registryHost is the host of the registry of the image, eg. docker.io for nginx, empty if the image is invalid
+func registryHost(image string) string {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return ""
+	}
+
+	return reference.Domain(named)
+}
+
+// initContainerRegistryAuth is the credentials of the deployment for an init container pulled from the same
+// registry, the credentials are never sent to an other registry, nothing is kept after the deployment
+func initContainerRegistryAuth(image, deploymentImage string, auth *imageHelper.RegistryAuth) *imageHelper.RegistryAuth {
+	host := registryHost(image)
+	if auth == nil || host == "" || host != registryHost(deploymentImage) {
+		return nil
+	}
+
+	return auth
+}
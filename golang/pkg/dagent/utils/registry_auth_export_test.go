@@ -0,0 +1,6 @@
+package utils
+
+var (
+	RegistryHost              = registryHost
+	InitContainerRegistryAuth = initContainerRegistryAuth
+)
@@ -0,0 +1,30 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestRegistryHost(t *testing.T) {
+	assert.Equal(t, "docker.io", utils.RegistryHost("nginx"))
+	assert.Equal(t, "docker.io", utils.RegistryHost("index.docker.io/library/traefik:v2.11"))
+	assert.Equal(t, "registry.example.com:5000", utils.RegistryHost("registry.example.com:5000/app:1.0"))
+	assert.Empty(t, utils.RegistryHost("Invalid Image"))
+}
+
+func TestInitContainerRegistryAuth(t *testing.T) {
+	auth := &imageHelper.RegistryAuth{Name: "ghcr", URL: "ghcr.io", User: "user", Password: "token"}
+
+	// the init containers of the same registry get the credentials of the deployment
+	assert.Equal(t, auth, utils.InitContainerRegistryAuth("ghcr.io/org/migrations:1.0", "ghcr.io/org/app:1.0", auth))
+	assert.Nil(t, utils.InitContainerRegistryAuth("busybox", "ghcr.io/org/app:1.0", auth))
+	assert.Nil(t, utils.InitContainerRegistryAuth("Invalid Image", "ghcr.io/org/app:1.0", auth))
+	assert.Nil(t, utils.InitContainerRegistryAuth("ghcr.io/org/migrations:1.0", "ghcr.io/org/app:1.0", nil))
+}
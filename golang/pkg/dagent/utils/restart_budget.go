@@ -0,0 +1,424 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+const (
+	defaultRestartBackoff    = 10 * time.Second
+	defaultRestartMaxBackoff = 5 * time.Minute
+	// delay of resubscribing to the docker events after the stream failed
+	restartBudgetRetryDelay = 5 * time.Second
+)
+
+func validateRestartBudget(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	containerConfig := &deployImageRequest.ContainerConfig
+	budget := containerConfig.RestartBudget
+	if budget == nil {
+		return
+	}
+
+	if containerConfig.RestartPolicy == container.RestartPolicyDisabled {
+		result.Add("ContainerConfig.restartBudget", "the container is not restarted by its restart policy",
+			"use a restart policy other than no")
+	}
+	if budget.WindowSeconds == 0 {
+		result.Add("ContainerConfig.restartBudget.windowSeconds", "the window of the budget is empty", "eg. 600")
+	}
+	if budget.MaxBackoffSeconds != 0 && budget.MaxBackoffSeconds < budget.BackoffSeconds {
+		result.Add("ContainerConfig.restartBudget.maxBackoffSeconds",
+			fmt.Sprintf("the max backoff %ds is less than the backoff %ds", budget.MaxBackoffSeconds, budget.BackoffSeconds), "")
+	}
+	switch budget.GiveUpAction {
+	case "", v1.RestartGiveUpBackoff, v1.RestartGiveUpStop:
+	default:
+		result.Add("ContainerConfig.restartBudget.giveUpAction", fmt.Sprintf("invalid give up action: %s", budget.GiveUpAction),
+			fmt.Sprintf("use %s or %s", v1.RestartGiveUpBackoff, v1.RestartGiveUpStop))
+	}
+}
+
+// restartBudget is the state of the budget of a container, the restarts are the ones in the window
+type restartBudget struct {
+	nextRestart time.Time
+	timer       *time.Timer
+	restarts    []time.Time
+	// the restart policy the container is restored to after the backoff
+	policy container.RestartPolicy
+	config v1.RestartBudget
+	// the stops of the agent not seen yet, the other stops cancel the backoff
+	pendingStops int
+	state        common.RestartBudgetState
+}
+
+func (b *restartBudget) window() time.Duration {
+	return time.Duration(b.config.WindowSeconds) * time.Second
+}
+
+func (b *restartBudget) prune(now time.Time) {
+	kept := b.restarts[:0]
+	for _, it := range b.restarts {
+		if now.Sub(it) < b.window() {
+			kept = append(kept, it)
+		}
+	}
+	b.restarts = kept
+}
+
+// restarted counts a restart of the container, the result is the state of the budget after it,
+// with the delay of the next restart if it is backing off
+func (b *restartBudget) restarted(now time.Time) (common.RestartBudgetState, time.Duration) {
+	b.restarts = append(b.restarts, now)
+	b.prune(now)
+
+	over := len(b.restarts) - int(b.config.MaxRestarts)
+	if over <= 0 {
+		return common.RestartBudgetState_WITHIN_BUDGET, 0
+	}
+
+	backoff := time.Duration(b.config.BackoffSeconds) * time.Second
+	if backoff == 0 {
+		backoff = defaultRestartBackoff
+	}
+	maxBackoff := time.Duration(b.config.MaxBackoffSeconds) * time.Second
+	if maxBackoff == 0 {
+		maxBackoff = max(defaultRestartMaxBackoff, backoff)
+	}
+
+	delay := backoff
+	for i := 1; i < over && delay <= maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay <= maxBackoff {
+		return common.RestartBudgetState_BACKING_OFF, delay
+	}
+	if b.config.GiveUpAction == v1.RestartGiveUpStop {
+		return common.RestartBudgetState_GAVE_UP, 0
+	}
+
+	return common.RestartBudgetState_BACKING_OFF, maxBackoff
+}
+
+func (b *restartBudget) status(now time.Time) *common.RestartBudgetStatus {
+	b.prune(now)
+
+	status := &common.RestartBudgetStatus{
+		State:       b.state,
+		Restarts:    uint32(len(b.restarts)),
+		MaxRestarts: b.config.MaxRestarts,
+	}
+	if b.timer != nil {
+		status.NextRestartAt = timestamppb.New(b.nextRestart)
+	} else if b.state == common.RestartBudgetState_BACKING_OFF && len(b.restarts) <= int(b.config.MaxRestarts) {
+		// it has been running long enough since the last backoff
+		status.State = common.RestartBudgetState_WITHIN_BUDGET
+	}
+
+	return status
+}
+
+// restartBudgets applies the restart budgets of the containers on top of their restart policies,
+// the containers are tracked by their IDs, so a redeployed container starts with a new budget
+type restartBudgets struct {
+	budgets map[string]*restartBudget
+	now     func() time.Time
+	mutex   sync.Mutex
+}
+
+func newRestartBudgets(now func() time.Time) *restartBudgets {
+	return &restartBudgets{
+		budgets: map[string]*restartBudget{},
+		now:     now,
+	}
+}
+
+var containerRestartBudgets = newRestartBudgets(time.Now)
+
+func parseRestartBudget(labels map[string]string) *v1.RestartBudget {
+	value, ok := GetOrganizationLabel(labels, label.RestartBudget)
+	if !ok || value == "" {
+		return nil
+	}
+
+	budget := &v1.RestartBudget{}
+	if err := json.Unmarshal([]byte(value), budget); err != nil {
+		log.Warn().Err(err).Msg("Failed to parse the restart budget of the container")
+		return nil
+	}
+
+	return budget
+}
+
+// status is the state of the budget of the container, nil if it has no budget
+func (r *restartBudgets) status(containerID string, labels map[string]string) *common.RestartBudgetStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if budget, ok := r.budgets[containerID]; ok {
+		return budget.status(r.now())
+	}
+
+	config := parseRestartBudget(labels)
+	if config == nil {
+		return nil
+	}
+
+	return &common.RestartBudgetStatus{
+		State:       common.RestartBudgetState_WITHIN_BUDGET,
+		MaxRestarts: config.MaxRestarts,
+	}
+}
+
+// budget is the tracked budget of the container, nil if it has none
+func (r *restartBudgets) budget(containerID string, labels map[string]string) *restartBudget {
+	if budget, ok := r.budgets[containerID]; ok {
+		return budget
+	}
+
+	config := parseRestartBudget(labels)
+	if config == nil {
+		return nil
+	}
+
+	budget := &restartBudget{
+		config: *config,
+		state:  common.RestartBudgetState_WITHIN_BUDGET,
+	}
+	r.budgets[containerID] = budget
+
+	return budget
+}
+
+func (r *restartBudgets) forget(containerID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if budget, ok := r.budgets[containerID]; ok && budget.timer != nil {
+		budget.timer.Stop()
+	}
+	delete(r.budgets, containerID)
+}
+
+// died counts the exit of the container if its restart policy restarts it, then disables the restart policy
+// and stops it for the backoff or for good if the budget is exceeded, the exits while it is stopped by the agent
+// are not counted
+func (r *restartBudgets) died(ctx context.Context, cli client.APIClient, event *events.Message) {
+	containerID := event.Actor.ID
+
+	r.mutex.Lock()
+	budget := r.budget(containerID, event.Actor.Attributes)
+	if budget == nil || budget.timer != nil || budget.state == common.RestartBudgetState_GAVE_UP {
+		r.mutex.Unlock()
+		return
+	}
+	r.mutex.Unlock()
+
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		log.Debug().Err(err).Str("containerId", containerID).Msg("Failed to inspect the container for its restart budget")
+		return
+	}
+	// stopped by the user or exited without the restart policy restarting it
+	if inspect.ContainerJSONBase == nil || inspect.State == nil || inspect.HostConfig == nil ||
+		(!inspect.State.Restarting && !inspect.State.Running) {
+		return
+	}
+
+	r.mutex.Lock()
+	now := r.now()
+	state, delay := budget.restarted(now)
+	restarts := len(budget.restarts)
+	budget.state = state
+	if state == common.RestartBudgetState_WITHIN_BUDGET {
+		r.mutex.Unlock()
+		return
+	}
+	if state == common.RestartBudgetState_BACKING_OFF {
+		policy := inspect.HostConfig.RestartPolicy
+		budget.policy = policy
+		budget.nextRestart = now.Add(delay)
+		budget.timer = time.AfterFunc(delay, func() {
+			r.restart(ctx, cli, containerID, policy)
+		})
+	}
+	budget.pendingStops++
+	r.mutex.Unlock()
+
+	logger := log.Warn().Str("container", inspect.Name).Int("restarts", restarts)
+	if state == common.RestartBudgetState_GAVE_UP {
+		logger.Msg("Restart budget exceeded, giving up on the container")
+	} else {
+		logger.Dur("backoff", delay).Msg("Restart budget exceeded, backing off")
+	}
+
+	// the stop cancels the pending restart of the restart policy, the update makes the status of the budget visible
+	err = updateRestartPolicy(ctx, cli, containerID, container.RestartPolicy{Name: container.RestartPolicyDisabled})
+	if err != nil {
+		log.Error().Err(err).Str("container", inspect.Name).Msg("Failed to disable the restart policy of the container")
+	}
+	if err = cli.ContainerStop(ctx, containerID, container.StopOptions{}); err != nil {
+		log.Error().Err(err).Str("container", inspect.Name).Msg("Failed to stop the container for its restart budget")
+
+		r.mutex.Lock()
+		budget.pendingStops = max(budget.pendingStops-1, 0)
+		r.mutex.Unlock()
+	}
+}
+
+// stopped cancels the backoff if the container is stopped by someone else than the agent, its restart policy
+// is restored, so it stays stopped like any other container stopped by hand
+func (r *restartBudgets) stopped(ctx context.Context, cli client.APIClient, containerID string) {
+	r.mutex.Lock()
+	budget, ok := r.budgets[containerID]
+	if !ok || budget.timer == nil {
+		r.mutex.Unlock()
+		return
+	}
+	if budget.pendingStops > 0 {
+		budget.pendingStops--
+		r.mutex.Unlock()
+		return
+	}
+
+	budget.timer.Stop()
+	budget.timer = nil
+	policy := budget.policy
+	r.mutex.Unlock()
+
+	log.Info().Str("containerId", containerID).Msg("The container is stopped during its backoff, it is not restarted")
+	if err := updateRestartPolicy(ctx, cli, containerID, policy); err != nil {
+		log.Error().Err(err).Str("containerId", containerID).Msg("Failed to restore the restart policy of the container")
+	}
+}
+
+// restoreRestartPolicies restores the restart policies disabled by the budgets before the agent restarted, the
+// backoffs are not kept, the stopped containers are started with a new budget, which stops them again if they
+// are still crash-looping
+func restoreRestartPolicies(ctx context.Context, cli client.APIClient) {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.DyrectorioOrg+label.RestartPolicy)),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to list the containers with restart budgets, their restart policies are not restored")
+		return
+	}
+
+	for i := range containers {
+		policy, _ := GetOrganizationLabel(containers[i].Labels, label.RestartPolicy)
+		if policy == "" || container.RestartPolicyMode(policy) == container.RestartPolicyDisabled {
+			continue
+		}
+
+		containerID := containers[i].ID
+		inspect, err := cli.ContainerInspect(ctx, containerID)
+		if err != nil || inspect.ContainerJSONBase == nil || inspect.HostConfig == nil || inspect.State == nil ||
+			inspect.HostConfig.RestartPolicy.Name != container.RestartPolicyDisabled {
+			continue
+		}
+
+		log.Info().Str("container", inspect.Name).Str("policy", policy).Msg("Restoring the restart policy disabled by the restart budget")
+		err = updateRestartPolicy(ctx, cli, containerID, container.RestartPolicy{Name: container.RestartPolicyMode(policy)})
+		if err != nil {
+			log.Error().Err(err).Str("container", inspect.Name).Msg("Failed to restore the restart policy of the container")
+			continue
+		}
+		if !inspect.State.Running {
+			if err = cli.ContainerStart(ctx, containerID, container.StartOptions{}); err != nil {
+				log.Error().Err(err).Str("container", inspect.Name).Msg("Failed to start the container after its backoff")
+			}
+		}
+	}
+}
+
+func updateRestartPolicy(ctx context.Context, cli client.APIClient, containerID string, policy container.RestartPolicy) error {
+	_, err := cli.ContainerUpdate(ctx, containerID, container.UpdateConfig{RestartPolicy: policy})
+	return err
+}
+
+// restart restores the restart policy of the container after its backoff, then starts it
+func (r *restartBudgets) restart(ctx context.Context, cli client.APIClient, containerID string, policy container.RestartPolicy) {
+	r.mutex.Lock()
+	if budget, ok := r.budgets[containerID]; ok {
+		budget.timer = nil
+		budget.pendingStops = 0
+	}
+	r.mutex.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err := updateRestartPolicy(ctx, cli, containerID, policy); err != nil {
+		log.Error().Err(err).Str("containerId", containerID).Msg("Failed to restore the restart policy of the container")
+	}
+	if err := cli.ContainerStart(ctx, containerID, container.StartOptions{}); err != nil {
+		log.Error().Err(err).Str("containerId", containerID).Msg("Failed to restart the container after its backoff")
+	}
+}
+
+// WatchRestartBudgets applies the restart budgets of the containers until the context is done
+func WatchRestartBudgets(ctx context.Context) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create the docker client, restart budgets are not applied")
+		return
+	}
+
+	restoreRestartPolicies(ctx, cli)
+	for ctx.Err() == nil {
+		containerRestartBudgets.watch(ctx, cli)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(restartBudgetRetryDelay):
+		}
+	}
+}
+
+func (r *restartBudgets) watch(ctx context.Context, cli client.APIClient) {
+	messages, errs := cli.Events(ctx, types.EventsOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", string(events.ContainerEventType)),
+			filters.Arg("event", string(events.ActionDie)),
+			filters.Arg("event", string(events.ActionStop)),
+			filters.Arg("event", string(events.ActionDestroy)),
+		),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-errs:
+			if ctx.Err() == nil {
+				log.Warn().Err(err).Msg("Restart budget event stream failed, resubscribing")
+			}
+			return
+		case message := <-messages:
+			switch message.Action {
+			case events.ActionDestroy:
+				r.forget(message.Actor.ID)
+			case events.ActionStop:
+				r.stopped(ctx, cli, message.Actor.ID)
+			default:
+				r.died(ctx, cli, &message)
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/client"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+type RestartBudget = restartBudget
+
+func NewRestartBudget(config v1.RestartBudget) *RestartBudget {
+	return &restartBudget{config: config, state: common.RestartBudgetState_WITHIN_BUDGET}
+}
+
+func (b *restartBudget) Restarted(now time.Time) (common.RestartBudgetState, time.Duration) {
+	state, delay := b.restarted(now)
+	b.state = state
+
+	return state, delay
+}
+
+func (b *restartBudget) Status(now time.Time) *common.RestartBudgetStatus {
+	return b.status(now)
+}
+
+type RestartBudgets = restartBudgets
+
+func NewRestartBudgets() *RestartBudgets {
+	return newRestartBudgets(time.Now)
+}
+
+func (r *restartBudgets) Died(ctx context.Context, cli client.APIClient, event *events.Message) {
+	r.died(ctx, cli, event)
+}
+
+func (r *restartBudgets) Stopped(ctx context.Context, cli client.APIClient, containerID string) {
+	r.stopped(ctx, cli, containerID)
+}
+
+// BackingOff tells if the restart of the container is pending
+func (r *restartBudgets) BackingOff(containerID string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	budget, ok := r.budgets[containerID]
+	return ok && budget.timer != nil
+}
+
+var RestoreRestartPolicies = restoreRestartPolicies
+
+func RestartBudgetStatus(labels map[string]string) *common.RestartBudgetStatus {
+	return newRestartBudgets(time.Now).status("container-id", labels)
+}
@@ -0,0 +1,173 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestValidateDeployRequestRestartBudget(t *testing.T) {
+	req := dagentDeployRequest()
+	req.ContainerConfig.RestartBudget = &v1.RestartBudget{MaxRestarts: 3, WindowSeconds: 600, GiveUpAction: v1.RestartGiveUpStop}
+	assert.NoError(t, utils.ValidateDeployRequest(req))
+
+	req.ContainerConfig.RestartPolicy = container.RestartPolicyDisabled
+	req.ContainerConfig.RestartBudget = &v1.RestartBudget{BackoffSeconds: 60, MaxBackoffSeconds: 30, GiveUpAction: "remove"}
+	assert.Equal(t, []string{
+		"ContainerConfig.restartBudget",
+		"ContainerConfig.restartBudget.windowSeconds",
+		"ContainerConfig.restartBudget.maxBackoffSeconds",
+		"ContainerConfig.restartBudget.giveUpAction",
+	}, validationPaths(t, utils.ValidateDeployRequest(req)))
+}
+
+type restartStep struct {
+	state common.RestartBudgetState
+	delay time.Duration
+}
+
+func restartSteps(budget *utils.RestartBudget, start time.Time, count int) []restartStep {
+	steps := []restartStep{}
+	for i := 0; i < count; i++ {
+		state, delay := budget.Restarted(start.Add(time.Duration(i) * time.Second))
+		steps = append(steps, restartStep{state: state, delay: delay})
+	}
+
+	return steps
+}
+
+func TestRestartBudgetBackoff(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	config := v1.RestartBudget{MaxRestarts: 2, WindowSeconds: 600, BackoffSeconds: 10, MaxBackoffSeconds: 40}
+
+	budget := utils.NewRestartBudget(config)
+	assert.Equal(t, []restartStep{
+		{common.RestartBudgetState_WITHIN_BUDGET, 0},
+		{common.RestartBudgetState_WITHIN_BUDGET, 0},
+		{common.RestartBudgetState_BACKING_OFF, 10 * time.Second},
+		{common.RestartBudgetState_BACKING_OFF, 20 * time.Second},
+		{common.RestartBudgetState_BACKING_OFF, 40 * time.Second},
+		{common.RestartBudgetState_BACKING_OFF, 40 * time.Second},
+	}, restartSteps(budget, start, 6))
+
+	config.GiveUpAction = v1.RestartGiveUpStop
+	budget = utils.NewRestartBudget(config)
+	steps := restartSteps(budget, start, 6)
+	assert.Equal(t, restartStep{common.RestartBudgetState_BACKING_OFF, 40 * time.Second}, steps[4])
+	assert.Equal(t, restartStep{common.RestartBudgetState_GAVE_UP, 0}, steps[5])
+}
+
+func TestRestartBudgetWindow(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	budget := utils.NewRestartBudget(v1.RestartBudget{MaxRestarts: 1, WindowSeconds: 60})
+
+	restartSteps(budget, start, 2)
+	status := budget.Status(start.Add(2 * time.Second))
+	assert.Equal(t, common.RestartBudgetState_BACKING_OFF, status.State)
+	assert.Equal(t, uint32(2), status.Restarts)
+	assert.Equal(t, uint32(1), status.MaxRestarts)
+
+	// the restarts before the window are forgotten
+	status = budget.Status(start.Add(time.Minute + time.Second))
+	assert.Equal(t, common.RestartBudgetState_WITHIN_BUDGET, status.State)
+	assert.Equal(t, uint32(0), status.Restarts)
+
+	state, delay := budget.Restarted(start.Add(2 * time.Minute))
+	assert.Equal(t, common.RestartBudgetState_WITHIN_BUDGET, state)
+	assert.Zero(t, delay)
+}
+
+func TestRestartBudgetStatus(t *testing.T) {
+	assert.Nil(t, utils.RestartBudgetStatus(map[string]string{}))
+	assert.Nil(t, utils.RestartBudgetStatus(map[string]string{label.DyrectorioOrg + label.RestartBudget: "{"}))
+
+	status := utils.RestartBudgetStatus(map[string]string{label.DyrectorioOrg + label.RestartBudget: `{"maxRestarts":5,"windowSeconds":600}`})
+	assert.Equal(t, common.RestartBudgetState_WITHIN_BUDGET, status.State)
+	assert.Equal(t, uint32(5), status.MaxRestarts)
+	assert.Nil(t, status.NextRestartAt)
+}
+
+func budgetContainer(t *testing.T, docker *dockerfake.Client, name string, policy container.RestartPolicyMode,
+	labels map[string]string,
+) string {
+	t.Helper()
+
+	created, err := docker.ContainerCreate(context.Background(), &container.Config{Image: "nginx", Labels: labels},
+		&container.HostConfig{RestartPolicy: container.RestartPolicy{Name: policy}}, nil, nil, name)
+	assert.NoError(t, err)
+
+	return created.ID
+}
+
+func restartPolicyOf(t *testing.T, docker *dockerfake.Client, containerID string) container.RestartPolicyMode {
+	t.Helper()
+
+	inspect, err := docker.ContainerInspect(context.Background(), containerID)
+	assert.NoError(t, err)
+
+	return inspect.HostConfig.RestartPolicy.Name
+}
+
+func TestRestartBudgetExternalStop(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx")
+	labels := map[string]string{
+		label.DyrectorioOrg + label.RestartBudget: `{"maxRestarts":0,"windowSeconds":600,"backoffSeconds":600}`,
+		label.DyrectorioOrg + label.RestartPolicy: string(container.RestartPolicyAlways),
+	}
+	containerID := budgetContainer(t, docker, "crash-loop", container.RestartPolicyAlways, labels)
+	assert.NoError(t, docker.ContainerStart(ctx, containerID, container.StartOptions{}))
+
+	budgets := utils.NewRestartBudgets()
+	budgets.Died(ctx, docker, &events.Message{Actor: events.Actor{ID: containerID, Attributes: labels}})
+	assert.True(t, budgets.BackingOff(containerID))
+	assert.Equal(t, container.RestartPolicyDisabled, restartPolicyOf(t, docker, containerID))
+
+	// the stop of the agent itself keeps the backoff
+	budgets.Stopped(ctx, docker, containerID)
+	assert.True(t, budgets.BackingOff(containerID))
+
+	budgets.Stopped(ctx, docker, containerID)
+	assert.False(t, budgets.BackingOff(containerID))
+	assert.Equal(t, container.RestartPolicyAlways, restartPolicyOf(t, docker, containerID))
+}
+
+func TestRestoreRestartPolicies(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx")
+
+	backingOff := budgetContainer(t, docker, "backing-off", container.RestartPolicyDisabled, map[string]string{
+		label.DyrectorioOrg + label.RestartPolicy: string(container.RestartPolicyUnlessStopped),
+	})
+	updated := budgetContainer(t, docker, "updated", container.RestartPolicyOnFailure, map[string]string{
+		label.DyrectorioOrg + label.RestartPolicy: string(container.RestartPolicyAlways),
+	})
+	unlabelled := budgetContainer(t, docker, "unlabelled", container.RestartPolicyDisabled, map[string]string{})
+
+	utils.RestoreRestartPolicies(ctx, docker)
+
+	assert.Equal(t, container.RestartPolicyUnlessStopped, restartPolicyOf(t, docker, backingOff))
+	inspect, err := docker.ContainerInspect(ctx, backingOff)
+	assert.NoError(t, err)
+	assert.True(t, inspect.State.Running)
+
+	assert.Equal(t, container.RestartPolicyOnFailure, restartPolicyOf(t, docker, updated))
+	assert.Equal(t, container.RestartPolicyDisabled, restartPolicyOf(t, docker, unlabelled))
+	// only the container stopped for its backoff is started
+	assert.Equal(t, 1, docker.CallCount("ContainerStart"))
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+// the engine resolves the names only on the user defined networks, the containers of a prefix share one,
+// so they reach each other by their service name, whatever address they got on their last recreation
+const serviceDiscoveryDriver = "bridge"
+
+// projectNetworkName is the network of the containers of the prefix, named after the prefix like the one of dyo
+func projectNetworkName(prefix string) string {
+	return prefix
+}
+
+// resolvesNames tells if the containers of the network mode can be attached to a user defined network
+func resolvesNames(networkMode string) bool {
+	mode := container.NetworkMode(networkMode)
+
+	return !mode.IsHost() && !mode.IsNone() && !mode.IsContainer()
+}
+
+// withProjectNetwork adds the network of the prefix to the networks of the container, if service discovery is enabled
+func withProjectNetwork(cfg *config.Configuration, prefix, networkMode string, networks []string) []string {
+	if !cfg.ServiceDiscoveryEnabled || prefix == "" || !resolvesNames(networkMode) {
+		return networks
+	}
+
+	name := projectNetworkName(prefix)
+	if slices.Contains(networks, name) {
+		return networks
+	}
+
+	return append(networks, name)
+}
+
+// serviceAliases are the names of the container on the network of its prefix, the same ones a deployment gives it
+func serviceAliases(containerName, prefix string) []string {
+	service := strings.TrimPrefix(containerName, prefix+"-")
+	if service == containerName {
+		return []string{containerName}
+	}
+
+	return []string{containerName, service}
+}
+
+// ensureProjectNetwork creates the network of the prefix if it does not exist yet
+func ensureProjectNetwork(ctx context.Context, cli client.APIClient, name string) error {
+	networks, err := cli.NetworkList(ctx, types.NetworkListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", fmt.Sprintf("^%s$", name))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the networks: %w", err)
+	}
+
+	for i := range networks {
+		if networks[i].Name == name {
+			return nil
+		}
+	}
+
+	if _, err = cli.NetworkCreate(ctx, name, types.NetworkCreate{CheckDuplicate: true, Driver: serviceDiscoveryDriver}); err != nil {
+		return fmt.Errorf("failed to create the network %s: %w", name, err)
+	}
+	log.Info().Str("network", name).Msg("Service discovery network created")
+
+	return nil
+}
+
+// syncServiceDiscovery attaches the managed containers to the network of their prefix,
+// the ones deployed before service discovery was enabled are resolvable without a redeploy
+func syncServiceDiscovery(ctx context.Context, cli client.APIClient) error {
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label.DyrectorioOrg+label.ContainerPrefix)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the containers: %w", err)
+	}
+
+	ensured := map[string]bool{}
+	for i := range containers {
+		cont := &containers[i]
+		prefix := cont.Labels[label.DyrectorioOrg+label.ContainerPrefix]
+		if prefix == "" || len(cont.Names) == 0 || !resolvesNames(cont.HostConfig.NetworkMode) {
+			continue
+		}
+
+		name := projectNetworkName(prefix)
+		if cont.NetworkSettings != nil {
+			if _, ok := cont.NetworkSettings.Networks[name]; ok {
+				continue
+			}
+		}
+
+		if !ensured[name] {
+			if err = ensureProjectNetwork(ctx, cli, name); err != nil {
+				return err
+			}
+			ensured[name] = true
+		}
+
+		containerName := strings.TrimPrefix(cont.Names[0], "/")
+		err = cli.NetworkConnect(ctx, name, cont.ID, &network.EndpointSettings{Aliases: serviceAliases(containerName, prefix)})
+		if err != nil {
+			log.Warn().Err(err).Str("container", containerName).Str("network", name).Msg("Failed to attach the container to its prefix network")
+			continue
+		}
+		log.Info().Str("container", containerName).Str("network", name).Msg("Container attached to its prefix network")
+	}
+
+	return nil
+}
+
+// SyncServiceDiscovery attaches the containers deployed before service discovery was enabled to the network of their prefix
+func SyncServiceDiscovery(ctx context.Context) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to sync the service discovery networks")
+		return
+	}
+
+	if err = syncServiceDiscovery(ctx, cli); err != nil {
+		log.Warn().Err(err).Msg("Failed to sync the service discovery networks")
+	}
+}
@@ -0,0 +1,6 @@
+package utils
+
+var (
+	WithProjectNetwork     = withProjectNetwork
+	SyncServiceDiscoveryOn = syncServiceDiscovery
+)
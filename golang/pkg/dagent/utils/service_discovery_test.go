@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+func TestWithProjectNetwork(t *testing.T) {
+	cfg := &config.Configuration{ServiceDiscoveryEnabled: true}
+
+	assert.Equal(t, []string{"backend", "shop"}, utils.WithProjectNetwork(cfg, "shop", "bridge", []string{"backend"}))
+	assert.Equal(t, []string{"shop"}, utils.WithProjectNetwork(cfg, "shop", "", nil))
+	assert.Equal(t, []string{"shop", "backend"}, utils.WithProjectNetwork(cfg, "shop", "traefik", []string{"shop", "backend"}))
+	assert.Empty(t, utils.WithProjectNetwork(cfg, "shop", "host", nil))
+	assert.Empty(t, utils.WithProjectNetwork(cfg, "shop", "none", nil))
+	assert.Empty(t, utils.WithProjectNetwork(cfg, "shop", "container:db", nil))
+	assert.Empty(t, utils.WithProjectNetwork(cfg, "", "bridge", nil))
+	assert.Empty(t, utils.WithProjectNetwork(&config.Configuration{}, "shop", "bridge", nil))
+}
+
+func createPrefixedContainer(t *testing.T, docker *dockerfake.Client, name, prefix, networkMode string) string {
+	resp, err := docker.ContainerCreate(context.Background(),
+		&container.Config{Image: "nginx", Labels: map[string]string{label.DyrectorioOrg + label.ContainerPrefix: prefix}},
+		&container.HostConfig{NetworkMode: container.NetworkMode(networkMode)}, nil, nil, name)
+	assert.NoError(t, err)
+
+	return resp.ID
+}
+
+func TestSyncServiceDiscovery(t *testing.T) {
+	ctx := context.Background()
+	docker := dockerfake.New()
+	docker.AddImage("nginx")
+
+	web := createPrefixedContainer(t, docker, "shop-web", "shop", "bridge")
+	db := createPrefixedContainer(t, docker, "shop-db", "shop", "bridge")
+	host := createPrefixedContainer(t, docker, "shop-exporter", "shop", "host")
+	blog := createPrefixedContainer(t, docker, "blog-web", "blog", "bridge")
+	_, err := docker.ContainerCreate(ctx, &container.Config{Image: "nginx"}, &container.HostConfig{}, nil, nil, "unmanaged")
+	assert.NoError(t, err)
+
+	assert.NoError(t, utils.SyncServiceDiscoveryOn(ctx, docker))
+	assert.Equal(t, 2, docker.CallCount("NetworkCreate"))
+
+	inspect, err := docker.ContainerInspect(ctx, web)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shop-web", "web"}, inspect.NetworkSettings.Networks["shop"].Aliases)
+
+	inspect, err = docker.ContainerInspect(ctx, db)
+	assert.NoError(t, err)
+	assert.Contains(t, inspect.NetworkSettings.Networks, "shop")
+
+	inspect, err = docker.ContainerInspect(ctx, blog)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"blog-web", "web"}, inspect.NetworkSettings.Networks["blog"].Aliases)
+
+	inspect, err = docker.ContainerInspect(ctx, host)
+	assert.NoError(t, err)
+	assert.NotContains(t, inspect.NetworkSettings.Networks, "shop")
+
+	shop, err := docker.NetworkInspect(ctx, "shop", types.NetworkInspectOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, shop.Containers, 2)
+
+	// attached ones are left alone
+	assert.NoError(t, utils.SyncServiceDiscoveryOn(ctx, docker))
+	assert.Equal(t, 2, docker.CallCount("NetworkCreate"))
+	assert.Equal(t, 3, docker.CallCount("NetworkConnect"))
+}
@@ -0,0 +1,363 @@
+package utils
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/docker/docker/client"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+const (
+	snapshotDirName  = "@snapshot"
+	snapshotFilePerm = 0o600
+	// snapshotKeyFileName is the key of the secret hashes, it never leaves the node
+	snapshotKeyFileName = "snapshot.key"
+	snapshotKeyLength   = 32
+	// secretsPath is the JSON path of the secrets in the snapshots, their changes are reported without the values
+	secretsPath = "secrets"
+)
+
+var snapshotKeyMutex sync.Mutex
+
+// DeploymentSnapshot is the effective configuration of a deployed container, secrets are only stored as HMACs
+// keyed with the snapshot key of the node, so the low-entropy ones can not be brute-forced from the hashes
+type DeploymentSnapshot struct {
+	Environment     map[string]string  `json:"environment"`
+	Secrets         map[string]string  `json:"secrets"`
+	Image           string             `json:"image"`
+	ContainerConfig v1.ContainerConfig `json:"containerConfig"`
+}
+
+type snapshotFile struct {
+	CreatedAt    time.Time          `json:"createdAt"`
+	Hash         string             `json:"hash"`
+	DeploymentID string             `json:"deploymentId,omitempty"`
+	Version      string             `json:"version,omitempty"`
+	Snapshot     DeploymentSnapshot `json:"snapshot"`
+}
+
+func newDeploymentSnapshot(key []byte, deployImageRequest *v1.DeployImageRequest, image string,
+	environment, secrets map[string]string,
+) *DeploymentSnapshot {
+	secretHashes := map[string]string{}
+	for name, value := range secrets {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(value))
+		secretHashes[name] = fmt.Sprintf("hmac-sha256:%x", mac.Sum(nil))
+	}
+
+	containerConfig := deployImageRequest.ContainerConfig
+	// both are part of the snapshot in their effective form
+	containerConfig.Environment = nil
+	containerConfig.Secrets = nil
+
+	return &DeploymentSnapshot{
+		Environment:     environment,
+		Secrets:         secretHashes,
+		Image:           image,
+		ContainerConfig: containerConfig,
+	}
+}
+
+// Hash is the content hash of the snapshot, map keys are sorted by the encoder so it is stable
+func (s *DeploymentSnapshot) Hash() (string, error) {
+	content, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content)), nil
+}
+
+// snapshotKey reads the key of the secret hashes of the node, it is generated on the first use
+func snapshotKey(dataRoot string) ([]byte, error) {
+	snapshotKeyMutex.Lock()
+	defer snapshotKeyMutex.Unlock()
+
+	filePath := filepath.Join(dataRoot, snapshotKeyFileName)
+	key, err := os.ReadFile(filePath) // #nosec G304 -- the path is the data root of the agent
+	if err == nil && len(key) == snapshotKeyLength {
+		return key, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	key = make([]byte, snapshotKeyLength)
+	if _, err = rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dataRoot, dirPerm); err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(filePath, key, snapshotFilePerm); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// newNodeDeploymentSnapshot is the snapshot of the request with the snapshot key of the node
+func newNodeDeploymentSnapshot(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest, image string,
+	environment, secrets map[string]string,
+) (*DeploymentSnapshot, error) {
+	key, err := snapshotKey(cfg.InternalMountPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the snapshot key: %w", err)
+	}
+
+	return newDeploymentSnapshot(key, deployImageRequest, image, environment, secrets), nil
+}
+
+func snapshotPath(dataRoot, prefix, containerName string) string {
+	return filepath.Join(dataRoot, prefix, snapshotDirName, containerName+".json")
+}
+
+func writeSnapshot(filePath string, file *snapshotFile) error {
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(filePath), dirPerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, content, snapshotFilePerm)
+}
+
+// readSnapshot returns nil without an error if there is no snapshot yet
+func readSnapshot(filePath string) (*snapshotFile, error) {
+	content, err := os.ReadFile(filePath) // #nosec G304 -- the path is built from the prefix and the container name
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	file := &snapshotFile{}
+	if err = json.Unmarshal(content, file); err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %w", filePath, err)
+	}
+
+	return file, nil
+}
+
+// saveDeploymentSnapshot stores the effective configuration after a successful deployment
+func saveDeploymentSnapshot(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest, versionData *v1.VersionData,
+	image string, environment, secrets map[string]string,
+) error {
+	snapshot, err := newNodeDeploymentSnapshot(cfg, deployImageRequest, image, environment, secrets)
+	if err != nil {
+		return err
+	}
+	hash, err := snapshot.Hash()
+	if err != nil {
+		return err
+	}
+
+	file := &snapshotFile{
+		CreatedAt:    time.Now().UTC(),
+		Hash:         hash,
+		DeploymentID: deployImageRequest.DeploymentID,
+		Snapshot:     *snapshot,
+	}
+	if versionData != nil {
+		file.Version = versionData.Version
+	}
+
+	filePath := snapshotPath(cfg.InternalMountPath, getContainerPrefix(deployImageRequest), getContainerName(deployImageRequest))
+	return writeSnapshot(filePath, file)
+}
+
+// diffSnapshots lists the changed leaves of the two configurations by their JSON path,
+// lists are compared as a whole
+func diffSnapshots(current, next *DeploymentSnapshot) ([]v1.DeploymentChange, error) {
+	currentTree, err := toJSONTree(current)
+	if err != nil {
+		return nil, err
+	}
+
+	nextTree, err := toJSONTree(next)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []v1.DeploymentChange{}
+	diffJSONTree("", currentTree, nextTree, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+func toJSONTree(snapshot *DeploymentSnapshot) (any, error) {
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree any
+	if err = json.Unmarshal(content, &tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+func diffJSONTree(path string, current, next any, changes *[]v1.DeploymentChange) {
+	currentObject, currentIsObject := current.(map[string]any)
+	nextObject, nextIsObject := next.(map[string]any)
+
+	if currentIsObject && nextIsObject {
+		for key, value := range currentObject {
+			diffJSONTree(joinJSONPath(path, key), value, nextObject[key], changes)
+		}
+
+		for key, value := range nextObject {
+			if _, ok := currentObject[key]; !ok {
+				diffJSONTree(joinJSONPath(path, key), nil, value, changes)
+			}
+		}
+
+		return
+	}
+
+	currentValue, nextValue := jsonLeaf(current), jsonLeaf(next)
+	if pointer.GetString(currentValue) == pointer.GetString(nextValue) && (currentValue == nil) == (nextValue == nil) {
+		return
+	}
+
+	// only the change of a secret is reported, the hashes are kept on the node
+	if strings.HasPrefix(path, secretsPath+".") {
+		currentValue, nextValue = redactedSecret(currentValue), redactedSecret(nextValue)
+	}
+
+	*changes = append(*changes, v1.DeploymentChange{
+		Path: path,
+		Old:  currentValue,
+		New:  nextValue,
+	})
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// jsonLeaf renders a value of the tree, strings are kept as is, null and empty values are missing
+func jsonLeaf(value any) *string {
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if typed == "" {
+			return nil
+		}
+		return &typed
+	case []any:
+		if len(typed) == 0 {
+			return nil
+		}
+	case map[string]any:
+		if len(typed) == 0 {
+			return nil
+		}
+	}
+
+	content, err := json.Marshal(value)
+	if err != nil {
+		return pointer.ToString(fmt.Sprint(value))
+	}
+
+	return pointer.ToString(string(content))
+}
+
+func redactedSecret(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	return pointer.ToString("*****")
+}
+
+// DiffDeployment compares the request with the snapshot of the deployed container without changing anything
+func DiffDeployment(ctx context.Context, deployImageRequest *v1.DeployImageRequest) (*v1.DeploymentDiff, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	containerName := getContainerName(deployImageRequest)
+	prefix := getContainerPrefix(deployImageRequest)
+
+	image, err := getImageNameFromRequest(deployImageRequest, cfg.ImageRewriteRules)
+	if err != nil {
+		return nil, fmt.Errorf("image name error: %w", err)
+	}
+
+	environment, secrets, err := deploymentEnvironment(cfg, deployImageRequest, prefix, false)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := newNodeDeploymentSnapshot(cfg, deployImageRequest, image, environment, secrets)
+	if err != nil {
+		return nil, err
+	}
+	diff := &v1.DeploymentDiff{
+		Container: containerName,
+		Changes:   []v1.DeploymentChange{},
+	}
+	if diff.NewHash, err = next.Hash(); err != nil {
+		return nil, err
+	}
+
+	current, err := readSnapshot(snapshotPath(cfg.InternalMountPath, prefix, containerName))
+	if err != nil || current == nil {
+		return diff, err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	cont, err := dockerHelper.GetContainerByName(ctx, cli, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	diff.Deployed = cont != nil
+	diff.CurrentHash = current.Hash
+	if current.Hash == diff.NewHash {
+		return diff, nil
+	}
+
+	diff.Changes, err = diffSnapshots(&current.Snapshot, next)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,27 @@
+package utils
+
+var (
+	NewDeploymentSnapshot = newDeploymentSnapshot
+	DiffSnapshots         = diffSnapshots
+	SnapshotPath          = snapshotPath
+	SnapshotKey           = snapshotKey
+)
+
+// SnapshotRoundTrip writes the snapshot and reads it back
+func SnapshotRoundTrip(filePath string, snapshot *DeploymentSnapshot) (*DeploymentSnapshot, string, error) {
+	hash, err := snapshot.Hash()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err = writeSnapshot(filePath, &snapshotFile{Hash: hash, Snapshot: *snapshot}); err != nil {
+		return nil, "", err
+	}
+
+	file, err := readSnapshot(filePath)
+	if err != nil || file == nil {
+		return nil, "", err
+	}
+
+	return &file.Snapshot, file.Hash, nil
+}
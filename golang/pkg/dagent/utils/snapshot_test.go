@@ -0,0 +1,137 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+var testSnapshotKey = []byte("0123456789abcdef0123456789abcdef")
+
+func snapshotRequest() *v1.DeployImageRequest {
+	return &v1.DeployImageRequest{
+		ContainerConfig: v1.ContainerConfig{
+			Container:   "web",
+			Environment: map[string]string{"LOG_LEVEL": "info"},
+			Secrets:     map[string]string{"PASSWORD": "encrypted"},
+			Ports:       []builder.PortBinding{{ExposedPort: 80, PortBinding: pointer.ToUint16(8080)}},
+		},
+	}
+}
+
+func TestDeploymentSnapshotHash(t *testing.T) {
+	environment := map[string]string{"LOG_LEVEL": "info", "REGION": "eu"}
+	secrets := map[string]string{"PASSWORD": "secret"}
+
+	snapshot := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25", environment, secrets)
+	assert.NotEqual(t, "secret", snapshot.Secrets["PASSWORD"], "secrets are stored as hashes")
+	assert.Contains(t, snapshot.Secrets["PASSWORD"], "hmac-sha256:")
+
+	otherNode := utils.NewDeploymentSnapshot([]byte("fedcba9876543210fedcba9876543210"), snapshotRequest(),
+		"docker.io/library/nginx:1.25", environment, secrets)
+	assert.NotEqual(t, snapshot.Secrets["PASSWORD"], otherNode.Secrets["PASSWORD"], "the hashes are keyed by the node")
+	assert.Nil(t, snapshot.ContainerConfig.Secrets)
+	assert.Nil(t, snapshot.ContainerConfig.Environment)
+
+	hash, err := snapshot.Hash()
+	assert.NoError(t, err)
+
+	same := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25",
+		map[string]string{"REGION": "eu", "LOG_LEVEL": "info"}, map[string]string{"PASSWORD": "secret"})
+	sameHash, err := same.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	rotated := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25",
+		environment, map[string]string{"PASSWORD": "rotated"})
+	rotatedHash, err := rotated.Hash()
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, rotatedHash, "a changed secret changes the hash")
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	current := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25",
+		map[string]string{"LOG_LEVEL": "info", "REGION": "eu"}, map[string]string{"PASSWORD": "secret"})
+
+	nextRequest := snapshotRequest()
+	nextRequest.ContainerConfig.Ports[0].PortBinding = pointer.ToUint16(9090)
+	nextRequest.ContainerConfig.User = pointer.ToInt64(1000)
+	next := utils.NewDeploymentSnapshot(testSnapshotKey, nextRequest, "docker.io/library/nginx:1.26",
+		map[string]string{"LOG_LEVEL": "debug", "TZ": "UTC"}, map[string]string{"PASSWORD": "secret"})
+
+	changes, err := utils.DiffSnapshots(current, next)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []v1.DeploymentChange{
+		{Path: "containerConfig.port", Old: pointer.ToString(`[{"exposedPort":80,"portBinding":8080}]`),
+			New: pointer.ToString(`[{"exposedPort":80,"portBinding":9090}]`)},
+		{Path: "containerConfig.user", New: pointer.ToString("1000")},
+		{Path: "environment.LOG_LEVEL", Old: pointer.ToString("info"), New: pointer.ToString("debug")},
+		{Path: "environment.REGION", Old: pointer.ToString("eu")},
+		{Path: "environment.TZ", New: pointer.ToString("UTC")},
+		{Path: "image", Old: pointer.ToString("docker.io/library/nginx:1.25"), New: pointer.ToString("docker.io/library/nginx:1.26")},
+	}, changes)
+
+	unchanged, err := utils.DiffSnapshots(current, current)
+	assert.NoError(t, err)
+	assert.Empty(t, unchanged)
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	filePath := utils.SnapshotPath(t.TempDir(), "prefix", "prefix-web")
+	assert.Equal(t, filepath.Join("prefix", "@snapshot", "prefix-web.json"),
+		filepath.Join(filepath.Base(filepath.Dir(filepath.Dir(filePath))), "@snapshot", filepath.Base(filePath)))
+
+	snapshot := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25",
+		map[string]string{"LOG_LEVEL": "info"}, map[string]string{})
+	expectedHash, err := snapshot.Hash()
+	assert.NoError(t, err)
+
+	read, hash, err := utils.SnapshotRoundTrip(filePath, snapshot)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, hash)
+
+	readHash, err := read.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, readHash, "the hash survives serialization")
+}
+
+func TestDiffSnapshotsRedactsSecrets(t *testing.T) {
+	current := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25",
+		map[string]string{}, map[string]string{"PASSWORD": "secret", "TOKEN": "token"})
+	next := utils.NewDeploymentSnapshot(testSnapshotKey, snapshotRequest(), "docker.io/library/nginx:1.25",
+		map[string]string{}, map[string]string{"PASSWORD": "rotated", "API_KEY": "key"})
+
+	changes, err := utils.DiffSnapshots(current, next)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1.DeploymentChange{
+		{Path: "secrets.API_KEY", New: pointer.ToString("*****")},
+		{Path: "secrets.PASSWORD", Old: pointer.ToString("*****"), New: pointer.ToString("*****")},
+		{Path: "secrets.TOKEN", Old: pointer.ToString("*****")},
+	}, changes)
+}
+
+func TestSnapshotKeyIsKeptOnTheNode(t *testing.T) {
+	dataRoot := t.TempDir()
+
+	key, err := utils.SnapshotKey(dataRoot)
+	assert.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	again, err := utils.SnapshotKey(dataRoot)
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	other, err := utils.SnapshotKey(t.TempDir())
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
@@ -0,0 +1,263 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/internal/logdefer"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const (
+	rollingTrafficWindow = 24 * time.Hour
+	trafficDayFormat     = "2006-01-02"
+	trafficDay           = 24 * time.Hour
+	// trafficStatsConcurrency is the number of containers whose stats are requested at once
+	trafficStatsConcurrency = 8
+)
+
+type trafficCounter struct {
+	rx uint64
+	tx uint64
+}
+
+type trafficBucket struct {
+	start time.Time
+	trafficCounter
+}
+
+// prefixTraffic are the totals of a prefix by the hour for the rolling window and by the day, the oldest comes first
+type prefixTraffic struct {
+	hourly []trafficBucket
+	daily  []trafficBucket
+}
+
+// addTraffic adds the traffic to the bucket starting at the time, the buckets are created in order
+func addTraffic(buckets []trafficBucket, start time.Time, traffic trafficCounter) []trafficBucket {
+	if len(buckets) == 0 || buckets[len(buckets)-1].start.Before(start) {
+		buckets = append(buckets, trafficBucket{start: start})
+	}
+
+	last := &buckets[len(buckets)-1]
+	last.rx += traffic.rx
+	last.tx += traffic.tx
+
+	return buckets
+}
+
+// trafficSince drops the buckets starting before the time
+func trafficSince(buckets []trafficBucket, start time.Time) []trafficBucket {
+	for len(buckets) > 0 && buckets[0].start.Before(start) {
+		buckets = buckets[1:]
+	}
+
+	return buckets
+}
+
+// TrafficSampler samples the network counters of the managed containers and sums up the traffic by their prefix,
+// the counters of a container start over when it restarts, which is counted as new traffic. The totals are kept
+// in memory only, they start over when the agent restarts, the Since of the usage tells from when they are counted
+type TrafficSampler struct {
+	started   time.Time
+	counters  map[string]trafficCounter
+	prefixes  map[string]*prefixTraffic
+	appConfig *config.Configuration
+	mutex     sync.Mutex
+}
+
+func NewTrafficSampler(cfg *config.Configuration) *TrafficSampler {
+	return &TrafficSampler{
+		started:   time.Now(),
+		counters:  map[string]trafficCounter{},
+		prefixes:  map[string]*prefixTraffic{},
+		appConfig: cfg,
+	}
+}
+
+// Start samples until the context is done, it is a no-op if the interval is not positive
+func (s *TrafficSampler) Start(ctx context.Context) {
+	if s.appConfig.TrafficSampleInterval <= 0 {
+		log.Info().Msg("Traffic sampling is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.appConfig.TrafficSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sample(ctx); err != nil {
+				log.Warn().Err(err).Msg("Failed to sample network traffic")
+			}
+		}
+	}
+}
+
+func (s *TrafficSampler) sample(ctx context.Context) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", label.DyrectorioOrg+label.ContainerPrefix)),
+	})
+	if err != nil {
+		return err
+	}
+
+	live := map[string]bool{}
+	group := errgroup.Group{}
+	group.SetLimit(trafficStatsConcurrency)
+	for i := range containers {
+		cont := &containers[i]
+		live[cont.ID] = true
+
+		group.Go(func() error {
+			traffic, err := containerTraffic(ctx, cli, cont.ID)
+			if err != nil {
+				log.Debug().Err(err).Str("container", containerDisplayName(cont)).Msg("Failed to get the network stats")
+				return nil
+			}
+
+			s.record(cont.ID, cont.Labels[label.DyrectorioOrg+label.ContainerPrefix], time.Unix(cont.Created, 0),
+				traffic.rx, traffic.tx, time.Now())
+			return nil
+		})
+	}
+	if err = group.Wait(); err != nil {
+		return err
+	}
+	s.prune(live)
+
+	return nil
+}
+
+// containerTraffic sums up the counters of the networks of the container, the host network is not counted,
+// the counters are read once, without waiting for the second sample of the CPU usage
+func containerTraffic(ctx context.Context, cli client.APIClient, id string) (trafficCounter, error) {
+	resp, err := cli.ContainerStatsOneShot(ctx, id)
+	if err != nil {
+		return trafficCounter{}, err
+	}
+	defer logdefer.LogDeferredErr(resp.Body.Close, log.Warn(), "error closing container stats")
+
+	stats := &types.StatsJSON{}
+	if err = json.NewDecoder(resp.Body).Decode(stats); err != nil {
+		return trafficCounter{}, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+
+	traffic := trafficCounter{}
+	for _, network := range stats.Networks {
+		traffic.rx += network.RxBytes
+		traffic.tx += network.TxBytes
+	}
+
+	return traffic, nil
+}
+
+// record adds the traffic since the previous sample of the container, the first sample of a container
+// created before the sampler started is only the baseline, as its traffic before is unknown
+func (s *TrafficSampler) record(id, prefix string, created time.Time, rx, tx uint64, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	last, seen := s.counters[id]
+	s.counters[id] = trafficCounter{rx: rx, tx: tx}
+
+	traffic := trafficCounter{rx: rx, tx: tx}
+	switch {
+	case !seen && created.Before(s.started):
+		return
+	case seen && rx >= last.rx && tx >= last.tx:
+		traffic = trafficCounter{rx: rx - last.rx, tx: tx - last.tx}
+	}
+
+	totals, ok := s.prefixes[prefix]
+	if !ok {
+		totals = &prefixTraffic{}
+		s.prefixes[prefix] = totals
+	}
+
+	now = now.UTC()
+	totals.hourly = addTraffic(totals.hourly, now.Truncate(time.Hour), traffic)
+	totals.hourly = trafficSince(totals.hourly, now.Truncate(time.Hour).Add(time.Hour-rollingTrafficWindow))
+	totals.daily = addTraffic(totals.daily, now.Truncate(trafficDay), traffic)
+	if days := int(s.appConfig.TrafficHistoryDays); days > 0 {
+		totals.daily = trafficSince(totals.daily, now.Truncate(trafficDay).AddDate(0, 0, 1-days))
+	}
+}
+
+// prune drops the counters of the removed containers, their traffic is kept in the totals
+func (s *TrafficSampler) prune(live map[string]bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id := range s.counters {
+		if !live[id] {
+			delete(s.counters, id)
+		}
+	}
+}
+
+func (s *TrafficSampler) usage(prefix string, now time.Time) []*agent.PrefixTraffic {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	usage := []*agent.PrefixTraffic{}
+	for name, totals := range s.prefixes {
+		if prefix != "" && name != prefix {
+			continue
+		}
+
+		rolling := &agent.TrafficTotal{}
+		for _, it := range trafficSince(totals.hourly, now.UTC().Truncate(time.Hour).Add(time.Hour-rollingTrafficWindow)) {
+			rolling.RxBytes += it.rx
+			rolling.TxBytes += it.tx
+		}
+
+		daily := []*agent.DailyTraffic{}
+		for _, it := range totals.daily {
+			daily = append(daily, &agent.DailyTraffic{
+				Day:   it.start.Format(trafficDayFormat),
+				Total: &agent.TrafficTotal{RxBytes: it.rx, TxBytes: it.tx},
+			})
+		}
+
+		usage = append(usage, &agent.PrefixTraffic{
+			Prefix:  name,
+			Rolling: rolling,
+			Daily:   daily,
+		})
+	}
+
+	sort.Slice(usage, func(i, j int) bool { return usage[i].Prefix < usage[j].Prefix })
+
+	return usage
+}
+
+// Usage is the traffic of the prefixes sampled so far
+func (s *TrafficSampler) Usage(_ context.Context, request *agent.TrafficUsageRequest) (*agent.TrafficUsageResponse, error) {
+	return &agent.TrafficUsageResponse{
+		Prefix:   request.Prefix,
+		Since:    timestamppb.New(s.started),
+		Prefixes: s.usage(request.GetPrefix(), time.Now()),
+	}, nil
+}
@@ -0,0 +1,6 @@
+package utils
+
+var (
+	RecordTraffic = (*TrafficSampler).record
+	TrafficUsage  = (*TrafficSampler).usage
+)
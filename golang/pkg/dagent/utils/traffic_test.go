@@ -0,0 +1,65 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestTrafficSamplerDeltas(t *testing.T) {
+	sampler := utils.NewTrafficSampler(&config.Configuration{TrafficHistoryDays: 31})
+	now := time.Now().UTC()
+	before := now.Add(-time.Hour)
+
+	// the counters of a container running before the sampler are the baseline
+	utils.RecordTraffic(sampler, "api", "shop", before, 1000, 500, now)
+	utils.RecordTraffic(sampler, "api", "shop", before, 1300, 600, now)
+	// a container created after the sampler started is counted from zero
+	utils.RecordTraffic(sampler, "web", "shop", now, 200, 100, now)
+	// the counters start over on restart
+	utils.RecordTraffic(sampler, "api", "shop", before, 50, 20, now)
+	utils.RecordTraffic(sampler, "db", "blog", now, 10, 10, now)
+
+	usage := utils.TrafficUsage(sampler, "", now)
+	assert.Len(t, usage, 2)
+	assert.Equal(t, "blog", usage[0].Prefix)
+	assert.Equal(t, "shop", usage[1].Prefix)
+	assert.Equal(t, uint64(550), usage[1].Rolling.RxBytes)
+	assert.Equal(t, uint64(220), usage[1].Rolling.TxBytes)
+	assert.Len(t, usage[1].Daily, 1)
+	assert.Equal(t, now.Format("2006-01-02"), usage[1].Daily[0].Day)
+	assert.Equal(t, uint64(550), usage[1].Daily[0].Total.RxBytes)
+
+	filtered := utils.TrafficUsage(sampler, "blog", now)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, uint64(10), filtered[0].Rolling.RxBytes)
+}
+
+func TestTrafficSamplerWindows(t *testing.T) {
+	sampler := utils.NewTrafficSampler(&config.Configuration{TrafficHistoryDays: 2})
+	start := time.Date(2030, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	utils.RecordTraffic(sampler, "api", "shop", start, 100, 0, start)
+	utils.RecordTraffic(sampler, "api", "shop", start, 300, 0, start.Add(20*time.Hour))
+	utils.RecordTraffic(sampler, "api", "shop", start, 600, 0, start.Add(30*time.Hour))
+	utils.RecordTraffic(sampler, "api", "shop", start, 1000, 0, start.Add(60*time.Hour))
+
+	usage := utils.TrafficUsage(sampler, "shop", start.Add(60*time.Hour))
+	assert.Equal(t, uint64(400), usage[0].Rolling.RxBytes)
+	assert.Len(t, usage[0].Daily, 2)
+	assert.Equal(t, "2030-03-11", usage[0].Daily[0].Day)
+	assert.Equal(t, uint64(500), usage[0].Daily[0].Total.RxBytes)
+	assert.Equal(t, "2030-03-12", usage[0].Daily[1].Day)
+	assert.Equal(t, uint64(400), usage[0].Daily[1].Total.RxBytes)
+
+	// the rolling window moves on without new samples
+	usage = utils.TrafficUsage(sampler, "shop", start.Add(90*time.Hour))
+	assert.Zero(t, usage[0].Rolling.RxBytes)
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
+	dockerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+// runsSameConfiguration tells if the snapshot of the running container has the hash of the request
+func runsSameConfiguration(cfg *config.Configuration, deployImageRequest *v1.DeployImageRequest, cont *types.Container,
+	image string, environment, secrets map[string]string,
+) (bool, error) {
+	if cont == nil || cont.State != "running" {
+		return false, nil
+	}
+
+	filePath := snapshotPath(cfg.InternalMountPath, getContainerPrefix(deployImageRequest), getContainerName(deployImageRequest))
+	current, err := readSnapshot(filePath)
+	if err != nil || current == nil {
+		return false, err
+	}
+
+	snapshot, err := newNodeDeploymentSnapshot(cfg, deployImageRequest, image, environment, secrets)
+	if err != nil {
+		return false, err
+	}
+	hash, err := snapshot.Hash()
+	if err != nil {
+		return false, err
+	}
+
+	return current.Hash == hash, nil
+}
+
+// runsSameImage pulls the image if it has a newer version and compares it with the image of the container,
+// so a mutable tag pointing to a new image is not mistaken for an unchanged container
+func runsSameImage(ctx context.Context, cli client.APIClient, deployImageRequest *v1.DeployImageRequest,
+	cont *types.Container, image string, limiter *rate.Limiter,
+) (bool, error) {
+	if deployImageRequest.Registry != nil && *deployImageRequest.Registry != "" {
+		err := imageHelper.CustomImagePull(ctx, cli, image,
+			dockerbuilder.EncodeRegistryAuth(deployImageRequest.RegistryAuth), imageHelper.PullIfNewer, nil, limiter)
+		if err != nil {
+			return false, fmt.Errorf("image pull error: %w", err)
+		}
+	}
+
+	localImage, err := imageHelper.GetImageByReference(ctx, cli, image)
+	if err != nil {
+		return false, err
+	}
+
+	return localImage.ID == cont.ImageID, nil
+}
+
+// skipUnchanged leaves the container as is if it runs with the requested configuration and image,
+// any error falls back to recreating the container
+func skipUnchanged(ctx context.Context, cli client.APIClient, cfg *config.Configuration, dog *dogger.DeploymentLogger,
+	deployImageRequest *v1.DeployImageRequest, cont *types.Container, image string, environment, secrets map[string]string,
+) bool {
+	if deployImageRequest.RecreateUnchanged {
+		return false
+	}
+
+	containerName := getContainerName(deployImageRequest)
+	same, err := runsSameConfiguration(cfg, deployImageRequest, cont, image, environment, secrets)
+	if err == nil && same {
+		same, err = runsSameImage(ctx, cli, deployImageRequest, cont, image, cfg.BandwidthLimiter)
+	}
+
+	if err != nil {
+		log.Warn().Err(err).Str("container", containerName).Msg("Failed to compare the container with the request, recreating it")
+		return false
+	}
+
+	if !same {
+		return false
+	}
+
+	dog.WriteContainerState(mapper.MapDockerStateToCruxContainerState(cont.State), cont.State, dogger.Info,
+		fmt.Sprintf("Container is unchanged, skipping: %s", containerName))
+
+	return true
+}
@@ -0,0 +1,6 @@
+package utils
+
+var (
+	RunsSameConfiguration  = runsSameConfiguration
+	SaveDeploymentSnapshot = saveDeploymentSnapshot
+)
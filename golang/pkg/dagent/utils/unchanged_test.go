@@ -0,0 +1,47 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestRunsSameConfiguration(t *testing.T) {
+	cfg := &config.Configuration{InternalMountPath: t.TempDir()}
+	req := snapshotRequest()
+	req.InstanceConfig.ContainerPreName = "prefix"
+	image := "docker.io/library/nginx:1.25"
+	environment := map[string]string{"LOG_LEVEL": "info"}
+	secrets := map[string]string{"PASSWORD": "secret"}
+	running := &types.Container{State: "running"}
+
+	same, err := utils.RunsSameConfiguration(cfg, req, running, image, environment, secrets)
+	assert.NoError(t, err)
+	assert.False(t, same, "there is no snapshot yet")
+
+	assert.NoError(t, utils.SaveDeploymentSnapshot(cfg, req, &v1.VersionData{Version: "1.0.0"}, image, environment, secrets))
+
+	same, err = utils.RunsSameConfiguration(cfg, req, running, image, environment, secrets)
+	assert.NoError(t, err)
+	assert.True(t, same)
+
+	same, err = utils.RunsSameConfiguration(cfg, req, &types.Container{State: "exited"}, image, environment, secrets)
+	assert.NoError(t, err)
+	assert.False(t, same, "stopped containers are recreated")
+
+	same, err = utils.RunsSameConfiguration(cfg, req, running, image, environment, map[string]string{"PASSWORD": "rotated"})
+	assert.NoError(t, err)
+	assert.False(t, same, "a rotated secret changes the configuration")
+
+	same, err = utils.RunsSameConfiguration(cfg, req, running, "docker.io/library/nginx:1.26", environment, secrets)
+	assert.NoError(t, err)
+	assert.False(t, same)
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/docker/docker/api/types/container"
+	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/domain"
+	dockerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+// validateDeployRequest checks the docker specific fields on top of the common rules
+func validateDeployRequest(deployImageRequest *v1.DeployImageRequest) error {
+	return v1.ValidateDeployImageRequest(deployImageRequest,
+		validateContainerName, validateRestartPolicy, validateIsolation, validateRuntime, validateNetworkOptions, validateLogFiles,
+		validateRestartBudget, validateExpressions)
+}
+
+// validateContainerName checks the name of the docker container, the prefix may come from the mount path
+// and it is joined with the name of the container, so the parts can be valid on their own
+func validateContainerName(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	prefix := getContainerPrefix(deployImageRequest)
+	if prefix != "" && prefix != deployImageRequest.InstanceConfig.ContainerPreName {
+		if err := domain.IsCompliantDNS(prefix); err != nil {
+			result.Add("InstanceConfig.mountPath", err.Error(), "the mount path is used as the prefix of the container")
+		}
+	}
+
+	containerName := getContainerName(deployImageRequest)
+	if len(containerName) > validation.DNS1123LabelMaxLength {
+		result.Add("ContainerConfig.container",
+			fmt.Sprintf("the name of the container %s is longer than %d characters", containerName, validation.DNS1123LabelMaxLength),
+			"shorten the prefix or the name of the container")
+	}
+}
+
+func validateRestartPolicy(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	policy := container.RestartPolicy{Name: deployImageRequest.ContainerConfig.RestartPolicy}
+	if err := container.ValidateRestartPolicy(policy); err != nil {
+		result.Add("ContainerConfig.restartPolicy", err.Error(), "")
+	}
+}
+
+// validateIsolation only checks the value, the daemon decides whether it is supported
+func validateIsolation(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	mode := container.Isolation(strings.ToLower(deployImageRequest.ContainerConfig.Isolation))
+	if mode == "" || mode.IsDefault() || mode.IsHyperV() || mode.IsProcess() {
+		return
+	}
+
+	result.Add("ContainerConfig.isolation", fmt.Sprintf("invalid isolation mode: %s", deployImageRequest.ContainerConfig.Isolation),
+		"use default, process or hyperv")
+}
+
+// validateRuntime only checks the values, the deployment checks whether the daemon has the runtime
+func validateRuntime(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	runtime := deployImageRequest.ContainerConfig.Runtime
+	if strings.ContainsFunc(runtime, unicode.IsSpace) {
+		result.Add("ContainerConfig.runtime", fmt.Sprintf("invalid runtime name: %q", runtime),
+			"use the name of the runtime on the daemon, eg. nvidia")
+	}
+
+	for key := range deployImageRequest.ContainerConfig.RuntimeOptions {
+		if strings.TrimSpace(key) == "" {
+			result.Add("ContainerConfig.runtimeOptions", "empty option name", "")
+		}
+	}
+}
+
+func validateNetworkOptions(deployImageRequest *v1.DeployImageRequest, result *v1.ValidationError) {
+	names := maps.Keys(deployImageRequest.ContainerConfig.NetworkOptions)
+	sort.Strings(names)
+
+	for _, name := range names {
+		options := deployImageRequest.ContainerConfig.NetworkOptions[name]
+		if err := dockerbuilder.ValidateNetworkOptions(name, &options); err != nil {
+			result.Add(fmt.Sprintf("ContainerConfig.networkOptions[%q]", name), err.Error(), "")
+		}
+	}
+}
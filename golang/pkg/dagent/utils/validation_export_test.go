@@ -0,0 +1,3 @@
+package utils
+
+var ValidateDeployRequest = validateDeployRequest
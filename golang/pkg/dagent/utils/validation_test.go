@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func dagentDeployRequest() *v1.DeployImageRequest {
+	return &v1.DeployImageRequest{
+		RequestID:      "request-id",
+		ImageName:      "library/nginx",
+		Tag:            "1.25",
+		InstanceConfig: v1.InstanceConfig{ContainerPreName: "prefix"},
+		ContainerConfig: v1.ContainerConfig{
+			Container:     "web",
+			RestartPolicy: container.RestartPolicyUnlessStopped,
+		},
+	}
+}
+
+func validationPaths(t *testing.T, err error) []string {
+	t.Helper()
+
+	validationErr := &v1.ValidationError{}
+	if !assert.ErrorAs(t, err, &validationErr) {
+		return nil
+	}
+
+	paths := []string{}
+	for _, field := range validationErr.Fields {
+		paths = append(paths, field.Path)
+	}
+
+	return paths
+}
+
+func TestValidateDeployRequest(t *testing.T) {
+	assert.NoError(t, utils.ValidateDeployRequest(dagentDeployRequest()))
+
+	req := dagentDeployRequest()
+	req.InstanceConfig.MountPath = "Mount_Path"
+	req.ContainerConfig.RestartPolicy = "sometimes"
+	req.ContainerConfig.Isolation = "vm"
+	req.ContainerConfig.Runtime = "kata runtime"
+	req.ContainerConfig.NetworkOptions = map[string]builder.NetworkOptions{
+		"lan": {Driver: "macvlan", Subnet: "not-a-subnet"},
+	}
+
+	assert.Equal(t, []string{
+		"InstanceConfig.mountPath",
+		"ContainerConfig.restartPolicy",
+		"ContainerConfig.isolation",
+		"ContainerConfig.runtime",
+		`ContainerConfig.networkOptions["lan"]`,
+	}, validationPaths(t, utils.ValidateDeployRequest(req)))
+}
+
+func TestValidateDeployRequestContainerNameLength(t *testing.T) {
+	req := dagentDeployRequest()
+	req.InstanceConfig.ContainerPreName = strings.Repeat("p", 40)
+	req.ContainerConfig.Container = strings.Repeat("c", 40)
+
+	err := utils.ValidateDeployRequest(req)
+	assert.Equal(t, []string{"ContainerConfig.container"}, validationPaths(t, err))
+	assert.ErrorContains(t, err, "is longer than 63 characters, shorten the prefix or the name of the container")
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// unknownVolumeSize is reported by the engine if the driver does not measure the volume
+const unknownVolumeSize = -1
+
+func volumeUsageContainerIdentifier(cont *types.Container) *common.ContainerIdentifier {
+	prefix := cont.Labels[label.DyrectorioOrg+label.ContainerPrefix]
+	name := containerDisplayName(cont)
+	if prefix != "" {
+		name = strings.TrimPrefix(name, prefix+"-")
+	}
+
+	return &common.ContainerIdentifier{
+		Prefix: prefix,
+		Name:   name,
+	}
+}
+
+// mapVolumeUsage joins the volumes of the disk usage with the containers mounting them,
+// volumes not used by any of the containers are left out, the largest volume comes first
+func mapVolumeUsage(volumes []*volume.Volume, containers []types.Container) []*agent.VolumeUsage {
+	mountedBy := map[string][]*common.ContainerIdentifier{}
+	for i := range containers {
+		for _, mountPoint := range containers[i].Mounts {
+			if mountPoint.Type != mount.TypeVolume || mountPoint.Name == "" {
+				continue
+			}
+
+			mountedBy[mountPoint.Name] = append(mountedBy[mountPoint.Name], volumeUsageContainerIdentifier(&containers[i]))
+		}
+	}
+
+	usage := []*agent.VolumeUsage{}
+	for _, vol := range volumes {
+		if vol == nil || len(mountedBy[vol.Name]) == 0 {
+			continue
+		}
+
+		size := int64(unknownVolumeSize)
+		if vol.UsageData != nil {
+			size = vol.UsageData.Size
+		}
+
+		usage = append(usage, &agent.VolumeUsage{
+			Name:       vol.Name,
+			Driver:     vol.Driver,
+			Size:       size,
+			Containers: mountedBy[vol.Name],
+		})
+	}
+
+	sort.SliceStable(usage, func(i, j int) bool {
+		if usage[i].Size != usage[j].Size {
+			return usage[i].Size > usage[j].Size
+		}
+
+		return usage[i].Name < usage[j].Name
+	})
+
+	return usage
+}
+
+// VolumeUsage measures the volumes of the managed containers, like `docker system df -v` does
+func VolumeUsage(ctx context.Context, request *agent.VolumeUsageRequest) ([]*agent.VolumeUsage, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	managedFilter := label.DyrectorioOrg + label.ContainerPrefix
+	if request.GetPrefix() != "" {
+		managedFilter = label.GetPrefixLabelFilter(request.GetPrefix())
+	}
+
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", managedFilter)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diskUsage, err := cli.DiskUsage(ctx, types.DiskUsageOptions{Types: []types.DiskUsageObject{types.VolumeObject}})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapVolumeUsage(diskUsage.Volumes, containers), nil
+}
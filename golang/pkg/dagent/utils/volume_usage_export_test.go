@@ -0,0 +1,3 @@
+package utils
+
+var MapVolumeUsage = mapVolumeUsage
@@ -0,0 +1,71 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/label"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestMapVolumeUsage(t *testing.T) {
+	prefixLabel := label.DyrectorioOrg + label.ContainerPrefix
+	containers := []types.Container{
+		{
+			Names:  []string{"/shop-db"},
+			Labels: map[string]string{prefixLabel: "shop"},
+			Mounts: []types.MountPoint{
+				{Type: mount.TypeVolume, Name: "shop-db-data"},
+				{Type: mount.TypeBind, Source: "/srv/dagent/shop/db"},
+			},
+		},
+		{
+			Names:  []string{"/shop-backup"},
+			Labels: map[string]string{prefixLabel: "shop"},
+			Mounts: []types.MountPoint{
+				{Type: mount.TypeVolume, Name: "shop-db-data"},
+				{Type: mount.TypeVolume, Name: "shop-backup-cache"},
+			},
+		},
+	}
+
+	volumes := []*volume.Volume{
+		{Name: "shop-backup-cache", Driver: "local", UsageData: &volume.UsageData{Size: 1024, RefCount: 1}},
+		{Name: "shop-db-data", Driver: "local", UsageData: &volume.UsageData{Size: 4096, RefCount: 2}},
+		{Name: "unmanaged", Driver: "local", UsageData: &volume.UsageData{Size: 8192}},
+		{Name: "nfs", Driver: "nfs"},
+	}
+
+	usage := utils.MapVolumeUsage(volumes, containers)
+	assert.Len(t, usage, 2)
+
+	assert.Equal(t, "shop-db-data", usage[0].Name)
+	assert.Equal(t, int64(4096), usage[0].Size)
+	assert.Equal(t, []*common.ContainerIdentifier{
+		{Prefix: "shop", Name: "db"},
+		{Prefix: "shop", Name: "backup"},
+	}, usage[0].Containers)
+
+	assert.Equal(t, "shop-backup-cache", usage[1].Name)
+	assert.Equal(t, int64(1024), usage[1].Size)
+}
+
+func TestMapVolumeUsageUnknownSize(t *testing.T) {
+	containers := []types.Container{
+		{Names: []string{"/db"}, Mounts: []types.MountPoint{{Type: mount.TypeVolume, Name: "nfs"}}},
+	}
+
+	usage := utils.MapVolumeUsage([]*volume.Volume{{Name: "nfs", Driver: "nfs"}}, containers)
+	assert.Len(t, usage, 1)
+	assert.Equal(t, int64(-1), usage[0].Size)
+	assert.Equal(t, "db", usage[0].Containers[0].Name)
+	assert.Equal(t, "", usage[0].Containers[0].Prefix)
+}
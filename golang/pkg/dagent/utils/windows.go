@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	containerRuntime "github.com/dyrector-io/dyrectorio/golang/internal/runtime/container"
+)
+
+const (
+	namedPipePrefix      = `\\.\pipe\`
+	namedPipeSlashPrefix = `//./pipe/`
+	windowsSeparator     = `\`
+	nanoPerMilli         = 1_000_000
+)
+
+var ErrIsolationNotSupported = errors.New("isolation mode is only supported on Windows daemons")
+
+// the OS of the daemon does not change while the agent runs, it is not queried again for every deployment
+var daemonOS struct {
+	windows *bool
+	sync.Mutex
+}
+
+func isWindowsDaemon(ctx context.Context, cli client.APIClient) (bool, error) {
+	daemonOS.Lock()
+	defer daemonOS.Unlock()
+
+	if daemonOS.windows != nil {
+		return *daemonOS.windows, nil
+	}
+
+	windows, err := containerRuntime.IsWindowsDaemon(ctx, cli)
+	if err != nil {
+		return false, err
+	}
+
+	daemonOS.windows = &windows
+	return windows, nil
+}
+
+func isNamedPipe(source string) bool {
+	return strings.HasPrefix(source, namedPipePrefix) || strings.HasPrefix(source, namedPipeSlashPrefix)
+}
+
+// drive letter paths, eg. C:\data or C:/data
+func isWindowsAbsPath(source string) bool {
+	if len(source) < len(`C:\`) || source[1] != ':' || (source[2] != '\\' && source[2] != '/') {
+		return false
+	}
+
+	return unicode.IsLetter(rune(source[0]))
+}
+
+func isAbsHostPath(source string, windows bool) bool {
+	if windows {
+		return isWindowsAbsPath(source)
+	}
+
+	return strings.HasPrefix(source, "/")
+}
+
+// joinHostPath joins paths of the daemon's host, the agent itself may run on a different OS
+func joinHostPath(windows bool, elem ...string) string {
+	if !windows {
+		return path.Join(elem...)
+	}
+
+	parts := []string{}
+	for i, e := range elem {
+		e = strings.ReplaceAll(e, "/", windowsSeparator)
+		if i > 0 {
+			e = strings.TrimLeft(e, windowsSeparator)
+		}
+		e = strings.TrimRight(e, windowsSeparator)
+		if e != "" {
+			parts = append(parts, e)
+		}
+	}
+
+	return strings.Join(parts, windowsSeparator)
+}
+
+func mapIsolation(isolation string, windows bool) (container.Isolation, error) {
+	mode := container.Isolation(strings.ToLower(isolation))
+	if mode == "" || mode.IsDefault() {
+		return "", nil
+	}
+
+	if !windows {
+		return "", fmt.Errorf("%w: %s", ErrIsolationNotSupported, isolation)
+	}
+
+	if !mode.IsHyperV() && !mode.IsProcess() {
+		return "", fmt.Errorf("invalid isolation mode: %s", isolation)
+	}
+
+	return mode, nil
+}
+
+func parseQuantity(value string, apply func(quantity resource.Quantity)) error {
+	if value == "" {
+		return nil
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return err
+	}
+
+	apply(quantity)
+	return nil
+}
+
+// mapResourceConfig converts the k8s style limits to the resources of Windows containers,
+// it is only used for Windows daemons, they have no memory reservation nor cpu shares, requests are ignored
+func mapResourceConfig(resourceConfig v1.ResourceConfig) (*container.Resources, error) {
+	limits, requests := resourceConfig.Limits, resourceConfig.Requests
+	if limits.CPU == "" && limits.Memory == "" && requests.CPU == "" && requests.Memory == "" {
+		return nil, nil
+	}
+
+	resources := &container.Resources{}
+
+	err := parseQuantity(limits.CPU, func(cpu resource.Quantity) {
+		resources.NanoCPUs = cpu.MilliValue() * nanoPerMilli
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid cpu limit: %w", err)
+	}
+
+	err = parseQuantity(limits.Memory, func(memory resource.Quantity) {
+		resources.Memory = memory.Value()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid memory limit: %w", err)
+	}
+
+	if requests.CPU != "" || requests.Memory != "" {
+		log.Debug().Msg("Resource requests are not supported by Windows daemons, ignoring")
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,8 @@
+package utils
+
+var (
+	JoinHostPath      = joinHostPath
+	IsAbsHostPath     = isAbsHostPath
+	MapIsolation      = mapIsolation
+	MapResourceConfig = mapResourceConfig
+)
@@ -0,0 +1,70 @@
+//go:build unit
+// +build unit
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
+)
+
+func TestJoinHostPath(t *testing.T) {
+	assert.Equal(t, "/srv/dagent/prefix/name/data", utils.JoinHostPath(false, "/srv/dagent", "prefix", "name", "data"))
+	assert.Equal(t, `C:\dagent\prefix\name\data`, utils.JoinHostPath(true, `C:\dagent\`, "prefix", "name", "/data"))
+	assert.Equal(t, `C:\dagent\prefix\name\data\nested`, utils.JoinHostPath(true, "C:/dagent", "prefix", "name", "data/nested"))
+}
+
+func TestIsAbsHostPath(t *testing.T) {
+	assert.True(t, utils.IsAbsHostPath("/data", false))
+	assert.False(t, utils.IsAbsHostPath(`C:\data`, false))
+	assert.True(t, utils.IsAbsHostPath(`C:\data`, true))
+	assert.True(t, utils.IsAbsHostPath("d:/data", true))
+	assert.False(t, utils.IsAbsHostPath("data", true))
+	assert.False(t, utils.IsAbsHostPath("/data", true))
+}
+
+func TestMapIsolation(t *testing.T) {
+	isolation, err := utils.MapIsolation("", false)
+	assert.NoError(t, err)
+	assert.Equal(t, container.Isolation(""), isolation)
+
+	isolation, err = utils.MapIsolation("default", false)
+	assert.NoError(t, err)
+	assert.Equal(t, container.Isolation(""), isolation)
+
+	_, err = utils.MapIsolation("hyperv", false)
+	assert.ErrorIs(t, err, utils.ErrIsolationNotSupported)
+
+	isolation, err = utils.MapIsolation("process", true)
+	assert.NoError(t, err)
+	assert.Equal(t, container.IsolationProcess, isolation)
+
+	_, err = utils.MapIsolation("vm", true)
+	assert.Error(t, err)
+}
+
+func TestMapResourceConfig(t *testing.T) {
+	resources, err := utils.MapResourceConfig(v1.ResourceConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, resources)
+
+	config := v1.ResourceConfig{
+		Limits:   v1.Resources{CPU: "500m", Memory: "512Mi"},
+		Requests: v1.Resources{CPU: "250m", Memory: "64Mi"},
+	}
+
+	resources, err = utils.MapResourceConfig(config)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500_000_000), resources.NanoCPUs)
+	assert.Equal(t, int64(512*1024*1024), resources.Memory)
+	assert.Equal(t, int64(0), resources.CPUShares)
+	assert.Equal(t, int64(0), resources.MemoryReservation)
+
+	_, err = utils.MapResourceConfig(v1.ResourceConfig{Limits: v1.Resources{CPU: "lots"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,461 @@
+// Package dockerfake is an in-memory docker client for the tests of the builder, the helpers and the agents,
+// so they can run without a daemon. Only the calls of these packages are implemented, the others panic.
+package dockerfake
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/system"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+)
+
+const (
+	APIVersion    = "1.45"
+	ServerVersion = "26.1.5"
+
+	defaultPullLayers = 3
+	defaultRuntime    = "runc"
+	layerSize         = 1 << 20
+)
+
+// Call is a recorded call of the client, Target is the image, container, network or volume of the call
+type Call struct {
+	Method string
+	Target string
+}
+
+// Process is what the containers of an image do after they start
+type Process struct {
+	Stdout string
+	Stderr string
+	// RunFor is the time the container runs for if it exits, it exits right after the start by default
+	RunFor   time.Duration
+	ExitCode int64
+	Exits    bool
+	// OOMKilled is reported by the inspect of the container after it exits, eg. with ExitCode 137
+	OOMKilled bool
+}
+
+// Client implements client.APIClient in memory. The images are pulled with simulated progress, the container
+// names conflict like on a daemon and the starts can be slowed down, see PullLayers and StartDelay.
+type Client struct {
+	client.APIClient
+	images     map[string]*image.Summary
+	containers map[string]*fakeContainer
+	networks   map[string]*types.NetworkResource
+	volumes    map[string]*volume.Volume
+	processes  map[string]Process
+	failures   map[string]error
+	calls      []Call
+	// Runtimes are the OCI runtimes configured on the daemon, the containers of other runtimes are not created
+	Runtimes []string
+	// PullLayers is the number of layers reported by the pull progress
+	PullLayers int
+	// StartDelay is the time a container takes to start, the start fails if the context is done before
+	StartDelay time.Duration
+	sequence   int
+	mutex      sync.Mutex
+}
+
+func New() *Client {
+	return &Client{
+		images:     map[string]*image.Summary{},
+		containers: map[string]*fakeContainer{},
+		networks:   map[string]*types.NetworkResource{},
+		volumes:    map[string]*volume.Volume{},
+		processes:  map[string]Process{},
+		failures:   map[string]error{},
+		PullLayers: defaultPullLayers,
+		Runtimes:   []string{defaultRuntime},
+	}
+}
+
+// normalizeImage expands the reference like the daemon, eg. nginx is docker.io/library/nginx:latest
+func normalizeImage(ref string) string {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return ref
+	}
+
+	return reference.TagNameOnly(named).String()
+}
+
+func (c *Client) nextID(seed string) string {
+	c.sequence++
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%s-%d", seed, c.sequence))))
+}
+
+// record adds the call and returns the error set by FailOn, the lock must be held
+func (c *Client) record(method, target string) error {
+	c.calls = append(c.calls, Call{Method: method, Target: target})
+
+	err, ok := c.failures[method]
+	if ok {
+		delete(c.failures, method)
+	}
+
+	return err
+}
+
+// FailOn makes the next call of the method return the error, eg. FailOn("ImagePull", err)
+func (c *Client) FailOn(method string, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.failures[method] = err
+}
+
+// SetProcess sets what the containers of the image do after they start, they keep running by default
+func (c *Client) SetProcess(imageRef string, process Process) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.processes[normalizeImage(imageRef)] = process
+}
+
+// AddImage adds a local image without pulling it
+func (c *Client) AddImage(imageRef string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.addImage(normalizeImage(imageRef))
+}
+
+func (c *Client) addImage(ref string) *image.Summary {
+	if existing, ok := c.images[ref]; ok {
+		return existing
+	}
+
+	named, _ := reference.ParseNormalizedNamed(ref)
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(ref)))
+	summary := &image.Summary{
+		ID:       "sha256:" + c.nextID(ref),
+		RepoTags: []string{ref},
+		Created:  time.Now().Unix(),
+		Size:     int64(c.PullLayers) * layerSize,
+	}
+	if named != nil {
+		summary.RepoDigests = []string{fmt.Sprintf("%s@%s", reference.FamiliarName(named), digest)}
+	}
+	c.images[ref] = summary
+
+	return summary
+}
+
+// Calls are the recorded calls in order
+func (c *Client) Calls() []Call {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]Call{}, c.calls...)
+}
+
+// CallCount is the number of the calls of the method
+func (c *Client) CallCount(method string) int {
+	count := 0
+	for _, call := range c.Calls() {
+		if call.Method == method {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (c *Client) Close() error {
+	return nil
+}
+
+func (c *Client) ClientVersion() string {
+	return APIVersion
+}
+
+func (c *Client) DaemonHost() string {
+	return "fake://dockerfake"
+}
+
+func (c *Client) NegotiateAPIVersion(_ context.Context) {}
+
+func (c *Client) Ping(_ context.Context) (types.Ping, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("Ping", ""); err != nil {
+		return types.Ping{}, err
+	}
+
+	return types.Ping{APIVersion: APIVersion, OSType: "linux"}, nil
+}
+
+func (c *Client) ServerVersion(_ context.Context) (types.Version, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ServerVersion", ""); err != nil {
+		return types.Version{}, err
+	}
+
+	return types.Version{Version: ServerVersion, APIVersion: APIVersion, Os: "linux", Arch: "amd64"}, nil
+}
+
+func (c *Client) Info(_ context.Context) (system.Info, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("Info", ""); err != nil {
+		return system.Info{}, err
+	}
+
+	runtimes := map[string]system.RuntimeWithStatus{}
+	for _, name := range c.Runtimes {
+		runtimes[name] = system.RuntimeWithStatus{Runtime: system.Runtime{Path: name}}
+	}
+
+	return system.Info{
+		OSType:         "linux",
+		InitBinary:     "docker-init",
+		ServerVersion:  ServerVersion,
+		DefaultRuntime: defaultRuntime,
+		Runtimes:       runtimes,
+	}, nil
+}
+
+func (c *Client) pullProgress(ref string, existed bool) []byte {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+
+	// the messages of the daemon, the errors of the encoder can be ignored writing into a buffer
+	message := func(id, status string, current, total int) {
+		progress := map[string]interface{}{"status": status}
+		if id != "" {
+			progress["id"] = id
+		}
+		if total > 0 {
+			progress["progressDetail"] = map[string]int{"current": current, "total": total}
+		}
+		_ = encoder.Encode(progress)
+	}
+
+	if existed {
+		message("", "Status: Image is up to date for "+ref, 0, 0)
+		return buffer.Bytes()
+	}
+
+	named, _ := reference.ParseNormalizedNamed(ref)
+	if tagged, ok := named.(reference.Tagged); ok {
+		message(tagged.Tag(), "Pulling from "+reference.FamiliarName(named), 0, 0)
+	}
+	for i := 0; i < c.PullLayers; i++ {
+		message(fmt.Sprintf("layer%d", i), "Pulling fs layer", 0, 0)
+	}
+	for i := 0; i < c.PullLayers; i++ {
+		id := fmt.Sprintf("layer%d", i)
+		message(id, "Downloading", layerSize/2, layerSize)
+		message(id, "Downloading", layerSize, layerSize)
+		message(id, "Download complete", 0, 0)
+		message(id, "Pull complete", 0, 0)
+	}
+	message("", fmt.Sprintf("Digest: sha256:%x", sha256.Sum256([]byte(ref))), 0, 0)
+	message("", "Status: Downloaded newer image for "+ref, 0, 0)
+
+	return buffer.Bytes()
+}
+
+func (c *Client) ImagePull(_ context.Context, ref string, _ image.PullOptions) (io.ReadCloser, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ImagePull", ref); err != nil {
+		return nil, err
+	}
+
+	normalized := normalizeImage(ref)
+	_, existed := c.images[normalized]
+	c.addImage(normalized)
+
+	return io.NopCloser(bytes.NewReader(c.pullProgress(normalized, existed))), nil
+}
+
+func (c *Client) ImageList(_ context.Context, options image.ListOptions) ([]image.Summary, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ImageList", strings.Join(options.Filters.Get("reference"), ",")); err != nil {
+		return nil, err
+	}
+
+	images := []image.Summary{}
+	for ref, summary := range c.images {
+		references := options.Filters.Get("reference")
+		if len(references) > 0 && normalizeImage(references[0]) != ref {
+			continue
+		}
+
+		images = append(images, *summary)
+	}
+
+	return images, nil
+}
+
+func (c *Client) findImage(ref string) *image.Summary {
+	if summary, ok := c.images[normalizeImage(ref)]; ok {
+		return summary
+	}
+
+	for _, summary := range c.images {
+		if summary.ID == ref || strings.TrimPrefix(summary.ID, "sha256:") == ref {
+			return summary
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) ImageInspectWithRaw(_ context.Context, ref string) (types.ImageInspect, []byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ImageInspectWithRaw", ref); err != nil {
+		return types.ImageInspect{}, nil, err
+	}
+
+	summary := c.findImage(ref)
+	if summary == nil {
+		return types.ImageInspect{}, nil, errdefs.NotFound(fmt.Errorf("No such image: %s", ref))
+	}
+
+	inspect := types.ImageInspect{
+		ID:          summary.ID,
+		RepoTags:    summary.RepoTags,
+		RepoDigests: summary.RepoDigests,
+		Size:        summary.Size,
+		Os:          "linux",
+	}
+	raw, err := json.Marshal(inspect)
+
+	return inspect, raw, err
+}
+
+func (c *Client) ImageRemove(_ context.Context, ref string, options image.RemoveOptions) ([]image.DeleteResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ImageRemove", ref); err != nil {
+		return nil, err
+	}
+
+	summary := c.findImage(ref)
+	if summary == nil {
+		return nil, errdefs.NotFound(fmt.Errorf("No such image: %s", ref))
+	}
+
+	for _, cont := range c.containers {
+		if cont.inspect.Image == summary.ID && !options.Force {
+			return nil, errdefs.Conflict(fmt.Errorf("unable to delete %s: image is being used by container %s",
+				ref, cont.inspect.ID[:12]))
+		}
+	}
+
+	delete(c.images, summary.RepoTags[0])
+
+	return []image.DeleteResponse{{Untagged: summary.RepoTags[0]}, {Deleted: summary.ID}}, nil
+}
+
+// ImageLoad adds the tagged images of the tarball written by docker save or go-containerregistry
+func (c *Client) ImageLoad(_ context.Context, input io.Reader, _ bool) (types.ImageLoadResponse, error) {
+	manifests := []struct {
+		RepoTags []string
+	}{}
+
+	archive := tar.NewReader(input)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return types.ImageLoadResponse{}, err
+		}
+
+		if header.Name == "manifest.json" {
+			if err = json.NewDecoder(archive).Decode(&manifests); err != nil {
+				return types.ImageLoadResponse{}, err
+			}
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ImageLoad", ""); err != nil {
+		return types.ImageLoadResponse{}, err
+	}
+
+	buffer := &bytes.Buffer{}
+	for _, manifest := range manifests {
+		for _, tag := range manifest.RepoTags {
+			ref := normalizeImage(tag)
+			delete(c.images, ref)
+			c.addImage(ref)
+			_, _ = fmt.Fprintf(buffer, "{\"stream\":\"Loaded image: %s\\n\"}\n", tag)
+		}
+	}
+
+	return types.ImageLoadResponse{Body: io.NopCloser(buffer), JSON: true}, nil
+}
+
+// ImageSave writes a tarball of the images with the manifest docker load reads, the layers are left out
+func (c *Client) ImageSave(_ context.Context, refs []string) (io.ReadCloser, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	manifests := []map[string]any{}
+	for _, ref := range refs {
+		if err := c.record("ImageSave", ref); err != nil {
+			return nil, err
+		}
+
+		summary := c.findImage(ref)
+		if summary == nil {
+			return nil, errdefs.NotFound(fmt.Errorf("reference does not exist: %s", ref))
+		}
+		manifests = append(manifests, map[string]any{
+			"Config":   strings.TrimPrefix(summary.ID, "sha256:") + ".json",
+			"RepoTags": summary.RepoTags,
+			"Layers":   []string{},
+		})
+	}
+
+	manifest, err := json.Marshal(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+	archive := tar.NewWriter(buffer)
+	if err = archive.WriteHeader(&tar.Header{Name: "manifest.json", Mode: 0o644, Size: int64(len(manifest))}); err != nil {
+		return nil, err
+	}
+	if _, err = archive.Write(manifest); err != nil {
+		return nil, err
+	}
+	if err = archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(buffer), nil
+}
@@ -0,0 +1,140 @@
+//go:build unit
+// +build unit
+
+package dockerfake_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
+	"github.com/stretchr/testify/assert"
+
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/docker"
+	imageHelper "github.com/dyrector-io/dyrectorio/golang/internal/helper/image"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dockerfake"
+)
+
+type stringWriter struct {
+	lines []string
+}
+
+func (w *stringWriter) WriteString(s string) (int, error) {
+	w.lines = append(w.lines, s)
+	return len(s), nil
+}
+
+func builder(ctx context.Context, cli *dockerfake.Client, name string) containerbuilder.Builder {
+	return containerbuilder.NewDockerBuilder(ctx).
+		WithClient(cli).
+		WithImage("nginx:latest").
+		WithImagePriority(imageHelper.ForcePull).
+		WithName(name)
+}
+
+func TestBuilderCreateAndStart(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+
+	cont, err := builder(ctx, cli, "web").WithLabels(map[string]string{"app": "web"}).CreateAndStart()
+	assert.NoError(t, err)
+	assert.Equal(t, "web", cont.GetName())
+
+	running, err := dockerHelper.GetContainerByName(ctx, cli, "web")
+	assert.NoError(t, err)
+	assert.Equal(t, "running", running.State)
+	assert.Equal(t, "web", running.Labels["app"])
+	assert.Equal(t, 1, cli.CallCount("ImagePull"))
+
+	_, err = builder(ctx, cli, "web").Create()
+	assert.True(t, errdefs.IsConflict(err))
+	assert.Equal(t, 2, cli.CallCount("ContainerCreate"))
+
+	_, err = builder(ctx, cli, "web").WithoutConflict().Create()
+	assert.NoError(t, err)
+
+	replaced, err := dockerHelper.GetContainerByName(ctx, cli, "web")
+	assert.NoError(t, err)
+	assert.NotEqual(t, running.ID, replaced.ID)
+	assert.Equal(t, "created", replaced.State)
+	assert.Equal(t, 1, cli.CallCount("ContainerStop"))
+}
+
+func TestNameConflict(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+	cli.AddImage("nginx")
+
+	_, err := cli.ContainerCreate(ctx, &container.Config{Image: "nginx"}, nil, nil, nil, "web")
+	assert.NoError(t, err)
+
+	_, err = cli.ContainerCreate(ctx, &container.Config{Image: "nginx"}, nil, nil, nil, "web")
+	assert.True(t, errdefs.IsConflict(err))
+
+	_, err = cli.ContainerCreate(ctx, &container.Config{Image: "redis"}, nil, nil, nil, "cache")
+	assert.True(t, errdefs.IsNotFound(err))
+}
+
+func TestRunAndCapture(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+	cli.SetProcess("nginx:latest", dockerfake.Process{Exits: true, ExitCode: 3, Stdout: "out\n", Stderr: "err\n"})
+
+	result, err := builder(ctx, cli, "one-shot").RunAndCapture(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), result.ExitCode)
+	assert.Equal(t, "out\n", result.Stdout)
+	assert.Equal(t, "err\n", result.Stderr)
+
+	remaining, err := dockerHelper.GetAllContainersByName(ctx, cli, "one-shot")
+	assert.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestRunAndCaptureOOMKilled(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+	cli.SetProcess("nginx:latest", dockerfake.Process{Exits: true, ExitCode: 137, OOMKilled: true})
+
+	result, err := builder(ctx, cli, "one-shot").RunAndCapture(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(137), result.ExitCode)
+	assert.Equal(t, "SIGKILL", result.Signal())
+	assert.True(t, result.OOMKilled)
+}
+
+func TestSlowStart(t *testing.T) {
+	cli := dockerfake.New()
+	cli.StartDelay = time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := builder(ctx, cli, "slow").CreateAndStart()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPullProgress(t *testing.T) {
+	ctx := context.Background()
+	cli := dockerfake.New()
+	logger := &stringWriter{}
+
+	err := imageHelper.Pull(ctx, cli, logger, "docker.io/library/nginx:latest", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Pulling image: docker.io/library/nginx:latest", logger.lines[0])
+	assert.True(t, strings.HasPrefix(logger.lines[1], "Image: "))
+
+	summary, err := imageHelper.GetImageByReference(ctx, cli, "nginx")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docker.io/library/nginx:latest"}, summary.RepoTags)
+
+	pullErr := errors.New("pull access denied")
+	cli.FailOn("ImagePull", pullErr)
+	assert.ErrorIs(t, imageHelper.Pull(ctx, cli, nil, "nginx", ""), pullErr)
+	assert.NoError(t, imageHelper.Pull(ctx, cli, nil, "nginx", ""))
+}
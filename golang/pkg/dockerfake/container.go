@@ -0,0 +1,430 @@
+package dockerfake
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	stateCreated = "created"
+	stateRunning = "running"
+	stateExited  = "exited"
+)
+
+type fakeContainer struct {
+	inspect   types.ContainerJSON
+	created   time.Time
+	stopTimer *time.Timer
+	waiters   []chan container.WaitResponse
+	process   Process
+}
+
+// findContainer looks up the container by ID, ID prefix or name like the daemon, the lock must be held
+func (c *Client) findContainer(idOrName string) *fakeContainer {
+	name := "/" + strings.TrimPrefix(idOrName, "/")
+	for id, cont := range c.containers {
+		if id == idOrName || cont.inspect.Name == name {
+			return cont
+		}
+	}
+
+	for id, cont := range c.containers {
+		if idOrName != "" && strings.HasPrefix(id, idOrName) {
+			return cont
+		}
+	}
+
+	return nil
+}
+
+func noSuchContainer(idOrName string) error {
+	return errdefs.NotFound(fmt.Errorf("No such container: %s", idOrName))
+}
+
+func (c *Client) ContainerCreate(_ context.Context, config *container.Config, hostConfig *container.HostConfig,
+	networkingConfig *network.NetworkingConfig, _ *ocispec.Platform, containerName string,
+) (container.CreateResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerCreate", containerName); err != nil {
+		return container.CreateResponse{}, err
+	}
+
+	summary := c.findImage(config.Image)
+	if summary == nil {
+		return container.CreateResponse{}, errdefs.NotFound(fmt.Errorf("No such image: %s", config.Image))
+	}
+
+	id := c.nextID(containerName)
+	if containerName == "" {
+		containerName = id[:12]
+	}
+	if existing := c.findContainer(containerName); existing != nil && existing.inspect.Name == "/"+containerName {
+		return container.CreateResponse{}, errdefs.Conflict(fmt.Errorf(
+			"Conflict. The container name \"/%s\" is already in use by container \"%s\". "+
+				"You have to remove (or rename) that container to be able to reuse that name.", containerName, existing.inspect.ID))
+	}
+
+	if hostConfig == nil {
+		hostConfig = &container.HostConfig{}
+	}
+	if hostConfig.Runtime != "" && !slices.Contains(c.Runtimes, hostConfig.Runtime) {
+		return container.CreateResponse{}, errdefs.InvalidParameter(fmt.Errorf("unknown or invalid runtime name: %s", hostConfig.Runtime))
+	}
+	settings := &types.NetworkSettings{Networks: map[string]*network.EndpointSettings{}}
+	if networkingConfig != nil {
+		for name, endpoint := range networkingConfig.EndpointsConfig {
+			settings.Networks[name] = endpoint
+		}
+	}
+
+	now := time.Now()
+	c.containers[id] = &fakeContainer{
+		inspect: types.ContainerJSON{
+			ContainerJSONBase: &types.ContainerJSONBase{
+				ID:         id,
+				Created:    now.Format(time.RFC3339Nano),
+				Name:       "/" + containerName,
+				Image:      summary.ID,
+				State:      &types.ContainerState{Status: stateCreated},
+				HostConfig: hostConfig,
+			},
+			Config:          config,
+			NetworkSettings: settings,
+		},
+		process: c.processes[normalizeImage(config.Image)],
+		created: now,
+	}
+
+	return container.CreateResponse{ID: id}, nil
+}
+
+func (c *Client) ContainerList(_ context.Context, options container.ListOptions) ([]types.Container, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerList", ""); err != nil {
+		return nil, err
+	}
+
+	containers := []types.Container{}
+	for id, cont := range c.containers {
+		name := strings.TrimPrefix(cont.inspect.Name, "/")
+		state := cont.inspect.State.Status
+		switch {
+		case !options.All && state != stateRunning:
+			continue
+		case !options.Filters.Match("id", id):
+			continue
+		case !options.Filters.Match("name", name):
+			continue
+		case !options.Filters.ExactMatch("status", state):
+			continue
+		case !options.Filters.MatchKVList("label", cont.inspect.Config.Labels):
+			continue
+		}
+
+		containers = append(containers, cont.summary())
+	}
+
+	return containers, nil
+}
+
+func (cont *fakeContainer) summary() types.Container {
+	networks := map[string]*network.EndpointSettings{}
+	for name, endpoint := range cont.inspect.NetworkSettings.Networks {
+		networks[name] = endpoint
+	}
+
+	summary := types.Container{
+		ID:              cont.inspect.ID,
+		Names:           []string{cont.inspect.Name},
+		Image:           cont.inspect.Config.Image,
+		ImageID:         cont.inspect.Image,
+		Labels:          cont.inspect.Config.Labels,
+		State:           cont.inspect.State.Status,
+		Status:          cont.inspect.State.Status,
+		Created:         cont.created.Unix(),
+		NetworkSettings: &types.SummaryNetworkSettings{Networks: networks},
+	}
+	summary.HostConfig.NetworkMode = string(cont.inspect.HostConfig.NetworkMode)
+
+	return summary
+}
+
+func (c *Client) ContainerInspect(_ context.Context, idOrName string) (types.ContainerJSON, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerInspect", idOrName); err != nil {
+		return types.ContainerJSON{}, err
+	}
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		return types.ContainerJSON{}, noSuchContainer(idOrName)
+	}
+
+	inspect := cont.inspect
+	state := *cont.inspect.State
+	inspect.ContainerJSONBase = &types.ContainerJSONBase{}
+	*inspect.ContainerJSONBase = *cont.inspect.ContainerJSONBase
+	inspect.State = &state
+
+	return inspect, nil
+}
+
+// ContainerStart waits for StartDelay, then the container runs its process, see SetProcess
+func (c *Client) ContainerStart(ctx context.Context, idOrName string, _ container.StartOptions) error {
+	c.mutex.Lock()
+	err := c.record("ContainerStart", idOrName)
+	delay := c.StartDelay
+	c.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		return noSuchContainer(idOrName)
+	}
+	if cont.inspect.State.Status == stateRunning {
+		return nil
+	}
+
+	cont.inspect.State = &types.ContainerState{
+		Status:    stateRunning,
+		Running:   true,
+		Pid:       c.sequence,
+		StartedAt: time.Now().Format(time.RFC3339Nano),
+	}
+
+	if !cont.process.Exits {
+		return nil
+	}
+	if cont.process.RunFor <= 0 {
+		cont.exit(cont.process.ExitCode, cont.process.OOMKilled)
+		return nil
+	}
+
+	id := cont.inspect.ID
+	cont.stopTimer = time.AfterFunc(cont.process.RunFor, func() {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if current, ok := c.containers[id]; ok && current.inspect.State.Running {
+			current.exit(current.process.ExitCode, current.process.OOMKilled)
+		}
+	})
+
+	return nil
+}
+
+// exit stops the container and notifies the waiting calls, the lock must be held
+func (cont *fakeContainer) exit(code int64, oomKilled bool) {
+	if cont.stopTimer != nil {
+		cont.stopTimer.Stop()
+		cont.stopTimer = nil
+	}
+
+	cont.inspect.State = &types.ContainerState{
+		Status:     stateExited,
+		ExitCode:   int(code),
+		OOMKilled:  oomKilled,
+		StartedAt:  cont.inspect.State.StartedAt,
+		FinishedAt: time.Now().Format(time.RFC3339Nano),
+	}
+
+	for _, waiter := range cont.waiters {
+		waiter <- container.WaitResponse{StatusCode: code}
+		close(waiter)
+	}
+	cont.waiters = nil
+}
+
+func (c *Client) ContainerStop(_ context.Context, idOrName string, _ container.StopOptions) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerStop", idOrName); err != nil {
+		return err
+	}
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		return noSuchContainer(idOrName)
+	}
+	if cont.inspect.State.Running {
+		cont.exit(0, false)
+	}
+
+	return nil
+}
+
+func (c *Client) ContainerRestart(ctx context.Context, idOrName string, options container.StopOptions) error {
+	if err := c.ContainerStop(ctx, idOrName, options); err != nil {
+		return err
+	}
+
+	return c.ContainerStart(ctx, idOrName, container.StartOptions{})
+}
+
+func (c *Client) ContainerRemove(_ context.Context, idOrName string, options container.RemoveOptions) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerRemove", idOrName); err != nil {
+		return err
+	}
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		return noSuchContainer(idOrName)
+	}
+	if cont.inspect.State.Running && !options.Force {
+		return errdefs.Conflict(fmt.Errorf("cannot remove container %s: container is running: "+
+			"stop the container before removing or force remove", cont.inspect.Name))
+	}
+	if cont.inspect.State.Running {
+		cont.exit(0, false)
+	}
+
+	for _, endpoint := range cont.inspect.NetworkSettings.Networks {
+		if net, ok := c.networks[endpoint.NetworkID]; ok {
+			delete(net.Containers, cont.inspect.ID)
+		}
+	}
+	delete(c.containers, cont.inspect.ID)
+
+	return nil
+}
+
+// ContainerUpdate applies the restart policy of the update, the resources are not simulated
+func (c *Client) ContainerUpdate(_ context.Context, idOrName string, updateConfig container.UpdateConfig,
+) (container.ContainerUpdateOKBody, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerUpdate", idOrName); err != nil {
+		return container.ContainerUpdateOKBody{}, err
+	}
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		return container.ContainerUpdateOKBody{}, noSuchContainer(idOrName)
+	}
+	if updateConfig.RestartPolicy.Name != "" {
+		hostConfig := *cont.inspect.HostConfig
+		hostConfig.RestartPolicy = updateConfig.RestartPolicy
+		cont.inspect.HostConfig = &hostConfig
+	}
+
+	return container.ContainerUpdateOKBody{}, nil
+}
+
+// ContainerWait returns when the container exits, not-running returns right away if the container is not running
+func (c *Client) ContainerWait(ctx context.Context, idOrName string, condition container.WaitCondition,
+) (<-chan container.WaitResponse, <-chan error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	results := make(chan container.WaitResponse, 1)
+	errs := make(chan error, 1)
+
+	if err := c.record("ContainerWait", idOrName); err != nil {
+		errs <- err
+		return results, errs
+	}
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		errs <- noSuchContainer(idOrName)
+		return results, errs
+	}
+
+	if condition != container.WaitConditionNextExit && !cont.inspect.State.Running {
+		results <- container.WaitResponse{StatusCode: int64(cont.inspect.State.ExitCode)}
+		return results, errs
+	}
+
+	waiter := make(chan container.WaitResponse, 1)
+	cont.waiters = append(cont.waiters, waiter)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			errs <- ctx.Err()
+		case result := <-waiter:
+			results <- result
+		}
+	}()
+
+	return results, errs
+}
+
+// ContainerLogs returns the output of the process, multiplexed like the daemon unless the container has a TTY
+func (c *Client) ContainerLogs(_ context.Context, idOrName string, options container.LogsOptions) (io.ReadCloser, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("ContainerLogs", idOrName); err != nil {
+		return nil, err
+	}
+
+	cont := c.findContainer(idOrName)
+	if cont == nil {
+		return nil, noSuchContainer(idOrName)
+	}
+	if cont.inspect.State.StartedAt == "" {
+		return io.NopCloser(&bytes.Buffer{}), nil
+	}
+
+	buffer := &bytes.Buffer{}
+	streams := []struct {
+		output string
+		stream stdcopy.StdType
+		show   bool
+	}{
+		{cont.process.Stdout, stdcopy.Stdout, options.ShowStdout},
+		{cont.process.Stderr, stdcopy.Stderr, options.ShowStderr},
+	}
+	for _, stream := range streams {
+		if !stream.show || stream.output == "" {
+			continue
+		}
+
+		var writer io.Writer = buffer
+		if !cont.inspect.Config.Tty {
+			writer = stdcopy.NewStdWriter(buffer, stream.stream)
+		}
+		if _, err := writer.Write([]byte(stream.output)); err != nil {
+			return nil, err
+		}
+	}
+
+	return io.NopCloser(buffer), nil
+}
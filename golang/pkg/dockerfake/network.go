@@ -0,0 +1,235 @@
+package dockerfake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/errdefs"
+)
+
+const defaultNetworkDriver = "bridge"
+
+// findNetwork looks up the network by ID or name, the lock must be held
+func (c *Client) findNetwork(idOrName string) *types.NetworkResource {
+	for id, net := range c.networks {
+		if id == idOrName || net.Name == idOrName {
+			return net
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) NetworkCreate(_ context.Context, name string, options types.NetworkCreate) (types.NetworkCreateResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("NetworkCreate", name); err != nil {
+		return types.NetworkCreateResponse{}, err
+	}
+
+	if c.findNetwork(name) != nil {
+		return types.NetworkCreateResponse{}, errdefs.Conflict(fmt.Errorf("network with name %s already exists", name))
+	}
+
+	driver := options.Driver
+	if driver == "" {
+		driver = defaultNetworkDriver
+	}
+
+	id := c.nextID(name)
+	c.networks[id] = &types.NetworkResource{
+		Name:       name,
+		ID:         id,
+		Driver:     driver,
+		Scope:      "local",
+		Internal:   options.Internal,
+		Attachable: options.Attachable,
+		Labels:     options.Labels,
+		Options:    options.Options,
+		Containers: map[string]types.EndpointResource{},
+	}
+	if options.IPAM != nil {
+		c.networks[id].IPAM = *options.IPAM
+	}
+
+	return types.NetworkCreateResponse{ID: id}, nil
+}
+
+func (c *Client) NetworkList(_ context.Context, options types.NetworkListOptions) ([]types.NetworkResource, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("NetworkList", ""); err != nil {
+		return nil, err
+	}
+
+	networks := []types.NetworkResource{}
+	for id, net := range c.networks {
+		if !options.Filters.Match("name", net.Name) || !options.Filters.Match("id", id) ||
+			!options.Filters.ExactMatch("driver", net.Driver) || !options.Filters.MatchKVList("label", net.Labels) {
+			continue
+		}
+
+		networks = append(networks, *net)
+	}
+
+	return networks, nil
+}
+
+func (c *Client) NetworkInspect(_ context.Context, idOrName string, _ types.NetworkInspectOptions) (types.NetworkResource, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("NetworkInspect", idOrName); err != nil {
+		return types.NetworkResource{}, err
+	}
+
+	net := c.findNetwork(idOrName)
+	if net == nil {
+		return types.NetworkResource{}, errdefs.NotFound(fmt.Errorf("network %s not found", idOrName))
+	}
+
+	return *net, nil
+}
+
+func (c *Client) NetworkConnect(_ context.Context, networkID, containerID string, config *network.EndpointSettings) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("NetworkConnect", networkID); err != nil {
+		return err
+	}
+
+	net := c.findNetwork(networkID)
+	if net == nil {
+		return errdefs.NotFound(fmt.Errorf("network %s not found", networkID))
+	}
+	cont := c.findContainer(containerID)
+	if cont == nil {
+		return noSuchContainer(containerID)
+	}
+
+	endpoint := &network.EndpointSettings{}
+	if config != nil {
+		*endpoint = *config
+	}
+	endpoint.NetworkID = net.ID
+	cont.inspect.NetworkSettings.Networks[net.Name] = endpoint
+	net.Containers[cont.inspect.ID] = types.EndpointResource{Name: cont.inspect.Name[1:]}
+
+	return nil
+}
+
+func (c *Client) NetworkRemove(_ context.Context, idOrName string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("NetworkRemove", idOrName); err != nil {
+		return err
+	}
+
+	net := c.findNetwork(idOrName)
+	if net == nil {
+		return errdefs.NotFound(fmt.Errorf("network %s not found", idOrName))
+	}
+	if len(net.Containers) > 0 {
+		return errdefs.Forbidden(fmt.Errorf("error while removing network: network %s has active endpoints", net.Name))
+	}
+
+	delete(c.networks, net.ID)
+
+	return nil
+}
+
+// VolumeCreate returns the existing volume if there is one with the name, like the daemon
+func (c *Client) VolumeCreate(_ context.Context, options volume.CreateOptions) (volume.Volume, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("VolumeCreate", options.Name); err != nil {
+		return volume.Volume{}, err
+	}
+
+	name := options.Name
+	if name == "" {
+		name = c.nextID("volume")
+	}
+	if existing, ok := c.volumes[name]; ok {
+		return *existing, nil
+	}
+
+	driver := options.Driver
+	if driver == "" {
+		driver = "local"
+	}
+
+	c.volumes[name] = &volume.Volume{
+		Name:       name,
+		Driver:     driver,
+		Labels:     options.Labels,
+		Options:    options.DriverOpts,
+		Mountpoint: "/var/lib/docker/volumes/" + name + "/_data",
+		Scope:      "local",
+	}
+
+	return *c.volumes[name], nil
+}
+
+func (c *Client) VolumeInspect(_ context.Context, name string) (volume.Volume, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("VolumeInspect", name); err != nil {
+		return volume.Volume{}, err
+	}
+
+	existing, ok := c.volumes[name]
+	if !ok {
+		return volume.Volume{}, errdefs.NotFound(fmt.Errorf("get %s: no such volume", name))
+	}
+
+	return *existing, nil
+}
+
+func (c *Client) VolumeRemove(_ context.Context, name string, _ bool) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("VolumeRemove", name); err != nil {
+		return err
+	}
+
+	if _, ok := c.volumes[name]; !ok {
+		return errdefs.NotFound(fmt.Errorf("get %s: no such volume", name))
+	}
+	delete(c.volumes, name)
+
+	return nil
+}
+
+func (c *Client) VolumeList(_ context.Context, options volume.ListOptions) (volume.ListResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.record("VolumeList", ""); err != nil {
+		return volume.ListResponse{}, err
+	}
+
+	volumes := []*volume.Volume{}
+	for name, it := range c.volumes {
+		if !options.Filters.Match("name", name) || !options.Filters.MatchKVList("label", it.Labels) {
+			continue
+		}
+
+		listed := *it
+		volumes = append(volumes, &listed)
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Name < volumes[j].Name })
+
+	return volume.ListResponse{Volumes: volumes}, nil
+}
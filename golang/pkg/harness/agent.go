@@ -0,0 +1,143 @@
+package harness
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ilyakaznacheev/cleanenv"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent"
+
+	craneConfig "github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	dagentConfig "github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+const (
+	DagentNodeID = "harness-dagent"
+	CraneNodeID  = "harness-crane"
+)
+
+// NewDagentConfig reads the configuration from the environment, the files of the agent go to a temporary directory
+func NewDagentConfig(tb testing.TB) *dagentConfig.Configuration {
+	tb.Helper()
+
+	cfg := &dagentConfig.Configuration{}
+	if err := cleanenv.ReadEnv(cfg); err != nil {
+		tb.Fatalf("could not read the dagent configuration: %v", err)
+	}
+
+	cfg.InternalMountPath = tb.TempDir()
+	cfg.DataMountPath = cfg.InternalMountPath
+	cfg.TraefikEnabled = false
+
+	return cfg
+}
+
+// NewCraneConfig reads the configuration from the environment and uses the kubeconfig, resource sampling is
+// disabled, because envtest has no metrics server
+func NewCraneConfig(tb testing.TB, kubeConfig string) *craneConfig.Configuration {
+	tb.Helper()
+
+	cfg := &craneConfig.Configuration{}
+	if err := cleanenv.ReadEnv(cfg); err != nil {
+		tb.Fatalf("could not read the crane configuration: %v", err)
+	}
+
+	cfg.KubeConfig = kubeConfig
+	cfg.CraneInCluster = false
+	cfg.ResourceSampleInterval = 0
+
+	return cfg
+}
+
+// StartDagent runs dagent against the Crux until the end of the test, the docker daemon is the one
+// of DOCKER_HOST, see StartDocker. Only one agent can run at a time in a test binary.
+func StartDagent(tb testing.TB, crux *Crux, cfg *dagentConfig.Configuration) {
+	tb.Helper()
+
+	if err := crux.Configure(&cfg.CommonConfiguration, DagentNodeID); err != nil {
+		tb.Fatalf("could not configure dagent: %v", err)
+	}
+
+	run(tb, func(ctx context.Context) {
+		dagent.ServeContext(ctx, cfg)
+	})
+}
+
+// StartCrane runs crane against the Crux until the end of the test, the connection token is kept in memory
+// instead of a secret. Only one agent can run at a time in a test binary.
+func StartCrane(tb testing.TB, crux *Crux, cfg *craneConfig.Configuration) {
+	tb.Helper()
+
+	if err := crux.Configure(&cfg.CommonConfiguration, CraneNodeID); err != nil {
+		tb.Fatalf("could not configure crane: %v", err)
+	}
+
+	secrets := &memorySecretStore{privateKey: cfg.SecretPrivateKey}
+	run(tb, func(ctx context.Context) {
+		crane.ServeContext(ctx, cfg, secrets)
+	})
+}
+
+// run stops the agent before the Crux, the cleanups run in reverse order
+func run(tb testing.TB, serve func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		serve(ctx)
+	}()
+
+	tb.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+type memorySecretStore struct {
+	privateKey string
+	token      string
+	nonce      string
+	mutex      sync.Mutex
+}
+
+func (s *memorySecretStore) CheckPermissions() error {
+	return nil
+}
+
+func (s *memorySecretStore) LoadPrivateKey() (string, error) {
+	return s.privateKey, nil
+}
+
+func (s *memorySecretStore) GetConnectionToken() (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.token, nil
+}
+
+func (s *memorySecretStore) SaveConnectionToken(token string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.token = token
+	return nil
+}
+
+func (s *memorySecretStore) GetBlacklistedNonce() (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.nonce, nil
+}
+
+func (s *memorySecretStore) BlacklistNonce(value string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.nonce = value
+	return nil
+}
@@ -0,0 +1,435 @@
+// Package harness runs the agents against a fake Crux, so the deployment flows can be tested end-to-end
+// without the platform. dagent needs a docker daemon, see StartDocker, crane needs a cluster, see StartKube.
+package harness
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// the fake Crux does not verify the token, it is only sent by the agents
+const harnessToken = "harness"
+
+var ErrDeploymentIDMissing = errors.New("deployment id is missing from the metadata")
+
+// Deployment is the status stream of a deployment, it is done when the agent closes the stream
+type Deployment struct {
+	// Error is the envelope of the failure reported by the agent, the code and the category tell what went wrong
+	Error    *agent.AgentError
+	Messages []*common.DeploymentStatusMessage
+	Done     bool
+}
+
+// Status is the last deployment status sent by the agent
+func (d *Deployment) Status() common.DeploymentStatus {
+	status := common.DeploymentStatus_DEPLOYMENT_STATUS_UNSPECIFIED
+	for _, message := range d.Messages {
+		if message.GetData() != nil {
+			status = message.GetDeploymentStatus()
+		}
+	}
+
+	return status
+}
+
+// Logs are the log lines of the deployment in the order they were sent
+func (d *Deployment) Logs() []string {
+	logs := []string{}
+	for _, message := range d.Messages {
+		logs = append(logs, message.Log...)
+	}
+
+	return logs
+}
+
+// Crux is a gRPC server in place of the platform, it sends the commands to the connected agent and records
+// everything the agent sends back
+type Crux struct {
+	agent.UnimplementedAgentServer
+	server          *grpc.Server
+	listener        net.Listener
+	commands        chan *agent.AgentCommand
+	updated         chan struct{}
+	deployments     map[string]*Deployment
+	agents          []*agent.AgentInfo
+	commandErrors   []*agent.AgentCommandError
+	containerStates []*common.ContainerStateListMessage
+	responses       []proto.Message
+	mutex           sync.Mutex
+}
+
+// NewCrux starts the server on a random local port, it is stopped at the end of the test
+func NewCrux(tb testing.TB) *Crux {
+	tb.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatalf("could not listen: %v", err)
+	}
+
+	crux := &Crux{
+		server:      grpc.NewServer(),
+		listener:    listener,
+		commands:    make(chan *agent.AgentCommand),
+		updated:     make(chan struct{}),
+		deployments: map[string]*Deployment{},
+	}
+	agent.RegisterAgentServer(crux.server, crux)
+
+	go func() {
+		if serveErr := crux.server.Serve(listener); serveErr != nil {
+			tb.Logf("fake crux stopped: %v", serveErr)
+		}
+	}()
+	tb.Cleanup(crux.server.Stop)
+
+	return crux
+}
+
+// Address is the host and port the agents dial
+func (c *Crux) Address() string {
+	return c.listener.Addr().String()
+}
+
+// Configure points the agent to the server, the agent falls back to plain-text gRPC in debug mode
+func (c *Crux) Configure(cfg *config.CommonConfiguration, nodeID string) error {
+	key, err := config.GenerateKeyString()
+	if err != nil {
+		return err
+	}
+
+	cfg.SecretPrivateKey = key
+	cfg.Debug = true
+	cfg.FallbackJwtToken = nil
+	cfg.JwtToken = &config.ValidJWT{
+		Issuer:           c.Address(),
+		Subject:          nodeID,
+		IssuedAt:         time.Now(),
+		Type:             config.Connection,
+		StringifiedToken: harnessToken,
+	}
+
+	return nil
+}
+
+// notify wakes up the waiting calls, the lock must be held
+func (c *Crux) notify() {
+	close(c.updated)
+	c.updated = make(chan struct{})
+}
+
+// wait blocks until the condition is true, the condition is called with the lock held
+func (c *Crux) wait(ctx context.Context, condition func() bool) error {
+	for {
+		c.mutex.Lock()
+		if condition() {
+			c.mutex.Unlock()
+			return nil
+		}
+		updated := c.updated
+		c.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-updated:
+		}
+	}
+}
+
+// WaitForAgent blocks until an agent connects, it returns the info of the last connected agent
+func (c *Crux) WaitForAgent(ctx context.Context) (*agent.AgentInfo, error) {
+	var info *agent.AgentInfo
+	err := c.wait(ctx, func() bool {
+		if len(c.agents) == 0 {
+			return false
+		}
+		info = c.agents[len(c.agents)-1]
+		return true
+	})
+
+	return info, err
+}
+
+// Send blocks until the connected agent receives the command
+func (c *Crux) Send(ctx context.Context, command *agent.AgentCommand) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case c.commands <- command:
+		return nil
+	}
+}
+
+// Deploy sends the deploy request and waits until the agent finishes it
+func (c *Crux) Deploy(ctx context.Context, req *agent.DeployRequest) (*Deployment, error) {
+	err := c.Send(ctx, &agent.AgentCommand{Command: &agent.AgentCommand_Deploy{Deploy: req}})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.WaitForDeployment(ctx, req.Id)
+}
+
+// WaitForDeployment blocks until the agent closes the status stream of the deployment
+func (c *Crux) WaitForDeployment(ctx context.Context, id string) (*Deployment, error) {
+	var deployment *Deployment
+	err := c.wait(ctx, func() bool {
+		current, ok := c.deployments[id]
+		if !ok || !current.Done {
+			return false
+		}
+		deployment = &Deployment{
+			Error:    current.Error,
+			Messages: append([]*common.DeploymentStatusMessage{}, current.Messages...),
+			Done:     true,
+		}
+		return true
+	})
+
+	return deployment, err
+}
+
+// CommandErrors are the errors the agent reported for the commands
+func (c *Crux) CommandErrors() []*agent.AgentCommandError {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]*agent.AgentCommandError{}, c.commandErrors...)
+}
+
+// ContainerStates are the messages of the container state streams
+func (c *Crux) ContainerStates() []*common.ContainerStateListMessage {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]*common.ContainerStateListMessage{}, c.containerStates...)
+}
+
+// Responses are the responses of the commands, eg. the inspection of a container or the diff of a deployment
+func (c *Crux) Responses() []proto.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]proto.Message{}, c.responses...)
+}
+
+func (c *Crux) record(response proto.Message) (*common.Empty, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.responses = append(c.responses, response)
+	c.notify()
+
+	return &common.Empty{}, nil
+}
+
+func (c *Crux) Connect(info *agent.AgentInfo, stream agent.Agent_ConnectServer) error {
+	c.mutex.Lock()
+	c.agents = append(c.agents, info)
+	c.notify()
+	c.mutex.Unlock()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case command := <-c.commands:
+			if err := stream.Send(command); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// CommandError records the error, the failure of a deployment is kept with the deployment too
+func (c *Crux) CommandError(ctx context.Context, commandErr *agent.AgentCommandError) (*common.Empty, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.commandErrors = append(c.commandErrors, commandErr)
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get("dyo-deployment-id"); len(ids) > 0 && commandErr.GetDeploy() != nil {
+		deployment, ok := c.deployments[ids[0]]
+		if !ok {
+			deployment = &Deployment{}
+			c.deployments[ids[0]] = deployment
+		}
+		deployment.Error = commandErr.GetDeploy()
+	}
+	c.notify()
+
+	return &common.Empty{}, nil
+}
+
+func (c *Crux) DeploymentStatus(stream agent.Agent_DeploymentStatusServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	ids := md.Get("dyo-deployment-id")
+	if len(ids) == 0 {
+		return ErrDeploymentIDMissing
+	}
+
+	// the error of the deployment can be reported before its stream is opened
+	c.mutex.Lock()
+	deployment, ok := c.deployments[ids[0]]
+	if !ok || deployment.Done {
+		deployment = &Deployment{}
+		c.deployments[ids[0]] = deployment
+	}
+	c.mutex.Unlock()
+
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			c.mutex.Lock()
+			deployment.Done = true
+			c.notify()
+			c.mutex.Unlock()
+
+			return stream.SendAndClose(&common.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		c.mutex.Lock()
+		deployment.Messages = append(deployment.Messages, message)
+		c.notify()
+		c.mutex.Unlock()
+	}
+}
+
+func (c *Crux) ContainerState(stream agent.Agent_ContainerStateServer) error {
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&common.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		c.mutex.Lock()
+		c.containerStates = append(c.containerStates, message)
+		c.notify()
+		c.mutex.Unlock()
+	}
+}
+
+func (c *Crux) WorkloadOperation(stream agent.Agent_WorkloadOperationServer) error {
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&common.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = c.record(message); err != nil {
+			return err
+		}
+	}
+}
+
+// DebugSession records the output of the session, the fake Crux sends no input
+func (c *Crux) DebugSession(stream agent.Agent_DebugSessionServer) error {
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = c.record(message); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Crux) SecretList(_ context.Context, res *common.ListSecretsResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) DeleteContainers(_ context.Context, res *common.Empty) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) ContainerLog(_ context.Context, res *common.ContainerLogListResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) ContainerInspect(_ context.Context, res *common.ContainerInspectResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) DeploymentDiff(_ context.Context, res *agent.DeploymentDiffResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) VolumeUsage(_ context.Context, res *agent.VolumeUsageResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) PruneReport(_ context.Context, res *agent.PruneReportResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) TrafficUsage(_ context.Context, res *agent.TrafficUsageResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) IngressConfig(_ context.Context, res *agent.IngressConfigResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) RollbackToRevision(_ context.Context, res *agent.RollbackToRevisionResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) ResourceRecommendation(_ context.Context, res *agent.ResourceRecommendationResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) ContainerCheckpoint(_ context.Context, res *agent.ContainerCheckpointResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) ContainerRestore(_ context.Context, res *agent.ContainerRestoreResponse) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) ClusterCapabilities(_ context.Context, res *agent.ClusterCapabilitiesMessage) (*common.Empty, error) {
+	return c.record(res)
+}
+
+func (c *Crux) HostMetrics(stream agent.Agent_HostMetricsServer) error {
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&common.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = c.record(message); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,121 @@
+//go:build unit
+// +build unit
+
+package harness_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/harness"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestCruxDeployment(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crux := harness.NewCrux(t)
+
+	conn, err := grpc.NewClient(crux.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := agent.NewAgentClient(conn)
+
+	stream, err := client.Connect(ctx, &agent.AgentInfo{Id: "test-agent"})
+	assert.NoError(t, err)
+
+	info, err := crux.WaitForAgent(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-agent", info.Id)
+
+	sent := make(chan error, 1)
+	go func() {
+		sent <- crux.Send(ctx, &agent.AgentCommand{Command: &agent.AgentCommand_Deploy{Deploy: &agent.DeployRequest{Id: "deployment-1"}}})
+	}()
+
+	command, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "deployment-1", command.GetDeploy().GetId())
+	assert.NoError(t, <-sent)
+
+	statusStream, err := client.DeploymentStatus(metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", "deployment-1"))
+	assert.NoError(t, err)
+	assert.NoError(t, statusStream.Send(&common.DeploymentStatusMessage{
+		Log:  []string{"Started."},
+		Data: &common.DeploymentStatusMessage_DeploymentStatus{DeploymentStatus: common.DeploymentStatus_IN_PROGRESS},
+	}))
+	assert.NoError(t, statusStream.Send(&common.DeploymentStatusMessage{Log: []string{"Pulling image"}}))
+	assert.NoError(t, statusStream.Send(&common.DeploymentStatusMessage{
+		Data: &common.DeploymentStatusMessage_DeploymentStatus{DeploymentStatus: common.DeploymentStatus_SUCCESSFUL},
+	}))
+	_, err = statusStream.CloseAndRecv()
+	assert.NoError(t, err)
+
+	deployment, err := crux.WaitForDeployment(ctx, "deployment-1")
+	assert.NoError(t, err)
+	assert.True(t, deployment.Done)
+	assert.Equal(t, common.DeploymentStatus_SUCCESSFUL, deployment.Status())
+	assert.Equal(t, []string{"Started.", "Pulling image"}, deployment.Logs())
+}
+
+func TestCruxRecordsResponses(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crux := harness.NewCrux(t)
+
+	conn, err := grpc.NewClient(crux.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := agent.NewAgentClient(conn)
+
+	_, err = client.CommandError(ctx, &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_VolumeUsage{VolumeUsage: &agent.AgentError{Error: "failed"}},
+	})
+	assert.NoError(t, err)
+	_, err = client.VolumeUsage(ctx, &agent.VolumeUsageResponse{})
+	assert.NoError(t, err)
+
+	assert.Len(t, crux.CommandErrors(), 1)
+	assert.Equal(t, "failed", crux.CommandErrors()[0].GetVolumeUsage().GetError())
+	assert.Len(t, crux.Responses(), 1)
+}
+
+func TestCruxDeploymentError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crux := harness.NewCrux(t)
+
+	conn, err := grpc.NewClient(crux.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := agent.NewAgentClient(conn)
+
+	deployCtx := metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", "deployment-1")
+	statusStream, err := client.DeploymentStatus(deployCtx)
+	assert.NoError(t, err)
+	_, err = client.CommandError(deployCtx, &agent.AgentCommandError{
+		Command: &agent.AgentCommandError_Deploy{Deploy: &agent.AgentError{
+			Code:      "IMAGE_NOT_FOUND",
+			Category:  agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND,
+			Retryable: false,
+		}},
+	})
+	assert.NoError(t, err)
+	_, err = statusStream.CloseAndRecv()
+	assert.NoError(t, err)
+
+	deployment, err := crux.WaitForDeployment(ctx, "deployment-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "IMAGE_NOT_FOUND", deployment.Error.GetCode())
+	assert.Equal(t, agent.ErrorCategory_ERROR_CATEGORY_NOT_FOUND, deployment.Error.GetCategory())
+}
@@ -0,0 +1,107 @@
+package harness
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	DindImage = "docker:26-dind"
+
+	dindPort         = "2375/tcp"
+	dindPollInterval = 500 * time.Millisecond
+	dindStartTimeout = time.Minute
+)
+
+// StartDocker runs a docker-in-docker daemon using the daemon of the environment and points DOCKER_HOST
+// to it until the end of the test, so the containers of the test do not leak to the host. The daemon has
+// to be privileged and its port is published on the loopback interface.
+func StartDocker(tb testing.TB) string {
+	tb.Helper()
+
+	ctx := context.Background()
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		tb.Fatalf("could not create docker client: %v", err)
+	}
+
+	reader, err := cli.ImagePull(ctx, DindImage, image.PullOptions{})
+	if err != nil {
+		tb.Fatalf("could not pull %s: %v", DindImage, err)
+	}
+	_, err = io.Copy(io.Discard, reader)
+	if closeErr := reader.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		tb.Fatalf("could not pull %s: %v", DindImage, err)
+	}
+
+	created, err := cli.ContainerCreate(ctx,
+		&container.Config{
+			Image:        DindImage,
+			Env:          []string{"DOCKER_TLS_CERTDIR="},
+			ExposedPorts: nat.PortSet{dindPort: struct{}{}},
+		},
+		&container.HostConfig{
+			Privileged:   true,
+			PortBindings: nat.PortMap{dindPort: []nat.PortBinding{{HostIP: "127.0.0.1"}}},
+		},
+		nil, nil, "")
+	if err != nil {
+		tb.Fatalf("could not create the docker-in-docker container: %v", err)
+	}
+
+	tb.Cleanup(func() {
+		removeErr := cli.ContainerRemove(context.Background(), created.ID, container.RemoveOptions{Force: true, RemoveVolumes: true})
+		if removeErr != nil {
+			tb.Logf("could not remove the docker-in-docker container: %v", removeErr)
+		}
+	})
+
+	if err = cli.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+		tb.Fatalf("could not start the docker-in-docker container: %v", err)
+	}
+
+	inspect, err := cli.ContainerInspect(ctx, created.ID)
+	if err != nil {
+		tb.Fatalf("could not inspect the docker-in-docker container: %v", err)
+	}
+
+	bindings := inspect.NetworkSettings.Ports[dindPort]
+	if len(bindings) == 0 {
+		tb.Fatalf("port %s of the docker-in-docker container is not published", dindPort)
+	}
+
+	host := fmt.Sprintf("tcp://127.0.0.1:%s", bindings[0].HostPort)
+	if err = waitForDocker(ctx, host); err != nil {
+		tb.Fatalf("docker-in-docker daemon is not ready: %v", err)
+	}
+
+	tb.Setenv("DOCKER_HOST", host)
+
+	return host
+}
+
+func waitForDocker(ctx context.Context, host string) error {
+	cli, err := client.NewClientWithOpts(client.WithHost(host), client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	return wait.PollUntilContextTimeout(ctx, dindPollInterval, dindStartTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			_, pingErr := cli.Ping(ctx)
+			return pingErr == nil, nil
+		})
+}
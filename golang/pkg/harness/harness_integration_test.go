@@ -0,0 +1,42 @@
+//go:build integration
+// +build integration
+
+package harness_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/harness"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestDagentDeploy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	harness.StartDocker(t)
+	crux := harness.NewCrux(t)
+	harness.StartDagent(t, crux, harness.NewDagentConfig(t))
+
+	info, err := crux.WaitForAgent(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, harness.DagentNodeID, info.Id)
+
+	deployment, err := crux.Deploy(ctx, &agent.DeployRequest{
+		Id:     "harness-deployment",
+		Prefix: "harness",
+		Requests: []*agent.DeployWorkloadRequest{{
+			Id:        "harness-nginx",
+			ImageName: "nginx",
+			Tag:       "alpine",
+			Common:    &agent.CommonContainerConfig{Name: "nginx"},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.DeploymentStatus_SUCCESSFUL, deployment.Status(), deployment.Logs())
+}
@@ -0,0 +1,51 @@
+package harness
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+const kubeConfigPerm = 0o600
+
+// StartKube starts an API server and etcd using envtest and returns the path of an admin kubeconfig, the
+// binaries are looked up from KUBEBUILDER_ASSETS. envtest runs no controllers, so the pods are never created,
+// set USE_EXISTING_CLUSTER=true to use the cluster of KUBECONFIG instead, eg. a kind or k3d cluster.
+func StartKube(tb testing.TB) string {
+	tb.Helper()
+
+	if os.Getenv("USE_EXISTING_CLUSTER") == "true" {
+		return os.Getenv("KUBECONFIG")
+	}
+
+	env := &envtest.Environment{}
+	restConfig, err := env.Start()
+	if err != nil {
+		tb.Fatalf("could not start envtest: %v", err)
+	}
+
+	tb.Cleanup(func() {
+		if stopErr := env.Stop(); stopErr != nil {
+			tb.Logf("could not stop envtest: %v", stopErr)
+		}
+	})
+
+	user, err := env.ControlPlane.AddUser(envtest.User{Name: "crane", Groups: []string{"system:masters"}}, restConfig)
+	if err != nil {
+		tb.Fatalf("could not add the envtest user: %v", err)
+	}
+
+	content, err := user.KubeConfig()
+	if err != nil {
+		tb.Fatalf("could not create kubeconfig: %v", err)
+	}
+
+	kubeConfig := filepath.Join(tb.TempDir(), "kubeconfig")
+	if err = os.WriteFile(kubeConfig, content, kubeConfigPerm); err != nil {
+		tb.Fatalf("could not write kubeconfig: %v", err)
+	}
+
+	return kubeConfig
+}
@@ -15,6 +15,7 @@
 	common "github.com/dyrector-io/dyrectorio/protobuf/go/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,6 +27,363 @@
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How the platform handles a failed command, the agents classify their
+// errors the same way, so retrying and alerting do not depend on the
+// error messages
+type ErrorCategory int32
+
+const (
+	ErrorCategory_ERROR_CATEGORY_UNSPECIFIED ErrorCategory = 0
+	// The request is wrong, it fails the same way again
+	ErrorCategory_ERROR_CATEGORY_INVALID_ARGUMENT ErrorCategory = 1
+	ErrorCategory_ERROR_CATEGORY_NOT_FOUND        ErrorCategory = 2
+	// The object was changed or exists already
+	ErrorCategory_ERROR_CATEGORY_CONFLICT ErrorCategory = 3
+	// The credentials of the registry or the cluster are rejected
+	ErrorCategory_ERROR_CATEGORY_UNAUTHORIZED ErrorCategory = 4
+	// The node can not run the command, e.g. a missing runtime
+	ErrorCategory_ERROR_CATEGORY_FAILED_PRECONDITION ErrorCategory = 5
+	// The container runtime or the API server is not reachable
+	ErrorCategory_ERROR_CATEGORY_UNAVAILABLE ErrorCategory = 6
+	ErrorCategory_ERROR_CATEGORY_TIMEOUT     ErrorCategory = 7
+	// The node or the cluster is out of resources or throttled
+	ErrorCategory_ERROR_CATEGORY_RESOURCE_EXHAUSTED ErrorCategory = 8
+	// The command was canceled, e.g. the agent is shutting down
+	ErrorCategory_ERROR_CATEGORY_CANCELED      ErrorCategory = 9
+	ErrorCategory_ERROR_CATEGORY_UNIMPLEMENTED ErrorCategory = 10
+	ErrorCategory_ERROR_CATEGORY_INTERNAL      ErrorCategory = 11
+)
+
+// Enum value maps for ErrorCategory.
+var (
+	ErrorCategory_name = map[int32]string{
+		0:  "ERROR_CATEGORY_UNSPECIFIED",
+		1:  "ERROR_CATEGORY_INVALID_ARGUMENT",
+		2:  "ERROR_CATEGORY_NOT_FOUND",
+		3:  "ERROR_CATEGORY_CONFLICT",
+		4:  "ERROR_CATEGORY_UNAUTHORIZED",
+		5:  "ERROR_CATEGORY_FAILED_PRECONDITION",
+		6:  "ERROR_CATEGORY_UNAVAILABLE",
+		7:  "ERROR_CATEGORY_TIMEOUT",
+		8:  "ERROR_CATEGORY_RESOURCE_EXHAUSTED",
+		9:  "ERROR_CATEGORY_CANCELED",
+		10: "ERROR_CATEGORY_UNIMPLEMENTED",
+		11: "ERROR_CATEGORY_INTERNAL",
+	}
+	ErrorCategory_value = map[string]int32{
+		"ERROR_CATEGORY_UNSPECIFIED":         0,
+		"ERROR_CATEGORY_INVALID_ARGUMENT":    1,
+		"ERROR_CATEGORY_NOT_FOUND":           2,
+		"ERROR_CATEGORY_CONFLICT":            3,
+		"ERROR_CATEGORY_UNAUTHORIZED":        4,
+		"ERROR_CATEGORY_FAILED_PRECONDITION": 5,
+		"ERROR_CATEGORY_UNAVAILABLE":         6,
+		"ERROR_CATEGORY_TIMEOUT":             7,
+		"ERROR_CATEGORY_RESOURCE_EXHAUSTED":  8,
+		"ERROR_CATEGORY_CANCELED":            9,
+		"ERROR_CATEGORY_UNIMPLEMENTED":       10,
+		"ERROR_CATEGORY_INTERNAL":            11,
+	}
+)
+
+func (x ErrorCategory) Enum() *ErrorCategory {
+	p := new(ErrorCategory)
+	*p = x
+	return p
+}
+
+func (x ErrorCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCategory) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[0]
+}
+
+func (x ErrorCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCategory.Descriptor instead.
+func (ErrorCategory) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{0}
+}
+
+// What happens when the backoff would exceed its max
+type RestartGiveUpAction int32
+
+const (
+	RestartGiveUpAction_RESTART_GIVE_UP_ACTION_UNSPECIFIED RestartGiveUpAction = 0
+	// Keep restarting with the max backoff
+	RestartGiveUpAction_RESTART_GIVE_UP_ACTION_BACKOFF RestartGiveUpAction = 1
+	// Leave the container stopped and disable its restart policy
+	RestartGiveUpAction_RESTART_GIVE_UP_ACTION_STOP RestartGiveUpAction = 2
+)
+
+// Enum value maps for RestartGiveUpAction.
+var (
+	RestartGiveUpAction_name = map[int32]string{
+		0: "RESTART_GIVE_UP_ACTION_UNSPECIFIED",
+		1: "RESTART_GIVE_UP_ACTION_BACKOFF",
+		2: "RESTART_GIVE_UP_ACTION_STOP",
+	}
+	RestartGiveUpAction_value = map[string]int32{
+		"RESTART_GIVE_UP_ACTION_UNSPECIFIED": 0,
+		"RESTART_GIVE_UP_ACTION_BACKOFF":     1,
+		"RESTART_GIVE_UP_ACTION_STOP":        2,
+	}
+)
+
+func (x RestartGiveUpAction) Enum() *RestartGiveUpAction {
+	p := new(RestartGiveUpAction)
+	*p = x
+	return p
+}
+
+func (x RestartGiveUpAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartGiveUpAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[1].Descriptor()
+}
+
+func (RestartGiveUpAction) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[1]
+}
+
+func (x RestartGiveUpAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartGiveUpAction.Descriptor instead.
+func (RestartGiveUpAction) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{1}
+}
+
+// Windows only, ignored by Linux daemons
+type Isolation int32
+
+const (
+	Isolation_ISOLATION_UNSPECIFIED Isolation = 0
+	Isolation_ISOLATION_DEFAULT     Isolation = 1
+	Isolation_ISOLATION_PROCESS     Isolation = 2
+	Isolation_ISOLATION_HYPERV      Isolation = 3
+)
+
+// Enum value maps for Isolation.
+var (
+	Isolation_name = map[int32]string{
+		0: "ISOLATION_UNSPECIFIED",
+		1: "ISOLATION_DEFAULT",
+		2: "ISOLATION_PROCESS",
+		3: "ISOLATION_HYPERV",
+	}
+	Isolation_value = map[string]int32{
+		"ISOLATION_UNSPECIFIED": 0,
+		"ISOLATION_DEFAULT":     1,
+		"ISOLATION_PROCESS":     2,
+		"ISOLATION_HYPERV":      3,
+	}
+)
+
+func (x Isolation) Enum() *Isolation {
+	p := new(Isolation)
+	*p = x
+	return p
+}
+
+func (x Isolation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Isolation) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (Isolation) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[2]
+}
+
+func (x Isolation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Isolation.Descriptor instead.
+func (Isolation) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{2}
+}
+
+// Shape of the service of the container, unspecified means cluster IP or
+// load balancer based on useLoadBalancer
+type ServiceType int32
+
+const (
+	ServiceType_SERVICE_TYPE_UNSPECIFIED   ServiceType = 0
+	ServiceType_SERVICE_TYPE_CLUSTER_IP    ServiceType = 1
+	ServiceType_SERVICE_TYPE_HEADLESS      ServiceType = 2
+	ServiceType_SERVICE_TYPE_NODE_PORT     ServiceType = 3
+	ServiceType_SERVICE_TYPE_LOAD_BALANCER ServiceType = 4
+)
+
+// Enum value maps for ServiceType.
+var (
+	ServiceType_name = map[int32]string{
+		0: "SERVICE_TYPE_UNSPECIFIED",
+		1: "SERVICE_TYPE_CLUSTER_IP",
+		2: "SERVICE_TYPE_HEADLESS",
+		3: "SERVICE_TYPE_NODE_PORT",
+		4: "SERVICE_TYPE_LOAD_BALANCER",
+	}
+	ServiceType_value = map[string]int32{
+		"SERVICE_TYPE_UNSPECIFIED":   0,
+		"SERVICE_TYPE_CLUSTER_IP":    1,
+		"SERVICE_TYPE_HEADLESS":      2,
+		"SERVICE_TYPE_NODE_PORT":     3,
+		"SERVICE_TYPE_LOAD_BALANCER": 4,
+	}
+)
+
+func (x ServiceType) Enum() *ServiceType {
+	p := new(ServiceType)
+	*p = x
+	return p
+}
+
+func (x ServiceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[3].Descriptor()
+}
+
+func (ServiceType) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[3]
+}
+
+func (x ServiceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceType.Descriptor instead.
+func (ServiceType) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{3}
+}
+
+type PruneTarget int32
+
+const (
+	PruneTarget_PRUNE_TARGET_UNSPECIFIED PruneTarget = 0
+	// Dangling images not used by any container
+	PruneTarget_PRUNE_TARGET_IMAGES PruneTarget = 1
+	// Anonymous volumes not used by any container
+	PruneTarget_PRUNE_TARGET_VOLUMES PruneTarget = 2
+	// Managed containers which are dead or were never started
+	PruneTarget_PRUNE_TARGET_ZOMBIE_CONTAINERS PruneTarget = 3
+)
+
+// Enum value maps for PruneTarget.
+var (
+	PruneTarget_name = map[int32]string{
+		0: "PRUNE_TARGET_UNSPECIFIED",
+		1: "PRUNE_TARGET_IMAGES",
+		2: "PRUNE_TARGET_VOLUMES",
+		3: "PRUNE_TARGET_ZOMBIE_CONTAINERS",
+	}
+	PruneTarget_value = map[string]int32{
+		"PRUNE_TARGET_UNSPECIFIED":       0,
+		"PRUNE_TARGET_IMAGES":            1,
+		"PRUNE_TARGET_VOLUMES":           2,
+		"PRUNE_TARGET_ZOMBIE_CONTAINERS": 3,
+	}
+)
+
+func (x PruneTarget) Enum() *PruneTarget {
+	p := new(PruneTarget)
+	*p = x
+	return p
+}
+
+func (x PruneTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PruneTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[4].Descriptor()
+}
+
+func (PruneTarget) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[4]
+}
+
+func (x PruneTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PruneTarget.Descriptor instead.
+func (PruneTarget) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{4}
+}
+
+// Operational actions on the workload of a container, the progress is
+// streamed back on a WorkloadOperation channel opened with the id
+type WorkloadOperation int32
+
+const (
+	WorkloadOperation_WORKLOAD_OPERATION_UNSPECIFIED     WorkloadOperation = 0
+	WorkloadOperation_WORKLOAD_OPERATION_ROLLOUT_RESTART WorkloadOperation = 1
+	WorkloadOperation_WORKLOAD_OPERATION_DELETE_POD      WorkloadOperation = 2
+	WorkloadOperation_WORKLOAD_OPERATION_SCALE           WorkloadOperation = 3
+)
+
+// Enum value maps for WorkloadOperation.
+var (
+	WorkloadOperation_name = map[int32]string{
+		0: "WORKLOAD_OPERATION_UNSPECIFIED",
+		1: "WORKLOAD_OPERATION_ROLLOUT_RESTART",
+		2: "WORKLOAD_OPERATION_DELETE_POD",
+		3: "WORKLOAD_OPERATION_SCALE",
+	}
+	WorkloadOperation_value = map[string]int32{
+		"WORKLOAD_OPERATION_UNSPECIFIED":     0,
+		"WORKLOAD_OPERATION_ROLLOUT_RESTART": 1,
+		"WORKLOAD_OPERATION_DELETE_POD":      2,
+		"WORKLOAD_OPERATION_SCALE":           3,
+	}
+)
+
+func (x WorkloadOperation) Enum() *WorkloadOperation {
+	p := new(WorkloadOperation)
+	*p = x
+	return p
+}
+
+func (x WorkloadOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkloadOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[5].Descriptor()
+}
+
+func (WorkloadOperation) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[5]
+}
+
+func (x WorkloadOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkloadOperation.Descriptor instead.
+func (WorkloadOperation) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{5}
+}
+
 // Connection close
 type CloseReason int32
 
@@ -66,11 +424,11 @@ func (x CloseReason) String() string {
 }
 
 func (CloseReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_agent_proto_enumTypes[0].Descriptor()
+	return file_protobuf_proto_agent_proto_enumTypes[6].Descriptor()
 }
 
 func (CloseReason) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_agent_proto_enumTypes[0]
+	return &file_protobuf_proto_agent_proto_enumTypes[6]
 }
 
 func (x CloseReason) Number() protoreflect.EnumNumber {
@@ -79,7 +437,56 @@ func (x CloseReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CloseReason.Descriptor instead.
 func (CloseReason) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{0}
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{6}
+}
+
+// What the agent keeps from the earlier commands. The registry credentials
+// and the secrets are not kept, they are sent with every request using them.
+type CacheKind int32
+
+const (
+	CacheKind_CACHE_KIND_UNSPECIFIED CacheKind = 0
+	// Base container configs by their id
+	CacheKind_CACHE_KIND_CONFIG_BUNDLE CacheKind = 3
+)
+
+// Enum value maps for CacheKind.
+var (
+	CacheKind_name = map[int32]string{
+		0: "CACHE_KIND_UNSPECIFIED",
+		3: "CACHE_KIND_CONFIG_BUNDLE",
+	}
+	CacheKind_value = map[string]int32{
+		"CACHE_KIND_UNSPECIFIED":   0,
+		"CACHE_KIND_CONFIG_BUNDLE": 3,
+	}
+)
+
+func (x CacheKind) Enum() *CacheKind {
+	p := new(CacheKind)
+	*p = x
+	return p
+}
+
+func (x CacheKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CacheKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[7].Descriptor()
+}
+
+func (CacheKind) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[7]
+}
+
+func (x CacheKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CacheKind.Descriptor instead.
+func (CacheKind) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{7}
 }
 
 // *
@@ -92,6 +499,14 @@ type AgentInfo struct {
 	Version       string  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	PublicKey     string  `protobuf:"bytes,3,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 	ContainerName *string `protobuf:"bytes,4,opt,name=containerName,proto3,oneof" json:"containerName,omitempty"`
+	//
+	// Optional features of the agent, the platform only sends their commands
+	// to the agents listing them, eg. checkpoint
+	Capabilities []string `protobuf:"bytes,5,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	//
+	// What the cluster of crane serves, discovered before connecting,
+	// the changes are reported with ClusterCapabilities
+	Cluster *ClusterCapabilitiesMessage `protobuf:"bytes,6,opt,name=cluster,proto3,oneof" json:"cluster,omitempty"`
 }
 
 func (x *AgentInfo) Reset() {
@@ -154,30 +569,32 @@ func (x *AgentInfo) GetContainerName() string {
 	return ""
 }
 
-type AgentCommand struct {
+func (x *AgentInfo) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *AgentInfo) GetCluster() *ClusterCapabilitiesMessage {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+type ClusterIngressClass struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Command:
-	//
-	//	*AgentCommand_Deploy
-	//	*AgentCommand_ContainerState
-	//	*AgentCommand_ContainerDelete
-	//	*AgentCommand_DeployLegacy
-	//	*AgentCommand_ListSecrets
-	//	*AgentCommand_Update
-	//	*AgentCommand_Close
-	//	*AgentCommand_ContainerCommand
-	//	*AgentCommand_DeleteContainers
-	//	*AgentCommand_ContainerLog
-	//	*AgentCommand_ReplaceToken
-	//	*AgentCommand_ContainerInspect
-	Command isAgentCommand_Command `protobuf_oneof:"command"`
+	Name       string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Controller string `protobuf:"bytes,101,opt,name=controller,proto3" json:"controller,omitempty"`
+	Default    bool   `protobuf:"varint,102,opt,name=default,proto3" json:"default,omitempty"`
 }
 
-func (x *AgentCommand) Reset() {
-	*x = AgentCommand{}
+func (x *ClusterIngressClass) Reset() {
+	*x = ClusterIngressClass{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_proto_agent_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -185,13 +602,13 @@ func (x *AgentCommand) Reset() {
 	}
 }
 
-func (x *AgentCommand) String() string {
+func (x *ClusterIngressClass) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentCommand) ProtoMessage() {}
+func (*ClusterIngressClass) ProtoMessage() {}
 
-func (x *AgentCommand) ProtoReflect() protoreflect.Message {
+func (x *ClusterIngressClass) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_proto_agent_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -203,204 +620,223 @@ func (x *AgentCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentCommand.ProtoReflect.Descriptor instead.
-func (*AgentCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterIngressClass.ProtoReflect.Descriptor instead.
+func (*ClusterIngressClass) Descriptor() ([]byte, []int) {
 	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{1}
 }
 
-func (m *AgentCommand) GetCommand() isAgentCommand_Command {
-	if m != nil {
-		return m.Command
+func (x *ClusterIngressClass) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AgentCommand) GetDeploy() *DeployRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_Deploy); ok {
-		return x.Deploy
+func (x *ClusterIngressClass) GetController() string {
+	if x != nil {
+		return x.Controller
 	}
-	return nil
+	return ""
 }
 
-func (x *AgentCommand) GetContainerState() *ContainerStateRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ContainerState); ok {
-		return x.ContainerState
+func (x *ClusterIngressClass) GetDefault() bool {
+	if x != nil {
+		return x.Default
 	}
-	return nil
+	return false
 }
 
-func (x *AgentCommand) GetContainerDelete() *ContainerDeleteRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ContainerDelete); ok {
-		return x.ContainerDelete
-	}
-	return nil
+type ClusterStorageClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Provisioner     string `protobuf:"bytes,101,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	Default         bool   `protobuf:"varint,102,opt,name=default,proto3" json:"default,omitempty"`
+	VolumeExpansion bool   `protobuf:"varint,103,opt,name=volumeExpansion,proto3" json:"volumeExpansion,omitempty"`
 }
 
-func (x *AgentCommand) GetDeployLegacy() *DeployRequestLegacy {
-	if x, ok := x.GetCommand().(*AgentCommand_DeployLegacy); ok {
-		return x.DeployLegacy
+func (x *ClusterStorageClass) Reset() {
+	*x = ClusterStorageClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AgentCommand) GetListSecrets() *ListSecretsRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ListSecrets); ok {
-		return x.ListSecrets
-	}
-	return nil
+func (x *ClusterStorageClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AgentCommand) GetUpdate() *AgentUpdateRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_Update); ok {
-		return x.Update
-	}
-	return nil
-}
+func (*ClusterStorageClass) ProtoMessage() {}
 
-func (x *AgentCommand) GetClose() *CloseConnectionRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_Close); ok {
-		return x.Close
+func (x *ClusterStorageClass) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AgentCommand) GetContainerCommand() *common.ContainerCommandRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ContainerCommand); ok {
-		return x.ContainerCommand
-	}
-	return nil
+// Deprecated: Use ClusterStorageClass.ProtoReflect.Descriptor instead.
+func (*ClusterStorageClass) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AgentCommand) GetDeleteContainers() *common.DeleteContainersRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_DeleteContainers); ok {
-		return x.DeleteContainers
+func (x *ClusterStorageClass) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AgentCommand) GetContainerLog() *ContainerLogRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ContainerLog); ok {
-		return x.ContainerLog
+func (x *ClusterStorageClass) GetProvisioner() string {
+	if x != nil {
+		return x.Provisioner
 	}
-	return nil
+	return ""
 }
 
-func (x *AgentCommand) GetReplaceToken() *ReplaceTokenRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ReplaceToken); ok {
-		return x.ReplaceToken
+func (x *ClusterStorageClass) GetDefault() bool {
+	if x != nil {
+		return x.Default
 	}
-	return nil
+	return false
 }
 
-func (x *AgentCommand) GetContainerInspect() *ContainerInspectRequest {
-	if x, ok := x.GetCommand().(*AgentCommand_ContainerInspect); ok {
-		return x.ContainerInspect
+func (x *ClusterStorageClass) GetVolumeExpansion() bool {
+	if x != nil {
+		return x.VolumeExpansion
 	}
-	return nil
+	return false
 }
 
-type isAgentCommand_Command interface {
-	isAgentCommand_Command()
-}
+type ClusterCapabilitiesMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type AgentCommand_Deploy struct {
-	Deploy *DeployRequest `protobuf:"bytes,1,opt,name=deploy,proto3,oneof"`
+	// The git version of the API server, eg. v1.28.3
+	ServerVersion string                 `protobuf:"bytes,100,opt,name=serverVersion,proto3" json:"serverVersion,omitempty"`
+	DiscoveredAt  *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=discoveredAt,proto3" json:"discoveredAt,omitempty"`
+	// The served group versions, eg. apps/v1 or v1 for the core group
+	ApiVersions    []string               `protobuf:"bytes,1000,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
+	IngressClasses []*ClusterIngressClass `protobuf:"bytes,1001,rep,name=ingressClasses,proto3" json:"ingressClasses,omitempty"`
+	StorageClasses []*ClusterStorageClass `protobuf:"bytes,1002,rep,name=storageClasses,proto3" json:"storageClasses,omitempty"`
+	// The parts which could not be discovered, eg. for the lack of permissions
+	Warnings []string `protobuf:"bytes,1003,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-type AgentCommand_ContainerState struct {
-	ContainerState *ContainerStateRequest `protobuf:"bytes,2,opt,name=containerState,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) Reset() {
+	*x = ClusterCapabilitiesMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type AgentCommand_ContainerDelete struct {
-	ContainerDelete *ContainerDeleteRequest `protobuf:"bytes,3,opt,name=containerDelete,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type AgentCommand_DeployLegacy struct {
-	DeployLegacy *DeployRequestLegacy `protobuf:"bytes,4,opt,name=deployLegacy,proto3,oneof"`
-}
+func (*ClusterCapabilitiesMessage) ProtoMessage() {}
 
-type AgentCommand_ListSecrets struct {
-	ListSecrets *ListSecretsRequest `protobuf:"bytes,5,opt,name=listSecrets,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type AgentCommand_Update struct {
-	Update *AgentUpdateRequest `protobuf:"bytes,6,opt,name=update,proto3,oneof"`
+// Deprecated: Use ClusterCapabilitiesMessage.ProtoReflect.Descriptor instead.
+func (*ClusterCapabilitiesMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{3}
 }
 
-type AgentCommand_Close struct {
-	Close *CloseConnectionRequest `protobuf:"bytes,7,opt,name=close,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
 }
 
-type AgentCommand_ContainerCommand struct {
-	ContainerCommand *common.ContainerCommandRequest `protobuf:"bytes,8,opt,name=containerCommand,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) GetDiscoveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DiscoveredAt
+	}
+	return nil
 }
 
-type AgentCommand_DeleteContainers struct {
-	DeleteContainers *common.DeleteContainersRequest `protobuf:"bytes,9,opt,name=deleteContainers,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) GetApiVersions() []string {
+	if x != nil {
+		return x.ApiVersions
+	}
+	return nil
 }
 
-type AgentCommand_ContainerLog struct {
-	ContainerLog *ContainerLogRequest `protobuf:"bytes,10,opt,name=containerLog,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) GetIngressClasses() []*ClusterIngressClass {
+	if x != nil {
+		return x.IngressClasses
+	}
+	return nil
 }
 
-type AgentCommand_ReplaceToken struct {
-	ReplaceToken *ReplaceTokenRequest `protobuf:"bytes,11,opt,name=replaceToken,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) GetStorageClasses() []*ClusterStorageClass {
+	if x != nil {
+		return x.StorageClasses
+	}
+	return nil
 }
 
-type AgentCommand_ContainerInspect struct {
-	ContainerInspect *ContainerInspectRequest `protobuf:"bytes,12,opt,name=containerInspect,proto3,oneof"`
+func (x *ClusterCapabilitiesMessage) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
 }
 
-func (*AgentCommand_Deploy) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerState) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerDelete) isAgentCommand_Command() {}
-
-func (*AgentCommand_DeployLegacy) isAgentCommand_Command() {}
-
-func (*AgentCommand_ListSecrets) isAgentCommand_Command() {}
-
-func (*AgentCommand_Update) isAgentCommand_Command() {}
-
-func (*AgentCommand_Close) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerCommand) isAgentCommand_Command() {}
-
-func (*AgentCommand_DeleteContainers) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerLog) isAgentCommand_Command() {}
-
-func (*AgentCommand_ReplaceToken) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerInspect) isAgentCommand_Command() {}
-
-type AgentError struct {
+type HostDisk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The mount point, as the agent sees it
+	Path       string `protobuf:"bytes,100,opt,name=path,proto3" json:"path,omitempty"`
+	TotalBytes uint64 `protobuf:"varint,101,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	FreeBytes  uint64 `protobuf:"varint,102,opt,name=freeBytes,proto3" json:"freeBytes,omitempty"`
+	// Free for the unprivileged users, the reserved blocks excluded
+	AvailableBytes uint64 `protobuf:"varint,103,opt,name=availableBytes,proto3" json:"availableBytes,omitempty"`
 }
 
-func (x *AgentError) Reset() {
-	*x = AgentError{}
+func (x *HostDisk) Reset() {
+	*x = HostDisk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[2]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentError) String() string {
+func (x *HostDisk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentError) ProtoMessage() {}
+func (*HostDisk) ProtoMessage() {}
 
-func (x *AgentError) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[2]
+func (x *HostDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,56 +847,71 @@ func (x *AgentError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentError.ProtoReflect.Descriptor instead.
-func (*AgentError) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use HostDisk.ProtoReflect.Descriptor instead.
+func (*HostDisk) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AgentError) GetStatus() int32 {
+func (x *HostDisk) GetPath() string {
 	if x != nil {
-		return x.Status
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HostDisk) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
 	}
 	return 0
 }
 
-func (x *AgentError) GetError() string {
+func (x *HostDisk) GetFreeBytes() uint64 {
 	if x != nil {
-		return x.Error
+		return x.FreeBytes
 	}
-	return ""
+	return 0
 }
 
-type AgentCommandError struct {
+func (x *HostDisk) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+// The counters of the interface since the boot of the host
+type HostNetworkInterface struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Command:
-	//
-	//	*AgentCommandError_ListSecrets
-	//	*AgentCommandError_DeleteContainers
-	//	*AgentCommandError_ContainerLog
-	//	*AgentCommandError_ContainerInspect
-	Command isAgentCommandError_Command `protobuf_oneof:"command"`
+	Name      string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	RxBytes   uint64 `protobuf:"varint,101,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	TxBytes   uint64 `protobuf:"varint,102,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+	RxPackets uint64 `protobuf:"varint,103,opt,name=rxPackets,proto3" json:"rxPackets,omitempty"`
+	TxPackets uint64 `protobuf:"varint,104,opt,name=txPackets,proto3" json:"txPackets,omitempty"`
+	RxErrors  uint64 `protobuf:"varint,105,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
+	TxErrors  uint64 `protobuf:"varint,106,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
 }
 
-func (x *AgentCommandError) Reset() {
-	*x = AgentCommandError{}
+func (x *HostNetworkInterface) Reset() {
+	*x = HostNetworkInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[3]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentCommandError) String() string {
+func (x *HostNetworkInterface) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentCommandError) ProtoMessage() {}
+func (*HostNetworkInterface) ProtoMessage() {}
 
-func (x *AgentCommandError) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[3]
+func (x *HostNetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,101 +922,89 @@ func (x *AgentCommandError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentCommandError.ProtoReflect.Descriptor instead.
-func (*AgentCommandError) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use HostNetworkInterface.ProtoReflect.Descriptor instead.
+func (*HostNetworkInterface) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{5}
 }
 
-func (m *AgentCommandError) GetCommand() isAgentCommandError_Command {
-	if m != nil {
-		return m.Command
+func (x *HostNetworkInterface) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AgentCommandError) GetListSecrets() *AgentError {
-	if x, ok := x.GetCommand().(*AgentCommandError_ListSecrets); ok {
-		return x.ListSecrets
+func (x *HostNetworkInterface) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *AgentCommandError) GetDeleteContainers() *AgentError {
-	if x, ok := x.GetCommand().(*AgentCommandError_DeleteContainers); ok {
-		return x.DeleteContainers
+func (x *HostNetworkInterface) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *AgentCommandError) GetContainerLog() *AgentError {
-	if x, ok := x.GetCommand().(*AgentCommandError_ContainerLog); ok {
-		return x.ContainerLog
+func (x *HostNetworkInterface) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
 	}
-	return nil
+	return 0
 }
 
-func (x *AgentCommandError) GetContainerInspect() *AgentError {
-	if x, ok := x.GetCommand().(*AgentCommandError_ContainerInspect); ok {
-		return x.ContainerInspect
+func (x *HostNetworkInterface) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
 	}
-	return nil
+	return 0
 }
 
-type isAgentCommandError_Command interface {
-	isAgentCommandError_Command()
+func (x *HostNetworkInterface) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
 }
 
-type AgentCommandError_ListSecrets struct {
-	ListSecrets *AgentError `protobuf:"bytes,5,opt,name=listSecrets,proto3,oneof"`
+func (x *HostNetworkInterface) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
 }
 
-type AgentCommandError_DeleteContainers struct {
-	DeleteContainers *AgentError `protobuf:"bytes,9,opt,name=deleteContainers,proto3,oneof"`
-}
+type HostTemperature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type AgentCommandError_ContainerLog struct {
-	ContainerLog *AgentError `protobuf:"bytes,10,opt,name=containerLog,proto3,oneof"`
+	// The thermal zone, eg. thermal_zone0
+	Zone string `protobuf:"bytes,100,opt,name=zone,proto3" json:"zone,omitempty"`
+	// The sensor of the zone, eg. x86_pkg_temp
+	Type    string  `protobuf:"bytes,101,opt,name=type,proto3" json:"type,omitempty"`
+	Celsius float64 `protobuf:"fixed64,102,opt,name=celsius,proto3" json:"celsius,omitempty"`
 }
 
-type AgentCommandError_ContainerInspect struct {
-	ContainerInspect *AgentError `protobuf:"bytes,12,opt,name=containerInspect,proto3,oneof"`
+func (x *HostTemperature) Reset() {
+	*x = HostTemperature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*AgentCommandError_ListSecrets) isAgentCommandError_Command() {}
+func (x *HostTemperature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*AgentCommandError_DeleteContainers) isAgentCommandError_Command() {}
+func (*HostTemperature) ProtoMessage() {}
 
-func (*AgentCommandError_ContainerLog) isAgentCommandError_Command() {}
-
-func (*AgentCommandError_ContainerInspect) isAgentCommandError_Command() {}
-
-// This is more of a placeholder, we could include more, or return this
-// instantly after validation success.
-type DeployResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Started bool `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
-}
-
-func (x *DeployResponse) Reset() {
-	*x = DeployResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeployResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeployResponse) ProtoMessage() {}
-
-func (x *DeployResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[4]
+func (x *HostTemperature) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,48 +1015,71 @@ func (x *DeployResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployResponse.ProtoReflect.Descriptor instead.
-func (*DeployResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use HostTemperature.ProtoReflect.Descriptor instead.
+func (*HostTemperature) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeployResponse) GetStarted() bool {
+func (x *HostTemperature) GetZone() string {
 	if x != nil {
-		return x.Started
+		return x.Zone
 	}
-	return false
+	return ""
 }
 
-type DeployRequest struct {
+func (x *HostTemperature) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HostTemperature) GetCelsius() float64 {
+	if x != nil {
+		return x.Celsius
+	}
+	return 0
+}
+
+// A sample of the host of the agent, streamed periodically by the agents
+// with the hostMetrics capability
+type HostMetricsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	VersionName  string                   `protobuf:"bytes,2,opt,name=versionName,proto3" json:"versionName,omitempty"`
-	ReleaseNotes string                   `protobuf:"bytes,3,opt,name=releaseNotes,proto3" json:"releaseNotes,omitempty"`
-	Prefix       string                   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Secrets      map[string]string        `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Requests     []*DeployWorkloadRequest `protobuf:"bytes,6,rep,name=requests,proto3" json:"requests,omitempty"`
-}
-
-func (x *DeployRequest) Reset() {
-	*x = DeployRequest{}
+	CollectedAt *timestamppb.Timestamp `protobuf:"bytes,100,opt,name=collectedAt,proto3" json:"collectedAt,omitempty"`
+	// The busy fraction of the CPU time since the previous sample
+	CpuUsage             float64                 `protobuf:"fixed64,101,opt,name=cpuUsage,proto3" json:"cpuUsage,omitempty"`
+	CpuCores             uint32                  `protobuf:"varint,102,opt,name=cpuCores,proto3" json:"cpuCores,omitempty"`
+	Load1                float64                 `protobuf:"fixed64,103,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5                float64                 `protobuf:"fixed64,104,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15               float64                 `protobuf:"fixed64,105,opt,name=load15,proto3" json:"load15,omitempty"`
+	MemoryTotalBytes     uint64                  `protobuf:"varint,106,opt,name=memoryTotalBytes,proto3" json:"memoryTotalBytes,omitempty"`
+	MemoryAvailableBytes uint64                  `protobuf:"varint,107,opt,name=memoryAvailableBytes,proto3" json:"memoryAvailableBytes,omitempty"`
+	Disks                []*HostDisk             `protobuf:"bytes,1000,rep,name=disks,proto3" json:"disks,omitempty"`
+	Interfaces           []*HostNetworkInterface `protobuf:"bytes,1001,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// Empty if the host has no readable thermal zones
+	Temperatures []*HostTemperature `protobuf:"bytes,1002,rep,name=temperatures,proto3" json:"temperatures,omitempty"`
+}
+
+func (x *HostMetricsMessage) Reset() {
+	*x = HostMetricsMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[5]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeployRequest) String() string {
+func (x *HostMetricsMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployRequest) ProtoMessage() {}
+func (*HostMetricsMessage) ProtoMessage() {}
 
-func (x *DeployRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[5]
+func (x *HostMetricsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,129 +1090,139 @@ func (x *DeployRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployRequest.ProtoReflect.Descriptor instead.
-func (*DeployRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use HostMetricsMessage.ProtoReflect.Descriptor instead.
+func (*HostMetricsMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeployRequest) GetId() string {
+func (x *HostMetricsMessage) GetCollectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.CollectedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *DeployRequest) GetVersionName() string {
+func (x *HostMetricsMessage) GetCpuUsage() float64 {
 	if x != nil {
-		return x.VersionName
+		return x.CpuUsage
 	}
-	return ""
+	return 0
 }
 
-func (x *DeployRequest) GetReleaseNotes() string {
+func (x *HostMetricsMessage) GetCpuCores() uint32 {
 	if x != nil {
-		return x.ReleaseNotes
+		return x.CpuCores
 	}
-	return ""
+	return 0
 }
 
-func (x *DeployRequest) GetPrefix() string {
+func (x *HostMetricsMessage) GetLoad1() float64 {
 	if x != nil {
-		return x.Prefix
+		return x.Load1
 	}
-	return ""
+	return 0
 }
 
-func (x *DeployRequest) GetSecrets() map[string]string {
+func (x *HostMetricsMessage) GetLoad5() float64 {
 	if x != nil {
-		return x.Secrets
+		return x.Load5
 	}
-	return nil
+	return 0
 }
 
-func (x *DeployRequest) GetRequests() []*DeployWorkloadRequest {
+func (x *HostMetricsMessage) GetLoad15() float64 {
 	if x != nil {
-		return x.Requests
+		return x.Load15
 	}
-	return nil
-}
-
-// Request for a keys of existing secrets in a prefix, eg. namespace
-type ListSecretsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Target *common.ContainerOrPrefix `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	return 0
 }
 
-func (x *ListSecretsRequest) Reset() {
-	*x = ListSecretsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *HostMetricsMessage) GetMemoryTotalBytes() uint64 {
+	if x != nil {
+		return x.MemoryTotalBytes
 	}
+	return 0
 }
 
-func (x *ListSecretsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HostMetricsMessage) GetMemoryAvailableBytes() uint64 {
+	if x != nil {
+		return x.MemoryAvailableBytes
+	}
+	return 0
 }
 
-func (*ListSecretsRequest) ProtoMessage() {}
-
-func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *HostMetricsMessage) GetDisks() []*HostDisk {
+	if x != nil {
+		return x.Disks
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
-func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{6}
+func (x *HostMetricsMessage) GetInterfaces() []*HostNetworkInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
 }
 
-func (x *ListSecretsRequest) GetTarget() *common.ContainerOrPrefix {
+func (x *HostMetricsMessage) GetTemperatures() []*HostTemperature {
 	if x != nil {
-		return x.Target
+		return x.Temperatures
 	}
 	return nil
 }
 
-type RegistryAuth struct {
+type AgentCommand struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	User     string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Types that are assignable to Command:
+	//
+	//	*AgentCommand_Deploy
+	//	*AgentCommand_ContainerState
+	//	*AgentCommand_ContainerDelete
+	//	*AgentCommand_DeployLegacy
+	//	*AgentCommand_ListSecrets
+	//	*AgentCommand_Update
+	//	*AgentCommand_Close
+	//	*AgentCommand_ContainerCommand
+	//	*AgentCommand_DeleteContainers
+	//	*AgentCommand_ContainerLog
+	//	*AgentCommand_ReplaceToken
+	//	*AgentCommand_ContainerInspect
+	//	*AgentCommand_DeploymentDiff
+	//	*AgentCommand_VolumeUsage
+	//	*AgentCommand_RollbackToRevision
+	//	*AgentCommand_ResourceRecommendation
+	//	*AgentCommand_WorkloadOperation
+	//	*AgentCommand_ContainerCheckpoint
+	//	*AgentCommand_ContainerRestore
+	//	*AgentCommand_PruneReport
+	//	*AgentCommand_TrafficUsage
+	//	*AgentCommand_DebugContainer
+	//	*AgentCommand_IngressConfig
+	//	*AgentCommand_InvalidateCache
+	Command isAgentCommand_Command `protobuf_oneof:"command"`
 }
 
-func (x *RegistryAuth) Reset() {
-	*x = RegistryAuth{}
+func (x *AgentCommand) Reset() {
+	*x = AgentCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[7]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryAuth) String() string {
+func (x *AgentCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryAuth) ProtoMessage() {}
+func (*AgentCommand) ProtoMessage() {}
 
-func (x *RegistryAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[7]
+func (x *AgentCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,369 +1233,368 @@ func (x *RegistryAuth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryAuth.ProtoReflect.Descriptor instead.
-func (*RegistryAuth) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AgentCommand.ProtoReflect.Descriptor instead.
+func (*AgentCommand) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RegistryAuth) GetName() string {
-	if x != nil {
-		return x.Name
+func (m *AgentCommand) GetCommand() isAgentCommand_Command {
+	if m != nil {
+		return m.Command
 	}
-	return ""
+	return nil
 }
 
-func (x *RegistryAuth) GetUrl() string {
-	if x != nil {
-		return x.Url
+func (x *AgentCommand) GetDeploy() *DeployRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_Deploy); ok {
+		return x.Deploy
 	}
-	return ""
+	return nil
 }
 
-func (x *RegistryAuth) GetUser() string {
-	if x != nil {
-		return x.User
+func (x *AgentCommand) GetContainerState() *ContainerStateRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerState); ok {
+		return x.ContainerState
 	}
-	return ""
+	return nil
 }
 
-func (x *RegistryAuth) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *AgentCommand) GetContainerDelete() *ContainerDeleteRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerDelete); ok {
+		return x.ContainerDelete
 	}
-	return ""
+	return nil
 }
 
-type Port struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Internal int32  `protobuf:"varint,100,opt,name=internal,proto3" json:"internal,omitempty"`
-	External *int32 `protobuf:"varint,101,opt,name=external,proto3,oneof" json:"external,omitempty"`
+func (x *AgentCommand) GetDeployLegacy() *DeployRequestLegacy {
+	if x, ok := x.GetCommand().(*AgentCommand_DeployLegacy); ok {
+		return x.DeployLegacy
+	}
+	return nil
 }
 
-func (x *Port) Reset() {
-	*x = Port{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommand) GetListSecrets() *ListSecretsRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ListSecrets); ok {
+		return x.ListSecrets
 	}
+	return nil
 }
 
-func (x *Port) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentCommand) GetUpdate() *AgentUpdateRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_Update); ok {
+		return x.Update
+	}
+	return nil
 }
 
-func (*Port) ProtoMessage() {}
-
-func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AgentCommand) GetClose() *CloseConnectionRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_Close); ok {
+		return x.Close
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Port.ProtoReflect.Descriptor instead.
-func (*Port) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{8}
+func (x *AgentCommand) GetContainerCommand() *common.ContainerCommandRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerCommand); ok {
+		return x.ContainerCommand
+	}
+	return nil
 }
 
-func (x *Port) GetInternal() int32 {
-	if x != nil {
-		return x.Internal
+func (x *AgentCommand) GetDeleteContainers() *common.DeleteContainersRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_DeleteContainers); ok {
+		return x.DeleteContainers
 	}
-	return 0
+	return nil
 }
 
-func (x *Port) GetExternal() int32 {
-	if x != nil && x.External != nil {
-		return *x.External
+func (x *AgentCommand) GetContainerLog() *ContainerLogRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerLog); ok {
+		return x.ContainerLog
 	}
-	return 0
+	return nil
 }
 
-type PortRange struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	From int32 `protobuf:"varint,100,opt,name=from,proto3" json:"from,omitempty"`
-	To   int32 `protobuf:"varint,101,opt,name=to,proto3" json:"to,omitempty"`
+func (x *AgentCommand) GetReplaceToken() *ReplaceTokenRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ReplaceToken); ok {
+		return x.ReplaceToken
+	}
+	return nil
 }
 
-func (x *PortRange) Reset() {
-	*x = PortRange{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommand) GetContainerInspect() *ContainerInspectRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerInspect); ok {
+		return x.ContainerInspect
 	}
+	return nil
 }
 
-func (x *PortRange) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentCommand) GetDeploymentDiff() *DeploymentDiffRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_DeploymentDiff); ok {
+		return x.DeploymentDiff
+	}
+	return nil
 }
 
-func (*PortRange) ProtoMessage() {}
-
-func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AgentCommand) GetVolumeUsage() *VolumeUsageRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_VolumeUsage); ok {
+		return x.VolumeUsage
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
-func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{9}
+func (x *AgentCommand) GetRollbackToRevision() *RollbackToRevisionRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_RollbackToRevision); ok {
+		return x.RollbackToRevision
+	}
+	return nil
 }
 
-func (x *PortRange) GetFrom() int32 {
-	if x != nil {
-		return x.From
+func (x *AgentCommand) GetResourceRecommendation() *ResourceRecommendationRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ResourceRecommendation); ok {
+		return x.ResourceRecommendation
 	}
-	return 0
+	return nil
 }
 
-func (x *PortRange) GetTo() int32 {
-	if x != nil {
-		return x.To
+func (x *AgentCommand) GetWorkloadOperation() *WorkloadOperationRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_WorkloadOperation); ok {
+		return x.WorkloadOperation
 	}
-	return 0
+	return nil
 }
 
-type PortRangeBinding struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Internal *PortRange `protobuf:"bytes,100,opt,name=internal,proto3" json:"internal,omitempty"`
-	External *PortRange `protobuf:"bytes,101,opt,name=external,proto3" json:"external,omitempty"`
+func (x *AgentCommand) GetContainerCheckpoint() *ContainerCheckpointRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerCheckpoint); ok {
+		return x.ContainerCheckpoint
+	}
+	return nil
 }
 
-func (x *PortRangeBinding) Reset() {
-	*x = PortRangeBinding{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommand) GetContainerRestore() *ContainerRestoreRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerRestore); ok {
+		return x.ContainerRestore
 	}
+	return nil
 }
 
-func (x *PortRangeBinding) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentCommand) GetPruneReport() *PruneReportRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_PruneReport); ok {
+		return x.PruneReport
+	}
+	return nil
 }
 
-func (*PortRangeBinding) ProtoMessage() {}
-
-func (x *PortRangeBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AgentCommand) GetTrafficUsage() *TrafficUsageRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_TrafficUsage); ok {
+		return x.TrafficUsage
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PortRangeBinding.ProtoReflect.Descriptor instead.
-func (*PortRangeBinding) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{10}
+func (x *AgentCommand) GetDebugContainer() *DebugContainerRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_DebugContainer); ok {
+		return x.DebugContainer
+	}
+	return nil
 }
 
-func (x *PortRangeBinding) GetInternal() *PortRange {
-	if x != nil {
-		return x.Internal
+func (x *AgentCommand) GetIngressConfig() *IngressConfigRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_IngressConfig); ok {
+		return x.IngressConfig
 	}
 	return nil
 }
 
-func (x *PortRangeBinding) GetExternal() *PortRange {
-	if x != nil {
-		return x.External
+func (x *AgentCommand) GetInvalidateCache() *CacheInvalidationRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_InvalidateCache); ok {
+		return x.InvalidateCache
 	}
 	return nil
 }
 
-type Volume struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isAgentCommand_Command interface {
+	isAgentCommand_Command()
+}
 
-	Name  string             `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
-	Path  string             `protobuf:"bytes,101,opt,name=path,proto3" json:"path,omitempty"`
-	Size  *string            `protobuf:"bytes,102,opt,name=size,proto3,oneof" json:"size,omitempty"`
-	Type  *common.VolumeType `protobuf:"varint,103,opt,name=type,proto3,enum=common.VolumeType,oneof" json:"type,omitempty"`
-	Class *string            `protobuf:"bytes,104,opt,name=class,proto3,oneof" json:"class,omitempty"`
+type AgentCommand_Deploy struct {
+	Deploy *DeployRequest `protobuf:"bytes,1,opt,name=deploy,proto3,oneof"`
 }
 
-func (x *Volume) Reset() {
-	*x = Volume{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type AgentCommand_ContainerState struct {
+	ContainerState *ContainerStateRequest `protobuf:"bytes,2,opt,name=containerState,proto3,oneof"`
 }
 
-func (x *Volume) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type AgentCommand_ContainerDelete struct {
+	ContainerDelete *ContainerDeleteRequest `protobuf:"bytes,3,opt,name=containerDelete,proto3,oneof"`
 }
 
-func (*Volume) ProtoMessage() {}
+type AgentCommand_DeployLegacy struct {
+	DeployLegacy *DeployRequestLegacy `protobuf:"bytes,4,opt,name=deployLegacy,proto3,oneof"`
+}
 
-func (x *Volume) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type AgentCommand_ListSecrets struct {
+	ListSecrets *ListSecretsRequest `protobuf:"bytes,5,opt,name=listSecrets,proto3,oneof"`
 }
 
-// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
-func (*Volume) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{11}
+type AgentCommand_Update struct {
+	Update *AgentUpdateRequest `protobuf:"bytes,6,opt,name=update,proto3,oneof"`
 }
 
-func (x *Volume) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type AgentCommand_Close struct {
+	Close *CloseConnectionRequest `protobuf:"bytes,7,opt,name=close,proto3,oneof"`
 }
 
-func (x *Volume) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type AgentCommand_ContainerCommand struct {
+	ContainerCommand *common.ContainerCommandRequest `protobuf:"bytes,8,opt,name=containerCommand,proto3,oneof"`
 }
 
-func (x *Volume) GetSize() string {
-	if x != nil && x.Size != nil {
-		return *x.Size
-	}
-	return ""
+type AgentCommand_DeleteContainers struct {
+	DeleteContainers *common.DeleteContainersRequest `protobuf:"bytes,9,opt,name=deleteContainers,proto3,oneof"`
 }
 
-func (x *Volume) GetType() common.VolumeType {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return common.VolumeType(0)
+type AgentCommand_ContainerLog struct {
+	ContainerLog *ContainerLogRequest `protobuf:"bytes,10,opt,name=containerLog,proto3,oneof"`
 }
 
-func (x *Volume) GetClass() string {
-	if x != nil && x.Class != nil {
-		return *x.Class
-	}
-	return ""
+type AgentCommand_ReplaceToken struct {
+	ReplaceToken *ReplaceTokenRequest `protobuf:"bytes,11,opt,name=replaceToken,proto3,oneof"`
 }
 
-type VolumeLink struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AgentCommand_ContainerInspect struct {
+	ContainerInspect *ContainerInspectRequest `protobuf:"bytes,12,opt,name=containerInspect,proto3,oneof"`
+}
 
-	Name string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,101,opt,name=path,proto3" json:"path,omitempty"`
+type AgentCommand_DeploymentDiff struct {
+	DeploymentDiff *DeploymentDiffRequest `protobuf:"bytes,13,opt,name=deploymentDiff,proto3,oneof"`
 }
 
-func (x *VolumeLink) Reset() {
-	*x = VolumeLink{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type AgentCommand_VolumeUsage struct {
+	VolumeUsage *VolumeUsageRequest `protobuf:"bytes,14,opt,name=volumeUsage,proto3,oneof"`
 }
 
-func (x *VolumeLink) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type AgentCommand_RollbackToRevision struct {
+	RollbackToRevision *RollbackToRevisionRequest `protobuf:"bytes,15,opt,name=rollbackToRevision,proto3,oneof"`
 }
 
-func (*VolumeLink) ProtoMessage() {}
+type AgentCommand_ResourceRecommendation struct {
+	ResourceRecommendation *ResourceRecommendationRequest `protobuf:"bytes,16,opt,name=resourceRecommendation,proto3,oneof"`
+}
 
-func (x *VolumeLink) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type AgentCommand_WorkloadOperation struct {
+	WorkloadOperation *WorkloadOperationRequest `protobuf:"bytes,17,opt,name=workloadOperation,proto3,oneof"`
 }
 
-// Deprecated: Use VolumeLink.ProtoReflect.Descriptor instead.
-func (*VolumeLink) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{12}
+type AgentCommand_ContainerCheckpoint struct {
+	ContainerCheckpoint *ContainerCheckpointRequest `protobuf:"bytes,18,opt,name=containerCheckpoint,proto3,oneof"`
 }
 
-func (x *VolumeLink) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type AgentCommand_ContainerRestore struct {
+	ContainerRestore *ContainerRestoreRequest `protobuf:"bytes,19,opt,name=containerRestore,proto3,oneof"`
 }
 
-func (x *VolumeLink) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type AgentCommand_PruneReport struct {
+	PruneReport *PruneReportRequest `protobuf:"bytes,20,opt,name=pruneReport,proto3,oneof"`
 }
 
-type InitContainer struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AgentCommand_TrafficUsage struct {
+	TrafficUsage *TrafficUsageRequest `protobuf:"bytes,21,opt,name=trafficUsage,proto3,oneof"`
+}
 
-	Name            string            `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
-	Image           string            `protobuf:"bytes,101,opt,name=image,proto3" json:"image,omitempty"`
-	UseParentConfig *bool             `protobuf:"varint,102,opt,name=useParentConfig,proto3,oneof" json:"useParentConfig,omitempty"`
-	Volumes         []*VolumeLink     `protobuf:"bytes,1000,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	Command         []string          `protobuf:"bytes,1001,rep,name=command,proto3" json:"command,omitempty"`
-	Args            []string          `protobuf:"bytes,1002,rep,name=args,proto3" json:"args,omitempty"`
-	Environment     map[string]string `protobuf:"bytes,1003,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type AgentCommand_DebugContainer struct {
+	DebugContainer *DebugContainerRequest `protobuf:"bytes,22,opt,name=debugContainer,proto3,oneof"`
 }
 
-func (x *InitContainer) Reset() {
-	*x = InitContainer{}
+type AgentCommand_IngressConfig struct {
+	IngressConfig *IngressConfigRequest `protobuf:"bytes,23,opt,name=ingressConfig,proto3,oneof"`
+}
+
+type AgentCommand_InvalidateCache struct {
+	InvalidateCache *CacheInvalidationRequest `protobuf:"bytes,24,opt,name=invalidateCache,proto3,oneof"`
+}
+
+func (*AgentCommand_Deploy) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerState) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerDelete) isAgentCommand_Command() {}
+
+func (*AgentCommand_DeployLegacy) isAgentCommand_Command() {}
+
+func (*AgentCommand_ListSecrets) isAgentCommand_Command() {}
+
+func (*AgentCommand_Update) isAgentCommand_Command() {}
+
+func (*AgentCommand_Close) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerCommand) isAgentCommand_Command() {}
+
+func (*AgentCommand_DeleteContainers) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerLog) isAgentCommand_Command() {}
+
+func (*AgentCommand_ReplaceToken) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerInspect) isAgentCommand_Command() {}
+
+func (*AgentCommand_DeploymentDiff) isAgentCommand_Command() {}
+
+func (*AgentCommand_VolumeUsage) isAgentCommand_Command() {}
+
+func (*AgentCommand_RollbackToRevision) isAgentCommand_Command() {}
+
+func (*AgentCommand_ResourceRecommendation) isAgentCommand_Command() {}
+
+func (*AgentCommand_WorkloadOperation) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerCheckpoint) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerRestore) isAgentCommand_Command() {}
+
+func (*AgentCommand_PruneReport) isAgentCommand_Command() {}
+
+func (*AgentCommand_TrafficUsage) isAgentCommand_Command() {}
+
+func (*AgentCommand_DebugContainer) isAgentCommand_Command() {}
+
+func (*AgentCommand_IngressConfig) isAgentCommand_Command() {}
+
+func (*AgentCommand_InvalidateCache) isAgentCommand_Command() {}
+
+type AgentError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The gRPC status code of the category
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Stable, machine readable code of the error, e.g. CONTAINER_NOT_FOUND
+	Code     string        `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Category ErrorCategory `protobuf:"varint,4,opt,name=category,proto3,enum=agent.ErrorCategory" json:"category,omitempty"`
+	// The same command can succeed later without changing it
+	Retryable bool `protobuf:"varint,5,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	// The context of the error, e.g. the kind and the name of the object
+	Details map[string]string `protobuf:"bytes,6,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AgentError) Reset() {
+	*x = AgentError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[13]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InitContainer) String() string {
+func (x *AgentError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitContainer) ProtoMessage() {}
+func (*AgentError) ProtoMessage() {}
 
-func (x *InitContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[13]
+func (x *AgentError) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,87 +1605,99 @@ func (x *InitContainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitContainer.ProtoReflect.Descriptor instead.
-func (*InitContainer) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AgentError.ProtoReflect.Descriptor instead.
+func (*AgentError) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *InitContainer) GetName() string {
+func (x *AgentError) GetStatus() int32 {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *InitContainer) GetImage() string {
+func (x *AgentError) GetError() string {
 	if x != nil {
-		return x.Image
+		return x.Error
 	}
 	return ""
 }
 
-func (x *InitContainer) GetUseParentConfig() bool {
-	if x != nil && x.UseParentConfig != nil {
-		return *x.UseParentConfig
-	}
-	return false
-}
-
-func (x *InitContainer) GetVolumes() []*VolumeLink {
+func (x *AgentError) GetCode() string {
 	if x != nil {
-		return x.Volumes
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *InitContainer) GetCommand() []string {
+func (x *AgentError) GetCategory() ErrorCategory {
 	if x != nil {
-		return x.Command
+		return x.Category
 	}
-	return nil
+	return ErrorCategory_ERROR_CATEGORY_UNSPECIFIED
 }
 
-func (x *InitContainer) GetArgs() []string {
+func (x *AgentError) GetRetryable() bool {
 	if x != nil {
-		return x.Args
+		return x.Retryable
 	}
-	return nil
+	return false
 }
 
-func (x *InitContainer) GetEnvironment() map[string]string {
+func (x *AgentError) GetDetails() map[string]string {
 	if x != nil {
-		return x.Environment
+		return x.Details
 	}
 	return nil
 }
 
-type ImportContainer struct {
+type AgentCommandError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Volume      string            `protobuf:"bytes,100,opt,name=volume,proto3" json:"volume,omitempty"`
-	Command     string            `protobuf:"bytes,101,opt,name=command,proto3" json:"command,omitempty"`
-	Environment map[string]string `protobuf:"bytes,1000,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Command:
+	//
+	//	*AgentCommandError_ListSecrets
+	//	*AgentCommandError_DeleteContainers
+	//	*AgentCommandError_ContainerLog
+	//	*AgentCommandError_ContainerInspect
+	//	*AgentCommandError_DeploymentDiff
+	//	*AgentCommandError_VolumeUsage
+	//	*AgentCommandError_RollbackToRevision
+	//	*AgentCommandError_ResourceRecommendation
+	//	*AgentCommandError_WorkloadOperation
+	//	*AgentCommandError_ContainerCheckpoint
+	//	*AgentCommandError_ContainerRestore
+	//	*AgentCommandError_PruneReport
+	//	*AgentCommandError_TrafficUsage
+	//	*AgentCommandError_DebugContainer
+	//	*AgentCommandError_IngressConfig
+	//	*AgentCommandError_Deploy
+	//	*AgentCommandError_DeleteContainer
+	//	*AgentCommandError_ContainerCommand
+	//	*AgentCommandError_ContainerState
+	Command isAgentCommandError_Command `protobuf_oneof:"command"`
 }
 
-func (x *ImportContainer) Reset() {
-	*x = ImportContainer{}
+func (x *AgentCommandError) Reset() {
+	*x = AgentCommandError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[14]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportContainer) String() string {
+func (x *AgentCommandError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportContainer) ProtoMessage() {}
+func (*AgentCommandError) ProtoMessage() {}
 
-func (x *ImportContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[14]
+func (x *AgentCommandError) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,232 +1708,298 @@ func (x *ImportContainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportContainer.ProtoReflect.Descriptor instead.
-func (*ImportContainer) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AgentCommandError.ProtoReflect.Descriptor instead.
+func (*AgentCommandError) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ImportContainer) GetVolume() string {
-	if x != nil {
-		return x.Volume
+func (m *AgentCommandError) GetCommand() isAgentCommandError_Command {
+	if m != nil {
+		return m.Command
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportContainer) GetCommand() string {
-	if x != nil {
-		return x.Command
+func (x *AgentCommandError) GetListSecrets() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ListSecrets); ok {
+		return x.ListSecrets
 	}
-	return ""
+	return nil
 }
 
-func (x *ImportContainer) GetEnvironment() map[string]string {
-	if x != nil {
-		return x.Environment
+func (x *AgentCommandError) GetDeleteContainers() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_DeleteContainers); ok {
+		return x.DeleteContainers
 	}
 	return nil
 }
 
-type LogConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Driver  common.DriverType `protobuf:"varint,100,opt,name=driver,proto3,enum=common.DriverType" json:"driver,omitempty"`
-	Options map[string]string `protobuf:"bytes,1000,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *AgentCommandError) GetContainerLog() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ContainerLog); ok {
+		return x.ContainerLog
+	}
+	return nil
 }
 
-func (x *LogConfig) Reset() {
-	*x = LogConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommandError) GetContainerInspect() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ContainerInspect); ok {
+		return x.ContainerInspect
 	}
+	return nil
 }
 
-func (x *LogConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentCommandError) GetDeploymentDiff() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_DeploymentDiff); ok {
+		return x.DeploymentDiff
+	}
+	return nil
 }
 
-func (*LogConfig) ProtoMessage() {}
-
-func (x *LogConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AgentCommandError) GetVolumeUsage() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_VolumeUsage); ok {
+		return x.VolumeUsage
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LogConfig.ProtoReflect.Descriptor instead.
-func (*LogConfig) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{15}
+func (x *AgentCommandError) GetRollbackToRevision() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_RollbackToRevision); ok {
+		return x.RollbackToRevision
+	}
+	return nil
 }
 
-func (x *LogConfig) GetDriver() common.DriverType {
-	if x != nil {
-		return x.Driver
+func (x *AgentCommandError) GetResourceRecommendation() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ResourceRecommendation); ok {
+		return x.ResourceRecommendation
 	}
-	return common.DriverType(0)
+	return nil
 }
 
-func (x *LogConfig) GetOptions() map[string]string {
-	if x != nil {
-		return x.Options
+func (x *AgentCommandError) GetWorkloadOperation() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_WorkloadOperation); ok {
+		return x.WorkloadOperation
 	}
 	return nil
 }
 
-type Marker struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Deployment map[string]string `protobuf:"bytes,1000,rep,name=deployment,proto3" json:"deployment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Service    map[string]string `protobuf:"bytes,1001,rep,name=service,proto3" json:"service,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Ingress    map[string]string `protobuf:"bytes,1002,rep,name=ingress,proto3" json:"ingress,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *AgentCommandError) GetContainerCheckpoint() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ContainerCheckpoint); ok {
+		return x.ContainerCheckpoint
+	}
+	return nil
 }
 
-func (x *Marker) Reset() {
-	*x = Marker{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommandError) GetContainerRestore() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ContainerRestore); ok {
+		return x.ContainerRestore
 	}
+	return nil
 }
 
-func (x *Marker) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentCommandError) GetPruneReport() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_PruneReport); ok {
+		return x.PruneReport
+	}
+	return nil
 }
 
-func (*Marker) ProtoMessage() {}
-
-func (x *Marker) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AgentCommandError) GetTrafficUsage() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_TrafficUsage); ok {
+		return x.TrafficUsage
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Marker.ProtoReflect.Descriptor instead.
-func (*Marker) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{16}
+func (x *AgentCommandError) GetDebugContainer() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_DebugContainer); ok {
+		return x.DebugContainer
+	}
+	return nil
 }
 
-func (x *Marker) GetDeployment() map[string]string {
-	if x != nil {
-		return x.Deployment
+func (x *AgentCommandError) GetIngressConfig() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_IngressConfig); ok {
+		return x.IngressConfig
 	}
 	return nil
 }
 
-func (x *Marker) GetService() map[string]string {
-	if x != nil {
-		return x.Service
+func (x *AgentCommandError) GetDeploy() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_Deploy); ok {
+		return x.Deploy
 	}
 	return nil
 }
 
-func (x *Marker) GetIngress() map[string]string {
-	if x != nil {
-		return x.Ingress
+func (x *AgentCommandError) GetDeleteContainer() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_DeleteContainer); ok {
+		return x.DeleteContainer
 	}
 	return nil
 }
 
-type Metrics struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Port int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+func (x *AgentCommandError) GetContainerCommand() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ContainerCommand); ok {
+		return x.ContainerCommand
+	}
+	return nil
 }
 
-func (x *Metrics) Reset() {
-	*x = Metrics{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommandError) GetContainerState() *AgentError {
+	if x, ok := x.GetCommand().(*AgentCommandError_ContainerState); ok {
+		return x.ContainerState
 	}
+	return nil
 }
 
-func (x *Metrics) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isAgentCommandError_Command interface {
+	isAgentCommandError_Command()
 }
 
-func (*Metrics) ProtoMessage() {}
+type AgentCommandError_ListSecrets struct {
+	ListSecrets *AgentError `protobuf:"bytes,5,opt,name=listSecrets,proto3,oneof"`
+}
 
-func (x *Metrics) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type AgentCommandError_DeleteContainers struct {
+	DeleteContainers *AgentError `protobuf:"bytes,9,opt,name=deleteContainers,proto3,oneof"`
 }
 
-// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
-func (*Metrics) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{17}
+type AgentCommandError_ContainerLog struct {
+	ContainerLog *AgentError `protobuf:"bytes,10,opt,name=containerLog,proto3,oneof"`
 }
 
-func (x *Metrics) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+type AgentCommandError_ContainerInspect struct {
+	ContainerInspect *AgentError `protobuf:"bytes,12,opt,name=containerInspect,proto3,oneof"`
 }
 
-func (x *Metrics) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type AgentCommandError_DeploymentDiff struct {
+	DeploymentDiff *AgentError `protobuf:"bytes,13,opt,name=deploymentDiff,proto3,oneof"`
 }
 
-type ExpectedState struct {
+type AgentCommandError_VolumeUsage struct {
+	VolumeUsage *AgentError `protobuf:"bytes,14,opt,name=volumeUsage,proto3,oneof"`
+}
+
+type AgentCommandError_RollbackToRevision struct {
+	RollbackToRevision *AgentError `protobuf:"bytes,15,opt,name=rollbackToRevision,proto3,oneof"`
+}
+
+type AgentCommandError_ResourceRecommendation struct {
+	ResourceRecommendation *AgentError `protobuf:"bytes,16,opt,name=resourceRecommendation,proto3,oneof"`
+}
+
+type AgentCommandError_WorkloadOperation struct {
+	WorkloadOperation *AgentError `protobuf:"bytes,17,opt,name=workloadOperation,proto3,oneof"`
+}
+
+type AgentCommandError_ContainerCheckpoint struct {
+	ContainerCheckpoint *AgentError `protobuf:"bytes,18,opt,name=containerCheckpoint,proto3,oneof"`
+}
+
+type AgentCommandError_ContainerRestore struct {
+	ContainerRestore *AgentError `protobuf:"bytes,19,opt,name=containerRestore,proto3,oneof"`
+}
+
+type AgentCommandError_PruneReport struct {
+	PruneReport *AgentError `protobuf:"bytes,20,opt,name=pruneReport,proto3,oneof"`
+}
+
+type AgentCommandError_TrafficUsage struct {
+	TrafficUsage *AgentError `protobuf:"bytes,21,opt,name=trafficUsage,proto3,oneof"`
+}
+
+type AgentCommandError_DebugContainer struct {
+	DebugContainer *AgentError `protobuf:"bytes,22,opt,name=debugContainer,proto3,oneof"`
+}
+
+type AgentCommandError_IngressConfig struct {
+	IngressConfig *AgentError `protobuf:"bytes,23,opt,name=ingressConfig,proto3,oneof"`
+}
+
+type AgentCommandError_Deploy struct {
+	// The deployment is in the dyo-deployment-id metadata
+	Deploy *AgentError `protobuf:"bytes,24,opt,name=deploy,proto3,oneof"`
+}
+
+type AgentCommandError_DeleteContainer struct {
+	DeleteContainer *AgentError `protobuf:"bytes,25,opt,name=deleteContainer,proto3,oneof"`
+}
+
+type AgentCommandError_ContainerCommand struct {
+	ContainerCommand *AgentError `protobuf:"bytes,26,opt,name=containerCommand,proto3,oneof"`
+}
+
+type AgentCommandError_ContainerState struct {
+	// The prefix is in the dyo-container-prefix metadata
+	ContainerState *AgentError `protobuf:"bytes,27,opt,name=containerState,proto3,oneof"`
+}
+
+func (*AgentCommandError_ListSecrets) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_DeleteContainers) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ContainerLog) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ContainerInspect) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_DeploymentDiff) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_VolumeUsage) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_RollbackToRevision) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ResourceRecommendation) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_WorkloadOperation) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ContainerCheckpoint) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ContainerRestore) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_PruneReport) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_TrafficUsage) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_DebugContainer) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_IngressConfig) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_Deploy) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_DeleteContainer) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ContainerCommand) isAgentCommandError_Command() {}
+
+func (*AgentCommandError_ContainerState) isAgentCommandError_Command() {}
+
+// This is more of a placeholder, we could include more, or return this
+// instantly after validation success.
+type DeployResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State    common.ContainerState `protobuf:"varint,100,opt,name=state,proto3,enum=common.ContainerState" json:"state,omitempty"`
-	Timeout  *int32                `protobuf:"varint,101,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
-	ExitCode *int32                `protobuf:"varint,102,opt,name=exitCode,proto3,oneof" json:"exitCode,omitempty"`
+	Started bool `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
 }
 
-func (x *ExpectedState) Reset() {
-	*x = ExpectedState{}
+func (x *DeployResponse) Reset() {
+	*x = DeployResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[18]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExpectedState) String() string {
+func (x *DeployResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpectedState) ProtoMessage() {}
+func (*DeployResponse) ProtoMessage() {}
 
-func (x *ExpectedState) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[18]
+func (x *DeployResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,62 +2010,58 @@ func (x *ExpectedState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpectedState.ProtoReflect.Descriptor instead.
-func (*ExpectedState) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeployResponse.ProtoReflect.Descriptor instead.
+func (*DeployResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ExpectedState) GetState() common.ContainerState {
+func (x *DeployResponse) GetStarted() bool {
 	if x != nil {
-		return x.State
-	}
-	return common.ContainerState(0)
-}
-
-func (x *ExpectedState) GetTimeout() int32 {
-	if x != nil && x.Timeout != nil {
-		return *x.Timeout
-	}
-	return 0
-}
-
-func (x *ExpectedState) GetExitCode() int32 {
-	if x != nil && x.ExitCode != nil {
-		return *x.ExitCode
+		return x.Started
 	}
-	return 0
+	return false
 }
 
-type DagentContainerConfig struct {
+type DeployRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogConfig     *LogConfig            `protobuf:"bytes,100,opt,name=logConfig,proto3,oneof" json:"logConfig,omitempty"`
-	RestartPolicy *common.RestartPolicy `protobuf:"varint,101,opt,name=restartPolicy,proto3,enum=common.RestartPolicy,oneof" json:"restartPolicy,omitempty"`
-	NetworkMode   *common.NetworkMode   `protobuf:"varint,102,opt,name=networkMode,proto3,enum=common.NetworkMode,oneof" json:"networkMode,omitempty"`
-	ExpectedState *ExpectedState        `protobuf:"bytes,103,opt,name=expectedState,proto3,oneof" json:"expectedState,omitempty"`
-	Networks      []string              `protobuf:"bytes,1000,rep,name=networks,proto3" json:"networks,omitempty"`
-	Labels        map[string]string     `protobuf:"bytes,1001,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id           string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VersionName  string                   `protobuf:"bytes,2,opt,name=versionName,proto3" json:"versionName,omitempty"`
+	ReleaseNotes string                   `protobuf:"bytes,3,opt,name=releaseNotes,proto3" json:"releaseNotes,omitempty"`
+	Prefix       string                   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Secrets      map[string]string        `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Requests     []*DeployWorkloadRequest `protobuf:"bytes,6,rep,name=requests,proto3" json:"requests,omitempty"`
+	//
+	// Containers are only recreated if their configuration or image
+	// changed, unless this is set
+	RecreateUnchanged bool `protobuf:"varint,7,opt,name=recreateUnchanged,proto3" json:"recreateUnchanged,omitempty"`
+	//
+	// Set when the deployment is fanned out to the nodes of a node group,
+	// the same key is sent to every node of the group and with every retry,
+	// so the agents skip the deployment if they finished it already
+	IdempotencyKey *string `protobuf:"bytes,8,opt,name=idempotencyKey,proto3,oneof" json:"idempotencyKey,omitempty"`
+	NodeGroup      *string `protobuf:"bytes,9,opt,name=nodeGroup,proto3,oneof" json:"nodeGroup,omitempty"`
 }
 
-func (x *DagentContainerConfig) Reset() {
-	*x = DagentContainerConfig{}
+func (x *DeployRequest) Reset() {
+	*x = DeployRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[19]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DagentContainerConfig) String() string {
+func (x *DeployRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DagentContainerConfig) ProtoMessage() {}
+func (*DeployRequest) ProtoMessage() {}
 
-func (x *DagentContainerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[19]
+func (x *DeployRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1527,87 +2072,102 @@ func (x *DagentContainerConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DagentContainerConfig.ProtoReflect.Descriptor instead.
-func (*DagentContainerConfig) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeployRequest.ProtoReflect.Descriptor instead.
+func (*DeployRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DagentContainerConfig) GetLogConfig() *LogConfig {
+func (x *DeployRequest) GetId() string {
 	if x != nil {
-		return x.LogConfig
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *DagentContainerConfig) GetRestartPolicy() common.RestartPolicy {
-	if x != nil && x.RestartPolicy != nil {
-		return *x.RestartPolicy
+func (x *DeployRequest) GetVersionName() string {
+	if x != nil {
+		return x.VersionName
 	}
-	return common.RestartPolicy(0)
+	return ""
 }
 
-func (x *DagentContainerConfig) GetNetworkMode() common.NetworkMode {
-	if x != nil && x.NetworkMode != nil {
-		return *x.NetworkMode
+func (x *DeployRequest) GetReleaseNotes() string {
+	if x != nil {
+		return x.ReleaseNotes
 	}
-	return common.NetworkMode(0)
+	return ""
 }
 
-func (x *DagentContainerConfig) GetExpectedState() *ExpectedState {
+func (x *DeployRequest) GetPrefix() string {
 	if x != nil {
-		return x.ExpectedState
+		return x.Prefix
 	}
-	return nil
+	return ""
 }
 
-func (x *DagentContainerConfig) GetNetworks() []string {
+func (x *DeployRequest) GetSecrets() map[string]string {
 	if x != nil {
-		return x.Networks
+		return x.Secrets
 	}
 	return nil
 }
 
-func (x *DagentContainerConfig) GetLabels() map[string]string {
+func (x *DeployRequest) GetRequests() []*DeployWorkloadRequest {
 	if x != nil {
-		return x.Labels
+		return x.Requests
 	}
 	return nil
 }
 
-type CraneContainerConfig struct {
+func (x *DeployRequest) GetRecreateUnchanged() bool {
+	if x != nil {
+		return x.RecreateUnchanged
+	}
+	return false
+}
+
+func (x *DeployRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DeployRequest) GetNodeGroup() string {
+	if x != nil && x.NodeGroup != nil {
+		return *x.NodeGroup
+	}
+	return ""
+}
+
+// Request for a keys of existing secrets in a prefix, eg. namespace
+type ListSecretsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentStrategy *common.DeploymentStrategy `protobuf:"varint,100,opt,name=deploymentStrategy,proto3,enum=common.DeploymentStrategy,oneof" json:"deploymentStrategy,omitempty"`
-	HealthCheckConfig  *common.HealthCheckConfig  `protobuf:"bytes,101,opt,name=healthCheckConfig,proto3,oneof" json:"healthCheckConfig,omitempty"`
-	ResourceConfig     *common.ResourceConfig     `protobuf:"bytes,102,opt,name=resourceConfig,proto3,oneof" json:"resourceConfig,omitempty"`
-	ProxyHeaders       *bool                      `protobuf:"varint,103,opt,name=proxyHeaders,proto3,oneof" json:"proxyHeaders,omitempty"`
-	UseLoadBalancer    *bool                      `protobuf:"varint,104,opt,name=useLoadBalancer,proto3,oneof" json:"useLoadBalancer,omitempty"`
-	Annotations        *Marker                    `protobuf:"bytes,105,opt,name=annotations,proto3,oneof" json:"annotations,omitempty"`
-	Labels             *Marker                    `protobuf:"bytes,106,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
-	Metrics            *Metrics                   `protobuf:"bytes,107,opt,name=metrics,proto3,oneof" json:"metrics,omitempty"`
-	CustomHeaders      []string                   `protobuf:"bytes,1000,rep,name=customHeaders,proto3" json:"customHeaders,omitempty"`
-	ExtraLBAnnotations map[string]string          `protobuf:"bytes,1001,rep,name=extraLBAnnotations,proto3" json:"extraLBAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Target *common.ContainerOrPrefix `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Every key is sent if it is not set
+	Page *common.PageRequest `protobuf:"bytes,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
 }
 
-func (x *CraneContainerConfig) Reset() {
-	*x = CraneContainerConfig{}
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[20]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CraneContainerConfig) String() string {
+func (x *ListSecretsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CraneContainerConfig) ProtoMessage() {}
+func (*ListSecretsRequest) ProtoMessage() {}
 
-func (x *CraneContainerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[20]
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,121 +2178,122 @@ func (x *CraneContainerConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CraneContainerConfig.ProtoReflect.Descriptor instead.
-func (*CraneContainerConfig) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CraneContainerConfig) GetDeploymentStrategy() common.DeploymentStrategy {
-	if x != nil && x.DeploymentStrategy != nil {
-		return *x.DeploymentStrategy
-	}
-	return common.DeploymentStrategy(0)
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CraneContainerConfig) GetHealthCheckConfig() *common.HealthCheckConfig {
+func (x *ListSecretsRequest) GetTarget() *common.ContainerOrPrefix {
 	if x != nil {
-		return x.HealthCheckConfig
+		return x.Target
 	}
 	return nil
 }
 
-func (x *CraneContainerConfig) GetResourceConfig() *common.ResourceConfig {
+func (x *ListSecretsRequest) GetPage() *common.PageRequest {
 	if x != nil {
-		return x.ResourceConfig
+		return x.Page
 	}
 	return nil
 }
 
-func (x *CraneContainerConfig) GetProxyHeaders() bool {
-	if x != nil && x.ProxyHeaders != nil {
-		return *x.ProxyHeaders
-	}
-	return false
+type RegistryAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	User     string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *CraneContainerConfig) GetUseLoadBalancer() bool {
-	if x != nil && x.UseLoadBalancer != nil {
-		return *x.UseLoadBalancer
+func (x *RegistryAuth) Reset() {
+	*x = RegistryAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *CraneContainerConfig) GetAnnotations() *Marker {
-	if x != nil {
-		return x.Annotations
+func (x *RegistryAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryAuth) ProtoMessage() {}
+
+func (x *RegistryAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CraneContainerConfig) GetLabels() *Marker {
+// Deprecated: Use RegistryAuth.ProtoReflect.Descriptor instead.
+func (*RegistryAuth) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegistryAuth) GetName() string {
 	if x != nil {
-		return x.Labels
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CraneContainerConfig) GetMetrics() *Metrics {
+func (x *RegistryAuth) GetUrl() string {
 	if x != nil {
-		return x.Metrics
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *CraneContainerConfig) GetCustomHeaders() []string {
+func (x *RegistryAuth) GetUser() string {
 	if x != nil {
-		return x.CustomHeaders
+		return x.User
 	}
-	return nil
+	return ""
 }
 
-func (x *CraneContainerConfig) GetExtraLBAnnotations() map[string]string {
+func (x *RegistryAuth) GetPassword() string {
 	if x != nil {
-		return x.ExtraLBAnnotations
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-type CommonContainerConfig struct {
+type Port struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string                  `protobuf:"bytes,101,opt,name=name,proto3" json:"name,omitempty"`
-	Expose           *common.ExposeStrategy  `protobuf:"varint,102,opt,name=expose,proto3,enum=common.ExposeStrategy,oneof" json:"expose,omitempty"`
-	Routing          *common.Routing         `protobuf:"bytes,103,opt,name=routing,proto3,oneof" json:"routing,omitempty"`
-	ConfigContainer  *common.ConfigContainer `protobuf:"bytes,104,opt,name=configContainer,proto3,oneof" json:"configContainer,omitempty"`
-	ImportContainer  *ImportContainer        `protobuf:"bytes,105,opt,name=importContainer,proto3,oneof" json:"importContainer,omitempty"`
-	User             *int64                  `protobuf:"varint,106,opt,name=user,proto3,oneof" json:"user,omitempty"`
-	TTY              *bool                   `protobuf:"varint,107,opt,name=TTY,proto3,oneof" json:"TTY,omitempty"`
-	WorkingDirectory *string                 `protobuf:"bytes,108,opt,name=workingDirectory,proto3,oneof" json:"workingDirectory,omitempty"`
-	Ports            []*Port                 `protobuf:"bytes,1000,rep,name=ports,proto3" json:"ports,omitempty"`
-	PortRanges       []*PortRangeBinding     `protobuf:"bytes,1001,rep,name=portRanges,proto3" json:"portRanges,omitempty"`
-	Volumes          []*Volume               `protobuf:"bytes,1002,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	Commands         []string                `protobuf:"bytes,1003,rep,name=commands,proto3" json:"commands,omitempty"`
-	Args             []string                `protobuf:"bytes,1004,rep,name=args,proto3" json:"args,omitempty"`
-	Environment      map[string]string       `protobuf:"bytes,1005,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Secrets          map[string]string       `protobuf:"bytes,1006,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	InitContainers   []*InitContainer        `protobuf:"bytes,1007,rep,name=initContainers,proto3" json:"initContainers,omitempty"`
+	Internal int32  `protobuf:"varint,100,opt,name=internal,proto3" json:"internal,omitempty"`
+	External *int32 `protobuf:"varint,101,opt,name=external,proto3,oneof" json:"external,omitempty"`
 }
 
-func (x *CommonContainerConfig) Reset() {
-	*x = CommonContainerConfig{}
+func (x *Port) Reset() {
+	*x = Port{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[21]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommonContainerConfig) String() string {
+func (x *Port) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommonContainerConfig) ProtoMessage() {}
+func (*Port) ProtoMessage() {}
 
-func (x *CommonContainerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[21]
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,156 +2304,164 @@ func (x *CommonContainerConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommonContainerConfig.ProtoReflect.Descriptor instead.
-func (*CommonContainerConfig) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CommonContainerConfig) GetName() string {
+func (x *Port) GetInternal() int32 {
 	if x != nil {
-		return x.Name
+		return x.Internal
 	}
-	return ""
+	return 0
 }
 
-func (x *CommonContainerConfig) GetExpose() common.ExposeStrategy {
-	if x != nil && x.Expose != nil {
-		return *x.Expose
+func (x *Port) GetExternal() int32 {
+	if x != nil && x.External != nil {
+		return *x.External
 	}
-	return common.ExposeStrategy(0)
+	return 0
 }
 
-func (x *CommonContainerConfig) GetRouting() *common.Routing {
-	if x != nil {
-		return x.Routing
-	}
-	return nil
-}
+type PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CommonContainerConfig) GetConfigContainer() *common.ConfigContainer {
-	if x != nil {
-		return x.ConfigContainer
-	}
-	return nil
+	From int32 `protobuf:"varint,100,opt,name=from,proto3" json:"from,omitempty"`
+	To   int32 `protobuf:"varint,101,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *CommonContainerConfig) GetImportContainer() *ImportContainer {
-	if x != nil {
-		return x.ImportContainer
+func (x *PortRange) Reset() {
+	*x = PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CommonContainerConfig) GetUser() int64 {
-	if x != nil && x.User != nil {
-		return *x.User
-	}
-	return 0
+func (x *PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CommonContainerConfig) GetTTY() bool {
-	if x != nil && x.TTY != nil {
-		return *x.TTY
-	}
-	return false
-}
+func (*PortRange) ProtoMessage() {}
 
-func (x *CommonContainerConfig) GetWorkingDirectory() string {
-	if x != nil && x.WorkingDirectory != nil {
-		return *x.WorkingDirectory
+func (x *PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CommonContainerConfig) GetPorts() []*Port {
-	if x != nil {
-		return x.Ports
-	}
-	return nil
+// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
+func (*PortRange) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CommonContainerConfig) GetPortRanges() []*PortRangeBinding {
+func (x *PortRange) GetFrom() int32 {
 	if x != nil {
-		return x.PortRanges
+		return x.From
 	}
-	return nil
+	return 0
 }
 
-func (x *CommonContainerConfig) GetVolumes() []*Volume {
+func (x *PortRange) GetTo() int32 {
 	if x != nil {
-		return x.Volumes
+		return x.To
 	}
-	return nil
+	return 0
 }
 
-func (x *CommonContainerConfig) GetCommands() []string {
-	if x != nil {
-		return x.Commands
-	}
-	return nil
-}
+type PortRangeBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CommonContainerConfig) GetArgs() []string {
-	if x != nil {
-		return x.Args
-	}
-	return nil
+	Internal *PortRange `protobuf:"bytes,100,opt,name=internal,proto3" json:"internal,omitempty"`
+	External *PortRange `protobuf:"bytes,101,opt,name=external,proto3" json:"external,omitempty"`
 }
 
-func (x *CommonContainerConfig) GetEnvironment() map[string]string {
-	if x != nil {
-		return x.Environment
+func (x *PortRangeBinding) Reset() {
+	*x = PortRangeBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CommonContainerConfig) GetSecrets() map[string]string {
-	if x != nil {
-		return x.Secrets
-	}
-	return nil
+func (x *PortRangeBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CommonContainerConfig) GetInitContainers() []*InitContainer {
+func (*PortRangeBinding) ProtoMessage() {}
+
+func (x *PortRangeBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortRangeBinding.ProtoReflect.Descriptor instead.
+func (*PortRangeBinding) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PortRangeBinding) GetInternal() *PortRange {
 	if x != nil {
-		return x.InitContainers
+		return x.Internal
 	}
 	return nil
 }
 
-type DeployWorkloadRequest struct {
+func (x *PortRangeBinding) GetExternal() *PortRange {
+	if x != nil {
+		return x.External
+	}
+	return nil
+}
+
+type Volume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// ContainerConfigs
-	Common       *CommonContainerConfig `protobuf:"bytes,2,opt,name=common,proto3,oneof" json:"common,omitempty"`
-	Dagent       *DagentContainerConfig `protobuf:"bytes,3,opt,name=dagent,proto3,oneof" json:"dagent,omitempty"`
-	Crane        *CraneContainerConfig  `protobuf:"bytes,4,opt,name=crane,proto3,oneof" json:"crane,omitempty"`
-	Registry     *string                `protobuf:"bytes,5,opt,name=registry,proto3,oneof" json:"registry,omitempty"`
-	ImageName    string                 `protobuf:"bytes,6,opt,name=imageName,proto3" json:"imageName,omitempty"`
-	Tag          string                 `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
-	RegistryAuth *RegistryAuth          `protobuf:"bytes,8,opt,name=registryAuth,proto3,oneof" json:"registryAuth,omitempty"`
+	Name  string             `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Path  string             `protobuf:"bytes,101,opt,name=path,proto3" json:"path,omitempty"`
+	Size  *string            `protobuf:"bytes,102,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Type  *common.VolumeType `protobuf:"varint,103,opt,name=type,proto3,enum=common.VolumeType,oneof" json:"type,omitempty"`
+	Class *string            `protobuf:"bytes,104,opt,name=class,proto3,oneof" json:"class,omitempty"`
 }
 
-func (x *DeployWorkloadRequest) Reset() {
-	*x = DeployWorkloadRequest{}
+func (x *Volume) Reset() {
+	*x = Volume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[22]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeployWorkloadRequest) String() string {
+func (x *Volume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployWorkloadRequest) ProtoMessage() {}
+func (*Volume) ProtoMessage() {}
 
-func (x *DeployWorkloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[22]
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,93 +2472,72 @@ func (x *DeployWorkloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployWorkloadRequest.ProtoReflect.Descriptor instead.
-func (*DeployWorkloadRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeployWorkloadRequest) GetId() string {
+func (x *Volume) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DeployWorkloadRequest) GetCommon() *CommonContainerConfig {
-	if x != nil {
-		return x.Common
-	}
-	return nil
-}
-
-func (x *DeployWorkloadRequest) GetDagent() *DagentContainerConfig {
-	if x != nil {
-		return x.Dagent
-	}
-	return nil
-}
-
-func (x *DeployWorkloadRequest) GetCrane() *CraneContainerConfig {
+func (x *Volume) GetPath() string {
 	if x != nil {
-		return x.Crane
-	}
-	return nil
-}
-
-func (x *DeployWorkloadRequest) GetRegistry() string {
-	if x != nil && x.Registry != nil {
-		return *x.Registry
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DeployWorkloadRequest) GetImageName() string {
-	if x != nil {
-		return x.ImageName
+func (x *Volume) GetSize() string {
+	if x != nil && x.Size != nil {
+		return *x.Size
 	}
 	return ""
 }
 
-func (x *DeployWorkloadRequest) GetTag() string {
-	if x != nil {
-		return x.Tag
+func (x *Volume) GetType() common.VolumeType {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return ""
+	return common.VolumeType(0)
 }
 
-func (x *DeployWorkloadRequest) GetRegistryAuth() *RegistryAuth {
-	if x != nil {
-		return x.RegistryAuth
+func (x *Volume) GetClass() string {
+	if x != nil && x.Class != nil {
+		return *x.Class
 	}
-	return nil
+	return ""
 }
 
-type ContainerStateRequest struct {
+type VolumeLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prefix  *string `protobuf:"bytes,1,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
-	OneShot *bool   `protobuf:"varint,2,opt,name=oneShot,proto3,oneof" json:"oneShot,omitempty"`
+	Name string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,101,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ContainerStateRequest) Reset() {
-	*x = ContainerStateRequest{}
+func (x *VolumeLink) Reset() {
+	*x = VolumeLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[23]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerStateRequest) String() string {
+func (x *VolumeLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStateRequest) ProtoMessage() {}
+func (*VolumeLink) ProtoMessage() {}
 
-func (x *ContainerStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[23]
+func (x *VolumeLink) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,51 +2548,56 @@ func (x *ContainerStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStateRequest.ProtoReflect.Descriptor instead.
-func (*ContainerStateRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use VolumeLink.ProtoReflect.Descriptor instead.
+func (*VolumeLink) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ContainerStateRequest) GetPrefix() string {
-	if x != nil && x.Prefix != nil {
-		return *x.Prefix
+func (x *VolumeLink) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerStateRequest) GetOneShot() bool {
-	if x != nil && x.OneShot != nil {
-		return *x.OneShot
+func (x *VolumeLink) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return false
+	return ""
 }
 
-type ContainerDeleteRequest struct {
+type InitContainer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Name            string            `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Image           string            `protobuf:"bytes,101,opt,name=image,proto3" json:"image,omitempty"`
+	UseParentConfig *bool             `protobuf:"varint,102,opt,name=useParentConfig,proto3,oneof" json:"useParentConfig,omitempty"`
+	Volumes         []*VolumeLink     `protobuf:"bytes,1000,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Command         []string          `protobuf:"bytes,1001,rep,name=command,proto3" json:"command,omitempty"`
+	Args            []string          `protobuf:"bytes,1002,rep,name=args,proto3" json:"args,omitempty"`
+	Environment     map[string]string `protobuf:"bytes,1003,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ContainerDeleteRequest) Reset() {
-	*x = ContainerDeleteRequest{}
+func (x *InitContainer) Reset() {
+	*x = InitContainer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[24]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerDeleteRequest) String() string {
+func (x *InitContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerDeleteRequest) ProtoMessage() {}
+func (*InitContainer) ProtoMessage() {}
 
-func (x *ContainerDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[24]
+func (x *InitContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2055,51 +2608,87 @@ func (x *ContainerDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerDeleteRequest.ProtoReflect.Descriptor instead.
-func (*ContainerDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use InitContainer.ProtoReflect.Descriptor instead.
+func (*InitContainer) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ContainerDeleteRequest) GetPrefix() string {
+func (x *InitContainer) GetName() string {
 	if x != nil {
-		return x.Prefix
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerDeleteRequest) GetName() string {
+func (x *InitContainer) GetImage() string {
 	if x != nil {
-		return x.Name
+		return x.Image
 	}
 	return ""
 }
 
-type DeployRequestLegacy struct {
+func (x *InitContainer) GetUseParentConfig() bool {
+	if x != nil && x.UseParentConfig != nil {
+		return *x.UseParentConfig
+	}
+	return false
+}
+
+func (x *InitContainer) GetVolumes() []*VolumeLink {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *InitContainer) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *InitContainer) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *InitContainer) GetEnvironment() map[string]string {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+type ImportContainer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"` // for early dogger logging
-	Json      string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	Volume      string            `protobuf:"bytes,100,opt,name=volume,proto3" json:"volume,omitempty"`
+	Command     string            `protobuf:"bytes,101,opt,name=command,proto3" json:"command,omitempty"`
+	Environment map[string]string `protobuf:"bytes,1000,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DeployRequestLegacy) Reset() {
-	*x = DeployRequestLegacy{}
+func (x *ImportContainer) Reset() {
+	*x = ImportContainer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[25]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeployRequestLegacy) String() string {
+func (x *ImportContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployRequestLegacy) ProtoMessage() {}
+func (*ImportContainer) ProtoMessage() {}
 
-func (x *DeployRequestLegacy) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[25]
+func (x *ImportContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,53 +2699,58 @@ func (x *DeployRequestLegacy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployRequestLegacy.ProtoReflect.Descriptor instead.
-func (*DeployRequestLegacy) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ImportContainer.ProtoReflect.Descriptor instead.
+func (*ImportContainer) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeployRequestLegacy) GetRequestId() string {
+func (x *ImportContainer) GetVolume() string {
 	if x != nil {
-		return x.RequestId
+		return x.Volume
 	}
 	return ""
 }
 
-func (x *DeployRequestLegacy) GetJson() string {
+func (x *ImportContainer) GetCommand() string {
 	if x != nil {
-		return x.Json
+		return x.Command
 	}
 	return ""
 }
 
-// Dagent update
-type AgentUpdateRequest struct {
+func (x *ImportContainer) GetEnvironment() map[string]string {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+type LogConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag            string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
-	Token          string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Driver  common.DriverType `protobuf:"varint,100,opt,name=driver,proto3,enum=common.DriverType" json:"driver,omitempty"`
+	Options map[string]string `protobuf:"bytes,1000,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *AgentUpdateRequest) Reset() {
-	*x = AgentUpdateRequest{}
+func (x *LogConfig) Reset() {
+	*x = LogConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[26]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentUpdateRequest) String() string {
+func (x *LogConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentUpdateRequest) ProtoMessage() {}
+func (*LogConfig) ProtoMessage() {}
 
-func (x *AgentUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[26]
+func (x *LogConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,57 +2761,114 @@ func (x *AgentUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AgentUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use LogConfig.ProtoReflect.Descriptor instead.
+func (*LogConfig) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AgentUpdateRequest) GetTag() string {
+func (x *LogConfig) GetDriver() common.DriverType {
 	if x != nil {
-		return x.Tag
+		return x.Driver
 	}
-	return ""
+	return common.DriverType(0)
 }
 
-func (x *AgentUpdateRequest) GetTimeoutSeconds() int32 {
+func (x *LogConfig) GetOptions() map[string]string {
 	if x != nil {
-		return x.TimeoutSeconds
+		return x.Options
 	}
-	return 0
+	return nil
 }
 
-func (x *AgentUpdateRequest) GetToken() string {
+type Marker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deployment map[string]string `protobuf:"bytes,1000,rep,name=deployment,proto3" json:"deployment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Service    map[string]string `protobuf:"bytes,1001,rep,name=service,proto3" json:"service,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ingress    map[string]string `protobuf:"bytes,1002,rep,name=ingress,proto3" json:"ingress,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Marker) Reset() {
+	*x = Marker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Marker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Marker) ProtoMessage() {}
+
+func (x *Marker) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Marker.ProtoReflect.Descriptor instead.
+func (*Marker) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Marker) GetDeployment() map[string]string {
 	if x != nil {
-		return x.Token
+		return x.Deployment
 	}
-	return ""
+	return nil
 }
 
-type ReplaceTokenRequest struct {
+func (x *Marker) GetService() map[string]string {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *Marker) GetIngress() map[string]string {
+	if x != nil {
+		return x.Ingress
+	}
+	return nil
+}
+
+type Metrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Port int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ReplaceTokenRequest) Reset() {
-	*x = ReplaceTokenRequest{}
+func (x *Metrics) Reset() {
+	*x = Metrics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[27]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplaceTokenRequest) String() string {
+func (x *Metrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplaceTokenRequest) ProtoMessage() {}
+func (*Metrics) ProtoMessage() {}
 
-func (x *ReplaceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[27]
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,43 +2879,52 @@ func (x *ReplaceTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplaceTokenRequest.ProtoReflect.Descriptor instead.
-func (*ReplaceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ReplaceTokenRequest) GetToken() string {
+func (x *Metrics) GetPort() int32 {
 	if x != nil {
-		return x.Token
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Metrics) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-type AgentAbortUpdate struct {
+type ExpectedState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	State    common.ContainerState `protobuf:"varint,100,opt,name=state,proto3,enum=common.ContainerState" json:"state,omitempty"`
+	Timeout  *int32                `protobuf:"varint,101,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	ExitCode *int32                `protobuf:"varint,102,opt,name=exitCode,proto3,oneof" json:"exitCode,omitempty"`
 }
 
-func (x *AgentAbortUpdate) Reset() {
-	*x = AgentAbortUpdate{}
+func (x *ExpectedState) Reset() {
+	*x = ExpectedState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[28]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentAbortUpdate) String() string {
+func (x *ExpectedState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentAbortUpdate) ProtoMessage() {}
+func (*ExpectedState) ProtoMessage() {}
 
-func (x *AgentAbortUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[28]
+func (x *ExpectedState) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,46 +2935,64 @@ func (x *AgentAbortUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentAbortUpdate.ProtoReflect.Descriptor instead.
-func (*AgentAbortUpdate) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ExpectedState.ProtoReflect.Descriptor instead.
+func (*ExpectedState) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AgentAbortUpdate) GetError() string {
+func (x *ExpectedState) GetState() common.ContainerState {
 	if x != nil {
-		return x.Error
+		return x.State
 	}
-	return ""
+	return common.ContainerState(0)
 }
 
-// Container log
-type ContainerLogRequest struct {
+func (x *ExpectedState) GetTimeout() int32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *ExpectedState) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+// Restarts of a crash-looping container on top of its restart policy, the
+// ones over the budget of the window are delayed by an exponential backoff
+type RestartBudget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Container *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
-	Streaming bool                        `protobuf:"varint,2,opt,name=streaming,proto3" json:"streaming,omitempty"`
-	Tail      uint32                      `protobuf:"varint,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	MaxRestarts   uint32 `protobuf:"varint,100,opt,name=maxRestarts,proto3" json:"maxRestarts,omitempty"`
+	WindowSeconds uint32 `protobuf:"varint,101,opt,name=windowSeconds,proto3" json:"windowSeconds,omitempty"`
+	// First delay of the backoff, doubled by every restart over the budget
+	BackoffSeconds    *uint32              `protobuf:"varint,102,opt,name=backoffSeconds,proto3,oneof" json:"backoffSeconds,omitempty"`
+	MaxBackoffSeconds *uint32              `protobuf:"varint,103,opt,name=maxBackoffSeconds,proto3,oneof" json:"maxBackoffSeconds,omitempty"`
+	GiveUpAction      *RestartGiveUpAction `protobuf:"varint,104,opt,name=giveUpAction,proto3,enum=agent.RestartGiveUpAction,oneof" json:"giveUpAction,omitempty"`
 }
 
-func (x *ContainerLogRequest) Reset() {
-	*x = ContainerLogRequest{}
+func (x *RestartBudget) Reset() {
+	*x = RestartBudget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[29]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerLogRequest) String() string {
+func (x *RestartBudget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerLogRequest) ProtoMessage() {}
+func (*RestartBudget) ProtoMessage() {}
 
-func (x *ContainerLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[29]
+func (x *RestartBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,814 +3003,6387 @@ func (x *ContainerLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerLogRequest.ProtoReflect.Descriptor instead.
-func (*ContainerLogRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RestartBudget.ProtoReflect.Descriptor instead.
+func (*RestartBudget) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ContainerLogRequest) GetContainer() *common.ContainerIdentifier {
+func (x *RestartBudget) GetMaxRestarts() uint32 {
 	if x != nil {
-		return x.Container
+		return x.MaxRestarts
 	}
-	return nil
+	return 0
 }
 
-func (x *ContainerLogRequest) GetStreaming() bool {
+func (x *RestartBudget) GetWindowSeconds() uint32 {
 	if x != nil {
-		return x.Streaming
+		return x.WindowSeconds
 	}
-	return false
+	return 0
 }
 
-func (x *ContainerLogRequest) GetTail() uint32 {
-	if x != nil {
-		return x.Tail
+func (x *RestartBudget) GetBackoffSeconds() uint32 {
+	if x != nil && x.BackoffSeconds != nil {
+		return *x.BackoffSeconds
 	}
 	return 0
 }
 
-// Container inspect
-type ContainerInspectRequest struct {
+func (x *RestartBudget) GetMaxBackoffSeconds() uint32 {
+	if x != nil && x.MaxBackoffSeconds != nil {
+		return *x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RestartBudget) GetGiveUpAction() RestartGiveUpAction {
+	if x != nil && x.GiveUpAction != nil {
+		return *x.GiveUpAction
+	}
+	return RestartGiveUpAction_RESTART_GIVE_UP_ACTION_UNSPECIFIED
+}
+
+type NetworkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Container *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Name      string            `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Driver    *string           `protobuf:"bytes,101,opt,name=driver,proto3,oneof" json:"driver,omitempty"`
+	Parent    *string           `protobuf:"bytes,102,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
+	Subnet    *string           `protobuf:"bytes,103,opt,name=subnet,proto3,oneof" json:"subnet,omitempty"`
+	Gateway   *string           `protobuf:"bytes,104,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`
+	IpRange   *string           `protobuf:"bytes,105,opt,name=ipRange,proto3,oneof" json:"ipRange,omitempty"`
+	Mode      *string           `protobuf:"bytes,106,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	IpAddress *string           `protobuf:"bytes,107,opt,name=ipAddress,proto3,oneof" json:"ipAddress,omitempty"`
+	Options   map[string]string `protobuf:"bytes,1000,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ContainerInspectRequest) Reset() {
-	*x = ContainerInspectRequest{}
+func (x *NetworkConfig) Reset() {
+	*x = NetworkConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[30]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerInspectRequest) String() string {
+func (x *NetworkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerInspectRequest) ProtoMessage() {}
+func (*NetworkConfig) ProtoMessage() {}
 
-func (x *ContainerInspectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[30]
+func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ContainerInspectRequest.ProtoReflect.Descriptor instead.
-func (*ContainerInspectRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ContainerInspectRequest) GetContainer() *common.ContainerIdentifier {
-	if x != nil {
-		return x.Container
-	}
-	return nil
-}
-
-type CloseConnectionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Reason CloseReason `protobuf:"varint,1,opt,name=reason,proto3,enum=agent.CloseReason" json:"reason,omitempty"`
-}
-
-func (x *CloseConnectionRequest) Reset() {
-	*x = CloseConnectionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CloseConnectionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CloseConnectionRequest) ProtoMessage() {}
-
-func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NetworkConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetDriver() string {
+	if x != nil && x.Driver != nil {
+		return *x.Driver
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetParent() string {
+	if x != nil && x.Parent != nil {
+		return *x.Parent
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetSubnet() string {
+	if x != nil && x.Subnet != nil {
+		return *x.Subnet
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetGateway() string {
+	if x != nil && x.Gateway != nil {
+		return *x.Gateway
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetIpRange() string {
+	if x != nil && x.IpRange != nil {
+		return *x.IpRange
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DagentContainerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogConfig      *LogConfig             `protobuf:"bytes,100,opt,name=logConfig,proto3,oneof" json:"logConfig,omitempty"`
+	RestartPolicy  *common.RestartPolicy  `protobuf:"varint,101,opt,name=restartPolicy,proto3,enum=common.RestartPolicy,oneof" json:"restartPolicy,omitempty"`
+	NetworkMode    *common.NetworkMode    `protobuf:"varint,102,opt,name=networkMode,proto3,enum=common.NetworkMode,oneof" json:"networkMode,omitempty"`
+	ExpectedState  *ExpectedState         `protobuf:"bytes,103,opt,name=expectedState,proto3,oneof" json:"expectedState,omitempty"`
+	ResourceConfig *common.ResourceConfig `protobuf:"bytes,104,opt,name=resourceConfig,proto3,oneof" json:"resourceConfig,omitempty"`
+	Isolation      *Isolation             `protobuf:"varint,105,opt,name=isolation,proto3,enum=agent.Isolation,oneof" json:"isolation,omitempty"`
+	PidMode        *string                `protobuf:"bytes,106,opt,name=pidMode,proto3,oneof" json:"pidMode,omitempty"`
+	IpcMode        *string                `protobuf:"bytes,107,opt,name=ipcMode,proto3,oneof" json:"ipcMode,omitempty"`
+	//
+	// Templates of the hostname and the domain name, eg. {{ .Node }}-license,
+	// the variables are .Node, .Prefix, .Container and .RootDomain
+	Hostname      *string        `protobuf:"bytes,108,opt,name=hostname,proto3,oneof" json:"hostname,omitempty"`
+	Domainname    *string        `protobuf:"bytes,109,opt,name=domainname,proto3,oneof" json:"domainname,omitempty"`
+	RestartBudget *RestartBudget `protobuf:"bytes,110,opt,name=restartBudget,proto3,oneof" json:"restartBudget,omitempty"`
+	//
+	// OCI runtime of the container, eg. nvidia or kata, it has to be
+	// configured on the daemon, the default runtime is used if empty
+	Runtime        *string           `protobuf:"bytes,111,opt,name=runtime,proto3,oneof" json:"runtime,omitempty"`
+	Networks       []string          `protobuf:"bytes,1000,rep,name=networks,proto3" json:"networks,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,1001,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NetworkConfigs []*NetworkConfig  `protobuf:"bytes,1002,rep,name=networkConfigs,proto3" json:"networkConfigs,omitempty"`
+	//
+	// Globs of the log files written by the container, eg. /app/logs/*.log,
+	// they are tailed into the container log, so they have to be in a mount
+	LogFiles []string `protobuf:"bytes,1003,rep,name=logFiles,proto3" json:"logFiles,omitempty"`
+	//
+	// Options of the runtime, passed as the OCI annotations of the container,
+	// eg. io.katacontainers.config.hypervisor.default_vcpus
+	RuntimeOptions map[string]string `protobuf:"bytes,1004,rep,name=runtimeOptions,proto3" json:"runtimeOptions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DagentContainerConfig) Reset() {
+	*x = DagentContainerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DagentContainerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DagentContainerConfig) ProtoMessage() {}
+
+func (x *DagentContainerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DagentContainerConfig.ProtoReflect.Descriptor instead.
+func (*DagentContainerConfig) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DagentContainerConfig) GetLogConfig() *LogConfig {
+	if x != nil {
+		return x.LogConfig
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetRestartPolicy() common.RestartPolicy {
+	if x != nil && x.RestartPolicy != nil {
+		return *x.RestartPolicy
+	}
+	return common.RestartPolicy(0)
+}
+
+func (x *DagentContainerConfig) GetNetworkMode() common.NetworkMode {
+	if x != nil && x.NetworkMode != nil {
+		return *x.NetworkMode
+	}
+	return common.NetworkMode(0)
+}
+
+func (x *DagentContainerConfig) GetExpectedState() *ExpectedState {
+	if x != nil {
+		return x.ExpectedState
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetResourceConfig() *common.ResourceConfig {
+	if x != nil {
+		return x.ResourceConfig
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetIsolation() Isolation {
+	if x != nil && x.Isolation != nil {
+		return *x.Isolation
+	}
+	return Isolation_ISOLATION_UNSPECIFIED
+}
+
+func (x *DagentContainerConfig) GetPidMode() string {
+	if x != nil && x.PidMode != nil {
+		return *x.PidMode
+	}
+	return ""
+}
+
+func (x *DagentContainerConfig) GetIpcMode() string {
+	if x != nil && x.IpcMode != nil {
+		return *x.IpcMode
+	}
+	return ""
+}
+
+func (x *DagentContainerConfig) GetHostname() string {
+	if x != nil && x.Hostname != nil {
+		return *x.Hostname
+	}
+	return ""
+}
+
+func (x *DagentContainerConfig) GetDomainname() string {
+	if x != nil && x.Domainname != nil {
+		return *x.Domainname
+	}
+	return ""
+}
+
+func (x *DagentContainerConfig) GetRestartBudget() *RestartBudget {
+	if x != nil {
+		return x.RestartBudget
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetRuntime() string {
+	if x != nil && x.Runtime != nil {
+		return *x.Runtime
+	}
+	return ""
+}
+
+func (x *DagentContainerConfig) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetNetworkConfigs() []*NetworkConfig {
+	if x != nil {
+		return x.NetworkConfigs
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetLogFiles() []string {
+	if x != nil {
+		return x.LogFiles
+	}
+	return nil
+}
+
+func (x *DagentContainerConfig) GetRuntimeOptions() map[string]string {
+	if x != nil {
+		return x.RuntimeOptions
+	}
+	return nil
+}
+
+// A file mounted into the container, the content of secret files is encrypted
+// like the secrets of the container
+type ConfigFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string `protobuf:"bytes,100,opt,name=path,proto3" json:"path,omitempty"`
+	Content string `protobuf:"bytes,101,opt,name=content,proto3" json:"content,omitempty"`
+	Secret  *bool  `protobuf:"varint,102,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+}
+
+func (x *ConfigFile) Reset() {
+	*x = ConfigFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigFile) ProtoMessage() {}
+
+func (x *ConfigFile) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigFile.ProtoReflect.Descriptor instead.
+func (*ConfigFile) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConfigFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ConfigFile) GetSecret() bool {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return false
+}
+
+// The service account of the container, crane creates one named after the
+// container with the annotations, eg. eks.amazonaws.com/role-arn, unless an
+// existing service account is set
+type WorkloadIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceAccount *string           `protobuf:"bytes,100,opt,name=serviceAccount,proto3,oneof" json:"serviceAccount,omitempty"`
+	Annotations    map[string]string `protobuf:"bytes,1000,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WorkloadIdentity) Reset() {
+	*x = WorkloadIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadIdentity) ProtoMessage() {}
+
+func (x *WorkloadIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadIdentity.ProtoReflect.Descriptor instead.
+func (*WorkloadIdentity) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WorkloadIdentity) GetServiceAccount() string {
+	if x != nil && x.ServiceAccount != nil {
+		return *x.ServiceAccount
+	}
+	return ""
+}
+
+func (x *WorkloadIdentity) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// A service account token for the audience mounted into the container,
+// the kubelet rotates it before it expires
+type ProjectedToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path              string `protobuf:"bytes,100,opt,name=path,proto3" json:"path,omitempty"`
+	Audience          string `protobuf:"bytes,101,opt,name=audience,proto3" json:"audience,omitempty"`
+	ExpirationSeconds *int64 `protobuf:"varint,102,opt,name=expirationSeconds,proto3,oneof" json:"expirationSeconds,omitempty"`
+}
+
+func (x *ProjectedToken) Reset() {
+	*x = ProjectedToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectedToken) ProtoMessage() {}
+
+func (x *ProjectedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectedToken.ProtoReflect.Descriptor instead.
+func (*ProjectedToken) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProjectedToken) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProjectedToken) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *ProjectedToken) GetExpirationSeconds() int64 {
+	if x != nil && x.ExpirationSeconds != nil {
+		return *x.ExpirationSeconds
+	}
+	return 0
+}
+
+type CraneContainerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentStrategy *common.DeploymentStrategy `protobuf:"varint,100,opt,name=deploymentStrategy,proto3,enum=common.DeploymentStrategy,oneof" json:"deploymentStrategy,omitempty"`
+	HealthCheckConfig  *common.HealthCheckConfig  `protobuf:"bytes,101,opt,name=healthCheckConfig,proto3,oneof" json:"healthCheckConfig,omitempty"`
+	ResourceConfig     *common.ResourceConfig     `protobuf:"bytes,102,opt,name=resourceConfig,proto3,oneof" json:"resourceConfig,omitempty"`
+	ProxyHeaders       *bool                      `protobuf:"varint,103,opt,name=proxyHeaders,proto3,oneof" json:"proxyHeaders,omitempty"`
+	UseLoadBalancer    *bool                      `protobuf:"varint,104,opt,name=useLoadBalancer,proto3,oneof" json:"useLoadBalancer,omitempty"`
+	Annotations        *Marker                    `protobuf:"bytes,105,opt,name=annotations,proto3,oneof" json:"annotations,omitempty"`
+	Labels             *Marker                    `protobuf:"bytes,106,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
+	Metrics            *Metrics                   `protobuf:"bytes,107,opt,name=metrics,proto3,oneof" json:"metrics,omitempty"`
+	ServiceType        *ServiceType               `protobuf:"varint,108,opt,name=serviceType,proto3,enum=agent.ServiceType,oneof" json:"serviceType,omitempty"`
+	WorkloadIdentity   *WorkloadIdentity          `protobuf:"bytes,109,opt,name=workloadIdentity,proto3,oneof" json:"workloadIdentity,omitempty"`
+	CustomHeaders      []string                   `protobuf:"bytes,1000,rep,name=customHeaders,proto3" json:"customHeaders,omitempty"`
+	ExtraLBAnnotations map[string]string          `protobuf:"bytes,1001,rep,name=extraLBAnnotations,proto3" json:"extraLBAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ConfigFiles        []*ConfigFile              `protobuf:"bytes,1002,rep,name=configFiles,proto3" json:"configFiles,omitempty"`
+	ProjectedTokens    []*ProjectedToken          `protobuf:"bytes,1003,rep,name=projectedTokens,proto3" json:"projectedTokens,omitempty"`
+}
+
+func (x *CraneContainerConfig) Reset() {
+	*x = CraneContainerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CraneContainerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CraneContainerConfig) ProtoMessage() {}
+
+func (x *CraneContainerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CraneContainerConfig.ProtoReflect.Descriptor instead.
+func (*CraneContainerConfig) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CraneContainerConfig) GetDeploymentStrategy() common.DeploymentStrategy {
+	if x != nil && x.DeploymentStrategy != nil {
+		return *x.DeploymentStrategy
+	}
+	return common.DeploymentStrategy(0)
+}
+
+func (x *CraneContainerConfig) GetHealthCheckConfig() *common.HealthCheckConfig {
+	if x != nil {
+		return x.HealthCheckConfig
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetResourceConfig() *common.ResourceConfig {
+	if x != nil {
+		return x.ResourceConfig
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetProxyHeaders() bool {
+	if x != nil && x.ProxyHeaders != nil {
+		return *x.ProxyHeaders
+	}
+	return false
+}
+
+func (x *CraneContainerConfig) GetUseLoadBalancer() bool {
+	if x != nil && x.UseLoadBalancer != nil {
+		return *x.UseLoadBalancer
+	}
+	return false
+}
+
+func (x *CraneContainerConfig) GetAnnotations() *Marker {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetLabels() *Marker {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetServiceType() ServiceType {
+	if x != nil && x.ServiceType != nil {
+		return *x.ServiceType
+	}
+	return ServiceType_SERVICE_TYPE_UNSPECIFIED
+}
+
+func (x *CraneContainerConfig) GetWorkloadIdentity() *WorkloadIdentity {
+	if x != nil {
+		return x.WorkloadIdentity
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetCustomHeaders() []string {
+	if x != nil {
+		return x.CustomHeaders
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetExtraLBAnnotations() map[string]string {
+	if x != nil {
+		return x.ExtraLBAnnotations
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetConfigFiles() []*ConfigFile {
+	if x != nil {
+		return x.ConfigFiles
+	}
+	return nil
+}
+
+func (x *CraneContainerConfig) GetProjectedTokens() []*ProjectedToken {
+	if x != nil {
+		return x.ProjectedTokens
+	}
+	return nil
+}
+
+type CommonContainerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string                  `protobuf:"bytes,101,opt,name=name,proto3" json:"name,omitempty"`
+	Expose           *common.ExposeStrategy  `protobuf:"varint,102,opt,name=expose,proto3,enum=common.ExposeStrategy,oneof" json:"expose,omitempty"`
+	Routing          *common.Routing         `protobuf:"bytes,103,opt,name=routing,proto3,oneof" json:"routing,omitempty"`
+	ConfigContainer  *common.ConfigContainer `protobuf:"bytes,104,opt,name=configContainer,proto3,oneof" json:"configContainer,omitempty"`
+	ImportContainer  *ImportContainer        `protobuf:"bytes,105,opt,name=importContainer,proto3,oneof" json:"importContainer,omitempty"`
+	User             *int64                  `protobuf:"varint,106,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	TTY              *bool                   `protobuf:"varint,107,opt,name=TTY,proto3,oneof" json:"TTY,omitempty"`
+	WorkingDirectory *string                 `protobuf:"bytes,108,opt,name=workingDirectory,proto3,oneof" json:"workingDirectory,omitempty"`
+	Ports            []*Port                 `protobuf:"bytes,1000,rep,name=ports,proto3" json:"ports,omitempty"`
+	PortRanges       []*PortRangeBinding     `protobuf:"bytes,1001,rep,name=portRanges,proto3" json:"portRanges,omitempty"`
+	Volumes          []*Volume               `protobuf:"bytes,1002,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Commands         []string                `protobuf:"bytes,1003,rep,name=commands,proto3" json:"commands,omitempty"`
+	Args             []string                `protobuf:"bytes,1004,rep,name=args,proto3" json:"args,omitempty"`
+	Environment      map[string]string       `protobuf:"bytes,1005,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Secrets          map[string]string       `protobuf:"bytes,1006,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	InitContainers   []*InitContainer        `protobuf:"bytes,1007,rep,name=initContainers,proto3" json:"initContainers,omitempty"`
+}
+
+func (x *CommonContainerConfig) Reset() {
+	*x = CommonContainerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommonContainerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonContainerConfig) ProtoMessage() {}
+
+func (x *CommonContainerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonContainerConfig.ProtoReflect.Descriptor instead.
+func (*CommonContainerConfig) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CommonContainerConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommonContainerConfig) GetExpose() common.ExposeStrategy {
+	if x != nil && x.Expose != nil {
+		return *x.Expose
+	}
+	return common.ExposeStrategy(0)
+}
+
+func (x *CommonContainerConfig) GetRouting() *common.Routing {
+	if x != nil {
+		return x.Routing
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetConfigContainer() *common.ConfigContainer {
+	if x != nil {
+		return x.ConfigContainer
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetImportContainer() *ImportContainer {
+	if x != nil {
+		return x.ImportContainer
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetUser() int64 {
+	if x != nil && x.User != nil {
+		return *x.User
+	}
+	return 0
+}
+
+func (x *CommonContainerConfig) GetTTY() bool {
+	if x != nil && x.TTY != nil {
+		return *x.TTY
+	}
+	return false
+}
+
+func (x *CommonContainerConfig) GetWorkingDirectory() string {
+	if x != nil && x.WorkingDirectory != nil {
+		return *x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *CommonContainerConfig) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetPortRanges() []*PortRangeBinding {
+	if x != nil {
+		return x.PortRanges
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetEnvironment() map[string]string {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *CommonContainerConfig) GetInitContainers() []*InitContainer {
+	if x != nil {
+		return x.InitContainers
+	}
+	return nil
+}
+
+type DeployWorkloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ContainerConfigs
+	Common       *CommonContainerConfig `protobuf:"bytes,2,opt,name=common,proto3,oneof" json:"common,omitempty"`
+	Dagent       *DagentContainerConfig `protobuf:"bytes,3,opt,name=dagent,proto3,oneof" json:"dagent,omitempty"`
+	Crane        *CraneContainerConfig  `protobuf:"bytes,4,opt,name=crane,proto3,oneof" json:"crane,omitempty"`
+	Registry     *string                `protobuf:"bytes,5,opt,name=registry,proto3,oneof" json:"registry,omitempty"`
+	ImageName    string                 `protobuf:"bytes,6,opt,name=imageName,proto3" json:"imageName,omitempty"`
+	Tag          string                 `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	RegistryAuth *RegistryAuth          `protobuf:"bytes,8,opt,name=registryAuth,proto3,oneof" json:"registryAuth,omitempty"`
+	// the container config is merged from a base shared by the environments, the name is still taken from common
+	Base *BaseConfigRef `protobuf:"bytes,9,opt,name=base,proto3,oneof" json:"base,omitempty"`
+}
+
+func (x *DeployWorkloadRequest) Reset() {
+	*x = DeployWorkloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployWorkloadRequest) ProtoMessage() {}
+
+func (x *DeployWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*DeployWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeployWorkloadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeployWorkloadRequest) GetCommon() *CommonContainerConfig {
+	if x != nil {
+		return x.Common
+	}
+	return nil
+}
+
+func (x *DeployWorkloadRequest) GetDagent() *DagentContainerConfig {
+	if x != nil {
+		return x.Dagent
+	}
+	return nil
+}
+
+func (x *DeployWorkloadRequest) GetCrane() *CraneContainerConfig {
+	if x != nil {
+		return x.Crane
+	}
+	return nil
+}
+
+func (x *DeployWorkloadRequest) GetRegistry() string {
+	if x != nil && x.Registry != nil {
+		return *x.Registry
+	}
+	return ""
+}
+
+func (x *DeployWorkloadRequest) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *DeployWorkloadRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeployWorkloadRequest) GetRegistryAuth() *RegistryAuth {
+	if x != nil {
+		return x.RegistryAuth
+	}
+	return nil
+}
+
+func (x *DeployWorkloadRequest) GetBase() *BaseConfigRef {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// A base container config referenced by its ID and hash, the agent keeps the
+// last one of every ID, so the config is only sent if the agent does not have it yet.
+// The agent keeps a limited number of them, if the config is missing, the
+// deployment fails with the BASE_CONFIG_NOT_FOUND code and the request has to
+// be sent again with the config
+type BaseConfigRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	// hex encoded sha256 of the config json
+	Hash string `protobuf:"bytes,101,opt,name=hash,proto3" json:"hash,omitempty"`
+	// json merge patch (RFC 7386) of the environment applied to the base
+	OverlayJson string  `protobuf:"bytes,102,opt,name=overlayJson,proto3" json:"overlayJson,omitempty"`
+	ConfigJson  *string `protobuf:"bytes,103,opt,name=configJson,proto3,oneof" json:"configJson,omitempty"`
+}
+
+func (x *BaseConfigRef) Reset() {
+	*x = BaseConfigRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaseConfigRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseConfigRef) ProtoMessage() {}
+
+func (x *BaseConfigRef) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseConfigRef.ProtoReflect.Descriptor instead.
+func (*BaseConfigRef) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BaseConfigRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BaseConfigRef) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BaseConfigRef) GetOverlayJson() string {
+	if x != nil {
+		return x.OverlayJson
+	}
+	return ""
+}
+
+func (x *BaseConfigRef) GetConfigJson() string {
+	if x != nil && x.ConfigJson != nil {
+		return *x.ConfigJson
+	}
+	return ""
+}
+
+// The filter applies to the updates of the watching streams too, the
+// update of a container which stops matching it is sent once, e.g. its
+// removal, then it is not sent until it matches again. The page only
+// applies to the one shot listings, the updates are sent in batches. An
+// invalid page token fails the request with the INVALID_PAGE_TOKEN code.
+type ContainerStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix  *string             `protobuf:"bytes,1,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	OneShot *bool               `protobuf:"varint,2,opt,name=oneShot,proto3,oneof" json:"oneShot,omitempty"`
+	Filter  *common.ListFilter  `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Page    *common.PageRequest `protobuf:"bytes,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
+}
+
+func (x *ContainerStateRequest) Reset() {
+	*x = ContainerStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateRequest) ProtoMessage() {}
+
+func (x *ContainerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateRequest.ProtoReflect.Descriptor instead.
+func (*ContainerStateRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ContainerStateRequest) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *ContainerStateRequest) GetOneShot() bool {
+	if x != nil && x.OneShot != nil {
+		return *x.OneShot
+	}
+	return false
+}
+
+func (x *ContainerStateRequest) GetFilter() *common.ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ContainerStateRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ContainerDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ContainerDeleteRequest) Reset() {
+	*x = ContainerDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerDeleteRequest) ProtoMessage() {}
+
+func (x *ContainerDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ContainerDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ContainerDeleteRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ContainerDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeployRequestLegacy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"` // for early dogger logging
+	Json      string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *DeployRequestLegacy) Reset() {
+	*x = DeployRequestLegacy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployRequestLegacy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployRequestLegacy) ProtoMessage() {}
+
+func (x *DeployRequestLegacy) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployRequestLegacy.ProtoReflect.Descriptor instead.
+func (*DeployRequestLegacy) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeployRequestLegacy) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DeployRequestLegacy) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+// Dagent update
+type AgentUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag            string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	Token          string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AgentUpdateRequest) Reset() {
+	*x = AgentUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentUpdateRequest) ProtoMessage() {}
+
+func (x *AgentUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AgentUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AgentUpdateRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *AgentUpdateRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *AgentUpdateRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReplaceTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ReplaceTokenRequest) Reset() {
+	*x = ReplaceTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceTokenRequest) ProtoMessage() {}
+
+func (x *ReplaceTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceTokenRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceTokenRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReplaceTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AgentAbortUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AgentAbortUpdate) Reset() {
+	*x = AgentAbortUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentAbortUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentAbortUpdate) ProtoMessage() {}
+
+func (x *AgentAbortUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentAbortUpdate.ProtoReflect.Descriptor instead.
+func (*AgentAbortUpdate) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AgentAbortUpdate) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Container log
+type ContainerLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Streaming bool                        `protobuf:"varint,2,opt,name=streaming,proto3" json:"streaming,omitempty"`
+	Tail      uint32                      `protobuf:"varint,3,opt,name=tail,proto3" json:"tail,omitempty"`
+}
+
+func (x *ContainerLogRequest) Reset() {
+	*x = ContainerLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogRequest) ProtoMessage() {}
+
+func (x *ContainerLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogRequest.ProtoReflect.Descriptor instead.
+func (*ContainerLogRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ContainerLogRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetStreaming() bool {
+	if x != nil {
+		return x.Streaming
+	}
+	return false
+}
+
+func (x *ContainerLogRequest) GetTail() uint32 {
+	if x != nil {
+		return x.Tail
+	}
+	return 0
+}
+
+// Container inspect
+type ContainerInspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *ContainerInspectRequest) Reset() {
+	*x = ContainerInspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInspectRequest) ProtoMessage() {}
+
+func (x *ContainerInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInspectRequest.ProtoReflect.Descriptor instead.
+func (*ContainerInspectRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ContainerInspectRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+// Compares the requests with the snapshots of the running deployment,
+// without deploying anything
+type DeploymentDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix   string                   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Requests []*DeployWorkloadRequest `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *DeploymentDiffRequest) Reset() {
+	*x = DeploymentDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentDiffRequest) ProtoMessage() {}
+
+func (x *DeploymentDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentDiffRequest.ProtoReflect.Descriptor instead.
+func (*DeploymentDiffRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeploymentDiffRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeploymentDiffRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *DeploymentDiffRequest) GetRequests() []*DeployWorkloadRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type DeploymentFieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string  `protobuf:"bytes,100,opt,name=path,proto3" json:"path,omitempty"`
+	Old  *string `protobuf:"bytes,101,opt,name=old,proto3,oneof" json:"old,omitempty"`
+	New  *string `protobuf:"bytes,102,opt,name=new,proto3,oneof" json:"new,omitempty"`
+}
+
+func (x *DeploymentFieldChange) Reset() {
+	*x = DeploymentFieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentFieldChange) ProtoMessage() {}
+
+func (x *DeploymentFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentFieldChange.ProtoReflect.Descriptor instead.
+func (*DeploymentFieldChange) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeploymentFieldChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeploymentFieldChange) GetOld() string {
+	if x != nil && x.Old != nil {
+		return *x.Old
+	}
+	return ""
+}
+
+func (x *DeploymentFieldChange) GetNew() string {
+	if x != nil && x.New != nil {
+		return *x.New
+	}
+	return ""
+}
+
+type WorkloadDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                      `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Container   *common.ContainerIdentifier `protobuf:"bytes,101,opt,name=container,proto3" json:"container,omitempty"`
+	Deployed    bool                        `protobuf:"varint,102,opt,name=deployed,proto3" json:"deployed,omitempty"`
+	CurrentHash *string                     `protobuf:"bytes,103,opt,name=currentHash,proto3,oneof" json:"currentHash,omitempty"`
+	NewHash     string                      `protobuf:"bytes,104,opt,name=newHash,proto3" json:"newHash,omitempty"`
+	Changes     []*DeploymentFieldChange    `protobuf:"bytes,1000,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *WorkloadDiff) Reset() {
+	*x = WorkloadDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadDiff) ProtoMessage() {}
+
+func (x *WorkloadDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadDiff.ProtoReflect.Descriptor instead.
+func (*WorkloadDiff) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WorkloadDiff) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkloadDiff) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *WorkloadDiff) GetDeployed() bool {
+	if x != nil {
+		return x.Deployed
+	}
+	return false
+}
+
+func (x *WorkloadDiff) GetCurrentHash() string {
+	if x != nil && x.CurrentHash != nil {
+		return *x.CurrentHash
+	}
+	return ""
+}
+
+func (x *WorkloadDiff) GetNewHash() string {
+	if x != nil {
+		return x.NewHash
+	}
+	return ""
+}
+
+func (x *WorkloadDiff) GetChanges() []*DeploymentFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type DeploymentDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string          `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Workloads []*WorkloadDiff `protobuf:"bytes,1000,rep,name=workloads,proto3" json:"workloads,omitempty"`
+}
+
+func (x *DeploymentDiffResponse) Reset() {
+	*x = DeploymentDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentDiffResponse) ProtoMessage() {}
+
+func (x *DeploymentDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentDiffResponse.ProtoReflect.Descriptor instead.
+func (*DeploymentDiffResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeploymentDiffResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeploymentDiffResponse) GetWorkloads() []*WorkloadDiff {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+// Disk usage of the volumes attached to the managed containers,
+// limited to the containers of the prefix if it is set
+type VolumeUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix *string `protobuf:"bytes,1,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+}
+
+func (x *VolumeUsageRequest) Reset() {
+	*x = VolumeUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeUsageRequest) ProtoMessage() {}
+
+func (x *VolumeUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeUsageRequest.ProtoReflect.Descriptor instead.
+func (*VolumeUsageRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VolumeUsageRequest) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+type VolumeUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Driver string `protobuf:"bytes,101,opt,name=driver,proto3" json:"driver,omitempty"`
+	// Size in bytes, -1 if the driver can not tell it
+	Size       int64                         `protobuf:"varint,102,opt,name=size,proto3" json:"size,omitempty"`
+	Containers []*common.ContainerIdentifier `protobuf:"bytes,1000,rep,name=containers,proto3" json:"containers,omitempty"`
+}
+
+func (x *VolumeUsage) Reset() {
+	*x = VolumeUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeUsage) ProtoMessage() {}
+
+func (x *VolumeUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeUsage.ProtoReflect.Descriptor instead.
+func (*VolumeUsage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VolumeUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeUsage) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *VolumeUsage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *VolumeUsage) GetContainers() []*common.ContainerIdentifier {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type VolumeUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix  *string        `protobuf:"bytes,100,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	Volumes []*VolumeUsage `protobuf:"bytes,1000,rep,name=volumes,proto3" json:"volumes,omitempty"`
+}
+
+func (x *VolumeUsageResponse) Reset() {
+	*x = VolumeUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeUsageResponse) ProtoMessage() {}
+
+func (x *VolumeUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeUsageResponse.ProtoReflect.Descriptor instead.
+func (*VolumeUsageResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VolumeUsageResponse) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *VolumeUsageResponse) GetVolumes() []*VolumeUsage {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+// Dry run of the prunes, lists what each of them would remove with the space
+// it frees up, nothing is removed, every target is reported if none is set
+type PruneReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Targets []PruneTarget `protobuf:"varint,1000,rep,packed,name=targets,proto3,enum=agent.PruneTarget" json:"targets,omitempty"`
+}
+
+func (x *PruneReportRequest) Reset() {
+	*x = PruneReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneReportRequest) ProtoMessage() {}
+
+func (x *PruneReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneReportRequest.ProtoReflect.Descriptor instead.
+func (*PruneReportRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PruneReportRequest) GetTargets() []PruneTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type PruneCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target PruneTarget `protobuf:"varint,100,opt,name=target,proto3,enum=agent.PruneTarget" json:"target,omitempty"`
+	Id     string      `protobuf:"bytes,101,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string      `protobuf:"bytes,102,opt,name=name,proto3" json:"name,omitempty"`
+	// Reclaimed bytes, -1 if the daemon can not tell it
+	Size      int64                       `protobuf:"varint,103,opt,name=size,proto3" json:"size,omitempty"`
+	Reason    string                      `protobuf:"bytes,104,opt,name=reason,proto3" json:"reason,omitempty"`
+	Container *common.ContainerIdentifier `protobuf:"bytes,105,opt,name=container,proto3,oneof" json:"container,omitempty"`
+}
+
+func (x *PruneCandidate) Reset() {
+	*x = PruneCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneCandidate) ProtoMessage() {}
+
+func (x *PruneCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneCandidate.ProtoReflect.Descriptor instead.
+func (*PruneCandidate) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PruneCandidate) GetTarget() PruneTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PruneTarget_PRUNE_TARGET_UNSPECIFIED
+}
+
+func (x *PruneCandidate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PruneCandidate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PruneCandidate) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PruneCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PruneCandidate) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type PruneReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sum of the known sizes of the candidates
+	Reclaimable int64             `protobuf:"varint,100,opt,name=reclaimable,proto3" json:"reclaimable,omitempty"`
+	Candidates  []*PruneCandidate `protobuf:"bytes,1000,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *PruneReportResponse) Reset() {
+	*x = PruneReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneReportResponse) ProtoMessage() {}
+
+func (x *PruneReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneReportResponse.ProtoReflect.Descriptor instead.
+func (*PruneReportResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PruneReportResponse) GetReclaimable() int64 {
+	if x != nil {
+		return x.Reclaimable
+	}
+	return 0
+}
+
+func (x *PruneReportResponse) GetCandidates() []*PruneCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Network traffic of the managed containers summed up by their prefix, the
+// agent samples the counters of the containers, its totals start over when
+// it restarts, limited to the prefix if it is set
+type TrafficUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix *string `protobuf:"bytes,1,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+}
+
+func (x *TrafficUsageRequest) Reset() {
+	*x = TrafficUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficUsageRequest) ProtoMessage() {}
+
+func (x *TrafficUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficUsageRequest.ProtoReflect.Descriptor instead.
+func (*TrafficUsageRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TrafficUsageRequest) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+type TrafficTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RxBytes uint64 `protobuf:"varint,100,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	TxBytes uint64 `protobuf:"varint,101,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+}
+
+func (x *TrafficTotal) Reset() {
+	*x = TrafficTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTotal) ProtoMessage() {}
+
+func (x *TrafficTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTotal.ProtoReflect.Descriptor instead.
+func (*TrafficTotal) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TrafficTotal) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *TrafficTotal) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+type DailyTraffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The UTC day as YYYY-MM-DD
+	Day   string        `protobuf:"bytes,100,opt,name=day,proto3" json:"day,omitempty"`
+	Total *TrafficTotal `protobuf:"bytes,101,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *DailyTraffic) Reset() {
+	*x = DailyTraffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyTraffic) ProtoMessage() {}
+
+func (x *DailyTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyTraffic.ProtoReflect.Descriptor instead.
+func (*DailyTraffic) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DailyTraffic) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *DailyTraffic) GetTotal() *TrafficTotal {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type PrefixTraffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,100,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Traffic of the last 24 hours, by the hour
+	Rolling *TrafficTotal `protobuf:"bytes,101,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	// The oldest day comes first
+	Daily []*DailyTraffic `protobuf:"bytes,1000,rep,name=daily,proto3" json:"daily,omitempty"`
+}
+
+func (x *PrefixTraffic) Reset() {
+	*x = PrefixTraffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefixTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixTraffic) ProtoMessage() {}
+
+func (x *PrefixTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixTraffic.ProtoReflect.Descriptor instead.
+func (*PrefixTraffic) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PrefixTraffic) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PrefixTraffic) GetRolling() *TrafficTotal {
+	if x != nil {
+		return x.Rolling
+	}
+	return nil
+}
+
+func (x *PrefixTraffic) GetDaily() []*DailyTraffic {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+type TrafficUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix *string `protobuf:"bytes,100,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	// The start of the sampling
+	Since    *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=since,proto3" json:"since,omitempty"`
+	Prefixes []*PrefixTraffic       `protobuf:"bytes,1000,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *TrafficUsageResponse) Reset() {
+	*x = TrafficUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficUsageResponse) ProtoMessage() {}
+
+func (x *TrafficUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficUsageResponse.ProtoReflect.Descriptor instead.
+func (*TrafficUsageResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TrafficUsageResponse) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *TrafficUsageResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *TrafficUsageResponse) GetPrefixes() []*PrefixTraffic {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+// The Traefik instance of the node, only sent to the agents with the
+// managed ingress capability. The agent creates it, recreates it when the
+// configuration or the version changes, and removes it if it is disabled.
+type IngressConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The image tag of Traefik, eg. v2.11
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	LogLevel string `protobuf:"bytes,3,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+	Port     uint32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	// The TLS entrypoint with the ACME certificates, HTTP only if it is not set
+	TlsPort   *uint32 `protobuf:"varint,5,opt,name=tlsPort,proto3,oneof" json:"tlsPort,omitempty"`
+	AcmeEmail *string `protobuf:"bytes,6,opt,name=acmeEmail,proto3,oneof" json:"acmeEmail,omitempty"`
+	// The directory of the ACME certificates on the node, relative to the data path of the agent
+	AcmeStorage *string `protobuf:"bytes,7,opt,name=acmeStorage,proto3,oneof" json:"acmeStorage,omitempty"`
+	// The dashboard is served on this port if it is set
+	DashboardPort *uint32 `protobuf:"varint,8,opt,name=dashboardPort,proto3,oneof" json:"dashboardPort,omitempty"`
+	// Additional entrypoints besides web and websecure
+	Entrypoints []*IngressEntrypoint `protobuf:"bytes,1000,rep,name=entrypoints,proto3" json:"entrypoints,omitempty"`
+}
+
+func (x *IngressConfigRequest) Reset() {
+	*x = IngressConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngressConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressConfigRequest) ProtoMessage() {}
+
+func (x *IngressConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressConfigRequest.ProtoReflect.Descriptor instead.
+func (*IngressConfigRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *IngressConfigRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *IngressConfigRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *IngressConfigRequest) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *IngressConfigRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *IngressConfigRequest) GetTlsPort() uint32 {
+	if x != nil && x.TlsPort != nil {
+		return *x.TlsPort
+	}
+	return 0
+}
+
+func (x *IngressConfigRequest) GetAcmeEmail() string {
+	if x != nil && x.AcmeEmail != nil {
+		return *x.AcmeEmail
+	}
+	return ""
+}
+
+func (x *IngressConfigRequest) GetAcmeStorage() string {
+	if x != nil && x.AcmeStorage != nil {
+		return *x.AcmeStorage
+	}
+	return ""
+}
+
+func (x *IngressConfigRequest) GetDashboardPort() uint32 {
+	if x != nil && x.DashboardPort != nil {
+		return *x.DashboardPort
+	}
+	return 0
+}
+
+func (x *IngressConfigRequest) GetEntrypoints() []*IngressEntrypoint {
+	if x != nil {
+		return x.Entrypoints
+	}
+	return nil
+}
+
+type IngressEntrypoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Port uint32 `protobuf:"varint,101,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *IngressEntrypoint) Reset() {
+	*x = IngressEntrypoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngressEntrypoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressEntrypoint) ProtoMessage() {}
+
+func (x *IngressEntrypoint) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressEntrypoint.ProtoReflect.Descriptor instead.
+func (*IngressEntrypoint) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *IngressEntrypoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngressEntrypoint) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type IngressConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool   `protobuf:"varint,100,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Version string `protobuf:"bytes,101,opt,name=version,proto3" json:"version,omitempty"`
+	// The container was recreated, or removed, for the configuration
+	Changed     bool    `protobuf:"varint,102,opt,name=changed,proto3" json:"changed,omitempty"`
+	ContainerId *string `protobuf:"bytes,103,opt,name=containerId,proto3,oneof" json:"containerId,omitempty"`
+}
+
+func (x *IngressConfigResponse) Reset() {
+	*x = IngressConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngressConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressConfigResponse) ProtoMessage() {}
+
+func (x *IngressConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressConfigResponse.ProtoReflect.Descriptor instead.
+func (*IngressConfigResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *IngressConfigResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *IngressConfigResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *IngressConfigResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *IngressConfigResponse) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+// Rolls the container back to an earlier revision of its deployment,
+// the previous one if the revision is not set
+type RollbackToRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Revision  *int64                      `protobuf:"varint,2,opt,name=revision,proto3,oneof" json:"revision,omitempty"`
+	Actor     *string                     `protobuf:"bytes,3,opt,name=actor,proto3,oneof" json:"actor,omitempty"`
+}
+
+func (x *RollbackToRevisionRequest) Reset() {
+	*x = RollbackToRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackToRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackToRevisionRequest) ProtoMessage() {}
+
+func (x *RollbackToRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackToRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackToRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RollbackToRevisionRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *RollbackToRevisionRequest) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+func (x *RollbackToRevisionRequest) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+type DeploymentRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int64                  `protobuf:"varint,100,opt,name=revision,proto3" json:"revision,omitempty"`
+	ConfigHash string                 `protobuf:"bytes,101,opt,name=configHash,proto3" json:"configHash,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Actor      *string                `protobuf:"bytes,103,opt,name=actor,proto3,oneof" json:"actor,omitempty"`
+	// The revision the rollback restored, if this revision is a rollback
+	RollbackOf *int64 `protobuf:"varint,104,opt,name=rollbackOf,proto3,oneof" json:"rollbackOf,omitempty"`
+	// Image references and the digests of the images by container name
+	Images  map[string]string `protobuf:"bytes,1000,rep,name=images,proto3" json:"images,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Digests map[string]string `protobuf:"bytes,1001,rep,name=digests,proto3" json:"digests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DeploymentRevision) Reset() {
+	*x = DeploymentRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentRevision) ProtoMessage() {}
+
+func (x *DeploymentRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentRevision.ProtoReflect.Descriptor instead.
+func (*DeploymentRevision) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeploymentRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DeploymentRevision) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+func (x *DeploymentRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeploymentRevision) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *DeploymentRevision) GetRollbackOf() int64 {
+	if x != nil && x.RollbackOf != nil {
+		return *x.RollbackOf
+	}
+	return 0
+}
+
+func (x *DeploymentRevision) GetImages() map[string]string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *DeploymentRevision) GetDigests() map[string]string {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+type RollbackToRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *common.ContainerIdentifier `protobuf:"bytes,100,opt,name=container,proto3" json:"container,omitempty"`
+	Revision  *DeploymentRevision         `protobuf:"bytes,101,opt,name=revision,proto3" json:"revision,omitempty"`
+	History   []*DeploymentRevision       `protobuf:"bytes,1000,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *RollbackToRevisionResponse) Reset() {
+	*x = RollbackToRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackToRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackToRevisionResponse) ProtoMessage() {}
+
+func (x *RollbackToRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackToRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RollbackToRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RollbackToRevisionResponse) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *RollbackToRevisionResponse) GetRevision() *DeploymentRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *RollbackToRevisionResponse) GetHistory() []*DeploymentRevision {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Resource requests recommended from the 95th percentile of the sampled usage
+// of the managed containers, limited to the prefix if it is set
+type ResourceRecommendationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix *string `protobuf:"bytes,1,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+}
+
+func (x *ResourceRecommendationRequest) Reset() {
+	*x = ResourceRecommendationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceRecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecommendationRequest) ProtoMessage() {}
+
+func (x *ResourceRecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecommendationRequest.ProtoReflect.Descriptor instead.
+func (*ResourceRecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ResourceRecommendationRequest) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+type ResourceRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *common.ContainerIdentifier `protobuf:"bytes,100,opt,name=container,proto3" json:"container,omitempty"`
+	// The current requests of the container
+	Requests    *common.Resource `protobuf:"bytes,101,opt,name=requests,proto3,oneof" json:"requests,omitempty"`
+	Recommended *common.Resource `protobuf:"bytes,102,opt,name=recommended,proto3" json:"recommended,omitempty"`
+	Samples     int32            `protobuf:"varint,103,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *ResourceRecommendation) Reset() {
+	*x = ResourceRecommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecommendation) ProtoMessage() {}
+
+func (x *ResourceRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecommendation.ProtoReflect.Descriptor instead.
+func (*ResourceRecommendation) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ResourceRecommendation) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *ResourceRecommendation) GetRequests() *common.Resource {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ResourceRecommendation) GetRecommended() *common.Resource {
+	if x != nil {
+		return x.Recommended
+	}
+	return nil
+}
+
+func (x *ResourceRecommendation) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type ResourceRecommendationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix          *string                   `protobuf:"bytes,100,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	Recommendations []*ResourceRecommendation `protobuf:"bytes,1000,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *ResourceRecommendationResponse) Reset() {
+	*x = ResourceRecommendationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceRecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecommendationResponse) ProtoMessage() {}
+
+func (x *ResourceRecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecommendationResponse.ProtoReflect.Descriptor instead.
+func (*ResourceRecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ResourceRecommendationResponse) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *ResourceRecommendationResponse) GetRecommendations() []*ResourceRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type WorkloadOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Container *common.ContainerIdentifier `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	Operation WorkloadOperation           `protobuf:"varint,3,opt,name=operation,proto3,enum=agent.WorkloadOperation" json:"operation,omitempty"`
+	// The pod to delete
+	Pod *string `protobuf:"bytes,4,opt,name=pod,proto3,oneof" json:"pod,omitempty"`
+	// Deletes the pod without a grace period
+	Force *bool `protobuf:"varint,5,opt,name=force,proto3,oneof" json:"force,omitempty"`
+	// The replica count to scale to
+	Replicas *int32 `protobuf:"varint,6,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+}
+
+func (x *WorkloadOperationRequest) Reset() {
+	*x = WorkloadOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadOperationRequest) ProtoMessage() {}
+
+func (x *WorkloadOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadOperationRequest.ProtoReflect.Descriptor instead.
+func (*WorkloadOperationRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *WorkloadOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkloadOperationRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *WorkloadOperationRequest) GetOperation() WorkloadOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return WorkloadOperation_WORKLOAD_OPERATION_UNSPECIFIED
+}
+
+func (x *WorkloadOperationRequest) GetPod() string {
+	if x != nil && x.Pod != nil {
+		return *x.Pod
+	}
+	return ""
+}
+
+func (x *WorkloadOperationRequest) GetForce() bool {
+	if x != nil && x.Force != nil {
+		return *x.Force
+	}
+	return false
+}
+
+func (x *WorkloadOperationRequest) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+type WorkloadOperationMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status          common.DeploymentStatus `protobuf:"varint,100,opt,name=status,proto3,enum=common.DeploymentStatus" json:"status,omitempty"`
+	Message         string                  `protobuf:"bytes,101,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,102,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Replicas        *int32                  `protobuf:"varint,103,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	UpdatedReplicas *int32                  `protobuf:"varint,104,opt,name=updatedReplicas,proto3,oneof" json:"updatedReplicas,omitempty"`
+	ReadyReplicas   *int32                  `protobuf:"varint,105,opt,name=readyReplicas,proto3,oneof" json:"readyReplicas,omitempty"`
+}
+
+func (x *WorkloadOperationMessage) Reset() {
+	*x = WorkloadOperationMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadOperationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadOperationMessage) ProtoMessage() {}
+
+func (x *WorkloadOperationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadOperationMessage.ProtoReflect.Descriptor instead.
+func (*WorkloadOperationMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *WorkloadOperationMessage) GetStatus() common.DeploymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return common.DeploymentStatus(0)
+}
+
+func (x *WorkloadOperationMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkloadOperationMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WorkloadOperationMessage) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *WorkloadOperationMessage) GetUpdatedReplicas() int32 {
+	if x != nil && x.UpdatedReplicas != nil {
+		return *x.UpdatedReplicas
+	}
+	return 0
+}
+
+func (x *WorkloadOperationMessage) GetReadyReplicas() int32 {
+	if x != nil && x.ReadyReplicas != nil {
+		return *x.ReadyReplicas
+	}
+	return 0
+}
+
+// Attaches an ephemeral debug container to a pod of the workload of the
+// container, like kubectl debug, its terminal is streamed on a DebugSession
+// channel opened with the id, so images without a shell can be inspected
+type DebugContainerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Container *common.ContainerIdentifier `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	Image     string                      `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// The pod to attach to, a running pod of the workload if it is not set
+	Pod *string `protobuf:"bytes,4,opt,name=pod,proto3,oneof" json:"pod,omitempty"`
+	// Replaces the entrypoint of the image
+	Command []string `protobuf:"bytes,5,rep,name=command,proto3" json:"command,omitempty"`
+	Tty     bool     `protobuf:"varint,6,opt,name=tty,proto3" json:"tty,omitempty"`
+}
+
+func (x *DebugContainerRequest) Reset() {
+	*x = DebugContainerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugContainerRequest) ProtoMessage() {}
+
+func (x *DebugContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugContainerRequest.ProtoReflect.Descriptor instead.
+func (*DebugContainerRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DebugContainerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DebugContainerRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *DebugContainerRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *DebugContainerRequest) GetPod() string {
+	if x != nil && x.Pod != nil {
+		return *x.Pod
+	}
+	return ""
+}
+
+func (x *DebugContainerRequest) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *DebugContainerRequest) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+type TerminalSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  uint32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TerminalSize) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type DebugSessionStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pod       string `protobuf:"bytes,100,opt,name=pod,proto3" json:"pod,omitempty"`
+	Container string `protobuf:"bytes,101,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *DebugSessionStarted) Reset() {
+	*x = DebugSessionStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugSessionStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugSessionStarted) ProtoMessage() {}
+
+func (x *DebugSessionStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugSessionStarted.ProtoReflect.Descriptor instead.
+func (*DebugSessionStarted) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DebugSessionStarted) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *DebugSessionStarted) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type DebugSessionEnded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *string `protobuf:"bytes,100,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *DebugSessionEnded) Reset() {
+	*x = DebugSessionEnded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugSessionEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugSessionEnded) ProtoMessage() {}
+
+func (x *DebugSessionEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugSessionEnded.ProtoReflect.Descriptor instead.
+func (*DebugSessionEnded) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DebugSessionEnded) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type DebugSessionInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Input:
+	//	*DebugSessionInput_Stdin
+	//	*DebugSessionInput_Resize
+	//	*DebugSessionInput_CloseStdin
+	Input isDebugSessionInput_Input `protobuf_oneof:"input"`
+}
+
+func (x *DebugSessionInput) Reset() {
+	*x = DebugSessionInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugSessionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugSessionInput) ProtoMessage() {}
+
+func (x *DebugSessionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugSessionInput.ProtoReflect.Descriptor instead.
+func (*DebugSessionInput) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{74}
+}
+
+func (m *DebugSessionInput) GetInput() isDebugSessionInput_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (x *DebugSessionInput) GetStdin() []byte {
+	if x, ok := x.GetInput().(*DebugSessionInput_Stdin); ok {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *DebugSessionInput) GetResize() *TerminalSize {
+	if x, ok := x.GetInput().(*DebugSessionInput_Resize); ok {
+		return x.Resize
+	}
+	return nil
+}
+
+func (x *DebugSessionInput) GetCloseStdin() *common.Empty {
+	if x, ok := x.GetInput().(*DebugSessionInput_CloseStdin); ok {
+		return x.CloseStdin
+	}
+	return nil
+}
+
+type isDebugSessionInput_Input interface {
+	isDebugSessionInput_Input()
+}
+
+type DebugSessionInput_Stdin struct {
+	Stdin []byte `protobuf:"bytes,1,opt,name=stdin,proto3,oneof"`
+}
+
+type DebugSessionInput_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,2,opt,name=resize,proto3,oneof"`
+}
+
+type DebugSessionInput_CloseStdin struct {
+	// Closes the stdin of the session
+	CloseStdin *common.Empty `protobuf:"bytes,3,opt,name=closeStdin,proto3,oneof"`
+}
+
+func (*DebugSessionInput_Stdin) isDebugSessionInput_Input() {}
+
+func (*DebugSessionInput_Resize) isDebugSessionInput_Input() {}
+
+func (*DebugSessionInput_CloseStdin) isDebugSessionInput_Input() {}
+
+// The first message tells the debug container, the last one ends the session
+type DebugSessionOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Output:
+	//	*DebugSessionOutput_Started
+	//	*DebugSessionOutput_Stdout
+	//	*DebugSessionOutput_Stderr
+	//	*DebugSessionOutput_Ended
+	Output isDebugSessionOutput_Output `protobuf_oneof:"output"`
+}
+
+func (x *DebugSessionOutput) Reset() {
+	*x = DebugSessionOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugSessionOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugSessionOutput) ProtoMessage() {}
+
+func (x *DebugSessionOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugSessionOutput.ProtoReflect.Descriptor instead.
+func (*DebugSessionOutput) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{75}
+}
+
+func (m *DebugSessionOutput) GetOutput() isDebugSessionOutput_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (x *DebugSessionOutput) GetStarted() *DebugSessionStarted {
+	if x, ok := x.GetOutput().(*DebugSessionOutput_Started); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *DebugSessionOutput) GetStdout() []byte {
+	if x, ok := x.GetOutput().(*DebugSessionOutput_Stdout); ok {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *DebugSessionOutput) GetStderr() []byte {
+	if x, ok := x.GetOutput().(*DebugSessionOutput_Stderr); ok {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *DebugSessionOutput) GetEnded() *DebugSessionEnded {
+	if x, ok := x.GetOutput().(*DebugSessionOutput_Ended); ok {
+		return x.Ended
+	}
+	return nil
+}
+
+type isDebugSessionOutput_Output interface {
+	isDebugSessionOutput_Output()
+}
+
+type DebugSessionOutput_Started struct {
+	Started *DebugSessionStarted `protobuf:"bytes,1,opt,name=started,proto3,oneof"`
+}
+
+type DebugSessionOutput_Stdout struct {
+	Stdout []byte `protobuf:"bytes,2,opt,name=stdout,proto3,oneof"`
+}
+
+type DebugSessionOutput_Stderr struct {
+	Stderr []byte `protobuf:"bytes,3,opt,name=stderr,proto3,oneof"`
+}
+
+type DebugSessionOutput_Ended struct {
+	Ended *DebugSessionEnded `protobuf:"bytes,4,opt,name=ended,proto3,oneof"`
+}
+
+func (*DebugSessionOutput_Started) isDebugSessionOutput_Output() {}
+
+func (*DebugSessionOutput_Stdout) isDebugSessionOutput_Output() {}
+
+func (*DebugSessionOutput_Stderr) isDebugSessionOutput_Output() {}
+
+func (*DebugSessionOutput_Ended) isDebugSessionOutput_Output() {}
+
+type CloseConnectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason CloseReason `protobuf:"varint,1,opt,name=reason,proto3,enum=agent.CloseReason" json:"reason,omitempty"`
+}
+
+func (x *CloseConnectionRequest) Reset() {
+	*x = CloseConnectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseConnectionRequest) ProtoMessage() {}
+
+func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseConnectionRequest.ProtoReflect.Descriptor instead.
+func (*CloseConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CloseConnectionRequest) GetReason() CloseReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CloseReason_CLOSE_REASON_UNSPECIFIED
+}
+
+// Pushed when the configuration is rotated on the server, the agent drops
+// the cached entries, so they are sent again with the next deploy request
+// referencing them. The unspecified kind invalidates every
+// cache, the entries of the kind are all invalidated if the key is not set.
+// There is no response, the agent only logs the result.
+type CacheInvalidationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind CacheKind `protobuf:"varint,1,opt,name=kind,proto3,enum=agent.CacheKind" json:"kind,omitempty"`
+	Key  *string   `protobuf:"bytes,2,opt,name=key,proto3,oneof" json:"key,omitempty"`
+}
+
+func (x *CacheInvalidationRequest) Reset() {
+	*x = CacheInvalidationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheInvalidationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheInvalidationRequest) ProtoMessage() {}
+
+func (x *CacheInvalidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheInvalidationRequest.ProtoReflect.Descriptor instead.
+func (*CacheInvalidationRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CacheInvalidationRequest) GetKind() CacheKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CacheKind_CACHE_KIND_UNSPECIFIED
+}
+
+func (x *CacheInvalidationRequest) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+// Experimental, the state of a running container is checkpointed with CRIU,
+// then the container is stopped unless leaveRunning is set.
+// The id is generated by the agent if it is empty.
+type ContainerCheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container    *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	CheckpointId *string                     `protobuf:"bytes,2,opt,name=checkpointId,proto3,oneof" json:"checkpointId,omitempty"`
+	LeaveRunning bool                        `protobuf:"varint,3,opt,name=leaveRunning,proto3" json:"leaveRunning,omitempty"`
+}
+
+func (x *ContainerCheckpointRequest) Reset() {
+	*x = ContainerCheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerCheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerCheckpointRequest) ProtoMessage() {}
+
+func (x *ContainerCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*ContainerCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ContainerCheckpointRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *ContainerCheckpointRequest) GetCheckpointId() string {
+	if x != nil && x.CheckpointId != nil {
+		return *x.CheckpointId
+	}
+	return ""
+}
+
+func (x *ContainerCheckpointRequest) GetLeaveRunning() bool {
+	if x != nil {
+		return x.LeaveRunning
+	}
+	return false
+}
+
+type ContainerCheckpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container    *common.ContainerIdentifier `protobuf:"bytes,100,opt,name=container,proto3" json:"container,omitempty"`
+	CheckpointId string                      `protobuf:"bytes,101,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	// The directory of the checkpoint on the node, empty if it is kept by the container runtime
+	CheckpointDir *string `protobuf:"bytes,102,opt,name=checkpointDir,proto3,oneof" json:"checkpointDir,omitempty"`
+	// The checkpoints of the container, the new one included
+	Checkpoints []string `protobuf:"bytes,1000,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (x *ContainerCheckpointResponse) Reset() {
+	*x = ContainerCheckpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerCheckpointResponse) ProtoMessage() {}
+
+func (x *ContainerCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*ContainerCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ContainerCheckpointResponse) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *ContainerCheckpointResponse) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *ContainerCheckpointResponse) GetCheckpointDir() string {
+	if x != nil && x.CheckpointDir != nil {
+		return *x.CheckpointDir
+	}
+	return ""
+}
+
+func (x *ContainerCheckpointResponse) GetCheckpoints() []string {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+// Experimental, a stopped container is started from a checkpoint. After the
+// migration of its volumes, the container has to be deployed on the new node
+// without starting it, and the checkpoint copied to checkpointDir.
+type ContainerRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container     *common.ContainerIdentifier `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	CheckpointId  string                      `protobuf:"bytes,2,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	CheckpointDir *string                     `protobuf:"bytes,3,opt,name=checkpointDir,proto3,oneof" json:"checkpointDir,omitempty"`
+}
+
+func (x *ContainerRestoreRequest) Reset() {
+	*x = ContainerRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerRestoreRequest) ProtoMessage() {}
+
+func (x *ContainerRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerRestoreRequest.ProtoReflect.Descriptor instead.
+func (*ContainerRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ContainerRestoreRequest) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *ContainerRestoreRequest) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *ContainerRestoreRequest) GetCheckpointDir() string {
+	if x != nil && x.CheckpointDir != nil {
+		return *x.CheckpointDir
+	}
+	return ""
+}
+
+type ContainerRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container    *common.ContainerIdentifier `protobuf:"bytes,100,opt,name=container,proto3" json:"container,omitempty"`
+	CheckpointId string                      `protobuf:"bytes,101,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+}
+
+func (x *ContainerRestoreResponse) Reset() {
+	*x = ContainerRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerRestoreResponse) ProtoMessage() {}
+
+func (x *ContainerRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerRestoreResponse.ProtoReflect.Descriptor instead.
+func (*ContainerRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ContainerRestoreResponse) GetContainer() *common.ContainerIdentifier {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *ContainerRestoreResponse) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+var File_protobuf_proto_agent_proto protoreflect.FileDescriptor
+
+var file_protobuf_proto_agent_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x13,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x78, 0x70,
+	0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x02,
+	0x0a, 0x1a, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0xea, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52,
+	0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0xeb, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x84, 0x01, 0x0a,
+	0x08, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x14, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x68, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x69, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x53, 0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x66,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x22, 0xd1, 0x03,
+	0x0a, 0x12, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x61, 0x64, 0x31, 0x18, 0x67, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x31,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x35, 0x18, 0x68, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x6c, 0x6f, 0x61, 0x64, 0x35, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35,
+	0x18, 0x69, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35, 0x12, 0x2a,
+	0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0xc5, 0x0d, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x4d, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x40, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x3d, 0x0a, 0x0b, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a,
+	0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a,
+	0x11, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55,
+	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0d,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x4b, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x0a, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb3, 0x09, 0x0a, 0x11, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a,
+	0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x37,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x3f, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x35, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x12,
+	0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x12, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41,
+	0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x11,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x45, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2b, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x3d,
+	0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x3b,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x22, 0xcf, 0x03, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12,
+	0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x88, 0x01, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0x7e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x64, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x50, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1f,
+	0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0x2f, 0x0a, 0x09,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6e, 0x0a,
+	0x10, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x2c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12,
+	0x2c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0xad, 0x01,
+	0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x68, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x34, 0x0a,
+	0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0xe4, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x2d, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2c,
+	0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0xea, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xeb, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xcf, 0x01, 0x0a, 0x0f, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x10,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x01, 0x0a,
+	0x09, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xed, 0x02, 0x0a,
+	0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35,
+	0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x07,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x96, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x2b, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x67, 0x69, 0x76, 0x65, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x69, 0x76, 0x65, 0x55, 0x70, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0c, 0x67, 0x69, 0x76, 0x65, 0x55, 0x70, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67, 0x69, 0x76, 0x65, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xbe, 0x03, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x66,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x07, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xb3, 0x09, 0x0a, 0x15, 0x44, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x09,
+	0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48,
+	0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x02, 0x52,
+	0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x03, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x09, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x05, 0x52, 0x09, 0x69, 0x73,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x07, 0x70,
+	0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x70, 0x63,
+	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x07, 0x69, 0x70,
+	0x63, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52,
+	0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
+	0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x0a, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0b, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x3d,
+	0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x1b, 0x0a,
+	0x08, 0x6c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0xeb, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xec, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x41, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
+	0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x69, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x70, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x66,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xdf, 0x01, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4b,
+	0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe8, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x31, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x11,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xe5, 0x08, 0x0a, 0x14, 0x43,
+	0x72, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48, 0x00, 0x52, 0x12, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x11, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88,
+	0x01, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0f, 0x75, 0x73, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x69,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x48, 0x05, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x72, 0x48, 0x06, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x6b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x48, 0x07, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x6c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x10, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48, 0x09,
+	0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x64, 0x0a, 0x12,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x72, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0xeb, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0xf2, 0x07, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f,
+	0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x02, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a,
+	0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x03,
+	0x52, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x6a, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x54, 0x54, 0x59, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x03, 0x54, 0x54,
+	0x59, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06,
+	0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0xe8,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0xea,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0xeb, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x13, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0xec, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x50, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xed,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0xee, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0xef, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x54,
+	0x54, 0x59, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xda, 0x03, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06,
+	0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x06, 0x64, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x05, 0x63, 0x72, 0x61, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x72, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x05, 0x63, 0x72, 0x61, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x48, 0x04, 0x52, 0x0c, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2d,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x66, 0x48, 0x05, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x72, 0x61, 0x6e, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x79, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e,
+	0x22, 0xdd, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x68,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x6f, 0x6e, 0x65, 0x53,
+	0x68, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x02, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x03, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x22, 0x44, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22,
+	0x64, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2b, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x28, 0x0a, 0x10, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a,
+	0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x69,
+	0x6c, 0x22, 0x54, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x79, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x69, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x15, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03,
+	0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6f, 0x6c, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6e, 0x65, 0x77, 0x22, 0xff, 0x01,
+	0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x77, 0x48, 0x61, 0x73, 0x68, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x77, 0x48, 0x61, 0x73, 0x68, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x5c, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x3c, 0x0a,
+	0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x8b, 0x01, 0x0a, 0x0b,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x66, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x6c, 0x0a, 0x13, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x43, 0x0a, 0x12, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xda, 0x01, 0x0a,
+	0x0e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x68, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x13, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x13, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x42, 0x0a, 0x0c, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a,
+	0x0c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x61, 0x79, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12,
+	0x29, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x0d, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0xe8, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x22,
+	0xa3, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x87, 0x03, 0x0a, 0x14, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x6c, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x61, 0x63, 0x6d, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x61, 0x63, 0x6d,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x64,
+	0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x6c, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x63, 0x6d, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x61, 0x63, 0x6d, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x22,
+	0x3b, 0x0a, 0x11, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x9c, 0x01, 0x0a,
+	0x15, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x19,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xdd, 0x03, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x18, 0x68, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4f,
+	0x66, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0xe8,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x22, 0xc4, 0x01, 0x0a, 0x1a, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x47,
+	0x0a, 0x1d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xe1, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x67, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x1e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe8,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x22, 0x8f, 0x02, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x15, 0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x03, 0x70, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x6f, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x68, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0d,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x15,
+	0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x70,
+	0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x74,
+	0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x6f, 0x64, 0x22, 0x3c, 0x0a, 0x0c, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x45, 0x0a, 0x13, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x38,
+	0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74,
+	0x64, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22,
+	0xbc, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x44,
+	0x0a, 0x16, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x18, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xdc, 0x01,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0d,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x44, 0x69, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x22, 0xb5, 0x01, 0x0a,
+	0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x44, 0x69, 0x72, 0x22, 0x79, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x2a,
+	0x97, 0x03, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10,
+	0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x41,
+	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1b, 0x0a,
+	0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49,
+	0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x0b, 0x2a, 0x82, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x69, 0x76, 0x65, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x47, 0x49, 0x56,
+	0x45, 0x5f, 0x55, 0x50, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x5f, 0x47, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x50, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x47, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x50,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x2a, 0x6a,
+	0x0a, 0x09, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x49,
+	0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45,
+	0x53, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x48, 0x59, 0x50, 0x45, 0x52, 0x56, 0x10, 0x03, 0x2a, 0x9f, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45,
+	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x49, 0x50, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41,
+	0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x82, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x52, 0x55, 0x4e, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52,
+	0x55, 0x4e, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45,
+	0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x5f, 0x54, 0x41, 0x52,
+	0x47, 0x45, 0x54, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x53, 0x10, 0x02, 0x12, 0x22, 0x0a,
+	0x1e, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x5a, 0x4f,
+	0x4d, 0x42, 0x49, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x53, 0x10,
+	0x03, 0x2a, 0xa0, 0x01, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x4c,
+	0x4f, 0x41, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x57,
+	0x4f, 0x52, 0x4b, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x5f, 0x50, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x4b, 0x4c, 0x4f,
+	0x41, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41,
+	0x4c, 0x45, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x45, 0x4c, 0x46, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x02, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x04, 0x2a,
+	0x7f, 0x0a, 0x09, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x43, 0x48,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x42, 0x55,
+	0x4e, 0x44, 0x4c, 0x45, 0x10, 0x03, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x02,
+	0x10, 0x02, 0x2a, 0x18, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52,
+	0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x2a, 0x12, 0x43, 0x41,
+	0x43, 0x48, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53,
+	0x32, 0x84, 0x0c, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x30, 0x01, 0x12, 0x37,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0b, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d,
+	0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x12,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x44, 0x0a,
+	0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x28, 0x01, 0x12, 0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x42, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x45, 0x0a,
+	0x11, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x28, 0x01, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a,
+	0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x28, 0x01, 0x30, 0x01, 0x12, 0x38, 0x0a,
+	0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x20,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3e, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x38, 0x0a, 0x0b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3c, 0x0a, 0x0d, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a,
+	0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x42, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0b,
+	0x48, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x69,
+	0x6f, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_proto_agent_proto_rawDescOnce sync.Once
+	file_protobuf_proto_agent_proto_rawDescData = file_protobuf_proto_agent_proto_rawDesc
+)
+
+func file_protobuf_proto_agent_proto_rawDescGZIP() []byte {
+	file_protobuf_proto_agent_proto_rawDescOnce.Do(func() {
+		file_protobuf_proto_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_proto_agent_proto_rawDescData)
+	})
+	return file_protobuf_proto_agent_proto_rawDescData
+}
+
+var file_protobuf_proto_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_protobuf_proto_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_protobuf_proto_agent_proto_goTypes = []interface{}{
+	(ErrorCategory)(0),                       // 0: agent.ErrorCategory
+	(RestartGiveUpAction)(0),                 // 1: agent.RestartGiveUpAction
+	(Isolation)(0),                           // 2: agent.Isolation
+	(ServiceType)(0),                         // 3: agent.ServiceType
+	(PruneTarget)(0),                         // 4: agent.PruneTarget
+	(WorkloadOperation)(0),                   // 5: agent.WorkloadOperation
+	(CloseReason)(0),                         // 6: agent.CloseReason
+	(CacheKind)(0),                           // 7: agent.CacheKind
+	(*AgentInfo)(nil),                        // 8: agent.AgentInfo
+	(*ClusterIngressClass)(nil),              // 9: agent.ClusterIngressClass
+	(*ClusterStorageClass)(nil),              // 10: agent.ClusterStorageClass
+	(*ClusterCapabilitiesMessage)(nil),       // 11: agent.ClusterCapabilitiesMessage
+	(*HostDisk)(nil),                         // 12: agent.HostDisk
+	(*HostNetworkInterface)(nil),             // 13: agent.HostNetworkInterface
+	(*HostTemperature)(nil),                  // 14: agent.HostTemperature
+	(*HostMetricsMessage)(nil),               // 15: agent.HostMetricsMessage
+	(*AgentCommand)(nil),                     // 16: agent.AgentCommand
+	(*AgentError)(nil),                       // 17: agent.AgentError
+	(*AgentCommandError)(nil),                // 18: agent.AgentCommandError
+	(*DeployResponse)(nil),                   // 19: agent.DeployResponse
+	(*DeployRequest)(nil),                    // 20: agent.DeployRequest
+	(*ListSecretsRequest)(nil),               // 21: agent.ListSecretsRequest
+	(*RegistryAuth)(nil),                     // 22: agent.RegistryAuth
+	(*Port)(nil),                             // 23: agent.Port
+	(*PortRange)(nil),                        // 24: agent.PortRange
+	(*PortRangeBinding)(nil),                 // 25: agent.PortRangeBinding
+	(*Volume)(nil),                           // 26: agent.Volume
+	(*VolumeLink)(nil),                       // 27: agent.VolumeLink
+	(*InitContainer)(nil),                    // 28: agent.InitContainer
+	(*ImportContainer)(nil),                  // 29: agent.ImportContainer
+	(*LogConfig)(nil),                        // 30: agent.LogConfig
+	(*Marker)(nil),                           // 31: agent.Marker
+	(*Metrics)(nil),                          // 32: agent.Metrics
+	(*ExpectedState)(nil),                    // 33: agent.ExpectedState
+	(*RestartBudget)(nil),                    // 34: agent.RestartBudget
+	(*NetworkConfig)(nil),                    // 35: agent.NetworkConfig
+	(*DagentContainerConfig)(nil),            // 36: agent.DagentContainerConfig
+	(*ConfigFile)(nil),                       // 37: agent.ConfigFile
+	(*WorkloadIdentity)(nil),                 // 38: agent.WorkloadIdentity
+	(*ProjectedToken)(nil),                   // 39: agent.ProjectedToken
+	(*CraneContainerConfig)(nil),             // 40: agent.CraneContainerConfig
+	(*CommonContainerConfig)(nil),            // 41: agent.CommonContainerConfig
+	(*DeployWorkloadRequest)(nil),            // 42: agent.DeployWorkloadRequest
+	(*BaseConfigRef)(nil),                    // 43: agent.BaseConfigRef
+	(*ContainerStateRequest)(nil),            // 44: agent.ContainerStateRequest
+	(*ContainerDeleteRequest)(nil),           // 45: agent.ContainerDeleteRequest
+	(*DeployRequestLegacy)(nil),              // 46: agent.DeployRequestLegacy
+	(*AgentUpdateRequest)(nil),               // 47: agent.AgentUpdateRequest
+	(*ReplaceTokenRequest)(nil),              // 48: agent.ReplaceTokenRequest
+	(*AgentAbortUpdate)(nil),                 // 49: agent.AgentAbortUpdate
+	(*ContainerLogRequest)(nil),              // 50: agent.ContainerLogRequest
+	(*ContainerInspectRequest)(nil),          // 51: agent.ContainerInspectRequest
+	(*DeploymentDiffRequest)(nil),            // 52: agent.DeploymentDiffRequest
+	(*DeploymentFieldChange)(nil),            // 53: agent.DeploymentFieldChange
+	(*WorkloadDiff)(nil),                     // 54: agent.WorkloadDiff
+	(*DeploymentDiffResponse)(nil),           // 55: agent.DeploymentDiffResponse
+	(*VolumeUsageRequest)(nil),               // 56: agent.VolumeUsageRequest
+	(*VolumeUsage)(nil),                      // 57: agent.VolumeUsage
+	(*VolumeUsageResponse)(nil),              // 58: agent.VolumeUsageResponse
+	(*PruneReportRequest)(nil),               // 59: agent.PruneReportRequest
+	(*PruneCandidate)(nil),                   // 60: agent.PruneCandidate
+	(*PruneReportResponse)(nil),              // 61: agent.PruneReportResponse
+	(*TrafficUsageRequest)(nil),              // 62: agent.TrafficUsageRequest
+	(*TrafficTotal)(nil),                     // 63: agent.TrafficTotal
+	(*DailyTraffic)(nil),                     // 64: agent.DailyTraffic
+	(*PrefixTraffic)(nil),                    // 65: agent.PrefixTraffic
+	(*TrafficUsageResponse)(nil),             // 66: agent.TrafficUsageResponse
+	(*IngressConfigRequest)(nil),             // 67: agent.IngressConfigRequest
+	(*IngressEntrypoint)(nil),                // 68: agent.IngressEntrypoint
+	(*IngressConfigResponse)(nil),            // 69: agent.IngressConfigResponse
+	(*RollbackToRevisionRequest)(nil),        // 70: agent.RollbackToRevisionRequest
+	(*DeploymentRevision)(nil),               // 71: agent.DeploymentRevision
+	(*RollbackToRevisionResponse)(nil),       // 72: agent.RollbackToRevisionResponse
+	(*ResourceRecommendationRequest)(nil),    // 73: agent.ResourceRecommendationRequest
+	(*ResourceRecommendation)(nil),           // 74: agent.ResourceRecommendation
+	(*ResourceRecommendationResponse)(nil),   // 75: agent.ResourceRecommendationResponse
+	(*WorkloadOperationRequest)(nil),         // 76: agent.WorkloadOperationRequest
+	(*WorkloadOperationMessage)(nil),         // 77: agent.WorkloadOperationMessage
+	(*DebugContainerRequest)(nil),            // 78: agent.DebugContainerRequest
+	(*TerminalSize)(nil),                     // 79: agent.TerminalSize
+	(*DebugSessionStarted)(nil),              // 80: agent.DebugSessionStarted
+	(*DebugSessionEnded)(nil),                // 81: agent.DebugSessionEnded
+	(*DebugSessionInput)(nil),                // 82: agent.DebugSessionInput
+	(*DebugSessionOutput)(nil),               // 83: agent.DebugSessionOutput
+	(*CloseConnectionRequest)(nil),           // 84: agent.CloseConnectionRequest
+	(*CacheInvalidationRequest)(nil),         // 85: agent.CacheInvalidationRequest
+	(*ContainerCheckpointRequest)(nil),       // 86: agent.ContainerCheckpointRequest
+	(*ContainerCheckpointResponse)(nil),      // 87: agent.ContainerCheckpointResponse
+	(*ContainerRestoreRequest)(nil),          // 88: agent.ContainerRestoreRequest
+	(*ContainerRestoreResponse)(nil),         // 89: agent.ContainerRestoreResponse
+	nil,                                      // 90: agent.AgentError.DetailsEntry
+	nil,                                      // 91: agent.DeployRequest.SecretsEntry
+	nil,                                      // 92: agent.InitContainer.EnvironmentEntry
+	nil,                                      // 93: agent.ImportContainer.EnvironmentEntry
+	nil,                                      // 94: agent.LogConfig.OptionsEntry
+	nil,                                      // 95: agent.Marker.DeploymentEntry
+	nil,                                      // 96: agent.Marker.ServiceEntry
+	nil,                                      // 97: agent.Marker.IngressEntry
+	nil,                                      // 98: agent.NetworkConfig.OptionsEntry
+	nil,                                      // 99: agent.DagentContainerConfig.LabelsEntry
+	nil,                                      // 100: agent.DagentContainerConfig.RuntimeOptionsEntry
+	nil,                                      // 101: agent.WorkloadIdentity.AnnotationsEntry
+	nil,                                      // 102: agent.CraneContainerConfig.ExtraLBAnnotationsEntry
+	nil,                                      // 103: agent.CommonContainerConfig.EnvironmentEntry
+	nil,                                      // 104: agent.CommonContainerConfig.SecretsEntry
+	nil,                                      // 105: agent.DeploymentRevision.ImagesEntry
+	nil,                                      // 106: agent.DeploymentRevision.DigestsEntry
+	(*timestamppb.Timestamp)(nil),            // 107: google.protobuf.Timestamp
+	(*common.ContainerCommandRequest)(nil),   // 108: common.ContainerCommandRequest
+	(*common.DeleteContainersRequest)(nil),   // 109: common.DeleteContainersRequest
+	(*common.ContainerOrPrefix)(nil),         // 110: common.ContainerOrPrefix
+	(*common.PageRequest)(nil),               // 111: common.PageRequest
+	(common.VolumeType)(0),                   // 112: common.VolumeType
+	(common.DriverType)(0),                   // 113: common.DriverType
+	(common.ContainerState)(0),               // 114: common.ContainerState
+	(common.RestartPolicy)(0),                // 115: common.RestartPolicy
+	(common.NetworkMode)(0),                  // 116: common.NetworkMode
+	(*common.ResourceConfig)(nil),            // 117: common.ResourceConfig
+	(common.DeploymentStrategy)(0),           // 118: common.DeploymentStrategy
+	(*common.HealthCheckConfig)(nil),         // 119: common.HealthCheckConfig
+	(common.ExposeStrategy)(0),               // 120: common.ExposeStrategy
+	(*common.Routing)(nil),                   // 121: common.Routing
+	(*common.ConfigContainer)(nil),           // 122: common.ConfigContainer
+	(*common.ListFilter)(nil),                // 123: common.ListFilter
+	(*common.ContainerIdentifier)(nil),       // 124: common.ContainerIdentifier
+	(*common.Resource)(nil),                  // 125: common.Resource
+	(common.DeploymentStatus)(0),             // 126: common.DeploymentStatus
+	(*common.Empty)(nil),                     // 127: common.Empty
+	(*common.DeploymentStatusMessage)(nil),   // 128: common.DeploymentStatusMessage
+	(*common.ContainerStateListMessage)(nil), // 129: common.ContainerStateListMessage
+	(*common.ContainerLogMessage)(nil),       // 130: common.ContainerLogMessage
+	(*common.ListSecretsResponse)(nil),       // 131: common.ListSecretsResponse
+	(*common.ContainerLogListResponse)(nil),  // 132: common.ContainerLogListResponse
+	(*common.ContainerInspectResponse)(nil),  // 133: common.ContainerInspectResponse
+}
+var file_protobuf_proto_agent_proto_depIdxs = []int32{
+	11,  // 0: agent.AgentInfo.cluster:type_name -> agent.ClusterCapabilitiesMessage
+	107, // 1: agent.ClusterCapabilitiesMessage.discoveredAt:type_name -> google.protobuf.Timestamp
+	9,   // 2: agent.ClusterCapabilitiesMessage.ingressClasses:type_name -> agent.ClusterIngressClass
+	10,  // 3: agent.ClusterCapabilitiesMessage.storageClasses:type_name -> agent.ClusterStorageClass
+	107, // 4: agent.HostMetricsMessage.collectedAt:type_name -> google.protobuf.Timestamp
+	12,  // 5: agent.HostMetricsMessage.disks:type_name -> agent.HostDisk
+	13,  // 6: agent.HostMetricsMessage.interfaces:type_name -> agent.HostNetworkInterface
+	14,  // 7: agent.HostMetricsMessage.temperatures:type_name -> agent.HostTemperature
+	20,  // 8: agent.AgentCommand.deploy:type_name -> agent.DeployRequest
+	44,  // 9: agent.AgentCommand.containerState:type_name -> agent.ContainerStateRequest
+	45,  // 10: agent.AgentCommand.containerDelete:type_name -> agent.ContainerDeleteRequest
+	46,  // 11: agent.AgentCommand.deployLegacy:type_name -> agent.DeployRequestLegacy
+	21,  // 12: agent.AgentCommand.listSecrets:type_name -> agent.ListSecretsRequest
+	47,  // 13: agent.AgentCommand.update:type_name -> agent.AgentUpdateRequest
+	84,  // 14: agent.AgentCommand.close:type_name -> agent.CloseConnectionRequest
+	108, // 15: agent.AgentCommand.containerCommand:type_name -> common.ContainerCommandRequest
+	109, // 16: agent.AgentCommand.deleteContainers:type_name -> common.DeleteContainersRequest
+	50,  // 17: agent.AgentCommand.containerLog:type_name -> agent.ContainerLogRequest
+	48,  // 18: agent.AgentCommand.replaceToken:type_name -> agent.ReplaceTokenRequest
+	51,  // 19: agent.AgentCommand.containerInspect:type_name -> agent.ContainerInspectRequest
+	52,  // 20: agent.AgentCommand.deploymentDiff:type_name -> agent.DeploymentDiffRequest
+	56,  // 21: agent.AgentCommand.volumeUsage:type_name -> agent.VolumeUsageRequest
+	70,  // 22: agent.AgentCommand.rollbackToRevision:type_name -> agent.RollbackToRevisionRequest
+	73,  // 23: agent.AgentCommand.resourceRecommendation:type_name -> agent.ResourceRecommendationRequest
+	76,  // 24: agent.AgentCommand.workloadOperation:type_name -> agent.WorkloadOperationRequest
+	86,  // 25: agent.AgentCommand.containerCheckpoint:type_name -> agent.ContainerCheckpointRequest
+	88,  // 26: agent.AgentCommand.containerRestore:type_name -> agent.ContainerRestoreRequest
+	59,  // 27: agent.AgentCommand.pruneReport:type_name -> agent.PruneReportRequest
+	62,  // 28: agent.AgentCommand.trafficUsage:type_name -> agent.TrafficUsageRequest
+	78,  // 29: agent.AgentCommand.debugContainer:type_name -> agent.DebugContainerRequest
+	67,  // 30: agent.AgentCommand.ingressConfig:type_name -> agent.IngressConfigRequest
+	85,  // 31: agent.AgentCommand.invalidateCache:type_name -> agent.CacheInvalidationRequest
+	0,   // 32: agent.AgentError.category:type_name -> agent.ErrorCategory
+	90,  // 33: agent.AgentError.details:type_name -> agent.AgentError.DetailsEntry
+	17,  // 34: agent.AgentCommandError.listSecrets:type_name -> agent.AgentError
+	17,  // 35: agent.AgentCommandError.deleteContainers:type_name -> agent.AgentError
+	17,  // 36: agent.AgentCommandError.containerLog:type_name -> agent.AgentError
+	17,  // 37: agent.AgentCommandError.containerInspect:type_name -> agent.AgentError
+	17,  // 38: agent.AgentCommandError.deploymentDiff:type_name -> agent.AgentError
+	17,  // 39: agent.AgentCommandError.volumeUsage:type_name -> agent.AgentError
+	17,  // 40: agent.AgentCommandError.rollbackToRevision:type_name -> agent.AgentError
+	17,  // 41: agent.AgentCommandError.resourceRecommendation:type_name -> agent.AgentError
+	17,  // 42: agent.AgentCommandError.workloadOperation:type_name -> agent.AgentError
+	17,  // 43: agent.AgentCommandError.containerCheckpoint:type_name -> agent.AgentError
+	17,  // 44: agent.AgentCommandError.containerRestore:type_name -> agent.AgentError
+	17,  // 45: agent.AgentCommandError.pruneReport:type_name -> agent.AgentError
+	17,  // 46: agent.AgentCommandError.trafficUsage:type_name -> agent.AgentError
+	17,  // 47: agent.AgentCommandError.debugContainer:type_name -> agent.AgentError
+	17,  // 48: agent.AgentCommandError.ingressConfig:type_name -> agent.AgentError
+	17,  // 49: agent.AgentCommandError.deploy:type_name -> agent.AgentError
+	17,  // 50: agent.AgentCommandError.deleteContainer:type_name -> agent.AgentError
+	17,  // 51: agent.AgentCommandError.containerCommand:type_name -> agent.AgentError
+	17,  // 52: agent.AgentCommandError.containerState:type_name -> agent.AgentError
+	91,  // 53: agent.DeployRequest.secrets:type_name -> agent.DeployRequest.SecretsEntry
+	42,  // 54: agent.DeployRequest.requests:type_name -> agent.DeployWorkloadRequest
+	110, // 55: agent.ListSecretsRequest.target:type_name -> common.ContainerOrPrefix
+	111, // 56: agent.ListSecretsRequest.page:type_name -> common.PageRequest
+	24,  // 57: agent.PortRangeBinding.internal:type_name -> agent.PortRange
+	24,  // 58: agent.PortRangeBinding.external:type_name -> agent.PortRange
+	112, // 59: agent.Volume.type:type_name -> common.VolumeType
+	27,  // 60: agent.InitContainer.volumes:type_name -> agent.VolumeLink
+	92,  // 61: agent.InitContainer.environment:type_name -> agent.InitContainer.EnvironmentEntry
+	93,  // 62: agent.ImportContainer.environment:type_name -> agent.ImportContainer.EnvironmentEntry
+	113, // 63: agent.LogConfig.driver:type_name -> common.DriverType
+	94,  // 64: agent.LogConfig.options:type_name -> agent.LogConfig.OptionsEntry
+	95,  // 65: agent.Marker.deployment:type_name -> agent.Marker.DeploymentEntry
+	96,  // 66: agent.Marker.service:type_name -> agent.Marker.ServiceEntry
+	97,  // 67: agent.Marker.ingress:type_name -> agent.Marker.IngressEntry
+	114, // 68: agent.ExpectedState.state:type_name -> common.ContainerState
+	1,   // 69: agent.RestartBudget.giveUpAction:type_name -> agent.RestartGiveUpAction
+	98,  // 70: agent.NetworkConfig.options:type_name -> agent.NetworkConfig.OptionsEntry
+	30,  // 71: agent.DagentContainerConfig.logConfig:type_name -> agent.LogConfig
+	115, // 72: agent.DagentContainerConfig.restartPolicy:type_name -> common.RestartPolicy
+	116, // 73: agent.DagentContainerConfig.networkMode:type_name -> common.NetworkMode
+	33,  // 74: agent.DagentContainerConfig.expectedState:type_name -> agent.ExpectedState
+	117, // 75: agent.DagentContainerConfig.resourceConfig:type_name -> common.ResourceConfig
+	2,   // 76: agent.DagentContainerConfig.isolation:type_name -> agent.Isolation
+	34,  // 77: agent.DagentContainerConfig.restartBudget:type_name -> agent.RestartBudget
+	99,  // 78: agent.DagentContainerConfig.labels:type_name -> agent.DagentContainerConfig.LabelsEntry
+	35,  // 79: agent.DagentContainerConfig.networkConfigs:type_name -> agent.NetworkConfig
+	100, // 80: agent.DagentContainerConfig.runtimeOptions:type_name -> agent.DagentContainerConfig.RuntimeOptionsEntry
+	101, // 81: agent.WorkloadIdentity.annotations:type_name -> agent.WorkloadIdentity.AnnotationsEntry
+	118, // 82: agent.CraneContainerConfig.deploymentStrategy:type_name -> common.DeploymentStrategy
+	119, // 83: agent.CraneContainerConfig.healthCheckConfig:type_name -> common.HealthCheckConfig
+	117, // 84: agent.CraneContainerConfig.resourceConfig:type_name -> common.ResourceConfig
+	31,  // 85: agent.CraneContainerConfig.annotations:type_name -> agent.Marker
+	31,  // 86: agent.CraneContainerConfig.labels:type_name -> agent.Marker
+	32,  // 87: agent.CraneContainerConfig.metrics:type_name -> agent.Metrics
+	3,   // 88: agent.CraneContainerConfig.serviceType:type_name -> agent.ServiceType
+	38,  // 89: agent.CraneContainerConfig.workloadIdentity:type_name -> agent.WorkloadIdentity
+	102, // 90: agent.CraneContainerConfig.extraLBAnnotations:type_name -> agent.CraneContainerConfig.ExtraLBAnnotationsEntry
+	37,  // 91: agent.CraneContainerConfig.configFiles:type_name -> agent.ConfigFile
+	39,  // 92: agent.CraneContainerConfig.projectedTokens:type_name -> agent.ProjectedToken
+	120, // 93: agent.CommonContainerConfig.expose:type_name -> common.ExposeStrategy
+	121, // 94: agent.CommonContainerConfig.routing:type_name -> common.Routing
+	122, // 95: agent.CommonContainerConfig.configContainer:type_name -> common.ConfigContainer
+	29,  // 96: agent.CommonContainerConfig.importContainer:type_name -> agent.ImportContainer
+	23,  // 97: agent.CommonContainerConfig.ports:type_name -> agent.Port
+	25,  // 98: agent.CommonContainerConfig.portRanges:type_name -> agent.PortRangeBinding
+	26,  // 99: agent.CommonContainerConfig.volumes:type_name -> agent.Volume
+	103, // 100: agent.CommonContainerConfig.environment:type_name -> agent.CommonContainerConfig.EnvironmentEntry
+	104, // 101: agent.CommonContainerConfig.secrets:type_name -> agent.CommonContainerConfig.SecretsEntry
+	28,  // 102: agent.CommonContainerConfig.initContainers:type_name -> agent.InitContainer
+	41,  // 103: agent.DeployWorkloadRequest.common:type_name -> agent.CommonContainerConfig
+	36,  // 104: agent.DeployWorkloadRequest.dagent:type_name -> agent.DagentContainerConfig
+	40,  // 105: agent.DeployWorkloadRequest.crane:type_name -> agent.CraneContainerConfig
+	22,  // 106: agent.DeployWorkloadRequest.registryAuth:type_name -> agent.RegistryAuth
+	43,  // 107: agent.DeployWorkloadRequest.base:type_name -> agent.BaseConfigRef
+	123, // 108: agent.ContainerStateRequest.filter:type_name -> common.ListFilter
+	111, // 109: agent.ContainerStateRequest.page:type_name -> common.PageRequest
+	124, // 110: agent.ContainerLogRequest.container:type_name -> common.ContainerIdentifier
+	124, // 111: agent.ContainerInspectRequest.container:type_name -> common.ContainerIdentifier
+	42,  // 112: agent.DeploymentDiffRequest.requests:type_name -> agent.DeployWorkloadRequest
+	124, // 113: agent.WorkloadDiff.container:type_name -> common.ContainerIdentifier
+	53,  // 114: agent.WorkloadDiff.changes:type_name -> agent.DeploymentFieldChange
+	54,  // 115: agent.DeploymentDiffResponse.workloads:type_name -> agent.WorkloadDiff
+	124, // 116: agent.VolumeUsage.containers:type_name -> common.ContainerIdentifier
+	57,  // 117: agent.VolumeUsageResponse.volumes:type_name -> agent.VolumeUsage
+	4,   // 118: agent.PruneReportRequest.targets:type_name -> agent.PruneTarget
+	4,   // 119: agent.PruneCandidate.target:type_name -> agent.PruneTarget
+	124, // 120: agent.PruneCandidate.container:type_name -> common.ContainerIdentifier
+	60,  // 121: agent.PruneReportResponse.candidates:type_name -> agent.PruneCandidate
+	63,  // 122: agent.DailyTraffic.total:type_name -> agent.TrafficTotal
+	63,  // 123: agent.PrefixTraffic.rolling:type_name -> agent.TrafficTotal
+	64,  // 124: agent.PrefixTraffic.daily:type_name -> agent.DailyTraffic
+	107, // 125: agent.TrafficUsageResponse.since:type_name -> google.protobuf.Timestamp
+	65,  // 126: agent.TrafficUsageResponse.prefixes:type_name -> agent.PrefixTraffic
+	68,  // 127: agent.IngressConfigRequest.entrypoints:type_name -> agent.IngressEntrypoint
+	124, // 128: agent.RollbackToRevisionRequest.container:type_name -> common.ContainerIdentifier
+	107, // 129: agent.DeploymentRevision.createdAt:type_name -> google.protobuf.Timestamp
+	105, // 130: agent.DeploymentRevision.images:type_name -> agent.DeploymentRevision.ImagesEntry
+	106, // 131: agent.DeploymentRevision.digests:type_name -> agent.DeploymentRevision.DigestsEntry
+	124, // 132: agent.RollbackToRevisionResponse.container:type_name -> common.ContainerIdentifier
+	71,  // 133: agent.RollbackToRevisionResponse.revision:type_name -> agent.DeploymentRevision
+	71,  // 134: agent.RollbackToRevisionResponse.history:type_name -> agent.DeploymentRevision
+	124, // 135: agent.ResourceRecommendation.container:type_name -> common.ContainerIdentifier
+	125, // 136: agent.ResourceRecommendation.requests:type_name -> common.Resource
+	125, // 137: agent.ResourceRecommendation.recommended:type_name -> common.Resource
+	74,  // 138: agent.ResourceRecommendationResponse.recommendations:type_name -> agent.ResourceRecommendation
+	124, // 139: agent.WorkloadOperationRequest.container:type_name -> common.ContainerIdentifier
+	5,   // 140: agent.WorkloadOperationRequest.operation:type_name -> agent.WorkloadOperation
+	126, // 141: agent.WorkloadOperationMessage.status:type_name -> common.DeploymentStatus
+	107, // 142: agent.WorkloadOperationMessage.createdAt:type_name -> google.protobuf.Timestamp
+	124, // 143: agent.DebugContainerRequest.container:type_name -> common.ContainerIdentifier
+	79,  // 144: agent.DebugSessionInput.resize:type_name -> agent.TerminalSize
+	127, // 145: agent.DebugSessionInput.closeStdin:type_name -> common.Empty
+	80,  // 146: agent.DebugSessionOutput.started:type_name -> agent.DebugSessionStarted
+	81,  // 147: agent.DebugSessionOutput.ended:type_name -> agent.DebugSessionEnded
+	6,   // 148: agent.CloseConnectionRequest.reason:type_name -> agent.CloseReason
+	7,   // 149: agent.CacheInvalidationRequest.kind:type_name -> agent.CacheKind
+	124, // 150: agent.ContainerCheckpointRequest.container:type_name -> common.ContainerIdentifier
+	124, // 151: agent.ContainerCheckpointResponse.container:type_name -> common.ContainerIdentifier
+	124, // 152: agent.ContainerRestoreRequest.container:type_name -> common.ContainerIdentifier
+	124, // 153: agent.ContainerRestoreResponse.container:type_name -> common.ContainerIdentifier
+	8,   // 154: agent.Agent.Connect:input_type -> agent.AgentInfo
+	18,  // 155: agent.Agent.CommandError:input_type -> agent.AgentCommandError
+	49,  // 156: agent.Agent.AbortUpdate:input_type -> agent.AgentAbortUpdate
+	127, // 157: agent.Agent.TokenReplaced:input_type -> common.Empty
+	128, // 158: agent.Agent.DeploymentStatus:input_type -> common.DeploymentStatusMessage
+	129, // 159: agent.Agent.ContainerState:input_type -> common.ContainerStateListMessage
+	130, // 160: agent.Agent.ContainerLogStream:input_type -> common.ContainerLogMessage
+	77,  // 161: agent.Agent.WorkloadOperation:input_type -> agent.WorkloadOperationMessage
+	83,  // 162: agent.Agent.DebugSession:input_type -> agent.DebugSessionOutput
+	131, // 163: agent.Agent.SecretList:input_type -> common.ListSecretsResponse
+	127, // 164: agent.Agent.DeleteContainers:input_type -> common.Empty
+	132, // 165: agent.Agent.ContainerLog:input_type -> common.ContainerLogListResponse
+	133, // 166: agent.Agent.ContainerInspect:input_type -> common.ContainerInspectResponse
+	55,  // 167: agent.Agent.DeploymentDiff:input_type -> agent.DeploymentDiffResponse
+	58,  // 168: agent.Agent.VolumeUsage:input_type -> agent.VolumeUsageResponse
+	61,  // 169: agent.Agent.PruneReport:input_type -> agent.PruneReportResponse
+	66,  // 170: agent.Agent.TrafficUsage:input_type -> agent.TrafficUsageResponse
+	69,  // 171: agent.Agent.IngressConfig:input_type -> agent.IngressConfigResponse
+	72,  // 172: agent.Agent.RollbackToRevision:input_type -> agent.RollbackToRevisionResponse
+	75,  // 173: agent.Agent.ResourceRecommendation:input_type -> agent.ResourceRecommendationResponse
+	87,  // 174: agent.Agent.ContainerCheckpoint:input_type -> agent.ContainerCheckpointResponse
+	89,  // 175: agent.Agent.ContainerRestore:input_type -> agent.ContainerRestoreResponse
+	11,  // 176: agent.Agent.ClusterCapabilities:input_type -> agent.ClusterCapabilitiesMessage
+	15,  // 177: agent.Agent.HostMetrics:input_type -> agent.HostMetricsMessage
+	16,  // 178: agent.Agent.Connect:output_type -> agent.AgentCommand
+	127, // 179: agent.Agent.CommandError:output_type -> common.Empty
+	127, // 180: agent.Agent.AbortUpdate:output_type -> common.Empty
+	127, // 181: agent.Agent.TokenReplaced:output_type -> common.Empty
+	127, // 182: agent.Agent.DeploymentStatus:output_type -> common.Empty
+	127, // 183: agent.Agent.ContainerState:output_type -> common.Empty
+	127, // 184: agent.Agent.ContainerLogStream:output_type -> common.Empty
+	127, // 185: agent.Agent.WorkloadOperation:output_type -> common.Empty
+	82,  // 186: agent.Agent.DebugSession:output_type -> agent.DebugSessionInput
+	127, // 187: agent.Agent.SecretList:output_type -> common.Empty
+	127, // 188: agent.Agent.DeleteContainers:output_type -> common.Empty
+	127, // 189: agent.Agent.ContainerLog:output_type -> common.Empty
+	127, // 190: agent.Agent.ContainerInspect:output_type -> common.Empty
+	127, // 191: agent.Agent.DeploymentDiff:output_type -> common.Empty
+	127, // 192: agent.Agent.VolumeUsage:output_type -> common.Empty
+	127, // 193: agent.Agent.PruneReport:output_type -> common.Empty
+	127, // 194: agent.Agent.TrafficUsage:output_type -> common.Empty
+	127, // 195: agent.Agent.IngressConfig:output_type -> common.Empty
+	127, // 196: agent.Agent.RollbackToRevision:output_type -> common.Empty
+	127, // 197: agent.Agent.ResourceRecommendation:output_type -> common.Empty
+	127, // 198: agent.Agent.ContainerCheckpoint:output_type -> common.Empty
+	127, // 199: agent.Agent.ContainerRestore:output_type -> common.Empty
+	127, // 200: agent.Agent.ClusterCapabilities:output_type -> common.Empty
+	127, // 201: agent.Agent.HostMetrics:output_type -> common.Empty
+	178, // [178:202] is the sub-list for method output_type
+	154, // [154:178] is the sub-list for method input_type
+	154, // [154:154] is the sub-list for extension type_name
+	154, // [154:154] is the sub-list for extension extendee
+	0,   // [0:154] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_proto_agent_proto_init() }
+func file_protobuf_proto_agent_proto_init() {
+	if File_protobuf_proto_agent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_proto_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterIngressClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStorageClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterCapabilitiesMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostDisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostNetworkInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostTemperature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostMetricsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentCommandError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecretsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Port); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortRangeBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Volume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Marker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectedState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DagentContainerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectedToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CraneContainerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommonContainerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployWorkloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaseConfigRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployRequestLegacy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentAbortUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerInspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CloseConnectionRequest.ProtoReflect.Descriptor instead.
-func (*CloseConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *CloseConnectionRequest) GetReason() CloseReason {
-	if x != nil {
-		return x.Reason
-	}
-	return CloseReason_CLOSE_REASON_UNSPECIFIED
-}
-
-var File_protobuf_proto_agent_proto protoreflect.FileDescriptor
-
-var file_protobuf_proto_agent_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x90, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0xb9, 0x06, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x6c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a,
-	0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x12, 0x4d, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
-	0x3a, 0x0a, 0x0a, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x90, 0x02, 0x0a, 0x11,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x12, 0x3f, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x2a,
-	0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0xb0, 0x02, 0x0a, 0x0d, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
-	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x64, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x50, 0x0a, 0x04,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x12, 0x1f, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0x2f,
-	0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
-	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x22,
-	0x6e, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
-	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x2c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x65, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22,
-	0xad, 0x01, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22,
-	0x34, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xe4, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x2d, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x73,
-	0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01,
-	0x12, 0x2c, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x19,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x48,
-	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xeb, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x69,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xcf, 0x01, 0x0a,
-	0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3e,
-	0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad,
-	0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x06,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xed,
-	0x02, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x35, 0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0xea, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31,
-	0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x1f, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x66, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xe8, 0x03, 0x0a, 0x15, 0x44,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x67,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x02, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x48, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
-	0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc3, 0x06, 0x0a, 0x14, 0x43, 0x72, 0x61, 0x6e, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f,
-	0x0a, 0x12, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48, 0x00, 0x52, 0x12, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x4c, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a,
-	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52,
-	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88,
-	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x75,
-	0x73, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x68,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x05,
-	0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x48,
-	0x06, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x07, 0x52,
-	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0d, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0xe8, 0x07, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x64, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x4c, 0x42, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
-	0x15, 0x0a, 0x13, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x11, 0x0a, 0x0f,
-	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xf2, 0x07, 0x0a, 0x15,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x65, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x78, 0x70,
-	0x6f, 0x73, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x48, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x46,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x48, 0x02, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x03, 0x52, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x54, 0x54, 0x59, 0x18, 0x6b, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x03, 0x54, 0x54, 0x59, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
-	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x22,
-	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x18, 0xeb, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x12, 0x13, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0xec, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xed, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0xee, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
-	0x12, 0x3d, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x18, 0xef, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
-	0x0e, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x1a,
-	0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x54, 0x54, 0x59, 0x42, 0x13, 0x0a, 0x11, 0x5f,
-	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x22, 0xa2, 0x03, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x06, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x36, 0x0a, 0x05, 0x63, 0x72, 0x61, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x05,
-	0x63, 0x72, 0x61, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x41, 0x75, 0x74, 0x68, 0x48, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x41, 0x75, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x63, 0x72, 0x61, 0x6e, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x41, 0x75, 0x74, 0x68, 0x22, 0x6a, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6f,
-	0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07,
-	0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f,
-	0x74, 0x22, 0x44, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
-	0x22, 0x64, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2b, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x10, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x01,
-	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61,
-	0x69, 0x6c, 0x22, 0x54, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
-	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x16, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0x69,
-	0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
-	0x18, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43,
-	0x4c, 0x4f, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x44,
-	0x45, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55,
-	0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x56, 0x4f, 0x4b,
-	0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x04, 0x32, 0x9c, 0x05, 0x0a, 0x05, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x10,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x1a, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x35, 0x0a, 0x0b, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f,
-	0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x44, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x28, 0x01, 0x12, 0x42, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x30, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d,
-	0x69, 0x6f, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x6f, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_protobuf_proto_agent_proto_rawDescOnce sync.Once
-	file_protobuf_proto_agent_proto_rawDescData = file_protobuf_proto_agent_proto_rawDesc
-)
-
-func file_protobuf_proto_agent_proto_rawDescGZIP() []byte {
-	file_protobuf_proto_agent_proto_rawDescOnce.Do(func() {
-		file_protobuf_proto_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_proto_agent_proto_rawDescData)
-	})
-	return file_protobuf_proto_agent_proto_rawDescData
-}
-
-var file_protobuf_proto_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protobuf_proto_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
-var file_protobuf_proto_agent_proto_goTypes = []interface{}{
-	(CloseReason)(0),                         // 0: agent.CloseReason
-	(*AgentInfo)(nil),                        // 1: agent.AgentInfo
-	(*AgentCommand)(nil),                     // 2: agent.AgentCommand
-	(*AgentError)(nil),                       // 3: agent.AgentError
-	(*AgentCommandError)(nil),                // 4: agent.AgentCommandError
-	(*DeployResponse)(nil),                   // 5: agent.DeployResponse
-	(*DeployRequest)(nil),                    // 6: agent.DeployRequest
-	(*ListSecretsRequest)(nil),               // 7: agent.ListSecretsRequest
-	(*RegistryAuth)(nil),                     // 8: agent.RegistryAuth
-	(*Port)(nil),                             // 9: agent.Port
-	(*PortRange)(nil),                        // 10: agent.PortRange
-	(*PortRangeBinding)(nil),                 // 11: agent.PortRangeBinding
-	(*Volume)(nil),                           // 12: agent.Volume
-	(*VolumeLink)(nil),                       // 13: agent.VolumeLink
-	(*InitContainer)(nil),                    // 14: agent.InitContainer
-	(*ImportContainer)(nil),                  // 15: agent.ImportContainer
-	(*LogConfig)(nil),                        // 16: agent.LogConfig
-	(*Marker)(nil),                           // 17: agent.Marker
-	(*Metrics)(nil),                          // 18: agent.Metrics
-	(*ExpectedState)(nil),                    // 19: agent.ExpectedState
-	(*DagentContainerConfig)(nil),            // 20: agent.DagentContainerConfig
-	(*CraneContainerConfig)(nil),             // 21: agent.CraneContainerConfig
-	(*CommonContainerConfig)(nil),            // 22: agent.CommonContainerConfig
-	(*DeployWorkloadRequest)(nil),            // 23: agent.DeployWorkloadRequest
-	(*ContainerStateRequest)(nil),            // 24: agent.ContainerStateRequest
-	(*ContainerDeleteRequest)(nil),           // 25: agent.ContainerDeleteRequest
-	(*DeployRequestLegacy)(nil),              // 26: agent.DeployRequestLegacy
-	(*AgentUpdateRequest)(nil),               // 27: agent.AgentUpdateRequest
-	(*ReplaceTokenRequest)(nil),              // 28: agent.ReplaceTokenRequest
-	(*AgentAbortUpdate)(nil),                 // 29: agent.AgentAbortUpdate
-	(*ContainerLogRequest)(nil),              // 30: agent.ContainerLogRequest
-	(*ContainerInspectRequest)(nil),          // 31: agent.ContainerInspectRequest
-	(*CloseConnectionRequest)(nil),           // 32: agent.CloseConnectionRequest
-	nil,                                      // 33: agent.DeployRequest.SecretsEntry
-	nil,                                      // 34: agent.InitContainer.EnvironmentEntry
-	nil,                                      // 35: agent.ImportContainer.EnvironmentEntry
-	nil,                                      // 36: agent.LogConfig.OptionsEntry
-	nil,                                      // 37: agent.Marker.DeploymentEntry
-	nil,                                      // 38: agent.Marker.ServiceEntry
-	nil,                                      // 39: agent.Marker.IngressEntry
-	nil,                                      // 40: agent.DagentContainerConfig.LabelsEntry
-	nil,                                      // 41: agent.CraneContainerConfig.ExtraLBAnnotationsEntry
-	nil,                                      // 42: agent.CommonContainerConfig.EnvironmentEntry
-	nil,                                      // 43: agent.CommonContainerConfig.SecretsEntry
-	(*common.ContainerCommandRequest)(nil),   // 44: common.ContainerCommandRequest
-	(*common.DeleteContainersRequest)(nil),   // 45: common.DeleteContainersRequest
-	(*common.ContainerOrPrefix)(nil),         // 46: common.ContainerOrPrefix
-	(common.VolumeType)(0),                   // 47: common.VolumeType
-	(common.DriverType)(0),                   // 48: common.DriverType
-	(common.ContainerState)(0),               // 49: common.ContainerState
-	(common.RestartPolicy)(0),                // 50: common.RestartPolicy
-	(common.NetworkMode)(0),                  // 51: common.NetworkMode
-	(common.DeploymentStrategy)(0),           // 52: common.DeploymentStrategy
-	(*common.HealthCheckConfig)(nil),         // 53: common.HealthCheckConfig
-	(*common.ResourceConfig)(nil),            // 54: common.ResourceConfig
-	(common.ExposeStrategy)(0),               // 55: common.ExposeStrategy
-	(*common.Routing)(nil),                   // 56: common.Routing
-	(*common.ConfigContainer)(nil),           // 57: common.ConfigContainer
-	(*common.ContainerIdentifier)(nil),       // 58: common.ContainerIdentifier
-	(*common.Empty)(nil),                     // 59: common.Empty
-	(*common.DeploymentStatusMessage)(nil),   // 60: common.DeploymentStatusMessage
-	(*common.ContainerStateListMessage)(nil), // 61: common.ContainerStateListMessage
-	(*common.ContainerLogMessage)(nil),       // 62: common.ContainerLogMessage
-	(*common.ListSecretsResponse)(nil),       // 63: common.ListSecretsResponse
-	(*common.ContainerLogListResponse)(nil),  // 64: common.ContainerLogListResponse
-	(*common.ContainerInspectResponse)(nil),  // 65: common.ContainerInspectResponse
-}
-var file_protobuf_proto_agent_proto_depIdxs = []int32{
-	6,  // 0: agent.AgentCommand.deploy:type_name -> agent.DeployRequest
-	24, // 1: agent.AgentCommand.containerState:type_name -> agent.ContainerStateRequest
-	25, // 2: agent.AgentCommand.containerDelete:type_name -> agent.ContainerDeleteRequest
-	26, // 3: agent.AgentCommand.deployLegacy:type_name -> agent.DeployRequestLegacy
-	7,  // 4: agent.AgentCommand.listSecrets:type_name -> agent.ListSecretsRequest
-	27, // 5: agent.AgentCommand.update:type_name -> agent.AgentUpdateRequest
-	32, // 6: agent.AgentCommand.close:type_name -> agent.CloseConnectionRequest
-	44, // 7: agent.AgentCommand.containerCommand:type_name -> common.ContainerCommandRequest
-	45, // 8: agent.AgentCommand.deleteContainers:type_name -> common.DeleteContainersRequest
-	30, // 9: agent.AgentCommand.containerLog:type_name -> agent.ContainerLogRequest
-	28, // 10: agent.AgentCommand.replaceToken:type_name -> agent.ReplaceTokenRequest
-	31, // 11: agent.AgentCommand.containerInspect:type_name -> agent.ContainerInspectRequest
-	3,  // 12: agent.AgentCommandError.listSecrets:type_name -> agent.AgentError
-	3,  // 13: agent.AgentCommandError.deleteContainers:type_name -> agent.AgentError
-	3,  // 14: agent.AgentCommandError.containerLog:type_name -> agent.AgentError
-	3,  // 15: agent.AgentCommandError.containerInspect:type_name -> agent.AgentError
-	33, // 16: agent.DeployRequest.secrets:type_name -> agent.DeployRequest.SecretsEntry
-	23, // 17: agent.DeployRequest.requests:type_name -> agent.DeployWorkloadRequest
-	46, // 18: agent.ListSecretsRequest.target:type_name -> common.ContainerOrPrefix
-	10, // 19: agent.PortRangeBinding.internal:type_name -> agent.PortRange
-	10, // 20: agent.PortRangeBinding.external:type_name -> agent.PortRange
-	47, // 21: agent.Volume.type:type_name -> common.VolumeType
-	13, // 22: agent.InitContainer.volumes:type_name -> agent.VolumeLink
-	34, // 23: agent.InitContainer.environment:type_name -> agent.InitContainer.EnvironmentEntry
-	35, // 24: agent.ImportContainer.environment:type_name -> agent.ImportContainer.EnvironmentEntry
-	48, // 25: agent.LogConfig.driver:type_name -> common.DriverType
-	36, // 26: agent.LogConfig.options:type_name -> agent.LogConfig.OptionsEntry
-	37, // 27: agent.Marker.deployment:type_name -> agent.Marker.DeploymentEntry
-	38, // 28: agent.Marker.service:type_name -> agent.Marker.ServiceEntry
-	39, // 29: agent.Marker.ingress:type_name -> agent.Marker.IngressEntry
-	49, // 30: agent.ExpectedState.state:type_name -> common.ContainerState
-	16, // 31: agent.DagentContainerConfig.logConfig:type_name -> agent.LogConfig
-	50, // 32: agent.DagentContainerConfig.restartPolicy:type_name -> common.RestartPolicy
-	51, // 33: agent.DagentContainerConfig.networkMode:type_name -> common.NetworkMode
-	19, // 34: agent.DagentContainerConfig.expectedState:type_name -> agent.ExpectedState
-	40, // 35: agent.DagentContainerConfig.labels:type_name -> agent.DagentContainerConfig.LabelsEntry
-	52, // 36: agent.CraneContainerConfig.deploymentStrategy:type_name -> common.DeploymentStrategy
-	53, // 37: agent.CraneContainerConfig.healthCheckConfig:type_name -> common.HealthCheckConfig
-	54, // 38: agent.CraneContainerConfig.resourceConfig:type_name -> common.ResourceConfig
-	17, // 39: agent.CraneContainerConfig.annotations:type_name -> agent.Marker
-	17, // 40: agent.CraneContainerConfig.labels:type_name -> agent.Marker
-	18, // 41: agent.CraneContainerConfig.metrics:type_name -> agent.Metrics
-	41, // 42: agent.CraneContainerConfig.extraLBAnnotations:type_name -> agent.CraneContainerConfig.ExtraLBAnnotationsEntry
-	55, // 43: agent.CommonContainerConfig.expose:type_name -> common.ExposeStrategy
-	56, // 44: agent.CommonContainerConfig.routing:type_name -> common.Routing
-	57, // 45: agent.CommonContainerConfig.configContainer:type_name -> common.ConfigContainer
-	15, // 46: agent.CommonContainerConfig.importContainer:type_name -> agent.ImportContainer
-	9,  // 47: agent.CommonContainerConfig.ports:type_name -> agent.Port
-	11, // 48: agent.CommonContainerConfig.portRanges:type_name -> agent.PortRangeBinding
-	12, // 49: agent.CommonContainerConfig.volumes:type_name -> agent.Volume
-	42, // 50: agent.CommonContainerConfig.environment:type_name -> agent.CommonContainerConfig.EnvironmentEntry
-	43, // 51: agent.CommonContainerConfig.secrets:type_name -> agent.CommonContainerConfig.SecretsEntry
-	14, // 52: agent.CommonContainerConfig.initContainers:type_name -> agent.InitContainer
-	22, // 53: agent.DeployWorkloadRequest.common:type_name -> agent.CommonContainerConfig
-	20, // 54: agent.DeployWorkloadRequest.dagent:type_name -> agent.DagentContainerConfig
-	21, // 55: agent.DeployWorkloadRequest.crane:type_name -> agent.CraneContainerConfig
-	8,  // 56: agent.DeployWorkloadRequest.registryAuth:type_name -> agent.RegistryAuth
-	58, // 57: agent.ContainerLogRequest.container:type_name -> common.ContainerIdentifier
-	58, // 58: agent.ContainerInspectRequest.container:type_name -> common.ContainerIdentifier
-	0,  // 59: agent.CloseConnectionRequest.reason:type_name -> agent.CloseReason
-	1,  // 60: agent.Agent.Connect:input_type -> agent.AgentInfo
-	4,  // 61: agent.Agent.CommandError:input_type -> agent.AgentCommandError
-	29, // 62: agent.Agent.AbortUpdate:input_type -> agent.AgentAbortUpdate
-	59, // 63: agent.Agent.TokenReplaced:input_type -> common.Empty
-	60, // 64: agent.Agent.DeploymentStatus:input_type -> common.DeploymentStatusMessage
-	61, // 65: agent.Agent.ContainerState:input_type -> common.ContainerStateListMessage
-	62, // 66: agent.Agent.ContainerLogStream:input_type -> common.ContainerLogMessage
-	63, // 67: agent.Agent.SecretList:input_type -> common.ListSecretsResponse
-	59, // 68: agent.Agent.DeleteContainers:input_type -> common.Empty
-	64, // 69: agent.Agent.ContainerLog:input_type -> common.ContainerLogListResponse
-	65, // 70: agent.Agent.ContainerInspect:input_type -> common.ContainerInspectResponse
-	2,  // 71: agent.Agent.Connect:output_type -> agent.AgentCommand
-	59, // 72: agent.Agent.CommandError:output_type -> common.Empty
-	59, // 73: agent.Agent.AbortUpdate:output_type -> common.Empty
-	59, // 74: agent.Agent.TokenReplaced:output_type -> common.Empty
-	59, // 75: agent.Agent.DeploymentStatus:output_type -> common.Empty
-	59, // 76: agent.Agent.ContainerState:output_type -> common.Empty
-	59, // 77: agent.Agent.ContainerLogStream:output_type -> common.Empty
-	59, // 78: agent.Agent.SecretList:output_type -> common.Empty
-	59, // 79: agent.Agent.DeleteContainers:output_type -> common.Empty
-	59, // 80: agent.Agent.ContainerLog:output_type -> common.Empty
-	59, // 81: agent.Agent.ContainerInspect:output_type -> common.Empty
-	71, // [71:82] is the sub-list for method output_type
-	60, // [60:71] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
-}
-
-func init() { file_protobuf_proto_agent_proto_init() }
-func file_protobuf_proto_agent_proto_init() {
-	if File_protobuf_proto_agent_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_protobuf_proto_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentInfo); i {
+		file_protobuf_proto_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentFieldChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3143,8 +9394,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentCommand); i {
+		file_protobuf_proto_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3155,8 +9406,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentError); i {
+		file_protobuf_proto_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentDiffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3167,8 +9418,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentCommandError); i {
+		file_protobuf_proto_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3179,8 +9430,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployResponse); i {
+		file_protobuf_proto_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3191,8 +9442,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3203,8 +9454,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSecretsRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3215,8 +9466,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryAuth); i {
+		file_protobuf_proto_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneCandidate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3227,8 +9478,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Port); i {
+		file_protobuf_proto_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3239,8 +9490,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortRange); i {
+		file_protobuf_proto_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3251,8 +9502,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortRangeBinding); i {
+		file_protobuf_proto_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTotal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3263,8 +9514,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Volume); i {
+		file_protobuf_proto_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyTraffic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3275,8 +9526,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VolumeLink); i {
+		file_protobuf_proto_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefixTraffic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3287,8 +9538,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitContainer); i {
+		file_protobuf_proto_agent_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3299,8 +9550,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportContainer); i {
+		file_protobuf_proto_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngressConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3311,8 +9562,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogConfig); i {
+		file_protobuf_proto_agent_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngressEntrypoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3323,8 +9574,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Marker); i {
+		file_protobuf_proto_agent_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngressConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3335,8 +9586,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metrics); i {
+		file_protobuf_proto_agent_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackToRevisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3347,8 +9598,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpectedState); i {
+		file_protobuf_proto_agent_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3359,8 +9610,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DagentContainerConfig); i {
+		file_protobuf_proto_agent_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackToRevisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3371,8 +9622,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CraneContainerConfig); i {
+		file_protobuf_proto_agent_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRecommendationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3383,8 +9634,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommonContainerConfig); i {
+		file_protobuf_proto_agent_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRecommendation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3395,8 +9646,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployWorkloadRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRecommendationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3407,8 +9658,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadOperationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3419,8 +9670,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerDeleteRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadOperationMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3431,8 +9682,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployRequestLegacy); i {
+		file_protobuf_proto_agent_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugContainerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3443,8 +9694,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentUpdateRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +9706,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplaceTokenRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugSessionStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +9718,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentAbortUpdate); i {
+		file_protobuf_proto_agent_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugSessionEnded); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +9730,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerLogRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugSessionInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +9742,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerInspectRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugSessionOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,7 +9754,7 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_proto_agent_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseConnectionRequest); i {
 			case 0:
 				return &v.state
@@ -3515,9 +9766,69 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
+		file_protobuf_proto_agent_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheInvalidationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerCheckpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerCheckpointResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerRestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerRestoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_protobuf_proto_agent_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_protobuf_proto_agent_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_protobuf_proto_agent_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*AgentCommand_Deploy)(nil),
 		(*AgentCommand_ContainerState)(nil),
 		(*AgentCommand_ContainerDelete)(nil),
@@ -3530,29 +9841,97 @@ func file_protobuf_proto_agent_proto_init() {
 		(*AgentCommand_ContainerLog)(nil),
 		(*AgentCommand_ReplaceToken)(nil),
 		(*AgentCommand_ContainerInspect)(nil),
-	}
-	file_protobuf_proto_agent_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*AgentCommand_DeploymentDiff)(nil),
+		(*AgentCommand_VolumeUsage)(nil),
+		(*AgentCommand_RollbackToRevision)(nil),
+		(*AgentCommand_ResourceRecommendation)(nil),
+		(*AgentCommand_WorkloadOperation)(nil),
+		(*AgentCommand_ContainerCheckpoint)(nil),
+		(*AgentCommand_ContainerRestore)(nil),
+		(*AgentCommand_PruneReport)(nil),
+		(*AgentCommand_TrafficUsage)(nil),
+		(*AgentCommand_DebugContainer)(nil),
+		(*AgentCommand_IngressConfig)(nil),
+		(*AgentCommand_InvalidateCache)(nil),
+	}
+	file_protobuf_proto_agent_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*AgentCommandError_ListSecrets)(nil),
 		(*AgentCommandError_DeleteContainers)(nil),
 		(*AgentCommandError_ContainerLog)(nil),
 		(*AgentCommandError_ContainerInspect)(nil),
-	}
-	file_protobuf_proto_agent_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_protobuf_proto_agent_proto_msgTypes[11].OneofWrappers = []interface{}{}
+		(*AgentCommandError_DeploymentDiff)(nil),
+		(*AgentCommandError_VolumeUsage)(nil),
+		(*AgentCommandError_RollbackToRevision)(nil),
+		(*AgentCommandError_ResourceRecommendation)(nil),
+		(*AgentCommandError_WorkloadOperation)(nil),
+		(*AgentCommandError_ContainerCheckpoint)(nil),
+		(*AgentCommandError_ContainerRestore)(nil),
+		(*AgentCommandError_PruneReport)(nil),
+		(*AgentCommandError_TrafficUsage)(nil),
+		(*AgentCommandError_DebugContainer)(nil),
+		(*AgentCommandError_IngressConfig)(nil),
+		(*AgentCommandError_Deploy)(nil),
+		(*AgentCommandError_DeleteContainer)(nil),
+		(*AgentCommandError_ContainerCommand)(nil),
+		(*AgentCommandError_ContainerState)(nil),
+	}
+	file_protobuf_proto_agent_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	file_protobuf_proto_agent_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	file_protobuf_proto_agent_proto_msgTypes[18].OneofWrappers = []interface{}{}
-	file_protobuf_proto_agent_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	file_protobuf_proto_agent_proto_msgTypes[20].OneofWrappers = []interface{}{}
-	file_protobuf_proto_agent_proto_msgTypes[21].OneofWrappers = []interface{}{}
-	file_protobuf_proto_agent_proto_msgTypes[22].OneofWrappers = []interface{}{}
-	file_protobuf_proto_agent_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[59].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[74].OneofWrappers = []interface{}{
+		(*DebugSessionInput_Stdin)(nil),
+		(*DebugSessionInput_Resize)(nil),
+		(*DebugSessionInput_CloseStdin)(nil),
+	}
+	file_protobuf_proto_agent_proto_msgTypes[75].OneofWrappers = []interface{}{
+		(*DebugSessionOutput_Started)(nil),
+		(*DebugSessionOutput_Stdout)(nil),
+		(*DebugSessionOutput_Stderr)(nil),
+		(*DebugSessionOutput_Ended)(nil),
+	}
+	file_protobuf_proto_agent_proto_msgTypes[77].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[78].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[79].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[80].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_proto_agent_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   43,
+			NumEnums:      8,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
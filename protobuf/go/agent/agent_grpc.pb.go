@@ -38,11 +38,26 @@ type AgentClient interface {
 	DeploymentStatus(ctx context.Context, opts ...grpc.CallOption) (Agent_DeploymentStatusClient, error)
 	ContainerState(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerStateClient, error)
 	ContainerLogStream(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogStreamClient, error)
+	WorkloadOperation(ctx context.Context, opts ...grpc.CallOption) (Agent_WorkloadOperationClient, error)
+	DebugSession(ctx context.Context, opts ...grpc.CallOption) (Agent_DebugSessionClient, error)
 	// one-shot requests
 	SecretList(ctx context.Context, in *common.ListSecretsResponse, opts ...grpc.CallOption) (*common.Empty, error)
 	DeleteContainers(ctx context.Context, in *common.Empty, opts ...grpc.CallOption) (*common.Empty, error)
 	ContainerLog(ctx context.Context, in *common.ContainerLogListResponse, opts ...grpc.CallOption) (*common.Empty, error)
 	ContainerInspect(ctx context.Context, in *common.ContainerInspectResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	DeploymentDiff(ctx context.Context, in *DeploymentDiffResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	VolumeUsage(ctx context.Context, in *VolumeUsageResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	PruneReport(ctx context.Context, in *PruneReportResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	TrafficUsage(ctx context.Context, in *TrafficUsageResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	IngressConfig(ctx context.Context, in *IngressConfigResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	RollbackToRevision(ctx context.Context, in *RollbackToRevisionResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	ResourceRecommendation(ctx context.Context, in *ResourceRecommendationResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	// experimental, only requested from the agents with the capability
+	ContainerCheckpoint(ctx context.Context, in *ContainerCheckpointResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	ContainerRestore(ctx context.Context, in *ContainerRestoreResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	// periodic reports
+	ClusterCapabilities(ctx context.Context, in *ClusterCapabilitiesMessage, opts ...grpc.CallOption) (*common.Empty, error)
+	HostMetrics(ctx context.Context, opts ...grpc.CallOption) (Agent_HostMetricsClient, error)
 }
 
 type agentClient struct {
@@ -214,6 +229,71 @@ func (x *agentContainerLogStreamClient) CloseAndRecv() (*common.Empty, error) {
 	return m, nil
 }
 
+func (c *agentClient) WorkloadOperation(ctx context.Context, opts ...grpc.CallOption) (Agent_WorkloadOperationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[4], "/agent.Agent/WorkloadOperation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentWorkloadOperationClient{stream}
+	return x, nil
+}
+
+type Agent_WorkloadOperationClient interface {
+	Send(*WorkloadOperationMessage) error
+	CloseAndRecv() (*common.Empty, error)
+	grpc.ClientStream
+}
+
+type agentWorkloadOperationClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentWorkloadOperationClient) Send(m *WorkloadOperationMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentWorkloadOperationClient) CloseAndRecv() (*common.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(common.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) DebugSession(ctx context.Context, opts ...grpc.CallOption) (Agent_DebugSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[5], "/agent.Agent/DebugSession", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentDebugSessionClient{stream}
+	return x, nil
+}
+
+type Agent_DebugSessionClient interface {
+	Send(*DebugSessionOutput) error
+	Recv() (*DebugSessionInput, error)
+	grpc.ClientStream
+}
+
+type agentDebugSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentDebugSessionClient) Send(m *DebugSessionOutput) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentDebugSessionClient) Recv() (*DebugSessionInput, error) {
+	m := new(DebugSessionInput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *agentClient) SecretList(ctx context.Context, in *common.ListSecretsResponse, opts ...grpc.CallOption) (*common.Empty, error) {
 	out := new(common.Empty)
 	err := c.cc.Invoke(ctx, "/agent.Agent/SecretList", in, out, opts...)
@@ -250,6 +330,130 @@ func (c *agentClient) ContainerInspect(ctx context.Context, in *common.Container
 	return out, nil
 }
 
+func (c *agentClient) DeploymentDiff(ctx context.Context, in *DeploymentDiffResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/DeploymentDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) VolumeUsage(ctx context.Context, in *VolumeUsageResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/VolumeUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) PruneReport(ctx context.Context, in *PruneReportResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/PruneReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) TrafficUsage(ctx context.Context, in *TrafficUsageResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/TrafficUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) IngressConfig(ctx context.Context, in *IngressConfigResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/IngressConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) RollbackToRevision(ctx context.Context, in *RollbackToRevisionResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/RollbackToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ResourceRecommendation(ctx context.Context, in *ResourceRecommendationResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ResourceRecommendation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ContainerCheckpoint(ctx context.Context, in *ContainerCheckpointResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ContainerCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ContainerRestore(ctx context.Context, in *ContainerRestoreResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ContainerRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ClusterCapabilities(ctx context.Context, in *ClusterCapabilitiesMessage, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ClusterCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) HostMetrics(ctx context.Context, opts ...grpc.CallOption) (Agent_HostMetricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[6], "/agent.Agent/HostMetrics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentHostMetricsClient{stream}
+	return x, nil
+}
+
+type Agent_HostMetricsClient interface {
+	Send(*HostMetricsMessage) error
+	CloseAndRecv() (*common.Empty, error)
+	grpc.ClientStream
+}
+
+type agentHostMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentHostMetricsClient) Send(m *HostMetricsMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentHostMetricsClient) CloseAndRecv() (*common.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(common.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -269,11 +473,26 @@ type AgentServer interface {
 	DeploymentStatus(Agent_DeploymentStatusServer) error
 	ContainerState(Agent_ContainerStateServer) error
 	ContainerLogStream(Agent_ContainerLogStreamServer) error
+	WorkloadOperation(Agent_WorkloadOperationServer) error
+	DebugSession(Agent_DebugSessionServer) error
 	// one-shot requests
 	SecretList(context.Context, *common.ListSecretsResponse) (*common.Empty, error)
 	DeleteContainers(context.Context, *common.Empty) (*common.Empty, error)
 	ContainerLog(context.Context, *common.ContainerLogListResponse) (*common.Empty, error)
 	ContainerInspect(context.Context, *common.ContainerInspectResponse) (*common.Empty, error)
+	DeploymentDiff(context.Context, *DeploymentDiffResponse) (*common.Empty, error)
+	VolumeUsage(context.Context, *VolumeUsageResponse) (*common.Empty, error)
+	PruneReport(context.Context, *PruneReportResponse) (*common.Empty, error)
+	TrafficUsage(context.Context, *TrafficUsageResponse) (*common.Empty, error)
+	IngressConfig(context.Context, *IngressConfigResponse) (*common.Empty, error)
+	RollbackToRevision(context.Context, *RollbackToRevisionResponse) (*common.Empty, error)
+	ResourceRecommendation(context.Context, *ResourceRecommendationResponse) (*common.Empty, error)
+	// experimental, only requested from the agents with the capability
+	ContainerCheckpoint(context.Context, *ContainerCheckpointResponse) (*common.Empty, error)
+	ContainerRestore(context.Context, *ContainerRestoreResponse) (*common.Empty, error)
+	// periodic reports
+	ClusterCapabilities(context.Context, *ClusterCapabilitiesMessage) (*common.Empty, error)
+	HostMetrics(Agent_HostMetricsServer) error
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -302,6 +521,12 @@ func (UnimplementedAgentServer) ContainerState(Agent_ContainerStateServer) error
 func (UnimplementedAgentServer) ContainerLogStream(Agent_ContainerLogStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method ContainerLogStream not implemented")
 }
+func (UnimplementedAgentServer) WorkloadOperation(Agent_WorkloadOperationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WorkloadOperation not implemented")
+}
+func (UnimplementedAgentServer) DebugSession(Agent_DebugSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method DebugSession not implemented")
+}
 func (UnimplementedAgentServer) SecretList(context.Context, *common.ListSecretsResponse) (*common.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SecretList not implemented")
 }
@@ -314,6 +539,39 @@ func (UnimplementedAgentServer) ContainerLog(context.Context, *common.ContainerL
 func (UnimplementedAgentServer) ContainerInspect(context.Context, *common.ContainerInspectResponse) (*common.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContainerInspect not implemented")
 }
+func (UnimplementedAgentServer) DeploymentDiff(context.Context, *DeploymentDiffResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentDiff not implemented")
+}
+func (UnimplementedAgentServer) VolumeUsage(context.Context, *VolumeUsageResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeUsage not implemented")
+}
+func (UnimplementedAgentServer) PruneReport(context.Context, *PruneReportResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneReport not implemented")
+}
+func (UnimplementedAgentServer) TrafficUsage(context.Context, *TrafficUsageResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrafficUsage not implemented")
+}
+func (UnimplementedAgentServer) IngressConfig(context.Context, *IngressConfigResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IngressConfig not implemented")
+}
+func (UnimplementedAgentServer) RollbackToRevision(context.Context, *RollbackToRevisionResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackToRevision not implemented")
+}
+func (UnimplementedAgentServer) ResourceRecommendation(context.Context, *ResourceRecommendationResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceRecommendation not implemented")
+}
+func (UnimplementedAgentServer) ContainerCheckpoint(context.Context, *ContainerCheckpointResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerCheckpoint not implemented")
+}
+func (UnimplementedAgentServer) ContainerRestore(context.Context, *ContainerRestoreResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerRestore not implemented")
+}
+func (UnimplementedAgentServer) ClusterCapabilities(context.Context, *ClusterCapabilitiesMessage) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterCapabilities not implemented")
+}
+func (UnimplementedAgentServer) HostMetrics(Agent_HostMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method HostMetrics not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -480,6 +738,58 @@ func (x *agentContainerLogStreamServer) Recv() (*common.ContainerLogMessage, err
 	return m, nil
 }
 
+func _Agent_WorkloadOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).WorkloadOperation(&agentWorkloadOperationServer{stream})
+}
+
+type Agent_WorkloadOperationServer interface {
+	SendAndClose(*common.Empty) error
+	Recv() (*WorkloadOperationMessage, error)
+	grpc.ServerStream
+}
+
+type agentWorkloadOperationServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentWorkloadOperationServer) SendAndClose(m *common.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentWorkloadOperationServer) Recv() (*WorkloadOperationMessage, error) {
+	m := new(WorkloadOperationMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_DebugSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).DebugSession(&agentDebugSessionServer{stream})
+}
+
+type Agent_DebugSessionServer interface {
+	Send(*DebugSessionInput) error
+	Recv() (*DebugSessionOutput, error)
+	grpc.ServerStream
+}
+
+type agentDebugSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentDebugSessionServer) Send(m *DebugSessionInput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentDebugSessionServer) Recv() (*DebugSessionOutput, error) {
+	m := new(DebugSessionOutput)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Agent_SecretList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.ListSecretsResponse)
 	if err := dec(in); err != nil {
@@ -552,6 +862,212 @@ func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_DeploymentDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentDiffResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).DeploymentDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/DeploymentDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).DeploymentDiff(ctx, req.(*DeploymentDiffResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_VolumeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeUsageResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).VolumeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/VolumeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).VolumeUsage(ctx, req.(*VolumeUsageResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_PruneReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneReportResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).PruneReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/PruneReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).PruneReport(ctx, req.(*PruneReportResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_TrafficUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficUsageResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).TrafficUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/TrafficUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).TrafficUsage(ctx, req.(*TrafficUsageResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_IngressConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngressConfigResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).IngressConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/IngressConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).IngressConfig(ctx, req.(*IngressConfigResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_RollbackToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackToRevisionResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).RollbackToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/RollbackToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).RollbackToRevision(ctx, req.(*RollbackToRevisionResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ResourceRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceRecommendationResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ResourceRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ResourceRecommendation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ResourceRecommendation(ctx, req.(*ResourceRecommendationResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ContainerCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCheckpointResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ContainerCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ContainerCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ContainerCheckpoint(ctx, req.(*ContainerCheckpointResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ContainerRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRestoreResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ContainerRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ContainerRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ContainerRestore(ctx, req.(*ContainerRestoreResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ClusterCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterCapabilitiesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ClusterCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ClusterCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ClusterCapabilities(ctx, req.(*ClusterCapabilitiesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_HostMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).HostMetrics(&agentHostMetricsServer{stream})
+}
+
+type Agent_HostMetricsServer interface {
+	SendAndClose(*common.Empty) error
+	Recv() (*HostMetricsMessage, error)
+	grpc.ServerStream
+}
+
+type agentHostMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentHostMetricsServer) SendAndClose(m *common.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentHostMetricsServer) Recv() (*HostMetricsMessage, error) {
+	m := new(HostMetricsMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -587,6 +1103,46 @@ func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec f
 			MethodName: "ContainerInspect",
 			Handler:    _Agent_ContainerInspect_Handler,
 		},
+		{
+			MethodName: "DeploymentDiff",
+			Handler:    _Agent_DeploymentDiff_Handler,
+		},
+		{
+			MethodName: "VolumeUsage",
+			Handler:    _Agent_VolumeUsage_Handler,
+		},
+		{
+			MethodName: "PruneReport",
+			Handler:    _Agent_PruneReport_Handler,
+		},
+		{
+			MethodName: "TrafficUsage",
+			Handler:    _Agent_TrafficUsage_Handler,
+		},
+		{
+			MethodName: "IngressConfig",
+			Handler:    _Agent_IngressConfig_Handler,
+		},
+		{
+			MethodName: "RollbackToRevision",
+			Handler:    _Agent_RollbackToRevision_Handler,
+		},
+		{
+			MethodName: "ResourceRecommendation",
+			Handler:    _Agent_ResourceRecommendation_Handler,
+		},
+		{
+			MethodName: "ContainerCheckpoint",
+			Handler:    _Agent_ContainerCheckpoint_Handler,
+		},
+		{
+			MethodName: "ContainerRestore",
+			Handler:    _Agent_ContainerRestore_Handler,
+		},
+		{
+			MethodName: "ClusterCapabilities",
+			Handler:    _Agent_ClusterCapabilities_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -609,6 +1165,22 @@ func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec f
 			Handler:       _Agent_ContainerLogStream_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WorkloadOperation",
+			Handler:       _Agent_WorkloadOperation_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DebugSession",
+			Handler:       _Agent_DebugSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "HostMetrics",
+			Handler:       _Agent_HostMetrics_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "protobuf/proto/agent.proto",
 }
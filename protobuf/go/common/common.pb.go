@@ -77,6 +77,114 @@ func (ContainerState) EnumDescriptor() ([]byte, []int) {
 	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{0}
 }
 
+// Readiness of a running container, reported by the docker health check
+// or the kubernetes readiness and startup probes
+type ContainerHealth int32
+
+const (
+	ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED ContainerHealth = 0
+	ContainerHealth_HEALTH_STARTING              ContainerHealth = 1
+	ContainerHealth_HEALTHY                      ContainerHealth = 2
+	ContainerHealth_UNHEALTHY                    ContainerHealth = 3
+)
+
+// Enum value maps for ContainerHealth.
+var (
+	ContainerHealth_name = map[int32]string{
+		0: "CONTAINER_HEALTH_UNSPECIFIED",
+		1: "HEALTH_STARTING",
+		2: "HEALTHY",
+		3: "UNHEALTHY",
+	}
+	ContainerHealth_value = map[string]int32{
+		"CONTAINER_HEALTH_UNSPECIFIED": 0,
+		"HEALTH_STARTING":              1,
+		"HEALTHY":                      2,
+		"UNHEALTHY":                    3,
+	}
+)
+
+func (x ContainerHealth) Enum() *ContainerHealth {
+	p := new(ContainerHealth)
+	*p = x
+	return p
+}
+
+func (x ContainerHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_common_proto_enumTypes[1].Descriptor()
+}
+
+func (ContainerHealth) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_common_proto_enumTypes[1]
+}
+
+func (x ContainerHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerHealth.Descriptor instead.
+func (ContainerHealth) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{1}
+}
+
+type RestartBudgetState int32
+
+const (
+	RestartBudgetState_RESTART_BUDGET_STATE_UNSPECIFIED RestartBudgetState = 0
+	RestartBudgetState_WITHIN_BUDGET                    RestartBudgetState = 1
+	// The restarts are delayed, the container is waiting for the next one
+	RestartBudgetState_BACKING_OFF RestartBudgetState = 2
+	// The container is left stopped, its restart policy is disabled
+	RestartBudgetState_GAVE_UP RestartBudgetState = 3
+)
+
+// Enum value maps for RestartBudgetState.
+var (
+	RestartBudgetState_name = map[int32]string{
+		0: "RESTART_BUDGET_STATE_UNSPECIFIED",
+		1: "WITHIN_BUDGET",
+		2: "BACKING_OFF",
+		3: "GAVE_UP",
+	}
+	RestartBudgetState_value = map[string]int32{
+		"RESTART_BUDGET_STATE_UNSPECIFIED": 0,
+		"WITHIN_BUDGET":                    1,
+		"BACKING_OFF":                      2,
+		"GAVE_UP":                          3,
+	}
+)
+
+func (x RestartBudgetState) Enum() *RestartBudgetState {
+	p := new(RestartBudgetState)
+	*p = x
+	return p
+}
+
+func (x RestartBudgetState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartBudgetState) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_common_proto_enumTypes[2].Descriptor()
+}
+
+func (RestartBudgetState) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_common_proto_enumTypes[2]
+}
+
+func (x RestartBudgetState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartBudgetState.Descriptor instead.
+func (RestartBudgetState) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{2}
+}
+
 type DeploymentStatus int32
 
 const (
@@ -122,11 +230,11 @@ func (x DeploymentStatus) String() string {
 }
 
 func (DeploymentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[1].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[3].Descriptor()
 }
 
 func (DeploymentStatus) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[1]
+	return &file_protobuf_proto_common_proto_enumTypes[3]
 }
 
 func (x DeploymentStatus) Number() protoreflect.EnumNumber {
@@ -135,7 +243,7 @@ func (x DeploymentStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeploymentStatus.Descriptor instead.
 func (DeploymentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{1}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{3}
 }
 
 type DeploymentMessageLevel int32
@@ -174,11 +282,11 @@ func (x DeploymentMessageLevel) String() string {
 }
 
 func (DeploymentMessageLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[2].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[4].Descriptor()
 }
 
 func (DeploymentMessageLevel) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[2]
+	return &file_protobuf_proto_common_proto_enumTypes[4]
 }
 
 func (x DeploymentMessageLevel) Number() protoreflect.EnumNumber {
@@ -187,7 +295,7 @@ func (x DeploymentMessageLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeploymentMessageLevel.Descriptor instead.
 func (DeploymentMessageLevel) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{2}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{4}
 }
 
 type NetworkMode int32
@@ -226,11 +334,11 @@ func (x NetworkMode) String() string {
 }
 
 func (NetworkMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[3].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[5].Descriptor()
 }
 
 func (NetworkMode) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[3]
+	return &file_protobuf_proto_common_proto_enumTypes[5]
 }
 
 func (x NetworkMode) Number() protoreflect.EnumNumber {
@@ -239,7 +347,7 @@ func (x NetworkMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkMode.Descriptor instead.
 func (NetworkMode) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{3}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{5}
 }
 
 type RestartPolicy int32
@@ -284,11 +392,11 @@ func (x RestartPolicy) String() string {
 }
 
 func (RestartPolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[4].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[6].Descriptor()
 }
 
 func (RestartPolicy) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[4]
+	return &file_protobuf_proto_common_proto_enumTypes[6]
 }
 
 func (x RestartPolicy) Number() protoreflect.EnumNumber {
@@ -297,7 +405,7 @@ func (x RestartPolicy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RestartPolicy.Descriptor instead.
 func (RestartPolicy) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{4}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{6}
 }
 
 type DeploymentStrategy int32
@@ -333,11 +441,11 @@ func (x DeploymentStrategy) String() string {
 }
 
 func (DeploymentStrategy) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[5].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[7].Descriptor()
 }
 
 func (DeploymentStrategy) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[5]
+	return &file_protobuf_proto_common_proto_enumTypes[7]
 }
 
 func (x DeploymentStrategy) Number() protoreflect.EnumNumber {
@@ -346,7 +454,7 @@ func (x DeploymentStrategy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeploymentStrategy.Descriptor instead.
 func (DeploymentStrategy) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{5}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{7}
 }
 
 type VolumeType int32
@@ -394,11 +502,11 @@ func (x VolumeType) String() string {
 }
 
 func (VolumeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[6].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[8].Descriptor()
 }
 
 func (VolumeType) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[6]
+	return &file_protobuf_proto_common_proto_enumTypes[8]
 }
 
 func (x VolumeType) Number() protoreflect.EnumNumber {
@@ -407,7 +515,7 @@ func (x VolumeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VolumeType.Descriptor instead.
 func (VolumeType) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{6}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{8}
 }
 
 type DriverType int32
@@ -476,11 +584,11 @@ func (x DriverType) String() string {
 }
 
 func (DriverType) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[7].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[9].Descriptor()
 }
 
 func (DriverType) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[7]
+	return &file_protobuf_proto_common_proto_enumTypes[9]
 }
 
 func (x DriverType) Number() protoreflect.EnumNumber {
@@ -489,7 +597,7 @@ func (x DriverType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DriverType.Descriptor instead.
 func (DriverType) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{7}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{9}
 }
 
 type ExposeStrategy int32
@@ -528,11 +636,11 @@ func (x ExposeStrategy) String() string {
 }
 
 func (ExposeStrategy) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[8].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[10].Descriptor()
 }
 
 func (ExposeStrategy) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[8]
+	return &file_protobuf_proto_common_proto_enumTypes[10]
 }
 
 func (x ExposeStrategy) Number() protoreflect.EnumNumber {
@@ -541,7 +649,7 @@ func (x ExposeStrategy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExposeStrategy.Descriptor instead.
 func (ExposeStrategy) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{8}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{10}
 }
 
 type ContainerOperation int32
@@ -580,11 +688,11 @@ func (x ContainerOperation) String() string {
 }
 
 func (ContainerOperation) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_common_proto_enumTypes[9].Descriptor()
+	return file_protobuf_proto_common_proto_enumTypes[11].Descriptor()
 }
 
 func (ContainerOperation) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_common_proto_enumTypes[9]
+	return &file_protobuf_proto_common_proto_enumTypes[11]
 }
 
 func (x ContainerOperation) Number() protoreflect.EnumNumber {
@@ -593,7 +701,7 @@ func (x ContainerOperation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContainerOperation.Descriptor instead.
 func (ContainerOperation) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{9}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{11}
 }
 
 type Empty struct {
@@ -642,6 +750,8 @@ type InstanceDeploymentItem struct {
 	InstanceId string         `protobuf:"bytes,100,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
 	State      ContainerState `protobuf:"varint,101,opt,name=state,proto3,enum=common.ContainerState" json:"state,omitempty"`
 	Reason     string         `protobuf:"bytes,102,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the effective entrypoint and args of the started container
+	Command []string `protobuf:"bytes,1000,rep,name=command,proto3" json:"command,omitempty"`
 }
 
 func (x *InstanceDeploymentItem) Reset() {
@@ -697,6 +807,13 @@ func (x *InstanceDeploymentItem) GetReason() string {
 	return ""
 }
 
+func (x *InstanceDeploymentItem) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
 type DeployContainerProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -773,6 +890,8 @@ type DeploymentStatusMessage struct {
 	Data     isDeploymentStatusMessage_Data `protobuf_oneof:"data"`
 	Log      []string                       `protobuf:"bytes,1000,rep,name=log,proto3" json:"log,omitempty"`
 	LogLevel *DeploymentMessageLevel        `protobuf:"varint,1001,opt,name=logLevel,proto3,enum=common.DeploymentMessageLevel,oneof" json:"logLevel,omitempty"`
+	// the node reporting the status, so the ones of a node group can be told apart
+	NodeId *string `protobuf:"bytes,1002,opt,name=nodeId,proto3,oneof" json:"nodeId,omitempty"`
 }
 
 func (x *DeploymentStatusMessage) Reset() {
@@ -849,6 +968,13 @@ func (x *DeploymentStatusMessage) GetLogLevel() DeploymentMessageLevel {
 	return DeploymentMessageLevel_DEPLOYMENT_MESSAGE_UNSPECIFIED
 }
 
+func (x *DeploymentStatusMessage) GetNodeId() string {
+	if x != nil && x.NodeId != nil {
+		return *x.NodeId
+	}
+	return ""
+}
+
 type isDeploymentStatusMessage_Data interface {
 	isDeploymentStatusMessage_Data()
 }
@@ -876,8 +1002,10 @@ type ContainerStateItemPort struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Internal int32 `protobuf:"varint,100,opt,name=internal,proto3" json:"internal,omitempty"`
-	External int32 `protobuf:"varint,101,opt,name=external,proto3" json:"external,omitempty"`
+	Internal int32   `protobuf:"varint,100,opt,name=internal,proto3" json:"internal,omitempty"`
+	External int32   `protobuf:"varint,101,opt,name=external,proto3" json:"external,omitempty"`
+	HostIp   *string `protobuf:"bytes,102,opt,name=hostIp,proto3,oneof" json:"hostIp,omitempty"`
+	Protocol *string `protobuf:"bytes,103,opt,name=protocol,proto3,oneof" json:"protocol,omitempty"`
 }
 
 func (x *ContainerStateItemPort) Reset() {
@@ -926,17 +1054,35 @@ func (x *ContainerStateItemPort) GetExternal() int32 {
 	return 0
 }
 
-type ContainerStateListMessage struct {
+func (x *ContainerStateItemPort) GetHostIp() string {
+	if x != nil && x.HostIp != nil {
+		return *x.HostIp
+	}
+	return ""
+}
+
+func (x *ContainerStateItemPort) GetProtocol() string {
+	if x != nil && x.Protocol != nil {
+		return *x.Protocol
+	}
+	return ""
+}
+
+type ContainerStateItemNetwork struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prefix *string               `protobuf:"bytes,100,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
-	Data   []*ContainerStateItem `protobuf:"bytes,1000,rep,name=data,proto3" json:"data,omitempty"`
+	Name        string   `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	IpAddress   string   `protobuf:"bytes,101,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	Ipv6Address *string  `protobuf:"bytes,102,opt,name=ipv6Address,proto3,oneof" json:"ipv6Address,omitempty"`
+	Gateway     *string  `protobuf:"bytes,103,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`
+	MacAddress  *string  `protobuf:"bytes,104,opt,name=macAddress,proto3,oneof" json:"macAddress,omitempty"`
+	Aliases     []string `protobuf:"bytes,1000,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
-func (x *ContainerStateListMessage) Reset() {
-	*x = ContainerStateListMessage{}
+func (x *ContainerStateItemNetwork) Reset() {
+	*x = ContainerStateItemNetwork{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_proto_common_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -944,13 +1090,13 @@ func (x *ContainerStateListMessage) Reset() {
 	}
 }
 
-func (x *ContainerStateListMessage) String() string {
+func (x *ContainerStateItemNetwork) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStateListMessage) ProtoMessage() {}
+func (*ContainerStateItemNetwork) ProtoMessage() {}
 
-func (x *ContainerStateListMessage) ProtoReflect() protoreflect.Message {
+func (x *ContainerStateItemNetwork) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_proto_common_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -962,49 +1108,68 @@ func (x *ContainerStateListMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStateListMessage.ProtoReflect.Descriptor instead.
-func (*ContainerStateListMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerStateItemNetwork.ProtoReflect.Descriptor instead.
+func (*ContainerStateItemNetwork) Descriptor() ([]byte, []int) {
 	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ContainerStateListMessage) GetPrefix() string {
-	if x != nil && x.Prefix != nil {
-		return *x.Prefix
+func (x *ContainerStateItemNetwork) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerStateListMessage) GetData() []*ContainerStateItem {
+func (x *ContainerStateItemNetwork) GetIpAddress() string {
 	if x != nil {
-		return x.Data
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ContainerStateItemNetwork) GetIpv6Address() string {
+	if x != nil && x.Ipv6Address != nil {
+		return *x.Ipv6Address
+	}
+	return ""
+}
+
+func (x *ContainerStateItemNetwork) GetGateway() string {
+	if x != nil && x.Gateway != nil {
+		return *x.Gateway
+	}
+	return ""
+}
+
+func (x *ContainerStateItemNetwork) GetMacAddress() string {
+	if x != nil && x.MacAddress != nil {
+		return *x.MacAddress
+	}
+	return ""
+}
+
+func (x *ContainerStateItemNetwork) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
 	}
 	return nil
 }
 
-type ContainerStateItem struct {
+type ContainerStateListMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        *ContainerIdentifier   `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Command   string                 `protobuf:"bytes,101,opt,name=command,proto3" json:"command,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	// The 'State' of the container (Created, Running, etc)
-	State ContainerState `protobuf:"varint,103,opt,name=state,proto3,enum=common.ContainerState" json:"state,omitempty"`
-	// The 'reason' behind 'state'.
-	Reason string `protobuf:"bytes,107,opt,name=reason,proto3" json:"reason,omitempty"`
-	// The 'Status' of the container ("Created 1min ago", "Exited with code 123",
-	// etc). Unused but left here for reverse compatibility with the legacy
-	// version.
-	Status    string                    `protobuf:"bytes,104,opt,name=status,proto3" json:"status,omitempty"`
-	ImageName string                    `protobuf:"bytes,105,opt,name=imageName,proto3" json:"imageName,omitempty"`
-	ImageTag  string                    `protobuf:"bytes,106,opt,name=imageTag,proto3" json:"imageTag,omitempty"`
-	Ports     []*ContainerStateItemPort `protobuf:"bytes,1000,rep,name=ports,proto3" json:"ports,omitempty"`
-	Labels    map[string]string         `protobuf:"bytes,1001,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Prefix *string `protobuf:"bytes,100,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	//
+	// Set on a page of a one shot listing if there are more items, the
+	// next page is requested with it
+	NextPageToken *string               `protobuf:"bytes,101,opt,name=nextPageToken,proto3,oneof" json:"nextPageToken,omitempty"`
+	Data          []*ContainerStateItem `protobuf:"bytes,1000,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ContainerStateItem) Reset() {
-	*x = ContainerStateItem{}
+func (x *ContainerStateListMessage) Reset() {
+	*x = ContainerStateListMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_proto_common_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,13 +1177,13 @@ func (x *ContainerStateItem) Reset() {
 	}
 }
 
-func (x *ContainerStateItem) String() string {
+func (x *ContainerStateListMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStateItem) ProtoMessage() {}
+func (*ContainerStateListMessage) ProtoMessage() {}
 
-func (x *ContainerStateItem) ProtoReflect() protoreflect.Message {
+func (x *ContainerStateListMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_proto_common_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1030,106 +1195,123 @@ func (x *ContainerStateItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStateItem.ProtoReflect.Descriptor instead.
-func (*ContainerStateItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerStateListMessage.ProtoReflect.Descriptor instead.
+func (*ContainerStateListMessage) Descriptor() ([]byte, []int) {
 	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ContainerStateItem) GetId() *ContainerIdentifier {
-	if x != nil {
-		return x.Id
+func (x *ContainerStateListMessage) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
 	}
-	return nil
+	return ""
 }
 
-func (x *ContainerStateItem) GetCommand() string {
-	if x != nil {
-		return x.Command
+func (x *ContainerStateListMessage) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ContainerStateItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ContainerStateListMessage) GetData() []*ContainerStateItem {
 	if x != nil {
-		return x.CreatedAt
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ContainerStateItem) GetState() ContainerState {
-	if x != nil {
-		return x.State
-	}
-	return ContainerState_CONTAINER_STATE_UNSPECIFIED
+// Filters of the listings, the unset ones match every item
+type ListFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The items in any of the states
+	States []ContainerState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=common.ContainerState" json:"states,omitempty"`
+	//
+	// The items having every label, an empty value matches every value of
+	// the label
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ContainerStateItem) GetReason() string {
-	if x != nil {
-		return x.Reason
+func (x *ListFilter) Reset() {
+	*x = ListFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ContainerStateItem) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *ListFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ContainerStateItem) GetImageName() string {
-	if x != nil {
-		return x.ImageName
+func (*ListFilter) ProtoMessage() {}
+
+func (x *ListFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerStateItem) GetImageTag() string {
-	if x != nil {
-		return x.ImageTag
-	}
-	return ""
+// Deprecated: Use ListFilter.ProtoReflect.Descriptor instead.
+func (*ListFilter) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ContainerStateItem) GetPorts() []*ContainerStateItemPort {
+func (x *ListFilter) GetStates() []ContainerState {
 	if x != nil {
-		return x.Ports
+		return x.States
 	}
 	return nil
 }
 
-func (x *ContainerStateItem) GetLabels() map[string]string {
+func (x *ListFilter) GetLabels() map[string]string {
 	if x != nil {
 		return x.Labels
 	}
 	return nil
 }
 
-type ContainerLogMessage struct {
+// A page of a listing, the items are ordered by their keys, the token is
+// a cursor, so the items changed between the pages are not repeated or
+// skipped
+type PageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Log string `protobuf:"bytes,100,opt,name=log,proto3" json:"log,omitempty"`
+	// The token of the previous page, the first page if it is not set
+	PageToken *string `protobuf:"bytes,1,opt,name=pageToken,proto3,oneof" json:"pageToken,omitempty"`
+	// The default size of the agent is used if it is zero
+	PageSize uint32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 }
 
-func (x *ContainerLogMessage) Reset() {
-	*x = ContainerLogMessage{}
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[7]
+		mi := &file_protobuf_proto_common_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerLogMessage) String() string {
+func (x *PageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerLogMessage) ProtoMessage() {}
+func (*PageRequest) ProtoMessage() {}
 
-func (x *ContainerLogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[7]
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,9 +1322,317 @@ func (x *ContainerLogMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerLogMessage.ProtoReflect.Descriptor instead.
-func (*ContainerLogMessage) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PageRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *PageRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RestartBudgetStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State RestartBudgetState `protobuf:"varint,100,opt,name=state,proto3,enum=common.RestartBudgetState" json:"state,omitempty"`
+	// Restarts in the window of the budget
+	Restarts      uint32                 `protobuf:"varint,101,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	MaxRestarts   uint32                 `protobuf:"varint,102,opt,name=maxRestarts,proto3" json:"maxRestarts,omitempty"`
+	NextRestartAt *timestamppb.Timestamp `protobuf:"bytes,103,opt,name=nextRestartAt,proto3,oneof" json:"nextRestartAt,omitempty"`
+}
+
+func (x *RestartBudgetStatus) Reset() {
+	*x = RestartBudgetStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestartBudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartBudgetStatus) ProtoMessage() {}
+
+func (x *RestartBudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartBudgetStatus.ProtoReflect.Descriptor instead.
+func (*RestartBudgetStatus) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestartBudgetStatus) GetState() RestartBudgetState {
+	if x != nil {
+		return x.State
+	}
+	return RestartBudgetState_RESTART_BUDGET_STATE_UNSPECIFIED
+}
+
+func (x *RestartBudgetStatus) GetRestarts() uint32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *RestartBudgetStatus) GetMaxRestarts() uint32 {
+	if x != nil {
+		return x.MaxRestarts
+	}
+	return 0
+}
+
+func (x *RestartBudgetStatus) GetNextRestartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRestartAt
+	}
+	return nil
+}
+
+type ContainerStateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *ContainerIdentifier   `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Command   string                 `protobuf:"bytes,101,opt,name=command,proto3" json:"command,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// The 'State' of the container (Created, Running, etc)
+	State ContainerState `protobuf:"varint,103,opt,name=state,proto3,enum=common.ContainerState" json:"state,omitempty"`
+	// The 'reason' behind 'state'.
+	Reason string `protobuf:"bytes,107,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The 'Status' of the container ("Created 1min ago", "Exited with code 123",
+	// etc). Unused but left here for reverse compatibility with the legacy
+	// version.
+	Status    string `protobuf:"bytes,104,opt,name=status,proto3" json:"status,omitempty"`
+	ImageName string `protobuf:"bytes,105,opt,name=imageName,proto3" json:"imageName,omitempty"`
+	ImageTag  string `protobuf:"bytes,106,opt,name=imageTag,proto3" json:"imageTag,omitempty"`
+	// Unspecified if the container has no health check or readiness probe
+	Health ContainerHealth `protobuf:"varint,108,opt,name=health,proto3,enum=common.ContainerHealth" json:"health,omitempty"`
+	//
+	// Restarts by the restart policy or the kubernetes liveness probe, a
+	// growing count means the container keeps failing while it looks running
+	RestartCount int32 `protobuf:"varint,109,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	// The container or its last run was killed for running out of memory
+	OomKilled bool `protobuf:"varint,110,opt,name=oomKilled,proto3" json:"oomKilled,omitempty"`
+	// The time the container entered its current state
+	StateSince *timestamppb.Timestamp `protobuf:"bytes,111,opt,name=stateSince,proto3,oneof" json:"stateSince,omitempty"`
+	// Set if the container has a restart budget
+	RestartBudget *RestartBudgetStatus         `protobuf:"bytes,112,opt,name=restartBudget,proto3,oneof" json:"restartBudget,omitempty"`
+	Ports         []*ContainerStateItemPort    `protobuf:"bytes,1000,rep,name=ports,proto3" json:"ports,omitempty"`
+	Labels        map[string]string            `protobuf:"bytes,1001,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Networks      []*ContainerStateItemNetwork `protobuf:"bytes,1002,rep,name=networks,proto3" json:"networks,omitempty"`
+}
+
+func (x *ContainerStateItem) Reset() {
+	*x = ContainerStateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateItem) ProtoMessage() {}
+
+func (x *ContainerStateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateItem.ProtoReflect.Descriptor instead.
+func (*ContainerStateItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ContainerStateItem) GetId() *ContainerIdentifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetState() ContainerState {
+	if x != nil {
+		return x.State
+	}
+	return ContainerState_CONTAINER_STATE_UNSPECIFIED
+}
+
+func (x *ContainerStateItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetImageTag() string {
+	if x != nil {
+		return x.ImageTag
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetHealth() ContainerHealth {
+	if x != nil {
+		return x.Health
+	}
+	return ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
+}
+
+func (x *ContainerStateItem) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ContainerStateItem) GetOomKilled() bool {
+	if x != nil {
+		return x.OomKilled
+	}
+	return false
+}
+
+func (x *ContainerStateItem) GetStateSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StateSince
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetRestartBudget() *RestartBudgetStatus {
+	if x != nil {
+		return x.RestartBudget
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetPorts() []*ContainerStateItemPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetNetworks() []*ContainerStateItemNetwork {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type ContainerLogMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The timestamp of the line is corrected by the clock skew of the node, if the date header of the responses tells it
+	Log string `protobuf:"bytes,100,opt,name=log,proto3" json:"log,omitempty"`
+	// The timestamp of the line is in the future even after the correction
+	SuspiciousTimestamp bool `protobuf:"varint,101,opt,name=suspiciousTimestamp,proto3" json:"suspiciousTimestamp,omitempty"`
+}
+
+func (x *ContainerLogMessage) Reset() {
+	*x = ContainerLogMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogMessage) ProtoMessage() {}
+
+func (x *ContainerLogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogMessage.ProtoReflect.Descriptor instead.
+func (*ContainerLogMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ContainerLogMessage) GetLog() string {
@@ -1152,6 +1642,13 @@ func (x *ContainerLogMessage) GetLog() string {
 	return ""
 }
 
+func (x *ContainerLogMessage) GetSuspiciousTimestamp() bool {
+	if x != nil {
+		return x.SuspiciousTimestamp
+	}
+	return false
+}
+
 type ContainerLogListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1163,7 +1660,7 @@ type ContainerLogListResponse struct {
 func (x *ContainerLogListResponse) Reset() {
 	*x = ContainerLogListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[8]
+		mi := &file_protobuf_proto_common_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1176,7 +1673,7 @@ func (x *ContainerLogListResponse) String() string {
 func (*ContainerLogListResponse) ProtoMessage() {}
 
 func (x *ContainerLogListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[8]
+	mi := &file_protobuf_proto_common_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1686,7 @@ func (x *ContainerLogListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerLogListResponse.ProtoReflect.Descriptor instead.
 func (*ContainerLogListResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{8}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ContainerLogListResponse) GetLogs() []string {
@@ -1210,7 +1707,7 @@ type ContainerInspectResponse struct {
 func (x *ContainerInspectResponse) Reset() {
 	*x = ContainerInspectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[9]
+		mi := &file_protobuf_proto_common_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1223,7 +1720,7 @@ func (x *ContainerInspectResponse) String() string {
 func (*ContainerInspectResponse) ProtoMessage() {}
 
 func (x *ContainerInspectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[9]
+	mi := &file_protobuf_proto_common_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1733,7 @@ func (x *ContainerInspectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerInspectResponse.ProtoReflect.Descriptor instead.
 func (*ContainerInspectResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{9}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ContainerInspectResponse) GetData() string {
@@ -1261,7 +1758,7 @@ type Routing struct {
 func (x *Routing) Reset() {
 	*x = Routing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[10]
+		mi := &file_protobuf_proto_common_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1771,7 @@ func (x *Routing) String() string {
 func (*Routing) ProtoMessage() {}
 
 func (x *Routing) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[10]
+	mi := &file_protobuf_proto_common_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1784,7 @@ func (x *Routing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Routing.ProtoReflect.Descriptor instead.
 func (*Routing) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{10}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Routing) GetDomain() string {
@@ -1308,51 +1805,296 @@ func (x *Routing) GetStripPath() bool {
 	if x != nil && x.StripPath != nil {
 		return *x.StripPath
 	}
-	return false
+	return false
+}
+
+func (x *Routing) GetUploadLimit() string {
+	if x != nil && x.UploadLimit != nil {
+		return *x.UploadLimit
+	}
+	return ""
+}
+
+func (x *Routing) GetPort() uint32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+type ConfigContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image     string `protobuf:"bytes,100,opt,name=image,proto3" json:"image,omitempty"`
+	Volume    string `protobuf:"bytes,101,opt,name=volume,proto3" json:"volume,omitempty"`
+	Path      string `protobuf:"bytes,102,opt,name=path,proto3" json:"path,omitempty"`
+	KeepFiles bool   `protobuf:"varint,103,opt,name=keepFiles,proto3" json:"keepFiles,omitempty"`
+}
+
+func (x *ConfigContainer) Reset() {
+	*x = ConfigContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigContainer) ProtoMessage() {}
+
+func (x *ConfigContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigContainer.ProtoReflect.Descriptor instead.
+func (*ConfigContainer) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfigContainer) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ConfigContainer) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *ConfigContainer) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigContainer) GetKeepFiles() bool {
+	if x != nil {
+		return x.KeepFiles
+	}
+	return false
+}
+
+// Request of an http probe, the port defaults to the port of the health check config
+type HTTPProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,100,opt,name=path,proto3" json:"path,omitempty"`
+	Port *int32 `protobuf:"varint,101,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	// HTTP or HTTPS, HTTP by default
+	Scheme  *string           `protobuf:"bytes,102,opt,name=scheme,proto3,oneof" json:"scheme,omitempty"`
+	Headers map[string]string `protobuf:"bytes,103,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HTTPProbe) Reset() {
+	*x = HTTPProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPProbe) ProtoMessage() {}
+
+func (x *HTTPProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPProbe.ProtoReflect.Descriptor instead.
+func (*HTTPProbe) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HTTPProbe) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HTTPProbe) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *HTTPProbe) GetScheme() string {
+	if x != nil && x.Scheme != nil {
+		return *x.Scheme
+	}
+	return ""
+}
+
+func (x *HTTPProbe) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type TCPProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port *int32 `protobuf:"varint,100,opt,name=port,proto3,oneof" json:"port,omitempty"`
+}
+
+func (x *TCPProbe) Reset() {
+	*x = TCPProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCPProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCPProbe) ProtoMessage() {}
+
+func (x *TCPProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCPProbe.ProtoReflect.Descriptor instead.
+func (*TCPProbe) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TCPProbe) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+type ExecProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command []string `protobuf:"bytes,100,rep,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *ExecProbe) Reset() {
+	*x = ExecProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_common_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecProbe) ProtoMessage() {}
+
+func (x *ExecProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Routing) GetUploadLimit() string {
-	if x != nil && x.UploadLimit != nil {
-		return *x.UploadLimit
-	}
-	return ""
+// Deprecated: Use ExecProbe.ProtoReflect.Descriptor instead.
+func (*ExecProbe) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Routing) GetPort() uint32 {
-	if x != nil && x.Port != nil {
-		return *x.Port
+func (x *ExecProbe) GetCommand() []string {
+	if x != nil {
+		return x.Command
 	}
-	return 0
+	return nil
 }
 
-type ConfigContainer struct {
+// The unset fields are the defaults of crane, not the ones of kubernetes
+type ProbeConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image     string `protobuf:"bytes,100,opt,name=image,proto3" json:"image,omitempty"`
-	Volume    string `protobuf:"bytes,101,opt,name=volume,proto3" json:"volume,omitempty"`
-	Path      string `protobuf:"bytes,102,opt,name=path,proto3" json:"path,omitempty"`
-	KeepFiles bool   `protobuf:"varint,103,opt,name=keepFiles,proto3" json:"keepFiles,omitempty"`
+	// Types that are assignable to Handler:
+	//	*ProbeConfig_Http
+	//	*ProbeConfig_Tcp
+	//	*ProbeConfig_Exec
+	Handler             isProbeConfig_Handler `protobuf_oneof:"handler"`
+	InitialDelaySeconds *int32                `protobuf:"varint,110,opt,name=initialDelaySeconds,proto3,oneof" json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       *int32                `protobuf:"varint,111,opt,name=periodSeconds,proto3,oneof" json:"periodSeconds,omitempty"`
+	TimeoutSeconds      *int32                `protobuf:"varint,112,opt,name=timeoutSeconds,proto3,oneof" json:"timeoutSeconds,omitempty"`
+	SuccessThreshold    *int32                `protobuf:"varint,113,opt,name=successThreshold,proto3,oneof" json:"successThreshold,omitempty"`
+	FailureThreshold    *int32                `protobuf:"varint,114,opt,name=failureThreshold,proto3,oneof" json:"failureThreshold,omitempty"`
 }
 
-func (x *ConfigContainer) Reset() {
-	*x = ConfigContainer{}
+func (x *ProbeConfig) Reset() {
+	*x = ProbeConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[11]
+		mi := &file_protobuf_proto_common_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigContainer) String() string {
+func (x *ProbeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigContainer) ProtoMessage() {}
+func (*ProbeConfig) ProtoMessage() {}
 
-func (x *ConfigContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[11]
+func (x *ProbeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_common_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,54 +2105,116 @@ func (x *ConfigContainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigContainer.ProtoReflect.Descriptor instead.
-func (*ConfigContainer) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ProbeConfig.ProtoReflect.Descriptor instead.
+func (*ProbeConfig) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ConfigContainer) GetImage() string {
-	if x != nil {
-		return x.Image
+func (m *ProbeConfig) GetHandler() isProbeConfig_Handler {
+	if m != nil {
+		return m.Handler
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigContainer) GetVolume() string {
-	if x != nil {
-		return x.Volume
+func (x *ProbeConfig) GetHttp() *HTTPProbe {
+	if x, ok := x.GetHandler().(*ProbeConfig_Http); ok {
+		return x.Http
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigContainer) GetPath() string {
-	if x != nil {
-		return x.Path
+func (x *ProbeConfig) GetTcp() *TCPProbe {
+	if x, ok := x.GetHandler().(*ProbeConfig_Tcp); ok {
+		return x.Tcp
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigContainer) GetKeepFiles() bool {
-	if x != nil {
-		return x.KeepFiles
+func (x *ProbeConfig) GetExec() *ExecProbe {
+	if x, ok := x.GetHandler().(*ProbeConfig_Exec); ok {
+		return x.Exec
 	}
-	return false
+	return nil
+}
+
+func (x *ProbeConfig) GetInitialDelaySeconds() int32 {
+	if x != nil && x.InitialDelaySeconds != nil {
+		return *x.InitialDelaySeconds
+	}
+	return 0
+}
+
+func (x *ProbeConfig) GetPeriodSeconds() int32 {
+	if x != nil && x.PeriodSeconds != nil {
+		return *x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *ProbeConfig) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ProbeConfig) GetSuccessThreshold() int32 {
+	if x != nil && x.SuccessThreshold != nil {
+		return *x.SuccessThreshold
+	}
+	return 0
+}
+
+func (x *ProbeConfig) GetFailureThreshold() int32 {
+	if x != nil && x.FailureThreshold != nil {
+		return *x.FailureThreshold
+	}
+	return 0
+}
+
+type isProbeConfig_Handler interface {
+	isProbeConfig_Handler()
 }
 
+type ProbeConfig_Http struct {
+	Http *HTTPProbe `protobuf:"bytes,100,opt,name=http,proto3,oneof"`
+}
+
+type ProbeConfig_Tcp struct {
+	Tcp *TCPProbe `protobuf:"bytes,101,opt,name=tcp,proto3,oneof"`
+}
+
+type ProbeConfig_Exec struct {
+	Exec *ExecProbe `protobuf:"bytes,102,opt,name=exec,proto3,oneof"`
+}
+
+func (*ProbeConfig_Http) isProbeConfig_Handler() {}
+
+func (*ProbeConfig_Tcp) isProbeConfig_Handler() {}
+
+func (*ProbeConfig_Exec) isProbeConfig_Handler() {}
+
+// The probe paths are http probes on the port, the probe configs take
+// precedence over them
 type HealthCheckConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port           *int32  `protobuf:"varint,100,opt,name=port,proto3,oneof" json:"port,omitempty"`
-	LivenessProbe  *string `protobuf:"bytes,101,opt,name=livenessProbe,proto3,oneof" json:"livenessProbe,omitempty"`
-	ReadinessProbe *string `protobuf:"bytes,102,opt,name=readinessProbe,proto3,oneof" json:"readinessProbe,omitempty"`
-	StartupProbe   *string `protobuf:"bytes,103,opt,name=startupProbe,proto3,oneof" json:"startupProbe,omitempty"`
+	Port           *int32       `protobuf:"varint,100,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	LivenessProbe  *string      `protobuf:"bytes,101,opt,name=livenessProbe,proto3,oneof" json:"livenessProbe,omitempty"`
+	ReadinessProbe *string      `protobuf:"bytes,102,opt,name=readinessProbe,proto3,oneof" json:"readinessProbe,omitempty"`
+	StartupProbe   *string      `protobuf:"bytes,103,opt,name=startupProbe,proto3,oneof" json:"startupProbe,omitempty"`
+	Liveness       *ProbeConfig `protobuf:"bytes,104,opt,name=liveness,proto3,oneof" json:"liveness,omitempty"`
+	Readiness      *ProbeConfig `protobuf:"bytes,105,opt,name=readiness,proto3,oneof" json:"readiness,omitempty"`
+	Startup        *ProbeConfig `protobuf:"bytes,106,opt,name=startup,proto3,oneof" json:"startup,omitempty"`
 }
 
 func (x *HealthCheckConfig) Reset() {
 	*x = HealthCheckConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[12]
+		mi := &file_protobuf_proto_common_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1423,7 +2227,7 @@ func (x *HealthCheckConfig) String() string {
 func (*HealthCheckConfig) ProtoMessage() {}
 
 func (x *HealthCheckConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[12]
+	mi := &file_protobuf_proto_common_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +2240,7 @@ func (x *HealthCheckConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckConfig.ProtoReflect.Descriptor instead.
 func (*HealthCheckConfig) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{12}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *HealthCheckConfig) GetPort() int32 {
@@ -1467,6 +2271,27 @@ func (x *HealthCheckConfig) GetStartupProbe() string {
 	return ""
 }
 
+func (x *HealthCheckConfig) GetLiveness() *ProbeConfig {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *HealthCheckConfig) GetReadiness() *ProbeConfig {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+func (x *HealthCheckConfig) GetStartup() *ProbeConfig {
+	if x != nil {
+		return x.Startup
+	}
+	return nil
+}
+
 type Resource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1479,7 +2304,7 @@ type Resource struct {
 func (x *Resource) Reset() {
 	*x = Resource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[13]
+		mi := &file_protobuf_proto_common_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1492,7 +2317,7 @@ func (x *Resource) String() string {
 func (*Resource) ProtoMessage() {}
 
 func (x *Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[13]
+	mi := &file_protobuf_proto_common_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,7 +2330,7 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resource.ProtoReflect.Descriptor instead.
 func (*Resource) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{13}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Resource) GetCpu() string {
@@ -1534,7 +2359,7 @@ type ResourceConfig struct {
 func (x *ResourceConfig) Reset() {
 	*x = ResourceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[14]
+		mi := &file_protobuf_proto_common_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1547,7 +2372,7 @@ func (x *ResourceConfig) String() string {
 func (*ResourceConfig) ProtoMessage() {}
 
 func (x *ResourceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[14]
+	mi := &file_protobuf_proto_common_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,7 +2385,7 @@ func (x *ResourceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceConfig.ProtoReflect.Descriptor instead.
 func (*ResourceConfig) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{14}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ResourceConfig) GetLimits() *Resource {
@@ -1589,7 +2414,7 @@ type KeyValue struct {
 func (x *KeyValue) Reset() {
 	*x = KeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[15]
+		mi := &file_protobuf_proto_common_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1602,7 +2427,7 @@ func (x *KeyValue) String() string {
 func (*KeyValue) ProtoMessage() {}
 
 func (x *KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[15]
+	mi := &file_protobuf_proto_common_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +2440,7 @@ func (x *KeyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyValue.ProtoReflect.Descriptor instead.
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{15}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *KeyValue) GetKey() string {
@@ -1647,7 +2472,7 @@ type ContainerOrPrefix struct {
 func (x *ContainerOrPrefix) Reset() {
 	*x = ContainerOrPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[16]
+		mi := &file_protobuf_proto_common_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1660,7 +2485,7 @@ func (x *ContainerOrPrefix) String() string {
 func (*ContainerOrPrefix) ProtoMessage() {}
 
 func (x *ContainerOrPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[16]
+	mi := &file_protobuf_proto_common_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,7 +2498,7 @@ func (x *ContainerOrPrefix) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerOrPrefix.ProtoReflect.Descriptor instead.
 func (*ContainerOrPrefix) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{16}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{24}
 }
 
 func (m *ContainerOrPrefix) GetTarget() isContainerOrPrefix_Target {
@@ -1721,12 +2546,14 @@ type ListSecretsResponse struct {
 	Target    *ContainerOrPrefix `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	PublicKey string             `protobuf:"bytes,3,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 	Keys      []string           `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Set if the keys are paged and there are more of them
+	NextPageToken *string `protobuf:"bytes,5,opt,name=nextPageToken,proto3,oneof" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListSecretsResponse) Reset() {
 	*x = ListSecretsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[17]
+		mi := &file_protobuf_proto_common_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1739,7 +2566,7 @@ func (x *ListSecretsResponse) String() string {
 func (*ListSecretsResponse) ProtoMessage() {}
 
 func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[17]
+	mi := &file_protobuf_proto_common_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,7 +2579,7 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
 func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{17}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListSecretsResponse) GetTarget() *ContainerOrPrefix {
@@ -1776,6 +2603,13 @@ func (x *ListSecretsResponse) GetKeys() []string {
 	return nil
 }
 
+func (x *ListSecretsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
 type UniqueKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1788,7 +2622,7 @@ type UniqueKey struct {
 func (x *UniqueKey) Reset() {
 	*x = UniqueKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[18]
+		mi := &file_protobuf_proto_common_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1801,7 +2635,7 @@ func (x *UniqueKey) String() string {
 func (*UniqueKey) ProtoMessage() {}
 
 func (x *UniqueKey) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[18]
+	mi := &file_protobuf_proto_common_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,7 +2648,7 @@ func (x *UniqueKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniqueKey.ProtoReflect.Descriptor instead.
 func (*UniqueKey) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{18}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UniqueKey) GetId() string {
@@ -1843,7 +2677,7 @@ type ContainerIdentifier struct {
 func (x *ContainerIdentifier) Reset() {
 	*x = ContainerIdentifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[19]
+		mi := &file_protobuf_proto_common_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1856,7 +2690,7 @@ func (x *ContainerIdentifier) String() string {
 func (*ContainerIdentifier) ProtoMessage() {}
 
 func (x *ContainerIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[19]
+	mi := &file_protobuf_proto_common_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,7 +2703,7 @@ func (x *ContainerIdentifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerIdentifier.ProtoReflect.Descriptor instead.
 func (*ContainerIdentifier) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{19}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ContainerIdentifier) GetPrefix() string {
@@ -1898,7 +2732,7 @@ type ContainerCommandRequest struct {
 func (x *ContainerCommandRequest) Reset() {
 	*x = ContainerCommandRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[20]
+		mi := &file_protobuf_proto_common_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1911,7 +2745,7 @@ func (x *ContainerCommandRequest) String() string {
 func (*ContainerCommandRequest) ProtoMessage() {}
 
 func (x *ContainerCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[20]
+	mi := &file_protobuf_proto_common_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,7 +2758,7 @@ func (x *ContainerCommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerCommandRequest.ProtoReflect.Descriptor instead.
 func (*ContainerCommandRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{20}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ContainerCommandRequest) GetContainer() *ContainerIdentifier {
@@ -1952,7 +2786,7 @@ type DeleteContainersRequest struct {
 func (x *DeleteContainersRequest) Reset() {
 	*x = DeleteContainersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_common_proto_msgTypes[21]
+		mi := &file_protobuf_proto_common_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1965,7 +2799,7 @@ func (x *DeleteContainersRequest) String() string {
 func (*DeleteContainersRequest) ProtoMessage() {}
 
 func (x *DeleteContainersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_common_proto_msgTypes[21]
+	mi := &file_protobuf_proto_common_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +2812,7 @@ func (x *DeleteContainersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteContainersRequest.ProtoReflect.Descriptor instead.
 func (*DeleteContainersRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{21}
+	return file_protobuf_proto_common_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteContainersRequest) GetTarget() *ContainerOrPrefix {
@@ -1996,261 +2830,427 @@ func (x *DeleteContainersRequest) GetTarget() *ContainerOrPrefix {
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x7e, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x6d, 0x0a, 0x17, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
+	0x99, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x66,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xdd,
-	0x02, 0x0a, 0x17, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xc9, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
-	0x52, 0x10, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48,
-	0x00, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x11, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0xe8, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x08, 0x6c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x50,
-	0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x22, 0x74, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
-	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xe0, 0x03, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x19, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0xe8, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x6d, 0x0a, 0x17, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x03, 0x0a, 0x17, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x10, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x50,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x11, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x11, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x6c, 0x6f, 0x67, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0xe9, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18,
+	0xea, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70,
+	0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x67, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xfe, 0x01, 0x0a,
+	0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0b,
+	0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x67,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb1, 0x01,
+	0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0xe8, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xde, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74,
+	0x22, 0xbd, 0x06, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67,
+	0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67,
+	0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x6f, 0x6d, 0x4b, 0x69, 0x6c, 0x6c,
+	0x65, 0x64, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6f, 0x6d, 0x4b, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x68,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74,
-	0x65, 0x6d, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0xe9, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x18, 0xea, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x22, 0x59, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x75, 0x73,
+	0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f,
+	0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2f, 0x0a, 0x18, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18,
+	0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x18,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdd, 0x01, 0x0a,
+	0x07, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61, 0x74, 0x68, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x68, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50,
+	0x61, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x71, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x67,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22,
+	0xdf, 0x01, 0x0a, 0x09, 0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x67, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x22, 0x2c, 0x0a, 0x08, 0x54, 0x43, 0x50, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x17, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x25, 0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x64, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0xe8, 0x03, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x54,
+	0x54, 0x50, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12,
+	0x24, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x43, 0x50, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x00,
+	0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x27, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35,
+	0x0a, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x13, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0d,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2b, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x70, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x71, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f,
+	0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x72, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x22, 0xb5, 0x03, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x34, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x68, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
+	0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x04, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x05, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x32, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x06, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x50, 0x72, 0x6f,
+	0x62, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x22, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x63, 0x70, 0x75, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x63, 0x70,
+	0x75, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x8a, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2d, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x01, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x74, 0x0a,
+	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a,
+	0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x41, 0x0a, 0x13,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x8e, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39,
-	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c,
-	0x6f, 0x67, 0x22, 0x2f, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13,
-	0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c,
-	0x6f, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0xdd, 0x01, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x1b, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69,
-	0x70, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
-	0x6f, 0x72, 0x74, 0x22, 0x71, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x66, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6b, 0x65, 0x65,
-	0x70, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
-	0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d,
-	0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x2b, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f,
-	0x62, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
-	0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x67, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x50, 0x72,
-	0x6f, 0x62, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42,
-	0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62,
-	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50,
-	0x72, 0x6f, 0x62, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70,
-	0x50, 0x72, 0x6f, 0x62, 0x65, 0x22, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x15, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x03, 0x63, 0x70, 0x75, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x63, 0x70, 0x75, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x01,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x74, 0x0a, 0x11, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3b,
-	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00,
-	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x06, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
-	0x7a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x2d, 0x0a, 0x09, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8e, 0x01,
-	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c,
-	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2a, 0x64, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f,
-	0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x58, 0x49,
-	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44,
-	0x10, 0x04, 0x2a, 0x8f, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x4c, 0x4f,
-	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52,
-	0x45, 0x50, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
-	0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x42, 0x53, 0x4f, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x4f, 0x57, 0x4e, 0x47, 0x52, 0x41, 0x44,
-	0x45, 0x44, 0x10, 0x06, 0x2a, 0x5e, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22,
-	0x0a, 0x1e, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x53,
-	0x53, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x06, 0x2a, 0x6e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e,
-	0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f, 0x10,
-	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x04, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10,
-	0x05, 0x2a, 0x5b, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4c, 0x4f,
-	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x52, 0x45, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x4f,
-	0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x61,
-	0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
-	0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x4f, 0x10,
-	0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x57, 0x4f, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x57,
-	0x58, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x45, 0x4d, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03,
-	0x54, 0x4d, 0x50, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10,
-	0x06, 0x2a, 0xdf, 0x01, 0x0a, 0x0a, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x17, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
-	0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12,
-	0x14, 0x0a, 0x10, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e,
-	0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x43, 0x50, 0x4c, 0x4f, 0x47, 0x53,
-	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x0d, 0x0a,
-	0x09, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x55, 0x52,
-	0x4e, 0x41, 0x4c, 0x44, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x45, 0x4c, 0x46, 0x10, 0x08,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x55, 0x45, 0x4e, 0x54, 0x44, 0x10, 0x09, 0x12, 0x0b, 0x0a,
-	0x07, 0x41, 0x57, 0x53, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x50,
-	0x4c, 0x55, 0x4e, 0x4b, 0x10, 0x0b, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x54, 0x57, 0x4c, 0x4f, 0x47,
-	0x53, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x4f, 0x47, 0x45, 0x4e, 0x54, 0x52, 0x49, 0x45,
-	0x53, 0x10, 0x0d, 0x2a, 0x5f, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x45, 0x5f,
-	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x4e, 0x45, 0x5f, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12,
-	0x13, 0x0a, 0x0f, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x54,
-	0x4c, 0x53, 0x10, 0x03, 0x2a, 0x79, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f,
-	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x42,
-	0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x79,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x69, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x4c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2a, 0x64,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45,
+	0x58, 0x49, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x04, 0x2a, 0x64, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55,
+	0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x42, 0x55, 0x44, 0x47,
+	0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x49, 0x4e,
+	0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x43,
+	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x41,
+	0x56, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x03, 0x2a, 0x8f, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4f,
+	0x42, 0x53, 0x4f, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x4f, 0x57,
+	0x4e, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x5e, 0x0a, 0x16, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09,
+	0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x0b, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x06, 0x2a, 0x6e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x06,
+	0x0a, 0x02, 0x4e, 0x4f, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53,
+	0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x4f,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x5b, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23, 0x0a, 0x1f,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x02, 0x2a, 0x61, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06,
+	0x0a, 0x02, 0x52, 0x4f, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x57, 0x4f, 0x10, 0x02, 0x12,
+	0x07, 0x0a, 0x03, 0x52, 0x57, 0x58, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x45, 0x4d, 0x10,
+	0x04, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4d, 0x50, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45,
+	0x43, 0x52, 0x45, 0x54, 0x10, 0x06, 0x2a, 0xdf, 0x01, 0x0a, 0x0a, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x43,
+	0x50, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c,
+	0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10,
+	0x05, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x10, 0x06, 0x12, 0x0c, 0x0a,
+	0x08, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x44, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x47,
+	0x45, 0x4c, 0x46, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x55, 0x45, 0x4e, 0x54, 0x44,
+	0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x57, 0x53, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x0a, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x50, 0x4c, 0x55, 0x4e, 0x4b, 0x10, 0x0b, 0x12, 0x0b, 0x0a, 0x07, 0x45,
+	0x54, 0x57, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x4f, 0x47, 0x45,
+	0x4e, 0x54, 0x52, 0x49, 0x45, 0x53, 0x10, 0x0d, 0x2a, 0x5f, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f,
+	0x73, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58,
+	0x50, 0x4f, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e,
+	0x4f, 0x4e, 0x45, 0x5f, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x58, 0x50, 0x4f,
+	0x53, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x2a, 0x79, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4f,
+	0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a,
+	0x11, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x10, 0x03, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x64,
+	0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2265,68 +3265,95 @@ func file_protobuf_proto_common_proto_rawDescGZIP() []byte {
 	return file_protobuf_proto_common_proto_rawDescData
 }
 
-var file_protobuf_proto_common_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_protobuf_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_protobuf_proto_common_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_protobuf_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_protobuf_proto_common_proto_goTypes = []interface{}{
 	(ContainerState)(0),               // 0: common.ContainerState
-	(DeploymentStatus)(0),             // 1: common.DeploymentStatus
-	(DeploymentMessageLevel)(0),       // 2: common.DeploymentMessageLevel
-	(NetworkMode)(0),                  // 3: common.NetworkMode
-	(RestartPolicy)(0),                // 4: common.RestartPolicy
-	(DeploymentStrategy)(0),           // 5: common.DeploymentStrategy
-	(VolumeType)(0),                   // 6: common.VolumeType
-	(DriverType)(0),                   // 7: common.DriverType
-	(ExposeStrategy)(0),               // 8: common.ExposeStrategy
-	(ContainerOperation)(0),           // 9: common.ContainerOperation
-	(*Empty)(nil),                     // 10: common.Empty
-	(*InstanceDeploymentItem)(nil),    // 11: common.InstanceDeploymentItem
-	(*DeployContainerProgress)(nil),   // 12: common.DeployContainerProgress
-	(*DeploymentStatusMessage)(nil),   // 13: common.DeploymentStatusMessage
-	(*ContainerStateItemPort)(nil),    // 14: common.ContainerStateItemPort
-	(*ContainerStateListMessage)(nil), // 15: common.ContainerStateListMessage
-	(*ContainerStateItem)(nil),        // 16: common.ContainerStateItem
-	(*ContainerLogMessage)(nil),       // 17: common.ContainerLogMessage
-	(*ContainerLogListResponse)(nil),  // 18: common.ContainerLogListResponse
-	(*ContainerInspectResponse)(nil),  // 19: common.ContainerInspectResponse
-	(*Routing)(nil),                   // 20: common.Routing
-	(*ConfigContainer)(nil),           // 21: common.ConfigContainer
-	(*HealthCheckConfig)(nil),         // 22: common.HealthCheckConfig
-	(*Resource)(nil),                  // 23: common.Resource
-	(*ResourceConfig)(nil),            // 24: common.ResourceConfig
-	(*KeyValue)(nil),                  // 25: common.KeyValue
-	(*ContainerOrPrefix)(nil),         // 26: common.ContainerOrPrefix
-	(*ListSecretsResponse)(nil),       // 27: common.ListSecretsResponse
-	(*UniqueKey)(nil),                 // 28: common.UniqueKey
-	(*ContainerIdentifier)(nil),       // 29: common.ContainerIdentifier
-	(*ContainerCommandRequest)(nil),   // 30: common.ContainerCommandRequest
-	(*DeleteContainersRequest)(nil),   // 31: common.DeleteContainersRequest
-	nil,                               // 32: common.ContainerStateItem.LabelsEntry
-	(*timestamppb.Timestamp)(nil),     // 33: google.protobuf.Timestamp
+	(ContainerHealth)(0),              // 1: common.ContainerHealth
+	(RestartBudgetState)(0),           // 2: common.RestartBudgetState
+	(DeploymentStatus)(0),             // 3: common.DeploymentStatus
+	(DeploymentMessageLevel)(0),       // 4: common.DeploymentMessageLevel
+	(NetworkMode)(0),                  // 5: common.NetworkMode
+	(RestartPolicy)(0),                // 6: common.RestartPolicy
+	(DeploymentStrategy)(0),           // 7: common.DeploymentStrategy
+	(VolumeType)(0),                   // 8: common.VolumeType
+	(DriverType)(0),                   // 9: common.DriverType
+	(ExposeStrategy)(0),               // 10: common.ExposeStrategy
+	(ContainerOperation)(0),           // 11: common.ContainerOperation
+	(*Empty)(nil),                     // 12: common.Empty
+	(*InstanceDeploymentItem)(nil),    // 13: common.InstanceDeploymentItem
+	(*DeployContainerProgress)(nil),   // 14: common.DeployContainerProgress
+	(*DeploymentStatusMessage)(nil),   // 15: common.DeploymentStatusMessage
+	(*ContainerStateItemPort)(nil),    // 16: common.ContainerStateItemPort
+	(*ContainerStateItemNetwork)(nil), // 17: common.ContainerStateItemNetwork
+	(*ContainerStateListMessage)(nil), // 18: common.ContainerStateListMessage
+	(*ListFilter)(nil),                // 19: common.ListFilter
+	(*PageRequest)(nil),               // 20: common.PageRequest
+	(*RestartBudgetStatus)(nil),       // 21: common.RestartBudgetStatus
+	(*ContainerStateItem)(nil),        // 22: common.ContainerStateItem
+	(*ContainerLogMessage)(nil),       // 23: common.ContainerLogMessage
+	(*ContainerLogListResponse)(nil),  // 24: common.ContainerLogListResponse
+	(*ContainerInspectResponse)(nil),  // 25: common.ContainerInspectResponse
+	(*Routing)(nil),                   // 26: common.Routing
+	(*ConfigContainer)(nil),           // 27: common.ConfigContainer
+	(*HTTPProbe)(nil),                 // 28: common.HTTPProbe
+	(*TCPProbe)(nil),                  // 29: common.TCPProbe
+	(*ExecProbe)(nil),                 // 30: common.ExecProbe
+	(*ProbeConfig)(nil),               // 31: common.ProbeConfig
+	(*HealthCheckConfig)(nil),         // 32: common.HealthCheckConfig
+	(*Resource)(nil),                  // 33: common.Resource
+	(*ResourceConfig)(nil),            // 34: common.ResourceConfig
+	(*KeyValue)(nil),                  // 35: common.KeyValue
+	(*ContainerOrPrefix)(nil),         // 36: common.ContainerOrPrefix
+	(*ListSecretsResponse)(nil),       // 37: common.ListSecretsResponse
+	(*UniqueKey)(nil),                 // 38: common.UniqueKey
+	(*ContainerIdentifier)(nil),       // 39: common.ContainerIdentifier
+	(*ContainerCommandRequest)(nil),   // 40: common.ContainerCommandRequest
+	(*DeleteContainersRequest)(nil),   // 41: common.DeleteContainersRequest
+	nil,                               // 42: common.ListFilter.LabelsEntry
+	nil,                               // 43: common.ContainerStateItem.LabelsEntry
+	nil,                               // 44: common.HTTPProbe.HeadersEntry
+	(*timestamppb.Timestamp)(nil),     // 45: google.protobuf.Timestamp
 }
 var file_protobuf_proto_common_proto_depIdxs = []int32{
 	0,  // 0: common.InstanceDeploymentItem.state:type_name -> common.ContainerState
-	11, // 1: common.DeploymentStatusMessage.instance:type_name -> common.InstanceDeploymentItem
-	1,  // 2: common.DeploymentStatusMessage.deploymentStatus:type_name -> common.DeploymentStatus
-	12, // 3: common.DeploymentStatusMessage.containerProgress:type_name -> common.DeployContainerProgress
-	2,  // 4: common.DeploymentStatusMessage.logLevel:type_name -> common.DeploymentMessageLevel
-	16, // 5: common.ContainerStateListMessage.data:type_name -> common.ContainerStateItem
-	29, // 6: common.ContainerStateItem.id:type_name -> common.ContainerIdentifier
-	33, // 7: common.ContainerStateItem.createdAt:type_name -> google.protobuf.Timestamp
-	0,  // 8: common.ContainerStateItem.state:type_name -> common.ContainerState
-	14, // 9: common.ContainerStateItem.ports:type_name -> common.ContainerStateItemPort
-	32, // 10: common.ContainerStateItem.labels:type_name -> common.ContainerStateItem.LabelsEntry
-	23, // 11: common.ResourceConfig.limits:type_name -> common.Resource
-	23, // 12: common.ResourceConfig.requests:type_name -> common.Resource
-	29, // 13: common.ContainerOrPrefix.container:type_name -> common.ContainerIdentifier
-	26, // 14: common.ListSecretsResponse.target:type_name -> common.ContainerOrPrefix
-	29, // 15: common.ContainerCommandRequest.container:type_name -> common.ContainerIdentifier
-	9,  // 16: common.ContainerCommandRequest.operation:type_name -> common.ContainerOperation
-	26, // 17: common.DeleteContainersRequest.target:type_name -> common.ContainerOrPrefix
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	13, // 1: common.DeploymentStatusMessage.instance:type_name -> common.InstanceDeploymentItem
+	3,  // 2: common.DeploymentStatusMessage.deploymentStatus:type_name -> common.DeploymentStatus
+	14, // 3: common.DeploymentStatusMessage.containerProgress:type_name -> common.DeployContainerProgress
+	4,  // 4: common.DeploymentStatusMessage.logLevel:type_name -> common.DeploymentMessageLevel
+	22, // 5: common.ContainerStateListMessage.data:type_name -> common.ContainerStateItem
+	0,  // 6: common.ListFilter.states:type_name -> common.ContainerState
+	42, // 7: common.ListFilter.labels:type_name -> common.ListFilter.LabelsEntry
+	2,  // 8: common.RestartBudgetStatus.state:type_name -> common.RestartBudgetState
+	45, // 9: common.RestartBudgetStatus.nextRestartAt:type_name -> google.protobuf.Timestamp
+	39, // 10: common.ContainerStateItem.id:type_name -> common.ContainerIdentifier
+	45, // 11: common.ContainerStateItem.createdAt:type_name -> google.protobuf.Timestamp
+	0,  // 12: common.ContainerStateItem.state:type_name -> common.ContainerState
+	1,  // 13: common.ContainerStateItem.health:type_name -> common.ContainerHealth
+	45, // 14: common.ContainerStateItem.stateSince:type_name -> google.protobuf.Timestamp
+	21, // 15: common.ContainerStateItem.restartBudget:type_name -> common.RestartBudgetStatus
+	16, // 16: common.ContainerStateItem.ports:type_name -> common.ContainerStateItemPort
+	43, // 17: common.ContainerStateItem.labels:type_name -> common.ContainerStateItem.LabelsEntry
+	17, // 18: common.ContainerStateItem.networks:type_name -> common.ContainerStateItemNetwork
+	44, // 19: common.HTTPProbe.headers:type_name -> common.HTTPProbe.HeadersEntry
+	28, // 20: common.ProbeConfig.http:type_name -> common.HTTPProbe
+	29, // 21: common.ProbeConfig.tcp:type_name -> common.TCPProbe
+	30, // 22: common.ProbeConfig.exec:type_name -> common.ExecProbe
+	31, // 23: common.HealthCheckConfig.liveness:type_name -> common.ProbeConfig
+	31, // 24: common.HealthCheckConfig.readiness:type_name -> common.ProbeConfig
+	31, // 25: common.HealthCheckConfig.startup:type_name -> common.ProbeConfig
+	33, // 26: common.ResourceConfig.limits:type_name -> common.Resource
+	33, // 27: common.ResourceConfig.requests:type_name -> common.Resource
+	39, // 28: common.ContainerOrPrefix.container:type_name -> common.ContainerIdentifier
+	36, // 29: common.ListSecretsResponse.target:type_name -> common.ContainerOrPrefix
+	39, // 30: common.ContainerCommandRequest.container:type_name -> common.ContainerIdentifier
+	11, // 31: common.ContainerCommandRequest.operation:type_name -> common.ContainerOperation
+	36, // 32: common.DeleteContainersRequest.target:type_name -> common.ContainerOrPrefix
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_proto_common_proto_init() }
@@ -2396,7 +3423,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateListMessage); i {
+			switch v := v.(*ContainerStateItemNetwork); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2408,7 +3435,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateItem); i {
+			switch v := v.(*ContainerStateListMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2420,7 +3447,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerLogMessage); i {
+			switch v := v.(*ListFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2432,7 +3459,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerLogListResponse); i {
+			switch v := v.(*PageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2444,7 +3471,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerInspectResponse); i {
+			switch v := v.(*RestartBudgetStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2456,7 +3483,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Routing); i {
+			switch v := v.(*ContainerStateItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2468,7 +3495,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigContainer); i {
+			switch v := v.(*ContainerLogMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2480,7 +3507,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckConfig); i {
+			switch v := v.(*ContainerLogListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2492,7 +3519,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resource); i {
+			switch v := v.(*ContainerInspectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2504,7 +3531,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceConfig); i {
+			switch v := v.(*Routing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2516,7 +3543,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyValue); i {
+			switch v := v.(*ConfigContainer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2528,7 +3555,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerOrPrefix); i {
+			switch v := v.(*HTTPProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2540,7 +3567,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSecretsResponse); i {
+			switch v := v.(*TCPProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2552,7 +3579,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniqueKey); i {
+			switch v := v.(*ExecProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2564,7 +3591,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerIdentifier); i {
+			switch v := v.(*ProbeConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2576,7 +3603,7 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerCommandRequest); i {
+			switch v := v.(*HealthCheckConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2588,6 +3615,102 @@ func file_protobuf_proto_common_proto_init() {
 			}
 		}
 		file_protobuf_proto_common_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerOrPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecretsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_common_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteContainersRequest); i {
 			case 0:
 				return &v.state
@@ -2605,22 +3728,35 @@ func file_protobuf_proto_common_proto_init() {
 		(*DeploymentStatusMessage_DeploymentStatus)(nil),
 		(*DeploymentStatusMessage_ContainerProgress)(nil),
 	}
+	file_protobuf_proto_common_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_protobuf_proto_common_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	file_protobuf_proto_common_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_protobuf_proto_common_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_protobuf_proto_common_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	file_protobuf_proto_common_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_protobuf_proto_common_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_protobuf_proto_common_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*ProbeConfig_Http)(nil),
+		(*ProbeConfig_Tcp)(nil),
+		(*ProbeConfig_Exec)(nil),
+	}
+	file_protobuf_proto_common_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_protobuf_proto_common_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*ContainerOrPrefix_Container)(nil),
 		(*ContainerOrPrefix_Prefix)(nil),
 	}
+	file_protobuf_proto_common_proto_msgTypes[25].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_proto_common_proto_rawDesc,
-			NumEnums:      10,
-			NumMessages:   23,
+			NumEnums:      12,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,6 +2,7 @@
 import { Metadata } from '@grpc/grpc-js'
 import { GrpcMethod, GrpcStreamMethod } from '@nestjs/microservices'
 import { Observable } from 'rxjs'
+import { Timestamp } from '../../google/protobuf/timestamp'
 import {
   ConfigContainer,
   ContainerCommandRequest,
@@ -13,20 +14,26 @@ import {
   ContainerState,
   ContainerStateListMessage,
   DeleteContainersRequest,
+  DeploymentStatus,
   DeploymentStatusMessage,
   DeploymentStrategy,
   DriverType,
   Empty,
   ExposeStrategy,
   HealthCheckConfig,
+  ListFilter,
   ListSecretsResponse,
   NetworkMode,
+  PageRequest,
+  Resource,
   ResourceConfig,
   RestartPolicy,
   Routing,
   VolumeType,
   containerStateFromJSON,
   containerStateToJSON,
+  deploymentStatusFromJSON,
+  deploymentStatusToJSON,
   deploymentStrategyFromJSON,
   deploymentStrategyToJSON,
   driverTypeFromJSON,
@@ -48,6 +55,351 @@ export const protobufPackage = 'agent'
  * Logs, statuses, deployments
  */
 
+/**
+ * How the platform handles a failed command, the agents classify their
+ * errors the same way, so retrying and alerting do not depend on the
+ * error messages
+ */
+export enum ErrorCategory {
+  ERROR_CATEGORY_UNSPECIFIED = 0,
+  /** The request is wrong, it fails the same way again */
+  ERROR_CATEGORY_INVALID_ARGUMENT = 1,
+  ERROR_CATEGORY_NOT_FOUND = 2,
+  /** The object was changed or exists already */
+  ERROR_CATEGORY_CONFLICT = 3,
+  /** The credentials of the registry or the cluster are rejected */
+  ERROR_CATEGORY_UNAUTHORIZED = 4,
+  /** The node can not run the command, e.g. a missing runtime */
+  ERROR_CATEGORY_FAILED_PRECONDITION = 5,
+  /** The container runtime or the API server is not reachable */
+  ERROR_CATEGORY_UNAVAILABLE = 6,
+  ERROR_CATEGORY_TIMEOUT = 7,
+  /** The node or the cluster is out of resources or throttled */
+  ERROR_CATEGORY_RESOURCE_EXHAUSTED = 8,
+  /** The command was canceled, e.g. the agent is shutting down */
+  ERROR_CATEGORY_CANCELED = 9,
+  ERROR_CATEGORY_UNIMPLEMENTED = 10,
+  ERROR_CATEGORY_INTERNAL = 11,
+  UNRECOGNIZED = -1,
+}
+
+export function errorCategoryFromJSON(object: any): ErrorCategory {
+  switch (object) {
+    case 0:
+    case 'ERROR_CATEGORY_UNSPECIFIED':
+      return ErrorCategory.ERROR_CATEGORY_UNSPECIFIED
+    case 1:
+    case 'ERROR_CATEGORY_INVALID_ARGUMENT':
+      return ErrorCategory.ERROR_CATEGORY_INVALID_ARGUMENT
+    case 2:
+    case 'ERROR_CATEGORY_NOT_FOUND':
+      return ErrorCategory.ERROR_CATEGORY_NOT_FOUND
+    case 3:
+    case 'ERROR_CATEGORY_CONFLICT':
+      return ErrorCategory.ERROR_CATEGORY_CONFLICT
+    case 4:
+    case 'ERROR_CATEGORY_UNAUTHORIZED':
+      return ErrorCategory.ERROR_CATEGORY_UNAUTHORIZED
+    case 5:
+    case 'ERROR_CATEGORY_FAILED_PRECONDITION':
+      return ErrorCategory.ERROR_CATEGORY_FAILED_PRECONDITION
+    case 6:
+    case 'ERROR_CATEGORY_UNAVAILABLE':
+      return ErrorCategory.ERROR_CATEGORY_UNAVAILABLE
+    case 7:
+    case 'ERROR_CATEGORY_TIMEOUT':
+      return ErrorCategory.ERROR_CATEGORY_TIMEOUT
+    case 8:
+    case 'ERROR_CATEGORY_RESOURCE_EXHAUSTED':
+      return ErrorCategory.ERROR_CATEGORY_RESOURCE_EXHAUSTED
+    case 9:
+    case 'ERROR_CATEGORY_CANCELED':
+      return ErrorCategory.ERROR_CATEGORY_CANCELED
+    case 10:
+    case 'ERROR_CATEGORY_UNIMPLEMENTED':
+      return ErrorCategory.ERROR_CATEGORY_UNIMPLEMENTED
+    case 11:
+    case 'ERROR_CATEGORY_INTERNAL':
+      return ErrorCategory.ERROR_CATEGORY_INTERNAL
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return ErrorCategory.UNRECOGNIZED
+  }
+}
+
+export function errorCategoryToJSON(object: ErrorCategory): string {
+  switch (object) {
+    case ErrorCategory.ERROR_CATEGORY_UNSPECIFIED:
+      return 'ERROR_CATEGORY_UNSPECIFIED'
+    case ErrorCategory.ERROR_CATEGORY_INVALID_ARGUMENT:
+      return 'ERROR_CATEGORY_INVALID_ARGUMENT'
+    case ErrorCategory.ERROR_CATEGORY_NOT_FOUND:
+      return 'ERROR_CATEGORY_NOT_FOUND'
+    case ErrorCategory.ERROR_CATEGORY_CONFLICT:
+      return 'ERROR_CATEGORY_CONFLICT'
+    case ErrorCategory.ERROR_CATEGORY_UNAUTHORIZED:
+      return 'ERROR_CATEGORY_UNAUTHORIZED'
+    case ErrorCategory.ERROR_CATEGORY_FAILED_PRECONDITION:
+      return 'ERROR_CATEGORY_FAILED_PRECONDITION'
+    case ErrorCategory.ERROR_CATEGORY_UNAVAILABLE:
+      return 'ERROR_CATEGORY_UNAVAILABLE'
+    case ErrorCategory.ERROR_CATEGORY_TIMEOUT:
+      return 'ERROR_CATEGORY_TIMEOUT'
+    case ErrorCategory.ERROR_CATEGORY_RESOURCE_EXHAUSTED:
+      return 'ERROR_CATEGORY_RESOURCE_EXHAUSTED'
+    case ErrorCategory.ERROR_CATEGORY_CANCELED:
+      return 'ERROR_CATEGORY_CANCELED'
+    case ErrorCategory.ERROR_CATEGORY_UNIMPLEMENTED:
+      return 'ERROR_CATEGORY_UNIMPLEMENTED'
+    case ErrorCategory.ERROR_CATEGORY_INTERNAL:
+      return 'ERROR_CATEGORY_INTERNAL'
+    case ErrorCategory.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+/** What happens when the backoff would exceed its max */
+export enum RestartGiveUpAction {
+  RESTART_GIVE_UP_ACTION_UNSPECIFIED = 0,
+  /** Keep restarting with the max backoff */
+  RESTART_GIVE_UP_ACTION_BACKOFF = 1,
+  /** Leave the container stopped and disable its restart policy */
+  RESTART_GIVE_UP_ACTION_STOP = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function restartGiveUpActionFromJSON(object: any): RestartGiveUpAction {
+  switch (object) {
+    case 0:
+    case 'RESTART_GIVE_UP_ACTION_UNSPECIFIED':
+      return RestartGiveUpAction.RESTART_GIVE_UP_ACTION_UNSPECIFIED
+    case 1:
+    case 'RESTART_GIVE_UP_ACTION_BACKOFF':
+      return RestartGiveUpAction.RESTART_GIVE_UP_ACTION_BACKOFF
+    case 2:
+    case 'RESTART_GIVE_UP_ACTION_STOP':
+      return RestartGiveUpAction.RESTART_GIVE_UP_ACTION_STOP
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return RestartGiveUpAction.UNRECOGNIZED
+  }
+}
+
+export function restartGiveUpActionToJSON(object: RestartGiveUpAction): string {
+  switch (object) {
+    case RestartGiveUpAction.RESTART_GIVE_UP_ACTION_UNSPECIFIED:
+      return 'RESTART_GIVE_UP_ACTION_UNSPECIFIED'
+    case RestartGiveUpAction.RESTART_GIVE_UP_ACTION_BACKOFF:
+      return 'RESTART_GIVE_UP_ACTION_BACKOFF'
+    case RestartGiveUpAction.RESTART_GIVE_UP_ACTION_STOP:
+      return 'RESTART_GIVE_UP_ACTION_STOP'
+    case RestartGiveUpAction.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+/** Windows only, ignored by Linux daemons */
+export enum Isolation {
+  ISOLATION_UNSPECIFIED = 0,
+  ISOLATION_DEFAULT = 1,
+  ISOLATION_PROCESS = 2,
+  ISOLATION_HYPERV = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function isolationFromJSON(object: any): Isolation {
+  switch (object) {
+    case 0:
+    case 'ISOLATION_UNSPECIFIED':
+      return Isolation.ISOLATION_UNSPECIFIED
+    case 1:
+    case 'ISOLATION_DEFAULT':
+      return Isolation.ISOLATION_DEFAULT
+    case 2:
+    case 'ISOLATION_PROCESS':
+      return Isolation.ISOLATION_PROCESS
+    case 3:
+    case 'ISOLATION_HYPERV':
+      return Isolation.ISOLATION_HYPERV
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return Isolation.UNRECOGNIZED
+  }
+}
+
+export function isolationToJSON(object: Isolation): string {
+  switch (object) {
+    case Isolation.ISOLATION_UNSPECIFIED:
+      return 'ISOLATION_UNSPECIFIED'
+    case Isolation.ISOLATION_DEFAULT:
+      return 'ISOLATION_DEFAULT'
+    case Isolation.ISOLATION_PROCESS:
+      return 'ISOLATION_PROCESS'
+    case Isolation.ISOLATION_HYPERV:
+      return 'ISOLATION_HYPERV'
+    case Isolation.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+/**
+ * Shape of the service of the container, unspecified means cluster IP or
+ * load balancer based on useLoadBalancer
+ */
+export enum ServiceType {
+  SERVICE_TYPE_UNSPECIFIED = 0,
+  SERVICE_TYPE_CLUSTER_IP = 1,
+  SERVICE_TYPE_HEADLESS = 2,
+  SERVICE_TYPE_NODE_PORT = 3,
+  SERVICE_TYPE_LOAD_BALANCER = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function serviceTypeFromJSON(object: any): ServiceType {
+  switch (object) {
+    case 0:
+    case 'SERVICE_TYPE_UNSPECIFIED':
+      return ServiceType.SERVICE_TYPE_UNSPECIFIED
+    case 1:
+    case 'SERVICE_TYPE_CLUSTER_IP':
+      return ServiceType.SERVICE_TYPE_CLUSTER_IP
+    case 2:
+    case 'SERVICE_TYPE_HEADLESS':
+      return ServiceType.SERVICE_TYPE_HEADLESS
+    case 3:
+    case 'SERVICE_TYPE_NODE_PORT':
+      return ServiceType.SERVICE_TYPE_NODE_PORT
+    case 4:
+    case 'SERVICE_TYPE_LOAD_BALANCER':
+      return ServiceType.SERVICE_TYPE_LOAD_BALANCER
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return ServiceType.UNRECOGNIZED
+  }
+}
+
+export function serviceTypeToJSON(object: ServiceType): string {
+  switch (object) {
+    case ServiceType.SERVICE_TYPE_UNSPECIFIED:
+      return 'SERVICE_TYPE_UNSPECIFIED'
+    case ServiceType.SERVICE_TYPE_CLUSTER_IP:
+      return 'SERVICE_TYPE_CLUSTER_IP'
+    case ServiceType.SERVICE_TYPE_HEADLESS:
+      return 'SERVICE_TYPE_HEADLESS'
+    case ServiceType.SERVICE_TYPE_NODE_PORT:
+      return 'SERVICE_TYPE_NODE_PORT'
+    case ServiceType.SERVICE_TYPE_LOAD_BALANCER:
+      return 'SERVICE_TYPE_LOAD_BALANCER'
+    case ServiceType.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+export enum PruneTarget {
+  PRUNE_TARGET_UNSPECIFIED = 0,
+  /** Dangling images not used by any container */
+  PRUNE_TARGET_IMAGES = 1,
+  /** Anonymous volumes not used by any container */
+  PRUNE_TARGET_VOLUMES = 2,
+  /** Managed containers which are dead or were never started */
+  PRUNE_TARGET_ZOMBIE_CONTAINERS = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function pruneTargetFromJSON(object: any): PruneTarget {
+  switch (object) {
+    case 0:
+    case 'PRUNE_TARGET_UNSPECIFIED':
+      return PruneTarget.PRUNE_TARGET_UNSPECIFIED
+    case 1:
+    case 'PRUNE_TARGET_IMAGES':
+      return PruneTarget.PRUNE_TARGET_IMAGES
+    case 2:
+    case 'PRUNE_TARGET_VOLUMES':
+      return PruneTarget.PRUNE_TARGET_VOLUMES
+    case 3:
+    case 'PRUNE_TARGET_ZOMBIE_CONTAINERS':
+      return PruneTarget.PRUNE_TARGET_ZOMBIE_CONTAINERS
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return PruneTarget.UNRECOGNIZED
+  }
+}
+
+export function pruneTargetToJSON(object: PruneTarget): string {
+  switch (object) {
+    case PruneTarget.PRUNE_TARGET_UNSPECIFIED:
+      return 'PRUNE_TARGET_UNSPECIFIED'
+    case PruneTarget.PRUNE_TARGET_IMAGES:
+      return 'PRUNE_TARGET_IMAGES'
+    case PruneTarget.PRUNE_TARGET_VOLUMES:
+      return 'PRUNE_TARGET_VOLUMES'
+    case PruneTarget.PRUNE_TARGET_ZOMBIE_CONTAINERS:
+      return 'PRUNE_TARGET_ZOMBIE_CONTAINERS'
+    case PruneTarget.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+/**
+ * Operational actions on the workload of a container, the progress is
+ * streamed back on a WorkloadOperation channel opened with the id
+ */
+export enum WorkloadOperation {
+  WORKLOAD_OPERATION_UNSPECIFIED = 0,
+  WORKLOAD_OPERATION_ROLLOUT_RESTART = 1,
+  WORKLOAD_OPERATION_DELETE_POD = 2,
+  WORKLOAD_OPERATION_SCALE = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function workloadOperationFromJSON(object: any): WorkloadOperation {
+  switch (object) {
+    case 0:
+    case 'WORKLOAD_OPERATION_UNSPECIFIED':
+      return WorkloadOperation.WORKLOAD_OPERATION_UNSPECIFIED
+    case 1:
+    case 'WORKLOAD_OPERATION_ROLLOUT_RESTART':
+      return WorkloadOperation.WORKLOAD_OPERATION_ROLLOUT_RESTART
+    case 2:
+    case 'WORKLOAD_OPERATION_DELETE_POD':
+      return WorkloadOperation.WORKLOAD_OPERATION_DELETE_POD
+    case 3:
+    case 'WORKLOAD_OPERATION_SCALE':
+      return WorkloadOperation.WORKLOAD_OPERATION_SCALE
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return WorkloadOperation.UNRECOGNIZED
+  }
+}
+
+export function workloadOperationToJSON(object: WorkloadOperation): string {
+  switch (object) {
+    case WorkloadOperation.WORKLOAD_OPERATION_UNSPECIFIED:
+      return 'WORKLOAD_OPERATION_UNSPECIFIED'
+    case WorkloadOperation.WORKLOAD_OPERATION_ROLLOUT_RESTART:
+      return 'WORKLOAD_OPERATION_ROLLOUT_RESTART'
+    case WorkloadOperation.WORKLOAD_OPERATION_DELETE_POD:
+      return 'WORKLOAD_OPERATION_DELETE_POD'
+    case WorkloadOperation.WORKLOAD_OPERATION_SCALE:
+      return 'WORKLOAD_OPERATION_SCALE'
+    case WorkloadOperation.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 /** Connection close */
 export enum CloseReason {
   CLOSE_REASON_UNSPECIFIED = 0,
@@ -100,12 +452,133 @@ export function closeReasonToJSON(object: CloseReason): string {
   }
 }
 
+/**
+ * What the agent keeps from the earlier commands. The registry credentials
+ * and the secrets are not kept, they are sent with every request using them.
+ */
+export enum CacheKind {
+  CACHE_KIND_UNSPECIFIED = 0,
+  /** Base container configs by their id */
+  CACHE_KIND_CONFIG_BUNDLE = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function cacheKindFromJSON(object: any): CacheKind {
+  switch (object) {
+    case 0:
+    case 'CACHE_KIND_UNSPECIFIED':
+      return CacheKind.CACHE_KIND_UNSPECIFIED
+    case 3:
+    case 'CACHE_KIND_CONFIG_BUNDLE':
+      return CacheKind.CACHE_KIND_CONFIG_BUNDLE
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return CacheKind.UNRECOGNIZED
+  }
+}
+
+export function cacheKindToJSON(object: CacheKind): string {
+  switch (object) {
+    case CacheKind.CACHE_KIND_UNSPECIFIED:
+      return 'CACHE_KIND_UNSPECIFIED'
+    case CacheKind.CACHE_KIND_CONFIG_BUNDLE:
+      return 'CACHE_KIND_CONFIG_BUNDLE'
+    case CacheKind.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 /**  */
 export interface AgentInfo {
   id: string
   version: string
   publicKey: string
   containerName?: string | undefined
+  /**
+   * Optional features of the agent, the platform only sends their commands
+   * to the agents listing them, eg. checkpoint
+   */
+  capabilities: string[]
+  /**
+   * What the cluster of crane serves, discovered before connecting,
+   * the changes are reported with ClusterCapabilities
+   */
+  cluster?: ClusterCapabilitiesMessage | undefined
+}
+
+export interface ClusterIngressClass {
+  name: string
+  controller: string
+  default: boolean
+}
+
+export interface ClusterStorageClass {
+  name: string
+  provisioner: string
+  default: boolean
+  volumeExpansion: boolean
+}
+
+export interface ClusterCapabilitiesMessage {
+  /** The git version of the API server, eg. v1.28.3 */
+  serverVersion: string
+  discoveredAt: Timestamp | undefined
+  /** The served group versions, eg. apps/v1 or v1 for the core group */
+  apiVersions: string[]
+  ingressClasses: ClusterIngressClass[]
+  storageClasses: ClusterStorageClass[]
+  /** The parts which could not be discovered, eg. for the lack of permissions */
+  warnings: string[]
+}
+
+export interface HostDisk {
+  /** The mount point, as the agent sees it */
+  path: string
+  totalBytes: number
+  freeBytes: number
+  /** Free for the unprivileged users, the reserved blocks excluded */
+  availableBytes: number
+}
+
+/** The counters of the interface since the boot of the host */
+export interface HostNetworkInterface {
+  name: string
+  rxBytes: number
+  txBytes: number
+  rxPackets: number
+  txPackets: number
+  rxErrors: number
+  txErrors: number
+}
+
+export interface HostTemperature {
+  /** The thermal zone, eg. thermal_zone0 */
+  zone: string
+  /** The sensor of the zone, eg. x86_pkg_temp */
+  type: string
+  celsius: number
+}
+
+/**
+ * A sample of the host of the agent, streamed periodically by the agents
+ * with the hostMetrics capability
+ */
+export interface HostMetricsMessage {
+  collectedAt: Timestamp | undefined
+  /** The busy fraction of the CPU time since the previous sample */
+  cpuUsage: number
+  cpuCores: number
+  load1: number
+  load5: number
+  load15: number
+  memoryTotalBytes: number
+  memoryAvailableBytes: number
+  disks: HostDisk[]
+  interfaces: HostNetworkInterface[]
+  /** Empty if the host has no readable thermal zones */
+  temperatures: HostTemperature[]
 }
 
 export interface AgentCommand {
@@ -121,11 +594,36 @@ export interface AgentCommand {
   containerLog?: ContainerLogRequest | undefined
   replaceToken?: ReplaceTokenRequest | undefined
   containerInspect?: ContainerInspectRequest | undefined
+  deploymentDiff?: DeploymentDiffRequest | undefined
+  volumeUsage?: VolumeUsageRequest | undefined
+  rollbackToRevision?: RollbackToRevisionRequest | undefined
+  resourceRecommendation?: ResourceRecommendationRequest | undefined
+  workloadOperation?: WorkloadOperationRequest | undefined
+  containerCheckpoint?: ContainerCheckpointRequest | undefined
+  containerRestore?: ContainerRestoreRequest | undefined
+  pruneReport?: PruneReportRequest | undefined
+  trafficUsage?: TrafficUsageRequest | undefined
+  debugContainer?: DebugContainerRequest | undefined
+  ingressConfig?: IngressConfigRequest | undefined
+  invalidateCache?: CacheInvalidationRequest | undefined
 }
 
 export interface AgentError {
+  /** The gRPC status code of the category */
   status: number
   error: string
+  /** Stable, machine readable code of the error, e.g. CONTAINER_NOT_FOUND */
+  code: string
+  category: ErrorCategory
+  /** The same command can succeed later without changing it */
+  retryable: boolean
+  /** The context of the error, e.g. the kind and the name of the object */
+  details: { [key: string]: string }
+}
+
+export interface AgentError_DetailsEntry {
+  key: string
+  value: string
 }
 
 export interface AgentCommandError {
@@ -133,6 +631,23 @@ export interface AgentCommandError {
   deleteContainers?: AgentError | undefined
   containerLog?: AgentError | undefined
   containerInspect?: AgentError | undefined
+  deploymentDiff?: AgentError | undefined
+  volumeUsage?: AgentError | undefined
+  rollbackToRevision?: AgentError | undefined
+  resourceRecommendation?: AgentError | undefined
+  workloadOperation?: AgentError | undefined
+  containerCheckpoint?: AgentError | undefined
+  containerRestore?: AgentError | undefined
+  pruneReport?: AgentError | undefined
+  trafficUsage?: AgentError | undefined
+  debugContainer?: AgentError | undefined
+  ingressConfig?: AgentError | undefined
+  /** The deployment is in the dyo-deployment-id metadata */
+  deploy?: AgentError | undefined
+  deleteContainer?: AgentError | undefined
+  containerCommand?: AgentError | undefined
+  /** The prefix is in the dyo-container-prefix metadata */
+  containerState?: AgentError | undefined
 }
 
 /**
@@ -150,6 +665,18 @@ export interface DeployRequest {
   prefix: string
   secrets: { [key: string]: string }
   requests: DeployWorkloadRequest[]
+  /**
+   * Containers are only recreated if their configuration or image
+   * changed, unless this is set
+   */
+  recreateUnchanged: boolean
+  /**
+   * Set when the deployment is fanned out to the nodes of a node group,
+   * the same key is sent to every node of the group and with every retry,
+   * so the agents skip the deployment if they finished it already
+   */
+  idempotencyKey?: string | undefined
+  nodeGroup?: string | undefined
 }
 
 export interface DeployRequest_SecretsEntry {
@@ -160,6 +687,8 @@ export interface DeployRequest_SecretsEntry {
 /** Request for a keys of existing secrets in a prefix, eg. namespace */
 export interface ListSecretsRequest {
   target: ContainerOrPrefix | undefined
+  /** Every key is sent if it is not set */
+  page?: PageRequest | undefined
 }
 
 export interface RegistryAuth {
@@ -265,13 +794,70 @@ export interface ExpectedState {
   exitCode?: number | undefined
 }
 
+/**
+ * Restarts of a crash-looping container on top of its restart policy, the
+ * ones over the budget of the window are delayed by an exponential backoff
+ */
+export interface RestartBudget {
+  maxRestarts: number
+  windowSeconds: number
+  /** First delay of the backoff, doubled by every restart over the budget */
+  backoffSeconds?: number | undefined
+  maxBackoffSeconds?: number | undefined
+  giveUpAction?: RestartGiveUpAction | undefined
+}
+
+export interface NetworkConfig {
+  name: string
+  driver?: string | undefined
+  parent?: string | undefined
+  subnet?: string | undefined
+  gateway?: string | undefined
+  ipRange?: string | undefined
+  mode?: string | undefined
+  ipAddress?: string | undefined
+  options: { [key: string]: string }
+}
+
+export interface NetworkConfig_OptionsEntry {
+  key: string
+  value: string
+}
+
 export interface DagentContainerConfig {
   logConfig?: LogConfig | undefined
   restartPolicy?: RestartPolicy | undefined
   networkMode?: NetworkMode | undefined
   expectedState?: ExpectedState | undefined
+  resourceConfig?: ResourceConfig | undefined
+  isolation?: Isolation | undefined
+  pidMode?: string | undefined
+  ipcMode?: string | undefined
+  /**
+   * Templates of the hostname and the domain name, eg. {{ .Node }}-license,
+   * the variables are .Node, .Prefix, .Container and .RootDomain
+   */
+  hostname?: string | undefined
+  domainname?: string | undefined
+  restartBudget?: RestartBudget | undefined
+  /**
+   * OCI runtime of the container, eg. nvidia or kata, it has to be
+   * configured on the daemon, the default runtime is used if empty
+   */
+  runtime?: string | undefined
   networks: string[]
   labels: { [key: string]: string }
+  networkConfigs: NetworkConfig[]
+  /**
+   * Globs of the log files written by the container, eg. /app/logs/*.log,
+   * they are tailed into the container log, so they have to be in a mount
+   */
+  logFiles: string[]
+  /**
+   * Options of the runtime, passed as the OCI annotations of the container,
+   * eg. io.katacontainers.config.hypervisor.default_vcpus
+   */
+  runtimeOptions: { [key: string]: string }
 }
 
 export interface DagentContainerConfig_LabelsEntry {
@@ -279,6 +865,46 @@ export interface DagentContainerConfig_LabelsEntry {
   value: string
 }
 
+export interface DagentContainerConfig_RuntimeOptionsEntry {
+  key: string
+  value: string
+}
+
+/**
+ * A file mounted into the container, the content of secret files is encrypted
+ * like the secrets of the container
+ */
+export interface ConfigFile {
+  path: string
+  content: string
+  secret?: boolean | undefined
+}
+
+/**
+ * The service account of the container, crane creates one named after the
+ * container with the annotations, eg. eks.amazonaws.com/role-arn, unless an
+ * existing service account is set
+ */
+export interface WorkloadIdentity {
+  serviceAccount?: string | undefined
+  annotations: { [key: string]: string }
+}
+
+export interface WorkloadIdentity_AnnotationsEntry {
+  key: string
+  value: string
+}
+
+/**
+ * A service account token for the audience mounted into the container,
+ * the kubelet rotates it before it expires
+ */
+export interface ProjectedToken {
+  path: string
+  audience: string
+  expirationSeconds?: number | undefined
+}
+
 export interface CraneContainerConfig {
   deploymentStrategy?: DeploymentStrategy | undefined
   healthCheckConfig?: HealthCheckConfig | undefined
@@ -288,8 +914,12 @@ export interface CraneContainerConfig {
   annotations?: Marker | undefined
   labels?: Marker | undefined
   metrics?: Metrics | undefined
+  serviceType?: ServiceType | undefined
+  workloadIdentity?: WorkloadIdentity | undefined
   customHeaders: string[]
   extraLBAnnotations: { [key: string]: string }
+  configFiles: ConfigFile[]
+  projectedTokens: ProjectedToken[]
 }
 
 export interface CraneContainerConfig_ExtraLBAnnotationsEntry {
@@ -336,11 +966,38 @@ export interface DeployWorkloadRequest {
   imageName: string
   tag: string
   registryAuth?: RegistryAuth | undefined
+  /** the container config is merged from a base shared by the environments, the name is still taken from common */
+  base?: BaseConfigRef | undefined
+}
+
+/**
+ * A base container config referenced by its ID and hash, the agent keeps the
+ * last one of every ID, so the config is only sent if the agent does not have it yet.
+ * The agent keeps a limited number of them, if the config is missing, the
+ * deployment fails with the BASE_CONFIG_NOT_FOUND code and the request has to
+ * be sent again with the config
+ */
+export interface BaseConfigRef {
+  id: string
+  /** hex encoded sha256 of the config json */
+  hash: string
+  /** json merge patch (RFC 7386) of the environment applied to the base */
+  overlayJson: string
+  configJson?: string | undefined
 }
 
+/**
+ * The filter applies to the updates of the watching streams too, the
+ * update of a container which stops matching it is sent once, e.g. its
+ * removal, then it is not sent until it matches again. The page only
+ * applies to the one shot listings, the updates are sent in batches. An
+ * invalid page token fails the request with the INVALID_PAGE_TOKEN code.
+ */
 export interface ContainerStateRequest {
   prefix?: string | undefined
   oneShot?: boolean | undefined
+  filter?: ListFilter | undefined
+  page?: PageRequest | undefined
 }
 
 export interface ContainerDeleteRequest {
@@ -381,32 +1038,602 @@ export interface ContainerInspectRequest {
   container: ContainerIdentifier | undefined
 }
 
-export interface CloseConnectionRequest {
-  reason: CloseReason
+/**
+ * Compares the requests with the snapshots of the running deployment,
+ * without deploying anything
+ */
+export interface DeploymentDiffRequest {
+  id: string
+  prefix: string
+  requests: DeployWorkloadRequest[]
 }
 
-export const AGENT_PACKAGE_NAME = 'agent'
+export interface DeploymentFieldChange {
+  path: string
+  old?: string | undefined
+  new?: string | undefined
+}
 
-function createBaseAgentInfo(): AgentInfo {
-  return { id: '', version: '', publicKey: '' }
+export interface WorkloadDiff {
+  id: string
+  container: ContainerIdentifier | undefined
+  deployed: boolean
+  currentHash?: string | undefined
+  newHash: string
+  changes: DeploymentFieldChange[]
 }
 
-export const AgentInfo = {
-  fromJSON(object: any): AgentInfo {
-    return {
-      id: isSet(object.id) ? String(object.id) : '',
-      version: isSet(object.version) ? String(object.version) : '',
-      publicKey: isSet(object.publicKey) ? String(object.publicKey) : '',
-      containerName: isSet(object.containerName) ? String(object.containerName) : undefined,
-    }
-  },
+export interface DeploymentDiffResponse {
+  id: string
+  workloads: WorkloadDiff[]
+}
 
-  toJSON(message: AgentInfo): unknown {
-    const obj: any = {}
-    message.id !== undefined && (obj.id = message.id)
+/**
+ * Disk usage of the volumes attached to the managed containers,
+ * limited to the containers of the prefix if it is set
+ */
+export interface VolumeUsageRequest {
+  prefix?: string | undefined
+}
+
+export interface VolumeUsage {
+  name: string
+  driver: string
+  /** Size in bytes, -1 if the driver can not tell it */
+  size: number
+  containers: ContainerIdentifier[]
+}
+
+export interface VolumeUsageResponse {
+  prefix?: string | undefined
+  volumes: VolumeUsage[]
+}
+
+/**
+ * Dry run of the prunes, lists what each of them would remove with the space
+ * it frees up, nothing is removed, every target is reported if none is set
+ */
+export interface PruneReportRequest {
+  targets: PruneTarget[]
+}
+
+export interface PruneCandidate {
+  target: PruneTarget
+  id: string
+  name: string
+  /** Reclaimed bytes, -1 if the daemon can not tell it */
+  size: number
+  reason: string
+  container?: ContainerIdentifier | undefined
+}
+
+export interface PruneReportResponse {
+  /** Sum of the known sizes of the candidates */
+  reclaimable: number
+  candidates: PruneCandidate[]
+}
+
+/**
+ * Network traffic of the managed containers summed up by their prefix, the
+ * agent samples the counters of the containers, its totals start over when
+ * it restarts, limited to the prefix if it is set
+ */
+export interface TrafficUsageRequest {
+  prefix?: string | undefined
+}
+
+export interface TrafficTotal {
+  rxBytes: number
+  txBytes: number
+}
+
+export interface DailyTraffic {
+  /** The UTC day as YYYY-MM-DD */
+  day: string
+  total: TrafficTotal | undefined
+}
+
+export interface PrefixTraffic {
+  prefix: string
+  /** Traffic of the last 24 hours, by the hour */
+  rolling: TrafficTotal | undefined
+  /** The oldest day comes first */
+  daily: DailyTraffic[]
+}
+
+export interface TrafficUsageResponse {
+  prefix?: string | undefined
+  /** The start of the sampling */
+  since: Timestamp | undefined
+  prefixes: PrefixTraffic[]
+}
+
+/**
+ * The Traefik instance of the node, only sent to the agents with the
+ * managed ingress capability. The agent creates it, recreates it when the
+ * configuration or the version changes, and removes it if it is disabled.
+ */
+export interface IngressConfigRequest {
+  enabled: boolean
+  /** The image tag of Traefik, eg. v2.11 */
+  version: string
+  logLevel: string
+  port: number
+  /** The TLS entrypoint with the ACME certificates, HTTP only if it is not set */
+  tlsPort?: number | undefined
+  acmeEmail?: string | undefined
+  /** The directory of the ACME certificates on the node, relative to the data path of the agent */
+  acmeStorage?: string | undefined
+  /** The dashboard is served on this port if it is set */
+  dashboardPort?: number | undefined
+  /** Additional entrypoints besides web and websecure */
+  entrypoints: IngressEntrypoint[]
+}
+
+export interface IngressEntrypoint {
+  name: string
+  port: number
+}
+
+export interface IngressConfigResponse {
+  enabled: boolean
+  version: string
+  /** The container was recreated, or removed, for the configuration */
+  changed: boolean
+  containerId?: string | undefined
+}
+
+/**
+ * Rolls the container back to an earlier revision of its deployment,
+ * the previous one if the revision is not set
+ */
+export interface RollbackToRevisionRequest {
+  container: ContainerIdentifier | undefined
+  revision?: number | undefined
+  actor?: string | undefined
+}
+
+export interface DeploymentRevision {
+  revision: number
+  configHash: string
+  createdAt: Timestamp | undefined
+  actor?: string | undefined
+  /** The revision the rollback restored, if this revision is a rollback */
+  rollbackOf?: number | undefined
+  /** Image references and the digests of the images by container name */
+  images: { [key: string]: string }
+  digests: { [key: string]: string }
+}
+
+export interface DeploymentRevision_ImagesEntry {
+  key: string
+  value: string
+}
+
+export interface DeploymentRevision_DigestsEntry {
+  key: string
+  value: string
+}
+
+export interface RollbackToRevisionResponse {
+  container: ContainerIdentifier | undefined
+  revision: DeploymentRevision | undefined
+  history: DeploymentRevision[]
+}
+
+/**
+ * Resource requests recommended from the 95th percentile of the sampled usage
+ * of the managed containers, limited to the prefix if it is set
+ */
+export interface ResourceRecommendationRequest {
+  prefix?: string | undefined
+}
+
+export interface ResourceRecommendation {
+  container: ContainerIdentifier | undefined
+  /** The current requests of the container */
+  requests?: Resource | undefined
+  recommended: Resource | undefined
+  samples: number
+}
+
+export interface ResourceRecommendationResponse {
+  prefix?: string | undefined
+  recommendations: ResourceRecommendation[]
+}
+
+export interface WorkloadOperationRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  operation: WorkloadOperation
+  /** The pod to delete */
+  pod?: string | undefined
+  /** Deletes the pod without a grace period */
+  force?: boolean | undefined
+  /** The replica count to scale to */
+  replicas?: number | undefined
+}
+
+export interface WorkloadOperationMessage {
+  status: DeploymentStatus
+  message: string
+  createdAt: Timestamp | undefined
+  replicas?: number | undefined
+  updatedReplicas?: number | undefined
+  readyReplicas?: number | undefined
+}
+
+/**
+ * Attaches an ephemeral debug container to a pod of the workload of the
+ * container, like kubectl debug, its terminal is streamed on a DebugSession
+ * channel opened with the id, so images without a shell can be inspected
+ */
+export interface DebugContainerRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  image: string
+  /** The pod to attach to, a running pod of the workload if it is not set */
+  pod?: string | undefined
+  /** Replaces the entrypoint of the image */
+  command: string[]
+  tty: boolean
+}
+
+export interface TerminalSize {
+  width: number
+  height: number
+}
+
+export interface DebugSessionStarted {
+  pod: string
+  container: string
+}
+
+export interface DebugSessionEnded {
+  error?: string | undefined
+}
+
+export interface DebugSessionInput {
+  stdin?: Uint8Array | undefined
+  resize?: TerminalSize | undefined
+  /** Closes the stdin of the session */
+  closeStdin?: Empty | undefined
+}
+
+/** The first message tells the debug container, the last one ends the session */
+export interface DebugSessionOutput {
+  started?: DebugSessionStarted | undefined
+  stdout?: Uint8Array | undefined
+  stderr?: Uint8Array | undefined
+  ended?: DebugSessionEnded | undefined
+}
+
+export interface CloseConnectionRequest {
+  reason: CloseReason
+}
+
+/**
+ * Pushed when the configuration is rotated on the server, the agent drops
+ * the cached entries, so they are sent again with the next deploy request
+ * referencing them. The unspecified kind invalidates every
+ * cache, the entries of the kind are all invalidated if the key is not set.
+ * There is no response, the agent only logs the result.
+ */
+export interface CacheInvalidationRequest {
+  kind: CacheKind
+  key?: string | undefined
+}
+
+/**
+ * Experimental, the state of a running container is checkpointed with CRIU,
+ * then the container is stopped unless leaveRunning is set.
+ * The id is generated by the agent if it is empty.
+ */
+export interface ContainerCheckpointRequest {
+  container: ContainerIdentifier | undefined
+  checkpointId?: string | undefined
+  leaveRunning: boolean
+}
+
+export interface ContainerCheckpointResponse {
+  container: ContainerIdentifier | undefined
+  checkpointId: string
+  /** The directory of the checkpoint on the node, empty if it is kept by the container runtime */
+  checkpointDir?: string | undefined
+  /** The checkpoints of the container, the new one included */
+  checkpoints: string[]
+}
+
+/**
+ * Experimental, a stopped container is started from a checkpoint. After the
+ * migration of its volumes, the container has to be deployed on the new node
+ * without starting it, and the checkpoint copied to checkpointDir.
+ */
+export interface ContainerRestoreRequest {
+  container: ContainerIdentifier | undefined
+  checkpointId: string
+  checkpointDir?: string | undefined
+}
+
+export interface ContainerRestoreResponse {
+  container: ContainerIdentifier | undefined
+  checkpointId: string
+}
+
+export const AGENT_PACKAGE_NAME = 'agent'
+
+function createBaseAgentInfo(): AgentInfo {
+  return { id: '', version: '', publicKey: '', capabilities: [] }
+}
+
+export const AgentInfo = {
+  fromJSON(object: any): AgentInfo {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      version: isSet(object.version) ? String(object.version) : '',
+      publicKey: isSet(object.publicKey) ? String(object.publicKey) : '',
+      containerName: isSet(object.containerName) ? String(object.containerName) : undefined,
+      capabilities: Array.isArray(object?.capabilities) ? object.capabilities.map((e: any) => String(e)) : [],
+      cluster: isSet(object.cluster) ? ClusterCapabilitiesMessage.fromJSON(object.cluster) : undefined,
+    }
+  },
+
+  toJSON(message: AgentInfo): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
     message.version !== undefined && (obj.version = message.version)
     message.publicKey !== undefined && (obj.publicKey = message.publicKey)
     message.containerName !== undefined && (obj.containerName = message.containerName)
+    if (message.capabilities) {
+      obj.capabilities = message.capabilities.map(e => e)
+    } else {
+      obj.capabilities = []
+    }
+    message.cluster !== undefined &&
+      (obj.cluster = message.cluster ? ClusterCapabilitiesMessage.toJSON(message.cluster) : undefined)
+    return obj
+  },
+}
+
+function createBaseClusterIngressClass(): ClusterIngressClass {
+  return { name: '', controller: '', default: false }
+}
+
+export const ClusterIngressClass = {
+  fromJSON(object: any): ClusterIngressClass {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      controller: isSet(object.controller) ? String(object.controller) : '',
+      default: isSet(object.default) ? Boolean(object.default) : false,
+    }
+  },
+
+  toJSON(message: ClusterIngressClass): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.controller !== undefined && (obj.controller = message.controller)
+    message.default !== undefined && (obj.default = message.default)
+    return obj
+  },
+}
+
+function createBaseClusterStorageClass(): ClusterStorageClass {
+  return { name: '', provisioner: '', default: false, volumeExpansion: false }
+}
+
+export const ClusterStorageClass = {
+  fromJSON(object: any): ClusterStorageClass {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      provisioner: isSet(object.provisioner) ? String(object.provisioner) : '',
+      default: isSet(object.default) ? Boolean(object.default) : false,
+      volumeExpansion: isSet(object.volumeExpansion) ? Boolean(object.volumeExpansion) : false,
+    }
+  },
+
+  toJSON(message: ClusterStorageClass): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.provisioner !== undefined && (obj.provisioner = message.provisioner)
+    message.default !== undefined && (obj.default = message.default)
+    message.volumeExpansion !== undefined && (obj.volumeExpansion = message.volumeExpansion)
+    return obj
+  },
+}
+
+function createBaseClusterCapabilitiesMessage(): ClusterCapabilitiesMessage {
+  return {
+    serverVersion: '',
+    discoveredAt: undefined,
+    apiVersions: [],
+    ingressClasses: [],
+    storageClasses: [],
+    warnings: [],
+  }
+}
+
+export const ClusterCapabilitiesMessage = {
+  fromJSON(object: any): ClusterCapabilitiesMessage {
+    return {
+      serverVersion: isSet(object.serverVersion) ? String(object.serverVersion) : '',
+      discoveredAt: isSet(object.discoveredAt) ? fromJsonTimestamp(object.discoveredAt) : undefined,
+      apiVersions: Array.isArray(object?.apiVersions) ? object.apiVersions.map((e: any) => String(e)) : [],
+      ingressClasses: Array.isArray(object?.ingressClasses)
+        ? object.ingressClasses.map((e: any) => ClusterIngressClass.fromJSON(e))
+        : [],
+      storageClasses: Array.isArray(object?.storageClasses)
+        ? object.storageClasses.map((e: any) => ClusterStorageClass.fromJSON(e))
+        : [],
+      warnings: Array.isArray(object?.warnings) ? object.warnings.map((e: any) => String(e)) : [],
+    }
+  },
+
+  toJSON(message: ClusterCapabilitiesMessage): unknown {
+    const obj: any = {}
+    message.serverVersion !== undefined && (obj.serverVersion = message.serverVersion)
+    message.discoveredAt !== undefined && (obj.discoveredAt = fromTimestamp(message.discoveredAt).toISOString())
+    if (message.apiVersions) {
+      obj.apiVersions = message.apiVersions.map(e => e)
+    } else {
+      obj.apiVersions = []
+    }
+    if (message.ingressClasses) {
+      obj.ingressClasses = message.ingressClasses.map(e => (e ? ClusterIngressClass.toJSON(e) : undefined))
+    } else {
+      obj.ingressClasses = []
+    }
+    if (message.storageClasses) {
+      obj.storageClasses = message.storageClasses.map(e => (e ? ClusterStorageClass.toJSON(e) : undefined))
+    } else {
+      obj.storageClasses = []
+    }
+    if (message.warnings) {
+      obj.warnings = message.warnings.map(e => e)
+    } else {
+      obj.warnings = []
+    }
+    return obj
+  },
+}
+
+function createBaseHostDisk(): HostDisk {
+  return { path: '', totalBytes: 0, freeBytes: 0, availableBytes: 0 }
+}
+
+export const HostDisk = {
+  fromJSON(object: any): HostDisk {
+    return {
+      path: isSet(object.path) ? String(object.path) : '',
+      totalBytes: isSet(object.totalBytes) ? Number(object.totalBytes) : 0,
+      freeBytes: isSet(object.freeBytes) ? Number(object.freeBytes) : 0,
+      availableBytes: isSet(object.availableBytes) ? Number(object.availableBytes) : 0,
+    }
+  },
+
+  toJSON(message: HostDisk): unknown {
+    const obj: any = {}
+    message.path !== undefined && (obj.path = message.path)
+    message.totalBytes !== undefined && (obj.totalBytes = Math.round(message.totalBytes))
+    message.freeBytes !== undefined && (obj.freeBytes = Math.round(message.freeBytes))
+    message.availableBytes !== undefined && (obj.availableBytes = Math.round(message.availableBytes))
+    return obj
+  },
+}
+
+function createBaseHostNetworkInterface(): HostNetworkInterface {
+  return { name: '', rxBytes: 0, txBytes: 0, rxPackets: 0, txPackets: 0, rxErrors: 0, txErrors: 0 }
+}
+
+export const HostNetworkInterface = {
+  fromJSON(object: any): HostNetworkInterface {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      rxBytes: isSet(object.rxBytes) ? Number(object.rxBytes) : 0,
+      txBytes: isSet(object.txBytes) ? Number(object.txBytes) : 0,
+      rxPackets: isSet(object.rxPackets) ? Number(object.rxPackets) : 0,
+      txPackets: isSet(object.txPackets) ? Number(object.txPackets) : 0,
+      rxErrors: isSet(object.rxErrors) ? Number(object.rxErrors) : 0,
+      txErrors: isSet(object.txErrors) ? Number(object.txErrors) : 0,
+    }
+  },
+
+  toJSON(message: HostNetworkInterface): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.rxBytes !== undefined && (obj.rxBytes = Math.round(message.rxBytes))
+    message.txBytes !== undefined && (obj.txBytes = Math.round(message.txBytes))
+    message.rxPackets !== undefined && (obj.rxPackets = Math.round(message.rxPackets))
+    message.txPackets !== undefined && (obj.txPackets = Math.round(message.txPackets))
+    message.rxErrors !== undefined && (obj.rxErrors = Math.round(message.rxErrors))
+    message.txErrors !== undefined && (obj.txErrors = Math.round(message.txErrors))
+    return obj
+  },
+}
+
+function createBaseHostTemperature(): HostTemperature {
+  return { zone: '', type: '', celsius: 0 }
+}
+
+export const HostTemperature = {
+  fromJSON(object: any): HostTemperature {
+    return {
+      zone: isSet(object.zone) ? String(object.zone) : '',
+      type: isSet(object.type) ? String(object.type) : '',
+      celsius: isSet(object.celsius) ? Number(object.celsius) : 0,
+    }
+  },
+
+  toJSON(message: HostTemperature): unknown {
+    const obj: any = {}
+    message.zone !== undefined && (obj.zone = message.zone)
+    message.type !== undefined && (obj.type = message.type)
+    message.celsius !== undefined && (obj.celsius = message.celsius)
+    return obj
+  },
+}
+
+function createBaseHostMetricsMessage(): HostMetricsMessage {
+  return {
+    collectedAt: undefined,
+    cpuUsage: 0,
+    cpuCores: 0,
+    load1: 0,
+    load5: 0,
+    load15: 0,
+    memoryTotalBytes: 0,
+    memoryAvailableBytes: 0,
+    disks: [],
+    interfaces: [],
+    temperatures: [],
+  }
+}
+
+export const HostMetricsMessage = {
+  fromJSON(object: any): HostMetricsMessage {
+    return {
+      collectedAt: isSet(object.collectedAt) ? fromJsonTimestamp(object.collectedAt) : undefined,
+      cpuUsage: isSet(object.cpuUsage) ? Number(object.cpuUsage) : 0,
+      cpuCores: isSet(object.cpuCores) ? Number(object.cpuCores) : 0,
+      load1: isSet(object.load1) ? Number(object.load1) : 0,
+      load5: isSet(object.load5) ? Number(object.load5) : 0,
+      load15: isSet(object.load15) ? Number(object.load15) : 0,
+      memoryTotalBytes: isSet(object.memoryTotalBytes) ? Number(object.memoryTotalBytes) : 0,
+      memoryAvailableBytes: isSet(object.memoryAvailableBytes) ? Number(object.memoryAvailableBytes) : 0,
+      disks: Array.isArray(object?.disks) ? object.disks.map((e: any) => HostDisk.fromJSON(e)) : [],
+      interfaces: Array.isArray(object?.interfaces)
+        ? object.interfaces.map((e: any) => HostNetworkInterface.fromJSON(e))
+        : [],
+      temperatures: Array.isArray(object?.temperatures)
+        ? object.temperatures.map((e: any) => HostTemperature.fromJSON(e))
+        : [],
+    }
+  },
+
+  toJSON(message: HostMetricsMessage): unknown {
+    const obj: any = {}
+    message.collectedAt !== undefined && (obj.collectedAt = fromTimestamp(message.collectedAt).toISOString())
+    message.cpuUsage !== undefined && (obj.cpuUsage = message.cpuUsage)
+    message.cpuCores !== undefined && (obj.cpuCores = Math.round(message.cpuCores))
+    message.load1 !== undefined && (obj.load1 = message.load1)
+    message.load5 !== undefined && (obj.load5 = message.load5)
+    message.load15 !== undefined && (obj.load15 = message.load15)
+    message.memoryTotalBytes !== undefined && (obj.memoryTotalBytes = Math.round(message.memoryTotalBytes))
+    message.memoryAvailableBytes !== undefined && (obj.memoryAvailableBytes = Math.round(message.memoryAvailableBytes))
+    if (message.disks) {
+      obj.disks = message.disks.map(e => (e ? HostDisk.toJSON(e) : undefined))
+    } else {
+      obj.disks = []
+    }
+    if (message.interfaces) {
+      obj.interfaces = message.interfaces.map(e => (e ? HostNetworkInterface.toJSON(e) : undefined))
+    } else {
+      obj.interfaces = []
+    }
+    if (message.temperatures) {
+      obj.temperatures = message.temperatures.map(e => (e ? HostTemperature.toJSON(e) : undefined))
+    } else {
+      obj.temperatures = []
+    }
     return obj
   },
 }
@@ -415,655 +1642,2047 @@ function createBaseAgentCommand(): AgentCommand {
   return {}
 }
 
-export const AgentCommand = {
-  fromJSON(object: any): AgentCommand {
+export const AgentCommand = {
+  fromJSON(object: any): AgentCommand {
+    return {
+      deploy: isSet(object.deploy) ? DeployRequest.fromJSON(object.deploy) : undefined,
+      containerState: isSet(object.containerState) ? ContainerStateRequest.fromJSON(object.containerState) : undefined,
+      containerDelete: isSet(object.containerDelete)
+        ? ContainerDeleteRequest.fromJSON(object.containerDelete)
+        : undefined,
+      deployLegacy: isSet(object.deployLegacy) ? DeployRequestLegacy.fromJSON(object.deployLegacy) : undefined,
+      listSecrets: isSet(object.listSecrets) ? ListSecretsRequest.fromJSON(object.listSecrets) : undefined,
+      update: isSet(object.update) ? AgentUpdateRequest.fromJSON(object.update) : undefined,
+      close: isSet(object.close) ? CloseConnectionRequest.fromJSON(object.close) : undefined,
+      containerCommand: isSet(object.containerCommand)
+        ? ContainerCommandRequest.fromJSON(object.containerCommand)
+        : undefined,
+      deleteContainers: isSet(object.deleteContainers)
+        ? DeleteContainersRequest.fromJSON(object.deleteContainers)
+        : undefined,
+      containerLog: isSet(object.containerLog) ? ContainerLogRequest.fromJSON(object.containerLog) : undefined,
+      replaceToken: isSet(object.replaceToken) ? ReplaceTokenRequest.fromJSON(object.replaceToken) : undefined,
+      containerInspect: isSet(object.containerInspect)
+        ? ContainerInspectRequest.fromJSON(object.containerInspect)
+        : undefined,
+      deploymentDiff: isSet(object.deploymentDiff) ? DeploymentDiffRequest.fromJSON(object.deploymentDiff) : undefined,
+      volumeUsage: isSet(object.volumeUsage) ? VolumeUsageRequest.fromJSON(object.volumeUsage) : undefined,
+      rollbackToRevision: isSet(object.rollbackToRevision)
+        ? RollbackToRevisionRequest.fromJSON(object.rollbackToRevision)
+        : undefined,
+      resourceRecommendation: isSet(object.resourceRecommendation)
+        ? ResourceRecommendationRequest.fromJSON(object.resourceRecommendation)
+        : undefined,
+      workloadOperation: isSet(object.workloadOperation)
+        ? WorkloadOperationRequest.fromJSON(object.workloadOperation)
+        : undefined,
+      containerCheckpoint: isSet(object.containerCheckpoint)
+        ? ContainerCheckpointRequest.fromJSON(object.containerCheckpoint)
+        : undefined,
+      containerRestore: isSet(object.containerRestore)
+        ? ContainerRestoreRequest.fromJSON(object.containerRestore)
+        : undefined,
+      pruneReport: isSet(object.pruneReport) ? PruneReportRequest.fromJSON(object.pruneReport) : undefined,
+      trafficUsage: isSet(object.trafficUsage) ? TrafficUsageRequest.fromJSON(object.trafficUsage) : undefined,
+      debugContainer: isSet(object.debugContainer) ? DebugContainerRequest.fromJSON(object.debugContainer) : undefined,
+      ingressConfig: isSet(object.ingressConfig) ? IngressConfigRequest.fromJSON(object.ingressConfig) : undefined,
+      invalidateCache: isSet(object.invalidateCache)
+        ? CacheInvalidationRequest.fromJSON(object.invalidateCache)
+        : undefined,
+    }
+  },
+
+  toJSON(message: AgentCommand): unknown {
+    const obj: any = {}
+    message.deploy !== undefined && (obj.deploy = message.deploy ? DeployRequest.toJSON(message.deploy) : undefined)
+    message.containerState !== undefined &&
+      (obj.containerState = message.containerState ? ContainerStateRequest.toJSON(message.containerState) : undefined)
+    message.containerDelete !== undefined &&
+      (obj.containerDelete = message.containerDelete
+        ? ContainerDeleteRequest.toJSON(message.containerDelete)
+        : undefined)
+    message.deployLegacy !== undefined &&
+      (obj.deployLegacy = message.deployLegacy ? DeployRequestLegacy.toJSON(message.deployLegacy) : undefined)
+    message.listSecrets !== undefined &&
+      (obj.listSecrets = message.listSecrets ? ListSecretsRequest.toJSON(message.listSecrets) : undefined)
+    message.update !== undefined &&
+      (obj.update = message.update ? AgentUpdateRequest.toJSON(message.update) : undefined)
+    message.close !== undefined &&
+      (obj.close = message.close ? CloseConnectionRequest.toJSON(message.close) : undefined)
+    message.containerCommand !== undefined &&
+      (obj.containerCommand = message.containerCommand
+        ? ContainerCommandRequest.toJSON(message.containerCommand)
+        : undefined)
+    message.deleteContainers !== undefined &&
+      (obj.deleteContainers = message.deleteContainers
+        ? DeleteContainersRequest.toJSON(message.deleteContainers)
+        : undefined)
+    message.containerLog !== undefined &&
+      (obj.containerLog = message.containerLog ? ContainerLogRequest.toJSON(message.containerLog) : undefined)
+    message.replaceToken !== undefined &&
+      (obj.replaceToken = message.replaceToken ? ReplaceTokenRequest.toJSON(message.replaceToken) : undefined)
+    message.containerInspect !== undefined &&
+      (obj.containerInspect = message.containerInspect
+        ? ContainerInspectRequest.toJSON(message.containerInspect)
+        : undefined)
+    message.deploymentDiff !== undefined &&
+      (obj.deploymentDiff = message.deploymentDiff ? DeploymentDiffRequest.toJSON(message.deploymentDiff) : undefined)
+    message.volumeUsage !== undefined &&
+      (obj.volumeUsage = message.volumeUsage ? VolumeUsageRequest.toJSON(message.volumeUsage) : undefined)
+    message.rollbackToRevision !== undefined &&
+      (obj.rollbackToRevision = message.rollbackToRevision
+        ? RollbackToRevisionRequest.toJSON(message.rollbackToRevision)
+        : undefined)
+    message.resourceRecommendation !== undefined &&
+      (obj.resourceRecommendation = message.resourceRecommendation
+        ? ResourceRecommendationRequest.toJSON(message.resourceRecommendation)
+        : undefined)
+    message.workloadOperation !== undefined &&
+      (obj.workloadOperation = message.workloadOperation
+        ? WorkloadOperationRequest.toJSON(message.workloadOperation)
+        : undefined)
+    message.containerCheckpoint !== undefined &&
+      (obj.containerCheckpoint = message.containerCheckpoint
+        ? ContainerCheckpointRequest.toJSON(message.containerCheckpoint)
+        : undefined)
+    message.containerRestore !== undefined &&
+      (obj.containerRestore = message.containerRestore
+        ? ContainerRestoreRequest.toJSON(message.containerRestore)
+        : undefined)
+    message.pruneReport !== undefined &&
+      (obj.pruneReport = message.pruneReport ? PruneReportRequest.toJSON(message.pruneReport) : undefined)
+    message.trafficUsage !== undefined &&
+      (obj.trafficUsage = message.trafficUsage ? TrafficUsageRequest.toJSON(message.trafficUsage) : undefined)
+    message.debugContainer !== undefined &&
+      (obj.debugContainer = message.debugContainer ? DebugContainerRequest.toJSON(message.debugContainer) : undefined)
+    message.ingressConfig !== undefined &&
+      (obj.ingressConfig = message.ingressConfig ? IngressConfigRequest.toJSON(message.ingressConfig) : undefined)
+    message.invalidateCache !== undefined &&
+      (obj.invalidateCache = message.invalidateCache
+        ? CacheInvalidationRequest.toJSON(message.invalidateCache)
+        : undefined)
+    return obj
+  },
+}
+
+function createBaseAgentError(): AgentError {
+  return { status: 0, error: '', code: '', category: 0, retryable: false, details: {} }
+}
+
+export const AgentError = {
+  fromJSON(object: any): AgentError {
+    return {
+      status: isSet(object.status) ? Number(object.status) : 0,
+      error: isSet(object.error) ? String(object.error) : '',
+      code: isSet(object.code) ? String(object.code) : '',
+      category: isSet(object.category) ? errorCategoryFromJSON(object.category) : 0,
+      retryable: isSet(object.retryable) ? Boolean(object.retryable) : false,
+      details: isObject(object.details)
+        ? Object.entries(object.details).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: AgentError): unknown {
+    const obj: any = {}
+    message.status !== undefined && (obj.status = Math.round(message.status))
+    message.error !== undefined && (obj.error = message.error)
+    message.code !== undefined && (obj.code = message.code)
+    message.category !== undefined && (obj.category = errorCategoryToJSON(message.category))
+    message.retryable !== undefined && (obj.retryable = message.retryable)
+    obj.details = {}
+    if (message.details) {
+      Object.entries(message.details).forEach(([k, v]) => {
+        obj.details[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseAgentError_DetailsEntry(): AgentError_DetailsEntry {
+  return { key: '', value: '' }
+}
+
+export const AgentError_DetailsEntry = {
+  fromJSON(object: any): AgentError_DetailsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: AgentError_DetailsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseAgentCommandError(): AgentCommandError {
+  return {}
+}
+
+export const AgentCommandError = {
+  fromJSON(object: any): AgentCommandError {
+    return {
+      listSecrets: isSet(object.listSecrets) ? AgentError.fromJSON(object.listSecrets) : undefined,
+      deleteContainers: isSet(object.deleteContainers) ? AgentError.fromJSON(object.deleteContainers) : undefined,
+      containerLog: isSet(object.containerLog) ? AgentError.fromJSON(object.containerLog) : undefined,
+      containerInspect: isSet(object.containerInspect) ? AgentError.fromJSON(object.containerInspect) : undefined,
+      deploymentDiff: isSet(object.deploymentDiff) ? AgentError.fromJSON(object.deploymentDiff) : undefined,
+      volumeUsage: isSet(object.volumeUsage) ? AgentError.fromJSON(object.volumeUsage) : undefined,
+      rollbackToRevision: isSet(object.rollbackToRevision) ? AgentError.fromJSON(object.rollbackToRevision) : undefined,
+      resourceRecommendation: isSet(object.resourceRecommendation)
+        ? AgentError.fromJSON(object.resourceRecommendation)
+        : undefined,
+      workloadOperation: isSet(object.workloadOperation) ? AgentError.fromJSON(object.workloadOperation) : undefined,
+      containerCheckpoint: isSet(object.containerCheckpoint)
+        ? AgentError.fromJSON(object.containerCheckpoint)
+        : undefined,
+      containerRestore: isSet(object.containerRestore) ? AgentError.fromJSON(object.containerRestore) : undefined,
+      pruneReport: isSet(object.pruneReport) ? AgentError.fromJSON(object.pruneReport) : undefined,
+      trafficUsage: isSet(object.trafficUsage) ? AgentError.fromJSON(object.trafficUsage) : undefined,
+      debugContainer: isSet(object.debugContainer) ? AgentError.fromJSON(object.debugContainer) : undefined,
+      ingressConfig: isSet(object.ingressConfig) ? AgentError.fromJSON(object.ingressConfig) : undefined,
+      deploy: isSet(object.deploy) ? AgentError.fromJSON(object.deploy) : undefined,
+      deleteContainer: isSet(object.deleteContainer) ? AgentError.fromJSON(object.deleteContainer) : undefined,
+      containerCommand: isSet(object.containerCommand) ? AgentError.fromJSON(object.containerCommand) : undefined,
+      containerState: isSet(object.containerState) ? AgentError.fromJSON(object.containerState) : undefined,
+    }
+  },
+
+  toJSON(message: AgentCommandError): unknown {
+    const obj: any = {}
+    message.listSecrets !== undefined &&
+      (obj.listSecrets = message.listSecrets ? AgentError.toJSON(message.listSecrets) : undefined)
+    message.deleteContainers !== undefined &&
+      (obj.deleteContainers = message.deleteContainers ? AgentError.toJSON(message.deleteContainers) : undefined)
+    message.containerLog !== undefined &&
+      (obj.containerLog = message.containerLog ? AgentError.toJSON(message.containerLog) : undefined)
+    message.containerInspect !== undefined &&
+      (obj.containerInspect = message.containerInspect ? AgentError.toJSON(message.containerInspect) : undefined)
+    message.deploymentDiff !== undefined &&
+      (obj.deploymentDiff = message.deploymentDiff ? AgentError.toJSON(message.deploymentDiff) : undefined)
+    message.volumeUsage !== undefined &&
+      (obj.volumeUsage = message.volumeUsage ? AgentError.toJSON(message.volumeUsage) : undefined)
+    message.rollbackToRevision !== undefined &&
+      (obj.rollbackToRevision = message.rollbackToRevision ? AgentError.toJSON(message.rollbackToRevision) : undefined)
+    message.resourceRecommendation !== undefined &&
+      (obj.resourceRecommendation = message.resourceRecommendation
+        ? AgentError.toJSON(message.resourceRecommendation)
+        : undefined)
+    message.workloadOperation !== undefined &&
+      (obj.workloadOperation = message.workloadOperation ? AgentError.toJSON(message.workloadOperation) : undefined)
+    message.containerCheckpoint !== undefined &&
+      (obj.containerCheckpoint = message.containerCheckpoint
+        ? AgentError.toJSON(message.containerCheckpoint)
+        : undefined)
+    message.containerRestore !== undefined &&
+      (obj.containerRestore = message.containerRestore ? AgentError.toJSON(message.containerRestore) : undefined)
+    message.pruneReport !== undefined &&
+      (obj.pruneReport = message.pruneReport ? AgentError.toJSON(message.pruneReport) : undefined)
+    message.trafficUsage !== undefined &&
+      (obj.trafficUsage = message.trafficUsage ? AgentError.toJSON(message.trafficUsage) : undefined)
+    message.debugContainer !== undefined &&
+      (obj.debugContainer = message.debugContainer ? AgentError.toJSON(message.debugContainer) : undefined)
+    message.ingressConfig !== undefined &&
+      (obj.ingressConfig = message.ingressConfig ? AgentError.toJSON(message.ingressConfig) : undefined)
+    message.deploy !== undefined && (obj.deploy = message.deploy ? AgentError.toJSON(message.deploy) : undefined)
+    message.deleteContainer !== undefined &&
+      (obj.deleteContainer = message.deleteContainer ? AgentError.toJSON(message.deleteContainer) : undefined)
+    message.containerCommand !== undefined &&
+      (obj.containerCommand = message.containerCommand ? AgentError.toJSON(message.containerCommand) : undefined)
+    message.containerState !== undefined &&
+      (obj.containerState = message.containerState ? AgentError.toJSON(message.containerState) : undefined)
+    return obj
+  },
+}
+
+function createBaseDeployResponse(): DeployResponse {
+  return { started: false }
+}
+
+export const DeployResponse = {
+  fromJSON(object: any): DeployResponse {
+    return { started: isSet(object.started) ? Boolean(object.started) : false }
+  },
+
+  toJSON(message: DeployResponse): unknown {
+    const obj: any = {}
+    message.started !== undefined && (obj.started = message.started)
+    return obj
+  },
+}
+
+function createBaseDeployRequest(): DeployRequest {
+  return { id: '', versionName: '', releaseNotes: '', prefix: '', secrets: {}, requests: [], recreateUnchanged: false }
+}
+
+export const DeployRequest = {
+  fromJSON(object: any): DeployRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      versionName: isSet(object.versionName) ? String(object.versionName) : '',
+      releaseNotes: isSet(object.releaseNotes) ? String(object.releaseNotes) : '',
+      prefix: isSet(object.prefix) ? String(object.prefix) : '',
+      secrets: isObject(object.secrets)
+        ? Object.entries(object.secrets).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      requests: Array.isArray(object?.requests)
+        ? object.requests.map((e: any) => DeployWorkloadRequest.fromJSON(e))
+        : [],
+      recreateUnchanged: isSet(object.recreateUnchanged) ? Boolean(object.recreateUnchanged) : false,
+      idempotencyKey: isSet(object.idempotencyKey) ? String(object.idempotencyKey) : undefined,
+      nodeGroup: isSet(object.nodeGroup) ? String(object.nodeGroup) : undefined,
+    }
+  },
+
+  toJSON(message: DeployRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.versionName !== undefined && (obj.versionName = message.versionName)
+    message.releaseNotes !== undefined && (obj.releaseNotes = message.releaseNotes)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    obj.secrets = {}
+    if (message.secrets) {
+      Object.entries(message.secrets).forEach(([k, v]) => {
+        obj.secrets[k] = v
+      })
+    }
+    if (message.requests) {
+      obj.requests = message.requests.map(e => (e ? DeployWorkloadRequest.toJSON(e) : undefined))
+    } else {
+      obj.requests = []
+    }
+    message.recreateUnchanged !== undefined && (obj.recreateUnchanged = message.recreateUnchanged)
+    message.idempotencyKey !== undefined && (obj.idempotencyKey = message.idempotencyKey)
+    message.nodeGroup !== undefined && (obj.nodeGroup = message.nodeGroup)
+    return obj
+  },
+}
+
+function createBaseDeployRequest_SecretsEntry(): DeployRequest_SecretsEntry {
+  return { key: '', value: '' }
+}
+
+export const DeployRequest_SecretsEntry = {
+  fromJSON(object: any): DeployRequest_SecretsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: DeployRequest_SecretsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseListSecretsRequest(): ListSecretsRequest {
+  return { target: undefined }
+}
+
+export const ListSecretsRequest = {
+  fromJSON(object: any): ListSecretsRequest {
+    return {
+      target: isSet(object.target) ? ContainerOrPrefix.fromJSON(object.target) : undefined,
+      page: isSet(object.page) ? PageRequest.fromJSON(object.page) : undefined,
+    }
+  },
+
+  toJSON(message: ListSecretsRequest): unknown {
+    const obj: any = {}
+    message.target !== undefined && (obj.target = message.target ? ContainerOrPrefix.toJSON(message.target) : undefined)
+    message.page !== undefined && (obj.page = message.page ? PageRequest.toJSON(message.page) : undefined)
+    return obj
+  },
+}
+
+function createBaseRegistryAuth(): RegistryAuth {
+  return { name: '', url: '', user: '', password: '' }
+}
+
+export const RegistryAuth = {
+  fromJSON(object: any): RegistryAuth {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      url: isSet(object.url) ? String(object.url) : '',
+      user: isSet(object.user) ? String(object.user) : '',
+      password: isSet(object.password) ? String(object.password) : '',
+    }
+  },
+
+  toJSON(message: RegistryAuth): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.url !== undefined && (obj.url = message.url)
+    message.user !== undefined && (obj.user = message.user)
+    message.password !== undefined && (obj.password = message.password)
+    return obj
+  },
+}
+
+function createBasePort(): Port {
+  return { internal: 0 }
+}
+
+export const Port = {
+  fromJSON(object: any): Port {
+    return {
+      internal: isSet(object.internal) ? Number(object.internal) : 0,
+      external: isSet(object.external) ? Number(object.external) : undefined,
+    }
+  },
+
+  toJSON(message: Port): unknown {
+    const obj: any = {}
+    message.internal !== undefined && (obj.internal = Math.round(message.internal))
+    message.external !== undefined && (obj.external = Math.round(message.external))
+    return obj
+  },
+}
+
+function createBasePortRange(): PortRange {
+  return { from: 0, to: 0 }
+}
+
+export const PortRange = {
+  fromJSON(object: any): PortRange {
+    return { from: isSet(object.from) ? Number(object.from) : 0, to: isSet(object.to) ? Number(object.to) : 0 }
+  },
+
+  toJSON(message: PortRange): unknown {
+    const obj: any = {}
+    message.from !== undefined && (obj.from = Math.round(message.from))
+    message.to !== undefined && (obj.to = Math.round(message.to))
+    return obj
+  },
+}
+
+function createBasePortRangeBinding(): PortRangeBinding {
+  return { internal: undefined, external: undefined }
+}
+
+export const PortRangeBinding = {
+  fromJSON(object: any): PortRangeBinding {
+    return {
+      internal: isSet(object.internal) ? PortRange.fromJSON(object.internal) : undefined,
+      external: isSet(object.external) ? PortRange.fromJSON(object.external) : undefined,
+    }
+  },
+
+  toJSON(message: PortRangeBinding): unknown {
+    const obj: any = {}
+    message.internal !== undefined && (obj.internal = message.internal ? PortRange.toJSON(message.internal) : undefined)
+    message.external !== undefined && (obj.external = message.external ? PortRange.toJSON(message.external) : undefined)
+    return obj
+  },
+}
+
+function createBaseVolume(): Volume {
+  return { name: '', path: '' }
+}
+
+export const Volume = {
+  fromJSON(object: any): Volume {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      path: isSet(object.path) ? String(object.path) : '',
+      size: isSet(object.size) ? String(object.size) : undefined,
+      type: isSet(object.type) ? volumeTypeFromJSON(object.type) : undefined,
+      class: isSet(object.class) ? String(object.class) : undefined,
+    }
+  },
+
+  toJSON(message: Volume): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.path !== undefined && (obj.path = message.path)
+    message.size !== undefined && (obj.size = message.size)
+    message.type !== undefined && (obj.type = message.type !== undefined ? volumeTypeToJSON(message.type) : undefined)
+    message.class !== undefined && (obj.class = message.class)
+    return obj
+  },
+}
+
+function createBaseVolumeLink(): VolumeLink {
+  return { name: '', path: '' }
+}
+
+export const VolumeLink = {
+  fromJSON(object: any): VolumeLink {
+    return { name: isSet(object.name) ? String(object.name) : '', path: isSet(object.path) ? String(object.path) : '' }
+  },
+
+  toJSON(message: VolumeLink): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.path !== undefined && (obj.path = message.path)
+    return obj
+  },
+}
+
+function createBaseInitContainer(): InitContainer {
+  return { name: '', image: '', volumes: [], command: [], args: [], environment: {} }
+}
+
+export const InitContainer = {
+  fromJSON(object: any): InitContainer {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      image: isSet(object.image) ? String(object.image) : '',
+      useParentConfig: isSet(object.useParentConfig) ? Boolean(object.useParentConfig) : undefined,
+      volumes: Array.isArray(object?.volumes) ? object.volumes.map((e: any) => VolumeLink.fromJSON(e)) : [],
+      command: Array.isArray(object?.command) ? object.command.map((e: any) => String(e)) : [],
+      args: Array.isArray(object?.args) ? object.args.map((e: any) => String(e)) : [],
+      environment: isObject(object.environment)
+        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: InitContainer): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.image !== undefined && (obj.image = message.image)
+    message.useParentConfig !== undefined && (obj.useParentConfig = message.useParentConfig)
+    if (message.volumes) {
+      obj.volumes = message.volumes.map(e => (e ? VolumeLink.toJSON(e) : undefined))
+    } else {
+      obj.volumes = []
+    }
+    if (message.command) {
+      obj.command = message.command.map(e => e)
+    } else {
+      obj.command = []
+    }
+    if (message.args) {
+      obj.args = message.args.map(e => e)
+    } else {
+      obj.args = []
+    }
+    obj.environment = {}
+    if (message.environment) {
+      Object.entries(message.environment).forEach(([k, v]) => {
+        obj.environment[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseInitContainer_EnvironmentEntry(): InitContainer_EnvironmentEntry {
+  return { key: '', value: '' }
+}
+
+export const InitContainer_EnvironmentEntry = {
+  fromJSON(object: any): InitContainer_EnvironmentEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: InitContainer_EnvironmentEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseImportContainer(): ImportContainer {
+  return { volume: '', command: '', environment: {} }
+}
+
+export const ImportContainer = {
+  fromJSON(object: any): ImportContainer {
+    return {
+      volume: isSet(object.volume) ? String(object.volume) : '',
+      command: isSet(object.command) ? String(object.command) : '',
+      environment: isObject(object.environment)
+        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: ImportContainer): unknown {
+    const obj: any = {}
+    message.volume !== undefined && (obj.volume = message.volume)
+    message.command !== undefined && (obj.command = message.command)
+    obj.environment = {}
+    if (message.environment) {
+      Object.entries(message.environment).forEach(([k, v]) => {
+        obj.environment[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseImportContainer_EnvironmentEntry(): ImportContainer_EnvironmentEntry {
+  return { key: '', value: '' }
+}
+
+export const ImportContainer_EnvironmentEntry = {
+  fromJSON(object: any): ImportContainer_EnvironmentEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: ImportContainer_EnvironmentEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseLogConfig(): LogConfig {
+  return { driver: 0, options: {} }
+}
+
+export const LogConfig = {
+  fromJSON(object: any): LogConfig {
+    return {
+      driver: isSet(object.driver) ? driverTypeFromJSON(object.driver) : 0,
+      options: isObject(object.options)
+        ? Object.entries(object.options).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: LogConfig): unknown {
+    const obj: any = {}
+    message.driver !== undefined && (obj.driver = driverTypeToJSON(message.driver))
+    obj.options = {}
+    if (message.options) {
+      Object.entries(message.options).forEach(([k, v]) => {
+        obj.options[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseLogConfig_OptionsEntry(): LogConfig_OptionsEntry {
+  return { key: '', value: '' }
+}
+
+export const LogConfig_OptionsEntry = {
+  fromJSON(object: any): LogConfig_OptionsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: LogConfig_OptionsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseMarker(): Marker {
+  return { deployment: {}, service: {}, ingress: {} }
+}
+
+export const Marker = {
+  fromJSON(object: any): Marker {
+    return {
+      deployment: isObject(object.deployment)
+        ? Object.entries(object.deployment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      service: isObject(object.service)
+        ? Object.entries(object.service).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      ingress: isObject(object.ingress)
+        ? Object.entries(object.ingress).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: Marker): unknown {
+    const obj: any = {}
+    obj.deployment = {}
+    if (message.deployment) {
+      Object.entries(message.deployment).forEach(([k, v]) => {
+        obj.deployment[k] = v
+      })
+    }
+    obj.service = {}
+    if (message.service) {
+      Object.entries(message.service).forEach(([k, v]) => {
+        obj.service[k] = v
+      })
+    }
+    obj.ingress = {}
+    if (message.ingress) {
+      Object.entries(message.ingress).forEach(([k, v]) => {
+        obj.ingress[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseMarker_DeploymentEntry(): Marker_DeploymentEntry {
+  return { key: '', value: '' }
+}
+
+export const Marker_DeploymentEntry = {
+  fromJSON(object: any): Marker_DeploymentEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: Marker_DeploymentEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseMarker_ServiceEntry(): Marker_ServiceEntry {
+  return { key: '', value: '' }
+}
+
+export const Marker_ServiceEntry = {
+  fromJSON(object: any): Marker_ServiceEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: Marker_ServiceEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseMarker_IngressEntry(): Marker_IngressEntry {
+  return { key: '', value: '' }
+}
+
+export const Marker_IngressEntry = {
+  fromJSON(object: any): Marker_IngressEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: Marker_IngressEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseMetrics(): Metrics {
+  return { port: 0, path: '' }
+}
+
+export const Metrics = {
+  fromJSON(object: any): Metrics {
+    return { port: isSet(object.port) ? Number(object.port) : 0, path: isSet(object.path) ? String(object.path) : '' }
+  },
+
+  toJSON(message: Metrics): unknown {
+    const obj: any = {}
+    message.port !== undefined && (obj.port = Math.round(message.port))
+    message.path !== undefined && (obj.path = message.path)
+    return obj
+  },
+}
+
+function createBaseExpectedState(): ExpectedState {
+  return { state: 0 }
+}
+
+export const ExpectedState = {
+  fromJSON(object: any): ExpectedState {
+    return {
+      state: isSet(object.state) ? containerStateFromJSON(object.state) : 0,
+      timeout: isSet(object.timeout) ? Number(object.timeout) : undefined,
+      exitCode: isSet(object.exitCode) ? Number(object.exitCode) : undefined,
+    }
+  },
+
+  toJSON(message: ExpectedState): unknown {
+    const obj: any = {}
+    message.state !== undefined && (obj.state = containerStateToJSON(message.state))
+    message.timeout !== undefined && (obj.timeout = Math.round(message.timeout))
+    message.exitCode !== undefined && (obj.exitCode = Math.round(message.exitCode))
+    return obj
+  },
+}
+
+function createBaseRestartBudget(): RestartBudget {
+  return { maxRestarts: 0, windowSeconds: 0 }
+}
+
+export const RestartBudget = {
+  fromJSON(object: any): RestartBudget {
+    return {
+      maxRestarts: isSet(object.maxRestarts) ? Number(object.maxRestarts) : 0,
+      windowSeconds: isSet(object.windowSeconds) ? Number(object.windowSeconds) : 0,
+      backoffSeconds: isSet(object.backoffSeconds) ? Number(object.backoffSeconds) : undefined,
+      maxBackoffSeconds: isSet(object.maxBackoffSeconds) ? Number(object.maxBackoffSeconds) : undefined,
+      giveUpAction: isSet(object.giveUpAction) ? restartGiveUpActionFromJSON(object.giveUpAction) : undefined,
+    }
+  },
+
+  toJSON(message: RestartBudget): unknown {
+    const obj: any = {}
+    message.maxRestarts !== undefined && (obj.maxRestarts = Math.round(message.maxRestarts))
+    message.windowSeconds !== undefined && (obj.windowSeconds = Math.round(message.windowSeconds))
+    message.backoffSeconds !== undefined && (obj.backoffSeconds = Math.round(message.backoffSeconds))
+    message.maxBackoffSeconds !== undefined && (obj.maxBackoffSeconds = Math.round(message.maxBackoffSeconds))
+    message.giveUpAction !== undefined &&
+      (obj.giveUpAction =
+        message.giveUpAction !== undefined ? restartGiveUpActionToJSON(message.giveUpAction) : undefined)
+    return obj
+  },
+}
+
+function createBaseNetworkConfig(): NetworkConfig {
+  return { name: '', options: {} }
+}
+
+export const NetworkConfig = {
+  fromJSON(object: any): NetworkConfig {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      driver: isSet(object.driver) ? String(object.driver) : undefined,
+      parent: isSet(object.parent) ? String(object.parent) : undefined,
+      subnet: isSet(object.subnet) ? String(object.subnet) : undefined,
+      gateway: isSet(object.gateway) ? String(object.gateway) : undefined,
+      ipRange: isSet(object.ipRange) ? String(object.ipRange) : undefined,
+      mode: isSet(object.mode) ? String(object.mode) : undefined,
+      ipAddress: isSet(object.ipAddress) ? String(object.ipAddress) : undefined,
+      options: isObject(object.options)
+        ? Object.entries(object.options).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: NetworkConfig): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.driver !== undefined && (obj.driver = message.driver)
+    message.parent !== undefined && (obj.parent = message.parent)
+    message.subnet !== undefined && (obj.subnet = message.subnet)
+    message.gateway !== undefined && (obj.gateway = message.gateway)
+    message.ipRange !== undefined && (obj.ipRange = message.ipRange)
+    message.mode !== undefined && (obj.mode = message.mode)
+    message.ipAddress !== undefined && (obj.ipAddress = message.ipAddress)
+    obj.options = {}
+    if (message.options) {
+      Object.entries(message.options).forEach(([k, v]) => {
+        obj.options[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseNetworkConfig_OptionsEntry(): NetworkConfig_OptionsEntry {
+  return { key: '', value: '' }
+}
+
+export const NetworkConfig_OptionsEntry = {
+  fromJSON(object: any): NetworkConfig_OptionsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: NetworkConfig_OptionsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseDagentContainerConfig(): DagentContainerConfig {
+  return { networks: [], labels: {}, networkConfigs: [], logFiles: [], runtimeOptions: {} }
+}
+
+export const DagentContainerConfig = {
+  fromJSON(object: any): DagentContainerConfig {
+    return {
+      logConfig: isSet(object.logConfig) ? LogConfig.fromJSON(object.logConfig) : undefined,
+      restartPolicy: isSet(object.restartPolicy) ? restartPolicyFromJSON(object.restartPolicy) : undefined,
+      networkMode: isSet(object.networkMode) ? networkModeFromJSON(object.networkMode) : undefined,
+      expectedState: isSet(object.expectedState) ? ExpectedState.fromJSON(object.expectedState) : undefined,
+      resourceConfig: isSet(object.resourceConfig) ? ResourceConfig.fromJSON(object.resourceConfig) : undefined,
+      isolation: isSet(object.isolation) ? isolationFromJSON(object.isolation) : undefined,
+      pidMode: isSet(object.pidMode) ? String(object.pidMode) : undefined,
+      ipcMode: isSet(object.ipcMode) ? String(object.ipcMode) : undefined,
+      hostname: isSet(object.hostname) ? String(object.hostname) : undefined,
+      domainname: isSet(object.domainname) ? String(object.domainname) : undefined,
+      restartBudget: isSet(object.restartBudget) ? RestartBudget.fromJSON(object.restartBudget) : undefined,
+      runtime: isSet(object.runtime) ? String(object.runtime) : undefined,
+      networks: Array.isArray(object?.networks) ? object.networks.map((e: any) => String(e)) : [],
+      labels: isObject(object.labels)
+        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      networkConfigs: Array.isArray(object?.networkConfigs)
+        ? object.networkConfigs.map((e: any) => NetworkConfig.fromJSON(e))
+        : [],
+      logFiles: Array.isArray(object?.logFiles) ? object.logFiles.map((e: any) => String(e)) : [],
+      runtimeOptions: isObject(object.runtimeOptions)
+        ? Object.entries(object.runtimeOptions).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: DagentContainerConfig): unknown {
+    const obj: any = {}
+    message.logConfig !== undefined &&
+      (obj.logConfig = message.logConfig ? LogConfig.toJSON(message.logConfig) : undefined)
+    message.restartPolicy !== undefined &&
+      (obj.restartPolicy = message.restartPolicy !== undefined ? restartPolicyToJSON(message.restartPolicy) : undefined)
+    message.networkMode !== undefined &&
+      (obj.networkMode = message.networkMode !== undefined ? networkModeToJSON(message.networkMode) : undefined)
+    message.expectedState !== undefined &&
+      (obj.expectedState = message.expectedState ? ExpectedState.toJSON(message.expectedState) : undefined)
+    message.resourceConfig !== undefined &&
+      (obj.resourceConfig = message.resourceConfig ? ResourceConfig.toJSON(message.resourceConfig) : undefined)
+    message.isolation !== undefined &&
+      (obj.isolation = message.isolation !== undefined ? isolationToJSON(message.isolation) : undefined)
+    message.pidMode !== undefined && (obj.pidMode = message.pidMode)
+    message.ipcMode !== undefined && (obj.ipcMode = message.ipcMode)
+    message.hostname !== undefined && (obj.hostname = message.hostname)
+    message.domainname !== undefined && (obj.domainname = message.domainname)
+    message.restartBudget !== undefined &&
+      (obj.restartBudget = message.restartBudget ? RestartBudget.toJSON(message.restartBudget) : undefined)
+    message.runtime !== undefined && (obj.runtime = message.runtime)
+    if (message.networks) {
+      obj.networks = message.networks.map(e => e)
+    } else {
+      obj.networks = []
+    }
+    obj.labels = {}
+    if (message.labels) {
+      Object.entries(message.labels).forEach(([k, v]) => {
+        obj.labels[k] = v
+      })
+    }
+    if (message.networkConfigs) {
+      obj.networkConfigs = message.networkConfigs.map(e => (e ? NetworkConfig.toJSON(e) : undefined))
+    } else {
+      obj.networkConfigs = []
+    }
+    if (message.logFiles) {
+      obj.logFiles = message.logFiles.map(e => e)
+    } else {
+      obj.logFiles = []
+    }
+    obj.runtimeOptions = {}
+    if (message.runtimeOptions) {
+      Object.entries(message.runtimeOptions).forEach(([k, v]) => {
+        obj.runtimeOptions[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseDagentContainerConfig_LabelsEntry(): DagentContainerConfig_LabelsEntry {
+  return { key: '', value: '' }
+}
+
+export const DagentContainerConfig_LabelsEntry = {
+  fromJSON(object: any): DagentContainerConfig_LabelsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: DagentContainerConfig_LabelsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseDagentContainerConfig_RuntimeOptionsEntry(): DagentContainerConfig_RuntimeOptionsEntry {
+  return { key: '', value: '' }
+}
+
+export const DagentContainerConfig_RuntimeOptionsEntry = {
+  fromJSON(object: any): DagentContainerConfig_RuntimeOptionsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: DagentContainerConfig_RuntimeOptionsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseConfigFile(): ConfigFile {
+  return { path: '', content: '' }
+}
+
+export const ConfigFile = {
+  fromJSON(object: any): ConfigFile {
+    return {
+      path: isSet(object.path) ? String(object.path) : '',
+      content: isSet(object.content) ? String(object.content) : '',
+      secret: isSet(object.secret) ? Boolean(object.secret) : undefined,
+    }
+  },
+
+  toJSON(message: ConfigFile): unknown {
+    const obj: any = {}
+    message.path !== undefined && (obj.path = message.path)
+    message.content !== undefined && (obj.content = message.content)
+    message.secret !== undefined && (obj.secret = message.secret)
+    return obj
+  },
+}
+
+function createBaseWorkloadIdentity(): WorkloadIdentity {
+  return { annotations: {} }
+}
+
+export const WorkloadIdentity = {
+  fromJSON(object: any): WorkloadIdentity {
+    return {
+      serviceAccount: isSet(object.serviceAccount) ? String(object.serviceAccount) : undefined,
+      annotations: isObject(object.annotations)
+        ? Object.entries(object.annotations).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: WorkloadIdentity): unknown {
+    const obj: any = {}
+    message.serviceAccount !== undefined && (obj.serviceAccount = message.serviceAccount)
+    obj.annotations = {}
+    if (message.annotations) {
+      Object.entries(message.annotations).forEach(([k, v]) => {
+        obj.annotations[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseWorkloadIdentity_AnnotationsEntry(): WorkloadIdentity_AnnotationsEntry {
+  return { key: '', value: '' }
+}
+
+export const WorkloadIdentity_AnnotationsEntry = {
+  fromJSON(object: any): WorkloadIdentity_AnnotationsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: WorkloadIdentity_AnnotationsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseProjectedToken(): ProjectedToken {
+  return { path: '', audience: '' }
+}
+
+export const ProjectedToken = {
+  fromJSON(object: any): ProjectedToken {
+    return {
+      path: isSet(object.path) ? String(object.path) : '',
+      audience: isSet(object.audience) ? String(object.audience) : '',
+      expirationSeconds: isSet(object.expirationSeconds) ? Number(object.expirationSeconds) : undefined,
+    }
+  },
+
+  toJSON(message: ProjectedToken): unknown {
+    const obj: any = {}
+    message.path !== undefined && (obj.path = message.path)
+    message.audience !== undefined && (obj.audience = message.audience)
+    message.expirationSeconds !== undefined && (obj.expirationSeconds = Math.round(message.expirationSeconds))
+    return obj
+  },
+}
+
+function createBaseCraneContainerConfig(): CraneContainerConfig {
+  return { customHeaders: [], extraLBAnnotations: {}, configFiles: [], projectedTokens: [] }
+}
+
+export const CraneContainerConfig = {
+  fromJSON(object: any): CraneContainerConfig {
+    return {
+      deploymentStrategy: isSet(object.deploymentStrategy)
+        ? deploymentStrategyFromJSON(object.deploymentStrategy)
+        : undefined,
+      healthCheckConfig: isSet(object.healthCheckConfig)
+        ? HealthCheckConfig.fromJSON(object.healthCheckConfig)
+        : undefined,
+      resourceConfig: isSet(object.resourceConfig) ? ResourceConfig.fromJSON(object.resourceConfig) : undefined,
+      proxyHeaders: isSet(object.proxyHeaders) ? Boolean(object.proxyHeaders) : undefined,
+      useLoadBalancer: isSet(object.useLoadBalancer) ? Boolean(object.useLoadBalancer) : undefined,
+      annotations: isSet(object.annotations) ? Marker.fromJSON(object.annotations) : undefined,
+      labels: isSet(object.labels) ? Marker.fromJSON(object.labels) : undefined,
+      metrics: isSet(object.metrics) ? Metrics.fromJSON(object.metrics) : undefined,
+      serviceType: isSet(object.serviceType) ? serviceTypeFromJSON(object.serviceType) : undefined,
+      workloadIdentity: isSet(object.workloadIdentity) ? WorkloadIdentity.fromJSON(object.workloadIdentity) : undefined,
+      customHeaders: Array.isArray(object?.customHeaders) ? object.customHeaders.map((e: any) => String(e)) : [],
+      extraLBAnnotations: isObject(object.extraLBAnnotations)
+        ? Object.entries(object.extraLBAnnotations).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      configFiles: Array.isArray(object?.configFiles) ? object.configFiles.map((e: any) => ConfigFile.fromJSON(e)) : [],
+      projectedTokens: Array.isArray(object?.projectedTokens)
+        ? object.projectedTokens.map((e: any) => ProjectedToken.fromJSON(e))
+        : [],
+    }
+  },
+
+  toJSON(message: CraneContainerConfig): unknown {
+    const obj: any = {}
+    message.deploymentStrategy !== undefined &&
+      (obj.deploymentStrategy =
+        message.deploymentStrategy !== undefined ? deploymentStrategyToJSON(message.deploymentStrategy) : undefined)
+    message.healthCheckConfig !== undefined &&
+      (obj.healthCheckConfig = message.healthCheckConfig
+        ? HealthCheckConfig.toJSON(message.healthCheckConfig)
+        : undefined)
+    message.resourceConfig !== undefined &&
+      (obj.resourceConfig = message.resourceConfig ? ResourceConfig.toJSON(message.resourceConfig) : undefined)
+    message.proxyHeaders !== undefined && (obj.proxyHeaders = message.proxyHeaders)
+    message.useLoadBalancer !== undefined && (obj.useLoadBalancer = message.useLoadBalancer)
+    message.annotations !== undefined &&
+      (obj.annotations = message.annotations ? Marker.toJSON(message.annotations) : undefined)
+    message.labels !== undefined && (obj.labels = message.labels ? Marker.toJSON(message.labels) : undefined)
+    message.metrics !== undefined && (obj.metrics = message.metrics ? Metrics.toJSON(message.metrics) : undefined)
+    message.serviceType !== undefined &&
+      (obj.serviceType = message.serviceType !== undefined ? serviceTypeToJSON(message.serviceType) : undefined)
+    message.workloadIdentity !== undefined &&
+      (obj.workloadIdentity = message.workloadIdentity ? WorkloadIdentity.toJSON(message.workloadIdentity) : undefined)
+    if (message.customHeaders) {
+      obj.customHeaders = message.customHeaders.map(e => e)
+    } else {
+      obj.customHeaders = []
+    }
+    obj.extraLBAnnotations = {}
+    if (message.extraLBAnnotations) {
+      Object.entries(message.extraLBAnnotations).forEach(([k, v]) => {
+        obj.extraLBAnnotations[k] = v
+      })
+    }
+    if (message.configFiles) {
+      obj.configFiles = message.configFiles.map(e => (e ? ConfigFile.toJSON(e) : undefined))
+    } else {
+      obj.configFiles = []
+    }
+    if (message.projectedTokens) {
+      obj.projectedTokens = message.projectedTokens.map(e => (e ? ProjectedToken.toJSON(e) : undefined))
+    } else {
+      obj.projectedTokens = []
+    }
+    return obj
+  },
+}
+
+function createBaseCraneContainerConfig_ExtraLBAnnotationsEntry(): CraneContainerConfig_ExtraLBAnnotationsEntry {
+  return { key: '', value: '' }
+}
+
+export const CraneContainerConfig_ExtraLBAnnotationsEntry = {
+  fromJSON(object: any): CraneContainerConfig_ExtraLBAnnotationsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: CraneContainerConfig_ExtraLBAnnotationsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseCommonContainerConfig(): CommonContainerConfig {
+  return {
+    name: '',
+    ports: [],
+    portRanges: [],
+    volumes: [],
+    commands: [],
+    args: [],
+    environment: {},
+    secrets: {},
+    initContainers: [],
+  }
+}
+
+export const CommonContainerConfig = {
+  fromJSON(object: any): CommonContainerConfig {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      expose: isSet(object.expose) ? exposeStrategyFromJSON(object.expose) : undefined,
+      routing: isSet(object.routing) ? Routing.fromJSON(object.routing) : undefined,
+      configContainer: isSet(object.configContainer) ? ConfigContainer.fromJSON(object.configContainer) : undefined,
+      importContainer: isSet(object.importContainer) ? ImportContainer.fromJSON(object.importContainer) : undefined,
+      user: isSet(object.user) ? Number(object.user) : undefined,
+      TTY: isSet(object.TTY) ? Boolean(object.TTY) : undefined,
+      workingDirectory: isSet(object.workingDirectory) ? String(object.workingDirectory) : undefined,
+      ports: Array.isArray(object?.ports) ? object.ports.map((e: any) => Port.fromJSON(e)) : [],
+      portRanges: Array.isArray(object?.portRanges)
+        ? object.portRanges.map((e: any) => PortRangeBinding.fromJSON(e))
+        : [],
+      volumes: Array.isArray(object?.volumes) ? object.volumes.map((e: any) => Volume.fromJSON(e)) : [],
+      commands: Array.isArray(object?.commands) ? object.commands.map((e: any) => String(e)) : [],
+      args: Array.isArray(object?.args) ? object.args.map((e: any) => String(e)) : [],
+      environment: isObject(object.environment)
+        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      secrets: isObject(object.secrets)
+        ? Object.entries(object.secrets).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      initContainers: Array.isArray(object?.initContainers)
+        ? object.initContainers.map((e: any) => InitContainer.fromJSON(e))
+        : [],
+    }
+  },
+
+  toJSON(message: CommonContainerConfig): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.expose !== undefined &&
+      (obj.expose = message.expose !== undefined ? exposeStrategyToJSON(message.expose) : undefined)
+    message.routing !== undefined && (obj.routing = message.routing ? Routing.toJSON(message.routing) : undefined)
+    message.configContainer !== undefined &&
+      (obj.configContainer = message.configContainer ? ConfigContainer.toJSON(message.configContainer) : undefined)
+    message.importContainer !== undefined &&
+      (obj.importContainer = message.importContainer ? ImportContainer.toJSON(message.importContainer) : undefined)
+    message.user !== undefined && (obj.user = Math.round(message.user))
+    message.TTY !== undefined && (obj.TTY = message.TTY)
+    message.workingDirectory !== undefined && (obj.workingDirectory = message.workingDirectory)
+    if (message.ports) {
+      obj.ports = message.ports.map(e => (e ? Port.toJSON(e) : undefined))
+    } else {
+      obj.ports = []
+    }
+    if (message.portRanges) {
+      obj.portRanges = message.portRanges.map(e => (e ? PortRangeBinding.toJSON(e) : undefined))
+    } else {
+      obj.portRanges = []
+    }
+    if (message.volumes) {
+      obj.volumes = message.volumes.map(e => (e ? Volume.toJSON(e) : undefined))
+    } else {
+      obj.volumes = []
+    }
+    if (message.commands) {
+      obj.commands = message.commands.map(e => e)
+    } else {
+      obj.commands = []
+    }
+    if (message.args) {
+      obj.args = message.args.map(e => e)
+    } else {
+      obj.args = []
+    }
+    obj.environment = {}
+    if (message.environment) {
+      Object.entries(message.environment).forEach(([k, v]) => {
+        obj.environment[k] = v
+      })
+    }
+    obj.secrets = {}
+    if (message.secrets) {
+      Object.entries(message.secrets).forEach(([k, v]) => {
+        obj.secrets[k] = v
+      })
+    }
+    if (message.initContainers) {
+      obj.initContainers = message.initContainers.map(e => (e ? InitContainer.toJSON(e) : undefined))
+    } else {
+      obj.initContainers = []
+    }
+    return obj
+  },
+}
+
+function createBaseCommonContainerConfig_EnvironmentEntry(): CommonContainerConfig_EnvironmentEntry {
+  return { key: '', value: '' }
+}
+
+export const CommonContainerConfig_EnvironmentEntry = {
+  fromJSON(object: any): CommonContainerConfig_EnvironmentEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: CommonContainerConfig_EnvironmentEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseCommonContainerConfig_SecretsEntry(): CommonContainerConfig_SecretsEntry {
+  return { key: '', value: '' }
+}
+
+export const CommonContainerConfig_SecretsEntry = {
+  fromJSON(object: any): CommonContainerConfig_SecretsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: CommonContainerConfig_SecretsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseDeployWorkloadRequest(): DeployWorkloadRequest {
+  return { id: '', imageName: '', tag: '' }
+}
+
+export const DeployWorkloadRequest = {
+  fromJSON(object: any): DeployWorkloadRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      common: isSet(object.common) ? CommonContainerConfig.fromJSON(object.common) : undefined,
+      dagent: isSet(object.dagent) ? DagentContainerConfig.fromJSON(object.dagent) : undefined,
+      crane: isSet(object.crane) ? CraneContainerConfig.fromJSON(object.crane) : undefined,
+      registry: isSet(object.registry) ? String(object.registry) : undefined,
+      imageName: isSet(object.imageName) ? String(object.imageName) : '',
+      tag: isSet(object.tag) ? String(object.tag) : '',
+      registryAuth: isSet(object.registryAuth) ? RegistryAuth.fromJSON(object.registryAuth) : undefined,
+      base: isSet(object.base) ? BaseConfigRef.fromJSON(object.base) : undefined,
+    }
+  },
+
+  toJSON(message: DeployWorkloadRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.common !== undefined &&
+      (obj.common = message.common ? CommonContainerConfig.toJSON(message.common) : undefined)
+    message.dagent !== undefined &&
+      (obj.dagent = message.dagent ? DagentContainerConfig.toJSON(message.dagent) : undefined)
+    message.crane !== undefined && (obj.crane = message.crane ? CraneContainerConfig.toJSON(message.crane) : undefined)
+    message.registry !== undefined && (obj.registry = message.registry)
+    message.imageName !== undefined && (obj.imageName = message.imageName)
+    message.tag !== undefined && (obj.tag = message.tag)
+    message.registryAuth !== undefined &&
+      (obj.registryAuth = message.registryAuth ? RegistryAuth.toJSON(message.registryAuth) : undefined)
+    message.base !== undefined && (obj.base = message.base ? BaseConfigRef.toJSON(message.base) : undefined)
+    return obj
+  },
+}
+
+function createBaseBaseConfigRef(): BaseConfigRef {
+  return { id: '', hash: '', overlayJson: '' }
+}
+
+export const BaseConfigRef = {
+  fromJSON(object: any): BaseConfigRef {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      hash: isSet(object.hash) ? String(object.hash) : '',
+      overlayJson: isSet(object.overlayJson) ? String(object.overlayJson) : '',
+      configJson: isSet(object.configJson) ? String(object.configJson) : undefined,
+    }
+  },
+
+  toJSON(message: BaseConfigRef): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.hash !== undefined && (obj.hash = message.hash)
+    message.overlayJson !== undefined && (obj.overlayJson = message.overlayJson)
+    message.configJson !== undefined && (obj.configJson = message.configJson)
+    return obj
+  },
+}
+
+function createBaseContainerStateRequest(): ContainerStateRequest {
+  return {}
+}
+
+export const ContainerStateRequest = {
+  fromJSON(object: any): ContainerStateRequest {
+    return {
+      prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      oneShot: isSet(object.oneShot) ? Boolean(object.oneShot) : undefined,
+      filter: isSet(object.filter) ? ListFilter.fromJSON(object.filter) : undefined,
+      page: isSet(object.page) ? PageRequest.fromJSON(object.page) : undefined,
+    }
+  },
+
+  toJSON(message: ContainerStateRequest): unknown {
+    const obj: any = {}
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.oneShot !== undefined && (obj.oneShot = message.oneShot)
+    message.filter !== undefined && (obj.filter = message.filter ? ListFilter.toJSON(message.filter) : undefined)
+    message.page !== undefined && (obj.page = message.page ? PageRequest.toJSON(message.page) : undefined)
+    return obj
+  },
+}
+
+function createBaseContainerDeleteRequest(): ContainerDeleteRequest {
+  return { prefix: '', name: '' }
+}
+
+export const ContainerDeleteRequest = {
+  fromJSON(object: any): ContainerDeleteRequest {
     return {
-      deploy: isSet(object.deploy) ? DeployRequest.fromJSON(object.deploy) : undefined,
-      containerState: isSet(object.containerState) ? ContainerStateRequest.fromJSON(object.containerState) : undefined,
-      containerDelete: isSet(object.containerDelete)
-        ? ContainerDeleteRequest.fromJSON(object.containerDelete)
-        : undefined,
-      deployLegacy: isSet(object.deployLegacy) ? DeployRequestLegacy.fromJSON(object.deployLegacy) : undefined,
-      listSecrets: isSet(object.listSecrets) ? ListSecretsRequest.fromJSON(object.listSecrets) : undefined,
-      update: isSet(object.update) ? AgentUpdateRequest.fromJSON(object.update) : undefined,
-      close: isSet(object.close) ? CloseConnectionRequest.fromJSON(object.close) : undefined,
-      containerCommand: isSet(object.containerCommand)
-        ? ContainerCommandRequest.fromJSON(object.containerCommand)
-        : undefined,
-      deleteContainers: isSet(object.deleteContainers)
-        ? DeleteContainersRequest.fromJSON(object.deleteContainers)
-        : undefined,
-      containerLog: isSet(object.containerLog) ? ContainerLogRequest.fromJSON(object.containerLog) : undefined,
-      replaceToken: isSet(object.replaceToken) ? ReplaceTokenRequest.fromJSON(object.replaceToken) : undefined,
-      containerInspect: isSet(object.containerInspect)
-        ? ContainerInspectRequest.fromJSON(object.containerInspect)
-        : undefined,
+      prefix: isSet(object.prefix) ? String(object.prefix) : '',
+      name: isSet(object.name) ? String(object.name) : '',
     }
   },
 
-  toJSON(message: AgentCommand): unknown {
+  toJSON(message: ContainerDeleteRequest): unknown {
     const obj: any = {}
-    message.deploy !== undefined && (obj.deploy = message.deploy ? DeployRequest.toJSON(message.deploy) : undefined)
-    message.containerState !== undefined &&
-      (obj.containerState = message.containerState ? ContainerStateRequest.toJSON(message.containerState) : undefined)
-    message.containerDelete !== undefined &&
-      (obj.containerDelete = message.containerDelete
-        ? ContainerDeleteRequest.toJSON(message.containerDelete)
-        : undefined)
-    message.deployLegacy !== undefined &&
-      (obj.deployLegacy = message.deployLegacy ? DeployRequestLegacy.toJSON(message.deployLegacy) : undefined)
-    message.listSecrets !== undefined &&
-      (obj.listSecrets = message.listSecrets ? ListSecretsRequest.toJSON(message.listSecrets) : undefined)
-    message.update !== undefined &&
-      (obj.update = message.update ? AgentUpdateRequest.toJSON(message.update) : undefined)
-    message.close !== undefined &&
-      (obj.close = message.close ? CloseConnectionRequest.toJSON(message.close) : undefined)
-    message.containerCommand !== undefined &&
-      (obj.containerCommand = message.containerCommand
-        ? ContainerCommandRequest.toJSON(message.containerCommand)
-        : undefined)
-    message.deleteContainers !== undefined &&
-      (obj.deleteContainers = message.deleteContainers
-        ? DeleteContainersRequest.toJSON(message.deleteContainers)
-        : undefined)
-    message.containerLog !== undefined &&
-      (obj.containerLog = message.containerLog ? ContainerLogRequest.toJSON(message.containerLog) : undefined)
-    message.replaceToken !== undefined &&
-      (obj.replaceToken = message.replaceToken ? ReplaceTokenRequest.toJSON(message.replaceToken) : undefined)
-    message.containerInspect !== undefined &&
-      (obj.containerInspect = message.containerInspect
-        ? ContainerInspectRequest.toJSON(message.containerInspect)
-        : undefined)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.name !== undefined && (obj.name = message.name)
     return obj
   },
 }
 
-function createBaseAgentError(): AgentError {
-  return { status: 0, error: '' }
+function createBaseDeployRequestLegacy(): DeployRequestLegacy {
+  return { requestId: '', json: '' }
 }
 
-export const AgentError = {
-  fromJSON(object: any): AgentError {
+export const DeployRequestLegacy = {
+  fromJSON(object: any): DeployRequestLegacy {
     return {
-      status: isSet(object.status) ? Number(object.status) : 0,
-      error: isSet(object.error) ? String(object.error) : '',
+      requestId: isSet(object.requestId) ? String(object.requestId) : '',
+      json: isSet(object.json) ? String(object.json) : '',
     }
   },
 
-  toJSON(message: AgentError): unknown {
+  toJSON(message: DeployRequestLegacy): unknown {
     const obj: any = {}
-    message.status !== undefined && (obj.status = Math.round(message.status))
-    message.error !== undefined && (obj.error = message.error)
+    message.requestId !== undefined && (obj.requestId = message.requestId)
+    message.json !== undefined && (obj.json = message.json)
     return obj
   },
 }
 
-function createBaseAgentCommandError(): AgentCommandError {
-  return {}
+function createBaseAgentUpdateRequest(): AgentUpdateRequest {
+  return { tag: '', timeoutSeconds: 0, token: '' }
 }
 
-export const AgentCommandError = {
-  fromJSON(object: any): AgentCommandError {
+export const AgentUpdateRequest = {
+  fromJSON(object: any): AgentUpdateRequest {
     return {
-      listSecrets: isSet(object.listSecrets) ? AgentError.fromJSON(object.listSecrets) : undefined,
-      deleteContainers: isSet(object.deleteContainers) ? AgentError.fromJSON(object.deleteContainers) : undefined,
-      containerLog: isSet(object.containerLog) ? AgentError.fromJSON(object.containerLog) : undefined,
-      containerInspect: isSet(object.containerInspect) ? AgentError.fromJSON(object.containerInspect) : undefined,
+      tag: isSet(object.tag) ? String(object.tag) : '',
+      timeoutSeconds: isSet(object.timeoutSeconds) ? Number(object.timeoutSeconds) : 0,
+      token: isSet(object.token) ? String(object.token) : '',
     }
   },
 
-  toJSON(message: AgentCommandError): unknown {
+  toJSON(message: AgentUpdateRequest): unknown {
     const obj: any = {}
-    message.listSecrets !== undefined &&
-      (obj.listSecrets = message.listSecrets ? AgentError.toJSON(message.listSecrets) : undefined)
-    message.deleteContainers !== undefined &&
-      (obj.deleteContainers = message.deleteContainers ? AgentError.toJSON(message.deleteContainers) : undefined)
-    message.containerLog !== undefined &&
-      (obj.containerLog = message.containerLog ? AgentError.toJSON(message.containerLog) : undefined)
-    message.containerInspect !== undefined &&
-      (obj.containerInspect = message.containerInspect ? AgentError.toJSON(message.containerInspect) : undefined)
+    message.tag !== undefined && (obj.tag = message.tag)
+    message.timeoutSeconds !== undefined && (obj.timeoutSeconds = Math.round(message.timeoutSeconds))
+    message.token !== undefined && (obj.token = message.token)
     return obj
   },
 }
 
-function createBaseDeployResponse(): DeployResponse {
-  return { started: false }
+function createBaseReplaceTokenRequest(): ReplaceTokenRequest {
+  return { token: '' }
 }
 
-export const DeployResponse = {
-  fromJSON(object: any): DeployResponse {
-    return { started: isSet(object.started) ? Boolean(object.started) : false }
+export const ReplaceTokenRequest = {
+  fromJSON(object: any): ReplaceTokenRequest {
+    return { token: isSet(object.token) ? String(object.token) : '' }
   },
 
-  toJSON(message: DeployResponse): unknown {
+  toJSON(message: ReplaceTokenRequest): unknown {
     const obj: any = {}
-    message.started !== undefined && (obj.started = message.started)
+    message.token !== undefined && (obj.token = message.token)
     return obj
   },
 }
 
-function createBaseDeployRequest(): DeployRequest {
-  return { id: '', versionName: '', releaseNotes: '', prefix: '', secrets: {}, requests: [] }
+function createBaseAgentAbortUpdate(): AgentAbortUpdate {
+  return { error: '' }
 }
 
-export const DeployRequest = {
-  fromJSON(object: any): DeployRequest {
-    return {
-      id: isSet(object.id) ? String(object.id) : '',
-      versionName: isSet(object.versionName) ? String(object.versionName) : '',
-      releaseNotes: isSet(object.releaseNotes) ? String(object.releaseNotes) : '',
-      prefix: isSet(object.prefix) ? String(object.prefix) : '',
-      secrets: isObject(object.secrets)
-        ? Object.entries(object.secrets).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
-      requests: Array.isArray(object?.requests)
-        ? object.requests.map((e: any) => DeployWorkloadRequest.fromJSON(e))
-        : [],
-    }
+export const AgentAbortUpdate = {
+  fromJSON(object: any): AgentAbortUpdate {
+    return { error: isSet(object.error) ? String(object.error) : '' }
   },
 
-  toJSON(message: DeployRequest): unknown {
+  toJSON(message: AgentAbortUpdate): unknown {
     const obj: any = {}
-    message.id !== undefined && (obj.id = message.id)
-    message.versionName !== undefined && (obj.versionName = message.versionName)
-    message.releaseNotes !== undefined && (obj.releaseNotes = message.releaseNotes)
-    message.prefix !== undefined && (obj.prefix = message.prefix)
-    obj.secrets = {}
-    if (message.secrets) {
-      Object.entries(message.secrets).forEach(([k, v]) => {
-        obj.secrets[k] = v
-      })
-    }
-    if (message.requests) {
-      obj.requests = message.requests.map(e => (e ? DeployWorkloadRequest.toJSON(e) : undefined))
-    } else {
-      obj.requests = []
-    }
+    message.error !== undefined && (obj.error = message.error)
     return obj
   },
 }
 
-function createBaseDeployRequest_SecretsEntry(): DeployRequest_SecretsEntry {
-  return { key: '', value: '' }
+function createBaseContainerLogRequest(): ContainerLogRequest {
+  return { container: undefined, streaming: false, tail: 0 }
 }
 
-export const DeployRequest_SecretsEntry = {
-  fromJSON(object: any): DeployRequest_SecretsEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const ContainerLogRequest = {
+  fromJSON(object: any): ContainerLogRequest {
+    return {
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      streaming: isSet(object.streaming) ? Boolean(object.streaming) : false,
+      tail: isSet(object.tail) ? Number(object.tail) : 0,
+    }
   },
 
-  toJSON(message: DeployRequest_SecretsEntry): unknown {
+  toJSON(message: ContainerLogRequest): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.streaming !== undefined && (obj.streaming = message.streaming)
+    message.tail !== undefined && (obj.tail = Math.round(message.tail))
     return obj
   },
 }
 
-function createBaseListSecretsRequest(): ListSecretsRequest {
-  return { target: undefined }
+function createBaseContainerInspectRequest(): ContainerInspectRequest {
+  return { container: undefined }
 }
 
-export const ListSecretsRequest = {
-  fromJSON(object: any): ListSecretsRequest {
-    return { target: isSet(object.target) ? ContainerOrPrefix.fromJSON(object.target) : undefined }
+export const ContainerInspectRequest = {
+  fromJSON(object: any): ContainerInspectRequest {
+    return { container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined }
   },
 
-  toJSON(message: ListSecretsRequest): unknown {
+  toJSON(message: ContainerInspectRequest): unknown {
     const obj: any = {}
-    message.target !== undefined && (obj.target = message.target ? ContainerOrPrefix.toJSON(message.target) : undefined)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
     return obj
   },
 }
 
-function createBaseRegistryAuth(): RegistryAuth {
-  return { name: '', url: '', user: '', password: '' }
+function createBaseDeploymentDiffRequest(): DeploymentDiffRequest {
+  return { id: '', prefix: '', requests: [] }
 }
 
-export const RegistryAuth = {
-  fromJSON(object: any): RegistryAuth {
+export const DeploymentDiffRequest = {
+  fromJSON(object: any): DeploymentDiffRequest {
     return {
-      name: isSet(object.name) ? String(object.name) : '',
-      url: isSet(object.url) ? String(object.url) : '',
-      user: isSet(object.user) ? String(object.user) : '',
-      password: isSet(object.password) ? String(object.password) : '',
+      id: isSet(object.id) ? String(object.id) : '',
+      prefix: isSet(object.prefix) ? String(object.prefix) : '',
+      requests: Array.isArray(object?.requests)
+        ? object.requests.map((e: any) => DeployWorkloadRequest.fromJSON(e))
+        : [],
     }
   },
 
-  toJSON(message: RegistryAuth): unknown {
+  toJSON(message: DeploymentDiffRequest): unknown {
     const obj: any = {}
-    message.name !== undefined && (obj.name = message.name)
-    message.url !== undefined && (obj.url = message.url)
-    message.user !== undefined && (obj.user = message.user)
-    message.password !== undefined && (obj.password = message.password)
+    message.id !== undefined && (obj.id = message.id)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    if (message.requests) {
+      obj.requests = message.requests.map(e => (e ? DeployWorkloadRequest.toJSON(e) : undefined))
+    } else {
+      obj.requests = []
+    }
     return obj
   },
 }
 
-function createBasePort(): Port {
-  return { internal: 0 }
+function createBaseDeploymentFieldChange(): DeploymentFieldChange {
+  return { path: '' }
 }
 
-export const Port = {
-  fromJSON(object: any): Port {
+export const DeploymentFieldChange = {
+  fromJSON(object: any): DeploymentFieldChange {
     return {
-      internal: isSet(object.internal) ? Number(object.internal) : 0,
-      external: isSet(object.external) ? Number(object.external) : undefined,
+      path: isSet(object.path) ? String(object.path) : '',
+      old: isSet(object.old) ? String(object.old) : undefined,
+      new: isSet(object.new) ? String(object.new) : undefined,
     }
   },
 
-  toJSON(message: Port): unknown {
+  toJSON(message: DeploymentFieldChange): unknown {
     const obj: any = {}
-    message.internal !== undefined && (obj.internal = Math.round(message.internal))
-    message.external !== undefined && (obj.external = Math.round(message.external))
+    message.path !== undefined && (obj.path = message.path)
+    message.old !== undefined && (obj.old = message.old)
+    message.new !== undefined && (obj.new = message.new)
     return obj
   },
 }
 
-function createBasePortRange(): PortRange {
-  return { from: 0, to: 0 }
+function createBaseWorkloadDiff(): WorkloadDiff {
+  return { id: '', container: undefined, deployed: false, newHash: '', changes: [] }
 }
 
-export const PortRange = {
-  fromJSON(object: any): PortRange {
-    return { from: isSet(object.from) ? Number(object.from) : 0, to: isSet(object.to) ? Number(object.to) : 0 }
+export const WorkloadDiff = {
+  fromJSON(object: any): WorkloadDiff {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      deployed: isSet(object.deployed) ? Boolean(object.deployed) : false,
+      currentHash: isSet(object.currentHash) ? String(object.currentHash) : undefined,
+      newHash: isSet(object.newHash) ? String(object.newHash) : '',
+      changes: Array.isArray(object?.changes) ? object.changes.map((e: any) => DeploymentFieldChange.fromJSON(e)) : [],
+    }
   },
 
-  toJSON(message: PortRange): unknown {
+  toJSON(message: WorkloadDiff): unknown {
     const obj: any = {}
-    message.from !== undefined && (obj.from = Math.round(message.from))
-    message.to !== undefined && (obj.to = Math.round(message.to))
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.deployed !== undefined && (obj.deployed = message.deployed)
+    message.currentHash !== undefined && (obj.currentHash = message.currentHash)
+    message.newHash !== undefined && (obj.newHash = message.newHash)
+    if (message.changes) {
+      obj.changes = message.changes.map(e => (e ? DeploymentFieldChange.toJSON(e) : undefined))
+    } else {
+      obj.changes = []
+    }
     return obj
   },
 }
-
-function createBasePortRangeBinding(): PortRangeBinding {
-  return { internal: undefined, external: undefined }
+
+function createBaseDeploymentDiffResponse(): DeploymentDiffResponse {
+  return { id: '', workloads: [] }
 }
 
-export const PortRangeBinding = {
-  fromJSON(object: any): PortRangeBinding {
+export const DeploymentDiffResponse = {
+  fromJSON(object: any): DeploymentDiffResponse {
     return {
-      internal: isSet(object.internal) ? PortRange.fromJSON(object.internal) : undefined,
-      external: isSet(object.external) ? PortRange.fromJSON(object.external) : undefined,
+      id: isSet(object.id) ? String(object.id) : '',
+      workloads: Array.isArray(object?.workloads) ? object.workloads.map((e: any) => WorkloadDiff.fromJSON(e)) : [],
     }
   },
 
-  toJSON(message: PortRangeBinding): unknown {
+  toJSON(message: DeploymentDiffResponse): unknown {
     const obj: any = {}
-    message.internal !== undefined && (obj.internal = message.internal ? PortRange.toJSON(message.internal) : undefined)
-    message.external !== undefined && (obj.external = message.external ? PortRange.toJSON(message.external) : undefined)
+    message.id !== undefined && (obj.id = message.id)
+    if (message.workloads) {
+      obj.workloads = message.workloads.map(e => (e ? WorkloadDiff.toJSON(e) : undefined))
+    } else {
+      obj.workloads = []
+    }
     return obj
   },
 }
 
-function createBaseVolume(): Volume {
-  return { name: '', path: '' }
+function createBaseVolumeUsageRequest(): VolumeUsageRequest {
+  return {}
 }
 
-export const Volume = {
-  fromJSON(object: any): Volume {
-    return {
-      name: isSet(object.name) ? String(object.name) : '',
-      path: isSet(object.path) ? String(object.path) : '',
-      size: isSet(object.size) ? String(object.size) : undefined,
-      type: isSet(object.type) ? volumeTypeFromJSON(object.type) : undefined,
-      class: isSet(object.class) ? String(object.class) : undefined,
-    }
+export const VolumeUsageRequest = {
+  fromJSON(object: any): VolumeUsageRequest {
+    return { prefix: isSet(object.prefix) ? String(object.prefix) : undefined }
   },
 
-  toJSON(message: Volume): unknown {
+  toJSON(message: VolumeUsageRequest): unknown {
     const obj: any = {}
-    message.name !== undefined && (obj.name = message.name)
-    message.path !== undefined && (obj.path = message.path)
-    message.size !== undefined && (obj.size = message.size)
-    message.type !== undefined && (obj.type = message.type !== undefined ? volumeTypeToJSON(message.type) : undefined)
-    message.class !== undefined && (obj.class = message.class)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
     return obj
   },
 }
 
-function createBaseVolumeLink(): VolumeLink {
-  return { name: '', path: '' }
+function createBaseVolumeUsage(): VolumeUsage {
+  return { name: '', driver: '', size: 0, containers: [] }
 }
 
-export const VolumeLink = {
-  fromJSON(object: any): VolumeLink {
-    return { name: isSet(object.name) ? String(object.name) : '', path: isSet(object.path) ? String(object.path) : '' }
+export const VolumeUsage = {
+  fromJSON(object: any): VolumeUsage {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      driver: isSet(object.driver) ? String(object.driver) : '',
+      size: isSet(object.size) ? Number(object.size) : 0,
+      containers: Array.isArray(object?.containers)
+        ? object.containers.map((e: any) => ContainerIdentifier.fromJSON(e))
+        : [],
+    }
   },
 
-  toJSON(message: VolumeLink): unknown {
+  toJSON(message: VolumeUsage): unknown {
     const obj: any = {}
     message.name !== undefined && (obj.name = message.name)
-    message.path !== undefined && (obj.path = message.path)
+    message.driver !== undefined && (obj.driver = message.driver)
+    message.size !== undefined && (obj.size = Math.round(message.size))
+    if (message.containers) {
+      obj.containers = message.containers.map(e => (e ? ContainerIdentifier.toJSON(e) : undefined))
+    } else {
+      obj.containers = []
+    }
     return obj
   },
 }
 
-function createBaseInitContainer(): InitContainer {
-  return { name: '', image: '', volumes: [], command: [], args: [], environment: {} }
+function createBaseVolumeUsageResponse(): VolumeUsageResponse {
+  return { volumes: [] }
 }
 
-export const InitContainer = {
-  fromJSON(object: any): InitContainer {
+export const VolumeUsageResponse = {
+  fromJSON(object: any): VolumeUsageResponse {
     return {
-      name: isSet(object.name) ? String(object.name) : '',
-      image: isSet(object.image) ? String(object.image) : '',
-      useParentConfig: isSet(object.useParentConfig) ? Boolean(object.useParentConfig) : undefined,
-      volumes: Array.isArray(object?.volumes) ? object.volumes.map((e: any) => VolumeLink.fromJSON(e)) : [],
-      command: Array.isArray(object?.command) ? object.command.map((e: any) => String(e)) : [],
-      args: Array.isArray(object?.args) ? object.args.map((e: any) => String(e)) : [],
-      environment: isObject(object.environment)
-        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
+      prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      volumes: Array.isArray(object?.volumes) ? object.volumes.map((e: any) => VolumeUsage.fromJSON(e)) : [],
     }
   },
 
-  toJSON(message: InitContainer): unknown {
+  toJSON(message: VolumeUsageResponse): unknown {
     const obj: any = {}
-    message.name !== undefined && (obj.name = message.name)
-    message.image !== undefined && (obj.image = message.image)
-    message.useParentConfig !== undefined && (obj.useParentConfig = message.useParentConfig)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
     if (message.volumes) {
-      obj.volumes = message.volumes.map(e => (e ? VolumeLink.toJSON(e) : undefined))
+      obj.volumes = message.volumes.map(e => (e ? VolumeUsage.toJSON(e) : undefined))
     } else {
       obj.volumes = []
     }
-    if (message.command) {
-      obj.command = message.command.map(e => e)
-    } else {
-      obj.command = []
-    }
-    if (message.args) {
-      obj.args = message.args.map(e => e)
+    return obj
+  },
+}
+
+function createBasePruneReportRequest(): PruneReportRequest {
+  return { targets: [] }
+}
+
+export const PruneReportRequest = {
+  fromJSON(object: any): PruneReportRequest {
+    return { targets: Array.isArray(object?.targets) ? object.targets.map((e: any) => pruneTargetFromJSON(e)) : [] }
+  },
+
+  toJSON(message: PruneReportRequest): unknown {
+    const obj: any = {}
+    if (message.targets) {
+      obj.targets = message.targets.map(e => pruneTargetToJSON(e))
     } else {
-      obj.args = []
-    }
-    obj.environment = {}
-    if (message.environment) {
-      Object.entries(message.environment).forEach(([k, v]) => {
-        obj.environment[k] = v
-      })
+      obj.targets = []
     }
     return obj
   },
 }
 
-function createBaseInitContainer_EnvironmentEntry(): InitContainer_EnvironmentEntry {
-  return { key: '', value: '' }
+function createBasePruneCandidate(): PruneCandidate {
+  return { target: 0, id: '', name: '', size: 0, reason: '' }
 }
 
-export const InitContainer_EnvironmentEntry = {
-  fromJSON(object: any): InitContainer_EnvironmentEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const PruneCandidate = {
+  fromJSON(object: any): PruneCandidate {
+    return {
+      target: isSet(object.target) ? pruneTargetFromJSON(object.target) : 0,
+      id: isSet(object.id) ? String(object.id) : '',
+      name: isSet(object.name) ? String(object.name) : '',
+      size: isSet(object.size) ? Number(object.size) : 0,
+      reason: isSet(object.reason) ? String(object.reason) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+    }
   },
 
-  toJSON(message: InitContainer_EnvironmentEntry): unknown {
+  toJSON(message: PruneCandidate): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.target !== undefined && (obj.target = pruneTargetToJSON(message.target))
+    message.id !== undefined && (obj.id = message.id)
+    message.name !== undefined && (obj.name = message.name)
+    message.size !== undefined && (obj.size = Math.round(message.size))
+    message.reason !== undefined && (obj.reason = message.reason)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
     return obj
   },
 }
 
-function createBaseImportContainer(): ImportContainer {
-  return { volume: '', command: '', environment: {} }
+function createBasePruneReportResponse(): PruneReportResponse {
+  return { reclaimable: 0, candidates: [] }
 }
 
-export const ImportContainer = {
-  fromJSON(object: any): ImportContainer {
+export const PruneReportResponse = {
+  fromJSON(object: any): PruneReportResponse {
     return {
-      volume: isSet(object.volume) ? String(object.volume) : '',
-      command: isSet(object.command) ? String(object.command) : '',
-      environment: isObject(object.environment)
-        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
+      reclaimable: isSet(object.reclaimable) ? Number(object.reclaimable) : 0,
+      candidates: Array.isArray(object?.candidates)
+        ? object.candidates.map((e: any) => PruneCandidate.fromJSON(e))
+        : [],
     }
   },
 
-  toJSON(message: ImportContainer): unknown {
+  toJSON(message: PruneReportResponse): unknown {
     const obj: any = {}
-    message.volume !== undefined && (obj.volume = message.volume)
-    message.command !== undefined && (obj.command = message.command)
-    obj.environment = {}
-    if (message.environment) {
-      Object.entries(message.environment).forEach(([k, v]) => {
-        obj.environment[k] = v
-      })
+    message.reclaimable !== undefined && (obj.reclaimable = Math.round(message.reclaimable))
+    if (message.candidates) {
+      obj.candidates = message.candidates.map(e => (e ? PruneCandidate.toJSON(e) : undefined))
+    } else {
+      obj.candidates = []
     }
     return obj
   },
 }
 
-function createBaseImportContainer_EnvironmentEntry(): ImportContainer_EnvironmentEntry {
-  return { key: '', value: '' }
+function createBaseTrafficUsageRequest(): TrafficUsageRequest {
+  return {}
 }
 
-export const ImportContainer_EnvironmentEntry = {
-  fromJSON(object: any): ImportContainer_EnvironmentEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const TrafficUsageRequest = {
+  fromJSON(object: any): TrafficUsageRequest {
+    return { prefix: isSet(object.prefix) ? String(object.prefix) : undefined }
   },
 
-  toJSON(message: ImportContainer_EnvironmentEntry): unknown {
+  toJSON(message: TrafficUsageRequest): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
     return obj
   },
 }
 
-function createBaseLogConfig(): LogConfig {
-  return { driver: 0, options: {} }
+function createBaseTrafficTotal(): TrafficTotal {
+  return { rxBytes: 0, txBytes: 0 }
 }
 
-export const LogConfig = {
-  fromJSON(object: any): LogConfig {
+export const TrafficTotal = {
+  fromJSON(object: any): TrafficTotal {
     return {
-      driver: isSet(object.driver) ? driverTypeFromJSON(object.driver) : 0,
-      options: isObject(object.options)
-        ? Object.entries(object.options).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
+      rxBytes: isSet(object.rxBytes) ? Number(object.rxBytes) : 0,
+      txBytes: isSet(object.txBytes) ? Number(object.txBytes) : 0,
     }
   },
 
-  toJSON(message: LogConfig): unknown {
+  toJSON(message: TrafficTotal): unknown {
     const obj: any = {}
-    message.driver !== undefined && (obj.driver = driverTypeToJSON(message.driver))
-    obj.options = {}
-    if (message.options) {
-      Object.entries(message.options).forEach(([k, v]) => {
-        obj.options[k] = v
-      })
-    }
+    message.rxBytes !== undefined && (obj.rxBytes = Math.round(message.rxBytes))
+    message.txBytes !== undefined && (obj.txBytes = Math.round(message.txBytes))
     return obj
   },
 }
 
-function createBaseLogConfig_OptionsEntry(): LogConfig_OptionsEntry {
-  return { key: '', value: '' }
+function createBaseDailyTraffic(): DailyTraffic {
+  return { day: '', total: undefined }
 }
 
-export const LogConfig_OptionsEntry = {
-  fromJSON(object: any): LogConfig_OptionsEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const DailyTraffic = {
+  fromJSON(object: any): DailyTraffic {
+    return {
+      day: isSet(object.day) ? String(object.day) : '',
+      total: isSet(object.total) ? TrafficTotal.fromJSON(object.total) : undefined,
+    }
   },
 
-  toJSON(message: LogConfig_OptionsEntry): unknown {
+  toJSON(message: DailyTraffic): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.day !== undefined && (obj.day = message.day)
+    message.total !== undefined && (obj.total = message.total ? TrafficTotal.toJSON(message.total) : undefined)
     return obj
   },
 }
 
-function createBaseMarker(): Marker {
-  return { deployment: {}, service: {}, ingress: {} }
+function createBasePrefixTraffic(): PrefixTraffic {
+  return { prefix: '', rolling: undefined, daily: [] }
 }
 
-export const Marker = {
-  fromJSON(object: any): Marker {
+export const PrefixTraffic = {
+  fromJSON(object: any): PrefixTraffic {
     return {
-      deployment: isObject(object.deployment)
-        ? Object.entries(object.deployment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
-      service: isObject(object.service)
-        ? Object.entries(object.service).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
-      ingress: isObject(object.ingress)
-        ? Object.entries(object.ingress).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
+      prefix: isSet(object.prefix) ? String(object.prefix) : '',
+      rolling: isSet(object.rolling) ? TrafficTotal.fromJSON(object.rolling) : undefined,
+      daily: Array.isArray(object?.daily) ? object.daily.map((e: any) => DailyTraffic.fromJSON(e)) : [],
     }
   },
 
-  toJSON(message: Marker): unknown {
+  toJSON(message: PrefixTraffic): unknown {
     const obj: any = {}
-    obj.deployment = {}
-    if (message.deployment) {
-      Object.entries(message.deployment).forEach(([k, v]) => {
-        obj.deployment[k] = v
-      })
-    }
-    obj.service = {}
-    if (message.service) {
-      Object.entries(message.service).forEach(([k, v]) => {
-        obj.service[k] = v
-      })
-    }
-    obj.ingress = {}
-    if (message.ingress) {
-      Object.entries(message.ingress).forEach(([k, v]) => {
-        obj.ingress[k] = v
-      })
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.rolling !== undefined && (obj.rolling = message.rolling ? TrafficTotal.toJSON(message.rolling) : undefined)
+    if (message.daily) {
+      obj.daily = message.daily.map(e => (e ? DailyTraffic.toJSON(e) : undefined))
+    } else {
+      obj.daily = []
     }
     return obj
   },
 }
 
-function createBaseMarker_DeploymentEntry(): Marker_DeploymentEntry {
-  return { key: '', value: '' }
+function createBaseTrafficUsageResponse(): TrafficUsageResponse {
+  return { since: undefined, prefixes: [] }
 }
 
-export const Marker_DeploymentEntry = {
-  fromJSON(object: any): Marker_DeploymentEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const TrafficUsageResponse = {
+  fromJSON(object: any): TrafficUsageResponse {
+    return {
+      prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      since: isSet(object.since) ? fromJsonTimestamp(object.since) : undefined,
+      prefixes: Array.isArray(object?.prefixes) ? object.prefixes.map((e: any) => PrefixTraffic.fromJSON(e)) : [],
+    }
   },
 
-  toJSON(message: Marker_DeploymentEntry): unknown {
+  toJSON(message: TrafficUsageResponse): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.since !== undefined && (obj.since = fromTimestamp(message.since).toISOString())
+    if (message.prefixes) {
+      obj.prefixes = message.prefixes.map(e => (e ? PrefixTraffic.toJSON(e) : undefined))
+    } else {
+      obj.prefixes = []
+    }
     return obj
   },
 }
 
-function createBaseMarker_ServiceEntry(): Marker_ServiceEntry {
-  return { key: '', value: '' }
+function createBaseIngressConfigRequest(): IngressConfigRequest {
+  return { enabled: false, version: '', logLevel: '', port: 0, entrypoints: [] }
 }
 
-export const Marker_ServiceEntry = {
-  fromJSON(object: any): Marker_ServiceEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const IngressConfigRequest = {
+  fromJSON(object: any): IngressConfigRequest {
+    return {
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      version: isSet(object.version) ? String(object.version) : '',
+      logLevel: isSet(object.logLevel) ? String(object.logLevel) : '',
+      port: isSet(object.port) ? Number(object.port) : 0,
+      tlsPort: isSet(object.tlsPort) ? Number(object.tlsPort) : undefined,
+      acmeEmail: isSet(object.acmeEmail) ? String(object.acmeEmail) : undefined,
+      acmeStorage: isSet(object.acmeStorage) ? String(object.acmeStorage) : undefined,
+      dashboardPort: isSet(object.dashboardPort) ? Number(object.dashboardPort) : undefined,
+      entrypoints: Array.isArray(object?.entrypoints)
+        ? object.entrypoints.map((e: any) => IngressEntrypoint.fromJSON(e))
+        : [],
+    }
   },
 
-  toJSON(message: Marker_ServiceEntry): unknown {
+  toJSON(message: IngressConfigRequest): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.enabled !== undefined && (obj.enabled = message.enabled)
+    message.version !== undefined && (obj.version = message.version)
+    message.logLevel !== undefined && (obj.logLevel = message.logLevel)
+    message.port !== undefined && (obj.port = Math.round(message.port))
+    message.tlsPort !== undefined && (obj.tlsPort = Math.round(message.tlsPort))
+    message.acmeEmail !== undefined && (obj.acmeEmail = message.acmeEmail)
+    message.acmeStorage !== undefined && (obj.acmeStorage = message.acmeStorage)
+    message.dashboardPort !== undefined && (obj.dashboardPort = Math.round(message.dashboardPort))
+    if (message.entrypoints) {
+      obj.entrypoints = message.entrypoints.map(e => (e ? IngressEntrypoint.toJSON(e) : undefined))
+    } else {
+      obj.entrypoints = []
+    }
     return obj
   },
 }
 
-function createBaseMarker_IngressEntry(): Marker_IngressEntry {
-  return { key: '', value: '' }
+function createBaseIngressEntrypoint(): IngressEntrypoint {
+  return { name: '', port: 0 }
 }
 
-export const Marker_IngressEntry = {
-  fromJSON(object: any): Marker_IngressEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const IngressEntrypoint = {
+  fromJSON(object: any): IngressEntrypoint {
+    return { name: isSet(object.name) ? String(object.name) : '', port: isSet(object.port) ? Number(object.port) : 0 }
   },
 
-  toJSON(message: Marker_IngressEntry): unknown {
+  toJSON(message: IngressEntrypoint): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.name !== undefined && (obj.name = message.name)
+    message.port !== undefined && (obj.port = Math.round(message.port))
     return obj
   },
 }
 
-function createBaseMetrics(): Metrics {
-  return { port: 0, path: '' }
+function createBaseIngressConfigResponse(): IngressConfigResponse {
+  return { enabled: false, version: '', changed: false }
 }
 
-export const Metrics = {
-  fromJSON(object: any): Metrics {
-    return { port: isSet(object.port) ? Number(object.port) : 0, path: isSet(object.path) ? String(object.path) : '' }
+export const IngressConfigResponse = {
+  fromJSON(object: any): IngressConfigResponse {
+    return {
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      version: isSet(object.version) ? String(object.version) : '',
+      changed: isSet(object.changed) ? Boolean(object.changed) : false,
+      containerId: isSet(object.containerId) ? String(object.containerId) : undefined,
+    }
   },
 
-  toJSON(message: Metrics): unknown {
+  toJSON(message: IngressConfigResponse): unknown {
     const obj: any = {}
-    message.port !== undefined && (obj.port = Math.round(message.port))
-    message.path !== undefined && (obj.path = message.path)
+    message.enabled !== undefined && (obj.enabled = message.enabled)
+    message.version !== undefined && (obj.version = message.version)
+    message.changed !== undefined && (obj.changed = message.changed)
+    message.containerId !== undefined && (obj.containerId = message.containerId)
     return obj
   },
 }
 
-function createBaseExpectedState(): ExpectedState {
-  return { state: 0 }
+function createBaseRollbackToRevisionRequest(): RollbackToRevisionRequest {
+  return { container: undefined }
 }
 
-export const ExpectedState = {
-  fromJSON(object: any): ExpectedState {
+export const RollbackToRevisionRequest = {
+  fromJSON(object: any): RollbackToRevisionRequest {
     return {
-      state: isSet(object.state) ? containerStateFromJSON(object.state) : 0,
-      timeout: isSet(object.timeout) ? Number(object.timeout) : undefined,
-      exitCode: isSet(object.exitCode) ? Number(object.exitCode) : undefined,
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      revision: isSet(object.revision) ? Number(object.revision) : undefined,
+      actor: isSet(object.actor) ? String(object.actor) : undefined,
     }
   },
 
-  toJSON(message: ExpectedState): unknown {
+  toJSON(message: RollbackToRevisionRequest): unknown {
     const obj: any = {}
-    message.state !== undefined && (obj.state = containerStateToJSON(message.state))
-    message.timeout !== undefined && (obj.timeout = Math.round(message.timeout))
-    message.exitCode !== undefined && (obj.exitCode = Math.round(message.exitCode))
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.revision !== undefined && (obj.revision = Math.round(message.revision))
+    message.actor !== undefined && (obj.actor = message.actor)
     return obj
   },
 }
 
-function createBaseDagentContainerConfig(): DagentContainerConfig {
-  return { networks: [], labels: {} }
+function createBaseDeploymentRevision(): DeploymentRevision {
+  return { revision: 0, configHash: '', createdAt: undefined, images: {}, digests: {} }
 }
 
-export const DagentContainerConfig = {
-  fromJSON(object: any): DagentContainerConfig {
+export const DeploymentRevision = {
+  fromJSON(object: any): DeploymentRevision {
     return {
-      logConfig: isSet(object.logConfig) ? LogConfig.fromJSON(object.logConfig) : undefined,
-      restartPolicy: isSet(object.restartPolicy) ? restartPolicyFromJSON(object.restartPolicy) : undefined,
-      networkMode: isSet(object.networkMode) ? networkModeFromJSON(object.networkMode) : undefined,
-      expectedState: isSet(object.expectedState) ? ExpectedState.fromJSON(object.expectedState) : undefined,
-      networks: Array.isArray(object?.networks) ? object.networks.map((e: any) => String(e)) : [],
-      labels: isObject(object.labels)
-        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      revision: isSet(object.revision) ? Number(object.revision) : 0,
+      configHash: isSet(object.configHash) ? String(object.configHash) : '',
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      actor: isSet(object.actor) ? String(object.actor) : undefined,
+      rollbackOf: isSet(object.rollbackOf) ? Number(object.rollbackOf) : undefined,
+      images: isObject(object.images)
+        ? Object.entries(object.images).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+      digests: isObject(object.digests)
+        ? Object.entries(object.digests).reduce<{ [key: string]: string }>((acc, [key, value]) => {
             acc[key] = String(value)
             return acc
           }, {})
@@ -1071,41 +3690,39 @@ export const DagentContainerConfig = {
     }
   },
 
-  toJSON(message: DagentContainerConfig): unknown {
+  toJSON(message: DeploymentRevision): unknown {
     const obj: any = {}
-    message.logConfig !== undefined &&
-      (obj.logConfig = message.logConfig ? LogConfig.toJSON(message.logConfig) : undefined)
-    message.restartPolicy !== undefined &&
-      (obj.restartPolicy = message.restartPolicy !== undefined ? restartPolicyToJSON(message.restartPolicy) : undefined)
-    message.networkMode !== undefined &&
-      (obj.networkMode = message.networkMode !== undefined ? networkModeToJSON(message.networkMode) : undefined)
-    message.expectedState !== undefined &&
-      (obj.expectedState = message.expectedState ? ExpectedState.toJSON(message.expectedState) : undefined)
-    if (message.networks) {
-      obj.networks = message.networks.map(e => e)
-    } else {
-      obj.networks = []
+    message.revision !== undefined && (obj.revision = Math.round(message.revision))
+    message.configHash !== undefined && (obj.configHash = message.configHash)
+    message.createdAt !== undefined && (obj.createdAt = fromTimestamp(message.createdAt).toISOString())
+    message.actor !== undefined && (obj.actor = message.actor)
+    message.rollbackOf !== undefined && (obj.rollbackOf = Math.round(message.rollbackOf))
+    obj.images = {}
+    if (message.images) {
+      Object.entries(message.images).forEach(([k, v]) => {
+        obj.images[k] = v
+      })
     }
-    obj.labels = {}
-    if (message.labels) {
-      Object.entries(message.labels).forEach(([k, v]) => {
-        obj.labels[k] = v
+    obj.digests = {}
+    if (message.digests) {
+      Object.entries(message.digests).forEach(([k, v]) => {
+        obj.digests[k] = v
       })
     }
     return obj
   },
 }
 
-function createBaseDagentContainerConfig_LabelsEntry(): DagentContainerConfig_LabelsEntry {
+function createBaseDeploymentRevision_ImagesEntry(): DeploymentRevision_ImagesEntry {
   return { key: '', value: '' }
 }
 
-export const DagentContainerConfig_LabelsEntry = {
-  fromJSON(object: any): DagentContainerConfig_LabelsEntry {
+export const DeploymentRevision_ImagesEntry = {
+  fromJSON(object: any): DeploymentRevision_ImagesEntry {
     return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
   },
 
-  toJSON(message: DagentContainerConfig_LabelsEntry): unknown {
+  toJSON(message: DeploymentRevision_ImagesEntry): unknown {
     const obj: any = {}
     message.key !== undefined && (obj.key = message.key)
     message.value !== undefined && (obj.value = message.value)
@@ -1113,428 +3730,444 @@ export const DagentContainerConfig_LabelsEntry = {
   },
 }
 
-function createBaseCraneContainerConfig(): CraneContainerConfig {
-  return { customHeaders: [], extraLBAnnotations: {} }
+function createBaseDeploymentRevision_DigestsEntry(): DeploymentRevision_DigestsEntry {
+  return { key: '', value: '' }
 }
 
-export const CraneContainerConfig = {
-  fromJSON(object: any): CraneContainerConfig {
+export const DeploymentRevision_DigestsEntry = {
+  fromJSON(object: any): DeploymentRevision_DigestsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: DeploymentRevision_DigestsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseRollbackToRevisionResponse(): RollbackToRevisionResponse {
+  return { container: undefined, revision: undefined, history: [] }
+}
+
+export const RollbackToRevisionResponse = {
+  fromJSON(object: any): RollbackToRevisionResponse {
     return {
-      deploymentStrategy: isSet(object.deploymentStrategy)
-        ? deploymentStrategyFromJSON(object.deploymentStrategy)
-        : undefined,
-      healthCheckConfig: isSet(object.healthCheckConfig)
-        ? HealthCheckConfig.fromJSON(object.healthCheckConfig)
-        : undefined,
-      resourceConfig: isSet(object.resourceConfig) ? ResourceConfig.fromJSON(object.resourceConfig) : undefined,
-      proxyHeaders: isSet(object.proxyHeaders) ? Boolean(object.proxyHeaders) : undefined,
-      useLoadBalancer: isSet(object.useLoadBalancer) ? Boolean(object.useLoadBalancer) : undefined,
-      annotations: isSet(object.annotations) ? Marker.fromJSON(object.annotations) : undefined,
-      labels: isSet(object.labels) ? Marker.fromJSON(object.labels) : undefined,
-      metrics: isSet(object.metrics) ? Metrics.fromJSON(object.metrics) : undefined,
-      customHeaders: Array.isArray(object?.customHeaders) ? object.customHeaders.map((e: any) => String(e)) : [],
-      extraLBAnnotations: isObject(object.extraLBAnnotations)
-        ? Object.entries(object.extraLBAnnotations).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      revision: isSet(object.revision) ? DeploymentRevision.fromJSON(object.revision) : undefined,
+      history: Array.isArray(object?.history) ? object.history.map((e: any) => DeploymentRevision.fromJSON(e)) : [],
     }
   },
 
-  toJSON(message: CraneContainerConfig): unknown {
+  toJSON(message: RollbackToRevisionResponse): unknown {
     const obj: any = {}
-    message.deploymentStrategy !== undefined &&
-      (obj.deploymentStrategy =
-        message.deploymentStrategy !== undefined ? deploymentStrategyToJSON(message.deploymentStrategy) : undefined)
-    message.healthCheckConfig !== undefined &&
-      (obj.healthCheckConfig = message.healthCheckConfig
-        ? HealthCheckConfig.toJSON(message.healthCheckConfig)
-        : undefined)
-    message.resourceConfig !== undefined &&
-      (obj.resourceConfig = message.resourceConfig ? ResourceConfig.toJSON(message.resourceConfig) : undefined)
-    message.proxyHeaders !== undefined && (obj.proxyHeaders = message.proxyHeaders)
-    message.useLoadBalancer !== undefined && (obj.useLoadBalancer = message.useLoadBalancer)
-    message.annotations !== undefined &&
-      (obj.annotations = message.annotations ? Marker.toJSON(message.annotations) : undefined)
-    message.labels !== undefined && (obj.labels = message.labels ? Marker.toJSON(message.labels) : undefined)
-    message.metrics !== undefined && (obj.metrics = message.metrics ? Metrics.toJSON(message.metrics) : undefined)
-    if (message.customHeaders) {
-      obj.customHeaders = message.customHeaders.map(e => e)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.revision !== undefined &&
+      (obj.revision = message.revision ? DeploymentRevision.toJSON(message.revision) : undefined)
+    if (message.history) {
+      obj.history = message.history.map(e => (e ? DeploymentRevision.toJSON(e) : undefined))
     } else {
-      obj.customHeaders = []
-    }
-    obj.extraLBAnnotations = {}
-    if (message.extraLBAnnotations) {
-      Object.entries(message.extraLBAnnotations).forEach(([k, v]) => {
-        obj.extraLBAnnotations[k] = v
-      })
+      obj.history = []
     }
     return obj
   },
 }
 
-function createBaseCraneContainerConfig_ExtraLBAnnotationsEntry(): CraneContainerConfig_ExtraLBAnnotationsEntry {
-  return { key: '', value: '' }
+function createBaseResourceRecommendationRequest(): ResourceRecommendationRequest {
+  return {}
 }
 
-export const CraneContainerConfig_ExtraLBAnnotationsEntry = {
-  fromJSON(object: any): CraneContainerConfig_ExtraLBAnnotationsEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const ResourceRecommendationRequest = {
+  fromJSON(object: any): ResourceRecommendationRequest {
+    return { prefix: isSet(object.prefix) ? String(object.prefix) : undefined }
   },
 
-  toJSON(message: CraneContainerConfig_ExtraLBAnnotationsEntry): unknown {
+  toJSON(message: ResourceRecommendationRequest): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
     return obj
   },
 }
 
-function createBaseCommonContainerConfig(): CommonContainerConfig {
-  return {
-    name: '',
-    ports: [],
-    portRanges: [],
-    volumes: [],
-    commands: [],
-    args: [],
-    environment: {},
-    secrets: {},
-    initContainers: [],
-  }
+function createBaseResourceRecommendation(): ResourceRecommendation {
+  return { container: undefined, recommended: undefined, samples: 0 }
 }
 
-export const CommonContainerConfig = {
-  fromJSON(object: any): CommonContainerConfig {
+export const ResourceRecommendation = {
+  fromJSON(object: any): ResourceRecommendation {
     return {
-      name: isSet(object.name) ? String(object.name) : '',
-      expose: isSet(object.expose) ? exposeStrategyFromJSON(object.expose) : undefined,
-      routing: isSet(object.routing) ? Routing.fromJSON(object.routing) : undefined,
-      configContainer: isSet(object.configContainer) ? ConfigContainer.fromJSON(object.configContainer) : undefined,
-      importContainer: isSet(object.importContainer) ? ImportContainer.fromJSON(object.importContainer) : undefined,
-      user: isSet(object.user) ? Number(object.user) : undefined,
-      TTY: isSet(object.TTY) ? Boolean(object.TTY) : undefined,
-      workingDirectory: isSet(object.workingDirectory) ? String(object.workingDirectory) : undefined,
-      ports: Array.isArray(object?.ports) ? object.ports.map((e: any) => Port.fromJSON(e)) : [],
-      portRanges: Array.isArray(object?.portRanges)
-        ? object.portRanges.map((e: any) => PortRangeBinding.fromJSON(e))
-        : [],
-      volumes: Array.isArray(object?.volumes) ? object.volumes.map((e: any) => Volume.fromJSON(e)) : [],
-      commands: Array.isArray(object?.commands) ? object.commands.map((e: any) => String(e)) : [],
-      args: Array.isArray(object?.args) ? object.args.map((e: any) => String(e)) : [],
-      environment: isObject(object.environment)
-        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
-      secrets: isObject(object.secrets)
-        ? Object.entries(object.secrets).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-            acc[key] = String(value)
-            return acc
-          }, {})
-        : {},
-      initContainers: Array.isArray(object?.initContainers)
-        ? object.initContainers.map((e: any) => InitContainer.fromJSON(e))
-        : [],
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      requests: isSet(object.requests) ? Resource.fromJSON(object.requests) : undefined,
+      recommended: isSet(object.recommended) ? Resource.fromJSON(object.recommended) : undefined,
+      samples: isSet(object.samples) ? Number(object.samples) : 0,
     }
   },
 
-  toJSON(message: CommonContainerConfig): unknown {
+  toJSON(message: ResourceRecommendation): unknown {
     const obj: any = {}
-    message.name !== undefined && (obj.name = message.name)
-    message.expose !== undefined &&
-      (obj.expose = message.expose !== undefined ? exposeStrategyToJSON(message.expose) : undefined)
-    message.routing !== undefined && (obj.routing = message.routing ? Routing.toJSON(message.routing) : undefined)
-    message.configContainer !== undefined &&
-      (obj.configContainer = message.configContainer ? ConfigContainer.toJSON(message.configContainer) : undefined)
-    message.importContainer !== undefined &&
-      (obj.importContainer = message.importContainer ? ImportContainer.toJSON(message.importContainer) : undefined)
-    message.user !== undefined && (obj.user = Math.round(message.user))
-    message.TTY !== undefined && (obj.TTY = message.TTY)
-    message.workingDirectory !== undefined && (obj.workingDirectory = message.workingDirectory)
-    if (message.ports) {
-      obj.ports = message.ports.map(e => (e ? Port.toJSON(e) : undefined))
-    } else {
-      obj.ports = []
-    }
-    if (message.portRanges) {
-      obj.portRanges = message.portRanges.map(e => (e ? PortRangeBinding.toJSON(e) : undefined))
-    } else {
-      obj.portRanges = []
-    }
-    if (message.volumes) {
-      obj.volumes = message.volumes.map(e => (e ? Volume.toJSON(e) : undefined))
-    } else {
-      obj.volumes = []
-    }
-    if (message.commands) {
-      obj.commands = message.commands.map(e => e)
-    } else {
-      obj.commands = []
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.requests !== undefined && (obj.requests = message.requests ? Resource.toJSON(message.requests) : undefined)
+    message.recommended !== undefined &&
+      (obj.recommended = message.recommended ? Resource.toJSON(message.recommended) : undefined)
+    message.samples !== undefined && (obj.samples = Math.round(message.samples))
+    return obj
+  },
+}
+
+function createBaseResourceRecommendationResponse(): ResourceRecommendationResponse {
+  return { recommendations: [] }
+}
+
+export const ResourceRecommendationResponse = {
+  fromJSON(object: any): ResourceRecommendationResponse {
+    return {
+      prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      recommendations: Array.isArray(object?.recommendations)
+        ? object.recommendations.map((e: any) => ResourceRecommendation.fromJSON(e))
+        : [],
     }
-    if (message.args) {
-      obj.args = message.args.map(e => e)
+  },
+
+  toJSON(message: ResourceRecommendationResponse): unknown {
+    const obj: any = {}
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    if (message.recommendations) {
+      obj.recommendations = message.recommendations.map(e => (e ? ResourceRecommendation.toJSON(e) : undefined))
     } else {
-      obj.args = []
-    }
-    obj.environment = {}
-    if (message.environment) {
-      Object.entries(message.environment).forEach(([k, v]) => {
-        obj.environment[k] = v
-      })
-    }
-    obj.secrets = {}
-    if (message.secrets) {
-      Object.entries(message.secrets).forEach(([k, v]) => {
-        obj.secrets[k] = v
-      })
+      obj.recommendations = []
     }
-    if (message.initContainers) {
-      obj.initContainers = message.initContainers.map(e => (e ? InitContainer.toJSON(e) : undefined))
-    } else {
-      obj.initContainers = []
+    return obj
+  },
+}
+
+function createBaseWorkloadOperationRequest(): WorkloadOperationRequest {
+  return { id: '', container: undefined, operation: 0 }
+}
+
+export const WorkloadOperationRequest = {
+  fromJSON(object: any): WorkloadOperationRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      operation: isSet(object.operation) ? workloadOperationFromJSON(object.operation) : 0,
+      pod: isSet(object.pod) ? String(object.pod) : undefined,
+      force: isSet(object.force) ? Boolean(object.force) : undefined,
+      replicas: isSet(object.replicas) ? Number(object.replicas) : undefined,
     }
+  },
+
+  toJSON(message: WorkloadOperationRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.operation !== undefined && (obj.operation = workloadOperationToJSON(message.operation))
+    message.pod !== undefined && (obj.pod = message.pod)
+    message.force !== undefined && (obj.force = message.force)
+    message.replicas !== undefined && (obj.replicas = Math.round(message.replicas))
     return obj
   },
 }
 
-function createBaseCommonContainerConfig_EnvironmentEntry(): CommonContainerConfig_EnvironmentEntry {
-  return { key: '', value: '' }
+function createBaseWorkloadOperationMessage(): WorkloadOperationMessage {
+  return { status: 0, message: '', createdAt: undefined }
 }
 
-export const CommonContainerConfig_EnvironmentEntry = {
-  fromJSON(object: any): CommonContainerConfig_EnvironmentEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const WorkloadOperationMessage = {
+  fromJSON(object: any): WorkloadOperationMessage {
+    return {
+      status: isSet(object.status) ? deploymentStatusFromJSON(object.status) : 0,
+      message: isSet(object.message) ? String(object.message) : '',
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      replicas: isSet(object.replicas) ? Number(object.replicas) : undefined,
+      updatedReplicas: isSet(object.updatedReplicas) ? Number(object.updatedReplicas) : undefined,
+      readyReplicas: isSet(object.readyReplicas) ? Number(object.readyReplicas) : undefined,
+    }
   },
 
-  toJSON(message: CommonContainerConfig_EnvironmentEntry): unknown {
+  toJSON(message: WorkloadOperationMessage): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.status !== undefined && (obj.status = deploymentStatusToJSON(message.status))
+    message.message !== undefined && (obj.message = message.message)
+    message.createdAt !== undefined && (obj.createdAt = fromTimestamp(message.createdAt).toISOString())
+    message.replicas !== undefined && (obj.replicas = Math.round(message.replicas))
+    message.updatedReplicas !== undefined && (obj.updatedReplicas = Math.round(message.updatedReplicas))
+    message.readyReplicas !== undefined && (obj.readyReplicas = Math.round(message.readyReplicas))
     return obj
   },
 }
 
-function createBaseCommonContainerConfig_SecretsEntry(): CommonContainerConfig_SecretsEntry {
-  return { key: '', value: '' }
+function createBaseDebugContainerRequest(): DebugContainerRequest {
+  return { id: '', container: undefined, image: '', command: [], tty: false }
 }
 
-export const CommonContainerConfig_SecretsEntry = {
-  fromJSON(object: any): CommonContainerConfig_SecretsEntry {
-    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+export const DebugContainerRequest = {
+  fromJSON(object: any): DebugContainerRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      image: isSet(object.image) ? String(object.image) : '',
+      pod: isSet(object.pod) ? String(object.pod) : undefined,
+      command: Array.isArray(object?.command) ? object.command.map((e: any) => String(e)) : [],
+      tty: isSet(object.tty) ? Boolean(object.tty) : false,
+    }
   },
 
-  toJSON(message: CommonContainerConfig_SecretsEntry): unknown {
+  toJSON(message: DebugContainerRequest): unknown {
     const obj: any = {}
-    message.key !== undefined && (obj.key = message.key)
-    message.value !== undefined && (obj.value = message.value)
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.image !== undefined && (obj.image = message.image)
+    message.pod !== undefined && (obj.pod = message.pod)
+    if (message.command) {
+      obj.command = message.command.map(e => e)
+    } else {
+      obj.command = []
+    }
+    message.tty !== undefined && (obj.tty = message.tty)
     return obj
   },
 }
 
-function createBaseDeployWorkloadRequest(): DeployWorkloadRequest {
-  return { id: '', imageName: '', tag: '' }
+function createBaseTerminalSize(): TerminalSize {
+  return { width: 0, height: 0 }
 }
 
-export const DeployWorkloadRequest = {
-  fromJSON(object: any): DeployWorkloadRequest {
+export const TerminalSize = {
+  fromJSON(object: any): TerminalSize {
     return {
-      id: isSet(object.id) ? String(object.id) : '',
-      common: isSet(object.common) ? CommonContainerConfig.fromJSON(object.common) : undefined,
-      dagent: isSet(object.dagent) ? DagentContainerConfig.fromJSON(object.dagent) : undefined,
-      crane: isSet(object.crane) ? CraneContainerConfig.fromJSON(object.crane) : undefined,
-      registry: isSet(object.registry) ? String(object.registry) : undefined,
-      imageName: isSet(object.imageName) ? String(object.imageName) : '',
-      tag: isSet(object.tag) ? String(object.tag) : '',
-      registryAuth: isSet(object.registryAuth) ? RegistryAuth.fromJSON(object.registryAuth) : undefined,
+      width: isSet(object.width) ? Number(object.width) : 0,
+      height: isSet(object.height) ? Number(object.height) : 0,
     }
   },
 
-  toJSON(message: DeployWorkloadRequest): unknown {
+  toJSON(message: TerminalSize): unknown {
     const obj: any = {}
-    message.id !== undefined && (obj.id = message.id)
-    message.common !== undefined &&
-      (obj.common = message.common ? CommonContainerConfig.toJSON(message.common) : undefined)
-    message.dagent !== undefined &&
-      (obj.dagent = message.dagent ? DagentContainerConfig.toJSON(message.dagent) : undefined)
-    message.crane !== undefined && (obj.crane = message.crane ? CraneContainerConfig.toJSON(message.crane) : undefined)
-    message.registry !== undefined && (obj.registry = message.registry)
-    message.imageName !== undefined && (obj.imageName = message.imageName)
-    message.tag !== undefined && (obj.tag = message.tag)
-    message.registryAuth !== undefined &&
-      (obj.registryAuth = message.registryAuth ? RegistryAuth.toJSON(message.registryAuth) : undefined)
+    message.width !== undefined && (obj.width = Math.round(message.width))
+    message.height !== undefined && (obj.height = Math.round(message.height))
     return obj
   },
 }
 
-function createBaseContainerStateRequest(): ContainerStateRequest {
-  return {}
+function createBaseDebugSessionStarted(): DebugSessionStarted {
+  return { pod: '', container: '' }
 }
 
-export const ContainerStateRequest = {
-  fromJSON(object: any): ContainerStateRequest {
+export const DebugSessionStarted = {
+  fromJSON(object: any): DebugSessionStarted {
     return {
-      prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
-      oneShot: isSet(object.oneShot) ? Boolean(object.oneShot) : undefined,
+      pod: isSet(object.pod) ? String(object.pod) : '',
+      container: isSet(object.container) ? String(object.container) : '',
     }
   },
 
-  toJSON(message: ContainerStateRequest): unknown {
+  toJSON(message: DebugSessionStarted): unknown {
     const obj: any = {}
-    message.prefix !== undefined && (obj.prefix = message.prefix)
-    message.oneShot !== undefined && (obj.oneShot = message.oneShot)
+    message.pod !== undefined && (obj.pod = message.pod)
+    message.container !== undefined && (obj.container = message.container)
     return obj
   },
 }
 
-function createBaseContainerDeleteRequest(): ContainerDeleteRequest {
-  return { prefix: '', name: '' }
+function createBaseDebugSessionEnded(): DebugSessionEnded {
+  return {}
 }
 
-export const ContainerDeleteRequest = {
-  fromJSON(object: any): ContainerDeleteRequest {
-    return {
-      prefix: isSet(object.prefix) ? String(object.prefix) : '',
-      name: isSet(object.name) ? String(object.name) : '',
-    }
+export const DebugSessionEnded = {
+  fromJSON(object: any): DebugSessionEnded {
+    return { error: isSet(object.error) ? String(object.error) : undefined }
   },
 
-  toJSON(message: ContainerDeleteRequest): unknown {
+  toJSON(message: DebugSessionEnded): unknown {
     const obj: any = {}
-    message.prefix !== undefined && (obj.prefix = message.prefix)
-    message.name !== undefined && (obj.name = message.name)
+    message.error !== undefined && (obj.error = message.error)
     return obj
   },
 }
 
-function createBaseDeployRequestLegacy(): DeployRequestLegacy {
-  return { requestId: '', json: '' }
+function createBaseDebugSessionInput(): DebugSessionInput {
+  return {}
 }
 
-export const DeployRequestLegacy = {
-  fromJSON(object: any): DeployRequestLegacy {
+export const DebugSessionInput = {
+  fromJSON(object: any): DebugSessionInput {
     return {
-      requestId: isSet(object.requestId) ? String(object.requestId) : '',
-      json: isSet(object.json) ? String(object.json) : '',
+      stdin: isSet(object.stdin) ? bytesFromBase64(object.stdin) : undefined,
+      resize: isSet(object.resize) ? TerminalSize.fromJSON(object.resize) : undefined,
+      closeStdin: isSet(object.closeStdin) ? Empty.fromJSON(object.closeStdin) : undefined,
     }
   },
 
-  toJSON(message: DeployRequestLegacy): unknown {
+  toJSON(message: DebugSessionInput): unknown {
     const obj: any = {}
-    message.requestId !== undefined && (obj.requestId = message.requestId)
-    message.json !== undefined && (obj.json = message.json)
+    message.stdin !== undefined &&
+      (obj.stdin = message.stdin !== undefined ? base64FromBytes(message.stdin) : undefined)
+    message.resize !== undefined && (obj.resize = message.resize ? TerminalSize.toJSON(message.resize) : undefined)
+    message.closeStdin !== undefined &&
+      (obj.closeStdin = message.closeStdin ? Empty.toJSON(message.closeStdin) : undefined)
     return obj
   },
 }
 
-function createBaseAgentUpdateRequest(): AgentUpdateRequest {
-  return { tag: '', timeoutSeconds: 0, token: '' }
+function createBaseDebugSessionOutput(): DebugSessionOutput {
+  return {}
 }
 
-export const AgentUpdateRequest = {
-  fromJSON(object: any): AgentUpdateRequest {
+export const DebugSessionOutput = {
+  fromJSON(object: any): DebugSessionOutput {
     return {
-      tag: isSet(object.tag) ? String(object.tag) : '',
-      timeoutSeconds: isSet(object.timeoutSeconds) ? Number(object.timeoutSeconds) : 0,
-      token: isSet(object.token) ? String(object.token) : '',
+      started: isSet(object.started) ? DebugSessionStarted.fromJSON(object.started) : undefined,
+      stdout: isSet(object.stdout) ? bytesFromBase64(object.stdout) : undefined,
+      stderr: isSet(object.stderr) ? bytesFromBase64(object.stderr) : undefined,
+      ended: isSet(object.ended) ? DebugSessionEnded.fromJSON(object.ended) : undefined,
     }
   },
 
-  toJSON(message: AgentUpdateRequest): unknown {
+  toJSON(message: DebugSessionOutput): unknown {
     const obj: any = {}
-    message.tag !== undefined && (obj.tag = message.tag)
-    message.timeoutSeconds !== undefined && (obj.timeoutSeconds = Math.round(message.timeoutSeconds))
-    message.token !== undefined && (obj.token = message.token)
+    message.started !== undefined &&
+      (obj.started = message.started ? DebugSessionStarted.toJSON(message.started) : undefined)
+    message.stdout !== undefined &&
+      (obj.stdout = message.stdout !== undefined ? base64FromBytes(message.stdout) : undefined)
+    message.stderr !== undefined &&
+      (obj.stderr = message.stderr !== undefined ? base64FromBytes(message.stderr) : undefined)
+    message.ended !== undefined && (obj.ended = message.ended ? DebugSessionEnded.toJSON(message.ended) : undefined)
     return obj
   },
 }
 
-function createBaseReplaceTokenRequest(): ReplaceTokenRequest {
-  return { token: '' }
+function createBaseCloseConnectionRequest(): CloseConnectionRequest {
+  return { reason: 0 }
 }
 
-export const ReplaceTokenRequest = {
-  fromJSON(object: any): ReplaceTokenRequest {
-    return { token: isSet(object.token) ? String(object.token) : '' }
+export const CloseConnectionRequest = {
+  fromJSON(object: any): CloseConnectionRequest {
+    return { reason: isSet(object.reason) ? closeReasonFromJSON(object.reason) : 0 }
   },
 
-  toJSON(message: ReplaceTokenRequest): unknown {
+  toJSON(message: CloseConnectionRequest): unknown {
     const obj: any = {}
-    message.token !== undefined && (obj.token = message.token)
+    message.reason !== undefined && (obj.reason = closeReasonToJSON(message.reason))
     return obj
   },
 }
 
-function createBaseAgentAbortUpdate(): AgentAbortUpdate {
-  return { error: '' }
+function createBaseCacheInvalidationRequest(): CacheInvalidationRequest {
+  return { kind: 0 }
 }
 
-export const AgentAbortUpdate = {
-  fromJSON(object: any): AgentAbortUpdate {
-    return { error: isSet(object.error) ? String(object.error) : '' }
+export const CacheInvalidationRequest = {
+  fromJSON(object: any): CacheInvalidationRequest {
+    return {
+      kind: isSet(object.kind) ? cacheKindFromJSON(object.kind) : 0,
+      key: isSet(object.key) ? String(object.key) : undefined,
+    }
   },
 
-  toJSON(message: AgentAbortUpdate): unknown {
+  toJSON(message: CacheInvalidationRequest): unknown {
     const obj: any = {}
-    message.error !== undefined && (obj.error = message.error)
+    message.kind !== undefined && (obj.kind = cacheKindToJSON(message.kind))
+    message.key !== undefined && (obj.key = message.key)
     return obj
   },
 }
 
-function createBaseContainerLogRequest(): ContainerLogRequest {
-  return { container: undefined, streaming: false, tail: 0 }
+function createBaseContainerCheckpointRequest(): ContainerCheckpointRequest {
+  return { container: undefined, leaveRunning: false }
 }
 
-export const ContainerLogRequest = {
-  fromJSON(object: any): ContainerLogRequest {
+export const ContainerCheckpointRequest = {
+  fromJSON(object: any): ContainerCheckpointRequest {
     return {
       container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
-      streaming: isSet(object.streaming) ? Boolean(object.streaming) : false,
-      tail: isSet(object.tail) ? Number(object.tail) : 0,
+      checkpointId: isSet(object.checkpointId) ? String(object.checkpointId) : undefined,
+      leaveRunning: isSet(object.leaveRunning) ? Boolean(object.leaveRunning) : false,
     }
   },
 
-  toJSON(message: ContainerLogRequest): unknown {
+  toJSON(message: ContainerCheckpointRequest): unknown {
     const obj: any = {}
     message.container !== undefined &&
       (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
-    message.streaming !== undefined && (obj.streaming = message.streaming)
-    message.tail !== undefined && (obj.tail = Math.round(message.tail))
+    message.checkpointId !== undefined && (obj.checkpointId = message.checkpointId)
+    message.leaveRunning !== undefined && (obj.leaveRunning = message.leaveRunning)
     return obj
   },
 }
 
-function createBaseContainerInspectRequest(): ContainerInspectRequest {
-  return { container: undefined }
+function createBaseContainerCheckpointResponse(): ContainerCheckpointResponse {
+  return { container: undefined, checkpointId: '', checkpoints: [] }
 }
 
-export const ContainerInspectRequest = {
-  fromJSON(object: any): ContainerInspectRequest {
-    return { container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined }
+export const ContainerCheckpointResponse = {
+  fromJSON(object: any): ContainerCheckpointResponse {
+    return {
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      checkpointId: isSet(object.checkpointId) ? String(object.checkpointId) : '',
+      checkpointDir: isSet(object.checkpointDir) ? String(object.checkpointDir) : undefined,
+      checkpoints: Array.isArray(object?.checkpoints) ? object.checkpoints.map((e: any) => String(e)) : [],
+    }
   },
 
-  toJSON(message: ContainerInspectRequest): unknown {
+  toJSON(message: ContainerCheckpointResponse): unknown {
     const obj: any = {}
     message.container !== undefined &&
       (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.checkpointId !== undefined && (obj.checkpointId = message.checkpointId)
+    message.checkpointDir !== undefined && (obj.checkpointDir = message.checkpointDir)
+    if (message.checkpoints) {
+      obj.checkpoints = message.checkpoints.map(e => e)
+    } else {
+      obj.checkpoints = []
+    }
     return obj
   },
 }
 
-function createBaseCloseConnectionRequest(): CloseConnectionRequest {
-  return { reason: 0 }
+function createBaseContainerRestoreRequest(): ContainerRestoreRequest {
+  return { container: undefined, checkpointId: '' }
 }
 
-export const CloseConnectionRequest = {
-  fromJSON(object: any): CloseConnectionRequest {
-    return { reason: isSet(object.reason) ? closeReasonFromJSON(object.reason) : 0 }
+export const ContainerRestoreRequest = {
+  fromJSON(object: any): ContainerRestoreRequest {
+    return {
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      checkpointId: isSet(object.checkpointId) ? String(object.checkpointId) : '',
+      checkpointDir: isSet(object.checkpointDir) ? String(object.checkpointDir) : undefined,
+    }
   },
 
-  toJSON(message: CloseConnectionRequest): unknown {
+  toJSON(message: ContainerRestoreRequest): unknown {
     const obj: any = {}
-    message.reason !== undefined && (obj.reason = closeReasonToJSON(message.reason))
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.checkpointId !== undefined && (obj.checkpointId = message.checkpointId)
+    message.checkpointDir !== undefined && (obj.checkpointDir = message.checkpointDir)
+    return obj
+  },
+}
+
+function createBaseContainerRestoreResponse(): ContainerRestoreResponse {
+  return { container: undefined, checkpointId: '' }
+}
+
+export const ContainerRestoreResponse = {
+  fromJSON(object: any): ContainerRestoreResponse {
+    return {
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      checkpointId: isSet(object.checkpointId) ? String(object.checkpointId) : '',
+    }
+  },
+
+  toJSON(message: ContainerRestoreResponse): unknown {
+    const obj: any = {}
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.checkpointId !== undefined && (obj.checkpointId = message.checkpointId)
     return obj
   },
 }
@@ -1568,6 +4201,10 @@ export interface AgentClient {
 
   containerLogStream(request: Observable<ContainerLogMessage>, metadata: Metadata, ...rest: any): Observable<Empty>
 
+  workloadOperation(request: Observable<WorkloadOperationMessage>, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  debugSession(request: Observable<DebugSessionOutput>, metadata: Metadata, ...rest: any): Observable<DebugSessionInput>
+
   /** one-shot requests */
 
   secretList(request: ListSecretsResponse, metadata: Metadata, ...rest: any): Observable<Empty>
@@ -1577,6 +4214,32 @@ export interface AgentClient {
   containerLog(request: ContainerLogListResponse, metadata: Metadata, ...rest: any): Observable<Empty>
 
   containerInspect(request: ContainerInspectResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  deploymentDiff(request: DeploymentDiffResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  volumeUsage(request: VolumeUsageResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  pruneReport(request: PruneReportResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  trafficUsage(request: TrafficUsageResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  ingressConfig(request: IngressConfigResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  rollbackToRevision(request: RollbackToRevisionResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  resourceRecommendation(request: ResourceRecommendationResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  /** experimental, only requested from the agents with the capability */
+
+  containerCheckpoint(request: ContainerCheckpointResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  containerRestore(request: ContainerRestoreResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  /** periodic reports */
+
+  clusterCapabilities(request: ClusterCapabilitiesMessage, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  hostMetrics(request: Observable<HostMetricsMessage>, metadata: Metadata, ...rest: any): Observable<Empty>
 }
 
 /** Service handling deployment of containers and fetching statuses */
@@ -1620,6 +4283,14 @@ export interface AgentController {
     ...rest: any
   ): Promise<Empty> | Observable<Empty> | Empty
 
+  workloadOperation(
+    request: Observable<WorkloadOperationMessage>,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  debugSession(request: Observable<DebugSessionOutput>, metadata: Metadata, ...rest: any): Observable<DebugSessionInput>
+
   /** one-shot requests */
 
   secretList(request: ListSecretsResponse, metadata: Metadata, ...rest: any): Promise<Empty> | Observable<Empty> | Empty
@@ -1637,6 +4308,76 @@ export interface AgentController {
     metadata: Metadata,
     ...rest: any
   ): Promise<Empty> | Observable<Empty> | Empty
+
+  deploymentDiff(
+    request: DeploymentDiffResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  volumeUsage(
+    request: VolumeUsageResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  pruneReport(
+    request: PruneReportResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  trafficUsage(
+    request: TrafficUsageResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  ingressConfig(
+    request: IngressConfigResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  rollbackToRevision(
+    request: RollbackToRevisionResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  resourceRecommendation(
+    request: ResourceRecommendationResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  /** experimental, only requested from the agents with the capability */
+
+  containerCheckpoint(
+    request: ContainerCheckpointResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  containerRestore(
+    request: ContainerRestoreResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  /** periodic reports */
+
+  clusterCapabilities(
+    request: ClusterCapabilitiesMessage,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  hostMetrics(
+    request: Observable<HostMetricsMessage>,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
 }
 
 export function AgentControllerMethods() {
@@ -1650,12 +4391,29 @@ export function AgentControllerMethods() {
       'deleteContainers',
       'containerLog',
       'containerInspect',
+      'deploymentDiff',
+      'volumeUsage',
+      'pruneReport',
+      'trafficUsage',
+      'ingressConfig',
+      'rollbackToRevision',
+      'resourceRecommendation',
+      'containerCheckpoint',
+      'containerRestore',
+      'clusterCapabilities',
     ]
     for (const method of grpcMethods) {
       const descriptor: any = Reflect.getOwnPropertyDescriptor(constructor.prototype, method)
       GrpcMethod('Agent', method)(constructor.prototype[method], method, descriptor)
     }
-    const grpcStreamMethods: string[] = ['deploymentStatus', 'containerState', 'containerLogStream']
+    const grpcStreamMethods: string[] = [
+      'deploymentStatus',
+      'containerState',
+      'containerLogStream',
+      'workloadOperation',
+      'debugSession',
+      'hostMetrics',
+    ]
     for (const method of grpcStreamMethods) {
       const descriptor: any = Reflect.getOwnPropertyDescriptor(constructor.prototype, method)
       GrpcStreamMethod('Agent', method)(constructor.prototype[method], method, descriptor)
@@ -1665,6 +4423,72 @@ export function AgentControllerMethods() {
 
 export const AGENT_SERVICE_NAME = 'Agent'
 
+declare var self: any | undefined
+declare var window: any | undefined
+declare var global: any | undefined
+var tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== 'undefined') {
+    return globalThis
+  }
+  if (typeof self !== 'undefined') {
+    return self
+  }
+  if (typeof window !== 'undefined') {
+    return window
+  }
+  if (typeof global !== 'undefined') {
+    return global
+  }
+  throw 'Unable to locate global object'
+})()
+
+function bytesFromBase64(b64: string): Uint8Array {
+  if (tsProtoGlobalThis.Buffer) {
+    return Uint8Array.from(tsProtoGlobalThis.Buffer.from(b64, 'base64'))
+  } else {
+    const bin = tsProtoGlobalThis.atob(b64)
+    const arr = new Uint8Array(bin.length)
+    for (let i = 0; i < bin.length; ++i) {
+      arr[i] = bin.charCodeAt(i)
+    }
+    return arr
+  }
+}
+
+function base64FromBytes(arr: Uint8Array): string {
+  if (tsProtoGlobalThis.Buffer) {
+    return tsProtoGlobalThis.Buffer.from(arr).toString('base64')
+  } else {
+    const bin: string[] = []
+    arr.forEach(byte => {
+      bin.push(String.fromCharCode(byte))
+    })
+    return tsProtoGlobalThis.btoa(bin.join(''))
+  }
+}
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000
+  const nanos = (date.getTime() % 1_000) * 1_000_000
+  return { seconds, nanos }
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = t.seconds * 1_000
+  millis += t.nanos / 1_000_000
+  return new Date(millis)
+}
+
+function fromJsonTimestamp(o: any): Timestamp {
+  if (o instanceof Date) {
+    return toTimestamp(o)
+  } else if (typeof o === 'string') {
+    return toTimestamp(new Date(o))
+  } else {
+    return Timestamp.fromJSON(o)
+  }
+}
+
 function isObject(value: any): boolean {
   return typeof value === 'object' && value !== null
 }
@@ -55,6 +55,102 @@ export function containerStateToJSON(object: ContainerState): string {
   }
 }
 
+/**
+ * Readiness of a running container, reported by the docker health check
+ * or the kubernetes readiness and startup probes
+ */
+export enum ContainerHealth {
+  CONTAINER_HEALTH_UNSPECIFIED = 0,
+  HEALTH_STARTING = 1,
+  HEALTHY = 2,
+  UNHEALTHY = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function containerHealthFromJSON(object: any): ContainerHealth {
+  switch (object) {
+    case 0:
+    case 'CONTAINER_HEALTH_UNSPECIFIED':
+      return ContainerHealth.CONTAINER_HEALTH_UNSPECIFIED
+    case 1:
+    case 'HEALTH_STARTING':
+      return ContainerHealth.HEALTH_STARTING
+    case 2:
+    case 'HEALTHY':
+      return ContainerHealth.HEALTHY
+    case 3:
+    case 'UNHEALTHY':
+      return ContainerHealth.UNHEALTHY
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return ContainerHealth.UNRECOGNIZED
+  }
+}
+
+export function containerHealthToJSON(object: ContainerHealth): string {
+  switch (object) {
+    case ContainerHealth.CONTAINER_HEALTH_UNSPECIFIED:
+      return 'CONTAINER_HEALTH_UNSPECIFIED'
+    case ContainerHealth.HEALTH_STARTING:
+      return 'HEALTH_STARTING'
+    case ContainerHealth.HEALTHY:
+      return 'HEALTHY'
+    case ContainerHealth.UNHEALTHY:
+      return 'UNHEALTHY'
+    case ContainerHealth.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+export enum RestartBudgetState {
+  RESTART_BUDGET_STATE_UNSPECIFIED = 0,
+  WITHIN_BUDGET = 1,
+  /** The restarts are delayed, the container is waiting for the next one */
+  BACKING_OFF = 2,
+  /** The container is left stopped, its restart policy is disabled */
+  GAVE_UP = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function restartBudgetStateFromJSON(object: any): RestartBudgetState {
+  switch (object) {
+    case 0:
+    case 'RESTART_BUDGET_STATE_UNSPECIFIED':
+      return RestartBudgetState.RESTART_BUDGET_STATE_UNSPECIFIED
+    case 1:
+    case 'WITHIN_BUDGET':
+      return RestartBudgetState.WITHIN_BUDGET
+    case 2:
+    case 'BACKING_OFF':
+      return RestartBudgetState.BACKING_OFF
+    case 3:
+    case 'GAVE_UP':
+      return RestartBudgetState.GAVE_UP
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return RestartBudgetState.UNRECOGNIZED
+  }
+}
+
+export function restartBudgetStateToJSON(object: RestartBudgetState): string {
+  switch (object) {
+    case RestartBudgetState.RESTART_BUDGET_STATE_UNSPECIFIED:
+      return 'RESTART_BUDGET_STATE_UNSPECIFIED'
+    case RestartBudgetState.WITHIN_BUDGET:
+      return 'WITHIN_BUDGET'
+    case RestartBudgetState.BACKING_OFF:
+      return 'BACKING_OFF'
+    case RestartBudgetState.GAVE_UP:
+      return 'GAVE_UP'
+    case RestartBudgetState.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 export enum DeploymentStatus {
   DEPLOYMENT_STATUS_UNSPECIFIED = 0,
   PREPARING = 1,
@@ -568,6 +664,8 @@ export interface InstanceDeploymentItem {
   instanceId: string
   state: ContainerState
   reason: string
+  /** the effective entrypoint and args of the started container */
+  command: string[]
 }
 
 export interface DeployContainerProgress {
@@ -582,18 +680,72 @@ export interface DeploymentStatusMessage {
   containerProgress?: DeployContainerProgress | undefined
   log: string[]
   logLevel?: DeploymentMessageLevel | undefined
+  /** the node reporting the status, so the ones of a node group can be told apart */
+  nodeId?: string | undefined
 }
 
 export interface ContainerStateItemPort {
   internal: number
   external: number
+  hostIp?: string | undefined
+  protocol?: string | undefined
+}
+
+export interface ContainerStateItemNetwork {
+  name: string
+  ipAddress: string
+  ipv6Address?: string | undefined
+  gateway?: string | undefined
+  macAddress?: string | undefined
+  aliases: string[]
 }
 
 export interface ContainerStateListMessage {
   prefix?: string | undefined
+  /**
+   * Set on a page of a one shot listing if there are more items, the
+   * next page is requested with it
+   */
+  nextPageToken?: string | undefined
   data: ContainerStateItem[]
 }
 
+/** Filters of the listings, the unset ones match every item */
+export interface ListFilter {
+  /** The items in any of the states */
+  states: ContainerState[]
+  /**
+   * The items having every label, an empty value matches every value of
+   * the label
+   */
+  labels: { [key: string]: string }
+}
+
+export interface ListFilter_LabelsEntry {
+  key: string
+  value: string
+}
+
+/**
+ * A page of a listing, the items are ordered by their keys, the token is
+ * a cursor, so the items changed between the pages are not repeated or
+ * skipped
+ */
+export interface PageRequest {
+  /** The token of the previous page, the first page if it is not set */
+  pageToken?: string | undefined
+  /** The default size of the agent is used if it is zero */
+  pageSize: number
+}
+
+export interface RestartBudgetStatus {
+  state: RestartBudgetState
+  /** Restarts in the window of the budget */
+  restarts: number
+  maxRestarts: number
+  nextRestartAt?: Timestamp | undefined
+}
+
 export interface ContainerStateItem {
   id: ContainerIdentifier | undefined
   command: string
@@ -610,8 +762,22 @@ export interface ContainerStateItem {
   status: string
   imageName: string
   imageTag: string
+  /** Unspecified if the container has no health check or readiness probe */
+  health: ContainerHealth
+  /**
+   * Restarts by the restart policy or the kubernetes liveness probe, a
+   * growing count means the container keeps failing while it looks running
+   */
+  restartCount: number
+  /** The container or its last run was killed for running out of memory */
+  oomKilled: boolean
+  /** The time the container entered its current state */
+  stateSince?: Timestamp | undefined
+  /** Set if the container has a restart budget */
+  restartBudget?: RestartBudgetStatus | undefined
   ports: ContainerStateItemPort[]
   labels: { [key: string]: string }
+  networks: ContainerStateItemNetwork[]
 }
 
 export interface ContainerStateItem_LabelsEntry {
@@ -620,7 +786,10 @@ export interface ContainerStateItem_LabelsEntry {
 }
 
 export interface ContainerLogMessage {
+  /** The timestamp of the line is corrected by the clock skew of the node, if the date header of the responses tells it */
   log: string
+  /** The timestamp of the line is in the future even after the correction */
+  suspiciousTimestamp: boolean
 }
 
 export interface ContainerLogListResponse {
@@ -646,11 +815,52 @@ export interface ConfigContainer {
   keepFiles: boolean
 }
 
+/** Request of an http probe, the port defaults to the port of the health check config */
+export interface HTTPProbe {
+  path: string
+  port?: number | undefined
+  /** HTTP or HTTPS, HTTP by default */
+  scheme?: string | undefined
+  headers: { [key: string]: string }
+}
+
+export interface HTTPProbe_HeadersEntry {
+  key: string
+  value: string
+}
+
+export interface TCPProbe {
+  port?: number | undefined
+}
+
+export interface ExecProbe {
+  command: string[]
+}
+
+/** The unset fields are the defaults of crane, not the ones of kubernetes */
+export interface ProbeConfig {
+  http?: HTTPProbe | undefined
+  tcp?: TCPProbe | undefined
+  exec?: ExecProbe | undefined
+  initialDelaySeconds?: number | undefined
+  periodSeconds?: number | undefined
+  timeoutSeconds?: number | undefined
+  successThreshold?: number | undefined
+  failureThreshold?: number | undefined
+}
+
+/**
+ * The probe paths are http probes on the port, the probe configs take
+ * precedence over them
+ */
 export interface HealthCheckConfig {
   port?: number | undefined
   livenessProbe?: string | undefined
   readinessProbe?: string | undefined
   startupProbe?: string | undefined
+  liveness?: ProbeConfig | undefined
+  readiness?: ProbeConfig | undefined
+  startup?: ProbeConfig | undefined
 }
 
 export interface Resource {
@@ -677,6 +887,8 @@ export interface ListSecretsResponse {
   target: ContainerOrPrefix | undefined
   publicKey: string
   keys: string[]
+  /** Set if the keys are paged and there are more of them */
+  nextPageToken?: string | undefined
 }
 
 export interface UniqueKey {
@@ -716,7 +928,7 @@ export const Empty = {
 }
 
 function createBaseInstanceDeploymentItem(): InstanceDeploymentItem {
-  return { instanceId: '', state: 0, reason: '' }
+  return { instanceId: '', state: 0, reason: '', command: [] }
 }
 
 export const InstanceDeploymentItem = {
@@ -725,6 +937,7 @@ export const InstanceDeploymentItem = {
       instanceId: isSet(object.instanceId) ? String(object.instanceId) : '',
       state: isSet(object.state) ? containerStateFromJSON(object.state) : 0,
       reason: isSet(object.reason) ? String(object.reason) : '',
+      command: Array.isArray(object?.command) ? object.command.map((e: any) => String(e)) : [],
     }
   },
 
@@ -733,6 +946,11 @@ export const InstanceDeploymentItem = {
     message.instanceId !== undefined && (obj.instanceId = message.instanceId)
     message.state !== undefined && (obj.state = containerStateToJSON(message.state))
     message.reason !== undefined && (obj.reason = message.reason)
+    if (message.command) {
+      obj.command = message.command.map(e => e)
+    } else {
+      obj.command = []
+    }
     return obj
   },
 }
@@ -773,6 +991,7 @@ export const DeploymentStatusMessage = {
         : undefined,
       log: Array.isArray(object?.log) ? object.log.map((e: any) => String(e)) : [],
       logLevel: isSet(object.logLevel) ? deploymentMessageLevelFromJSON(object.logLevel) : undefined,
+      nodeId: isSet(object.nodeId) ? String(object.nodeId) : undefined,
     }
   },
 
@@ -794,6 +1013,7 @@ export const DeploymentStatusMessage = {
     }
     message.logLevel !== undefined &&
       (obj.logLevel = message.logLevel !== undefined ? deploymentMessageLevelToJSON(message.logLevel) : undefined)
+    message.nodeId !== undefined && (obj.nodeId = message.nodeId)
     return obj
   },
 }
@@ -807,6 +1027,8 @@ export const ContainerStateItemPort = {
     return {
       internal: isSet(object.internal) ? Number(object.internal) : 0,
       external: isSet(object.external) ? Number(object.external) : 0,
+      hostIp: isSet(object.hostIp) ? String(object.hostIp) : undefined,
+      protocol: isSet(object.protocol) ? String(object.protocol) : undefined,
     }
   },
 
@@ -814,6 +1036,40 @@ export const ContainerStateItemPort = {
     const obj: any = {}
     message.internal !== undefined && (obj.internal = Math.round(message.internal))
     message.external !== undefined && (obj.external = Math.round(message.external))
+    message.hostIp !== undefined && (obj.hostIp = message.hostIp)
+    message.protocol !== undefined && (obj.protocol = message.protocol)
+    return obj
+  },
+}
+
+function createBaseContainerStateItemNetwork(): ContainerStateItemNetwork {
+  return { name: '', ipAddress: '', aliases: [] }
+}
+
+export const ContainerStateItemNetwork = {
+  fromJSON(object: any): ContainerStateItemNetwork {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      ipAddress: isSet(object.ipAddress) ? String(object.ipAddress) : '',
+      ipv6Address: isSet(object.ipv6Address) ? String(object.ipv6Address) : undefined,
+      gateway: isSet(object.gateway) ? String(object.gateway) : undefined,
+      macAddress: isSet(object.macAddress) ? String(object.macAddress) : undefined,
+      aliases: Array.isArray(object?.aliases) ? object.aliases.map((e: any) => String(e)) : [],
+    }
+  },
+
+  toJSON(message: ContainerStateItemNetwork): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.ipAddress !== undefined && (obj.ipAddress = message.ipAddress)
+    message.ipv6Address !== undefined && (obj.ipv6Address = message.ipv6Address)
+    message.gateway !== undefined && (obj.gateway = message.gateway)
+    message.macAddress !== undefined && (obj.macAddress = message.macAddress)
+    if (message.aliases) {
+      obj.aliases = message.aliases.map(e => e)
+    } else {
+      obj.aliases = []
+    }
     return obj
   },
 }
@@ -826,6 +1082,7 @@ export const ContainerStateListMessage = {
   fromJSON(object: any): ContainerStateListMessage {
     return {
       prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : undefined,
       data: Array.isArray(object?.data) ? object.data.map((e: any) => ContainerStateItem.fromJSON(e)) : [],
     }
   },
@@ -833,6 +1090,7 @@ export const ContainerStateListMessage = {
   toJSON(message: ContainerStateListMessage): unknown {
     const obj: any = {}
     message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken)
     if (message.data) {
       obj.data = message.data.map(e => (e ? ContainerStateItem.toJSON(e) : undefined))
     } else {
@@ -842,6 +1100,101 @@ export const ContainerStateListMessage = {
   },
 }
 
+function createBaseListFilter(): ListFilter {
+  return { states: [], labels: {} }
+}
+
+export const ListFilter = {
+  fromJSON(object: any): ListFilter {
+    return {
+      states: Array.isArray(object?.states) ? object.states.map((e: any) => containerStateFromJSON(e)) : [],
+      labels: isObject(object.labels)
+        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: ListFilter): unknown {
+    const obj: any = {}
+    if (message.states) {
+      obj.states = message.states.map(e => containerStateToJSON(e))
+    } else {
+      obj.states = []
+    }
+    obj.labels = {}
+    if (message.labels) {
+      Object.entries(message.labels).forEach(([k, v]) => {
+        obj.labels[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseListFilter_LabelsEntry(): ListFilter_LabelsEntry {
+  return { key: '', value: '' }
+}
+
+export const ListFilter_LabelsEntry = {
+  fromJSON(object: any): ListFilter_LabelsEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: ListFilter_LabelsEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBasePageRequest(): PageRequest {
+  return { pageSize: 0 }
+}
+
+export const PageRequest = {
+  fromJSON(object: any): PageRequest {
+    return {
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : undefined,
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+    }
+  },
+
+  toJSON(message: PageRequest): unknown {
+    const obj: any = {}
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken)
+    message.pageSize !== undefined && (obj.pageSize = Math.round(message.pageSize))
+    return obj
+  },
+}
+
+function createBaseRestartBudgetStatus(): RestartBudgetStatus {
+  return { state: 0, restarts: 0, maxRestarts: 0 }
+}
+
+export const RestartBudgetStatus = {
+  fromJSON(object: any): RestartBudgetStatus {
+    return {
+      state: isSet(object.state) ? restartBudgetStateFromJSON(object.state) : 0,
+      restarts: isSet(object.restarts) ? Number(object.restarts) : 0,
+      maxRestarts: isSet(object.maxRestarts) ? Number(object.maxRestarts) : 0,
+      nextRestartAt: isSet(object.nextRestartAt) ? fromJsonTimestamp(object.nextRestartAt) : undefined,
+    }
+  },
+
+  toJSON(message: RestartBudgetStatus): unknown {
+    const obj: any = {}
+    message.state !== undefined && (obj.state = restartBudgetStateToJSON(message.state))
+    message.restarts !== undefined && (obj.restarts = Math.round(message.restarts))
+    message.maxRestarts !== undefined && (obj.maxRestarts = Math.round(message.maxRestarts))
+    message.nextRestartAt !== undefined && (obj.nextRestartAt = fromTimestamp(message.nextRestartAt).toISOString())
+    return obj
+  },
+}
+
 function createBaseContainerStateItem(): ContainerStateItem {
   return {
     id: undefined,
@@ -852,8 +1205,12 @@ function createBaseContainerStateItem(): ContainerStateItem {
     status: '',
     imageName: '',
     imageTag: '',
+    health: 0,
+    restartCount: 0,
+    oomKilled: false,
     ports: [],
     labels: {},
+    networks: [],
   }
 }
 
@@ -868,6 +1225,11 @@ export const ContainerStateItem = {
       status: isSet(object.status) ? String(object.status) : '',
       imageName: isSet(object.imageName) ? String(object.imageName) : '',
       imageTag: isSet(object.imageTag) ? String(object.imageTag) : '',
+      health: isSet(object.health) ? containerHealthFromJSON(object.health) : 0,
+      restartCount: isSet(object.restartCount) ? Number(object.restartCount) : 0,
+      oomKilled: isSet(object.oomKilled) ? Boolean(object.oomKilled) : false,
+      stateSince: isSet(object.stateSince) ? fromJsonTimestamp(object.stateSince) : undefined,
+      restartBudget: isSet(object.restartBudget) ? RestartBudgetStatus.fromJSON(object.restartBudget) : undefined,
       ports: Array.isArray(object?.ports) ? object.ports.map((e: any) => ContainerStateItemPort.fromJSON(e)) : [],
       labels: isObject(object.labels)
         ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
@@ -875,6 +1237,9 @@ export const ContainerStateItem = {
             return acc
           }, {})
         : {},
+      networks: Array.isArray(object?.networks)
+        ? object.networks.map((e: any) => ContainerStateItemNetwork.fromJSON(e))
+        : [],
     }
   },
 
@@ -888,6 +1253,12 @@ export const ContainerStateItem = {
     message.status !== undefined && (obj.status = message.status)
     message.imageName !== undefined && (obj.imageName = message.imageName)
     message.imageTag !== undefined && (obj.imageTag = message.imageTag)
+    message.health !== undefined && (obj.health = containerHealthToJSON(message.health))
+    message.restartCount !== undefined && (obj.restartCount = Math.round(message.restartCount))
+    message.oomKilled !== undefined && (obj.oomKilled = message.oomKilled)
+    message.stateSince !== undefined && (obj.stateSince = fromTimestamp(message.stateSince).toISOString())
+    message.restartBudget !== undefined &&
+      (obj.restartBudget = message.restartBudget ? RestartBudgetStatus.toJSON(message.restartBudget) : undefined)
     if (message.ports) {
       obj.ports = message.ports.map(e => (e ? ContainerStateItemPort.toJSON(e) : undefined))
     } else {
@@ -899,6 +1270,11 @@ export const ContainerStateItem = {
         obj.labels[k] = v
       })
     }
+    if (message.networks) {
+      obj.networks = message.networks.map(e => (e ? ContainerStateItemNetwork.toJSON(e) : undefined))
+    } else {
+      obj.networks = []
+    }
     return obj
   },
 }
@@ -921,17 +1297,21 @@ export const ContainerStateItem_LabelsEntry = {
 }
 
 function createBaseContainerLogMessage(): ContainerLogMessage {
-  return { log: '' }
+  return { log: '', suspiciousTimestamp: false }
 }
 
 export const ContainerLogMessage = {
   fromJSON(object: any): ContainerLogMessage {
-    return { log: isSet(object.log) ? String(object.log) : '' }
+    return {
+      log: isSet(object.log) ? String(object.log) : '',
+      suspiciousTimestamp: isSet(object.suspiciousTimestamp) ? Boolean(object.suspiciousTimestamp) : false,
+    }
   },
 
   toJSON(message: ContainerLogMessage): unknown {
     const obj: any = {}
     message.log !== undefined && (obj.log = message.log)
+    message.suspiciousTimestamp !== undefined && (obj.suspiciousTimestamp = message.suspiciousTimestamp)
     return obj
   },
 }
@@ -1022,6 +1402,125 @@ export const ConfigContainer = {
   },
 }
 
+function createBaseHTTPProbe(): HTTPProbe {
+  return { path: '', headers: {} }
+}
+
+export const HTTPProbe = {
+  fromJSON(object: any): HTTPProbe {
+    return {
+      path: isSet(object.path) ? String(object.path) : '',
+      port: isSet(object.port) ? Number(object.port) : undefined,
+      scheme: isSet(object.scheme) ? String(object.scheme) : undefined,
+      headers: isObject(object.headers)
+        ? Object.entries(object.headers).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: HTTPProbe): unknown {
+    const obj: any = {}
+    message.path !== undefined && (obj.path = message.path)
+    message.port !== undefined && (obj.port = Math.round(message.port))
+    message.scheme !== undefined && (obj.scheme = message.scheme)
+    obj.headers = {}
+    if (message.headers) {
+      Object.entries(message.headers).forEach(([k, v]) => {
+        obj.headers[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseHTTPProbe_HeadersEntry(): HTTPProbe_HeadersEntry {
+  return { key: '', value: '' }
+}
+
+export const HTTPProbe_HeadersEntry = {
+  fromJSON(object: any): HTTPProbe_HeadersEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: HTTPProbe_HeadersEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseTCPProbe(): TCPProbe {
+  return {}
+}
+
+export const TCPProbe = {
+  fromJSON(object: any): TCPProbe {
+    return { port: isSet(object.port) ? Number(object.port) : undefined }
+  },
+
+  toJSON(message: TCPProbe): unknown {
+    const obj: any = {}
+    message.port !== undefined && (obj.port = Math.round(message.port))
+    return obj
+  },
+}
+
+function createBaseExecProbe(): ExecProbe {
+  return { command: [] }
+}
+
+export const ExecProbe = {
+  fromJSON(object: any): ExecProbe {
+    return { command: Array.isArray(object?.command) ? object.command.map((e: any) => String(e)) : [] }
+  },
+
+  toJSON(message: ExecProbe): unknown {
+    const obj: any = {}
+    if (message.command) {
+      obj.command = message.command.map(e => e)
+    } else {
+      obj.command = []
+    }
+    return obj
+  },
+}
+
+function createBaseProbeConfig(): ProbeConfig {
+  return {}
+}
+
+export const ProbeConfig = {
+  fromJSON(object: any): ProbeConfig {
+    return {
+      http: isSet(object.http) ? HTTPProbe.fromJSON(object.http) : undefined,
+      tcp: isSet(object.tcp) ? TCPProbe.fromJSON(object.tcp) : undefined,
+      exec: isSet(object.exec) ? ExecProbe.fromJSON(object.exec) : undefined,
+      initialDelaySeconds: isSet(object.initialDelaySeconds) ? Number(object.initialDelaySeconds) : undefined,
+      periodSeconds: isSet(object.periodSeconds) ? Number(object.periodSeconds) : undefined,
+      timeoutSeconds: isSet(object.timeoutSeconds) ? Number(object.timeoutSeconds) : undefined,
+      successThreshold: isSet(object.successThreshold) ? Number(object.successThreshold) : undefined,
+      failureThreshold: isSet(object.failureThreshold) ? Number(object.failureThreshold) : undefined,
+    }
+  },
+
+  toJSON(message: ProbeConfig): unknown {
+    const obj: any = {}
+    message.http !== undefined && (obj.http = message.http ? HTTPProbe.toJSON(message.http) : undefined)
+    message.tcp !== undefined && (obj.tcp = message.tcp ? TCPProbe.toJSON(message.tcp) : undefined)
+    message.exec !== undefined && (obj.exec = message.exec ? ExecProbe.toJSON(message.exec) : undefined)
+    message.initialDelaySeconds !== undefined && (obj.initialDelaySeconds = Math.round(message.initialDelaySeconds))
+    message.periodSeconds !== undefined && (obj.periodSeconds = Math.round(message.periodSeconds))
+    message.timeoutSeconds !== undefined && (obj.timeoutSeconds = Math.round(message.timeoutSeconds))
+    message.successThreshold !== undefined && (obj.successThreshold = Math.round(message.successThreshold))
+    message.failureThreshold !== undefined && (obj.failureThreshold = Math.round(message.failureThreshold))
+    return obj
+  },
+}
+
 function createBaseHealthCheckConfig(): HealthCheckConfig {
   return {}
 }
@@ -1033,6 +1532,9 @@ export const HealthCheckConfig = {
       livenessProbe: isSet(object.livenessProbe) ? String(object.livenessProbe) : undefined,
       readinessProbe: isSet(object.readinessProbe) ? String(object.readinessProbe) : undefined,
       startupProbe: isSet(object.startupProbe) ? String(object.startupProbe) : undefined,
+      liveness: isSet(object.liveness) ? ProbeConfig.fromJSON(object.liveness) : undefined,
+      readiness: isSet(object.readiness) ? ProbeConfig.fromJSON(object.readiness) : undefined,
+      startup: isSet(object.startup) ? ProbeConfig.fromJSON(object.startup) : undefined,
     }
   },
 
@@ -1042,6 +1544,11 @@ export const HealthCheckConfig = {
     message.livenessProbe !== undefined && (obj.livenessProbe = message.livenessProbe)
     message.readinessProbe !== undefined && (obj.readinessProbe = message.readinessProbe)
     message.startupProbe !== undefined && (obj.startupProbe = message.startupProbe)
+    message.liveness !== undefined &&
+      (obj.liveness = message.liveness ? ProbeConfig.toJSON(message.liveness) : undefined)
+    message.readiness !== undefined &&
+      (obj.readiness = message.readiness ? ProbeConfig.toJSON(message.readiness) : undefined)
+    message.startup !== undefined && (obj.startup = message.startup ? ProbeConfig.toJSON(message.startup) : undefined)
     return obj
   },
 }
@@ -1134,6 +1641,7 @@ export const ListSecretsResponse = {
       target: isSet(object.target) ? ContainerOrPrefix.fromJSON(object.target) : undefined,
       publicKey: isSet(object.publicKey) ? String(object.publicKey) : '',
       keys: Array.isArray(object?.keys) ? object.keys.map((e: any) => String(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : undefined,
     }
   },
 
@@ -1146,6 +1654,7 @@ export const ListSecretsResponse = {
     } else {
       obj.keys = []
     }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken)
     return obj
   },
 }